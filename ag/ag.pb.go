@@ -11,6 +11,7 @@ import (
 	score "github.com/autograde/quickfeed/kit/score"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -279,7 +280,7 @@ func (x Submission_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Submission_Status.Descriptor instead.
 func (Submission_Status) EnumDescriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{16, 0}
+	return file_ag_ag_proto_rawDescGZIP(), []int{20, 0}
 }
 
 type GradingCriterion_Grade int32
@@ -328,7 +329,7 @@ func (x GradingCriterion_Grade) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GradingCriterion_Grade.Descriptor instead.
 func (GradingCriterion_Grade) EnumDescriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{20, 0}
+	return file_ag_ag_proto_rawDescGZIP(), []int{33, 0}
 }
 
 type SubmissionsForCourseRequest_Type int32
@@ -377,7 +378,7 @@ func (x SubmissionsForCourseRequest_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SubmissionsForCourseRequest_Type.Descriptor instead.
 func (SubmissionsForCourseRequest_Type) EnumDescriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{44, 0}
+	return file_ag_ag_proto_rawDescGZIP(), []int{62, 0}
 }
 
 type User struct {
@@ -779,6 +780,7 @@ type Course struct {
 	Enrollments      []*Enrollment         `protobuf:"bytes,13,rep,name=enrollments,proto3" json:"enrollments,omitempty"`
 	Assignments      []*Assignment         `protobuf:"bytes,14,rep,name=assignments,proto3" json:"assignments,omitempty"`
 	Groups           []*Group              `protobuf:"bytes,15,rep,name=groups,proto3" json:"groups,omitempty"`
+	DisplayTimeZone  string                `protobuf:"bytes,16,opt,name=displayTimeZone,proto3" json:"displayTimeZone,omitempty"` // IANA time zone in which deadlines are shown, e.g. Europe/Oslo
 }
 
 func (x *Course) Reset() {
@@ -918,6 +920,13 @@ func (x *Course) GetGroups() []*Group {
 	return nil
 }
 
+func (x *Course) GetDisplayTimeZone() string {
+	if x != nil {
+		return x.DisplayTimeZone
+	}
+	return ""
+}
+
 type Courses struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1499,19 +1508,69 @@ type Assignment struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID                uint64              `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	CourseID          uint64              `protobuf:"varint,2,opt,name=CourseID,proto3" json:"CourseID,omitempty"` // foreign key
-	Name              string              `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	ScriptFile        string              `protobuf:"bytes,4,opt,name=scriptFile,proto3" json:"scriptFile,omitempty"`
-	Deadline          string              `protobuf:"bytes,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
-	AutoApprove       bool                `protobuf:"varint,6,opt,name=autoApprove,proto3" json:"autoApprove,omitempty"`
-	Order             uint32              `protobuf:"varint,7,opt,name=order,proto3" json:"order,omitempty"`
-	IsGroupLab        bool                `protobuf:"varint,8,opt,name=isGroupLab,proto3" json:"isGroupLab,omitempty"`
-	ScoreLimit        uint32              `protobuf:"varint,9,opt,name=scoreLimit,proto3" json:"scoreLimit,omitempty"`               // minimal score limit for auto approval
-	Reviewers         uint32              `protobuf:"varint,10,opt,name=reviewers,proto3" json:"reviewers,omitempty"`                // number of reviewers that will review submissions for this assignment
-	Submissions       []*Submission       `protobuf:"bytes,11,rep,name=submissions,proto3" json:"submissions,omitempty"`             // submissions produced for this assignment
-	GradingBenchmarks []*GradingBenchmark `protobuf:"bytes,12,rep,name=gradingBenchmarks,proto3" json:"gradingBenchmarks,omitempty"` // grading benchmarks for this assignment
-	ContainerTimeout  uint32              `protobuf:"varint,13,opt,name=containerTimeout,proto3" json:"containerTimeout,omitempty"`  // TODO(meling) Do we need this?
+	ID                    uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID              uint64                 `protobuf:"varint,2,opt,name=CourseID,proto3" json:"CourseID,omitempty"` // foreign key
+	Name                  string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	ScriptFile            string                 `protobuf:"bytes,4,opt,name=scriptFile,proto3" json:"scriptFile,omitempty"`
+	Deadline              string                 `protobuf:"bytes,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	AutoApprove           bool                   `protobuf:"varint,6,opt,name=autoApprove,proto3" json:"autoApprove,omitempty"`
+	Order                 uint32                 `protobuf:"varint,7,opt,name=order,proto3" json:"order,omitempty"`
+	IsGroupLab            bool                   `protobuf:"varint,8,opt,name=isGroupLab,proto3" json:"isGroupLab,omitempty"`
+	ScoreLimit            uint32                 `protobuf:"varint,9,opt,name=scoreLimit,proto3" json:"scoreLimit,omitempty"`                                               // minimal score limit for auto approval
+	Reviewers             uint32                 `protobuf:"varint,10,opt,name=reviewers,proto3" json:"reviewers,omitempty"`                                                // number of reviewers that will review submissions for this assignment
+	Submissions           []*Submission          `protobuf:"bytes,11,rep,name=submissions,proto3" json:"submissions,omitempty"`                                             // submissions produced for this assignment
+	GradingBenchmarks     []*GradingBenchmark    `protobuf:"bytes,12,rep,name=gradingBenchmarks,proto3" json:"gradingBenchmarks,omitempty"`                                 // grading benchmarks for this assignment
+	ContainerTimeout      uint32                 `protobuf:"varint,13,opt,name=containerTimeout,proto3" json:"containerTimeout,omitempty"`                                  // TODO(meling) Do we need this?
+	PartOf                string                 `protobuf:"bytes,14,opt,name=partOf,proto3" json:"partOf,omitempty"`                                                       // name of the assignment heading the unit this assignment must be submitted with
+	Language              string                 `protobuf:"bytes,15,opt,name=language,proto3" json:"language,omitempty"`                                                   // programming language used to select default image and test command
+	Verbose               bool                   `protobuf:"varint,16,opt,name=verbose,proto3" json:"verbose,omitempty"`                                                    // capture standard error in addition to standard output from test runs
+	Retries               uint32                 `protobuf:"varint,17,opt,name=retries,proto3" json:"retries,omitempty"`                                                    // number of times failed retryable tests are rerun
+	Tests                 []*TestConfig          `protobuf:"bytes,18,rep,name=tests,proto3" json:"tests,omitempty"`                                                         // test-specific configuration for this assignment
+	TestsRepoURL          string                 `protobuf:"bytes,19,opt,name=testsRepoURL,proto3" json:"testsRepoURL,omitempty"`                                           // URL of external repository holding the tests for this assignment
+	TestsRepoRef          string                 `protobuf:"bytes,20,opt,name=testsRepoRef,proto3" json:"testsRepoRef,omitempty"`                                           // branch, tag or commit of the external tests repository
+	ManualOnly            bool                   `protobuf:"varint,21,opt,name=manualOnly,proto3" json:"manualOnly,omitempty"`                                              // assignment has no automated tests and is graded only by its grading benchmarks
+	ReleaseDate           *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=releaseDate,proto3" json:"releaseDate,omitempty" gorm:"serializer:timestamp;type:datetime"`   // date when the assignment is made available to students
+	CloseDate             *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=closeDate,proto3" json:"closeDate,omitempty" gorm:"serializer:timestamp;type:datetime"`       // date after which no more submissions are accepted
+	ExtraCreditCap        uint32                 `protobuf:"varint,24,opt,name=extraCreditCap,proto3" json:"extraCreditCap,omitempty"`                                      // maximum grade obtainable with extra credit tests
+	HidePoints            bool                   `protobuf:"varint,25,opt,name=hidePoints,proto3" json:"hidePoints,omitempty"`                                              // students are only shown whether tests pass, not their points
+	RequiredFiles         string                 `protobuf:"bytes,26,opt,name=requiredFiles,proto3" json:"requiredFiles,omitempty"`                                         // comma-separated list of files that must be present in submissions
+	ReviewerStrategy      string                 `protobuf:"bytes,27,opt,name=reviewerStrategy,proto3" json:"reviewerStrategy,omitempty"`                                   // strategy for distributing submissions among reviewers; empty for the default
+	MuteNotifications     bool                   `protobuf:"varint,28,opt,name=muteNotifications,proto3" json:"muteNotifications,omitempty"`                                // students are not notified when their results are ready
+	PlagiarismCheck       bool                   `protobuf:"varint,29,opt,name=plagiarismCheck,proto3" json:"plagiarismCheck,omitempty"`                                    // submissions are scanned by the plagiarism checker
+	SimilarityThreshold   uint32                 `protobuf:"varint,30,opt,name=similarityThreshold,proto3" json:"similarityThreshold,omitempty"`                            // similarity percentage above which submissions are flagged; zero for the checker's default
+	Network               string                 `protobuf:"bytes,31,opt,name=network,proto3" json:"network,omitempty"`                                                     // network policy for the containers running the tests; empty for none
+	CourseWeight          uint32                 `protobuf:"varint,32,opt,name=courseWeight,proto3" json:"courseWeight,omitempty"`                                          // share of the course grade given by this assignment
+	MaxParallel           uint32                 `protobuf:"varint,33,opt,name=maxParallel,proto3" json:"maxParallel,omitempty"`                                            // maximum number of test packages run concurrently; zero or one for serial
+	DiffMode              string                 `protobuf:"bytes,34,opt,name=diffMode,proto3" json:"diffMode,omitempty"`                                                   // comparison mode for output-diff grading; empty for exact
+	LatePenalty           uint32                 `protobuf:"varint,35,opt,name=latePenalty,proto3" json:"latePenalty,omitempty"`                                            // percentage points deducted for each started day after the deadline
+	OutputLimit           uint32                 `protobuf:"varint,36,opt,name=outputLimit,proto3" json:"outputLimit,omitempty"`                                            // maximum size in bytes of the captured test output; zero for the default
+	RetryOnInfra          uint32                 `protobuf:"varint,37,opt,name=retryOnInfra,proto3" json:"retryOnInfra,omitempty"`                                          // number of times the tests are rerun after an infrastructure failure
+	GraceHours            uint32                 `protobuf:"varint,38,opt,name=graceHours,proto3" json:"graceHours,omitempty"`                                              // hours after the deadline during which a submission is not late
+	TimeZone              string                 `protobuf:"bytes,39,opt,name=timeZone,proto3" json:"timeZone,omitempty"`                                                   // IANA time zone of the deadlines; empty for the server's local time zone
+	DeadlineTime          *timestamppb.Timestamp `protobuf:"bytes,40,opt,name=deadlineTime,proto3" json:"deadlineTime,omitempty" gorm:"serializer:timestamp;type:datetime"` // deadline in the assignment's time zone
+	Dockerfile            string                 `protobuf:"bytes,41,opt,name=dockerfile,proto3" json:"dockerfile,omitempty"`                                               // contents of the assignment's Dockerfile; empty for the course's image
+	DockerImage           string                 `protobuf:"bytes,42,opt,name=dockerImage,proto3" json:"dockerImage,omitempty"`                                             // docker image to run the tests in; empty for the image in the script
+	Tasks                 []*Task                `protobuf:"bytes,43,rep,name=tasks,proto3" json:"tasks,omitempty"`                                                         // tasks to be created as issues in the students' repositories
+	Requires              string                 `protobuf:"bytes,44,opt,name=requires,proto3" json:"requires,omitempty"`                                                   // comma-separated names of assignments that must be approved before submissions are accepted
+	MaxAttempts           uint32                 `protobuf:"varint,45,opt,name=maxAttempts,proto3" json:"maxAttempts,omitempty"`                                            // number of times a student or group may run the tests; zero for no limit
+	CooldownMinutes       uint32                 `protobuf:"varint,46,opt,name=cooldownMinutes,proto3" json:"cooldownMinutes,omitempty"`                                    // minutes a student or group must wait between test runs; zero for no wait
+	RandomSeed            bool                   `protobuf:"varint,47,opt,name=randomSeed,proto3" json:"randomSeed,omitempty"`                                              // tests receive a per-student seed for randomizing their inputs
+	Secrets               string                 `protobuf:"bytes,48,opt,name=secrets,proto3" json:"secrets,omitempty"`                                                     // comma-separated VARIABLE=name pairs of course secrets given to the tests
+	ApprovalRuns          uint32                 `protobuf:"varint,49,opt,name=approvalRuns,proto3" json:"approvalRuns,omitempty"`                                          // consecutive test runs reaching the score limit required for auto approval; zero or one for any run
+	ApproveBeforeDeadline bool                   `protobuf:"varint,50,opt,name=approveBeforeDeadline,proto3" json:"approveBeforeDeadline,omitempty"`                        // only submissions made before the deadline are auto approved
+	ManualReview          bool                   `protobuf:"varint,51,opt,name=manualReview,proto3" json:"manualReview,omitempty"`                                          // submissions are never auto approved, even above the score limit
+	CpuLimit              uint32                 `protobuf:"varint,52,opt,name=cpuLimit,proto3" json:"cpuLimit,omitempty"`                                                  // CPUs available to the test container, in thousandths of a CPU; zero for no limit
+	MemoryLimit           uint64                 `protobuf:"varint,53,opt,name=memoryLimit,proto3" json:"memoryLimit,omitempty"`                                            // memory available to the test container in bytes; zero for no limit
+	PidsLimit             uint32                 `protobuf:"varint,54,opt,name=pidsLimit,proto3" json:"pidsLimit,omitempty"`                                                // maximum number of processes and threads in the test container; zero for no limit
+	DiskLimit             uint64                 `protobuf:"varint,55,opt,name=diskLimit,proto3" json:"diskLimit,omitempty"`                                                // writable disk space of the test container in bytes; zero for no limit
+	AllowedHosts          string                 `protobuf:"bytes,56,opt,name=allowedHosts,proto3" json:"allowedHosts,omitempty"`                                           // comma-separated hosts reachable under the limited network policy; empty for common package registries
+	MaxReruns             uint32                 `protobuf:"varint,57,opt,name=maxReruns,proto3" json:"maxReruns,omitempty"`                                                // times a student or group may re-run the tests of their latest commit; zero for none
+	CoverageProfile       string                 `protobuf:"bytes,58,opt,name=coverageProfile,proto3" json:"coverageProfile,omitempty"`                                     // file in the artifacts folder to which the tests write their coverage profile; empty if coverage is not collected
+	CoverageWeight        uint32                 `protobuf:"varint,59,opt,name=coverageWeight,proto3" json:"coverageWeight,omitempty"`                                      // weight of the coverage score entry; zero for no entry
+	CoverageTarget        uint32                 `protobuf:"varint,60,opt,name=coverageTarget,proto3" json:"coverageTarget,omitempty"`                                      // coverage percentage earning the full coverage score; zero for 100
+	AnalysisChecks        []*AnalysisCheck       `protobuf:"bytes,61,rep,name=analysisChecks,proto3" json:"analysisChecks,omitempty"`                                       // static analysis checks run before the tests
+	Matrix                string                 `protobuf:"bytes,62,opt,name=matrix,proto3" json:"matrix,omitempty"`                                                       // comma-separated name=image entries of the build matrix, each running the tests; empty for a single run
+	TestFormat            string                 `protobuf:"bytes,63,opt,name=testFormat,proto3" json:"testFormat,omitempty"`                                               // format of the test output: text for go test -v or json for go test -json; empty for text
 }
 
 func (x *Assignment) Reset() {
@@ -1637,399 +1696,391 @@ func (x *Assignment) GetContainerTimeout() uint32 {
 	return 0
 }
 
-type Assignments struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Assignment) GetPartOf() string {
+	if x != nil {
+		return x.PartOf
+	}
+	return ""
+}
 
-	Assignments []*Assignment `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
+func (x *Assignment) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
 }
 
-func (x *Assignments) Reset() {
-	*x = Assignments{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Assignment) GetVerbose() bool {
+	if x != nil {
+		return x.Verbose
 	}
+	return false
 }
 
-func (x *Assignments) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Assignment) GetRetries() uint32 {
+	if x != nil {
+		return x.Retries
+	}
+	return 0
 }
 
-func (*Assignments) ProtoMessage() {}
+func (x *Assignment) GetTests() []*TestConfig {
+	if x != nil {
+		return x.Tests
+	}
+	return nil
+}
 
-func (x *Assignments) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Assignment) GetTestsRepoURL() string {
+	if x != nil {
+		return x.TestsRepoURL
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Assignments.ProtoReflect.Descriptor instead.
-func (*Assignments) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{15}
+func (x *Assignment) GetTestsRepoRef() string {
+	if x != nil {
+		return x.TestsRepoRef
+	}
+	return ""
 }
 
-func (x *Assignments) GetAssignments() []*Assignment {
+func (x *Assignment) GetManualOnly() bool {
 	if x != nil {
-		return x.Assignments
+		return x.ManualOnly
 	}
-	return nil
+	return false
 }
 
-type Submission struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Assignment) GetReleaseDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return nil
+}
 
-	ID           uint64            `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	AssignmentID uint64            `protobuf:"varint,2,opt,name=AssignmentID,proto3" json:"AssignmentID,omitempty"` // foreign key
-	UserID       uint64            `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty"`
-	GroupID      uint64            `protobuf:"varint,4,opt,name=groupID,proto3" json:"groupID,omitempty"`
-	Score        uint32            `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
-	CommitHash   string            `protobuf:"bytes,6,opt,name=commitHash,proto3" json:"commitHash,omitempty"`
-	Released     bool              `protobuf:"varint,7,opt,name=released,proto3" json:"released,omitempty"` // true => feedback is visible to the student or group members
-	Status       Submission_Status `protobuf:"varint,8,opt,name=status,proto3,enum=ag.Submission_Status" json:"status,omitempty"`
-	ApprovedDate string            `protobuf:"bytes,9,opt,name=approvedDate,proto3" json:"approvedDate,omitempty"`
-	Reviews      []*Review         `protobuf:"bytes,10,rep,name=reviews,proto3" json:"reviews,omitempty"`     // reviews produced for this submission
-	BuildInfo    *score.BuildInfo  `protobuf:"bytes,11,opt,name=BuildInfo,proto3" json:"BuildInfo,omitempty"` // build info for tests
-	Scores       []*score.Score    `protobuf:"bytes,12,rep,name=Scores,proto3" json:"Scores,omitempty"`       // list of scores for different tests
+func (x *Assignment) GetCloseDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CloseDate
+	}
+	return nil
 }
 
-func (x *Submission) Reset() {
-	*x = Submission{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Assignment) GetExtraCreditCap() uint32 {
+	if x != nil {
+		return x.ExtraCreditCap
 	}
+	return 0
 }
 
-func (x *Submission) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Assignment) GetHidePoints() bool {
+	if x != nil {
+		return x.HidePoints
+	}
+	return false
 }
 
-func (*Submission) ProtoMessage() {}
+func (x *Assignment) GetRequiredFiles() string {
+	if x != nil {
+		return x.RequiredFiles
+	}
+	return ""
+}
 
-func (x *Submission) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[16]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Assignment) GetReviewerStrategy() string {
+	if x != nil {
+		return x.ReviewerStrategy
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Submission.ProtoReflect.Descriptor instead.
-func (*Submission) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{16}
+func (x *Assignment) GetMuteNotifications() bool {
+	if x != nil {
+		return x.MuteNotifications
+	}
+	return false
 }
 
-func (x *Submission) GetID() uint64 {
+func (x *Assignment) GetPlagiarismCheck() bool {
 	if x != nil {
-		return x.ID
+		return x.PlagiarismCheck
 	}
-	return 0
+	return false
 }
 
-func (x *Submission) GetAssignmentID() uint64 {
+func (x *Assignment) GetSimilarityThreshold() uint32 {
 	if x != nil {
-		return x.AssignmentID
+		return x.SimilarityThreshold
 	}
 	return 0
 }
 
-func (x *Submission) GetUserID() uint64 {
+func (x *Assignment) GetNetwork() string {
 	if x != nil {
-		return x.UserID
+		return x.Network
 	}
-	return 0
+	return ""
 }
 
-func (x *Submission) GetGroupID() uint64 {
+func (x *Assignment) GetCourseWeight() uint32 {
 	if x != nil {
-		return x.GroupID
+		return x.CourseWeight
 	}
 	return 0
 }
 
-func (x *Submission) GetScore() uint32 {
+func (x *Assignment) GetMaxParallel() uint32 {
 	if x != nil {
-		return x.Score
+		return x.MaxParallel
 	}
 	return 0
 }
 
-func (x *Submission) GetCommitHash() string {
+func (x *Assignment) GetDiffMode() string {
 	if x != nil {
-		return x.CommitHash
+		return x.DiffMode
 	}
 	return ""
 }
 
-func (x *Submission) GetReleased() bool {
+func (x *Assignment) GetLatePenalty() uint32 {
 	if x != nil {
-		return x.Released
+		return x.LatePenalty
 	}
-	return false
+	return 0
 }
 
-func (x *Submission) GetStatus() Submission_Status {
+func (x *Assignment) GetOutputLimit() uint32 {
 	if x != nil {
-		return x.Status
+		return x.OutputLimit
 	}
-	return Submission_NONE
+	return 0
 }
 
-func (x *Submission) GetApprovedDate() string {
+func (x *Assignment) GetRetryOnInfra() uint32 {
 	if x != nil {
-		return x.ApprovedDate
+		return x.RetryOnInfra
 	}
-	return ""
+	return 0
 }
 
-func (x *Submission) GetReviews() []*Review {
+func (x *Assignment) GetGraceHours() uint32 {
 	if x != nil {
-		return x.Reviews
+		return x.GraceHours
 	}
-	return nil
+	return 0
 }
 
-func (x *Submission) GetBuildInfo() *score.BuildInfo {
+func (x *Assignment) GetTimeZone() string {
 	if x != nil {
-		return x.BuildInfo
+		return x.TimeZone
 	}
-	return nil
+	return ""
 }
 
-func (x *Submission) GetScores() []*score.Score {
+func (x *Assignment) GetDeadlineTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Scores
+		return x.DeadlineTime
 	}
 	return nil
 }
 
-type Submissions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Submissions []*Submission `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
+func (x *Assignment) GetDockerfile() string {
+	if x != nil {
+		return x.Dockerfile
+	}
+	return ""
 }
 
-func (x *Submissions) Reset() {
-	*x = Submissions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Assignment) GetDockerImage() string {
+	if x != nil {
+		return x.DockerImage
 	}
+	return ""
 }
 
-func (x *Submissions) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Assignment) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
 }
 
-func (*Submissions) ProtoMessage() {}
-
-func (x *Submissions) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Assignment) GetRequires() string {
+	if x != nil {
+		return x.Requires
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Submissions.ProtoReflect.Descriptor instead.
-func (*Submissions) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{17}
+func (x *Assignment) GetMaxAttempts() uint32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
 }
 
-func (x *Submissions) GetSubmissions() []*Submission {
+func (x *Assignment) GetCooldownMinutes() uint32 {
 	if x != nil {
-		return x.Submissions
+		return x.CooldownMinutes
 	}
-	return nil
+	return 0
 }
 
-type GradingBenchmark struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ID           uint64              `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	AssignmentID uint64              `protobuf:"varint,2,opt,name=AssignmentID,proto3" json:"AssignmentID,omitempty"` // foreign key
-	ReviewID     uint64              `protobuf:"varint,3,opt,name=ReviewID,proto3" json:"ReviewID,omitempty"`         // foreign key
-	Heading      string              `protobuf:"bytes,4,opt,name=heading,proto3" json:"heading,omitempty"`
-	Comment      string              `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
-	Criteria     []*GradingCriterion `protobuf:"bytes,6,rep,name=criteria,proto3" json:"criteria,omitempty" gorm:"foreignKey:BenchmarkID"`
+func (x *Assignment) GetRandomSeed() bool {
+	if x != nil {
+		return x.RandomSeed
+	}
+	return false
 }
 
-func (x *GradingBenchmark) Reset() {
-	*x = GradingBenchmark{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Assignment) GetSecrets() string {
+	if x != nil {
+		return x.Secrets
 	}
+	return ""
 }
 
-func (x *GradingBenchmark) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Assignment) GetApprovalRuns() uint32 {
+	if x != nil {
+		return x.ApprovalRuns
+	}
+	return 0
 }
 
-func (*GradingBenchmark) ProtoMessage() {}
+func (x *Assignment) GetApproveBeforeDeadline() bool {
+	if x != nil {
+		return x.ApproveBeforeDeadline
+	}
+	return false
+}
 
-func (x *GradingBenchmark) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Assignment) GetManualReview() bool {
+	if x != nil {
+		return x.ManualReview
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use GradingBenchmark.ProtoReflect.Descriptor instead.
-func (*GradingBenchmark) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{18}
+func (x *Assignment) GetCpuLimit() uint32 {
+	if x != nil {
+		return x.CpuLimit
+	}
+	return 0
 }
 
-func (x *GradingBenchmark) GetID() uint64 {
+func (x *Assignment) GetMemoryLimit() uint64 {
 	if x != nil {
-		return x.ID
+		return x.MemoryLimit
 	}
 	return 0
 }
 
-func (x *GradingBenchmark) GetAssignmentID() uint64 {
+func (x *Assignment) GetPidsLimit() uint32 {
 	if x != nil {
-		return x.AssignmentID
+		return x.PidsLimit
 	}
 	return 0
 }
 
-func (x *GradingBenchmark) GetReviewID() uint64 {
+func (x *Assignment) GetDiskLimit() uint64 {
 	if x != nil {
-		return x.ReviewID
+		return x.DiskLimit
 	}
 	return 0
 }
 
-func (x *GradingBenchmark) GetHeading() string {
+func (x *Assignment) GetAllowedHosts() string {
 	if x != nil {
-		return x.Heading
+		return x.AllowedHosts
 	}
 	return ""
 }
 
-func (x *GradingBenchmark) GetComment() string {
+func (x *Assignment) GetMaxReruns() uint32 {
 	if x != nil {
-		return x.Comment
+		return x.MaxReruns
 	}
-	return ""
+	return 0
 }
 
-func (x *GradingBenchmark) GetCriteria() []*GradingCriterion {
+func (x *Assignment) GetCoverageProfile() string {
 	if x != nil {
-		return x.Criteria
+		return x.CoverageProfile
 	}
-	return nil
+	return ""
 }
 
-type Benchmarks struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Benchmarks []*GradingBenchmark `protobuf:"bytes,1,rep,name=benchmarks,proto3" json:"benchmarks,omitempty"`
-}
-
-func (x *Benchmarks) Reset() {
-	*x = Benchmarks{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[19]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Assignment) GetCoverageWeight() uint32 {
+	if x != nil {
+		return x.CoverageWeight
 	}
+	return 0
 }
 
-func (x *Benchmarks) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Assignment) GetCoverageTarget() uint32 {
+	if x != nil {
+		return x.CoverageTarget
+	}
+	return 0
 }
 
-func (*Benchmarks) ProtoMessage() {}
-
-func (x *Benchmarks) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[19]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Assignment) GetAnalysisChecks() []*AnalysisCheck {
+	if x != nil {
+		return x.AnalysisChecks
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Benchmarks.ProtoReflect.Descriptor instead.
-func (*Benchmarks) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{19}
+func (x *Assignment) GetMatrix() string {
+	if x != nil {
+		return x.Matrix
+	}
+	return ""
 }
 
-func (x *Benchmarks) GetBenchmarks() []*GradingBenchmark {
+func (x *Assignment) GetTestFormat() string {
 	if x != nil {
-		return x.Benchmarks
+		return x.TestFormat
 	}
-	return nil
+	return ""
 }
 
-type GradingCriterion struct {
+// TestConfig holds configuration for a specific test of an assignment.
+type TestConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID          uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	BenchmarkID uint64                 `protobuf:"varint,2,opt,name=BenchmarkID,proto3" json:"BenchmarkID,omitempty"` // foreign key
-	Points      uint64                 `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`
-	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Grade       GradingCriterion_Grade `protobuf:"varint,5,opt,name=grade,proto3,enum=ag.GradingCriterion_Grade" json:"grade,omitempty"`
-	Comment     string                 `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
+	ID                  uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	AssignmentID        uint64 `protobuf:"varint,2,opt,name=AssignmentID,proto3" json:"AssignmentID,omitempty"` // foreign key
+	TestName            string `protobuf:"bytes,3,opt,name=testName,proto3" json:"testName,omitempty"`
+	Retryable           bool   `protobuf:"varint,4,opt,name=retryable,proto3" json:"retryable,omitempty"`                      // test is rerun if it fails, e.g., due to flaky infrastructure
+	ExtraCredit         bool   `protobuf:"varint,5,opt,name=extraCredit,proto3" json:"extraCredit,omitempty"`                  // test points are added on top of the grade obtained from the other tests
+	MaxScore            int32  `protobuf:"varint,6,opt,name=maxScore,proto3" json:"maxScore,omitempty"`                        // authoritative max score for the test; zero if reported by the test
+	Weight              int32  `protobuf:"varint,7,opt,name=weight,proto3" json:"weight,omitempty"`                            // authoritative weight for the test; zero if reported by the test
+	PassThreshold       uint32 `protobuf:"varint,8,opt,name=passThreshold,proto3" json:"passThreshold,omitempty"`              // percentage of the max score at which the test counts as passed; zero for the max score
+	Hidden              bool   `protobuf:"varint,9,opt,name=hidden,proto3" json:"hidden,omitempty"`                            // test score is not shown to students until after the deadline
+	RequiredForApproval bool   `protobuf:"varint,10,opt,name=requiredForApproval,proto3" json:"requiredForApproval,omitempty"` // test must pass for the submission to be auto approved
 }
 
-func (x *GradingCriterion) Reset() {
-	*x = GradingCriterion{}
+func (x *TestConfig) Reset() {
+	*x = TestConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[20]
+		mi := &file_ag_ag_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GradingCriterion) String() string {
+func (x *TestConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GradingCriterion) ProtoMessage() {}
+func (*TestConfig) ProtoMessage() {}
 
-func (x *GradingCriterion) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[20]
+func (x *TestConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2040,85 +2091,112 @@ func (x *GradingCriterion) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GradingCriterion.ProtoReflect.Descriptor instead.
-func (*GradingCriterion) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use TestConfig.ProtoReflect.Descriptor instead.
+func (*TestConfig) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GradingCriterion) GetID() uint64 {
+func (x *TestConfig) GetID() uint64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-func (x *GradingCriterion) GetBenchmarkID() uint64 {
+func (x *TestConfig) GetAssignmentID() uint64 {
 	if x != nil {
-		return x.BenchmarkID
+		return x.AssignmentID
 	}
 	return 0
 }
 
-func (x *GradingCriterion) GetPoints() uint64 {
+func (x *TestConfig) GetTestName() string {
 	if x != nil {
-		return x.Points
+		return x.TestName
+	}
+	return ""
+}
+
+func (x *TestConfig) GetRetryable() bool {
+	if x != nil {
+		return x.Retryable
+	}
+	return false
+}
+
+func (x *TestConfig) GetExtraCredit() bool {
+	if x != nil {
+		return x.ExtraCredit
+	}
+	return false
+}
+
+func (x *TestConfig) GetMaxScore() int32 {
+	if x != nil {
+		return x.MaxScore
 	}
 	return 0
 }
 
-func (x *GradingCriterion) GetDescription() string {
+func (x *TestConfig) GetWeight() int32 {
 	if x != nil {
-		return x.Description
+		return x.Weight
 	}
-	return ""
+	return 0
 }
 
-func (x *GradingCriterion) GetGrade() GradingCriterion_Grade {
+func (x *TestConfig) GetPassThreshold() uint32 {
 	if x != nil {
-		return x.Grade
+		return x.PassThreshold
 	}
-	return GradingCriterion_NONE
+	return 0
 }
 
-func (x *GradingCriterion) GetComment() string {
+func (x *TestConfig) GetHidden() bool {
 	if x != nil {
-		return x.Comment
+		return x.Hidden
 	}
-	return ""
+	return false
 }
 
-type Review struct {
+func (x *TestConfig) GetRequiredForApproval() bool {
+	if x != nil {
+		return x.RequiredForApproval
+	}
+	return false
+}
+
+// AnalysisCheck is a static analysis command, such as go vet, run on submissions before the tests.
+type AnalysisCheck struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID                uint64              `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	SubmissionID      uint64              `protobuf:"varint,2,opt,name=SubmissionID,proto3" json:"SubmissionID,omitempty"` // foreign key
-	ReviewerID        uint64              `protobuf:"varint,3,opt,name=ReviewerID,proto3" json:"ReviewerID,omitempty"`     // UserID of the reviewer
-	Feedback          string              `protobuf:"bytes,4,opt,name=feedback,proto3" json:"feedback,omitempty"`
-	Ready             bool                `protobuf:"varint,5,opt,name=ready,proto3" json:"ready,omitempty"`
-	Score             uint32              `protobuf:"varint,6,opt,name=score,proto3" json:"score,omitempty"`
-	GradingBenchmarks []*GradingBenchmark `protobuf:"bytes,7,rep,name=gradingBenchmarks,proto3" json:"gradingBenchmarks,omitempty" gorm:"foreignKey:ReviewID"`
-	Edited            string              `protobuf:"bytes,8,opt,name=edited,proto3" json:"edited,omitempty"`
+	ID           uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	AssignmentID uint64 `protobuf:"varint,2,opt,name=AssignmentID,proto3" json:"AssignmentID,omitempty"` // foreign key
+	Name         string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                  // name of the check, used for its annotations and score entry
+	Command      string `protobuf:"bytes,4,opt,name=command,proto3" json:"command,omitempty"`            // shell command run in the assignment folder, reporting findings as file:line: message
+	Weight       int32  `protobuf:"varint,5,opt,name=weight,proto3" json:"weight,omitempty"`             // weight of the check's score entry; zero if the findings are advisory only
+	Penalty      uint32 `protobuf:"varint,6,opt,name=penalty,proto3" json:"penalty,omitempty"`           // points out of 100 deducted from the check's score per finding; zero for 100
 }
 
-func (x *Review) Reset() {
-	*x = Review{}
+func (x *AnalysisCheck) Reset() {
+	*x = AnalysisCheck{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[21]
+		mi := &file_ag_ag_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Review) String() string {
+func (x *AnalysisCheck) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Review) ProtoMessage() {}
+func (*AnalysisCheck) ProtoMessage() {}
 
-func (x *Review) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[21]
+func (x *AnalysisCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2129,92 +2207,78 @@ func (x *Review) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Review.ProtoReflect.Descriptor instead.
-func (*Review) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use AnalysisCheck.ProtoReflect.Descriptor instead.
+func (*AnalysisCheck) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *Review) GetID() uint64 {
+func (x *AnalysisCheck) GetID() uint64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-func (x *Review) GetSubmissionID() uint64 {
-	if x != nil {
-		return x.SubmissionID
-	}
-	return 0
-}
-
-func (x *Review) GetReviewerID() uint64 {
+func (x *AnalysisCheck) GetAssignmentID() uint64 {
 	if x != nil {
-		return x.ReviewerID
+		return x.AssignmentID
 	}
 	return 0
 }
 
-func (x *Review) GetFeedback() string {
+func (x *AnalysisCheck) GetName() string {
 	if x != nil {
-		return x.Feedback
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Review) GetReady() bool {
+func (x *AnalysisCheck) GetCommand() string {
 	if x != nil {
-		return x.Ready
+		return x.Command
 	}
-	return false
+	return ""
 }
 
-func (x *Review) GetScore() uint32 {
+func (x *AnalysisCheck) GetWeight() int32 {
 	if x != nil {
-		return x.Score
+		return x.Weight
 	}
 	return 0
 }
 
-func (x *Review) GetGradingBenchmarks() []*GradingBenchmark {
-	if x != nil {
-		return x.GradingBenchmarks
-	}
-	return nil
-}
-
-func (x *Review) GetEdited() string {
+func (x *AnalysisCheck) GetPenalty() uint32 {
 	if x != nil {
-		return x.Edited
+		return x.Penalty
 	}
-	return ""
+	return 0
 }
 
-type Reviewers struct {
+type Assignments struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Reviewers []*User `protobuf:"bytes,1,rep,name=reviewers,proto3" json:"reviewers,omitempty"`
+	Assignments []*Assignment `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
 }
 
-func (x *Reviewers) Reset() {
-	*x = Reviewers{}
+func (x *Assignments) Reset() {
+	*x = Assignments{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[22]
+		mi := &file_ag_ag_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Reviewers) String() string {
+func (x *Assignments) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Reviewers) ProtoMessage() {}
+func (*Assignments) ProtoMessage() {}
 
-func (x *Reviewers) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[22]
+func (x *Assignments) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2225,44 +2289,49 @@ func (x *Reviewers) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Reviewers.ProtoReflect.Descriptor instead.
-func (*Reviewers) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use Assignments.ProtoReflect.Descriptor instead.
+func (*Assignments) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *Reviewers) GetReviewers() []*User {
+func (x *Assignments) GetAssignments() []*Assignment {
 	if x != nil {
-		return x.Reviewers
+		return x.Assignments
 	}
 	return nil
 }
 
-type ReviewRequest struct {
+// Task is a task of an assignment, parsed from a task-*.md file in the assignment's folder,
+// from which an issue can be created in the students' repositories.
+type Task struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CourseID uint64  `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	Review   *Review `protobuf:"bytes,2,opt,name=review,proto3" json:"review,omitempty"`
+	ID           uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	AssignmentID uint64 `protobuf:"varint,2,opt,name=AssignmentID,proto3" json:"AssignmentID,omitempty"` // foreign key
+	Name         string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                  // name of the task, e.g., hello_world for task-hello_world.md
+	Title        string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`                // title of the task, from the markdown heading on the first line
+	Body         string `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`                  // description of the task, in markdown
 }
 
-func (x *ReviewRequest) Reset() {
-	*x = ReviewRequest{}
+func (x *Task) Reset() {
+	*x = Task{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[23]
+		mi := &file_ag_ag_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReviewRequest) String() string {
+func (x *Task) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReviewRequest) ProtoMessage() {}
+func (*Task) ProtoMessage() {}
 
-func (x *ReviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[23]
+func (x *Task) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2273,50 +2342,78 @@ func (x *ReviewRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReviewRequest.ProtoReflect.Descriptor instead.
-func (*ReviewRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use Task.ProtoReflect.Descriptor instead.
+func (*Task) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ReviewRequest) GetCourseID() uint64 {
+func (x *Task) GetID() uint64 {
 	if x != nil {
-		return x.CourseID
+		return x.ID
 	}
 	return 0
 }
 
-func (x *ReviewRequest) GetReview() *Review {
+func (x *Task) GetAssignmentID() uint64 {
 	if x != nil {
-		return x.Review
+		return x.AssignmentID
 	}
-	return nil
+	return 0
 }
 
-type CourseRequest struct {
+func (x *Task) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Task) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Task) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+// Issue is the issue describing a task of an assignment in a student's or group's repository.
+type Issue struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CourseID uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	ID           uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	RepositoryID uint64 `protobuf:"varint,2,opt,name=RepositoryID,proto3" json:"RepositoryID,omitempty"` // foreign key
+	AssignmentID uint64 `protobuf:"varint,3,opt,name=AssignmentID,proto3" json:"AssignmentID,omitempty"`
+	TaskName     string `protobuf:"bytes,4,opt,name=taskName,proto3" json:"taskName,omitempty"`        // name of the task described by the issue
+	IssueNumber  uint64 `protobuf:"varint,5,opt,name=issueNumber,proto3" json:"issueNumber,omitempty"` // number of the issue in the repository
+	Title        string `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`              // title of the issue, as last created or updated from the task
+	Body         string `protobuf:"bytes,7,opt,name=body,proto3" json:"body,omitempty"`                // body of the issue, as last created or updated from the task
 }
 
-func (x *CourseRequest) Reset() {
-	*x = CourseRequest{}
+func (x *Issue) Reset() {
+	*x = Issue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[24]
+		mi := &file_ag_ag_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CourseRequest) String() string {
+func (x *Issue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CourseRequest) ProtoMessage() {}
+func (*Issue) ProtoMessage() {}
 
-func (x *CourseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[24]
+func (x *Issue) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2327,43 +2424,2126 @@ func (x *CourseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CourseRequest.ProtoReflect.Descriptor instead.
-func (*CourseRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use Issue.ProtoReflect.Descriptor instead.
+func (*Issue) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *CourseRequest) GetCourseID() uint64 {
+func (x *Issue) GetID() uint64 {
 	if x != nil {
-		return x.CourseID
+		return x.ID
 	}
 	return 0
 }
 
-type UserRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Issue) GetRepositoryID() uint64 {
+	if x != nil {
+		return x.RepositoryID
+	}
+	return 0
+}
 
-	UserID uint64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+func (x *Issue) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *Issue) GetTaskName() string {
+	if x != nil {
+		return x.TaskName
+	}
+	return ""
+}
+
+func (x *Issue) GetIssueNumber() uint64 {
+	if x != nil {
+		return x.IssueNumber
+	}
+	return 0
+}
+
+func (x *Issue) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Issue) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type Submission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID              uint64            `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	AssignmentID    uint64            `protobuf:"varint,2,opt,name=AssignmentID,proto3" json:"AssignmentID,omitempty"` // foreign key
+	UserID          uint64            `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	GroupID         uint64            `protobuf:"varint,4,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	Score           uint32            `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
+	CommitHash      string            `protobuf:"bytes,6,opt,name=commitHash,proto3" json:"commitHash,omitempty"`
+	Released        bool              `protobuf:"varint,7,opt,name=released,proto3" json:"released,omitempty"` // true => feedback is visible to the student or group members
+	Status          Submission_Status `protobuf:"varint,8,opt,name=status,proto3,enum=ag.Submission_Status" json:"status,omitempty"`
+	ApprovedDate    string            `protobuf:"bytes,9,opt,name=approvedDate,proto3" json:"approvedDate,omitempty"`
+	Reviews         []*Review         `protobuf:"bytes,10,rep,name=reviews,proto3" json:"reviews,omitempty"`                   // reviews produced for this submission
+	BuildInfo       *score.BuildInfo  `protobuf:"bytes,11,opt,name=BuildInfo,proto3" json:"BuildInfo,omitempty"`               // build info for tests
+	Scores          []*score.Score    `protobuf:"bytes,12,rep,name=Scores,proto3" json:"Scores,omitempty"`                     // list of scores for different tests
+	Attempts        uint32            `protobuf:"varint,13,opt,name=attempts,proto3" json:"attempts,omitempty"`                // number of times the tests have been run for the assignment, excluding rebuilds
+	PassStreak      uint32            `protobuf:"varint,14,opt,name=passStreak,proto3" json:"passStreak,omitempty"`            // number of consecutive test runs, excluding rebuilds, that passed the approval criteria
+	Reruns          uint32            `protobuf:"varint,15,opt,name=reruns,proto3" json:"reruns,omitempty"`                    // number of times the student or group has re-run the tests of the submission's commit
+	CoveragePercent float64           `protobuf:"fixed64,16,opt,name=coveragePercent,proto3" json:"coveragePercent,omitempty"` // percentage of the code covered by the tests; zero if coverage is not collected
+	Annotations     []*Annotation     `protobuf:"bytes,17,rep,name=annotations,proto3" json:"annotations,omitempty"`           // findings of the assignment's static analysis checks
+	MatrixResults   []*MatrixResult   `protobuf:"bytes,18,rep,name=matrixResults,proto3" json:"matrixResults,omitempty"`       // results for each entry of the assignment's build matrix; empty without a build matrix
+}
+
+func (x *Submission) Reset() {
+	*x = Submission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Submission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Submission) ProtoMessage() {}
+
+func (x *Submission) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Submission.ProtoReflect.Descriptor instead.
+func (*Submission) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Submission) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Submission) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *Submission) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *Submission) GetGroupID() uint64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *Submission) GetScore() uint32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Submission) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+func (x *Submission) GetReleased() bool {
+	if x != nil {
+		return x.Released
+	}
+	return false
+}
+
+func (x *Submission) GetStatus() Submission_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Submission_NONE
+}
+
+func (x *Submission) GetApprovedDate() string {
+	if x != nil {
+		return x.ApprovedDate
+	}
+	return ""
+}
+
+func (x *Submission) GetReviews() []*Review {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+func (x *Submission) GetBuildInfo() *score.BuildInfo {
+	if x != nil {
+		return x.BuildInfo
+	}
+	return nil
+}
+
+func (x *Submission) GetScores() []*score.Score {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *Submission) GetAttempts() uint32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *Submission) GetPassStreak() uint32 {
+	if x != nil {
+		return x.PassStreak
+	}
+	return 0
+}
+
+func (x *Submission) GetReruns() uint32 {
+	if x != nil {
+		return x.Reruns
+	}
+	return 0
+}
+
+func (x *Submission) GetCoveragePercent() float64 {
+	if x != nil {
+		return x.CoveragePercent
+	}
+	return 0
+}
+
+func (x *Submission) GetAnnotations() []*Annotation {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *Submission) GetMatrixResults() []*MatrixResult {
+	if x != nil {
+		return x.MatrixResults
+	}
+	return nil
+}
+
+// MatrixResult holds the result of running a submission's tests for one entry of the assignment's build matrix.
+type MatrixResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	SubmissionID uint64 `protobuf:"varint,2,opt,name=SubmissionID,proto3" json:"SubmissionID,omitempty"` // foreign key
+	Name         string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                  // name of the matrix entry
+	Image        string `protobuf:"bytes,4,opt,name=image,proto3" json:"image,omitempty"`                // docker image the tests ran in
+	Score        uint32 `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`               // grade computed from the scores of the entry's tests
+	BuildLog     string `protobuf:"bytes,6,opt,name=buildLog,proto3" json:"buildLog,omitempty"`
+	ExecTime     int64  `protobuf:"varint,7,opt,name=execTime,proto3" json:"execTime,omitempty"` // execution time of the tests in milliseconds
+	TimedOut     bool   `protobuf:"varint,8,opt,name=timedOut,proto3" json:"timedOut,omitempty"` // true if the tests were stopped by the container timeout
+}
+
+func (x *MatrixResult) Reset() {
+	*x = MatrixResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatrixResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatrixResult) ProtoMessage() {}
+
+func (x *MatrixResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatrixResult.ProtoReflect.Descriptor instead.
+func (*MatrixResult) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MatrixResult) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *MatrixResult) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *MatrixResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MatrixResult) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *MatrixResult) GetScore() uint32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *MatrixResult) GetBuildLog() string {
+	if x != nil {
+		return x.BuildLog
+	}
+	return ""
+}
+
+func (x *MatrixResult) GetExecTime() int64 {
+	if x != nil {
+		return x.ExecTime
+	}
+	return 0
+}
+
+func (x *MatrixResult) GetTimedOut() bool {
+	if x != nil {
+		return x.TimedOut
+	}
+	return false
+}
+
+// Annotation is a finding of a static analysis check on a submission.
+type Annotation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	SubmissionID uint64 `protobuf:"varint,2,opt,name=SubmissionID,proto3" json:"SubmissionID,omitempty"` // foreign key
+	CheckName    string `protobuf:"bytes,3,opt,name=checkName,proto3" json:"checkName,omitempty"`        // name of the check reporting the finding
+	File         string `protobuf:"bytes,4,opt,name=file,proto3" json:"file,omitempty"`                  // file of the finding, relative to the assignment folder
+	Line         uint32 `protobuf:"varint,5,opt,name=line,proto3" json:"line,omitempty"`                 // line of the finding; zero if unknown
+	Message      string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *Annotation) Reset() {
+	*x = Annotation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Annotation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Annotation) ProtoMessage() {}
+
+func (x *Annotation) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Annotation.ProtoReflect.Descriptor instead.
+func (*Annotation) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Annotation) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Annotation) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *Annotation) GetCheckName() string {
+	if x != nil {
+		return x.CheckName
+	}
+	return ""
+}
+
+func (x *Annotation) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *Annotation) GetLine() uint32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *Annotation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type Submissions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Submissions []*Submission `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
+}
+
+func (x *Submissions) Reset() {
+	*x = Submissions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Submissions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Submissions) ProtoMessage() {}
+
+func (x *Submissions) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Submissions.ProtoReflect.Descriptor instead.
+func (*Submissions) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Submissions) GetSubmissions() []*Submission {
+	if x != nil {
+		return x.Submissions
+	}
+	return nil
+}
+
+// Artifact is a file, such as a coverage report or a rendered plot,
+// written by the tests of a submission to the artifacts folder of the test container.
+type Artifact struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	SubmissionID uint64 `protobuf:"varint,2,opt,name=SubmissionID,proto3" json:"SubmissionID,omitempty" gorm:"uniqueIndex:idx_unique_artifact"` // foreign key
+	Name         string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty" gorm:"uniqueIndex:idx_unique_artifact"`                  // path of the file in the artifacts folder
+	Size         uint64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`                                                        // size of the file in bytes
+	Content      []byte `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`                                                   // contents of the file; omitted when listing the artifacts of a submission
+}
+
+func (x *Artifact) Reset() {
+	*x = Artifact{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Artifact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Artifact) ProtoMessage() {}
+
+func (x *Artifact) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Artifact.ProtoReflect.Descriptor instead.
+func (*Artifact) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Artifact) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Artifact) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *Artifact) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Artifact) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Artifact) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type Artifacts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Artifacts []*Artifact `protobuf:"bytes,1,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
+}
+
+func (x *Artifacts) Reset() {
+	*x = Artifacts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Artifacts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Artifacts) ProtoMessage() {}
+
+func (x *Artifacts) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Artifacts.ProtoReflect.Descriptor instead.
+func (*Artifacts) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Artifacts) GetArtifacts() []*Artifact {
+	if x != nil {
+		return x.Artifacts
+	}
+	return nil
+}
+
+// DeadlineExtension grants an individual student or a group a later deadline for an assignment.
+type DeadlineExtension struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	AssignmentID uint64 `protobuf:"varint,2,opt,name=AssignmentID,proto3" json:"AssignmentID,omitempty" gorm:"uniqueIndex:idx_unique_extension"` // foreign key
+	UserID       uint64 `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty" gorm:"uniqueIndex:idx_unique_extension"`
+	GroupID      uint64 `protobuf:"varint,4,opt,name=groupID,proto3" json:"groupID,omitempty" gorm:"uniqueIndex:idx_unique_extension"`
+	Deadline     string `protobuf:"bytes,5,opt,name=deadline,proto3" json:"deadline,omitempty"` // extended deadline, replacing the assignment's deadline
+}
+
+func (x *DeadlineExtension) Reset() {
+	*x = DeadlineExtension{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeadlineExtension) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadlineExtension) ProtoMessage() {}
+
+func (x *DeadlineExtension) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadlineExtension.ProtoReflect.Descriptor instead.
+func (*DeadlineExtension) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeadlineExtension) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *DeadlineExtension) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *DeadlineExtension) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *DeadlineExtension) GetGroupID() uint64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *DeadlineExtension) GetDeadline() string {
+	if x != nil {
+		return x.Deadline
+	}
+	return ""
+}
+
+// CourseSecret is a secret, such as the credentials of an external service,
+// given to the tests of a course's assignments. Secrets are never sent to clients.
+type CourseSecret struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID       uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID uint64 `protobuf:"varint,2,opt,name=CourseID,proto3" json:"CourseID,omitempty" gorm:"uniqueIndex:idx_unique_secret"` // foreign key
+	Name     string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty" gorm:"uniqueIndex:idx_unique_secret"`
+	Value    string `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *CourseSecret) Reset() {
+	*x = CourseSecret{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CourseSecret) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseSecret) ProtoMessage() {}
+
+func (x *CourseSecret) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseSecret.ProtoReflect.Descriptor instead.
+func (*CourseSecret) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CourseSecret) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *CourseSecret) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *CourseSecret) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CourseSecret) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// CourseModule groups assignments whose grades together make up a share of the course grade.
+type CourseModule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID          uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID    uint64 `protobuf:"varint,2,opt,name=CourseID,proto3" json:"CourseID,omitempty"` // foreign key
+	Name        string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Weight      uint32 `protobuf:"varint,4,opt,name=weight,proto3" json:"weight,omitempty"`          // share of the course grade given by the module
+	Assignments string `protobuf:"bytes,5,opt,name=assignments,proto3" json:"assignments,omitempty"` // comma-separated names of the module's assignments
+}
+
+func (x *CourseModule) Reset() {
+	*x = CourseModule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CourseModule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseModule) ProtoMessage() {}
+
+func (x *CourseModule) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseModule.ProtoReflect.Descriptor instead.
+func (*CourseModule) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CourseModule) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *CourseModule) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *CourseModule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CourseModule) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *CourseModule) GetAssignments() string {
+	if x != nil {
+		return x.Assignments
+	}
+	return ""
+}
+
+// ModuleGrade is a student's grade for the assignments of a course module.
+type ModuleGrade struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Weight uint32 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	Grade  uint32 `protobuf:"varint,3,opt,name=grade,proto3" json:"grade,omitempty"`
+}
+
+func (x *ModuleGrade) Reset() {
+	*x = ModuleGrade{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModuleGrade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleGrade) ProtoMessage() {}
+
+func (x *ModuleGrade) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModuleGrade.ProtoReflect.Descriptor instead.
+func (*ModuleGrade) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ModuleGrade) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ModuleGrade) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *ModuleGrade) GetGrade() uint32 {
+	if x != nil {
+		return x.Grade
+	}
+	return 0
+}
+
+// CourseGrade is a student's course grade, computed from the grades of the
+// course's assignments, along with the grades for each of the course's modules.
+type CourseGrade struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID uint64         `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	UserID   uint64         `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	Grade    uint32         `protobuf:"varint,3,opt,name=grade,proto3" json:"grade,omitempty"`
+	Modules  []*ModuleGrade `protobuf:"bytes,4,rep,name=modules,proto3" json:"modules,omitempty"`
+}
+
+func (x *CourseGrade) Reset() {
+	*x = CourseGrade{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CourseGrade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseGrade) ProtoMessage() {}
+
+func (x *CourseGrade) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseGrade.ProtoReflect.Descriptor instead.
+func (*CourseGrade) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CourseGrade) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *CourseGrade) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *CourseGrade) GetGrade() uint32 {
+	if x != nil {
+		return x.Grade
+	}
+	return 0
+}
+
+func (x *CourseGrade) GetModules() []*ModuleGrade {
+	if x != nil {
+		return x.Modules
+	}
+	return nil
+}
+
+type GradingBenchmark struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64              `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	AssignmentID uint64              `protobuf:"varint,2,opt,name=AssignmentID,proto3" json:"AssignmentID,omitempty"` // foreign key
+	ReviewID     uint64              `protobuf:"varint,3,opt,name=ReviewID,proto3" json:"ReviewID,omitempty"`         // foreign key
+	Heading      string              `protobuf:"bytes,4,opt,name=heading,proto3" json:"heading,omitempty"`
+	Comment      string              `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	Criteria     []*GradingCriterion `protobuf:"bytes,6,rep,name=criteria,proto3" json:"criteria,omitempty" gorm:"foreignKey:BenchmarkID"`
+	Weight       int32               `protobuf:"varint,7,opt,name=weight,proto3" json:"weight,omitempty"` // percentage of the combined grade given by this benchmark
+}
+
+func (x *GradingBenchmark) Reset() {
+	*x = GradingBenchmark{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GradingBenchmark) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradingBenchmark) ProtoMessage() {}
+
+func (x *GradingBenchmark) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradingBenchmark.ProtoReflect.Descriptor instead.
+func (*GradingBenchmark) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GradingBenchmark) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *GradingBenchmark) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *GradingBenchmark) GetReviewID() uint64 {
+	if x != nil {
+		return x.ReviewID
+	}
+	return 0
+}
+
+func (x *GradingBenchmark) GetHeading() string {
+	if x != nil {
+		return x.Heading
+	}
+	return ""
+}
+
+func (x *GradingBenchmark) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *GradingBenchmark) GetCriteria() []*GradingCriterion {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+func (x *GradingBenchmark) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type Benchmarks struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Benchmarks []*GradingBenchmark `protobuf:"bytes,1,rep,name=benchmarks,proto3" json:"benchmarks,omitempty"`
+}
+
+func (x *Benchmarks) Reset() {
+	*x = Benchmarks{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Benchmarks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Benchmarks) ProtoMessage() {}
+
+func (x *Benchmarks) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Benchmarks.ProtoReflect.Descriptor instead.
+func (*Benchmarks) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Benchmarks) GetBenchmarks() []*GradingBenchmark {
+	if x != nil {
+		return x.Benchmarks
+	}
+	return nil
+}
+
+type GradingCriterion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID          uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	BenchmarkID uint64                 `protobuf:"varint,2,opt,name=BenchmarkID,proto3" json:"BenchmarkID,omitempty"` // foreign key
+	Points      uint64                 `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Grade       GradingCriterion_Grade `protobuf:"varint,5,opt,name=grade,proto3,enum=ag.GradingCriterion_Grade" json:"grade,omitempty"`
+	Comment     string                 `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *GradingCriterion) Reset() {
+	*x = GradingCriterion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GradingCriterion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradingCriterion) ProtoMessage() {}
+
+func (x *GradingCriterion) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradingCriterion.ProtoReflect.Descriptor instead.
+func (*GradingCriterion) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GradingCriterion) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *GradingCriterion) GetBenchmarkID() uint64 {
+	if x != nil {
+		return x.BenchmarkID
+	}
+	return 0
+}
+
+func (x *GradingCriterion) GetPoints() uint64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *GradingCriterion) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GradingCriterion) GetGrade() GradingCriterion_Grade {
+	if x != nil {
+		return x.Grade
+	}
+	return GradingCriterion_NONE
+}
+
+func (x *GradingCriterion) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type Review struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID                uint64              `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	SubmissionID      uint64              `protobuf:"varint,2,opt,name=SubmissionID,proto3" json:"SubmissionID,omitempty"` // foreign key
+	ReviewerID        uint64              `protobuf:"varint,3,opt,name=ReviewerID,proto3" json:"ReviewerID,omitempty"`     // UserID of the reviewer
+	Feedback          string              `protobuf:"bytes,4,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	Ready             bool                `protobuf:"varint,5,opt,name=ready,proto3" json:"ready,omitempty"`
+	Score             uint32              `protobuf:"varint,6,opt,name=score,proto3" json:"score,omitempty"`
+	GradingBenchmarks []*GradingBenchmark `protobuf:"bytes,7,rep,name=gradingBenchmarks,proto3" json:"gradingBenchmarks,omitempty" gorm:"foreignKey:ReviewID"`
+	Edited            string              `protobuf:"bytes,8,opt,name=edited,proto3" json:"edited,omitempty"`
+}
+
+func (x *Review) Reset() {
+	*x = Review{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Review) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Review) ProtoMessage() {}
+
+func (x *Review) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Review.ProtoReflect.Descriptor instead.
+func (*Review) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Review) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Review) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *Review) GetReviewerID() uint64 {
+	if x != nil {
+		return x.ReviewerID
+	}
+	return 0
+}
+
+func (x *Review) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+func (x *Review) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *Review) GetScore() uint32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Review) GetGradingBenchmarks() []*GradingBenchmark {
+	if x != nil {
+		return x.GradingBenchmarks
+	}
+	return nil
+}
+
+func (x *Review) GetEdited() string {
+	if x != nil {
+		return x.Edited
+	}
+	return ""
+}
+
+type Reviewers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reviewers []*User `protobuf:"bytes,1,rep,name=reviewers,proto3" json:"reviewers,omitempty"`
+}
+
+func (x *Reviewers) Reset() {
+	*x = Reviewers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Reviewers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reviewers) ProtoMessage() {}
+
+func (x *Reviewers) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reviewers.ProtoReflect.Descriptor instead.
+func (*Reviewers) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Reviewers) GetReviewers() []*User {
+	if x != nil {
+		return x.Reviewers
+	}
+	return nil
+}
+
+type ReviewRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID uint64  `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Review   *Review `protobuf:"bytes,2,opt,name=review,proto3" json:"review,omitempty"`
+}
+
+func (x *ReviewRequest) Reset() {
+	*x = ReviewRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewRequest) ProtoMessage() {}
+
+func (x *ReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewRequest.ProtoReflect.Descriptor instead.
+func (*ReviewRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ReviewRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *ReviewRequest) GetReview() *Review {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+type CourseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+}
+
+func (x *CourseRequest) Reset() {
+	*x = CourseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseRequest) ProtoMessage() {}
+
+func (x *CourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseRequest.ProtoReflect.Descriptor instead.
+func (*CourseRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CourseRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+// BuildLogRequest identifies the test run of an assignment for a student or a group.
+type BuildLogRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID     uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AssignmentID uint64 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	UserID       uint64 `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty"`   // student whose test run to stream; zero for a group
+	GroupID      uint64 `protobuf:"varint,4,opt,name=groupID,proto3" json:"groupID,omitempty"` // group whose test run to stream; zero for a student
+}
+
+func (x *BuildLogRequest) Reset() {
+	*x = BuildLogRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildLogRequest) ProtoMessage() {}
+
+func (x *BuildLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildLogRequest.ProtoReflect.Descriptor instead.
+func (*BuildLogRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *BuildLogRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *BuildLogRequest) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *BuildLogRequest) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *BuildLogRequest) GetGroupID() uint64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+// BuildLogChunk holds output of a test run in progress.
+type BuildLogChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Log string `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
+}
+
+func (x *BuildLogChunk) Reset() {
+	*x = BuildLogChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildLogChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildLogChunk) ProtoMessage() {}
+
+func (x *BuildLogChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildLogChunk.ProtoReflect.Descriptor instead.
+func (*BuildLogChunk) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *BuildLogChunk) GetLog() string {
+	if x != nil {
+		return x.Log
+	}
+	return ""
+}
+
+// RegradeProgress describes the progress of re-running the tests
+// of the latest submissions for an assignment.
+type RegradeProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentID uint64 `protobuf:"varint,1,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	TriggeredBy  string `protobuf:"bytes,2,opt,name=triggeredBy,proto3" json:"triggeredBy,omitempty"` // login of the teacher who started the regrade
+	Total        uint32 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`            // latest submissions of the assignment's students and groups
+	Completed    uint32 `protobuf:"varint,4,opt,name=completed,proto3" json:"completed,omitempty"`    // submissions whose test run has completed, including failed ones
+	Failed       uint32 `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`          // submissions whose test run could not be completed
+}
+
+func (x *RegradeProgress) Reset() {
+	*x = RegradeProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegradeProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegradeProgress) ProtoMessage() {}
+
+func (x *RegradeProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegradeProgress.ProtoReflect.Descriptor instead.
+func (*RegradeProgress) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RegradeProgress) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *RegradeProgress) GetTriggeredBy() string {
+	if x != nil {
+		return x.TriggeredBy
+	}
+	return ""
+}
+
+func (x *RegradeProgress) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *RegradeProgress) GetCompleted() uint32 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *RegradeProgress) GetFailed() uint32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+// SubmissionQueue describes the test runs queued in a course.
+type SubmissionQueue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pending  uint32 `protobuf:"varint,1,opt,name=pending,proto3" json:"pending,omitempty"`   // test runs waiting to start
+	Running  uint32 `protobuf:"varint,2,opt,name=running,proto3" json:"running,omitempty"`   // test runs executing
+	Position uint32 `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"` // position of the user's first waiting test run, starting at 1; zero if none
+}
+
+func (x *SubmissionQueue) Reset() {
+	*x = SubmissionQueue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmissionQueue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionQueue) ProtoMessage() {}
+
+func (x *SubmissionQueue) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionQueue.ProtoReflect.Descriptor instead.
+func (*SubmissionQueue) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SubmissionQueue) GetPending() uint32 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *SubmissionQueue) GetRunning() uint32 {
+	if x != nil {
+		return x.Running
+	}
+	return 0
+}
+
+func (x *SubmissionQueue) GetPosition() uint32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type UserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID uint64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
 }
 
 func (x *UserRequest) Reset() {
 	*x = UserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[25]
+		mi := &file_ag_ag_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserRequest) ProtoMessage() {}
+
+func (x *UserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserRequest.ProtoReflect.Descriptor instead.
+func (*UserRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *UserRequest) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type GetGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID uint64 `protobuf:"varint,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
+}
+
+func (x *GetGroupRequest) Reset() {
+	*x = GetGroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupRequest) ProtoMessage() {}
+
+func (x *GetGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetGroupRequest) GetGroupID() uint64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+type GroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID   uint64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	GroupID  uint64 `protobuf:"varint,2,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	CourseID uint64 `protobuf:"varint,3,opt,name=courseID,proto3" json:"courseID,omitempty"`
+}
+
+func (x *GroupRequest) Reset() {
+	*x = GroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupRequest) ProtoMessage() {}
+
+func (x *GroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupRequest.ProtoReflect.Descriptor instead.
+func (*GroupRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GroupRequest) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *GroupRequest) GetGroupID() uint64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *GroupRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+type Provider struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+}
+
+func (x *Provider) Reset() {
+	*x = Provider{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Provider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Provider) ProtoMessage() {}
+
+func (x *Provider) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Provider.ProtoReflect.Descriptor instead.
+func (*Provider) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *Provider) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+type OrgRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrgName string `protobuf:"bytes,1,opt,name=orgName,proto3" json:"orgName,omitempty"`
+}
+
+func (x *OrgRequest) Reset() {
+	*x = OrgRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrgRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrgRequest) ProtoMessage() {}
+
+func (x *OrgRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrgRequest.ProtoReflect.Descriptor instead.
+func (*OrgRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *OrgRequest) GetOrgName() string {
+	if x != nil {
+		return x.OrgName
+	}
+	return ""
+}
+
+type Organization struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID          uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Path        string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Avatar      string `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	PaymentPlan string `protobuf:"bytes,4,opt,name=paymentPlan,proto3" json:"paymentPlan,omitempty"`
+}
+
+func (x *Organization) Reset() {
+	*x = Organization{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Organization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Organization) ProtoMessage() {}
+
+func (x *Organization) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Organization.ProtoReflect.Descriptor instead.
+func (*Organization) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Organization) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Organization) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Organization) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *Organization) GetPaymentPlan() string {
+	if x != nil {
+		return x.PaymentPlan
+	}
+	return ""
+}
+
+type Organizations struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Organizations []*Organization `protobuf:"bytes,1,rep,name=organizations,proto3" json:"organizations,omitempty"`
+}
+
+func (x *Organizations) Reset() {
+	*x = Organizations{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Organizations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Organizations) ProtoMessage() {}
+
+func (x *Organizations) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Organizations.ProtoReflect.Descriptor instead.
+func (*Organizations) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *Organizations) GetOrganizations() []*Organization {
+	if x != nil {
+		return x.Organizations
+	}
+	return nil
+}
+
+// EnrollmentRequest is a request for enrolled users of a given course,
+// whose enrollment status match those provided in the request. To ignore group members
+// that otherwise match the enrollment request, set ignoreGroupMembers to true.
+type EnrollmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID           uint64                  `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	IgnoreGroupMembers bool                    `protobuf:"varint,2,opt,name=ignoreGroupMembers,proto3" json:"ignoreGroupMembers,omitempty"`
+	WithActivity       bool                    `protobuf:"varint,3,opt,name=withActivity,proto3" json:"withActivity,omitempty"`
+	Statuses           []Enrollment_UserStatus `protobuf:"varint,4,rep,packed,name=statuses,proto3,enum=ag.Enrollment_UserStatus" json:"statuses,omitempty"`
+}
+
+func (x *EnrollmentRequest) Reset() {
+	*x = EnrollmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserRequest) String() string {
+func (x *EnrollmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserRequest) ProtoMessage() {}
+func (*EnrollmentRequest) ProtoMessage() {}
 
-func (x *UserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[25]
+func (x *EnrollmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2374,43 +4554,66 @@ func (x *UserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserRequest.ProtoReflect.Descriptor instead.
-func (*UserRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use EnrollmentRequest.ProtoReflect.Descriptor instead.
+func (*EnrollmentRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *UserRequest) GetUserID() uint64 {
+func (x *EnrollmentRequest) GetCourseID() uint64 {
 	if x != nil {
-		return x.UserID
+		return x.CourseID
 	}
 	return 0
 }
 
-type GetGroupRequest struct {
+func (x *EnrollmentRequest) GetIgnoreGroupMembers() bool {
+	if x != nil {
+		return x.IgnoreGroupMembers
+	}
+	return false
+}
+
+func (x *EnrollmentRequest) GetWithActivity() bool {
+	if x != nil {
+		return x.WithActivity
+	}
+	return false
+}
+
+func (x *EnrollmentRequest) GetStatuses() []Enrollment_UserStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+// EnrollmentStatusRequest is a request for a given user, with a specific enrollment status.
+type EnrollmentStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GroupID uint64 `protobuf:"varint,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	UserID   uint64                  `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Statuses []Enrollment_UserStatus `protobuf:"varint,2,rep,packed,name=statuses,proto3,enum=ag.Enrollment_UserStatus" json:"statuses,omitempty"`
 }
 
-func (x *GetGroupRequest) Reset() {
-	*x = GetGroupRequest{}
+func (x *EnrollmentStatusRequest) Reset() {
+	*x = EnrollmentStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[26]
+		mi := &file_ag_ag_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetGroupRequest) String() string {
+func (x *EnrollmentStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupRequest) ProtoMessage() {}
+func (*EnrollmentStatusRequest) ProtoMessage() {}
 
-func (x *GetGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[26]
+func (x *EnrollmentStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2421,19 +4624,26 @@ func (x *GetGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupRequest.ProtoReflect.Descriptor instead.
-func (*GetGroupRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use EnrollmentStatusRequest.ProtoReflect.Descriptor instead.
+func (*EnrollmentStatusRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *GetGroupRequest) GetGroupID() uint64 {
+func (x *EnrollmentStatusRequest) GetUserID() uint64 {
 	if x != nil {
-		return x.GroupID
+		return x.UserID
 	}
 	return 0
 }
 
-type GroupRequest struct {
+func (x *EnrollmentStatusRequest) GetStatuses() []Enrollment_UserStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+type SubmissionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -2443,23 +4653,23 @@ type GroupRequest struct {
 	CourseID uint64 `protobuf:"varint,3,opt,name=courseID,proto3" json:"courseID,omitempty"`
 }
 
-func (x *GroupRequest) Reset() {
-	*x = GroupRequest{}
+func (x *SubmissionRequest) Reset() {
+	*x = SubmissionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[27]
+		mi := &file_ag_ag_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GroupRequest) String() string {
+func (x *SubmissionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupRequest) ProtoMessage() {}
+func (*SubmissionRequest) ProtoMessage() {}
 
-func (x *GroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[27]
+func (x *SubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2470,57 +4680,61 @@ func (x *GroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupRequest.ProtoReflect.Descriptor instead.
-func (*GroupRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use SubmissionRequest.ProtoReflect.Descriptor instead.
+func (*SubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *GroupRequest) GetUserID() uint64 {
+func (x *SubmissionRequest) GetUserID() uint64 {
 	if x != nil {
 		return x.UserID
 	}
 	return 0
 }
 
-func (x *GroupRequest) GetGroupID() uint64 {
+func (x *SubmissionRequest) GetGroupID() uint64 {
 	if x != nil {
 		return x.GroupID
 	}
 	return 0
 }
 
-func (x *GroupRequest) GetCourseID() uint64 {
+func (x *SubmissionRequest) GetCourseID() uint64 {
 	if x != nil {
 		return x.CourseID
 	}
 	return 0
 }
 
-type Provider struct {
+type UpdateSubmissionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	SubmissionID uint64            `protobuf:"varint,1,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
+	CourseID     uint64            `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Score        uint32            `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
+	Released     bool              `protobuf:"varint,4,opt,name=released,proto3" json:"released,omitempty"`
+	Status       Submission_Status `protobuf:"varint,5,opt,name=status,proto3,enum=ag.Submission_Status" json:"status,omitempty"`
 }
 
-func (x *Provider) Reset() {
-	*x = Provider{}
+func (x *UpdateSubmissionRequest) Reset() {
+	*x = UpdateSubmissionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[28]
+		mi := &file_ag_ag_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Provider) String() string {
+func (x *UpdateSubmissionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Provider) ProtoMessage() {}
+func (*UpdateSubmissionRequest) ProtoMessage() {}
 
-func (x *Provider) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[28]
+func (x *UpdateSubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2531,93 +4745,75 @@ func (x *Provider) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Provider.ProtoReflect.Descriptor instead.
-func (*Provider) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use UpdateSubmissionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *Provider) GetProvider() string {
+func (x *UpdateSubmissionRequest) GetSubmissionID() uint64 {
 	if x != nil {
-		return x.Provider
+		return x.SubmissionID
 	}
-	return ""
-}
-
-type OrgRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	OrgName string `protobuf:"bytes,1,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	return 0
 }
 
-func (x *OrgRequest) Reset() {
-	*x = OrgRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[29]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *UpdateSubmissionRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
 	}
+	return 0
 }
 
-func (x *OrgRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*OrgRequest) ProtoMessage() {}
-
-func (x *OrgRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[29]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *UpdateSubmissionRequest) GetScore() uint32 {
+	if x != nil {
+		return x.Score
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use OrgRequest.ProtoReflect.Descriptor instead.
-func (*OrgRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{29}
+func (x *UpdateSubmissionRequest) GetReleased() bool {
+	if x != nil {
+		return x.Released
+	}
+	return false
 }
 
-func (x *OrgRequest) GetOrgName() string {
+func (x *UpdateSubmissionRequest) GetStatus() Submission_Status {
 	if x != nil {
-		return x.OrgName
+		return x.Status
 	}
-	return ""
+	return Submission_NONE
 }
 
-type Organization struct {
+type UpdateSubmissionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID          uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Path        string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Avatar      string `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
-	PaymentPlan string `protobuf:"bytes,4,opt,name=paymentPlan,proto3" json:"paymentPlan,omitempty"`
+	CourseID     uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AssignmentID uint64 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	ScoreLimit   uint32 `protobuf:"varint,3,opt,name=scoreLimit,proto3" json:"scoreLimit,omitempty"`
+	Release      bool   `protobuf:"varint,4,opt,name=release,proto3" json:"release,omitempty"`
+	Approve      bool   `protobuf:"varint,5,opt,name=approve,proto3" json:"approve,omitempty"`
 }
 
-func (x *Organization) Reset() {
-	*x = Organization{}
+func (x *UpdateSubmissionsRequest) Reset() {
+	*x = UpdateSubmissionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[30]
+		mi := &file_ag_ag_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Organization) String() string {
+func (x *UpdateSubmissionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Organization) ProtoMessage() {}
+func (*UpdateSubmissionsRequest) ProtoMessage() {}
 
-func (x *Organization) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[30]
+func (x *UpdateSubmissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2628,64 +4824,75 @@ func (x *Organization) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Organization.ProtoReflect.Descriptor instead.
-func (*Organization) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use UpdateSubmissionsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSubmissionsRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *Organization) GetID() uint64 {
+func (x *UpdateSubmissionsRequest) GetCourseID() uint64 {
 	if x != nil {
-		return x.ID
+		return x.CourseID
 	}
 	return 0
 }
 
-func (x *Organization) GetPath() string {
+func (x *UpdateSubmissionsRequest) GetAssignmentID() uint64 {
 	if x != nil {
-		return x.Path
+		return x.AssignmentID
 	}
-	return ""
+	return 0
 }
 
-func (x *Organization) GetAvatar() string {
+func (x *UpdateSubmissionsRequest) GetScoreLimit() uint32 {
 	if x != nil {
-		return x.Avatar
+		return x.ScoreLimit
 	}
-	return ""
+	return 0
 }
 
-func (x *Organization) GetPaymentPlan() string {
+func (x *UpdateSubmissionsRequest) GetRelease() bool {
 	if x != nil {
-		return x.PaymentPlan
+		return x.Release
 	}
-	return ""
+	return false
 }
 
-type Organizations struct {
+func (x *UpdateSubmissionsRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+// ArtifactRequest identifies an artifact of a submission by its name,
+// or all artifacts of the submission when listing them.
+type ArtifactRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Organizations []*Organization `protobuf:"bytes,1,rep,name=organizations,proto3" json:"organizations,omitempty"`
+	CourseID     uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	SubmissionID uint64 `protobuf:"varint,2,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
+	Name         string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"` // path of the artifact in the artifacts folder; ignored when listing artifacts
 }
 
-func (x *Organizations) Reset() {
-	*x = Organizations{}
+func (x *ArtifactRequest) Reset() {
+	*x = ArtifactRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[31]
+		mi := &file_ag_ag_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Organizations) String() string {
+func (x *ArtifactRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Organizations) ProtoMessage() {}
+func (*ArtifactRequest) ProtoMessage() {}
 
-func (x *Organizations) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[31]
+func (x *ArtifactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2696,49 +4903,58 @@ func (x *Organizations) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Organizations.ProtoReflect.Descriptor instead.
-func (*Organizations) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use ArtifactRequest.ProtoReflect.Descriptor instead.
+func (*ArtifactRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *Organizations) GetOrganizations() []*Organization {
+func (x *ArtifactRequest) GetCourseID() uint64 {
 	if x != nil {
-		return x.Organizations
+		return x.CourseID
 	}
-	return nil
+	return 0
 }
 
-// EnrollmentRequest is a request for enrolled users of a given course,
-// whose enrollment status match those provided in the request. To ignore group members
-// that otherwise match the enrollment request, set ignoreGroupMembers to true.
-type EnrollmentRequest struct {
+func (x *ArtifactRequest) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *ArtifactRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SubmissionReviewersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CourseID           uint64                  `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	IgnoreGroupMembers bool                    `protobuf:"varint,2,opt,name=ignoreGroupMembers,proto3" json:"ignoreGroupMembers,omitempty"`
-	WithActivity       bool                    `protobuf:"varint,3,opt,name=withActivity,proto3" json:"withActivity,omitempty"`
-	Statuses           []Enrollment_UserStatus `protobuf:"varint,4,rep,packed,name=statuses,proto3,enum=ag.Enrollment_UserStatus" json:"statuses,omitempty"`
+	SubmissionID uint64 `protobuf:"varint,1,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
+	CourseID     uint64 `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
 }
 
-func (x *EnrollmentRequest) Reset() {
-	*x = EnrollmentRequest{}
+func (x *SubmissionReviewersRequest) Reset() {
+	*x = SubmissionReviewersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[32]
+		mi := &file_ag_ag_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EnrollmentRequest) String() string {
+func (x *SubmissionReviewersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnrollmentRequest) ProtoMessage() {}
+func (*SubmissionReviewersRequest) ProtoMessage() {}
 
-func (x *EnrollmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[32]
+func (x *SubmissionReviewersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2749,66 +4965,50 @@ func (x *EnrollmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnrollmentRequest.ProtoReflect.Descriptor instead.
-func (*EnrollmentRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use SubmissionReviewersRequest.ProtoReflect.Descriptor instead.
+func (*SubmissionReviewersRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *EnrollmentRequest) GetCourseID() uint64 {
+func (x *SubmissionReviewersRequest) GetSubmissionID() uint64 {
 	if x != nil {
-		return x.CourseID
+		return x.SubmissionID
 	}
 	return 0
 }
 
-func (x *EnrollmentRequest) GetIgnoreGroupMembers() bool {
-	if x != nil {
-		return x.IgnoreGroupMembers
-	}
-	return false
-}
-
-func (x *EnrollmentRequest) GetWithActivity() bool {
-	if x != nil {
-		return x.WithActivity
-	}
-	return false
-}
-
-func (x *EnrollmentRequest) GetStatuses() []Enrollment_UserStatus {
+func (x *SubmissionReviewersRequest) GetCourseID() uint64 {
 	if x != nil {
-		return x.Statuses
+		return x.CourseID
 	}
-	return nil
+	return 0
 }
 
-// EnrollmentStatusRequest is a request for a given user, with a specific enrollment status.
-type EnrollmentStatusRequest struct {
+type Providers struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID   uint64                  `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	Statuses []Enrollment_UserStatus `protobuf:"varint,2,rep,packed,name=statuses,proto3,enum=ag.Enrollment_UserStatus" json:"statuses,omitempty"`
+	Providers []string `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
 }
 
-func (x *EnrollmentStatusRequest) Reset() {
-	*x = EnrollmentStatusRequest{}
+func (x *Providers) Reset() {
+	*x = Providers{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[33]
+		mi := &file_ag_ag_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EnrollmentStatusRequest) String() string {
+func (x *Providers) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnrollmentStatusRequest) ProtoMessage() {}
+func (*Providers) ProtoMessage() {}
 
-func (x *EnrollmentStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[33]
+func (x *Providers) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2819,52 +5019,44 @@ func (x *EnrollmentStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnrollmentStatusRequest.ProtoReflect.Descriptor instead.
-func (*EnrollmentStatusRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{33}
-}
-
-func (x *EnrollmentStatusRequest) GetUserID() uint64 {
-	if x != nil {
-		return x.UserID
-	}
-	return 0
+// Deprecated: Use Providers.ProtoReflect.Descriptor instead.
+func (*Providers) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *EnrollmentStatusRequest) GetStatuses() []Enrollment_UserStatus {
+func (x *Providers) GetProviders() []string {
 	if x != nil {
-		return x.Statuses
+		return x.Providers
 	}
 	return nil
 }
 
-type SubmissionRequest struct {
+type URLRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID   uint64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	GroupID  uint64 `protobuf:"varint,2,opt,name=groupID,proto3" json:"groupID,omitempty"`
-	CourseID uint64 `protobuf:"varint,3,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	CourseID  uint64            `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	RepoTypes []Repository_Type `protobuf:"varint,2,rep,packed,name=repoTypes,proto3,enum=ag.Repository_Type" json:"repoTypes,omitempty"`
 }
 
-func (x *SubmissionRequest) Reset() {
-	*x = SubmissionRequest{}
+func (x *URLRequest) Reset() {
+	*x = URLRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[34]
+		mi := &file_ag_ag_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubmissionRequest) String() string {
+func (x *URLRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmissionRequest) ProtoMessage() {}
+func (*URLRequest) ProtoMessage() {}
 
-func (x *SubmissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[34]
+func (x *URLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2875,61 +5067,53 @@ func (x *SubmissionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmissionRequest.ProtoReflect.Descriptor instead.
-func (*SubmissionRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{34}
-}
-
-func (x *SubmissionRequest) GetUserID() uint64 {
-	if x != nil {
-		return x.UserID
-	}
-	return 0
+// Deprecated: Use URLRequest.ProtoReflect.Descriptor instead.
+func (*URLRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *SubmissionRequest) GetGroupID() uint64 {
+func (x *URLRequest) GetCourseID() uint64 {
 	if x != nil {
-		return x.GroupID
+		return x.CourseID
 	}
 	return 0
 }
 
-func (x *SubmissionRequest) GetCourseID() uint64 {
+func (x *URLRequest) GetRepoTypes() []Repository_Type {
 	if x != nil {
-		return x.CourseID
+		return x.RepoTypes
 	}
-	return 0
+	return nil
 }
 
-type UpdateSubmissionRequest struct {
+// used to check whether student/group submission repo is empty
+type RepositoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubmissionID uint64            `protobuf:"varint,1,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
-	CourseID     uint64            `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	Score        uint32            `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
-	Released     bool              `protobuf:"varint,4,opt,name=released,proto3" json:"released,omitempty"`
-	Status       Submission_Status `protobuf:"varint,5,opt,name=status,proto3,enum=ag.Submission_Status" json:"status,omitempty"`
+	UserID   uint64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	GroupID  uint64 `protobuf:"varint,2,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	CourseID uint64 `protobuf:"varint,3,opt,name=courseID,proto3" json:"courseID,omitempty"`
 }
 
-func (x *UpdateSubmissionRequest) Reset() {
-	*x = UpdateSubmissionRequest{}
+func (x *RepositoryRequest) Reset() {
+	*x = RepositoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[35]
+		mi := &file_ag_ag_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateSubmissionRequest) String() string {
+func (x *RepositoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSubmissionRequest) ProtoMessage() {}
+func (*RepositoryRequest) ProtoMessage() {}
 
-func (x *UpdateSubmissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[35]
+func (x *RepositoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2940,75 +5124,57 @@ func (x *UpdateSubmissionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSubmissionRequest.ProtoReflect.Descriptor instead.
-func (*UpdateSubmissionRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use RepositoryRequest.ProtoReflect.Descriptor instead.
+func (*RepositoryRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *UpdateSubmissionRequest) GetSubmissionID() uint64 {
+func (x *RepositoryRequest) GetUserID() uint64 {
 	if x != nil {
-		return x.SubmissionID
+		return x.UserID
 	}
 	return 0
 }
 
-func (x *UpdateSubmissionRequest) GetCourseID() uint64 {
+func (x *RepositoryRequest) GetGroupID() uint64 {
 	if x != nil {
-		return x.CourseID
+		return x.GroupID
 	}
 	return 0
 }
 
-func (x *UpdateSubmissionRequest) GetScore() uint32 {
+func (x *RepositoryRequest) GetCourseID() uint64 {
 	if x != nil {
-		return x.Score
+		return x.CourseID
 	}
 	return 0
 }
 
-func (x *UpdateSubmissionRequest) GetReleased() bool {
-	if x != nil {
-		return x.Released
-	}
-	return false
-}
-
-func (x *UpdateSubmissionRequest) GetStatus() Submission_Status {
-	if x != nil {
-		return x.Status
-	}
-	return Submission_NONE
-}
-
-type UpdateSubmissionsRequest struct {
+type Repositories struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CourseID     uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	AssignmentID uint64 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
-	ScoreLimit   uint32 `protobuf:"varint,3,opt,name=scoreLimit,proto3" json:"scoreLimit,omitempty"`
-	Release      bool   `protobuf:"varint,4,opt,name=release,proto3" json:"release,omitempty"`
-	Approve      bool   `protobuf:"varint,5,opt,name=approve,proto3" json:"approve,omitempty"`
+	URLs map[string]string `protobuf:"bytes,1,rep,name=URLs,proto3" json:"URLs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *UpdateSubmissionsRequest) Reset() {
-	*x = UpdateSubmissionsRequest{}
+func (x *Repositories) Reset() {
+	*x = Repositories{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[36]
+		mi := &file_ag_ag_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateSubmissionsRequest) String() string {
+func (x *Repositories) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSubmissionsRequest) ProtoMessage() {}
+func (*Repositories) ProtoMessage() {}
 
-func (x *UpdateSubmissionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[36]
+func (x *Repositories) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3019,72 +5185,43 @@ func (x *UpdateSubmissionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSubmissionsRequest.ProtoReflect.Descriptor instead.
-func (*UpdateSubmissionsRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{36}
-}
-
-func (x *UpdateSubmissionsRequest) GetCourseID() uint64 {
-	if x != nil {
-		return x.CourseID
-	}
-	return 0
-}
-
-func (x *UpdateSubmissionsRequest) GetAssignmentID() uint64 {
-	if x != nil {
-		return x.AssignmentID
-	}
-	return 0
-}
-
-func (x *UpdateSubmissionsRequest) GetScoreLimit() uint32 {
-	if x != nil {
-		return x.ScoreLimit
-	}
-	return 0
-}
-
-func (x *UpdateSubmissionsRequest) GetRelease() bool {
-	if x != nil {
-		return x.Release
-	}
-	return false
+// Deprecated: Use Repositories.ProtoReflect.Descriptor instead.
+func (*Repositories) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *UpdateSubmissionsRequest) GetApprove() bool {
+func (x *Repositories) GetURLs() map[string]string {
 	if x != nil {
-		return x.Approve
+		return x.URLs
 	}
-	return false
+	return nil
 }
 
-type SubmissionReviewersRequest struct {
+type AuthorizationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubmissionID uint64 `protobuf:"varint,1,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
-	CourseID     uint64 `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	IsAuthorized bool `protobuf:"varint,1,opt,name=IsAuthorized,proto3" json:"IsAuthorized,omitempty"`
 }
 
-func (x *SubmissionReviewersRequest) Reset() {
-	*x = SubmissionReviewersRequest{}
+func (x *AuthorizationResponse) Reset() {
+	*x = AuthorizationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[37]
+		mi := &file_ag_ag_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubmissionReviewersRequest) String() string {
+func (x *AuthorizationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmissionReviewersRequest) ProtoMessage() {}
+func (*AuthorizationResponse) ProtoMessage() {}
 
-func (x *SubmissionReviewersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[37]
+func (x *AuthorizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3095,50 +5232,44 @@ func (x *SubmissionReviewersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmissionReviewersRequest.ProtoReflect.Descriptor instead.
-func (*SubmissionReviewersRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{37}
-}
-
-func (x *SubmissionReviewersRequest) GetSubmissionID() uint64 {
-	if x != nil {
-		return x.SubmissionID
-	}
-	return 0
+// Deprecated: Use AuthorizationResponse.ProtoReflect.Descriptor instead.
+func (*AuthorizationResponse) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *SubmissionReviewersRequest) GetCourseID() uint64 {
+func (x *AuthorizationResponse) GetIsAuthorized() bool {
 	if x != nil {
-		return x.CourseID
+		return x.IsAuthorized
 	}
-	return 0
+	return false
 }
 
-type Providers struct {
+type Status struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Providers []string `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+	Code  uint64 `protobuf:"varint,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
 }
 
-func (x *Providers) Reset() {
-	*x = Providers{}
+func (x *Status) Reset() {
+	*x = Status{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[38]
+		mi := &file_ag_ag_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Providers) String() string {
+func (x *Status) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Providers) ProtoMessage() {}
+func (*Status) ProtoMessage() {}
 
-func (x *Providers) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[38]
+func (x *Status) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3149,44 +5280,52 @@ func (x *Providers) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Providers.ProtoReflect.Descriptor instead.
-func (*Providers) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use Status.ProtoReflect.Descriptor instead.
+func (*Status) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *Providers) GetProviders() []string {
+func (x *Status) GetCode() uint64 {
 	if x != nil {
-		return x.Providers
+		return x.Code
 	}
-	return nil
+	return 0
 }
 
-type URLRequest struct {
+func (x *Status) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SubmissionsForCourseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CourseID  uint64            `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	RepoTypes []Repository_Type `protobuf:"varint,2,rep,packed,name=repoTypes,proto3,enum=ag.Repository_Type" json:"repoTypes,omitempty"`
+	CourseID      uint64                           `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Type          SubmissionsForCourseRequest_Type `protobuf:"varint,2,opt,name=type,proto3,enum=ag.SubmissionsForCourseRequest_Type" json:"type,omitempty"`
+	WithBuildInfo bool                             `protobuf:"varint,3,opt,name=withBuildInfo,proto3" json:"withBuildInfo,omitempty"`
 }
 
-func (x *URLRequest) Reset() {
-	*x = URLRequest{}
+func (x *SubmissionsForCourseRequest) Reset() {
+	*x = SubmissionsForCourseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[39]
+		mi := &file_ag_ag_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *URLRequest) String() string {
+func (x *SubmissionsForCourseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*URLRequest) ProtoMessage() {}
+func (*SubmissionsForCourseRequest) ProtoMessage() {}
 
-func (x *URLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[39]
+func (x *SubmissionsForCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3197,53 +5336,58 @@ func (x *URLRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use URLRequest.ProtoReflect.Descriptor instead.
-func (*URLRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use SubmissionsForCourseRequest.ProtoReflect.Descriptor instead.
+func (*SubmissionsForCourseRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *URLRequest) GetCourseID() uint64 {
+func (x *SubmissionsForCourseRequest) GetCourseID() uint64 {
 	if x != nil {
 		return x.CourseID
 	}
 	return 0
 }
 
-func (x *URLRequest) GetRepoTypes() []Repository_Type {
+func (x *SubmissionsForCourseRequest) GetType() SubmissionsForCourseRequest_Type {
 	if x != nil {
-		return x.RepoTypes
+		return x.Type
 	}
-	return nil
+	return SubmissionsForCourseRequest_ALL
 }
 
-// used to check whether student/group submission repo is empty
-type RepositoryRequest struct {
+func (x *SubmissionsForCourseRequest) GetWithBuildInfo() bool {
+	if x != nil {
+		return x.WithBuildInfo
+	}
+	return false
+}
+
+type RebuildRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID   uint64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	GroupID  uint64 `protobuf:"varint,2,opt,name=groupID,proto3" json:"groupID,omitempty"`
-	CourseID uint64 `protobuf:"varint,3,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	SubmissionID uint64 `protobuf:"varint,1,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
+	AssignmentID uint64 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
 }
 
-func (x *RepositoryRequest) Reset() {
-	*x = RepositoryRequest{}
+func (x *RebuildRequest) Reset() {
+	*x = RebuildRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[40]
+		mi := &file_ag_ag_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RepositoryRequest) String() string {
+func (x *RebuildRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RepositoryRequest) ProtoMessage() {}
+func (*RebuildRequest) ProtoMessage() {}
 
-func (x *RepositoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[40]
+func (x *RebuildRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3254,57 +5398,52 @@ func (x *RepositoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RepositoryRequest.ProtoReflect.Descriptor instead.
-func (*RepositoryRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{40}
-}
-
-func (x *RepositoryRequest) GetUserID() uint64 {
-	if x != nil {
-		return x.UserID
-	}
-	return 0
+// Deprecated: Use RebuildRequest.ProtoReflect.Descriptor instead.
+func (*RebuildRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *RepositoryRequest) GetGroupID() uint64 {
+func (x *RebuildRequest) GetSubmissionID() uint64 {
 	if x != nil {
-		return x.GroupID
+		return x.SubmissionID
 	}
 	return 0
 }
 
-func (x *RepositoryRequest) GetCourseID() uint64 {
+func (x *RebuildRequest) GetAssignmentID() uint64 {
 	if x != nil {
-		return x.CourseID
+		return x.AssignmentID
 	}
 	return 0
 }
 
-type Repositories struct {
+type CourseUserRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	URLs map[string]string `protobuf:"bytes,1,rep,name=URLs,proto3" json:"URLs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	CourseCode string `protobuf:"bytes,1,opt,name=courseCode,proto3" json:"courseCode,omitempty"`
+	CourseYear uint32 `protobuf:"varint,2,opt,name=courseYear,proto3" json:"courseYear,omitempty"`
+	UserLogin  string `protobuf:"bytes,3,opt,name=userLogin,proto3" json:"userLogin,omitempty"`
 }
 
-func (x *Repositories) Reset() {
-	*x = Repositories{}
+func (x *CourseUserRequest) Reset() {
+	*x = CourseUserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[41]
+		mi := &file_ag_ag_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Repositories) String() string {
+func (x *CourseUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Repositories) ProtoMessage() {}
+func (*CourseUserRequest) ProtoMessage() {}
 
-func (x *Repositories) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[41]
+func (x *CourseUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3315,43 +5454,58 @@ func (x *Repositories) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Repositories.ProtoReflect.Descriptor instead.
-func (*Repositories) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use CourseUserRequest.ProtoReflect.Descriptor instead.
+func (*CourseUserRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *Repositories) GetURLs() map[string]string {
+func (x *CourseUserRequest) GetCourseCode() string {
 	if x != nil {
-		return x.URLs
+		return x.CourseCode
 	}
-	return nil
+	return ""
 }
 
-type AuthorizationResponse struct {
+func (x *CourseUserRequest) GetCourseYear() uint32 {
+	if x != nil {
+		return x.CourseYear
+	}
+	return 0
+}
+
+func (x *CourseUserRequest) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+type AssignmentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsAuthorized bool `protobuf:"varint,1,opt,name=IsAuthorized,proto3" json:"IsAuthorized,omitempty"`
+	CourseID     uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AssignmentID uint64 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
 }
 
-func (x *AuthorizationResponse) Reset() {
-	*x = AuthorizationResponse{}
+func (x *AssignmentRequest) Reset() {
+	*x = AssignmentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[42]
+		mi := &file_ag_ag_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AuthorizationResponse) String() string {
+func (x *AssignmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthorizationResponse) ProtoMessage() {}
+func (*AssignmentRequest) ProtoMessage() {}
 
-func (x *AuthorizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[42]
+func (x *AssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3362,44 +5516,51 @@ func (x *AuthorizationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthorizationResponse.ProtoReflect.Descriptor instead.
-func (*AuthorizationResponse) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use AssignmentRequest.ProtoReflect.Descriptor instead.
+func (*AssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *AuthorizationResponse) GetIsAuthorized() bool {
+func (x *AssignmentRequest) GetCourseID() uint64 {
 	if x != nil {
-		return x.IsAuthorized
+		return x.CourseID
 	}
-	return false
+	return 0
 }
 
-type Status struct {
+func (x *AssignmentRequest) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+type DeadlineExtensionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code  uint64 `protobuf:"varint,1,opt,name=Code,proto3" json:"Code,omitempty"`
-	Error string `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+	CourseID  uint64             `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Extension *DeadlineExtension `protobuf:"bytes,2,opt,name=extension,proto3" json:"extension,omitempty"`
 }
 
-func (x *Status) Reset() {
-	*x = Status{}
+func (x *DeadlineExtensionRequest) Reset() {
+	*x = DeadlineExtensionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[43]
+		mi := &file_ag_ag_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Status) String() string {
+func (x *DeadlineExtensionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Status) ProtoMessage() {}
+func (*DeadlineExtensionRequest) ProtoMessage() {}
 
-func (x *Status) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[43]
+func (x *DeadlineExtensionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3410,52 +5571,51 @@ func (x *Status) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Status.ProtoReflect.Descriptor instead.
-func (*Status) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use DeadlineExtensionRequest.ProtoReflect.Descriptor instead.
+func (*DeadlineExtensionRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *Status) GetCode() uint64 {
+func (x *DeadlineExtensionRequest) GetCourseID() uint64 {
 	if x != nil {
-		return x.Code
+		return x.CourseID
 	}
 	return 0
 }
 
-func (x *Status) GetError() string {
+func (x *DeadlineExtensionRequest) GetExtension() *DeadlineExtension {
 	if x != nil {
-		return x.Error
+		return x.Extension
 	}
-	return ""
+	return nil
 }
 
-type SubmissionsForCourseRequest struct {
+type CourseGradeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CourseID      uint64                           `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	Type          SubmissionsForCourseRequest_Type `protobuf:"varint,2,opt,name=type,proto3,enum=ag.SubmissionsForCourseRequest_Type" json:"type,omitempty"`
-	WithBuildInfo bool                             `protobuf:"varint,3,opt,name=withBuildInfo,proto3" json:"withBuildInfo,omitempty"`
+	CourseID uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	UserID   uint64 `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
 }
 
-func (x *SubmissionsForCourseRequest) Reset() {
-	*x = SubmissionsForCourseRequest{}
+func (x *CourseGradeRequest) Reset() {
+	*x = CourseGradeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[44]
+		mi := &file_ag_ag_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubmissionsForCourseRequest) String() string {
+func (x *CourseGradeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmissionsForCourseRequest) ProtoMessage() {}
+func (*CourseGradeRequest) ProtoMessage() {}
 
-func (x *SubmissionsForCourseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[44]
+func (x *CourseGradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3466,58 +5626,57 @@ func (x *SubmissionsForCourseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmissionsForCourseRequest.ProtoReflect.Descriptor instead.
-func (*SubmissionsForCourseRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use CourseGradeRequest.ProtoReflect.Descriptor instead.
+func (*CourseGradeRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *SubmissionsForCourseRequest) GetCourseID() uint64 {
+func (x *CourseGradeRequest) GetCourseID() uint64 {
 	if x != nil {
 		return x.CourseID
 	}
 	return 0
 }
 
-func (x *SubmissionsForCourseRequest) GetType() SubmissionsForCourseRequest_Type {
-	if x != nil {
-		return x.Type
-	}
-	return SubmissionsForCourseRequest_ALL
-}
-
-func (x *SubmissionsForCourseRequest) GetWithBuildInfo() bool {
+func (x *CourseGradeRequest) GetUserID() uint64 {
 	if x != nil {
-		return x.WithBuildInfo
+		return x.UserID
 	}
-	return false
+	return 0
 }
 
-type RebuildRequest struct {
+// CourseRepositoryValidation holds the result of checking the tests repository of a course,
+// without updating the course's assignments.
+type CourseRepositoryValidation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubmissionID uint64 `protobuf:"varint,1,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
-	AssignmentID uint64 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	CourseID           uint64        `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Assignments        []*Assignment `protobuf:"bytes,2,rep,name=assignments,proto3" json:"assignments,omitempty"`               // assignments parsed from the tests repository
+	ChangedAssignments []string      `protobuf:"bytes,3,rep,name=changedAssignments,proto3" json:"changedAssignments,omitempty"` // names of the new or changed assignments
+	RemovedAssignments []string      `protobuf:"bytes,4,rep,name=removedAssignments,proto3" json:"removedAssignments,omitempty"` // names of the assignments no longer in the tests repository
+	Warnings           []string      `protobuf:"bytes,5,rep,name=warnings,proto3" json:"warnings,omitempty"`                     // possible mistakes that do not prevent updating the assignments
+	Errors             []string      `protobuf:"bytes,6,rep,name=errors,proto3" json:"errors,omitempty"`                         // problems that prevent updating the assignments
 }
 
-func (x *RebuildRequest) Reset() {
-	*x = RebuildRequest{}
+func (x *CourseRepositoryValidation) Reset() {
+	*x = CourseRepositoryValidation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[45]
+		mi := &file_ag_ag_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RebuildRequest) String() string {
+func (x *CourseRepositoryValidation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RebuildRequest) ProtoMessage() {}
+func (*CourseRepositoryValidation) ProtoMessage() {}
 
-func (x *RebuildRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[45]
+func (x *CourseRepositoryValidation) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3528,52 +5687,79 @@ func (x *RebuildRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RebuildRequest.ProtoReflect.Descriptor instead.
-func (*RebuildRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use CourseRepositoryValidation.ProtoReflect.Descriptor instead.
+func (*CourseRepositoryValidation) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *RebuildRequest) GetSubmissionID() uint64 {
+func (x *CourseRepositoryValidation) GetCourseID() uint64 {
 	if x != nil {
-		return x.SubmissionID
+		return x.CourseID
 	}
 	return 0
 }
 
-func (x *RebuildRequest) GetAssignmentID() uint64 {
+func (x *CourseRepositoryValidation) GetAssignments() []*Assignment {
 	if x != nil {
-		return x.AssignmentID
+		return x.Assignments
 	}
-	return 0
+	return nil
 }
 
-type CourseUserRequest struct {
+func (x *CourseRepositoryValidation) GetChangedAssignments() []string {
+	if x != nil {
+		return x.ChangedAssignments
+	}
+	return nil
+}
+
+func (x *CourseRepositoryValidation) GetRemovedAssignments() []string {
+	if x != nil {
+		return x.RemovedAssignments
+	}
+	return nil
+}
+
+func (x *CourseRepositoryValidation) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *CourseRepositoryValidation) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type AssignmentArchiveRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CourseCode string `protobuf:"bytes,1,opt,name=courseCode,proto3" json:"courseCode,omitempty"`
-	CourseYear uint32 `protobuf:"varint,2,opt,name=courseYear,proto3" json:"courseYear,omitempty"`
-	UserLogin  string `protobuf:"bytes,3,opt,name=userLogin,proto3" json:"userLogin,omitempty"`
+	CourseID        uint64   `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AssignmentNames []string `protobuf:"bytes,2,rep,name=assignmentNames,proto3" json:"assignmentNames,omitempty"` // assignments to export; all assignments if empty
 }
 
-func (x *CourseUserRequest) Reset() {
-	*x = CourseUserRequest{}
+func (x *AssignmentArchiveRequest) Reset() {
+	*x = AssignmentArchiveRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[46]
+		mi := &file_ag_ag_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CourseUserRequest) String() string {
+func (x *AssignmentArchiveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CourseUserRequest) ProtoMessage() {}
+func (*AssignmentArchiveRequest) ProtoMessage() {}
 
-func (x *CourseUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[46]
+func (x *AssignmentArchiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3584,58 +5770,53 @@ func (x *CourseUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CourseUserRequest.ProtoReflect.Descriptor instead.
-func (*CourseUserRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{46}
-}
-
-func (x *CourseUserRequest) GetCourseCode() string {
-	if x != nil {
-		return x.CourseCode
-	}
-	return ""
+// Deprecated: Use AssignmentArchiveRequest.ProtoReflect.Descriptor instead.
+func (*AssignmentArchiveRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *CourseUserRequest) GetCourseYear() uint32 {
+func (x *AssignmentArchiveRequest) GetCourseID() uint64 {
 	if x != nil {
-		return x.CourseYear
+		return x.CourseID
 	}
 	return 0
 }
 
-func (x *CourseUserRequest) GetUserLogin() string {
+func (x *AssignmentArchiveRequest) GetAssignmentNames() []string {
 	if x != nil {
-		return x.UserLogin
+		return x.AssignmentNames
 	}
-	return ""
+	return nil
 }
 
-type AssignmentRequest struct {
+// AssignmentArchive holds the files of assignments in the tests repository of a course,
+// bundled as a gzipped tar archive.
+type AssignmentArchive struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CourseID     uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	AssignmentID uint64 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	CourseID uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Archive  []byte `protobuf:"bytes,2,opt,name=archive,proto3" json:"archive,omitempty"`
 }
 
-func (x *AssignmentRequest) Reset() {
-	*x = AssignmentRequest{}
+func (x *AssignmentArchive) Reset() {
+	*x = AssignmentArchive{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[47]
+		mi := &file_ag_ag_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AssignmentRequest) String() string {
+func (x *AssignmentArchive) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssignmentRequest) ProtoMessage() {}
+func (*AssignmentArchive) ProtoMessage() {}
 
-func (x *AssignmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[47]
+func (x *AssignmentArchive) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3646,23 +5827,23 @@ func (x *AssignmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssignmentRequest.ProtoReflect.Descriptor instead.
-func (*AssignmentRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use AssignmentArchive.ProtoReflect.Descriptor instead.
+func (*AssignmentArchive) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *AssignmentRequest) GetCourseID() uint64 {
+func (x *AssignmentArchive) GetCourseID() uint64 {
 	if x != nil {
 		return x.CourseID
 	}
 	return 0
 }
 
-func (x *AssignmentRequest) GetAssignmentID() uint64 {
+func (x *AssignmentArchive) GetArchive() []byte {
 	if x != nil {
-		return x.AssignmentID
+		return x.Archive
 	}
-	return 0
+	return nil
 }
 
 // Void contains no fields. A server response with a Void still contains a gRPC status code,
@@ -3677,7 +5858,7 @@ type Void struct {
 func (x *Void) Reset() {
 	*x = Void{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[48]
+		mi := &file_ag_ag_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3690,7 +5871,7 @@ func (x *Void) String() string {
 func (*Void) ProtoMessage() {}
 
 func (x *Void) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[48]
+	mi := &file_ag_ag_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3703,647 +5884,1060 @@ func (x *Void) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Void.ProtoReflect.Descriptor instead.
 func (*Void) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{48}
+	return file_ag_ag_proto_rawDescGZIP(), []int{71}
 }
 
 var File_ag_ag_proto protoreflect.FileDescriptor
 
 var file_ag_ag_proto_rawDesc = []byte{
 	0x0a, 0x0b, 0x61, 0x67, 0x2f, 0x61, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x61,
-	0x67, 0x1a, 0x15, 0x6b, 0x69, 0x74, 0x2f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x73, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f,
-	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9e, 0x02, 0x0a, 0x04, 0x55, 0x73, 0x65,
-	0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49,
-	0x44, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x14, 0x0a,
-	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x55, 0x52, 0x4c,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x55, 0x52,
-	0x4c, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x3e, 0x0a, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x74,
-	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x12, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c,
-	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61,
-	0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e,
-	0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x27, 0x0a, 0x05, 0x55, 0x73, 0x65,
-	0x72, 0x73, 0x12, 0x1e, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65,
-	0x72, 0x73, 0x22, 0xf6, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x4c, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xca, 0xb5, 0x03, 0x2c, 0xa2, 0x01, 0x29,
-	0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65,
-	0x78, 0x3a, 0x75, 0x69, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x72,
-	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x12, 0x4c, 0x0a, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x44, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x30, 0xca, 0xb5, 0x03, 0x2c, 0xa2, 0x01, 0x29, 0x67, 0x6f,
-	0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a,
-	0x75, 0x69, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x6d,
-	0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49,
-	0x44, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x92, 0x03, 0x0a, 0x05,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x43, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x2f, 0xca, 0xb5, 0x03, 0x2b, 0xa2, 0x01, 0x28, 0x67, 0x6f, 0x72, 0x6d,
-	0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64,
-	0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x22, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x08, 0x63, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2f, 0xca, 0xb5,
-	0x03, 0x2b, 0xa2, 0x01, 0x28, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75,
-	0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75,
-	0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x52, 0x08, 0x63,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49,
-	0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12,
-	0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x15, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x44,
-	0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e,
-	0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x42, 0x24, 0xca, 0xb5, 0x03, 0x20, 0xa2, 0x01, 0x1d,
-	0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x6d, 0x61, 0x6e, 0x79, 0x32, 0x6d, 0x61, 0x6e, 0x79, 0x3a,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x3b, 0x22, 0x52, 0x05, 0x75,
-	0x73, 0x65, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45,
-	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c,
-	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x28, 0x0a, 0x0b, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47,
-	0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x01,
-	0x22, 0x2b, 0x0a, 0x06, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x21, 0x0a, 0x06, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x67, 0x2e,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x8b, 0x04,
-	0x0a, 0x06, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
-	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65,
-	0x61, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x10,
-	0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67,
-	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0e,
-	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x10, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
-	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68,
-	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x08, 0x73, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
-	0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x46, 0x0a, 0x08,
-	0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19,
-	0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0f, 0xca, 0xb5, 0x03, 0x0b, 0xa2,
-	0x01, 0x08, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x2d, 0x22, 0x52, 0x08, 0x65, 0x6e, 0x72, 0x6f,
-	0x6c, 0x6c, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45,
-	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c,
-	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67,
-	0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73, 0x73,
-	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x2f, 0x0a, 0x07, 0x43,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x22, 0xe8, 0x03, 0x0a,
-	0x0a, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x52, 0x0a, 0x0e, 0x6f,
-	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x42, 0x2a, 0xca, 0xb5, 0x03, 0x26, 0xa2, 0x01, 0x23, 0x67, 0x6f, 0x72, 0x6d,
-	0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x75, 0x69,
-	0x64, 0x5f, 0x67, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x52,
-	0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12,
-	0x22, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
-	0x79, 0x49, 0x44, 0x12, 0x42, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20,
+	0x67, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x15, 0x6b, 0x69, 0x74, 0x2f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x70, 0x61, 0x74, 0x63, 0x68,
+	0x2f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9e, 0x02, 0x0a, 0x04, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x55, 0x52,
+	0x4c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x55,
+	0x52, 0x4c, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x3e, 0x0a, 0x10, 0x72, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x65, 0x6e, 0x72, 0x6f,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65,
+	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x27, 0x0a, 0x05, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x22, 0xf6, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x4c, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xca, 0xb5, 0x03, 0x2c, 0xa2, 0x01,
+	0x29, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x3a, 0x75, 0x69, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f,
+	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x12, 0x4c, 0x0a, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x44,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x30, 0xca, 0xb5, 0x03, 0x2c, 0xa2, 0x01, 0x29, 0x67,
+	0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x3a, 0x75, 0x69, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x72, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x92, 0x03, 0x0a,
+	0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x43, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x2f, 0xca, 0xb5, 0x03, 0x2b, 0xa2, 0x01, 0x28, 0x67, 0x6f, 0x72,
+	0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69,
+	0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x08, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2f, 0xca,
+	0xb5, 0x03, 0x2b, 0xa2, 0x01, 0x28, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x52, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x61, 0x6d,
+	0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x44,
+	0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x44, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08,
+	0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x42, 0x24, 0xca, 0xb5, 0x03, 0x20, 0xa2, 0x01,
+	0x1d, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x6d, 0x61, 0x6e, 0x79, 0x32, 0x6d, 0x61, 0x6e, 0x79,
+	0x3a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x3b, 0x22, 0x52, 0x05,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e,
+	0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x72, 0x6f,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x28, 0x0a, 0x0b, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e,
+	0x47, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10,
+	0x01, 0x22, 0x2b, 0x0a, 0x06, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x21, 0x0a, 0x06, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x67,
+	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0xb5,
+	0x04, 0x0a, 0x06, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x79,
+	0x65, 0x61, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12,
+	0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61,
+	0x67, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x26, 0x0a,
+	0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x10, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74,
+	0x68, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x46, 0x0a,
+	0x08, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x19, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0f, 0xca, 0xb5, 0x03, 0x0b,
+	0xa2, 0x01, 0x08, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x2d, 0x22, 0x52, 0x08, 0x65, 0x6e, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e,
+	0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x72, 0x6f,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61,
+	0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x06, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x28, 0x0a, 0x0f,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x69,
+	0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x22, 0x2f, 0x0a, 0x07, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x73, 0x12, 0x24, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x07,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x22, 0xe8, 0x03, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x52, 0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2a,
+	0xca, 0xb5, 0x03, 0x26, 0xa2, 0x01, 0x23, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x75, 0x69, 0x64, 0x5f, 0x67, 0x69, 0x64,
+	0x5f, 0x6f, 0x72, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x12, 0x42,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2a,
+	0xca, 0xb5, 0x03, 0x26, 0xa2, 0x01, 0x23, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x75, 0x69, 0x64, 0x5f, 0x67, 0x69, 0x64,
+	0x5f, 0x6f, 0x72, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x44, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x05, 0x20,
 	0x01, 0x28, 0x04, 0x42, 0x2a, 0xca, 0xb5, 0x03, 0x26, 0xa2, 0x01, 0x23, 0x67, 0x6f, 0x72, 0x6d,
 	0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x75, 0x69,
 	0x64, 0x5f, 0x67, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x44, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2a, 0xca, 0xb5, 0x03, 0x26, 0xa2, 0x01,
-	0x23, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x3a, 0x75, 0x69, 0x64, 0x5f, 0x67, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x67, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x22, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x18, 0x0a,
-	0x07, 0x48, 0x54, 0x4d, 0x4c, 0x55, 0x52, 0x4c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x48, 0x54, 0x4d, 0x4c, 0x55, 0x52, 0x4c, 0x12, 0x5b, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x54,
-	0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x61, 0x67, 0x2e, 0x52,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x42, 0x2a,
-	0xca, 0xb5, 0x03, 0x26, 0xa2, 0x01, 0x23, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69,
-	0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x75, 0x69, 0x64, 0x5f, 0x67, 0x69, 0x64,
-	0x5f, 0x6f, 0x72, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f,
-	0x54, 0x79, 0x70, 0x65, 0x22, 0x51, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04,
-	0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x55, 0x52, 0x53, 0x45,
-	0x49, 0x4e, 0x46, 0x4f, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e,
-	0x4d, 0x45, 0x4e, 0x54, 0x53, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x45, 0x53, 0x54, 0x53,
-	0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x53, 0x45, 0x52, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05,
-	0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x05, 0x22, 0x89, 0x06, 0x0a, 0x0a, 0x45, 0x6e, 0x72, 0x6f,
-	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x4b, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2f, 0xca, 0xb5, 0x03, 0x2b, 0xa2, 0x01,
-	0x28, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x65, 0x6e,
-	0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x49, 0x44, 0x12, 0x47, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20,
+	0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x48, 0x54, 0x4d, 0x4c,
+	0x55, 0x52, 0x4c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x48, 0x54, 0x4d, 0x4c, 0x55,
+	0x52, 0x4c, 0x12, 0x5b, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x79, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x42, 0x2a, 0xca, 0xb5, 0x03, 0x26, 0xa2,
+	0x01, 0x23, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x3a, 0x75, 0x69, 0x64, 0x5f, 0x67, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x67, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x22, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x22,
+	0x51, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10,
+	0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x55, 0x52, 0x53, 0x45, 0x49, 0x4e, 0x46, 0x4f, 0x10,
+	0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x53,
+	0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x45, 0x53, 0x54, 0x53, 0x10, 0x03, 0x12, 0x08, 0x0a,
+	0x04, 0x55, 0x53, 0x45, 0x52, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x47, 0x52, 0x4f, 0x55, 0x50,
+	0x10, 0x05, 0x22, 0x89, 0x06, 0x0a, 0x0a, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49,
+	0x44, 0x12, 0x4b, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x04, 0x42, 0x2f, 0xca, 0xb5, 0x03, 0x2b, 0xa2, 0x01, 0x28, 0x67, 0x6f, 0x72, 0x6d,
 	0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64,
 	0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d,
-	0x65, 0x6e, 0x74, 0x22, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x10, 0x68, 0x61, 0x73, 0x54, 0x65, 0x61,
-	0x63, 0x68, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x10, 0x68, 0x61, 0x73, 0x54, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x70,
-	0x65, 0x73, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
-	0x12, 0x22, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x06, 0x63, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c,
-	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72,
-	0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x11, 0x73,
-	0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0f, 0xca, 0xb5, 0x03, 0x0b, 0xa2, 0x01, 0x08, 0x67,
-	0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x2d, 0x22, 0x52, 0x11, 0x73, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79,
-	0x73, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x2a, 0x0a, 0x10, 0x6c, 0x61,
-	0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
-	0x74, 0x79, 0x44, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41,
-	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x0c,
-	0x75, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x18, 0x0e, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70,
-	0x44, 0x61, 0x79, 0x73, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61,
-	0x79, 0x73, 0x22, 0x3d, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45,
-	0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x54, 0x55, 0x44, 0x45,
-	0x4e, 0x54, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x45, 0x41, 0x43, 0x48, 0x45, 0x52, 0x10,
-	0x03, 0x22, 0x40, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x09, 0x0a, 0x05, 0x55, 0x4e, 0x53, 0x45, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
-	0x48, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x56, 0x49, 0x53, 0x49,
-	0x42, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x41, 0x56, 0x4f, 0x52, 0x49, 0x54,
-	0x45, 0x10, 0x03, 0x22, 0x8a, 0x01, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70,
-	0x44, 0x61, 0x79, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x02, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65,
-	0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x65, 0x6e, 0x72, 0x6f,
-	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69,
-	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
-	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c,
-	0x75, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73,
-	0x22, 0x3f, 0x0a, 0x0b, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
-	0x30, 0x0a, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x22, 0x70, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c,
-	0x69, 0x6e, 0x6b, 0x12, 0x2e, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73,
-	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x22, 0x76, 0x0a, 0x0e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
-	0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x2e, 0x0a, 0x0a, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d,
-	0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45,
-	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x6e, 0x72, 0x6f, 0x6c,
-	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x67, 0x2e,
-	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x0b,
-	0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x61, 0x0a, 0x11, 0x43,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x22, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x06, 0x63, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d,
-	0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x22, 0xc0,
-	0x03, 0x0a, 0x0a, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a,
-	0x08, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x08, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
-	0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x75, 0x74,
-	0x6f, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
-	0x61, 0x75, 0x74, 0x6f, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x61, 0x62, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x61,
-	0x62, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x12,
-	0x30, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x42, 0x0a, 0x11, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63,
-	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61,
-	0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61,
-	0x72, 0x6b, 0x52, 0x11, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68,
-	0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
-	0x74, 0x22, 0x3f, 0x0a, 0x0b, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x12, 0x30, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67,
-	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x22, 0xd1, 0x03, 0x0a, 0x0a, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49,
+	0x65, 0x6e, 0x74, 0x22, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x47,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2f,
+	0xca, 0xb5, 0x03, 0x2b, 0xa2, 0x01, 0x28, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x5f, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x44, 0x12, 0x2a, 0x0a, 0x10, 0x68, 0x61, 0x73, 0x54, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x53,
+	0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x68, 0x61, 0x73,
+	0x54, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x1c, 0x0a,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x67,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x06, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x67,
+	0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12,
+	0x1f, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09,
+	0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x12, 0x31, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x19, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x11, 0x73, 0x6c, 0x69, 0x70, 0x44, 0x61,
+	0x79, 0x73, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x0f, 0xca, 0xb5, 0x03, 0x0b, 0xa2, 0x01, 0x08, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22,
+	0x2d, 0x22, 0x52, 0x11, 0x73, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x52, 0x65, 0x6d, 0x61,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x2a, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x64, 0x53,
+	0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x52,
+	0x0c, 0x75, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x22, 0x3d, 0x0a,
+	0x0a, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x08, 0x0a, 0x04, 0x4e,
+	0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47,
+	0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x54, 0x55, 0x44, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12,
+	0x0b, 0x0a, 0x07, 0x54, 0x45, 0x41, 0x43, 0x48, 0x45, 0x52, 0x10, 0x03, 0x22, 0x40, 0x0a, 0x0c,
+	0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x09, 0x0a, 0x05,
+	0x55, 0x4e, 0x53, 0x45, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x48, 0x49, 0x44, 0x44, 0x45,
+	0x4e, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x56, 0x49, 0x53, 0x49, 0x42, 0x4c, 0x45, 0x10, 0x02,
+	0x12, 0x0c, 0x0a, 0x08, 0x46, 0x41, 0x56, 0x4f, 0x52, 0x49, 0x54, 0x45, 0x10, 0x03, 0x22, 0x8a,
+	0x01, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x12,
+	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12,
+	0x22, 0x0a, 0x0c, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x64, 0x53,
+	0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x75,
+	0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x22, 0x3f, 0x0a, 0x0b, 0x45,
+	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x65, 0x6e,
+	0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x70, 0x0a, 0x0e,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x2e,
+	0x0a, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2e,
+	0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x76,
+	0x0a, 0x0e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6e, 0x6b,
+	0x12, 0x2e, 0x0a, 0x0a, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x34, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x61, 0x0a, 0x11, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x06, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x67,
+	0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12,
+	0x28, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69,
+	0x6e, 0x6b, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x22, 0xf3, 0x12, 0x0a, 0x0a, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64,
+	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64,
+	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a,
+	0x69, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x61, 0x62, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x69, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x61, 0x62, 0x12, 0x1e, 0x0a, 0x0a,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x73, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x42, 0x0a, 0x11,
+	0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
+	0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x11, 0x67,
+	0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73,
+	0x12, 0x2a, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x61, 0x72, 0x74, 0x4f, 0x66, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61,
+	0x72, 0x74, 0x4f, 0x66, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x74, 0x65, 0x73, 0x74, 0x73, 0x18, 0x12, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x05, 0x74, 0x65, 0x73, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x65,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x52, 0x4c, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x52, 0x4c, 0x12, 0x22,
+	0x0a, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x66, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x52,
+	0x65, 0x66, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x4f, 0x6e, 0x6c, 0x79,
+	0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x4f, 0x6e,
+	0x6c, 0x79, 0x12, 0x6e, 0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74,
+	0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x30, 0xca, 0xb5, 0x03, 0x2c, 0xa2, 0x01, 0x29, 0x67, 0x6f, 0x72, 0x6d,
+	0x3a, 0x22, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x3a, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x3b, 0x74, 0x79, 0x70, 0x65, 0x3a, 0x64, 0x61, 0x74, 0x65,
+	0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x6a, 0x0a, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18,
+	0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x42, 0x30, 0xca, 0xb5, 0x03, 0x2c, 0xa2, 0x01, 0x29, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22,
+	0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x3a, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x3b, 0x74, 0x79, 0x70, 0x65, 0x3a, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69,
+	0x6d, 0x65, 0x22, 0x52, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x26,
+	0x0a, 0x0e, 0x65, 0x78, 0x74, 0x72, 0x61, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x43, 0x61, 0x70,
+	0x18, 0x18, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x65, 0x78, 0x74, 0x72, 0x61, 0x43, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x43, 0x61, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x69, 0x64, 0x65, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x18, 0x19, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x68, 0x69, 0x64, 0x65,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x10,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72,
+	0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x75, 0x74, 0x65,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x1c, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x11, 0x6d, 0x75, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x6c, 0x61, 0x67, 0x69, 0x61,
+	0x72, 0x69, 0x73, 0x6d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0f, 0x70, 0x6c, 0x61, 0x67, 0x69, 0x61, 0x72, 0x69, 0x73, 0x6d, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x12, 0x30, 0x0a, 0x13, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x54, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x73,
+	0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x1f, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x22, 0x0a, 0x0c,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x20, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0c, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x18,
+	0x21, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x72, 0x61, 0x6c, 0x6c,
+	0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x66, 0x66, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x22,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x66, 0x66, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x23, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79,
+	0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x24, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x74, 0x72, 0x79, 0x4f, 0x6e, 0x49, 0x6e, 0x66,
+	0x72, 0x61, 0x18, 0x25, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72, 0x65, 0x74, 0x72, 0x79, 0x4f,
+	0x6e, 0x49, 0x6e, 0x66, 0x72, 0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x72, 0x61, 0x63, 0x65, 0x48,
+	0x6f, 0x75, 0x72, 0x73, 0x18, 0x26, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x67, 0x72, 0x61, 0x63,
+	0x65, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f,
+	0x6e, 0x65, 0x18, 0x27, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f,
+	0x6e, 0x65, 0x12, 0x70, 0x0a, 0x0c, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x30, 0xca, 0xb5, 0x03, 0x2c, 0xa2, 0x01, 0x29, 0x67, 0x6f, 0x72,
+	0x6d, 0x3a, 0x22, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x3a, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x3b, 0x74, 0x79, 0x70, 0x65, 0x3a, 0x64, 0x61, 0x74,
+	0x65, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x0c, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x66, 0x69,
+	0x6c, 0x65, 0x18, 0x29, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72,
+	0x66, 0x69, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x6b, 0x65,
+	0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18,
+	0x2b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52,
+	0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x73, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x73, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x41, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e,
+	0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x63,
+	0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x53, 0x65, 0x65, 0x64, 0x18, 0x2f, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x53, 0x65, 0x65, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x30, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x61, 0x6c, 0x52, 0x75, 0x6e, 0x73, 0x18, 0x31, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x75, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x15,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x44, 0x65, 0x61,
+	0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x32, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x61, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x18, 0x33, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6d, 0x61, 0x6e, 0x75, 0x61, 0x6c,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x70, 0x75, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x34, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x63, 0x70, 0x75, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x35, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x69, 0x64, 0x73, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x36, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x69, 0x64, 0x73, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x37, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x73,
+	0x18, 0x38, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x48,
+	0x6f, 0x73, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x72, 0x75, 0x6e,
+	0x73, 0x18, 0x39, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x72, 0x75,
+	0x6e, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x3a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x26, 0x0a, 0x0e,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x3b,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x57, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x39, 0x0a, 0x0e,
+	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x3d,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73,
+	0x69, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x0e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69,
+	0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x74, 0x72, 0x69,
+	0x78, 0x18, 0x3e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x12,
+	0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x3f, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22,
+	0xc0, 0x02, 0x0a, 0x0a, 0x54, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0e,
+	0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x22,
+	0x0a, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x65, 0x74, 0x72, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x72, 0x65, 0x74, 0x72, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x65, 0x78, 0x74, 0x72, 0x61, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x65, 0x78, 0x74, 0x72, 0x61, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x61, 0x73, 0x73, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x70, 0x61, 0x73, 0x73, 0x54,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64,
+	0x65, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e,
+	0x12, 0x30, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x22, 0xa3, 0x01, 0x0a, 0x0d, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x22, 0x3f, 0x0a, 0x0b, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61,
+	0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x78, 0x0a, 0x04, 0x54, 0x61, 0x73,
+	0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49,
 	0x44, 0x12, 0x22, 0x0a, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49,
 	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
-	0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a,
-	0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1e, 0x0a,
-	0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a,
-	0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x53,
-	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x72,
-	0x6f, 0x76, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x07,
-	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
-	0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x07, 0x72, 0x65, 0x76, 0x69, 0x65,
-	0x77, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x42, 0x75,
-	0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x24, 0x0a, 0x06, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65,
-	0x52, 0x06, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x22, 0x3c, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08,
-	0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45,
-	0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x56, 0x49,
-	0x53, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x22, 0x3f, 0x0a, 0x0b, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e,
-	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xee, 0x01, 0x0a, 0x10, 0x47, 0x72, 0x61, 0x64,
-	0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x0e, 0x0a, 0x02,
+	0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62,
+	0x6f, 0x64, 0x79, 0x22, 0xc7, 0x01, 0x0a, 0x05, 0x49, 0x73, 0x73, 0x75, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x22, 0x0a,
+	0x0c, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0c, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x49,
+	0x44, 0x12, 0x22, 0x0a, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x73, 0x75, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x69, 0x73, 0x73, 0x75, 0x65, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64,
+	0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0xb9, 0x05,
+	0x0a, 0x0a, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
 	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c,
 	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x04, 0x52, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44,
-	0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x08, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07,
-	0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x68,
-	0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x56, 0x0a, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x06, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43,
-	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x42, 0x24, 0xca, 0xb5, 0x03, 0x20, 0xa2, 0x01,
-	0x1d, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4b, 0x65,
-	0x79, 0x3a, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x44, 0x22, 0x52, 0x08,
-	0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x22, 0x42, 0x0a, 0x0a, 0x42, 0x65, 0x6e, 0x63,
-	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d,
-	0x61, 0x72, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x2e,
-	0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
-	0x52, 0x0a, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x22, 0xf5, 0x01, 0x0a,
-	0x10, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x44,
+	0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x52, 0x07, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x2e, 0x0a,
+	0x09, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x09, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x0a,
+	0x06, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x06, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x72, 0x75, 0x6e, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x72, 0x65, 0x72, 0x75, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x61, 0x67, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0f, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x12, 0x30, 0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x0d, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x67, 0x2e,
+	0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0d, 0x6d, 0x61,
+	0x74, 0x72, 0x69, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x3c, 0x0a, 0x06, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12,
+	0x0c, 0x0a, 0x08, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a,
+	0x08, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x52,
+	0x45, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x22, 0xd6, 0x01, 0x0a, 0x0c, 0x4d, 0x61,
+	0x74, 0x72, 0x69, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x6f, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x6f, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78,
+	0x65, 0x63, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x78,
+	0x65, 0x63, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x4f,
+	0x75, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x4f,
+	0x75, 0x74, 0x22, 0xa0, 0x01, 0x0a, 0x0a, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49,
-	0x44, 0x12, 0x20, 0x0a, 0x0b, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x44,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
-	0x6b, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a,
-	0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x61,
-	0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69,
-	0x6f, 0x6e, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x29, 0x0a, 0x05, 0x47, 0x72, 0x61,
-	0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
-	0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x41, 0x53, 0x53,
-	0x45, 0x44, 0x10, 0x02, 0x22, 0xa3, 0x02, 0x0a, 0x06, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12,
-	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12,
-	0x22, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49,
-	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65,
-	0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12,
-	0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
-	0x72, 0x65, 0x61, 0x64, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x65, 0x0a, 0x11, 0x67,
-	0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73,
-	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64,
-	0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x42, 0x21, 0xca, 0xb5,
-	0x03, 0x1d, 0xa2, 0x01, 0x1a, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x66, 0x6f, 0x72, 0x65, 0x69,
-	0x67, 0x6e, 0x4b, 0x65, 0x79, 0x3a, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x22, 0x52,
-	0x11, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
-	0x6b, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x22, 0x33, 0x0a, 0x09, 0x52, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65,
-	0x77, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x67, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x22,
-	0x4f, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x06,
-	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61,
-	0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x22, 0x2b, 0x0a, 0x0d, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x22, 0x25, 0x0a,
-	0x0b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x22, 0x2b, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
-	0x44, 0x22, 0x5c, 0x0a, 0x0c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x22,
-	0x26, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0x26, 0x0a, 0x0a, 0x4f, 0x72, 0x67, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x22,
-	0x6c, 0x0a, 0x0c, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x44, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3f, 0x0a, 0x0b, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xde, 0x01, 0x0a, 0x08, 0x41, 0x72, 0x74, 0x69, 0x66,
+	0x61, 0x63, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x49, 0x44, 0x12, 0x51, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xca, 0xb5, 0x03, 0x29, 0xa2,
+	0x01, 0x26, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x61,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x22, 0x52, 0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x41, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0xca, 0xb5, 0x03, 0x29, 0xa2, 0x01, 0x26, 0x67, 0x6f, 0x72,
+	0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69,
+	0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61,
+	0x63, 0x74, 0x22, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x37, 0x0a, 0x09, 0x41, 0x72, 0x74, 0x69, 0x66,
+	0x61, 0x63, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x09, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x72, 0x74,
+	0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x09, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73,
+	0x22, 0xa5, 0x02, 0x0a, 0x11, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x52, 0x0a, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2e, 0xca, 0xb5,
+	0x03, 0x2a, 0xa2, 0x01, 0x27, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x0c, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x46, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2e, 0xca, 0xb5, 0x03, 0x2a,
+	0xa2, 0x01, 0x27, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x48, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x2e, 0xca, 0xb5, 0x03, 0x2a, 0xa2, 0x01, 0x27, 0x67, 0x6f, 0x72, 0x6d,
+	0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64,
+	0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0xbe, 0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x47, 0x0a, 0x08, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2b, 0xca, 0xb5, 0x03,
+	0x27, 0xa2, 0x01, 0x24, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x52, 0x08, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x49, 0x44, 0x12, 0x3f, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x2b, 0xca, 0xb5, 0x03, 0x27, 0xa2, 0x01, 0x24, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x0c, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x22, 0x4f, 0x0a, 0x0b, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x0b, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12,
+	0x29, 0x0a, 0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x52, 0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x86, 0x02, 0x0a, 0x10, 0x47,
+	0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x12,
 	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x70,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x22, 0x47, 0x0a,
-	0x0d, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36,
-	0x0a, 0x0d, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e,
-	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xba, 0x01, 0x0a, 0x11, 0x45, 0x6e, 0x72, 0x6f, 0x6c,
-	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x22, 0x0a, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x12,
+	0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x56, 0x0a, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69,
+	0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x42, 0x24, 0xca, 0xb5, 0x03,
+	0x20, 0xa2, 0x01, 0x1d, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67,
+	0x6e, 0x4b, 0x65, 0x79, 0x3a, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x44,
+	0x22, 0x52, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x22, 0x42, 0x0a, 0x0a, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
+	0x73, 0x12, 0x34, 0x0a, 0x0a, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69,
+	0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x0a, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x22, 0xf5, 0x01, 0x0a, 0x10, 0x47, 0x72, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b,
+	0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x22, 0x29, 0x0a, 0x05, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x08, 0x0a,
+	0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x41, 0x53, 0x53, 0x45, 0x44, 0x10, 0x02, 0x22,
+	0xa3, 0x02, 0x0a, 0x06, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1e,
+	0x0a, 0x0a, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a,
+	0x0a, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65,
+	0x61, 0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x65, 0x0a, 0x11, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65,
+	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x42, 0x21, 0xca, 0xb5, 0x03, 0x1d, 0xa2, 0x01, 0x1a,
+	0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4b, 0x65, 0x79,
+	0x3a, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x22, 0x52, 0x11, 0x67, 0x72, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65,
+	0x64, 0x69, 0x74, 0x65, 0x64, 0x22, 0x33, 0x0a, 0x09, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65,
+	0x72, 0x73, 0x12, 0x26, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x22, 0x4f, 0x0a, 0x0d, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x52, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x2b, 0x0a, 0x0d, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
 	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
-	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x12, 0x69, 0x67, 0x6e, 0x6f,
-	0x72, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x77, 0x69, 0x74, 0x68,
-	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
-	0x77, 0x69, 0x74, 0x68, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x35, 0x0a, 0x08,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x19,
-	0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x65, 0x73, 0x22, 0x68, 0x0a, 0x17, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x22, 0x83, 0x01, 0x0a, 0x0f, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x22, 0x21,
+	0x0a, 0x0d, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x6f, 0x67, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12,
+	0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6c, 0x6f,
+	0x67, 0x22, 0xa3, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x65, 0x64, 0x42, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x65, 0x64, 0x42, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x22, 0x61, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x25, 0x0a, 0x0b, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x22, 0x2b, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x22, 0x5c,
+	0x0a, 0x0c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
 	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e,
-	0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x22, 0x61, 0x0a,
-	0x11, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44,
-	0x22, 0xba, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c,
-	0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
-	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05,
-	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x63, 0x6f,
-	0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x12, 0x2d,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
-	0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xae, 0x01,
-	0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
-	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73,
-	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63,
-	0x6f, 0x72, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
-	0x73, 0x63, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65,
-	0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x6c,
-	0x65, 0x61, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x22, 0x5c,
-	0x0a, 0x1a, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c,
-	0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
-	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x22, 0x29, 0x0a, 0x09,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x22, 0x5b, 0x0a, 0x0a, 0x55, 0x52, 0x4c, 0x52, 0x65,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x22, 0x26, 0x0a, 0x08,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x22, 0x26, 0x0a, 0x0a, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x6c, 0x0a, 0x0c,
+	0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x22, 0x47, 0x0a, 0x0d, 0x4f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x0d, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0xba, 0x01, 0x0a, 0x11, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x12, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x12, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x77, 0x69, 0x74,
+	0x68, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x61, 0x67,
+	0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73,
+	0x22, 0x68, 0x0a, 0x17, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c,
+	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x22, 0x61, 0x0a, 0x11, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x22, 0xba, 0x01,
+	0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x61, 0x67,
+	0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xae, 0x01, 0x0a, 0x18, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x22, 0x65, 0x0a, 0x0f, 0x41,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x5c, 0x0a, 0x1a, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44,
+	0x22, 0x29, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x22, 0x5b, 0x0a, 0x0a, 0x55,
+	0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x31, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x72,
+	0x65, 0x70, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x61, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x22, 0x77, 0x0a, 0x0c, 0x52,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x04, 0x55,
+	0x52, 0x4c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x67, 0x2e, 0x52,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x55, 0x52, 0x4c, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x55, 0x52, 0x4c, 0x73, 0x1a, 0x37, 0x0a, 0x09, 0x55,
+	0x52, 0x4c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x3b, 0x0a, 0x15, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a,
+	0x0c, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65,
+	0x64, 0x22, 0x32, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x43,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xc5, 0x01, 0x0a, 0x1b, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
 	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
-	0x44, 0x12, 0x31, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x6f, 0x72, 0x79, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x54,
-	0x79, 0x70, 0x65, 0x73, 0x22, 0x61, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
-	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x22, 0x77, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x04, 0x55, 0x52, 0x4c, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x55, 0x52, 0x4c, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x04, 0x55, 0x52, 0x4c, 0x73, 0x1a, 0x37, 0x0a, 0x09, 0x55, 0x52, 0x4c, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x22, 0x3b, 0x0a, 0x15, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x49, 0x73, 0x41,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0c, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x22, 0x32, 0x0a,
-	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x22, 0xc5, 0x01, 0x0a, 0x1b, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x46, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x38, 0x0a,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x61, 0x67,
-	0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x43,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x42,
-	0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
-	0x77, 0x69, 0x74, 0x68, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x2a, 0x0a,
-	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x0e,
-	0x0a, 0x0a, 0x49, 0x4e, 0x44, 0x49, 0x56, 0x49, 0x44, 0x55, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x09,
-	0x0a, 0x05, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x02, 0x22, 0x58, 0x0a, 0x0e, 0x52, 0x65, 0x62,
-	0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x73,
-	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12,
-	0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
-	0x74, 0x49, 0x44, 0x22, 0x71, 0x0a, 0x11, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x59, 0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x59, 0x65, 0x61, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72,
-	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65,
-	0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0x53, 0x0a, 0x11, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x44, 0x12, 0x38, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x24, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x46, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x77,
+	0x69, 0x74, 0x68, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66,
+	0x6f, 0x22, 0x2a, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4c, 0x4c,
+	0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x49, 0x4e, 0x44, 0x49, 0x56, 0x49, 0x44, 0x55, 0x41, 0x4c,
+	0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x02, 0x22, 0x58, 0x0a,
+	0x0e, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x71, 0x0a, 0x11, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x59, 0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x59, 0x65, 0x61, 0x72, 0x12, 0x1c, 0x0a, 0x09,
+	0x75, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x75, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0x53, 0x0a, 0x11, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22,
+	0x6b, 0x0a, 0x18, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63,
 	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67,
-	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61,
-	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x06, 0x0a, 0x04, 0x56,
-	0x6f, 0x69, 0x64, 0x32, 0xf4, 0x11, 0x0a, 0x11, 0x41, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64,
-	0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x07, 0x47, 0x65, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x12, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x08,
-	0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x22, 0x00, 0x12, 0x21, 0x0a, 0x08, 0x47, 0x65,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64,
-	0x1a, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x22, 0x00, 0x12, 0x34, 0x0a,
-	0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x22, 0x00, 0x12, 0x22, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x12, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x1a, 0x08, 0x2e, 0x61, 0x67,
-	0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x13, 0x49, 0x73, 0x41, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x54, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x12, 0x08,
-	0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x19, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2c, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x12, 0x13, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42,
-	0x79, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6e, 0x64, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x10,
-	0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x00, 0x12, 0x34, 0x0a,
-	0x11, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x42, 0x79, 0x43, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x73, 0x22, 0x00, 0x12, 0x25, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x12, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x1a, 0x09, 0x2e,
-	0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x00, 0x12, 0x24, 0x0a, 0x0b, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00,
-	0x12, 0x2b, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
-	0x10, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x2c, 0x0a,
-	0x09, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e,
-	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e,
-	0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x22, 0x00, 0x12, 0x25, 0x0a, 0x0a, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56,
-	0x6f, 0x69, 0x64, 0x1a, 0x0b, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
-	0x22, 0x00, 0x12, 0x3e, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
-	0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f,
-	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
-	0x22, 0x00, 0x12, 0x28, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x12, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x1a, 0x0a,
-	0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x22, 0x00, 0x12, 0x26, 0x0a, 0x0c,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x0a, 0x2e, 0x61,
-	0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f,
-	0x69, 0x64, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x0e,
-	0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x08,
-	0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0e, 0x47, 0x65,
-	0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x11, 0x2e, 0x61,
-	0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x22, 0x00, 0x12, 0x32, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69,
-	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e,
-	0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x72,
-	0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1b,
-	0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x67,
-	0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x42,
-	0x0a, 0x16, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x42, 0x79, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e,
-	0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x22, 0x00, 0x12, 0x2e, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x72, 0x6f,
-	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f,
-	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64,
-	0x22, 0x00, 0x12, 0x2e, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x72, 0x6f,
-	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f,
-	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64,
-	0x22, 0x00, 0x12, 0x32, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x72, 0x6f,
-	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e,
-	0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75,
-	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x22, 0x00, 0x12, 0x52, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x1f, 0x2e, 0x61,
-	0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72,
-	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
-	0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x61, 0x67, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x33, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x67, 0x2e,
+	0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x48, 0x0a, 0x12,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0xfe, 0x01, 0x0a, 0x1a, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
+	0x44, 0x12, 0x30, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x12, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x12, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x60, 0x0a, 0x18, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12,
+	0x28, 0x0a, 0x0f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x49, 0x0a, 0x11, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x22, 0x06, 0x0a, 0x04, 0x56, 0x6f, 0x69, 0x64, 0x32, 0xbd, 0x18, 0x0a,
+	0x11, 0x41, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x1f, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x08, 0x2e,
+	0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x22, 0x00, 0x12, 0x21, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12,
+	0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x42, 0x79, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x22, 0x00, 0x12, 0x22, 0x0a, 0x0a,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x08, 0x2e, 0x61, 0x67, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00,
+	0x12, 0x3c, 0x0a, 0x13, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64,
+	0x54, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x12, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69,
+	0x64, 0x1a, 0x19, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2c,
+	0x0a, 0x08, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x13, 0x2e, 0x61, 0x67, 0x2e,
+	0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x17,
+	0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6e,
+	0x64, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x42, 0x79, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x11, 0x2e, 0x61, 0x67,
+	0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a,
+	0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x00, 0x12, 0x25, 0x0a, 0x0b,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x09, 0x2e, 0x61, 0x67,
+	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x1a, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x22, 0x00, 0x12, 0x24, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x12, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x1a, 0x08, 0x2e,
+	0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x0b, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e,
+	0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x2c, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x25, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x73, 0x12, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x0b, 0x2e, 0x61,
+	0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x1b, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x61,
+	0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x22, 0x00, 0x12, 0x28, 0x0a, 0x0c, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x0a, 0x2e, 0x61, 0x67,
+	0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x1a, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x22, 0x00, 0x12, 0x26, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x34, 0x0a,
+	0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x56, 0x69, 0x73,
+	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72,
+	0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69,
+	0x64, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67,
+	0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x61,
+	0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12,
+	0x32, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69,
-	0x64, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64,
-	0x22, 0x00, 0x12, 0x39, 0x0a, 0x11, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x62,
-	0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x61, 0x67,
-	0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x37, 0x0a,
-	0x12, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e,
-	0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47,
-	0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x1a,
-	0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63,
-	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x14, 0x2e, 0x61, 0x67, 0x2e,
-	0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
-	0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0f,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x12,
-	0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63,
-	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22,
-	0x00, 0x12, 0x3f, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x69, 0x74, 0x65,
-	0x72, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e,
-	0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x1a, 0x14, 0x2e, 0x61, 0x67, 0x2e,
-	0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e,
-	0x22, 0x00, 0x12, 0x33, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x69, 0x74,
-	0x65, 0x72, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69,
-	0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x1a, 0x08, 0x2e, 0x61, 0x67,
-	0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x61, 0x67, 0x2e,
-	0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e,
-	0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0c,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x11, 0x2e, 0x61,
-	0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x00, 0x12, 0x2f, 0x0a,
-	0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x11, 0x2e,
-	0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x00, 0x12, 0x3f,
-	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x12, 0x1e,
-	0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d,
-	0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x22, 0x00, 0x12,
-	0x29, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12,
-	0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x0d, 0x2e, 0x61, 0x67, 0x2e, 0x50,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0f, 0x47, 0x65,
-	0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x2e,
-	0x61, 0x67, 0x2e, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
-	0x61, 0x67, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x00, 0x12, 0x35, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
-	0x72, 0x69, 0x65, 0x73, 0x12, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0b, 0x49, 0x73, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08,
-	0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x42, 0x26, 0x5a, 0x21, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61,
-	0x64, 0x65, 0x2f, 0x71, 0x75, 0x69, 0x63, 0x6b, 0x66, 0x65, 0x65, 0x64, 0x2f, 0x61, 0x67, 0xba,
-	0x02, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x64, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x18, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12,
+	0x11, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x61, 0x67, 0x2e, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x22, 0x00,
+	0x12, 0x3d, 0x0a, 0x11, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x1a, 0x0f, 0x2e, 0x61,
+	0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12,
+	0x46, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72,
+	0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x45, 0x6e,
+	0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e,
+	0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x10, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x1a,
+	0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x10, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x1a,
+	0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x11, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12,
+	0x3a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x16, 0x47,
+	0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x1f, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12,
+	0x3b, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x11,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x1c, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x11, 0x52,
+	0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x12, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x12, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x15, 0x2e, 0x61,
+	0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12,
+	0x41, 0x0a, 0x11, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x61, 0x67,
+	0x2e, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x22, 0x00, 0x12, 0x42, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x13, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0f, 0x52, 0x65, 0x72, 0x75, 0x6e, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x00, 0x12, 0x3e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x61, 0x67,
+	0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x4c, 0x6f, 0x67, 0x12, 0x13, 0x2e, 0x61, 0x67, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4c,
+	0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x4c, 0x6f, 0x67, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x00, 0x30, 0x01,
+	0x12, 0x34, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73,
+	0x12, 0x13, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66,
+	0x61, 0x63, 0x74, 0x73, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74,
+	0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x13, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66,
+	0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x61, 0x67, 0x2e,
+	0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x16, 0x47, 0x72,
+	0x61, 0x6e, 0x74, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x61, 0x67, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x16, 0x2e,
+	0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x14, 0x2e, 0x61, 0x67,
+	0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
+	0x6b, 0x1a, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65,
+	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0f, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x14, 0x2e, 0x61,
+	0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61,
+	0x72, 0x6b, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x33,
+	0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
+	0x6b, 0x12, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65,
+	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69,
+	0x64, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x69,
+	0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x1a, 0x14, 0x2e, 0x61,
+	0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69,
+	0x6f, 0x6e, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72,
+	0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x1a, 0x08, 0x2e,
+	0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0f, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x61,
+	0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69,
+	0x6f, 0x6e, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x2f,
+	0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x11,
+	0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x00, 0x12,
+	0x2f, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12,
+	0x11, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x00,
+	0x12, 0x3f, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73,
+	0x12, 0x1e, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0d, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x22,
+	0x00, 0x12, 0x29, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x73, 0x12, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x0d, 0x2e, 0x61, 0x67,
+	0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x10, 0x2e, 0x61, 0x67, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x52, 0x4c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0b, 0x49, 0x73,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x52,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x42, 0x26, 0x5a, 0x21,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x2f, 0x71, 0x75, 0x69, 0x63, 0x6b, 0x66, 0x65, 0x65, 0x64, 0x2f, 0x61,
+	0x67, 0xba, 0x02, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4359,7 +6953,7 @@ func file_ag_ag_proto_rawDescGZIP() []byte {
 }
 
 var file_ag_ag_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_ag_ag_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
+var file_ag_ag_proto_msgTypes = make([]protoimpl.MessageInfo, 73)
 var file_ag_ag_proto_goTypes = []interface{}{
 	(Group_GroupStatus)(0),                // 0: ag.Group.GroupStatus
 	(Repository_Type)(0),                  // 1: ag.Repository.Type
@@ -4383,183 +6977,245 @@ var file_ag_ag_proto_goTypes = []interface{}{
 	(*EnrollmentLink)(nil),                // 19: ag.EnrollmentLink
 	(*CourseSubmissions)(nil),             // 20: ag.CourseSubmissions
 	(*Assignment)(nil),                    // 21: ag.Assignment
-	(*Assignments)(nil),                   // 22: ag.Assignments
-	(*Submission)(nil),                    // 23: ag.Submission
-	(*Submissions)(nil),                   // 24: ag.Submissions
-	(*GradingBenchmark)(nil),              // 25: ag.GradingBenchmark
-	(*Benchmarks)(nil),                    // 26: ag.Benchmarks
-	(*GradingCriterion)(nil),              // 27: ag.GradingCriterion
-	(*Review)(nil),                        // 28: ag.Review
-	(*Reviewers)(nil),                     // 29: ag.Reviewers
-	(*ReviewRequest)(nil),                 // 30: ag.ReviewRequest
-	(*CourseRequest)(nil),                 // 31: ag.CourseRequest
-	(*UserRequest)(nil),                   // 32: ag.UserRequest
-	(*GetGroupRequest)(nil),               // 33: ag.GetGroupRequest
-	(*GroupRequest)(nil),                  // 34: ag.GroupRequest
-	(*Provider)(nil),                      // 35: ag.Provider
-	(*OrgRequest)(nil),                    // 36: ag.OrgRequest
-	(*Organization)(nil),                  // 37: ag.Organization
-	(*Organizations)(nil),                 // 38: ag.Organizations
-	(*EnrollmentRequest)(nil),             // 39: ag.EnrollmentRequest
-	(*EnrollmentStatusRequest)(nil),       // 40: ag.EnrollmentStatusRequest
-	(*SubmissionRequest)(nil),             // 41: ag.SubmissionRequest
-	(*UpdateSubmissionRequest)(nil),       // 42: ag.UpdateSubmissionRequest
-	(*UpdateSubmissionsRequest)(nil),      // 43: ag.UpdateSubmissionsRequest
-	(*SubmissionReviewersRequest)(nil),    // 44: ag.SubmissionReviewersRequest
-	(*Providers)(nil),                     // 45: ag.Providers
-	(*URLRequest)(nil),                    // 46: ag.URLRequest
-	(*RepositoryRequest)(nil),             // 47: ag.RepositoryRequest
-	(*Repositories)(nil),                  // 48: ag.Repositories
-	(*AuthorizationResponse)(nil),         // 49: ag.AuthorizationResponse
-	(*Status)(nil),                        // 50: ag.Status
-	(*SubmissionsForCourseRequest)(nil),   // 51: ag.SubmissionsForCourseRequest
-	(*RebuildRequest)(nil),                // 52: ag.RebuildRequest
-	(*CourseUserRequest)(nil),             // 53: ag.CourseUserRequest
-	(*AssignmentRequest)(nil),             // 54: ag.AssignmentRequest
-	(*Void)(nil),                          // 55: ag.Void
-	nil,                                   // 56: ag.Repositories.URLsEntry
-	(*score.BuildInfo)(nil),               // 57: score.BuildInfo
-	(*score.Score)(nil),                   // 58: score.Score
+	(*TestConfig)(nil),                    // 22: ag.TestConfig
+	(*AnalysisCheck)(nil),                 // 23: ag.AnalysisCheck
+	(*Assignments)(nil),                   // 24: ag.Assignments
+	(*Task)(nil),                          // 25: ag.Task
+	(*Issue)(nil),                         // 26: ag.Issue
+	(*Submission)(nil),                    // 27: ag.Submission
+	(*MatrixResult)(nil),                  // 28: ag.MatrixResult
+	(*Annotation)(nil),                    // 29: ag.Annotation
+	(*Submissions)(nil),                   // 30: ag.Submissions
+	(*Artifact)(nil),                      // 31: ag.Artifact
+	(*Artifacts)(nil),                     // 32: ag.Artifacts
+	(*DeadlineExtension)(nil),             // 33: ag.DeadlineExtension
+	(*CourseSecret)(nil),                  // 34: ag.CourseSecret
+	(*CourseModule)(nil),                  // 35: ag.CourseModule
+	(*ModuleGrade)(nil),                   // 36: ag.ModuleGrade
+	(*CourseGrade)(nil),                   // 37: ag.CourseGrade
+	(*GradingBenchmark)(nil),              // 38: ag.GradingBenchmark
+	(*Benchmarks)(nil),                    // 39: ag.Benchmarks
+	(*GradingCriterion)(nil),              // 40: ag.GradingCriterion
+	(*Review)(nil),                        // 41: ag.Review
+	(*Reviewers)(nil),                     // 42: ag.Reviewers
+	(*ReviewRequest)(nil),                 // 43: ag.ReviewRequest
+	(*CourseRequest)(nil),                 // 44: ag.CourseRequest
+	(*BuildLogRequest)(nil),               // 45: ag.BuildLogRequest
+	(*BuildLogChunk)(nil),                 // 46: ag.BuildLogChunk
+	(*RegradeProgress)(nil),               // 47: ag.RegradeProgress
+	(*SubmissionQueue)(nil),               // 48: ag.SubmissionQueue
+	(*UserRequest)(nil),                   // 49: ag.UserRequest
+	(*GetGroupRequest)(nil),               // 50: ag.GetGroupRequest
+	(*GroupRequest)(nil),                  // 51: ag.GroupRequest
+	(*Provider)(nil),                      // 52: ag.Provider
+	(*OrgRequest)(nil),                    // 53: ag.OrgRequest
+	(*Organization)(nil),                  // 54: ag.Organization
+	(*Organizations)(nil),                 // 55: ag.Organizations
+	(*EnrollmentRequest)(nil),             // 56: ag.EnrollmentRequest
+	(*EnrollmentStatusRequest)(nil),       // 57: ag.EnrollmentStatusRequest
+	(*SubmissionRequest)(nil),             // 58: ag.SubmissionRequest
+	(*UpdateSubmissionRequest)(nil),       // 59: ag.UpdateSubmissionRequest
+	(*UpdateSubmissionsRequest)(nil),      // 60: ag.UpdateSubmissionsRequest
+	(*ArtifactRequest)(nil),               // 61: ag.ArtifactRequest
+	(*SubmissionReviewersRequest)(nil),    // 62: ag.SubmissionReviewersRequest
+	(*Providers)(nil),                     // 63: ag.Providers
+	(*URLRequest)(nil),                    // 64: ag.URLRequest
+	(*RepositoryRequest)(nil),             // 65: ag.RepositoryRequest
+	(*Repositories)(nil),                  // 66: ag.Repositories
+	(*AuthorizationResponse)(nil),         // 67: ag.AuthorizationResponse
+	(*Status)(nil),                        // 68: ag.Status
+	(*SubmissionsForCourseRequest)(nil),   // 69: ag.SubmissionsForCourseRequest
+	(*RebuildRequest)(nil),                // 70: ag.RebuildRequest
+	(*CourseUserRequest)(nil),             // 71: ag.CourseUserRequest
+	(*AssignmentRequest)(nil),             // 72: ag.AssignmentRequest
+	(*DeadlineExtensionRequest)(nil),      // 73: ag.DeadlineExtensionRequest
+	(*CourseGradeRequest)(nil),            // 74: ag.CourseGradeRequest
+	(*CourseRepositoryValidation)(nil),    // 75: ag.CourseRepositoryValidation
+	(*AssignmentArchiveRequest)(nil),      // 76: ag.AssignmentArchiveRequest
+	(*AssignmentArchive)(nil),             // 77: ag.AssignmentArchive
+	(*Void)(nil),                          // 78: ag.Void
+	nil,                                   // 79: ag.Repositories.URLsEntry
+	(*timestamppb.Timestamp)(nil),         // 80: google.protobuf.Timestamp
+	(*score.BuildInfo)(nil),               // 81: score.BuildInfo
+	(*score.Score)(nil),                   // 82: score.Score
 }
 var file_ag_ag_proto_depIdxs = []int32{
-	9,  // 0: ag.User.remoteIdentities:type_name -> ag.RemoteIdentity
-	15, // 1: ag.User.enrollments:type_name -> ag.Enrollment
-	7,  // 2: ag.Users.users:type_name -> ag.User
-	0,  // 3: ag.Group.status:type_name -> ag.Group.GroupStatus
-	7,  // 4: ag.Group.users:type_name -> ag.User
-	15, // 5: ag.Group.enrollments:type_name -> ag.Enrollment
-	10, // 6: ag.Groups.groups:type_name -> ag.Group
-	2,  // 7: ag.Course.enrolled:type_name -> ag.Enrollment.UserStatus
-	15, // 8: ag.Course.enrollments:type_name -> ag.Enrollment
-	21, // 9: ag.Course.assignments:type_name -> ag.Assignment
-	10, // 10: ag.Course.groups:type_name -> ag.Group
-	12, // 11: ag.Courses.courses:type_name -> ag.Course
-	1,  // 12: ag.Repository.repoType:type_name -> ag.Repository.Type
-	7,  // 13: ag.Enrollment.user:type_name -> ag.User
-	12, // 14: ag.Enrollment.course:type_name -> ag.Course
-	10, // 15: ag.Enrollment.group:type_name -> ag.Group
-	2,  // 16: ag.Enrollment.status:type_name -> ag.Enrollment.UserStatus
-	3,  // 17: ag.Enrollment.state:type_name -> ag.Enrollment.DisplayState
-	16, // 18: ag.Enrollment.usedSlipDays:type_name -> ag.UsedSlipDays
-	15, // 19: ag.Enrollments.enrollments:type_name -> ag.Enrollment
-	21, // 20: ag.SubmissionLink.assignment:type_name -> ag.Assignment
-	23, // 21: ag.SubmissionLink.submission:type_name -> ag.Submission
-	15, // 22: ag.EnrollmentLink.enrollment:type_name -> ag.Enrollment
-	18, // 23: ag.EnrollmentLink.submissions:type_name -> ag.SubmissionLink
-	12, // 24: ag.CourseSubmissions.course:type_name -> ag.Course
-	19, // 25: ag.CourseSubmissions.links:type_name -> ag.EnrollmentLink
-	23, // 26: ag.Assignment.submissions:type_name -> ag.Submission
-	25, // 27: ag.Assignment.gradingBenchmarks:type_name -> ag.GradingBenchmark
-	21, // 28: ag.Assignments.assignments:type_name -> ag.Assignment
-	4,  // 29: ag.Submission.status:type_name -> ag.Submission.Status
-	28, // 30: ag.Submission.reviews:type_name -> ag.Review
-	57, // 31: ag.Submission.BuildInfo:type_name -> score.BuildInfo
-	58, // 32: ag.Submission.Scores:type_name -> score.Score
-	23, // 33: ag.Submissions.submissions:type_name -> ag.Submission
-	27, // 34: ag.GradingBenchmark.criteria:type_name -> ag.GradingCriterion
-	25, // 35: ag.Benchmarks.benchmarks:type_name -> ag.GradingBenchmark
-	5,  // 36: ag.GradingCriterion.grade:type_name -> ag.GradingCriterion.Grade
-	25, // 37: ag.Review.gradingBenchmarks:type_name -> ag.GradingBenchmark
-	7,  // 38: ag.Reviewers.reviewers:type_name -> ag.User
-	28, // 39: ag.ReviewRequest.review:type_name -> ag.Review
-	37, // 40: ag.Organizations.organizations:type_name -> ag.Organization
-	2,  // 41: ag.EnrollmentRequest.statuses:type_name -> ag.Enrollment.UserStatus
-	2,  // 42: ag.EnrollmentStatusRequest.statuses:type_name -> ag.Enrollment.UserStatus
-	4,  // 43: ag.UpdateSubmissionRequest.status:type_name -> ag.Submission.Status
-	1,  // 44: ag.URLRequest.repoTypes:type_name -> ag.Repository.Type
-	56, // 45: ag.Repositories.URLs:type_name -> ag.Repositories.URLsEntry
-	6,  // 46: ag.SubmissionsForCourseRequest.type:type_name -> ag.SubmissionsForCourseRequest.Type
-	55, // 47: ag.AutograderService.GetUser:input_type -> ag.Void
-	55, // 48: ag.AutograderService.GetUsers:input_type -> ag.Void
-	53, // 49: ag.AutograderService.GetUserByCourse:input_type -> ag.CourseUserRequest
-	7,  // 50: ag.AutograderService.UpdateUser:input_type -> ag.User
-	55, // 51: ag.AutograderService.IsAuthorizedTeacher:input_type -> ag.Void
-	33, // 52: ag.AutograderService.GetGroup:input_type -> ag.GetGroupRequest
-	34, // 53: ag.AutograderService.GetGroupByUserAndCourse:input_type -> ag.GroupRequest
-	31, // 54: ag.AutograderService.GetGroupsByCourse:input_type -> ag.CourseRequest
-	10, // 55: ag.AutograderService.CreateGroup:input_type -> ag.Group
-	10, // 56: ag.AutograderService.UpdateGroup:input_type -> ag.Group
-	34, // 57: ag.AutograderService.DeleteGroup:input_type -> ag.GroupRequest
-	31, // 58: ag.AutograderService.GetCourse:input_type -> ag.CourseRequest
-	55, // 59: ag.AutograderService.GetCourses:input_type -> ag.Void
-	40, // 60: ag.AutograderService.GetCoursesByUser:input_type -> ag.EnrollmentStatusRequest
-	12, // 61: ag.AutograderService.CreateCourse:input_type -> ag.Course
-	12, // 62: ag.AutograderService.UpdateCourse:input_type -> ag.Course
-	15, // 63: ag.AutograderService.UpdateCourseVisibility:input_type -> ag.Enrollment
-	31, // 64: ag.AutograderService.GetAssignments:input_type -> ag.CourseRequest
-	31, // 65: ag.AutograderService.UpdateAssignments:input_type -> ag.CourseRequest
-	40, // 66: ag.AutograderService.GetEnrollmentsByUser:input_type -> ag.EnrollmentStatusRequest
-	39, // 67: ag.AutograderService.GetEnrollmentsByCourse:input_type -> ag.EnrollmentRequest
-	15, // 68: ag.AutograderService.CreateEnrollment:input_type -> ag.Enrollment
-	15, // 69: ag.AutograderService.UpdateEnrollment:input_type -> ag.Enrollment
-	31, // 70: ag.AutograderService.UpdateEnrollments:input_type -> ag.CourseRequest
-	41, // 71: ag.AutograderService.GetSubmissions:input_type -> ag.SubmissionRequest
-	51, // 72: ag.AutograderService.GetSubmissionsByCourse:input_type -> ag.SubmissionsForCourseRequest
-	42, // 73: ag.AutograderService.UpdateSubmission:input_type -> ag.UpdateSubmissionRequest
-	43, // 74: ag.AutograderService.UpdateSubmissions:input_type -> ag.UpdateSubmissionsRequest
-	52, // 75: ag.AutograderService.RebuildSubmission:input_type -> ag.RebuildRequest
-	54, // 76: ag.AutograderService.RebuildSubmissions:input_type -> ag.AssignmentRequest
-	25, // 77: ag.AutograderService.CreateBenchmark:input_type -> ag.GradingBenchmark
-	25, // 78: ag.AutograderService.UpdateBenchmark:input_type -> ag.GradingBenchmark
-	25, // 79: ag.AutograderService.DeleteBenchmark:input_type -> ag.GradingBenchmark
-	27, // 80: ag.AutograderService.CreateCriterion:input_type -> ag.GradingCriterion
-	27, // 81: ag.AutograderService.UpdateCriterion:input_type -> ag.GradingCriterion
-	27, // 82: ag.AutograderService.DeleteCriterion:input_type -> ag.GradingCriterion
-	30, // 83: ag.AutograderService.CreateReview:input_type -> ag.ReviewRequest
-	30, // 84: ag.AutograderService.UpdateReview:input_type -> ag.ReviewRequest
-	44, // 85: ag.AutograderService.GetReviewers:input_type -> ag.SubmissionReviewersRequest
-	55, // 86: ag.AutograderService.GetProviders:input_type -> ag.Void
-	36, // 87: ag.AutograderService.GetOrganization:input_type -> ag.OrgRequest
-	46, // 88: ag.AutograderService.GetRepositories:input_type -> ag.URLRequest
-	47, // 89: ag.AutograderService.IsEmptyRepo:input_type -> ag.RepositoryRequest
-	7,  // 90: ag.AutograderService.GetUser:output_type -> ag.User
-	8,  // 91: ag.AutograderService.GetUsers:output_type -> ag.Users
-	7,  // 92: ag.AutograderService.GetUserByCourse:output_type -> ag.User
-	55, // 93: ag.AutograderService.UpdateUser:output_type -> ag.Void
-	49, // 94: ag.AutograderService.IsAuthorizedTeacher:output_type -> ag.AuthorizationResponse
-	10, // 95: ag.AutograderService.GetGroup:output_type -> ag.Group
-	10, // 96: ag.AutograderService.GetGroupByUserAndCourse:output_type -> ag.Group
-	11, // 97: ag.AutograderService.GetGroupsByCourse:output_type -> ag.Groups
-	10, // 98: ag.AutograderService.CreateGroup:output_type -> ag.Group
-	55, // 99: ag.AutograderService.UpdateGroup:output_type -> ag.Void
-	55, // 100: ag.AutograderService.DeleteGroup:output_type -> ag.Void
-	12, // 101: ag.AutograderService.GetCourse:output_type -> ag.Course
-	13, // 102: ag.AutograderService.GetCourses:output_type -> ag.Courses
-	13, // 103: ag.AutograderService.GetCoursesByUser:output_type -> ag.Courses
-	12, // 104: ag.AutograderService.CreateCourse:output_type -> ag.Course
-	55, // 105: ag.AutograderService.UpdateCourse:output_type -> ag.Void
-	55, // 106: ag.AutograderService.UpdateCourseVisibility:output_type -> ag.Void
-	22, // 107: ag.AutograderService.GetAssignments:output_type -> ag.Assignments
-	55, // 108: ag.AutograderService.UpdateAssignments:output_type -> ag.Void
-	17, // 109: ag.AutograderService.GetEnrollmentsByUser:output_type -> ag.Enrollments
-	17, // 110: ag.AutograderService.GetEnrollmentsByCourse:output_type -> ag.Enrollments
-	55, // 111: ag.AutograderService.CreateEnrollment:output_type -> ag.Void
-	55, // 112: ag.AutograderService.UpdateEnrollment:output_type -> ag.Void
-	55, // 113: ag.AutograderService.UpdateEnrollments:output_type -> ag.Void
-	24, // 114: ag.AutograderService.GetSubmissions:output_type -> ag.Submissions
-	20, // 115: ag.AutograderService.GetSubmissionsByCourse:output_type -> ag.CourseSubmissions
-	55, // 116: ag.AutograderService.UpdateSubmission:output_type -> ag.Void
-	55, // 117: ag.AutograderService.UpdateSubmissions:output_type -> ag.Void
-	23, // 118: ag.AutograderService.RebuildSubmission:output_type -> ag.Submission
-	55, // 119: ag.AutograderService.RebuildSubmissions:output_type -> ag.Void
-	25, // 120: ag.AutograderService.CreateBenchmark:output_type -> ag.GradingBenchmark
-	55, // 121: ag.AutograderService.UpdateBenchmark:output_type -> ag.Void
-	55, // 122: ag.AutograderService.DeleteBenchmark:output_type -> ag.Void
-	27, // 123: ag.AutograderService.CreateCriterion:output_type -> ag.GradingCriterion
-	55, // 124: ag.AutograderService.UpdateCriterion:output_type -> ag.Void
-	55, // 125: ag.AutograderService.DeleteCriterion:output_type -> ag.Void
-	28, // 126: ag.AutograderService.CreateReview:output_type -> ag.Review
-	28, // 127: ag.AutograderService.UpdateReview:output_type -> ag.Review
-	29, // 128: ag.AutograderService.GetReviewers:output_type -> ag.Reviewers
-	45, // 129: ag.AutograderService.GetProviders:output_type -> ag.Providers
-	37, // 130: ag.AutograderService.GetOrganization:output_type -> ag.Organization
-	48, // 131: ag.AutograderService.GetRepositories:output_type -> ag.Repositories
-	55, // 132: ag.AutograderService.IsEmptyRepo:output_type -> ag.Void
-	90, // [90:133] is the sub-list for method output_type
-	47, // [47:90] is the sub-list for method input_type
-	47, // [47:47] is the sub-list for extension type_name
-	47, // [47:47] is the sub-list for extension extendee
-	0,  // [0:47] is the sub-list for field type_name
+	9,   // 0: ag.User.remoteIdentities:type_name -> ag.RemoteIdentity
+	15,  // 1: ag.User.enrollments:type_name -> ag.Enrollment
+	7,   // 2: ag.Users.users:type_name -> ag.User
+	0,   // 3: ag.Group.status:type_name -> ag.Group.GroupStatus
+	7,   // 4: ag.Group.users:type_name -> ag.User
+	15,  // 5: ag.Group.enrollments:type_name -> ag.Enrollment
+	10,  // 6: ag.Groups.groups:type_name -> ag.Group
+	2,   // 7: ag.Course.enrolled:type_name -> ag.Enrollment.UserStatus
+	15,  // 8: ag.Course.enrollments:type_name -> ag.Enrollment
+	21,  // 9: ag.Course.assignments:type_name -> ag.Assignment
+	10,  // 10: ag.Course.groups:type_name -> ag.Group
+	12,  // 11: ag.Courses.courses:type_name -> ag.Course
+	1,   // 12: ag.Repository.repoType:type_name -> ag.Repository.Type
+	7,   // 13: ag.Enrollment.user:type_name -> ag.User
+	12,  // 14: ag.Enrollment.course:type_name -> ag.Course
+	10,  // 15: ag.Enrollment.group:type_name -> ag.Group
+	2,   // 16: ag.Enrollment.status:type_name -> ag.Enrollment.UserStatus
+	3,   // 17: ag.Enrollment.state:type_name -> ag.Enrollment.DisplayState
+	16,  // 18: ag.Enrollment.usedSlipDays:type_name -> ag.UsedSlipDays
+	15,  // 19: ag.Enrollments.enrollments:type_name -> ag.Enrollment
+	21,  // 20: ag.SubmissionLink.assignment:type_name -> ag.Assignment
+	27,  // 21: ag.SubmissionLink.submission:type_name -> ag.Submission
+	15,  // 22: ag.EnrollmentLink.enrollment:type_name -> ag.Enrollment
+	18,  // 23: ag.EnrollmentLink.submissions:type_name -> ag.SubmissionLink
+	12,  // 24: ag.CourseSubmissions.course:type_name -> ag.Course
+	19,  // 25: ag.CourseSubmissions.links:type_name -> ag.EnrollmentLink
+	27,  // 26: ag.Assignment.submissions:type_name -> ag.Submission
+	38,  // 27: ag.Assignment.gradingBenchmarks:type_name -> ag.GradingBenchmark
+	22,  // 28: ag.Assignment.tests:type_name -> ag.TestConfig
+	80,  // 29: ag.Assignment.releaseDate:type_name -> google.protobuf.Timestamp
+	80,  // 30: ag.Assignment.closeDate:type_name -> google.protobuf.Timestamp
+	80,  // 31: ag.Assignment.deadlineTime:type_name -> google.protobuf.Timestamp
+	25,  // 32: ag.Assignment.tasks:type_name -> ag.Task
+	23,  // 33: ag.Assignment.analysisChecks:type_name -> ag.AnalysisCheck
+	21,  // 34: ag.Assignments.assignments:type_name -> ag.Assignment
+	4,   // 35: ag.Submission.status:type_name -> ag.Submission.Status
+	41,  // 36: ag.Submission.reviews:type_name -> ag.Review
+	81,  // 37: ag.Submission.BuildInfo:type_name -> score.BuildInfo
+	82,  // 38: ag.Submission.Scores:type_name -> score.Score
+	29,  // 39: ag.Submission.annotations:type_name -> ag.Annotation
+	28,  // 40: ag.Submission.matrixResults:type_name -> ag.MatrixResult
+	27,  // 41: ag.Submissions.submissions:type_name -> ag.Submission
+	31,  // 42: ag.Artifacts.artifacts:type_name -> ag.Artifact
+	36,  // 43: ag.CourseGrade.modules:type_name -> ag.ModuleGrade
+	40,  // 44: ag.GradingBenchmark.criteria:type_name -> ag.GradingCriterion
+	38,  // 45: ag.Benchmarks.benchmarks:type_name -> ag.GradingBenchmark
+	5,   // 46: ag.GradingCriterion.grade:type_name -> ag.GradingCriterion.Grade
+	38,  // 47: ag.Review.gradingBenchmarks:type_name -> ag.GradingBenchmark
+	7,   // 48: ag.Reviewers.reviewers:type_name -> ag.User
+	41,  // 49: ag.ReviewRequest.review:type_name -> ag.Review
+	54,  // 50: ag.Organizations.organizations:type_name -> ag.Organization
+	2,   // 51: ag.EnrollmentRequest.statuses:type_name -> ag.Enrollment.UserStatus
+	2,   // 52: ag.EnrollmentStatusRequest.statuses:type_name -> ag.Enrollment.UserStatus
+	4,   // 53: ag.UpdateSubmissionRequest.status:type_name -> ag.Submission.Status
+	1,   // 54: ag.URLRequest.repoTypes:type_name -> ag.Repository.Type
+	79,  // 55: ag.Repositories.URLs:type_name -> ag.Repositories.URLsEntry
+	6,   // 56: ag.SubmissionsForCourseRequest.type:type_name -> ag.SubmissionsForCourseRequest.Type
+	33,  // 57: ag.DeadlineExtensionRequest.extension:type_name -> ag.DeadlineExtension
+	21,  // 58: ag.CourseRepositoryValidation.assignments:type_name -> ag.Assignment
+	78,  // 59: ag.AutograderService.GetUser:input_type -> ag.Void
+	78,  // 60: ag.AutograderService.GetUsers:input_type -> ag.Void
+	71,  // 61: ag.AutograderService.GetUserByCourse:input_type -> ag.CourseUserRequest
+	7,   // 62: ag.AutograderService.UpdateUser:input_type -> ag.User
+	78,  // 63: ag.AutograderService.IsAuthorizedTeacher:input_type -> ag.Void
+	50,  // 64: ag.AutograderService.GetGroup:input_type -> ag.GetGroupRequest
+	51,  // 65: ag.AutograderService.GetGroupByUserAndCourse:input_type -> ag.GroupRequest
+	44,  // 66: ag.AutograderService.GetGroupsByCourse:input_type -> ag.CourseRequest
+	10,  // 67: ag.AutograderService.CreateGroup:input_type -> ag.Group
+	10,  // 68: ag.AutograderService.UpdateGroup:input_type -> ag.Group
+	51,  // 69: ag.AutograderService.DeleteGroup:input_type -> ag.GroupRequest
+	44,  // 70: ag.AutograderService.GetCourse:input_type -> ag.CourseRequest
+	78,  // 71: ag.AutograderService.GetCourses:input_type -> ag.Void
+	57,  // 72: ag.AutograderService.GetCoursesByUser:input_type -> ag.EnrollmentStatusRequest
+	12,  // 73: ag.AutograderService.CreateCourse:input_type -> ag.Course
+	12,  // 74: ag.AutograderService.UpdateCourse:input_type -> ag.Course
+	15,  // 75: ag.AutograderService.UpdateCourseVisibility:input_type -> ag.Enrollment
+	34,  // 76: ag.AutograderService.UpdateCourseSecret:input_type -> ag.CourseSecret
+	44,  // 77: ag.AutograderService.GetAssignments:input_type -> ag.CourseRequest
+	44,  // 78: ag.AutograderService.UpdateAssignments:input_type -> ag.CourseRequest
+	44,  // 79: ag.AutograderService.ValidateCourseRepository:input_type -> ag.CourseRequest
+	76,  // 80: ag.AutograderService.ExportAssignments:input_type -> ag.AssignmentArchiveRequest
+	77,  // 81: ag.AutograderService.ImportAssignments:input_type -> ag.AssignmentArchive
+	57,  // 82: ag.AutograderService.GetEnrollmentsByUser:input_type -> ag.EnrollmentStatusRequest
+	56,  // 83: ag.AutograderService.GetEnrollmentsByCourse:input_type -> ag.EnrollmentRequest
+	15,  // 84: ag.AutograderService.CreateEnrollment:input_type -> ag.Enrollment
+	15,  // 85: ag.AutograderService.UpdateEnrollment:input_type -> ag.Enrollment
+	44,  // 86: ag.AutograderService.UpdateEnrollments:input_type -> ag.CourseRequest
+	58,  // 87: ag.AutograderService.GetSubmissions:input_type -> ag.SubmissionRequest
+	69,  // 88: ag.AutograderService.GetSubmissionsByCourse:input_type -> ag.SubmissionsForCourseRequest
+	59,  // 89: ag.AutograderService.UpdateSubmission:input_type -> ag.UpdateSubmissionRequest
+	60,  // 90: ag.AutograderService.UpdateSubmissions:input_type -> ag.UpdateSubmissionsRequest
+	70,  // 91: ag.AutograderService.RebuildSubmission:input_type -> ag.RebuildRequest
+	72,  // 92: ag.AutograderService.RebuildSubmissions:input_type -> ag.AssignmentRequest
+	72,  // 93: ag.AutograderService.RegradeAssignment:input_type -> ag.AssignmentRequest
+	72,  // 94: ag.AutograderService.GetRegradeProgress:input_type -> ag.AssignmentRequest
+	72,  // 95: ag.AutograderService.RerunSubmission:input_type -> ag.AssignmentRequest
+	44,  // 96: ag.AutograderService.GetSubmissionQueue:input_type -> ag.CourseRequest
+	45,  // 97: ag.AutograderService.StreamBuildLog:input_type -> ag.BuildLogRequest
+	61,  // 98: ag.AutograderService.GetArtifacts:input_type -> ag.ArtifactRequest
+	61,  // 99: ag.AutograderService.GetArtifact:input_type -> ag.ArtifactRequest
+	73,  // 100: ag.AutograderService.GrantDeadlineExtension:input_type -> ag.DeadlineExtensionRequest
+	74,  // 101: ag.AutograderService.GetCourseGrade:input_type -> ag.CourseGradeRequest
+	38,  // 102: ag.AutograderService.CreateBenchmark:input_type -> ag.GradingBenchmark
+	38,  // 103: ag.AutograderService.UpdateBenchmark:input_type -> ag.GradingBenchmark
+	38,  // 104: ag.AutograderService.DeleteBenchmark:input_type -> ag.GradingBenchmark
+	40,  // 105: ag.AutograderService.CreateCriterion:input_type -> ag.GradingCriterion
+	40,  // 106: ag.AutograderService.UpdateCriterion:input_type -> ag.GradingCriterion
+	40,  // 107: ag.AutograderService.DeleteCriterion:input_type -> ag.GradingCriterion
+	43,  // 108: ag.AutograderService.CreateReview:input_type -> ag.ReviewRequest
+	43,  // 109: ag.AutograderService.UpdateReview:input_type -> ag.ReviewRequest
+	62,  // 110: ag.AutograderService.GetReviewers:input_type -> ag.SubmissionReviewersRequest
+	78,  // 111: ag.AutograderService.GetProviders:input_type -> ag.Void
+	53,  // 112: ag.AutograderService.GetOrganization:input_type -> ag.OrgRequest
+	64,  // 113: ag.AutograderService.GetRepositories:input_type -> ag.URLRequest
+	65,  // 114: ag.AutograderService.IsEmptyRepo:input_type -> ag.RepositoryRequest
+	7,   // 115: ag.AutograderService.GetUser:output_type -> ag.User
+	8,   // 116: ag.AutograderService.GetUsers:output_type -> ag.Users
+	7,   // 117: ag.AutograderService.GetUserByCourse:output_type -> ag.User
+	78,  // 118: ag.AutograderService.UpdateUser:output_type -> ag.Void
+	67,  // 119: ag.AutograderService.IsAuthorizedTeacher:output_type -> ag.AuthorizationResponse
+	10,  // 120: ag.AutograderService.GetGroup:output_type -> ag.Group
+	10,  // 121: ag.AutograderService.GetGroupByUserAndCourse:output_type -> ag.Group
+	11,  // 122: ag.AutograderService.GetGroupsByCourse:output_type -> ag.Groups
+	10,  // 123: ag.AutograderService.CreateGroup:output_type -> ag.Group
+	78,  // 124: ag.AutograderService.UpdateGroup:output_type -> ag.Void
+	78,  // 125: ag.AutograderService.DeleteGroup:output_type -> ag.Void
+	12,  // 126: ag.AutograderService.GetCourse:output_type -> ag.Course
+	13,  // 127: ag.AutograderService.GetCourses:output_type -> ag.Courses
+	13,  // 128: ag.AutograderService.GetCoursesByUser:output_type -> ag.Courses
+	12,  // 129: ag.AutograderService.CreateCourse:output_type -> ag.Course
+	78,  // 130: ag.AutograderService.UpdateCourse:output_type -> ag.Void
+	78,  // 131: ag.AutograderService.UpdateCourseVisibility:output_type -> ag.Void
+	78,  // 132: ag.AutograderService.UpdateCourseSecret:output_type -> ag.Void
+	24,  // 133: ag.AutograderService.GetAssignments:output_type -> ag.Assignments
+	78,  // 134: ag.AutograderService.UpdateAssignments:output_type -> ag.Void
+	75,  // 135: ag.AutograderService.ValidateCourseRepository:output_type -> ag.CourseRepositoryValidation
+	77,  // 136: ag.AutograderService.ExportAssignments:output_type -> ag.AssignmentArchive
+	24,  // 137: ag.AutograderService.ImportAssignments:output_type -> ag.Assignments
+	17,  // 138: ag.AutograderService.GetEnrollmentsByUser:output_type -> ag.Enrollments
+	17,  // 139: ag.AutograderService.GetEnrollmentsByCourse:output_type -> ag.Enrollments
+	78,  // 140: ag.AutograderService.CreateEnrollment:output_type -> ag.Void
+	78,  // 141: ag.AutograderService.UpdateEnrollment:output_type -> ag.Void
+	78,  // 142: ag.AutograderService.UpdateEnrollments:output_type -> ag.Void
+	30,  // 143: ag.AutograderService.GetSubmissions:output_type -> ag.Submissions
+	20,  // 144: ag.AutograderService.GetSubmissionsByCourse:output_type -> ag.CourseSubmissions
+	78,  // 145: ag.AutograderService.UpdateSubmission:output_type -> ag.Void
+	78,  // 146: ag.AutograderService.UpdateSubmissions:output_type -> ag.Void
+	27,  // 147: ag.AutograderService.RebuildSubmission:output_type -> ag.Submission
+	78,  // 148: ag.AutograderService.RebuildSubmissions:output_type -> ag.Void
+	47,  // 149: ag.AutograderService.RegradeAssignment:output_type -> ag.RegradeProgress
+	47,  // 150: ag.AutograderService.GetRegradeProgress:output_type -> ag.RegradeProgress
+	27,  // 151: ag.AutograderService.RerunSubmission:output_type -> ag.Submission
+	48,  // 152: ag.AutograderService.GetSubmissionQueue:output_type -> ag.SubmissionQueue
+	46,  // 153: ag.AutograderService.StreamBuildLog:output_type -> ag.BuildLogChunk
+	32,  // 154: ag.AutograderService.GetArtifacts:output_type -> ag.Artifacts
+	31,  // 155: ag.AutograderService.GetArtifact:output_type -> ag.Artifact
+	33,  // 156: ag.AutograderService.GrantDeadlineExtension:output_type -> ag.DeadlineExtension
+	37,  // 157: ag.AutograderService.GetCourseGrade:output_type -> ag.CourseGrade
+	38,  // 158: ag.AutograderService.CreateBenchmark:output_type -> ag.GradingBenchmark
+	78,  // 159: ag.AutograderService.UpdateBenchmark:output_type -> ag.Void
+	78,  // 160: ag.AutograderService.DeleteBenchmark:output_type -> ag.Void
+	40,  // 161: ag.AutograderService.CreateCriterion:output_type -> ag.GradingCriterion
+	78,  // 162: ag.AutograderService.UpdateCriterion:output_type -> ag.Void
+	78,  // 163: ag.AutograderService.DeleteCriterion:output_type -> ag.Void
+	41,  // 164: ag.AutograderService.CreateReview:output_type -> ag.Review
+	41,  // 165: ag.AutograderService.UpdateReview:output_type -> ag.Review
+	42,  // 166: ag.AutograderService.GetReviewers:output_type -> ag.Reviewers
+	63,  // 167: ag.AutograderService.GetProviders:output_type -> ag.Providers
+	54,  // 168: ag.AutograderService.GetOrganization:output_type -> ag.Organization
+	66,  // 169: ag.AutograderService.GetRepositories:output_type -> ag.Repositories
+	78,  // 170: ag.AutograderService.IsEmptyRepo:output_type -> ag.Void
+	115, // [115:171] is the sub-list for method output_type
+	59,  // [59:115] is the sub-list for method input_type
+	59,  // [59:59] is the sub-list for extension type_name
+	59,  // [59:59] is the sub-list for extension extendee
+	0,   // [0:59] is the sub-list for field type_name
 }
 
 func init() { file_ag_ag_proto_init() }
@@ -4580,8 +7236,116 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Users); i {
+		file_ag_ag_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Users); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoteIdentity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Group); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Groups); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Course); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Courses); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Repository); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Enrollment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UsedSlipDays); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Enrollments); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4592,8 +7356,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoteIdentity); i {
+		file_ag_ag_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmissionLink); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4604,8 +7368,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Group); i {
+		file_ag_ag_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnrollmentLink); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4616,8 +7380,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Groups); i {
+		file_ag_ag_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CourseSubmissions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4628,8 +7392,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Course); i {
+		file_ag_ag_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Assignment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4640,8 +7404,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Courses); i {
+		file_ag_ag_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4652,8 +7416,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Repository); i {
+		file_ag_ag_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnalysisCheck); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4664,8 +7428,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Enrollment); i {
+		file_ag_ag_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Assignments); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4676,8 +7440,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UsedSlipDays); i {
+		file_ag_ag_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Task); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4688,8 +7452,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Enrollments); i {
+		file_ag_ag_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Issue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4700,8 +7464,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubmissionLink); i {
+		file_ag_ag_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Submission); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4712,8 +7476,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnrollmentLink); i {
+		file_ag_ag_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatrixResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4724,8 +7488,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CourseSubmissions); i {
+		file_ag_ag_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Annotation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4736,8 +7500,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Assignment); i {
+		file_ag_ag_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Submissions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4748,8 +7512,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Assignments); i {
+		file_ag_ag_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Artifact); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4760,8 +7524,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Submission); i {
+		file_ag_ag_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Artifacts); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4772,8 +7536,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Submissions); i {
+		file_ag_ag_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeadlineExtension); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4784,7 +7548,55 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CourseSecret); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CourseModule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModuleGrade); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CourseGrade); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GradingBenchmark); i {
 			case 0:
 				return &v.state
@@ -4796,7 +7608,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Benchmarks); i {
 			case 0:
 				return &v.state
@@ -4808,7 +7620,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GradingCriterion); i {
 			case 0:
 				return &v.state
@@ -4820,7 +7632,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Review); i {
 			case 0:
 				return &v.state
@@ -4832,7 +7644,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Reviewers); i {
 			case 0:
 				return &v.state
@@ -4844,7 +7656,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReviewRequest); i {
 			case 0:
 				return &v.state
@@ -4856,7 +7668,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CourseRequest); i {
 			case 0:
 				return &v.state
@@ -4868,7 +7680,55 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildLogRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildLogChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegradeProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmissionQueue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserRequest); i {
 			case 0:
 				return &v.state
@@ -4880,7 +7740,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetGroupRequest); i {
 			case 0:
 				return &v.state
@@ -4892,7 +7752,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GroupRequest); i {
 			case 0:
 				return &v.state
@@ -4904,7 +7764,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Provider); i {
 			case 0:
 				return &v.state
@@ -4916,7 +7776,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OrgRequest); i {
 			case 0:
 				return &v.state
@@ -4928,7 +7788,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Organization); i {
 			case 0:
 				return &v.state
@@ -4940,7 +7800,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Organizations); i {
 			case 0:
 				return &v.state
@@ -4952,7 +7812,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EnrollmentRequest); i {
 			case 0:
 				return &v.state
@@ -4964,7 +7824,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EnrollmentStatusRequest); i {
 			case 0:
 				return &v.state
@@ -4976,7 +7836,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubmissionRequest); i {
 			case 0:
 				return &v.state
@@ -4988,7 +7848,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateSubmissionRequest); i {
 			case 0:
 				return &v.state
@@ -5000,7 +7860,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateSubmissionsRequest); i {
 			case 0:
 				return &v.state
@@ -5012,7 +7872,19 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArtifactRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubmissionReviewersRequest); i {
 			case 0:
 				return &v.state
@@ -5024,7 +7896,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Providers); i {
 			case 0:
 				return &v.state
@@ -5036,7 +7908,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*URLRequest); i {
 			case 0:
 				return &v.state
@@ -5048,7 +7920,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RepositoryRequest); i {
 			case 0:
 				return &v.state
@@ -5060,7 +7932,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Repositories); i {
 			case 0:
 				return &v.state
@@ -5072,7 +7944,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AuthorizationResponse); i {
 			case 0:
 				return &v.state
@@ -5084,7 +7956,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Status); i {
 			case 0:
 				return &v.state
@@ -5096,7 +7968,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubmissionsForCourseRequest); i {
 			case 0:
 				return &v.state
@@ -5108,7 +7980,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RebuildRequest); i {
 			case 0:
 				return &v.state
@@ -5120,7 +7992,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CourseUserRequest); i {
 			case 0:
 				return &v.state
@@ -5132,7 +8004,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AssignmentRequest); i {
 			case 0:
 				return &v.state
@@ -5144,7 +8016,67 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeadlineExtensionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CourseGradeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CourseRepositoryValidation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssignmentArchiveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssignmentArchive); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Void); i {
 			case 0:
 				return &v.state
@@ -5163,7 +8095,7 @@ func file_ag_ag_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ag_ag_proto_rawDesc,
 			NumEnums:      7,
-			NumMessages:   50,
+			NumMessages:   73,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
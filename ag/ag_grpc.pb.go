@@ -35,8 +35,12 @@ type AutograderServiceClient interface {
 	CreateCourse(ctx context.Context, in *Course, opts ...grpc.CallOption) (*Course, error)
 	UpdateCourse(ctx context.Context, in *Course, opts ...grpc.CallOption) (*Void, error)
 	UpdateCourseVisibility(ctx context.Context, in *Enrollment, opts ...grpc.CallOption) (*Void, error)
+	UpdateCourseSecret(ctx context.Context, in *CourseSecret, opts ...grpc.CallOption) (*Void, error)
 	GetAssignments(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Assignments, error)
 	UpdateAssignments(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Void, error)
+	ValidateCourseRepository(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*CourseRepositoryValidation, error)
+	ExportAssignments(ctx context.Context, in *AssignmentArchiveRequest, opts ...grpc.CallOption) (*AssignmentArchive, error)
+	ImportAssignments(ctx context.Context, in *AssignmentArchive, opts ...grpc.CallOption) (*Assignments, error)
 	GetEnrollmentsByUser(ctx context.Context, in *EnrollmentStatusRequest, opts ...grpc.CallOption) (*Enrollments, error)
 	GetEnrollmentsByCourse(ctx context.Context, in *EnrollmentRequest, opts ...grpc.CallOption) (*Enrollments, error)
 	CreateEnrollment(ctx context.Context, in *Enrollment, opts ...grpc.CallOption) (*Void, error)
@@ -50,6 +54,22 @@ type AutograderServiceClient interface {
 	UpdateSubmissions(ctx context.Context, in *UpdateSubmissionsRequest, opts ...grpc.CallOption) (*Void, error)
 	RebuildSubmission(ctx context.Context, in *RebuildRequest, opts ...grpc.CallOption) (*Submission, error)
 	RebuildSubmissions(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*Void, error)
+	// Start re-running the tests of the latest submission of every student or group for an assignment.
+	RegradeAssignment(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*RegradeProgress, error)
+	// Get the progress of the latest regrade of an assignment.
+	GetRegradeProgress(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*RegradeProgress, error)
+	// Re-run the tests of the latest commit of the user's or the user's group's submission for an assignment.
+	RerunSubmission(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*Submission, error)
+	// Get the number of queued test runs in a course and the position of the user's first test run.
+	GetSubmissionQueue(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*SubmissionQueue, error)
+	// Stream the output of the test run in progress for an assignment until the run completes.
+	StreamBuildLog(ctx context.Context, in *BuildLogRequest, opts ...grpc.CallOption) (AutograderService_StreamBuildLogClient, error)
+	// Get the names and sizes of the artifacts written by the tests of a submission.
+	GetArtifacts(ctx context.Context, in *ArtifactRequest, opts ...grpc.CallOption) (*Artifacts, error)
+	// Download an artifact written by the tests of a submission.
+	GetArtifact(ctx context.Context, in *ArtifactRequest, opts ...grpc.CallOption) (*Artifact, error)
+	GrantDeadlineExtension(ctx context.Context, in *DeadlineExtensionRequest, opts ...grpc.CallOption) (*DeadlineExtension, error)
+	GetCourseGrade(ctx context.Context, in *CourseGradeRequest, opts ...grpc.CallOption) (*CourseGrade, error)
 	CreateBenchmark(ctx context.Context, in *GradingBenchmark, opts ...grpc.CallOption) (*GradingBenchmark, error)
 	UpdateBenchmark(ctx context.Context, in *GradingBenchmark, opts ...grpc.CallOption) (*Void, error)
 	DeleteBenchmark(ctx context.Context, in *GradingBenchmark, opts ...grpc.CallOption) (*Void, error)
@@ -226,6 +246,15 @@ func (c *autograderServiceClient) UpdateCourseVisibility(ctx context.Context, in
 	return out, nil
 }
 
+func (c *autograderServiceClient) UpdateCourseSecret(ctx context.Context, in *CourseSecret, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/UpdateCourseSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *autograderServiceClient) GetAssignments(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Assignments, error) {
 	out := new(Assignments)
 	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetAssignments", in, out, opts...)
@@ -244,6 +273,33 @@ func (c *autograderServiceClient) UpdateAssignments(ctx context.Context, in *Cou
 	return out, nil
 }
 
+func (c *autograderServiceClient) ValidateCourseRepository(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*CourseRepositoryValidation, error) {
+	out := new(CourseRepositoryValidation)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/ValidateCourseRepository", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) ExportAssignments(ctx context.Context, in *AssignmentArchiveRequest, opts ...grpc.CallOption) (*AssignmentArchive, error) {
+	out := new(AssignmentArchive)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/ExportAssignments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) ImportAssignments(ctx context.Context, in *AssignmentArchive, opts ...grpc.CallOption) (*Assignments, error) {
+	out := new(Assignments)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/ImportAssignments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *autograderServiceClient) GetEnrollmentsByUser(ctx context.Context, in *EnrollmentStatusRequest, opts ...grpc.CallOption) (*Enrollments, error) {
 	out := new(Enrollments)
 	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetEnrollmentsByUser", in, out, opts...)
@@ -343,6 +399,110 @@ func (c *autograderServiceClient) RebuildSubmissions(ctx context.Context, in *As
 	return out, nil
 }
 
+func (c *autograderServiceClient) RegradeAssignment(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*RegradeProgress, error) {
+	out := new(RegradeProgress)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/RegradeAssignment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetRegradeProgress(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*RegradeProgress, error) {
+	out := new(RegradeProgress)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetRegradeProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) RerunSubmission(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*Submission, error) {
+	out := new(Submission)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/RerunSubmission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetSubmissionQueue(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*SubmissionQueue, error) {
+	out := new(SubmissionQueue)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetSubmissionQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) StreamBuildLog(ctx context.Context, in *BuildLogRequest, opts ...grpc.CallOption) (AutograderService_StreamBuildLogClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AutograderService_ServiceDesc.Streams[0], "/ag.AutograderService/StreamBuildLog", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &autograderServiceStreamBuildLogClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AutograderService_StreamBuildLogClient interface {
+	Recv() (*BuildLogChunk, error)
+	grpc.ClientStream
+}
+
+type autograderServiceStreamBuildLogClient struct {
+	grpc.ClientStream
+}
+
+func (x *autograderServiceStreamBuildLogClient) Recv() (*BuildLogChunk, error) {
+	m := new(BuildLogChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *autograderServiceClient) GetArtifacts(ctx context.Context, in *ArtifactRequest, opts ...grpc.CallOption) (*Artifacts, error) {
+	out := new(Artifacts)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetArtifacts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetArtifact(ctx context.Context, in *ArtifactRequest, opts ...grpc.CallOption) (*Artifact, error) {
+	out := new(Artifact)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetArtifact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GrantDeadlineExtension(ctx context.Context, in *DeadlineExtensionRequest, opts ...grpc.CallOption) (*DeadlineExtension, error) {
+	out := new(DeadlineExtension)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GrantDeadlineExtension", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetCourseGrade(ctx context.Context, in *CourseGradeRequest, opts ...grpc.CallOption) (*CourseGrade, error) {
+	out := new(CourseGrade)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetCourseGrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *autograderServiceClient) CreateBenchmark(ctx context.Context, in *GradingBenchmark, opts ...grpc.CallOption) (*GradingBenchmark, error) {
 	out := new(GradingBenchmark)
 	err := c.cc.Invoke(ctx, "/ag.AutograderService/CreateBenchmark", in, out, opts...)
@@ -481,8 +641,12 @@ type AutograderServiceServer interface {
 	CreateCourse(context.Context, *Course) (*Course, error)
 	UpdateCourse(context.Context, *Course) (*Void, error)
 	UpdateCourseVisibility(context.Context, *Enrollment) (*Void, error)
+	UpdateCourseSecret(context.Context, *CourseSecret) (*Void, error)
 	GetAssignments(context.Context, *CourseRequest) (*Assignments, error)
 	UpdateAssignments(context.Context, *CourseRequest) (*Void, error)
+	ValidateCourseRepository(context.Context, *CourseRequest) (*CourseRepositoryValidation, error)
+	ExportAssignments(context.Context, *AssignmentArchiveRequest) (*AssignmentArchive, error)
+	ImportAssignments(context.Context, *AssignmentArchive) (*Assignments, error)
 	GetEnrollmentsByUser(context.Context, *EnrollmentStatusRequest) (*Enrollments, error)
 	GetEnrollmentsByCourse(context.Context, *EnrollmentRequest) (*Enrollments, error)
 	CreateEnrollment(context.Context, *Enrollment) (*Void, error)
@@ -496,6 +660,22 @@ type AutograderServiceServer interface {
 	UpdateSubmissions(context.Context, *UpdateSubmissionsRequest) (*Void, error)
 	RebuildSubmission(context.Context, *RebuildRequest) (*Submission, error)
 	RebuildSubmissions(context.Context, *AssignmentRequest) (*Void, error)
+	// Start re-running the tests of the latest submission of every student or group for an assignment.
+	RegradeAssignment(context.Context, *AssignmentRequest) (*RegradeProgress, error)
+	// Get the progress of the latest regrade of an assignment.
+	GetRegradeProgress(context.Context, *AssignmentRequest) (*RegradeProgress, error)
+	// Re-run the tests of the latest commit of the user's or the user's group's submission for an assignment.
+	RerunSubmission(context.Context, *AssignmentRequest) (*Submission, error)
+	// Get the number of queued test runs in a course and the position of the user's first test run.
+	GetSubmissionQueue(context.Context, *CourseRequest) (*SubmissionQueue, error)
+	// Stream the output of the test run in progress for an assignment until the run completes.
+	StreamBuildLog(*BuildLogRequest, AutograderService_StreamBuildLogServer) error
+	// Get the names and sizes of the artifacts written by the tests of a submission.
+	GetArtifacts(context.Context, *ArtifactRequest) (*Artifacts, error)
+	// Download an artifact written by the tests of a submission.
+	GetArtifact(context.Context, *ArtifactRequest) (*Artifact, error)
+	GrantDeadlineExtension(context.Context, *DeadlineExtensionRequest) (*DeadlineExtension, error)
+	GetCourseGrade(context.Context, *CourseGradeRequest) (*CourseGrade, error)
 	CreateBenchmark(context.Context, *GradingBenchmark) (*GradingBenchmark, error)
 	UpdateBenchmark(context.Context, *GradingBenchmark) (*Void, error)
 	DeleteBenchmark(context.Context, *GradingBenchmark) (*Void, error)
@@ -567,12 +747,24 @@ func (UnimplementedAutograderServiceServer) UpdateCourse(context.Context, *Cours
 func (UnimplementedAutograderServiceServer) UpdateCourseVisibility(context.Context, *Enrollment) (*Void, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCourseVisibility not implemented")
 }
+func (UnimplementedAutograderServiceServer) UpdateCourseSecret(context.Context, *CourseSecret) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCourseSecret not implemented")
+}
 func (UnimplementedAutograderServiceServer) GetAssignments(context.Context, *CourseRequest) (*Assignments, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAssignments not implemented")
 }
 func (UnimplementedAutograderServiceServer) UpdateAssignments(context.Context, *CourseRequest) (*Void, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateAssignments not implemented")
 }
+func (UnimplementedAutograderServiceServer) ValidateCourseRepository(context.Context, *CourseRequest) (*CourseRepositoryValidation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateCourseRepository not implemented")
+}
+func (UnimplementedAutograderServiceServer) ExportAssignments(context.Context, *AssignmentArchiveRequest) (*AssignmentArchive, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportAssignments not implemented")
+}
+func (UnimplementedAutograderServiceServer) ImportAssignments(context.Context, *AssignmentArchive) (*Assignments, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportAssignments not implemented")
+}
 func (UnimplementedAutograderServiceServer) GetEnrollmentsByUser(context.Context, *EnrollmentStatusRequest) (*Enrollments, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEnrollmentsByUser not implemented")
 }
@@ -606,6 +798,33 @@ func (UnimplementedAutograderServiceServer) RebuildSubmission(context.Context, *
 func (UnimplementedAutograderServiceServer) RebuildSubmissions(context.Context, *AssignmentRequest) (*Void, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RebuildSubmissions not implemented")
 }
+func (UnimplementedAutograderServiceServer) RegradeAssignment(context.Context, *AssignmentRequest) (*RegradeProgress, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegradeAssignment not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetRegradeProgress(context.Context, *AssignmentRequest) (*RegradeProgress, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegradeProgress not implemented")
+}
+func (UnimplementedAutograderServiceServer) RerunSubmission(context.Context, *AssignmentRequest) (*Submission, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RerunSubmission not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetSubmissionQueue(context.Context, *CourseRequest) (*SubmissionQueue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubmissionQueue not implemented")
+}
+func (UnimplementedAutograderServiceServer) StreamBuildLog(*BuildLogRequest, AutograderService_StreamBuildLogServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBuildLog not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetArtifacts(context.Context, *ArtifactRequest) (*Artifacts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArtifacts not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetArtifact(context.Context, *ArtifactRequest) (*Artifact, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArtifact not implemented")
+}
+func (UnimplementedAutograderServiceServer) GrantDeadlineExtension(context.Context, *DeadlineExtensionRequest) (*DeadlineExtension, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantDeadlineExtension not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetCourseGrade(context.Context, *CourseGradeRequest) (*CourseGrade, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourseGrade not implemented")
+}
 func (UnimplementedAutograderServiceServer) CreateBenchmark(context.Context, *GradingBenchmark) (*GradingBenchmark, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateBenchmark not implemented")
 }
@@ -964,6 +1183,24 @@ func _AutograderService_UpdateCourseVisibility_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AutograderService_UpdateCourseSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseSecret)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateCourseSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/UpdateCourseSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateCourseSecret(ctx, req.(*CourseSecret))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AutograderService_GetAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CourseRequest)
 	if err := dec(in); err != nil {
@@ -1000,6 +1237,60 @@ func _AutograderService_UpdateAssignments_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AutograderService_ValidateCourseRepository_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).ValidateCourseRepository(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/ValidateCourseRepository",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).ValidateCourseRepository(ctx, req.(*CourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_ExportAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignmentArchiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).ExportAssignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/ExportAssignments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).ExportAssignments(ctx, req.(*AssignmentArchiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_ImportAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignmentArchive)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).ImportAssignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/ImportAssignments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).ImportAssignments(ctx, req.(*AssignmentArchive))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AutograderService_GetEnrollmentsByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EnrollmentStatusRequest)
 	if err := dec(in); err != nil {
@@ -1198,6 +1489,171 @@ func _AutograderService_RebuildSubmissions_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AutograderService_RegradeAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).RegradeAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/RegradeAssignment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).RegradeAssignment(ctx, req.(*AssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetRegradeProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetRegradeProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetRegradeProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetRegradeProgress(ctx, req.(*AssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_RerunSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).RerunSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/RerunSubmission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).RerunSubmission(ctx, req.(*AssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetSubmissionQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetSubmissionQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetSubmissionQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetSubmissionQueue(ctx, req.(*CourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_StreamBuildLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BuildLogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AutograderServiceServer).StreamBuildLog(m, &autograderServiceStreamBuildLogServer{stream})
+}
+
+type AutograderService_StreamBuildLogServer interface {
+	Send(*BuildLogChunk) error
+	grpc.ServerStream
+}
+
+type autograderServiceStreamBuildLogServer struct {
+	grpc.ServerStream
+}
+
+func (x *autograderServiceStreamBuildLogServer) Send(m *BuildLogChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AutograderService_GetArtifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArtifactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetArtifacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetArtifacts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetArtifacts(ctx, req.(*ArtifactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArtifactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetArtifact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetArtifact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetArtifact(ctx, req.(*ArtifactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GrantDeadlineExtension_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeadlineExtensionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GrantDeadlineExtension(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GrantDeadlineExtension",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GrantDeadlineExtension(ctx, req.(*DeadlineExtensionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetCourseGrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseGradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetCourseGrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetCourseGrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetCourseGrade(ctx, req.(*CourseGradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AutograderService_CreateBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GradingBenchmark)
 	if err := dec(in); err != nil {
@@ -1507,6 +1963,10 @@ var AutograderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCourseVisibility",
 			Handler:    _AutograderService_UpdateCourseVisibility_Handler,
 		},
+		{
+			MethodName: "UpdateCourseSecret",
+			Handler:    _AutograderService_UpdateCourseSecret_Handler,
+		},
 		{
 			MethodName: "GetAssignments",
 			Handler:    _AutograderService_GetAssignments_Handler,
@@ -1515,6 +1975,18 @@ var AutograderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateAssignments",
 			Handler:    _AutograderService_UpdateAssignments_Handler,
 		},
+		{
+			MethodName: "ValidateCourseRepository",
+			Handler:    _AutograderService_ValidateCourseRepository_Handler,
+		},
+		{
+			MethodName: "ExportAssignments",
+			Handler:    _AutograderService_ExportAssignments_Handler,
+		},
+		{
+			MethodName: "ImportAssignments",
+			Handler:    _AutograderService_ImportAssignments_Handler,
+		},
 		{
 			MethodName: "GetEnrollmentsByUser",
 			Handler:    _AutograderService_GetEnrollmentsByUser_Handler,
@@ -1559,6 +2031,38 @@ var AutograderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RebuildSubmissions",
 			Handler:    _AutograderService_RebuildSubmissions_Handler,
 		},
+		{
+			MethodName: "RegradeAssignment",
+			Handler:    _AutograderService_RegradeAssignment_Handler,
+		},
+		{
+			MethodName: "GetRegradeProgress",
+			Handler:    _AutograderService_GetRegradeProgress_Handler,
+		},
+		{
+			MethodName: "RerunSubmission",
+			Handler:    _AutograderService_RerunSubmission_Handler,
+		},
+		{
+			MethodName: "GetSubmissionQueue",
+			Handler:    _AutograderService_GetSubmissionQueue_Handler,
+		},
+		{
+			MethodName: "GetArtifacts",
+			Handler:    _AutograderService_GetArtifacts_Handler,
+		},
+		{
+			MethodName: "GetArtifact",
+			Handler:    _AutograderService_GetArtifact_Handler,
+		},
+		{
+			MethodName: "GrantDeadlineExtension",
+			Handler:    _AutograderService_GrantDeadlineExtension_Handler,
+		},
+		{
+			MethodName: "GetCourseGrade",
+			Handler:    _AutograderService_GetCourseGrade_Handler,
+		},
 		{
 			MethodName: "CreateBenchmark",
 			Handler:    _AutograderService_CreateBenchmark_Handler,
@@ -1612,6 +2116,12 @@ var AutograderService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _AutograderService_IsEmptyRepo_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamBuildLog",
+			Handler:       _AutograderService_StreamBuildLog_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "ag/ag.proto",
 }
@@ -0,0 +1,110 @@
+package ag
+
+import (
+	"time"
+
+	"github.com/autograde/quickfeed/kit/score"
+)
+
+// ApprovalRun holds the outcome of a test run for a submission,
+// as evaluated by the auto approval policies of the submission's assignment.
+type ApprovalRun struct {
+	Score   uint32         // score of the run, less any late penalty
+	Scores  []*score.Score // scores of the individual tests; nil if unknown
+	BuiltAt time.Time      // time of the run; zero if unknown
+	Streak  uint32         // number of consecutive passing runs, including this run
+}
+
+// approvalPolicy is a condition that a test run must satisfy
+// for its submission to be auto approved.
+type approvalPolicy struct {
+	name    string
+	permits func(a *Assignment, run *ApprovalRun) bool
+}
+
+// passPolicies decide whether a test run passes, and
+// thereby counts towards the runs required for approval.
+var passPolicies = []approvalPolicy{
+	{name: "scorelimit", permits: func(a *Assignment, run *ApprovalRun) bool {
+		return run.Score >= a.GetScoreLimit()
+	}},
+	{name: "requiredtests", permits: passesApprovalTests},
+}
+
+// approvalPolicies decide whether a passing test run is approved.
+var approvalPolicies = []approvalPolicy{
+	{name: "approvalruns", permits: func(a *Assignment, run *ApprovalRun) bool {
+		return run.Streak >= a.GetApprovalRuns()
+	}},
+	{name: "beforedeadline", permits: func(a *Assignment, run *ApprovalRun) bool {
+		if !a.GetApproveBeforeDeadline() {
+			return true
+		}
+		if run.BuiltAt.IsZero() {
+			return false
+		}
+		sinceDeadline, err := a.SinceDeadline(run.BuiltAt)
+		return err == nil && sinceDeadline <= a.gracePeriod()
+	}},
+	{name: "manualreview", permits: func(a *Assignment, _ *ApprovalRun) bool {
+		return !a.GetManualReview()
+	}},
+}
+
+// passesApprovalTests returns true if the run passed each of the assignment's
+// tests that are required for approval.
+func passesApprovalTests(a *Assignment, run *ApprovalRun) bool {
+	for _, testName := range a.ApprovalTests() {
+		passed := false
+		for _, sc := range run.Scores {
+			if sc.GetTestName() == testName && a.IsPassing(sc) {
+				passed = true
+				break
+			}
+		}
+		if !passed {
+			return false
+		}
+	}
+	return true
+}
+
+// Passes returns true if the given test run reaches the assignment's score limit
+// and passes the tests required for approval, such that it counts towards the
+// consecutive runs required for approval.
+func (a *Assignment) Passes(run *ApprovalRun) bool {
+	return a.deniedBy(passPolicies, run) == ""
+}
+
+// ApprovalStatus returns an approved submission status if the assignment is auto approved
+// and the given test run satisfies all of the assignment's approval policies: the run must
+// pass, as decided by Passes, and must be the last of the required number of consecutive
+// passing runs, made before the deadline if so required, and the assignment must not
+// require manual review. Otherwise, the status of the latest submission is kept.
+func (a *Assignment) ApprovalStatus(latest *Submission, run *ApprovalRun) Submission_Status {
+	if a.GetAutoApprove() && a.ApprovalDeniedBy(run) == "" {
+		return Submission_APPROVED
+	}
+	// keep existing status if already approved/revision/rejected
+	return latest.GetStatus()
+}
+
+// ApprovalDeniedBy returns the name of the first approval policy that the given test run
+// does not satisfy, or the empty string if the run satisfies all approval policies.
+func (a *Assignment) ApprovalDeniedBy(run *ApprovalRun) string {
+	if policy := a.deniedBy(passPolicies, run); policy != "" {
+		return policy
+	}
+	return a.deniedBy(approvalPolicies, run)
+}
+
+// deniedBy returns the name of the first of the given policies that the given
+// test run does not satisfy, or the empty string if it satisfies all of them.
+func (a *Assignment) deniedBy(policies []approvalPolicy, run *ApprovalRun) string {
+	for _, policy := range policies {
+		if !policy.permits(a, run) {
+			return policy.name
+		}
+	}
+	return ""
+}
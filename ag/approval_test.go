@@ -0,0 +1,151 @@
+package ag_test
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/kit/score"
+)
+
+func TestApprovalStatus(t *testing.T) {
+	deadline := time.Date(2022, 9, 1, 23, 59, 0, 0, time.Local)
+	before, after := deadline.Add(-time.Hour), deadline.Add(time.Hour)
+	scores := []*score.Score{
+		{TestName: "TestA", Score: 5, MaxScore: 5, Weight: 1},
+		{TestName: "TestB", Score: 2, MaxScore: 5, Weight: 1},
+	}
+	assignment := func(configure func(*pb.Assignment)) *pb.Assignment {
+		a := &pb.Assignment{
+			Name:        "lab1",
+			AutoApprove: true,
+			ScoreLimit:  80,
+			Deadline:    deadline.Format(pb.TimeLayout),
+		}
+		configure(a)
+		return a
+	}
+	tests := []struct {
+		name       string
+		assignment *pb.Assignment
+		run        *pb.ApprovalRun
+		want       pb.Submission_Status
+		wantPolicy string
+	}{
+		{
+			name:       "score limit reached",
+			assignment: assignment(func(a *pb.Assignment) {}),
+			run:        &pb.ApprovalRun{Score: 80, Streak: 1},
+			want:       pb.Submission_APPROVED,
+		},
+		{
+			name:       "below score limit",
+			assignment: assignment(func(a *pb.Assignment) {}),
+			run:        &pb.ApprovalRun{Score: 79, Streak: 1},
+			want:       pb.Submission_NONE,
+			wantPolicy: "scorelimit",
+		},
+		{
+			name:       "too few consecutive runs",
+			assignment: assignment(func(a *pb.Assignment) { a.ApprovalRuns = 3 }),
+			run:        &pb.ApprovalRun{Score: 100, Streak: 2},
+			want:       pb.Submission_NONE,
+			wantPolicy: "approvalruns",
+		},
+		{
+			name:       "enough consecutive runs",
+			assignment: assignment(func(a *pb.Assignment) { a.ApprovalRuns = 3 }),
+			run:        &pb.ApprovalRun{Score: 100, Streak: 3},
+			want:       pb.Submission_APPROVED,
+		},
+		{
+			name:       "before deadline",
+			assignment: assignment(func(a *pb.Assignment) { a.ApproveBeforeDeadline = true }),
+			run:        &pb.ApprovalRun{Score: 100, Streak: 1, BuiltAt: before},
+			want:       pb.Submission_APPROVED,
+		},
+		{
+			name:       "after deadline",
+			assignment: assignment(func(a *pb.Assignment) { a.ApproveBeforeDeadline = true }),
+			run:        &pb.ApprovalRun{Score: 100, Streak: 1, BuiltAt: after},
+			want:       pb.Submission_NONE,
+			wantPolicy: "beforedeadline",
+		},
+		{
+			name:       "within grace period",
+			assignment: assignment(func(a *pb.Assignment) { a.ApproveBeforeDeadline = true; a.GraceHours = 2 }),
+			run:        &pb.ApprovalRun{Score: 100, Streak: 1, BuiltAt: after},
+			want:       pb.Submission_APPROVED,
+		},
+		{
+			name:       "unknown run time",
+			assignment: assignment(func(a *pb.Assignment) { a.ApproveBeforeDeadline = true }),
+			run:        &pb.ApprovalRun{Score: 100, Streak: 1},
+			want:       pb.Submission_NONE,
+			wantPolicy: "beforedeadline",
+		},
+		{
+			name:       "manual review",
+			assignment: assignment(func(a *pb.Assignment) { a.ManualReview = true }),
+			run:        &pb.ApprovalRun{Score: 100, Streak: 1},
+			want:       pb.Submission_NONE,
+			wantPolicy: "manualreview",
+		},
+		{
+			name: "required test passed",
+			assignment: assignment(func(a *pb.Assignment) {
+				a.Tests = []*pb.TestConfig{{TestName: "TestA", RequiredForApproval: true}}
+			}),
+			run:  &pb.ApprovalRun{Score: 100, Streak: 1, Scores: scores},
+			want: pb.Submission_APPROVED,
+		},
+		{
+			name: "required test failed",
+			assignment: assignment(func(a *pb.Assignment) {
+				a.Tests = []*pb.TestConfig{{TestName: "TestB", RequiredForApproval: true}}
+			}),
+			run:        &pb.ApprovalRun{Score: 100, Streak: 1, Scores: scores},
+			want:       pb.Submission_NONE,
+			wantPolicy: "requiredtests",
+		},
+		{
+			name: "required test passed at threshold",
+			assignment: assignment(func(a *pb.Assignment) {
+				a.Tests = []*pb.TestConfig{{TestName: "TestB", RequiredForApproval: true, PassThreshold: 40}}
+			}),
+			run:  &pb.ApprovalRun{Score: 100, Streak: 1, Scores: scores},
+			want: pb.Submission_APPROVED,
+		},
+		{
+			name: "required test missing",
+			assignment: assignment(func(a *pb.Assignment) {
+				a.Tests = []*pb.TestConfig{{TestName: "TestC", RequiredForApproval: true}}
+			}),
+			run:        &pb.ApprovalRun{Score: 100, Streak: 1, Scores: scores},
+			want:       pb.Submission_NONE,
+			wantPolicy: "requiredtests",
+		},
+		{
+			name:       "no auto approval",
+			assignment: assignment(func(a *pb.Assignment) { a.AutoApprove = false }),
+			run:        &pb.ApprovalRun{Score: 100, Streak: 1},
+			want:       pb.Submission_NONE,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.assignment.ApprovalStatus(nil, test.run); got != test.want {
+				t.Errorf("ApprovalStatus() = %v, want %v", got, test.want)
+			}
+			if got := test.assignment.ApprovalDeniedBy(test.run); got != test.wantPolicy {
+				t.Errorf("ApprovalDeniedBy() = %q, want %q", got, test.wantPolicy)
+			}
+		})
+	}
+
+	// the status of an approved submission is kept
+	a := assignment(func(a *pb.Assignment) { a.ManualReview = true })
+	if got := a.ApprovalStatus(&pb.Submission{Status: pb.Submission_APPROVED}, &pb.ApprovalRun{Score: 100, Streak: 1}); got != pb.Submission_APPROVED {
+		t.Errorf("ApprovalStatus() = %v for approved submission, want %v", got, pb.Submission_APPROVED)
+	}
+}
@@ -1,7 +1,11 @@
 package ag
 
 import (
+	"strings"
 	"time"
+
+	"github.com/autograde/quickfeed/kit/score"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -9,11 +13,34 @@ const (
 	zero = time.Duration(0)
 )
 
+// Network policies for the containers running an assignment's tests.
+const (
+	// NetworkNone disables networking.
+	NetworkNone = "none"
+	// NetworkInternal allows access to other containers on the runner's
+	// internal network, e.g., a database, but not to external hosts.
+	NetworkInternal = "internal"
+	// NetworkLimited allows access to a restricted set of hosts, e.g., package registries,
+	// and the hosts of the student and tests repositories cloned by the tests' scripts;
+	// this is the default, such that student code cannot reach other hosts.
+	NetworkLimited = "limited"
+	// NetworkFull allows unrestricted network access.
+	NetworkFull = "full"
+)
+
+// Formats of the output of an assignment's tests.
+const (
+	// TestFormatText is go test's verbose output, or the output of another test framework.
+	TestFormatText = "text"
+	// TestFormatJSON is go test's -json event stream.
+	TestFormatJSON = "json"
+)
+
 // SinceDeadline returns the duration since the deadline.
 // A positive duration means the deadline has passed, whereas
 // a negative duration means the deadline has not yet passed.
 func (a *Assignment) SinceDeadline(now time.Time) (time.Duration, error) {
-	deadline, err := time.ParseInLocation(TimeLayout, a.GetDeadline(), now.Location())
+	deadline, err := a.DeadlineIn(now.Location())
 	if err != nil {
 		// this should not happen if deadlines are parsed and recorded correctly
 		return zero, err
@@ -21,35 +48,350 @@ func (a *Assignment) SinceDeadline(now time.Time) (time.Duration, error) {
 	return now.Sub(deadline), nil
 }
 
+// DeadlineIn returns the assignment's deadline as a point in time. If the deadline
+// was not recorded as a point in time, the deadline is interpreted in the assignment's
+// time zone, or if the assignment has no time zone, in the given location.
+func (a *Assignment) DeadlineIn(loc *time.Location) (time.Time, error) {
+	if a.GetDeadlineTime() != nil {
+		return a.GetDeadlineTime().AsTime(), nil
+	}
+	return time.ParseInLocation(TimeLayout, a.GetDeadline(), a.Location(loc))
+}
+
+// Location returns the location of the assignment's time zone,
+// or the given location if the assignment has no valid time zone.
+func (a *Assignment) Location(loc *time.Location) *time.Location {
+	if a.GetTimeZone() == "" {
+		return loc
+	}
+	if tz, err := time.LoadLocation(a.GetTimeZone()); err == nil {
+		return tz
+	}
+	return loc
+}
+
+// LatePenaltyPoints returns the number of points to deduct from the given grade
+// for a submission made at the given time, which is the assignment's late penalty
+// for each started day after the deadline. A submission within the assignment's
+// grace period after the deadline is not late, and a submission after the close
+// date, if any, loses the entire grade. The deducted points never exceed the
+// grade. An error is returned if the deadline cannot be parsed.
+func (a *Assignment) LatePenaltyPoints(submitted time.Time, grade uint32) (uint32, error) {
+	sinceDeadline, err := a.SinceDeadline(submitted)
+	if err != nil || sinceDeadline <= a.gracePeriod() {
+		return 0, err
+	}
+	if a.GetCloseDate() != nil && submitted.After(a.GetCloseDate().AsTime()) {
+		return grade, nil
+	}
+	daysLate := uint64((sinceDeadline + days - 1) / days)
+	if penalty := daysLate * uint64(a.GetLatePenalty()); penalty < uint64(grade) {
+		return uint32(penalty), nil
+	}
+	return grade, nil
+}
+
+// gracePeriod returns the duration after the deadline during which a submission is not late.
+func (a *Assignment) gracePeriod() time.Duration {
+	return time.Duration(a.GetGraceHours()) * time.Hour
+}
+
 // IsApproved returns an approved submission status if this assignment is already approved
 // for the latest submission, or if the score of the latest submission is sufficient
-// to autoapprove the assignment.
+// to autoapprove the assignment. The score is evaluated as a single test run, whose
+// test scores and time are unknown; see ApprovalStatus.
 func (a *Assignment) IsApproved(latest *Submission, score uint32) Submission_Status {
-	if a.GetAutoApprove() && score >= a.GetScoreLimit() {
-		return Submission_APPROVED
+	return a.ApprovalStatus(latest, &ApprovalRun{Score: score, Streak: 1})
+}
+
+// WithExtension returns a copy of the assignment whose deadline is replaced by
+// the given deadline extension, if any, interpreted in the assignment's time zone.
+// The close date, if any, is moved to the extended deadline if it would otherwise
+// close submissions before it. The assignment itself is returned if there is no extension.
+func (a *Assignment) WithExtension(ext *DeadlineExtension) *Assignment {
+	if ext.GetDeadline() == "" {
+		return a
+	}
+	extended := a.CloneWithoutSubmissions()
+	extended.Deadline = ext.GetDeadline()
+	extended.DeadlineTime = nil
+	deadline, err := time.ParseInLocation(TimeLayout, ext.GetDeadline(), a.Location(time.Local))
+	if err != nil {
+		return extended
+	}
+	if a.GetDeadlineTime() != nil {
+		extended.DeadlineTime = timestamppb.New(deadline)
 	}
-	// keep existing status if already approved/revision/rejected
-	return latest.GetStatus()
+	if a.GetCloseDate() != nil && a.GetCloseDate().AsTime().Before(deadline) {
+		extended.CloseDate = timestamppb.New(deadline)
+	}
+	return extended
 }
 
 // CloneWithoutSubmissions returns a deep copy of the given assignment
 // without submissions.
 func (a *Assignment) CloneWithoutSubmissions() *Assignment {
 	return &Assignment{
-		ID:                a.ID,
-		CourseID:          a.CourseID,
-		Name:              a.Name,
-		Deadline:          a.Deadline,
-		AutoApprove:       a.AutoApprove,
-		Order:             a.Order,
-		IsGroupLab:        a.IsGroupLab,
-		ScoreLimit:        a.ScoreLimit,
-		Reviewers:         a.Reviewers,
-		GradingBenchmarks: a.GradingBenchmarks,
+		ID:                    a.ID,
+		CourseID:              a.CourseID,
+		Name:                  a.Name,
+		Deadline:              a.Deadline,
+		AutoApprove:           a.AutoApprove,
+		Order:                 a.Order,
+		IsGroupLab:            a.IsGroupLab,
+		ScoreLimit:            a.ScoreLimit,
+		Reviewers:             a.Reviewers,
+		GradingBenchmarks:     a.GradingBenchmarks,
+		PartOf:                a.PartOf,
+		Language:              a.Language,
+		Verbose:               a.Verbose,
+		Retries:               a.Retries,
+		Tests:                 a.Tests,
+		TestsRepoURL:          a.TestsRepoURL,
+		TestsRepoRef:          a.TestsRepoRef,
+		ManualOnly:            a.ManualOnly,
+		ReleaseDate:           a.ReleaseDate,
+		CloseDate:             a.CloseDate,
+		ExtraCreditCap:        a.ExtraCreditCap,
+		HidePoints:            a.HidePoints,
+		RequiredFiles:         a.RequiredFiles,
+		ReviewerStrategy:      a.ReviewerStrategy,
+		MuteNotifications:     a.MuteNotifications,
+		PlagiarismCheck:       a.PlagiarismCheck,
+		SimilarityThreshold:   a.SimilarityThreshold,
+		Network:               a.Network,
+		CourseWeight:          a.CourseWeight,
+		MaxParallel:           a.MaxParallel,
+		DiffMode:              a.DiffMode,
+		LatePenalty:           a.LatePenalty,
+		OutputLimit:           a.OutputLimit,
+		RetryOnInfra:          a.RetryOnInfra,
+		GraceHours:            a.GraceHours,
+		TimeZone:              a.TimeZone,
+		DeadlineTime:          a.DeadlineTime,
+		Dockerfile:            a.Dockerfile,
+		DockerImage:           a.DockerImage,
+		Tasks:                 a.Tasks,
+		Requires:              a.Requires,
+		MaxAttempts:           a.MaxAttempts,
+		CooldownMinutes:       a.CooldownMinutes,
+		RandomSeed:            a.RandomSeed,
+		Secrets:               a.Secrets,
+		ApprovalRuns:          a.ApprovalRuns,
+		ApproveBeforeDeadline: a.ApproveBeforeDeadline,
+		ManualReview:          a.ManualReview,
+		CpuLimit:              a.CpuLimit,
+		MemoryLimit:           a.MemoryLimit,
+		PidsLimit:             a.PidsLimit,
+		DiskLimit:             a.DiskLimit,
+		AllowedHosts:          a.AllowedHosts,
+		MaxReruns:             a.MaxReruns,
+		CoverageProfile:       a.CoverageProfile,
+		CoverageWeight:        a.CoverageWeight,
+		CoverageTarget:        a.CoverageTarget,
+		AnalysisChecks:        a.AnalysisChecks,
+		Matrix:                a.Matrix,
+		TestFormat:            a.TestFormat,
+	}
+}
+
+// BuildImageName returns the name of the docker image built from the assignment's
+// Dockerfile for the course with the given code, which is the course code and the
+// assignment's name, e.g., dat320-lab1. The assignment's docker image is never used,
+// since it may name an image in a registry that must not be overwritten by a local build.
+func (a *Assignment) BuildImageName(courseCode string) string {
+	return strings.ToLower(courseCode + "-" + a.GetName())
+}
+
+// NotifyOnResult returns true if students should be notified
+// when the results of their submissions for the assignment are ready.
+func (a *Assignment) NotifyOnResult() bool {
+	return !a.GetMuteNotifications()
+}
+
+// NetworkPolicy returns the network policy for the containers running
+// the assignment's tests, which is NetworkLimited unless otherwise specified.
+func (a *Assignment) NetworkPolicy() string {
+	if a.GetNetwork() == "" {
+		return NetworkLimited
+	}
+	return a.GetNetwork()
+}
+
+// JSONTestOutput returns true if the assignment's tests print go test's -json
+// event stream rather than text.
+func (a *Assignment) JSONTestOutput() bool {
+	return a.GetTestFormat() == TestFormatJSON
+}
+
+// defaultAllowedHosts are the hosts reachable under the limited network policy
+// if the assignment does not name any; these are common package registries.
+var defaultAllowedHosts = []string{
+	"proxy.golang.org",
+	"sum.golang.org",
+	"pypi.org",
+	"files.pythonhosted.org",
+	"registry.npmjs.org",
+}
+
+// AllowedHostNames returns the hosts that the containers running the assignment's tests
+// may access under the limited network policy, which are common package registries
+// unless otherwise specified. A host of the form *.example.com matches any subdomain.
+func (a *Assignment) AllowedHostNames() []string {
+	if a.GetAllowedHosts() == "" {
+		return defaultAllowedHosts
+	}
+	return strings.Split(a.GetAllowedHosts(), ",")
+}
+
+// Parallelism returns the maximum number of test packages that may run
+// concurrently for the assignment, which is one (serial) unless otherwise specified.
+func (a *Assignment) Parallelism() int {
+	if a.GetMaxParallel() == 0 {
+		return 1
+	}
+	return int(a.GetMaxParallel())
+}
+
+// Prerequisites returns the names of the assignments that must be approved
+// before submissions for the assignment are accepted, or nil if there are none.
+func (a *Assignment) Prerequisites() []string {
+	if a.GetRequires() == "" {
+		return nil
+	}
+	return strings.Split(a.GetRequires(), ",")
+}
+
+// MatrixEntry is an entry of an assignment's build matrix,
+// naming a docker image in which to run the assignment's tests.
+type MatrixEntry struct {
+	Name  string
+	Image string
+}
+
+// BuildMatrix returns the entries of the assignment's build matrix in order,
+// or nil if the assignment's tests run once.
+func (a *Assignment) BuildMatrix() []MatrixEntry {
+	if a.GetMatrix() == "" {
+		return nil
+	}
+	var entries []MatrixEntry
+	for _, pair := range strings.Split(a.GetMatrix(), ",") {
+		if parts := strings.SplitN(pair, "=", 2); len(parts) == 2 {
+			entries = append(entries, MatrixEntry{Name: parts[0], Image: parts[1]})
+		}
+	}
+	return entries
+}
+
+// SecretVariables returns the names of the course secrets given to the assignment's
+// tests, keyed by the environment variable holding each secret, or nil if there are none.
+func (a *Assignment) SecretVariables() map[string]string {
+	if a.GetSecrets() == "" {
+		return nil
+	}
+	variables := make(map[string]string)
+	for _, pair := range strings.Split(a.GetSecrets(), ",") {
+		if parts := strings.SplitN(pair, "=", 2); len(parts) == 2 {
+			variables[parts[0]] = parts[1]
+		}
+	}
+	return variables
+}
+
+// RequiredFileNames returns the names of the files that must be present
+// in submissions for the assignment, or nil if there are none.
+func (a *Assignment) RequiredFileNames() []string {
+	if a.GetRequiredFiles() == "" {
+		return nil
+	}
+	return strings.Split(a.GetRequiredFiles(), ",")
+}
+
+// PassThreshold returns the percentage of the max score at which the given
+// test counts as passed, or zero if the test must obtain its max score.
+func (a *Assignment) PassThreshold(testName string) uint32 {
+	for _, test := range a.GetTests() {
+		if test.GetTestName() == testName {
+			return test.GetPassThreshold()
+		}
+	}
+	return 0
+}
+
+// IsPassing returns true if the given score counts as passed for the
+// assignment, taking the test's pass threshold into account. Tests below
+// their max score still contribute their fractional credit to the grade.
+func (a *Assignment) IsPassing(sc *score.Score) bool {
+	return sc.IsPassingAt(a.PassThreshold(sc.GetTestName()))
+}
+
+// ApprovalTests returns the names of the assignment's tests that
+// must pass for submissions to be auto approved.
+func (a *Assignment) ApprovalTests() []string {
+	var testNames []string
+	for _, test := range a.GetTests() {
+		if test.GetRequiredForApproval() {
+			testNames = append(testNames, test.GetTestName())
+		}
+	}
+	return testNames
+}
+
+// RetryableTests returns the names of the assignment's tests that may be
+// rerun if they fail.
+func (a *Assignment) RetryableTests() []string {
+	var testNames []string
+	for _, test := range a.GetTests() {
+		if test.GetRetryable() {
+			testNames = append(testNames, test.GetTestName())
+		}
+	}
+	return testNames
+}
+
+// HiddenTests returns the names of the assignment's tests whose
+// scores are not shown to students until after the deadline.
+func (a *Assignment) HiddenTests() []string {
+	var testNames []string
+	for _, test := range a.GetTests() {
+		if test.GetHidden() {
+			testNames = append(testNames, test.GetTestName())
+		}
+	}
+	return testNames
+}
+
+// RevealsHiddenTests returns true if the scores of the assignment's hidden tests
+// are shown to students at the given time, that is, after the deadline.
+// Hidden tests remain hidden if the deadline cannot be parsed.
+func (a *Assignment) RevealsHiddenTests(now time.Time) bool {
+	sinceDeadline, err := a.SinceDeadline(now)
+	return err == nil && sinceDeadline > 0
+}
+
+// ExtraCreditTests returns the names of the assignment's tests whose points
+// are added on top of the grade obtained from the other tests.
+func (a *Assignment) ExtraCreditTests() []string {
+	var testNames []string
+	for _, test := range a.GetTests() {
+		if test.GetExtraCredit() {
+			testNames = append(testNames, test.GetTestName())
+		}
+	}
+	return testNames
+}
+
+// Unit returns the name of the unit that this assignment belongs to.
+// Assignments that are not part of another assignment head their own unit.
+func (a *Assignment) Unit() string {
+	if a.GetPartOf() != "" {
+		return a.GetPartOf()
 	}
+	return a.GetName()
 }
 
 // GradedManually returns true if the assignment will be graded manually.
 func (a *Assignment) GradedManually() bool {
-	return a.GetReviewers() > 0
+	return a.GetReviewers() > 0 || a.GetManualOnly()
 }
@@ -2,8 +2,11 @@ package ag_test
 
 import (
 	"testing"
+	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/kit/score"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestIsApproved(t *testing.T) {
@@ -117,3 +120,132 @@ func TestIsApproved(t *testing.T) {
 		})
 	}
 }
+
+func TestAssignmentIsPassing(t *testing.T) {
+	assignment := &pb.Assignment{
+		Tests: []*pb.TestConfig{
+			{TestName: "TestPartial", PassThreshold: 70},
+			{TestName: "TestRetry", Retryable: true},
+		},
+	}
+	tests := []struct {
+		sc   *score.Score
+		want bool
+	}{
+		{sc: &score.Score{TestName: "TestPartial", Score: 8, MaxScore: 10, Weight: 1}, want: true},
+		{sc: &score.Score{TestName: "TestPartial", Score: 6, MaxScore: 10, Weight: 1}, want: false},
+		{sc: &score.Score{TestName: "TestRetry", Score: 8, MaxScore: 10, Weight: 1}, want: false},
+		{sc: &score.Score{TestName: "TestOther", Score: 10, MaxScore: 10, Weight: 1}, want: true},
+	}
+	for _, test := range tests {
+		if got := assignment.IsPassing(test.sc); got != test.want {
+			t.Errorf("IsPassing(%s: %d/%d) = %t, want %t", test.sc.TestName, test.sc.Score, test.sc.MaxScore, got, test.want)
+		}
+	}
+	// tests above their threshold still contribute fractional credit to the grade
+	results := score.NewResults(
+		&score.Score{TestName: "TestPartial", Score: 8, MaxScore: 10, Weight: 1},
+		&score.Score{TestName: "TestOther", Score: 10, MaxScore: 10, Weight: 1},
+	)
+	if got := results.Sum(); got != 90 {
+		t.Errorf("Sum() = %d, want 90", got)
+	}
+}
+
+func TestLatePenaltyPoints(t *testing.T) {
+	deadline := time.Date(2021, 9, 15, 23, 59, 0, 0, time.UTC)
+	assignment := &pb.Assignment{
+		Deadline:    deadline.Format(pb.TimeLayout),
+		CloseDate:   timestamppb.New(deadline.Add(72 * time.Hour)),
+		GraceHours:  2,
+		LatePenalty: 10,
+	}
+	tests := []struct {
+		name      string
+		submitted time.Time
+		want      uint32
+	}{
+		{name: "on time", submitted: deadline.Add(-time.Hour), want: 0},
+		{name: "within grace period", submitted: deadline.Add(2 * time.Hour), want: 0},
+		{name: "after grace period", submitted: deadline.Add(3 * time.Hour), want: 10},
+		{name: "two days late", submitted: deadline.Add(25 * time.Hour), want: 20},
+		{name: "at close date", submitted: deadline.Add(72 * time.Hour), want: 30},
+		{name: "after close date", submitted: deadline.Add(73 * time.Hour), want: 80},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := assignment.LatePenaltyPoints(test.submitted, 80)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != test.want {
+				t.Errorf("LatePenaltyPoints() = %d, want %d", got, test.want)
+			}
+		})
+	}
+}
+
+func TestWithExtension(t *testing.T) {
+	a := &pb.Assignment{
+		Name:      "lab1",
+		Deadline:  "2021-02-01T23:59:00",
+		TimeZone:  "UTC",
+		CloseDate: timestamppb.New(time.Date(2021, 2, 5, 23, 59, 0, 0, time.UTC)),
+	}
+	tests := []struct {
+		name          string
+		ext           *pb.DeadlineExtension
+		wantDeadline  string
+		wantCloseDate string
+	}{
+		{name: "NoExtension", ext: nil, wantDeadline: "2021-02-01T23:59:00", wantCloseDate: "2021-02-05T23:59:00"},
+		{name: "BeforeCloseDate", ext: &pb.DeadlineExtension{Deadline: "2021-02-03T23:59:00"}, wantDeadline: "2021-02-03T23:59:00", wantCloseDate: "2021-02-05T23:59:00"},
+		{name: "AfterCloseDate", ext: &pb.DeadlineExtension{Deadline: "2021-02-08T23:59:00"}, wantDeadline: "2021-02-08T23:59:00", wantCloseDate: "2021-02-08T23:59:00"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := a.WithExtension(test.ext)
+			closeDate := got.GetCloseDate().AsTime().Format(pb.TimeLayout)
+			if got.GetDeadline() != test.wantDeadline || closeDate != test.wantCloseDate {
+				t.Errorf("WithExtension(%v) = (%s, %s), want (%s, %s)", test.ext, got.GetDeadline(), closeDate, test.wantDeadline, test.wantCloseDate)
+			}
+		})
+	}
+	if a.GetDeadline() != "2021-02-01T23:59:00" {
+		t.Errorf("WithExtension() modified the assignment's deadline: %s", a.GetDeadline())
+	}
+}
+
+func TestSinceDeadlineTimeZone(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.Date(2021, 8, 30, 23, 59, 0, 0, tokyo)
+	submitted := deadline.Add(time.Hour).UTC()
+	tests := []struct {
+		name       string
+		assignment *pb.Assignment
+	}{
+		{name: "TimeZone", assignment: &pb.Assignment{Deadline: "2021-08-30T23:59:00", TimeZone: "Asia/Tokyo"}},
+		{name: "DeadlineTime", assignment: &pb.Assignment{Deadline: "2021-08-30T23:59:00", DeadlineTime: timestamppb.New(deadline)}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.assignment.SinceDeadline(submitted)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != time.Hour {
+				t.Errorf("SinceDeadline(%v) = %v, want %v", submitted, got, time.Hour)
+			}
+		})
+	}
+
+	// an extended deadline is interpreted in the assignment's time zone
+	a := &pb.Assignment{Deadline: "2021-08-30T23:59:00", TimeZone: "Asia/Tokyo", DeadlineTime: timestamppb.New(deadline)}
+	extended := a.WithExtension(&pb.DeadlineExtension{Deadline: "2021-08-31T23:59:00"})
+	if got := extended.GetDeadlineTime().AsTime(); !got.Equal(deadline.Add(24 * time.Hour)) {
+		t.Errorf("WithExtension() deadline = %v, want %v", got, deadline.Add(24*time.Hour))
+	}
+}
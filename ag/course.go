@@ -1,5 +1,7 @@
 package ag
 
+import "strings"
+
 // cache of access tokens for courses; they are cached here when fetching from database
 var accessTokens = make(map[uint64]string)
 
@@ -23,3 +25,11 @@ func (course *Course) SetSlipDays() {
 		g.SetSlipDays(course)
 	}
 }
+
+// AssignmentNames returns the names of the module's assignments.
+func (m *CourseModule) AssignmentNames() []string {
+	if m.GetAssignments() == "" {
+		return nil
+	}
+	return strings.Split(m.GetAssignments(), ",")
+}
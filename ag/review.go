@@ -1,5 +1,7 @@
 package ag
 
+import "math"
+
 // ComputeScore computes the total score for the review and assigns it to r.
 // If the grading criteria have predefined points, the score is the sum of these points.
 // Otherwise, each criterion is given equal weight, such that the max score is 100.
@@ -25,3 +27,48 @@ func (r *Review) ComputeScore() {
 		r.Score = uint32(scorePoints)
 	}
 }
+
+// CombinedGrade returns the grade obtained by blending the given score from the
+// automated tests with the review's grading benchmarks, according to the weights
+// declared by the benchmarks. Each benchmark's weight is the percentage of the
+// combined grade given by that benchmark, and the automated tests account for the
+// remaining percentage. A benchmark's score is the fraction of its points awarded
+// for passed criteria or, if its criteria have no points, the fraction of passed criteria.
+// If no benchmark declares a weight, the combined grade is the test score.
+func (r *Review) CombinedGrade(testScore uint32) uint32 {
+	var totalWeight, manualPoints float64
+	for _, bm := range r.GetGradingBenchmarks() {
+		weight := float64(bm.GetWeight())
+		if weight <= 0 {
+			continue
+		}
+		totalWeight += weight
+		manualPoints += weight * bm.fractionPassed()
+	}
+	if totalWeight == 0 {
+		return testScore
+	}
+	testWeight := math.Max(0, 100-totalWeight)
+	grade := (float64(testScore)*testWeight + 100*manualPoints) / (testWeight + totalWeight)
+	return uint32(math.Round(grade))
+}
+
+// fractionPassed returns the fraction of the benchmark's points, or criteria
+// if no points are given, that were awarded for passed criteria.
+func (bm *GradingBenchmark) fractionPassed() float64 {
+	var points, passedPoints, passedCriteria uint64
+	for _, c := range bm.GetCriteria() {
+		points += c.GetPoints()
+		if c.GetGrade() == GradingCriterion_PASSED {
+			passedPoints += c.GetPoints()
+			passedCriteria++
+		}
+	}
+	if points > 0 {
+		return float64(passedPoints) / float64(points)
+	}
+	if len(bm.GetCriteria()) == 0 {
+		return 0
+	}
+	return float64(passedCriteria) / float64(len(bm.GetCriteria()))
+}
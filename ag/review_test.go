@@ -113,3 +113,34 @@ func TestComputeScore(t *testing.T) {
 		}
 	}
 }
+
+func TestCombinedGrade(t *testing.T) {
+	passed := &pb.GradingCriterion{Grade: pb.GradingCriterion_PASSED}
+	failed := &pb.GradingCriterion{Grade: pb.GradingCriterion_FAILED}
+	tests := []struct {
+		name       string
+		testScore  uint32
+		benchmarks []*pb.GradingBenchmark
+		want       uint32
+	}{
+		{name: "no benchmarks", testScore: 70, want: 70},
+		{name: "unweighted benchmarks", testScore: 70, benchmarks: []*pb.GradingBenchmark{{Criteria: []*pb.GradingCriterion{failed}}}, want: 70},
+		{name: "half manual", testScore: 60, benchmarks: []*pb.GradingBenchmark{{Weight: 50, Criteria: []*pb.GradingCriterion{passed, failed}}}, want: 55},
+		{name: "manual only", testScore: 0, benchmarks: []*pb.GradingBenchmark{
+			{Weight: 75, Criteria: []*pb.GradingCriterion{passed, passed}},
+			{Weight: 25, Criteria: []*pb.GradingCriterion{failed, failed}},
+		}, want: 75},
+		{name: "points", testScore: 100, benchmarks: []*pb.GradingBenchmark{{Weight: 40, Criteria: []*pb.GradingCriterion{
+			{Points: 1, Grade: pb.GradingCriterion_PASSED},
+			{Points: 3, Grade: pb.GradingCriterion_FAILED},
+		}}}, want: 70},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			review := &pb.Review{GradingBenchmarks: tt.benchmarks}
+			if got := review.CombinedGrade(tt.testScore); got != tt.want {
+				t.Errorf("CombinedGrade(%d) = %d, want %d", tt.testScore, got, tt.want)
+			}
+		})
+	}
+}
@@ -3,6 +3,8 @@ package ag
 import (
 	"errors"
 	"time"
+
+	"github.com/autograde/quickfeed/kit/score"
 )
 
 var ErrMissingBuildInfo = errors.New("submission missing build information")
@@ -26,3 +28,46 @@ func (s *Submission) NewestBuildDate(submissionDate time.Time) (t time.Time, err
 	}
 	return submissionDate, nil
 }
+
+// HideTests removes the scores of the given assignment's hidden tests from the
+// submission, and recomputes the submission's score from the remaining tests,
+// so that the score does not reveal the results of the hidden tests. The output
+// of the hidden tests is also removed from the submission's build logs, and the
+// scores of its build matrix entries are cleared, since they include the hidden
+// tests and cannot be recomputed without the entries' test scores. This is used
+// when presenting submissions to students before the deadline.
+func (s *Submission) HideTests(assignment *Assignment) {
+	hidden := assignment.HiddenTests()
+	results := score.NewResults(s.GetScores()...).WithoutTests(hidden)
+	s.Scores = results.Scores
+	s.Score = results.Sum()
+	if extraCredit := assignment.ExtraCreditTests(); len(extraCredit) > 0 {
+		s.Score = results.SumWithExtraCredit(extraCredit, assignment.GetExtraCreditCap())
+	}
+	if s.GetBuildInfo() != nil {
+		s.BuildInfo.BuildLog = score.WithoutTestOutput(s.BuildInfo.GetBuildLog(), hidden)
+	}
+	for _, result := range s.GetMatrixResults() {
+		result.BuildLog = score.WithoutTestOutput(result.GetBuildLog(), hidden)
+		if len(hidden) > 0 {
+			result.Score = 0
+		}
+	}
+}
+
+// HidePoints replaces the submission's scores with scores that only reveal
+// whether each test passed, according to the tests' pass thresholds for the
+// given assignment, and clears the submission's total score and the scores of
+// its build matrix entries. This is used when presenting submissions to students
+// for assignments that hide points.
+func (s *Submission) HidePoints(assignment *Assignment) {
+	scores := make([]*score.Score, len(s.GetScores()))
+	for i, sc := range s.GetScores() {
+		scores[i] = sc.WithoutPointsAt(assignment.PassThreshold(sc.GetTestName()))
+	}
+	s.Score = 0
+	s.Scores = scores
+	for _, result := range s.GetMatrixResults() {
+		result.Score = 0
+	}
+}
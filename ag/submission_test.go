@@ -54,3 +54,44 @@ func TestNewestSubmissionDate(t *testing.T) {
 		t.Errorf("NewestBuildDate(%v) = %v, expected '%v'\n", tim, new, buildDate)
 	}
 }
+
+func TestHideTests(t *testing.T) {
+	assignment := &pb.Assignment{
+		Tests: []*pb.TestConfig{
+			{TestName: "TestHidden", Hidden: true},
+			{TestName: "TestRetry", Retryable: true},
+		},
+	}
+	submission := &pb.Submission{
+		Score: 75,
+		Scores: []*score.Score{
+			{TestName: "TestPass", Score: 5, MaxScore: 5, Weight: 1},
+			{TestName: "TestRetry", Score: 0, MaxScore: 5, Weight: 1},
+			{TestName: "TestHidden", Score: 5, MaxScore: 5, Weight: 2},
+		},
+		BuildInfo: &score.BuildInfo{
+			BuildLog: "=== RUN   TestPass\n--- PASS: TestPass (0.00s)\n=== RUN   TestHidden\n--- PASS: TestHidden (0.00s)\nPASS",
+		},
+		MatrixResults: []*pb.MatrixResult{
+			{Name: "go1.17", BuildLog: "=== RUN   TestHidden\n--- PASS: TestHidden (0.00s)\nPASS"},
+		},
+	}
+	submission.HideTests(assignment)
+	if len(submission.GetScores()) != 2 {
+		t.Fatalf("HideTests() kept %d scores, want 2", len(submission.GetScores()))
+	}
+	for _, sc := range submission.GetScores() {
+		if sc.GetTestName() == "TestHidden" {
+			t.Errorf("HideTests() kept the score of hidden test %s", sc.GetTestName())
+		}
+	}
+	if submission.GetScore() != 50 {
+		t.Errorf("HideTests() score = %d, want %d", submission.GetScore(), 50)
+	}
+	if want := "=== RUN   TestPass\n--- PASS: TestPass (0.00s)\nPASS"; submission.GetBuildInfo().GetBuildLog() != want {
+		t.Errorf("HideTests() build log = %q, want %q", submission.GetBuildInfo().GetBuildLog(), want)
+	}
+	if got := submission.GetMatrixResults()[0].GetBuildLog(); got != "PASS" {
+		t.Errorf("HideTests() matrix build log = %q, want %q", got, "PASS")
+	}
+}
@@ -102,6 +102,18 @@ func (req *Enrollment) IsValid() bool {
 		req.GetUserID() > 0 && req.GetCourseID() > 0
 }
 
+// IsValid ensures that course and assignment IDs are set,
+// along with either a user ID or a group ID.
+func (req *BuildLogRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetAssignmentID() > 0 &&
+		(req.GetUserID() > 0) != (req.GetGroupID() > 0)
+}
+
+// IsValid ensures that course and submission IDs are set.
+func (req *ArtifactRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetSubmissionID() > 0
+}
+
 // IsValid ensures that course ID is set
 func (req *CourseRequest) IsValid() bool {
 	return req.GetCourseID() > 0
@@ -175,6 +187,48 @@ func (req *AssignmentRequest) IsValid() bool {
 	return req.CourseID > 0 && req.AssignmentID > 0
 }
 
+// IsValid ensures that a deadline extension belongs to an assignment and
+// exactly one of a student or a group, and that its deadline is well-formed.
+func (ext *DeadlineExtension) IsValid() bool {
+	uid, gid := ext.GetUserID(), ext.GetGroupID()
+	if ext.GetAssignmentID() == 0 || (uid == 0) == (gid == 0) {
+		return false
+	}
+	_, err := time.Parse(TimeLayout, ext.GetDeadline())
+	return err == nil
+}
+
+// IsValid ensures that course ID is provided and the deadline extension is valid.
+func (req *DeadlineExtensionRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetExtension().IsValid()
+}
+
+// IsValid ensures that course ID and secret name are set, and that
+// the name can be referred to in the secrets of an assignment
+func (s *CourseSecret) IsValid() bool {
+	return s.GetCourseID() > 0 && s.GetName() != "" && !strings.ContainsAny(s.GetName(), ",=")
+}
+
+// IsValid ensures that both course and user IDs are set
+func (req *CourseGradeRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetUserID() > 0
+}
+
+// IsValid ensures that course ID is set and that the requested assignment names are not empty
+func (req *AssignmentArchiveRequest) IsValid() bool {
+	for _, name := range req.GetAssignmentNames() {
+		if name == "" {
+			return false
+		}
+	}
+	return req.GetCourseID() > 0
+}
+
+// IsValid ensures that course ID is set and that the archive is not empty
+func (a *AssignmentArchive) IsValid() bool {
+	return a.GetCourseID() > 0 && len(a.GetArchive()) > 0
+}
+
 // IsValid ensures that provider string is one of implemented providers
 func (req *Provider) IsValid() bool {
 	provider := req.GetProvider()
@@ -0,0 +1,21 @@
+package assignments
+
+import (
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/kit/score"
+)
+
+// AdjustGrade returns the given base grade adjusted for lateness and curving,
+// in that order: first, the assignment's late penalty is deducted for each
+// started day the submission is late, and then the curve is applied to the
+// penalized grade. Applying the penalty first ensures that a curve cannot
+// undo a late penalty for students already at the top of the cohort.
+// The adjusted grade is clamped to the range 0-100. If the assignment's
+// deadline cannot be parsed, no late penalty is applied.
+func AdjustGrade(base uint32, a *pb.Assignment, submittedAt time.Time, curve score.CurvePolicy) uint32 {
+	// a malformed deadline yields no penalty
+	penalty, _ := a.LatePenaltyPoints(submittedAt, base)
+	return curve.Apply(base - penalty)
+}
@@ -0,0 +1,84 @@
+package assignments
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/kit/score"
+)
+
+func TestParseLatePenalty(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": "assignmentid: 1\ndeadline: \"27-08-2018 12:00\"\nlatepenalty: 10\n",
+		"lab2/assignment.yml": "assignmentid: 2\ndeadline: \"27-08-2018 12:00\"\n",
+	})
+	assignments, _, err := parseAssignments(testsDir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantPenalty := map[string]uint32{"lab1": 10, "lab2": 0}
+	for _, assignment := range assignments {
+		if got, want := assignment.GetLatePenalty(), wantPenalty[assignment.GetName()]; got != want {
+			t.Errorf("%s: LatePenalty = %d, want %d", assignment.GetName(), got, want)
+		}
+	}
+
+	testsDir = createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": "assignmentid: 1\ndeadline: \"27-08-2018 12:00\"\nlatepenalty: 110\n",
+	})
+	if _, _, err := parseAssignments(testsDir, 0); err == nil {
+		t.Error("parseAssignments() succeeded, want error for latepenalty above 100")
+	}
+}
+
+func TestAdjustGrade(t *testing.T) {
+	deadline := time.Date(2021, 9, 15, 23, 59, 0, 0, time.UTC)
+	assignment := &pb.Assignment{Name: "lab1", Deadline: deadline.Format(pb.TimeLayout), LatePenalty: 10}
+	onTime := deadline.Add(-time.Hour)
+	oneDayLate := deadline.Add(time.Hour)
+	threeDaysLate := deadline.Add(2*day + time.Minute)
+
+	tests := []struct {
+		name      string
+		base      uint32
+		submitted time.Time
+		curve     score.CurvePolicy
+		want      uint32
+	}{
+		{name: "on time, no curve", base: 70, submitted: onTime, want: 70},
+		{name: "on time, additive", base: 70, submitted: onTime, curve: score.CurvePolicy{Method: score.Additive, Points: 5}, want: 75},
+		{name: "late, no curve", base: 70, submitted: oneDayLate, want: 60},
+		{name: "late, additive", base: 70, submitted: threeDaysLate, curve: score.CurvePolicy{Method: score.Additive, Points: 5}, want: 45},
+		{name: "late, linear to top", base: 60, submitted: oneDayLate, curve: score.CurvePolicy{Method: score.LinearToTop, Top: 80}, want: 63},
+		{name: "penalty exceeds grade", base: 20, submitted: threeDaysLate, want: 0},
+		{name: "curve capped at 100", base: 95, submitted: onTime, curve: score.CurvePolicy{Method: score.Additive, Points: 10}, want: 100},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := AdjustGrade(tt.base, assignment, tt.submitted, tt.curve)
+			if got != tt.want {
+				t.Errorf("AdjustGrade(%d) = %d, want %d", tt.base, got, tt.want)
+			}
+
+			// applying the penalty and then the curve manually gives the same grade
+			penalized := tt.base
+			if sinceDeadline, _ := assignment.SinceDeadline(tt.submitted); sinceDeadline > 0 {
+				daysLate := uint32((sinceDeadline + day - 1) / day)
+				if penalty := daysLate * assignment.GetLatePenalty(); penalty < penalized {
+					penalized -= penalty
+				} else {
+					penalized = 0
+				}
+			}
+			if manual := tt.curve.Apply(penalized); got != manual {
+				t.Errorf("AdjustGrade(%d) = %d, want %d from applying penalty then curve", tt.base, got, manual)
+			}
+		})
+	}
+
+	invalid := &pb.Assignment{Name: "lab2", Deadline: "not a date", LatePenalty: 10}
+	if got := AdjustGrade(70, invalid, threeDaysLate, score.CurvePolicy{}); got != 70 {
+		t.Errorf("AdjustGrade() with invalid deadline = %d, want 70", got)
+	}
+}
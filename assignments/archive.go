@@ -0,0 +1,446 @@
+package assignments
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/scm"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// MaxArchiveSize is the largest assignment archive, in bytes, that can be exported
+	// and imported. The server's gRPC message size limit must allow archives of this size.
+	MaxArchiveSize = 32 << 20
+
+	// maxExtractedSize is the largest total size of the files extracted from an assignment archive.
+	maxExtractedSize = 100 << 20
+)
+
+// ExportAssignments writes the files of the named assignments in the tests repository
+// of the given course to w, as a gzipped tar archive, or the files of all assignments
+// if no names are given. The archive holds each assignment's folder, including the
+// assignment file, criteria, scripts, Dockerfile and test code, along with the
+// course's scripts folder, such that the assignments can be imported into another
+// course with ImportAssignments. An error is returned if the archive is larger
+// than MaxArchiveSize.
+func ExportAssignments(ctx context.Context, logger *zap.SugaredLogger, sc scm.SCM, course *pb.Course, w io.Writer, names ...string) error {
+	cloneDir, err := cloneTestsRepo(ctx, logger, sc, course)
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(cloneDir)
+	return writeArchive(&limitedWriter{w: w, n: MaxArchiveSize}, filepath.Join(cloneDir, pb.TestsRepo), names)
+}
+
+// ImportAssignments adds the assignments in the given archive, as written by
+// ExportAssignments, to the tests repository of the given course, and updates
+// the course's assignments from the tests repository, as when the repository is
+// pushed to; the assignments' images are built and their criteria and tasks are
+// stored. It returns the imported assignments. An imported assignment keeps its
+// assignmentid, which must not be used by another assignment in the course.
+// Assignments of the same name in the tests repository are replaced by the
+// archive's assignments, while the course's scripts are kept; only scripts missing
+// from the course's scripts folder are added. Nothing is pushed to the tests
+// repository if the archive's assignments are invalid or conflict with the course's.
+func ImportAssignments(ctx context.Context, logger *zap.SugaredLogger, db database.Database, sc scm.SCM, course *pb.Course, r io.Reader) ([]*pb.Assignment, error) {
+	dir, err := ioutil.TempDir("", "archive")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	if err := extractArchive(r, dir); err != nil {
+		return nil, err
+	}
+	imported, _, err := parseAssignments(dir, course.GetID())
+	if err != nil {
+		return nil, err
+	}
+	if len(imported) == 0 {
+		return nil, fmt.Errorf("archive has no assignments")
+	}
+	stored, err := storedAssignments(db, course.GetID())
+	if err != nil {
+		return nil, err
+	}
+	if err := checkImportConflicts(stored, imported, course); err != nil {
+		return nil, err
+	}
+
+	cloneDir, err := cloneTestsRepo(ctx, logger, sc, course)
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(cloneDir)
+	repoDir := filepath.Join(cloneDir, pb.TestsRepo)
+	if err := copyArchive(dir, repoDir); err != nil {
+		return nil, err
+	}
+	// check the tests repository with the imported assignments before pushing it
+	if _, _, err := parseAssignments(cloneDir, course.GetID()); err != nil {
+		return nil, err
+	}
+	if err := pushTestsRepo(ctx, logger, repoDir, "Import "+strings.Join(assignmentNames(imported), ", ")); err != nil {
+		return nil, fmt.Errorf("failed to push imported assignments to '%s' repository: %w", pb.TestsRepo, err)
+	}
+	if err := updateFromTestsRepo(ctx, logger, db, sc, course); err != nil {
+		return nil, err
+	}
+
+	assignments := make([]*pb.Assignment, 0, len(imported))
+	for _, assignment := range imported {
+		stored, err := db.GetAssignment(&pb.Assignment{CourseID: course.GetID(), Name: assignment.GetName()})
+		if err != nil {
+			return nil, err
+		}
+		assignments = append(assignments, stored)
+	}
+	return assignments, nil
+}
+
+// checkImportConflicts returns an error if an imported assignment would replace a stored
+// assignment with another name, or if an assignment of the same name has another order.
+func checkImportConflicts(stored, imported []*pb.Assignment, course *pb.Course) error {
+	for _, assignment := range imported {
+		for _, old := range stored {
+			switch {
+			case old.GetOrder() == assignment.GetOrder() && old.GetName() != assignment.GetName():
+				return fmt.Errorf("assignment %s has the same assignmentid %d as assignment %s in course %s",
+					assignment.GetName(), assignment.GetOrder(), old.GetName(), course.GetCode())
+			case old.GetName() == assignment.GetName() && old.GetOrder() != assignment.GetOrder():
+				return fmt.Errorf("assignment %s has assignmentid %d, but has assignmentid %d in course %s",
+					assignment.GetName(), assignment.GetOrder(), old.GetOrder(), course.GetCode())
+			}
+		}
+	}
+	return nil
+}
+
+// writeArchive writes the folders of the named assignments in the tests repository
+// found in root, or of all assignments if no names are given, and the scripts folder
+// to w as a gzipped tar archive. The assignments defined in the repository's manifest,
+// if any, are written to a manifest holding only the named assignments.
+func writeArchive(w io.Writer, root string, names []string) error {
+	folders, manifest, err := assignmentFolders(root)
+	if err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		for name := range folders {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	dirs := make([]string, 0, len(names)+1)
+	exported := make(map[string]interface{})
+	for _, name := range names {
+		folder, ok := folders[name]
+		if !ok {
+			return fmt.Errorf("assignment %s not found in '%s' repository", name, pb.TestsRepo)
+		}
+		dirs = append(dirs, folder)
+		if data, ok := manifest[name]; ok {
+			exported[name] = data
+		}
+	}
+	if info, err := os.Stat(filepath.Join(root, scriptFolder)); err == nil && info.IsDir() {
+		dirs = append(dirs, scriptFolder)
+	}
+
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+	if len(exported) > 0 {
+		contents, err := yaml.Marshal(exported)
+		if err != nil {
+			return err
+		}
+		if err := writeArchiveFile(tw, manifestFile, 0644, contents); err != nil {
+			return err
+		}
+	}
+	for _, dir := range dirs {
+		if err := writeArchiveDir(tw, root, dir); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+// assignmentFolders returns the folders of the assignments in the tests repository
+// found in root, relative to root and keyed by assignment name, along with the
+// assignments defined in the repository's manifest, if any.
+func assignmentFolders(root string) (map[string]string, map[string]interface{}, error) {
+	folders := make(map[string]string)
+	var manifest map[string]interface{}
+	contents, err := ioutil.ReadFile(filepath.Join(root, manifestFile))
+	switch {
+	case err == nil:
+		if err := yaml.Unmarshal(contents, &manifest); err != nil {
+			return nil, nil, fmt.Errorf("error unmarshalling %s: %w", manifestFile, err)
+		}
+		for name := range manifest {
+			folders[name] = name
+		}
+	case !os.IsNotExist(err):
+		return nil, nil, err
+	}
+	err = filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if info.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			if depth := walkDepth(root, path); depth > MaxWalkDepth {
+				return fmt.Errorf("directory %s exceeds maximum depth %d", path, MaxWalkDepth)
+			}
+			return nil
+		}
+		switch info.Name() {
+		case target, targetYaml, targetJSON, targetTOML:
+			dir := filepath.Dir(path)
+			if dir == root {
+				return nil
+			}
+			folders[filepath.Base(dir)] = relPath(root, dir)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return folders, manifest, nil
+}
+
+// copyArchive copies the assignments and scripts extracted from an archive into dir
+// to the clone of a tests repository in repoDir. An assignment's folder replaces the
+// folder of the assignment of the same name, if any, and the assignment is defined
+// like the repository's other assignments: in the repository's manifest, if it has
+// one, and otherwise in an assignment file in the assignment's folder. Only scripts
+// missing from the repository's scripts folder are copied.
+func copyArchive(dir, repoDir string) error {
+	folders, manifest, err := assignmentFolders(dir)
+	if err != nil {
+		return err
+	}
+	repoFolders, repoManifest, err := assignmentFolders(repoDir)
+	if err != nil {
+		return err
+	}
+	for name, folder := range folders {
+		repoFolder, ok := repoFolders[name]
+		if !ok {
+			repoFolder = folder
+		}
+		dst := filepath.Join(repoDir, repoFolder)
+		if err := os.RemoveAll(dst); err != nil {
+			return err
+		}
+		if err := copyDir(filepath.Join(dir, folder), dst, false); err != nil {
+			return err
+		}
+		data, inManifest := manifest[name]
+		switch {
+		case repoManifest != nil && !inManifest:
+			// move the assignment file into the repository's manifest
+			if data, err = removeAssignmentFile(dst); err != nil {
+				return err
+			}
+			repoManifest[name] = data
+		case repoManifest != nil:
+			repoManifest[name] = data
+		case inManifest:
+			contents, err := yaml.Marshal(data)
+			if err != nil {
+				return err
+			}
+			if err := ioutil.WriteFile(filepath.Join(dst, target), contents, 0644); err != nil {
+				return err
+			}
+		}
+	}
+	if repoManifest != nil {
+		contents, err := yaml.Marshal(repoManifest)
+		if err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(filepath.Join(repoDir, manifestFile), contents, 0644); err != nil {
+			return err
+		}
+	}
+	if info, err := os.Stat(filepath.Join(dir, scriptFolder)); err == nil && info.IsDir() {
+		return copyDir(filepath.Join(dir, scriptFolder), filepath.Join(repoDir, scriptFolder), true)
+	}
+	return nil
+}
+
+// removeAssignmentFile removes the assignment file in the given folder, and returns its contents.
+func removeAssignmentFile(folder string) (interface{}, error) {
+	for _, filename := range []string{target, targetYaml, targetJSON, targetTOML} {
+		path := filepath.Join(folder, filename)
+		contents, err := ioutil.ReadFile(path)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if contents, err = normalizeAssignmentFile(filename, contents); err != nil {
+			return nil, err
+		}
+		var data interface{}
+		if err := yaml.Unmarshal(contents, &data); err != nil {
+			return nil, fmt.Errorf("error unmarshalling %s: %w", filename, err)
+		}
+		return data, os.Remove(path)
+	}
+	return nil, fmt.Errorf("no assignment file in %s", filepath.Base(folder))
+}
+
+// copyDir copies the regular files in the src folder to the dst folder, creating the dst
+// folder and its subfolders as needed. If keepExisting is true, existing files are kept.
+func copyDir(src, dst string, keepExisting bool) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, relPath(src, path))
+		if info.IsDir() {
+			return os.MkdirAll(target, 0755)
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		if _, err := os.Stat(target); err == nil && keepExisting {
+			return nil
+		}
+		contents, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return ioutil.WriteFile(target, contents, info.Mode().Perm())
+	})
+}
+
+// writeArchiveDir writes the regular files in the given folder, relative to root, to tw.
+func writeArchiveDir(tw *tar.Writer, root, dir string) error {
+	return filepath.Walk(filepath.Join(root, dir), func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			// directories are created when extracting their files; links are not exported
+			return nil
+		}
+		contents, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return writeArchiveFile(tw, relPath(root, path), info.Mode().Perm(), contents)
+	})
+}
+
+// writeArchiveFile writes a regular file with the given name, permissions and contents to tw.
+func writeArchiveFile(tw *tar.Writer, name string, perm os.FileMode, contents []byte) error {
+	if err := tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Mode:     int64(perm),
+		Size:     int64(len(contents)),
+	}); err != nil {
+		return err
+	}
+	_, err := tw.Write(contents)
+	return err
+}
+
+// extractArchive extracts the gzipped tar archive read from r into dir.
+// An error is returned if the archive has entries other than regular files
+// and directories, entries outside dir, or files larger than maxExtractedSize
+// in total.
+func extractArchive(r io.Reader, dir string) error {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return fmt.Errorf("invalid assignment archive: %w", err)
+	}
+	defer gr.Close()
+
+	tr := tar.NewReader(gr)
+	var size int64
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("invalid assignment archive: %w", err)
+		}
+		name := path.Clean(hdr.Name)
+		if path.IsAbs(name) || name == ".." || strings.HasPrefix(name, "../") {
+			return fmt.Errorf("invalid path %q in assignment archive", hdr.Name)
+		}
+		target := filepath.Join(dir, filepath.FromSlash(name))
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if size += hdr.Size; size > maxExtractedSize {
+				return fmt.Errorf("assignment archive's files exceed %d bytes", maxExtractedSize)
+			}
+			if err := extractFile(tr, target, hdr.FileInfo().Mode().Perm(), hdr.Size); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unsupported entry %q in assignment archive", hdr.Name)
+		}
+	}
+}
+
+// extractFile writes the given number of bytes read from r to a new file at target.
+func extractFile(r io.Reader, target string, perm os.FileMode, size int64) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(target, os.O_CREATE|os.O_EXCL|os.O_WRONLY, perm|0600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.CopyN(f, r, size); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// limitedWriter writes to w, failing writes beyond the first n bytes.
+type limitedWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (l *limitedWriter) Write(p []byte) (int, error) {
+	if int64(len(p)) > l.n {
+		return 0, fmt.Errorf("assignment archive exceeds %d bytes", MaxArchiveSize)
+	}
+	l.n -= int64(len(p))
+	return l.w.Write(p)
+}
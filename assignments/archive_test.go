@@ -0,0 +1,317 @@
+package assignments
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/scm"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+)
+
+// archiveFiles returns the contents of the files in the given archive, keyed by name.
+func archiveFiles(t *testing.T, archive []byte) map[string]string {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "archive")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := extractArchive(bytes.NewReader(archive), dir); err != nil {
+		t.Fatal(err)
+	}
+	files := make(map[string]string)
+	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		contents, err := ioutil.ReadFile(path)
+		files[relPath(dir, path)] = string(contents)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return files
+}
+
+func TestWriteArchive(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": "assignmentid: 1\ndeadline: 2022-09-01 23:59\n",
+		"lab1/criteria.json":  criteria,
+		"lab1/fib_test.go":    "package lab1\n",
+		"lab1/Dockerfile":     "FROM golang",
+		"lab2/assignment.yml": "assignmentid: 2\ndeadline: 2022-09-15 23:59\n",
+		"scripts/run.sh":      "#image/quickfeed:go\n",
+		"grading.yml":         "A: 90\n",
+		".git/HEAD":           "ref: refs/heads/main\n",
+	})
+
+	var archive bytes.Buffer
+	if err := writeArchive(&archive, testsDir, []string{"lab1"}); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{
+		"lab1/assignment.yml": "assignmentid: 1\ndeadline: 2022-09-01 23:59\n",
+		"lab1/criteria.json":  criteria,
+		"lab1/fib_test.go":    "package lab1\n",
+		"lab1/Dockerfile":     "FROM golang",
+		"scripts/run.sh":      "#image/quickfeed:go\n",
+	}
+	if diff := cmp.Diff(want, archiveFiles(t, archive.Bytes())); diff != "" {
+		t.Errorf("writeArchive() mismatch (-want +got):\n%s", diff)
+	}
+
+	archive.Reset()
+	if err := writeArchive(&archive, testsDir, nil); err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for name := range archiveFiles(t, archive.Bytes()) {
+		got = append(got, name)
+	}
+	sort.Strings(got)
+	wantNames := []string{"lab1/Dockerfile", "lab1/assignment.yml", "lab1/criteria.json", "lab1/fib_test.go", "lab2/assignment.yml", "scripts/run.sh"}
+	if diff := cmp.Diff(wantNames, got); diff != "" {
+		t.Errorf("writeArchive() of all assignments mismatch (-want +got):\n%s", diff)
+	}
+
+	if err := writeArchive(&archive, testsDir, []string{"lab3"}); err == nil {
+		t.Error("writeArchive() succeeded for unknown assignment, want error")
+	}
+}
+
+func TestWriteArchiveManifest(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		"assignments.yml":  "lab1:\n  assignmentid: 1\n  language: go\nlab2:\n  assignmentid: 2\n  language: go\n",
+		"lab1/fib_test.go": "package lab1\n",
+		"lab2/sum_test.go": "package lab2\n",
+	})
+	var archive bytes.Buffer
+	if err := writeArchive(&archive, testsDir, []string{"lab2"}); err != nil {
+		t.Fatal(err)
+	}
+	files := archiveFiles(t, archive.Bytes())
+	if _, ok := files["lab1/fib_test.go"]; ok {
+		t.Error("writeArchive() exported lab1, want only lab2")
+	}
+	dir := createTestsRepo(t, files)
+	assignments, _, err := parseAssignments(dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"lab2"}, assignmentNames(assignments)); diff != "" {
+		t.Errorf("parseAssignments() of exported manifest mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestExtractArchiveInvalid(t *testing.T) {
+	makeArchive := func(hdr *tar.Header) []byte {
+		var buf bytes.Buffer
+		gw := gzip.NewWriter(&buf)
+		tw := tar.NewWriter(gw)
+		if err := tw.WriteHeader(hdr); err != nil {
+			t.Fatal(err)
+		}
+		if hdr.Size > 0 {
+			if _, err := tw.Write(bytes.Repeat([]byte("x"), int(hdr.Size))); err != nil {
+				t.Fatal(err)
+			}
+		}
+		tw.Close()
+		gw.Close()
+		return buf.Bytes()
+	}
+	tests := map[string][]byte{
+		"not gzip":      []byte("lab1/assignment.yml"),
+		"parent path":   makeArchive(&tar.Header{Typeflag: tar.TypeReg, Name: "../lab1/run.sh", Mode: 0644, Size: 1}),
+		"absolute path": makeArchive(&tar.Header{Typeflag: tar.TypeReg, Name: "/etc/passwd", Mode: 0644, Size: 1}),
+		"symlink":       makeArchive(&tar.Header{Typeflag: tar.TypeSymlink, Name: "lab1/run.sh", Linkname: "/etc/passwd"}),
+	}
+	for name, archive := range tests {
+		t.Run(name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "archive")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+			if err := extractArchive(bytes.NewReader(archive), dir); err == nil {
+				t.Error("extractArchive() succeeded, want error")
+			}
+		})
+	}
+}
+
+// testsRepoSCM is a fake SCM whose tests repository is cloned from a local repository.
+type testsRepoSCM struct {
+	scm.SCM
+	cloneURL string
+}
+
+func (s *testsRepoSCM) CreateCloneURL(*scm.URLPathOptions) string {
+	return s.cloneURL
+}
+
+// createBareTestsRepo creates a bare tests repository holding the given files,
+// and returns its path, which is cloned into a folder named tests.
+func createBareTestsRepo(t *testing.T, files map[string]string) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("requires git")
+	}
+	work := createTestsRepo(t, files)
+	bare := filepath.Join(t.TempDir(), pb.TestsRepo+".git")
+	gitCommands(t,
+		[]string{"-C", work, "init", "--quiet"},
+		[]string{"-C", work, "add", "."},
+		[]string{"-C", work, "-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "--quiet", "-m", "init"},
+		[]string{"clone", "--quiet", "--bare", work, bare},
+	)
+	return bare
+}
+
+// gitCommands runs git with each of the given arguments, and returns the output of the last run.
+func gitCommands(t *testing.T, args ...[]string) string {
+	t.Helper()
+	var out []byte
+	for _, arg := range args {
+		var err error
+		if out, err = exec.Command("git", arg...).CombinedOutput(); err != nil {
+			t.Fatalf("git %s failed: %v\n%s", strings.Join(arg, " "), err, out)
+		}
+	}
+	return string(out)
+}
+
+func TestImportAssignments(t *testing.T) {
+	bare := createBareTestsRepo(t, map[string]string{
+		"intro/assignment.yml": "assignmentid: 1\nlanguage: go\n",
+		"intro/intro_test.go":  "package intro\n",
+		"scripts/run.sh":       "#image/quickfeed:go\n# course script\n",
+	})
+	sc := &testsRepoSCM{SCM: scm.NewFakeSCMClient(), cloneURL: bare}
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+	admin := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Code: "DAT320"}
+	qtest.CreateCourse(t, db, admin, course)
+	if err := db.CreateAssignment(&pb.Assignment{CourseID: course.ID, Name: "intro", Order: 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	testsDir := createTestsRepo(t, map[string]string{
+		"lab2/assignment.yml": "assignmentid: 2\ndeadline: 2022-09-15 23:59\n",
+		"lab2/criteria.json":  criteria,
+		"lab2/run.sh":         "#image/quickfeed:go\n",
+		"lab3/assignment.yml": "assignmentid: 3\ndeadline: 2022-09-30 23:59\nlanguage: go\n",
+		"scripts/run.sh":      "#image/quickfeed:go\n# archive script\n",
+		"scripts/Makefile":    "test:\n",
+	})
+	var archive bytes.Buffer
+	if err := writeArchive(&archive, testsDir, nil); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	logger := zap.NewNop().Sugar()
+	imported, err := ImportAssignments(ctx, logger, db, sc, course, bytes.NewReader(archive.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"lab2", "lab3"}, assignmentNames(imported)); diff != "" {
+		t.Errorf("ImportAssignments() mismatch (-want +got):\n%s", diff)
+	}
+	stored, err := storedAssignments(db, course.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"intro", "lab2", "lab3"}, assignmentNames(stored)); diff != "" {
+		t.Errorf("course assignments mismatch (-want +got):\n%s", diff)
+	}
+	for _, assignment := range stored {
+		if assignment.GetCourseID() != course.ID {
+			t.Errorf("CourseID of %s = %d, want %d", assignment.GetName(), assignment.GetCourseID(), course.ID)
+		}
+	}
+	lab2, err := db.GetAssignment(&pb.Assignment{CourseID: course.ID, Name: "lab2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	benchmarks, err := db.GetBenchmarks(lab2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(benchmarks) != 2 {
+		t.Errorf("lab2 has %d benchmarks, want 2", len(benchmarks))
+	}
+
+	// the archive's files are pushed to the tests repository, keeping the course's scripts
+	files := gitCommands(t, []string{"--git-dir", bare, "ls-tree", "-r", "--name-only", "HEAD"})
+	wantFiles := "intro/assignment.yml\nintro/intro_test.go\nlab2/assignment.yml\nlab2/criteria.json\nlab2/run.sh\nlab3/assignment.yml\nscripts/Makefile\nscripts/run.sh\n"
+	if diff := cmp.Diff(wantFiles, files); diff != "" {
+		t.Errorf("tests repository files mismatch (-want +got):\n%s", diff)
+	}
+	if got := gitCommands(t, []string{"--git-dir", bare, "show", "HEAD:scripts/run.sh"}); !strings.Contains(got, "course script") {
+		t.Errorf("scripts/run.sh = %q, want the course's script", got)
+	}
+
+	// importing the same archive again updates the assignments in place
+	if _, err := ImportAssignments(ctx, logger, db, sc, course, bytes.NewReader(archive.Bytes())); err != nil {
+		t.Fatal(err)
+	}
+	if again, err := db.GetBenchmarks(lab2); err != nil || len(again) != 2 || again[0].GetID() != benchmarks[0].GetID() {
+		t.Errorf("GetBenchmarks() after reimport = %v, %v, want the same 2 benchmarks", again, err)
+	}
+
+	conflicting := createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": "assignmentid: 1\nlanguage: go\n",
+	})
+	archive.Reset()
+	if err := writeArchive(&archive, conflicting, nil); err != nil {
+		t.Fatal(err)
+	}
+	_, err = ImportAssignments(ctx, logger, db, sc, course, bytes.NewReader(archive.Bytes()))
+	if err == nil || !strings.Contains(err.Error(), "intro") {
+		t.Errorf("ImportAssignments() = %v, want error for assignmentid of intro", err)
+	}
+	if got := gitCommands(t, []string{"--git-dir", bare, "ls-tree", "-r", "--name-only", "HEAD"}); got != wantFiles {
+		t.Errorf("tests repository files after conflicting import = %q, want %q", got, wantFiles)
+	}
+}
+
+func TestCopyArchiveManifest(t *testing.T) {
+	archiveDir := createTestsRepo(t, map[string]string{
+		"lab2/assignment.json": `{"assignmentid": 2, "language": "go"}`,
+		"lab2/sum_test.go":     "package lab2\n",
+	})
+	repoDir := createTestsRepo(t, map[string]string{
+		"assignments.yml":  "lab1:\n  assignmentid: 1\n  language: go\n",
+		"lab1/fib_test.go": "package lab1\n",
+	})
+	if err := copyArchive(archiveDir, repoDir); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(repoDir, "lab2", targetJSON)); !os.IsNotExist(err) {
+		t.Errorf("copyArchive() kept lab2's assignment file, want it moved to %s", manifestFile)
+	}
+	assignments, _, err := parseAssignments(repoDir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"lab1", "lab2"}, assignmentNames(assignments)); diff != "" {
+		t.Errorf("parseAssignments() after copyArchive() mismatch (-want +got):\n%s", diff)
+	}
+	if lab2 := assignments[1]; lab2.GetOrder() != 2 || lab2.GetLanguage() != "go" {
+		t.Errorf("lab2 = %v, want assignmentid 2 and language go", lab2)
+	}
+}
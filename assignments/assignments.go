@@ -2,11 +2,13 @@ package assignments
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/ci"
@@ -19,6 +21,8 @@ import (
 )
 
 // UpdateFromTestsRepo updates the database record for the course assignments.
+// Only new and changed assignments are updated, and assignments removed from
+// the tests repository are deleted, unless they have submissions.
 func UpdateFromTestsRepo(logger *zap.SugaredLogger, db database.Database, course *pb.Course) {
 	logger.Debugf("Updating %s from '%s' repository", course.GetCode(), pb.TestsRepo)
 	s, err := scm.NewSCMClient(logger, course.GetProvider(), course.GetAccessToken())
@@ -26,35 +30,81 @@ func UpdateFromTestsRepo(logger *zap.SugaredLogger, db database.Database, course
 		logger.Errorf("Failed to create SCM Client: %v", err)
 		return
 	}
-	assignments, dockerfile, err := fetchAssignments(context.Background(), logger, s, course)
+	if err := updateFromTestsRepo(context.Background(), logger, db, s, course); err != nil {
+		logger.Errorf("Failed to update assignments for %s: %v", course.GetCode(), err)
+	}
+}
+
+// updateFromTestsRepo updates the course and its assignments from the course's
+// tests repository, as described for UpdateFromTestsRepo.
+func updateFromTestsRepo(ctx context.Context, logger *zap.SugaredLogger, db database.Database, sc scm.SCM, course *pb.Course) error {
+	assignments, data, err := fetchAssignments(ctx, logger, sc, course)
 	if err != nil {
-		logger.Errorf("Failed to fetch assignments from '%s' repository: %v", pb.TestsRepo, err)
-		return
+		return fmt.Errorf("failed to fetch assignments from '%s' repository: %w", pb.TestsRepo, err)
+	}
+	for _, warning := range checkFolderOrders(assignments) {
+		logger.Warnf("%s: %s", course.GetCode(), warning)
+	}
+	stored, err := storedAssignments(db, course.GetID())
+	if err != nil {
+		return fmt.Errorf("failed to get assignments from database: %w", err)
 	}
+	updated, removed := diffAssignments(stored, assignments)
 	for _, assignment := range assignments {
 		updateGradingCriteria(logger, db, assignment)
 	}
-	if dockerfile != "" && dockerfile != course.Dockerfile {
+	updateCourse := false
+	if dockerfile := data.dockerfile; dockerfile != "" && dockerfile != course.Dockerfile {
 		course.Dockerfile = dockerfile
+		updateCourse = true
+	}
+	if lateDays := data.lateDays; lateDays != nil && *lateDays != course.SlipDays {
+		course.SlipDays = *lateDays
+		updateCourse = true
+	}
+	if timeZone := data.timeZone; timeZone != "" && timeZone != course.DisplayTimeZone {
+		course.DisplayTimeZone = timeZone
+		updateCourse = true
+	}
+	if updateCourse {
 		if err := db.UpdateCourse(course); err != nil {
-			logger.Debugf("Failed to update Dockerfile for course %s: %s", course.GetCode(), err)
-			return
+			return fmt.Errorf("failed to update Dockerfile, late days or time zone: %w", err)
 		}
 	}
-	if err = db.UpdateAssignments(assignments); err != nil {
-		for _, assignment := range assignments {
+	if err = db.UpdateAssignments(updated); err != nil {
+		for _, assignment := range updated {
 			logger.Debugf("Failed to update database for: %v", assignment)
 		}
-		logger.Errorf("Failed to update assignments in database: %v", err)
-		return
+		return fmt.Errorf("failed to update assignments in database: %w", err)
+	}
+	for _, assignment := range removed {
+		if err := db.DeleteAssignment(assignment); err != nil {
+			if errors.Is(err, database.ErrAssignmentHasSubmissions) {
+				logger.Warnf("%s: assignment %s was removed from '%s' repo, but is kept since it has submissions",
+					course.GetCode(), assignment.GetName(), pb.TestsRepo)
+				continue
+			}
+			return fmt.Errorf("failed to delete assignment %s from database: %w", assignment.GetName(), err)
+		}
+	}
+	if err := synchronizeTasks(ctx, logger, db, sc, course, assignments); err != nil {
+		return fmt.Errorf("failed to synchronize tasks with student repositories: %w", err)
+	}
+	// without a modules.yml file, the course's modules are removed
+	if err := db.UpdateCourseModules(course.GetID(), data.modules); err != nil {
+		return fmt.Errorf("failed to update modules: %w", err)
 	}
-	logger.Debugf("Assignments for %s successfully updated from '%s' repo", course.GetCode(), pb.TestsRepo)
+	logger.Debugf("Assignments for %s successfully updated from '%s' repo: %d created or updated, %d removed",
+		course.GetCode(), pb.TestsRepo, len(updated), len(removed))
+	return nil
 }
 
 // fetchAssignments returns a list of assignments for the given course, by
 // cloning the 'tests' repo for the given course and extracting the assignments
-// from the 'assignment.yml' files, one for each assignment. If there is a Dockerfile
-// in 'tests/script' will also return its contents.
+// from the 'assignment.yml' files, one for each assignment. It also returns
+// course-wide data, such as the contents of the Dockerfile in 'tests/script',
+// the grading scale in 'tests/grading.yml', the late days in 'tests/course.yml'
+// and the modules in 'tests/modules.yml', if present.
 //
 // Note: This will typically be called on a push event to the 'tests' repo,
 // which should happen infrequently. It may also be called manually by a
@@ -63,7 +113,73 @@ func UpdateFromTestsRepo(logger *zap.SugaredLogger, db database.Database, course
 // data from GitHub, processes the yml files and returns the assignments.
 // The TempDir() function ensures that cloning is done in distinct temp
 // directories, should there be concurrent calls to this function.
-func fetchAssignments(c context.Context, logger *zap.SugaredLogger, sc scm.SCM, course *pb.Course) ([]*pb.Assignment, string, error) {
+func fetchAssignments(c context.Context, logger *zap.SugaredLogger, sc scm.SCM, course *pb.Course) ([]*pb.Assignment, *courseData, error) {
+	cloneDir, err := cloneTestsRepo(c, logger, sc, course)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer os.RemoveAll(cloneDir)
+
+	// parse assignments found in the cloned tests directory;
+	// all problems with the assignment files are reported at once
+	assignments, data, err := parseAssignments(cloneDir, course.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, warning := range data.warnings {
+		logger.Warnf("Assignment file in %s's %s repository: %v", course.GetCode(), pb.TestsRepo, warning)
+	}
+
+	job := &ci.Job{}
+	runner := ci.Local{}
+	// build the course's image from its Dockerfile, tagged with the course code, once
+	// per update rather than per test run; the image is labeled with the Dockerfile's
+	// hash, and an unchanged image is quickly rebuilt from docker's build cache
+	if dockerfile := data.dockerfile; dockerfile != "" {
+		buildDir := filepath.Join(cloneDir, pb.TestsRepo, scriptFolder)
+		buildCmd := ci.BuildImageCommand(strings.ToLower(course.GetCode()), dockerfile)
+		job.Commands = []string{
+			"cd " + buildDir,
+			"ls -la",
+			"cat Dockerfile",
+			buildCmd,
+		}
+		logger.Debugf("cd %v", buildDir)
+		logger.Debugf(buildCmd)
+
+		if out, err := runner.Run(context.Background(), job); err != nil {
+			logger.Errorf("Failed to build image from %s's Dockerfile: %s", course.GetCode(), err)
+			logger.Debug(out)
+		}
+	}
+
+	// build the images of assignments with their own Dockerfile;
+	// unchanged images are quickly rebuilt from docker's build cache
+	for _, assignment := range assignments {
+		if assignment.GetDockerfile() == "" {
+			continue
+		}
+		image := assignment.BuildImageName(course.GetCode())
+		buildCmd := ci.BuildImageCommand(image, assignment.GetDockerfile())
+		job.Commands = []string{
+			"cd " + data.dockerDirs[assignment.GetName()],
+			buildCmd,
+		}
+		logger.Debugf("cd %v", data.dockerDirs[assignment.GetName()])
+		logger.Debugf(buildCmd)
+
+		if out, err := runner.Run(context.Background(), job); err != nil {
+			logger.Errorf("Failed to build image %s from %s's Dockerfile: %s", image, assignment.GetName(), err)
+			logger.Debug(out)
+		}
+	}
+	return assignments, data, nil
+}
+
+// cloneTestsRepo clones the 'tests' repository of the given course into a new
+// temporary directory, and returns the directory. The caller must remove the
+// directory when done with it.
+func cloneTestsRepo(c context.Context, logger *zap.SugaredLogger, sc scm.SCM, course *pb.Course) (string, error) {
 	ctx, cancel := context.WithTimeout(c, pb.MaxWait)
 	defer cancel()
 
@@ -73,9 +189,8 @@ func fetchAssignments(c context.Context, logger *zap.SugaredLogger, sc scm.SCM,
 	})
 	cloneDir, err := ioutil.TempDir("", pb.TestsRepo)
 	if err != nil {
-		return nil, "", err
+		return "", err
 	}
-	defer os.RemoveAll(cloneDir)
 
 	// clone the tests repository to cloneDir
 	job := &ci.Job{
@@ -88,94 +203,81 @@ func fetchAssignments(c context.Context, logger *zap.SugaredLogger, sc scm.SCM,
 	logger.Debugf("git clone %v", cloneURL)
 
 	runner := ci.Local{}
-	_, err = runner.Run(ctx, job)
-	if err != nil {
-		return nil, "", err
-	}
-
-	// parse assignments found in the cloned tests directory
-	assignments, dockerfile, err := parseAssignments(cloneDir, course.ID)
-	if err != nil {
-		return nil, "", err
+	if _, err := runner.Run(ctx, job); err != nil {
+		os.RemoveAll(cloneDir)
+		return "", err
 	}
+	return cloneDir, nil
+}
 
-	// if a Dockerfile added/updated, build docker image locally
-	// tag the image with the course code
-	if dockerfile != "" && dockerfile != course.Dockerfile {
-		buildDir := filepath.Join(cloneDir, pb.TestsRepo, scriptFolder)
-		buildCmd := fmt.Sprintf("docker build -t %s .", strings.ToLower(course.GetCode()))
-		job.Commands = []string{
-			"cd " + buildDir,
-			"ls -la",
-			"cat Dockerfile",
-			buildCmd,
-		}
-		logger.Debugf("cd %v", buildDir)
-		logger.Debugf(buildCmd)
+// pushTestsRepo commits the changes to the clone of a tests repository in repoDir, if any,
+// with the given commit message, and pushes the commit to the tests repository.
+func pushTestsRepo(c context.Context, logger *zap.SugaredLogger, repoDir, message string) error {
+	ctx, cancel := context.WithTimeout(c, pb.MaxWait)
+	defer cancel()
 
-		if out, err := runner.Run(context.Background(), job); err != nil {
-			logger.Errorf("Failed to build image from %s's Dockerfile: %s", course.GetCode(), err)
-			logger.Debug(out)
-		}
+	job := &ci.Job{
+		Commands: []string{
+			"set -e",
+			"cd " + repoDir,
+			"git add -A",
+			`git diff --cached --quiet || git -c user.name=QuickFeed -c user.email=quickfeed@localhost commit --quiet -m "$QUICKFEED_COMMIT_MESSAGE"`,
+			"git push --quiet origin HEAD",
+		},
+		Env: []string{"QUICKFEED_COMMIT_MESSAGE=" + message},
 	}
-	return assignments, dockerfile, nil
+	logger.Debugf("cd %v", repoDir)
+	logger.Debugf("git push origin HEAD: %s", message)
+
+	runner := ci.Local{}
+	_, err := runner.Run(ctx, job)
+	return err
 }
 
-// updateGradingCriteria will remove old grading criteria and related reviews when criteria.json gets updated
+// updateGradingCriteria updates the stored grading criteria of the given assignment
+// when its criteria.json file has changed. The stored benchmarks and criteria are
+// updated in place, such that the reviews of the assignment's submissions are kept.
 func updateGradingCriteria(logger *zap.SugaredLogger, db database.Database, assignment *pb.Assignment) {
-	if len(assignment.GetGradingBenchmarks()) > 0 {
-		savedAssignment, err := db.GetAssignment(&pb.Assignment{
-			CourseID: assignment.CourseID,
-			Name:     assignment.Name,
-		})
-		if err != nil {
-			if err == gorm.ErrRecordNotFound {
-				// a new assignment, no actions required
-				return
-			}
-			logger.Debugf("Failed to fetch assignment %s from database: %s", assignment.Name, err)
+	if len(assignment.GetGradingBenchmarks()) == 0 {
+		return
+	}
+	savedAssignment, err := db.GetAssignment(&pb.Assignment{
+		CourseID: assignment.CourseID,
+		Name:     assignment.Name,
+	})
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			// a new assignment, no actions required
 			return
 		}
-		if len(savedAssignment.GetGradingBenchmarks()) > 0 {
-			if !cmp.Equal(assignment.GradingBenchmarks, savedAssignment.GradingBenchmarks, cmp.Options{
-				protocmp.Transform(),
-				protocmp.IgnoreFields(&pb.GradingBenchmark{}, "ID", "AssignmentID", "ReviewID"),
-				protocmp.IgnoreFields(&pb.GradingCriterion{}, "ID", "BenchmarkID"),
-				protocmp.IgnoreEnums(),
-			}) {
-				for _, bm := range savedAssignment.GradingBenchmarks {
-					for _, c := range bm.Criteria {
-						if err := db.DeleteCriterion(c); err != nil {
-							logger.Errorf("Failed to delete criteria %v: %s\n", c, err)
-							return
-						}
-					}
-					if err := db.DeleteBenchmark(bm); err != nil {
-						logger.Errorf("Failed to delete benchmark %v: %s\n", bm, err)
-						return
-					}
-				}
-				submissions, err := db.GetSubmissions(&pb.Submission{AssignmentID: assignment.GetID()})
-				if err != nil {
-					// No submissions for this assignment, nothing to update
-					return
-				}
-				for _, submission := range submissions {
-					if err := db.DeleteReview(&pb.Review{SubmissionID: submission.ID}); err != nil {
-						logger.Errorf("Failed to delete reviews for submission %s to assignment %s: %s", submission.ID, assignment.Name, err)
-						return
-					}
-				}
-			} else {
-				assignment.GradingBenchmarks = nil
-			}
-		}
-		for _, bm := range assignment.GradingBenchmarks {
-			bm.AssignmentID = assignment.ID
-			if err := db.CreateBenchmark(bm); err != nil {
-				logger.Errorf("Failed to save grading benchmark %+v: %s", bm, err)
-				return
-			}
-		}
+		logger.Debugf("Failed to fetch assignment %s from database: %s", assignment.Name, err)
+		return
+	}
+	stored, err := db.GetBenchmarks(savedAssignment)
+	if err != nil {
+		logger.Errorf("Failed to fetch grading criteria for assignment %s: %v", assignment.Name, err)
+		return
+	}
+	mergeBenchmarks(stored, assignment.GradingBenchmarks)
+	if cmp.Equal(assignment.GradingBenchmarks, stored, cmp.Options{
+		protocmp.Transform(),
+		protocmp.IgnoreFields(&pb.GradingBenchmark{}, "AssignmentID", "ReviewID"),
+		protocmp.IgnoreEnums(),
+	}) {
+		return
+	}
+	if err := db.UpdateGradingCriteria(savedAssignment.GetID(), assignment.GradingBenchmarks); err != nil {
+		logger.Errorf("Failed to update grading criteria for assignment %s: %v", assignment.Name, err)
+	}
+}
+
+// TimeUntilDeadline returns the time remaining until the deadline of the given
+// assignment. A negative duration means the deadline has passed.
+// An error is returned if the assignment's deadline cannot be parsed.
+func TimeUntilDeadline(a *pb.Assignment, now time.Time) (time.Duration, error) {
+	sinceDeadline, err := a.SinceDeadline(now)
+	if err != nil {
+		return 0, fmt.Errorf("invalid deadline for assignment %s: %w", a.GetName(), err)
 	}
+	return -sinceDeadline, nil
 }
@@ -2,13 +2,24 @@ package assignments
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
+	"math"
+	"net/url"
 	"os"
+	"path"
 	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
 	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/kit/score"
+	units "github.com/docker/go-units"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"gopkg.in/yaml.v2"
 )
@@ -17,51 +28,348 @@ const (
 	target                       = "assignment.yml"
 	targetYaml                   = "assignment.yaml"
 	criteriaFile                 = "criteria.json"
+	pointsFile                   = "points.json"
 	scriptFile                   = "run.sh"
 	scriptFolder                 = "scripts"
 	dockerfile                   = "Dockerfile"
+	gradingFile                  = "grading.yml"
+	courseFile                   = "course.yml"
+	modulesFile                  = "modules.yml"
+	manifestFile                 = "assignments.yml"
 	defaultAutoApproveScoreLimit = 80
+	defaultRetries               = 1
+	defaultExtraCreditCap        = 100
+	maxRetryOnInfra              = 5
+	maxMatrixEntries             = 5
+	maxGraceHours                = 7 * 24
+	minCPUs                      = 0.01
 )
 
+// MaxWalkDepth is the maximum directory depth, relative to the tests repository's
+// root, that parseAssignments will walk, to bound the cost of parsing pathological
+// repositories. Normal course layouts are only a few levels deep. It is set from
+// the server's -tests.depth flag, and must not be changed while assignments are parsed.
+var MaxWalkDepth = 20
+
 // assignmentData holds information about a single assignment.
 // This is only used for parsing the 'assignment.yml' file.
 // Note that the struct can be private, but the fields must be
 // public to allow parsing.
 type assignmentData struct {
-	AssignmentID     uint   `yaml:"assignmentid"`
-	Deadline         string `yaml:"deadline"`
-	AutoApprove      bool   `yaml:"autoapprove"`
-	ScoreLimit       uint   `yaml:"scorelimit"`
-	IsGroupLab       bool   `yaml:"isgrouplab"`
-	Reviewers        uint   `yaml:"reviewers"`
-	ContainerTimeout uint   `yaml:"containertimeout"`
-	SkipTests        bool   `yaml:"skiptests"`
+	AssignmentID        uint              `yaml:"assignmentid"`
+	Deadline            string            `yaml:"deadline"`
+	AutoApprove         bool              `yaml:"autoapprove"`
+	ScoreLimit          uint              `yaml:"scorelimit"`
+	IsGroupLab          bool              `yaml:"isgrouplab"`
+	Reviewers           uint              `yaml:"reviewers"`
+	ContainerTimeout    uint              `yaml:"containertimeout"`
+	PartOf              string            `yaml:"partof"`
+	Language            string            `yaml:"language"`
+	Verbose             bool              `yaml:"verbose"`
+	Retries             uint              `yaml:"retries"`
+	RetryTests          []string          `yaml:"retrytests"`
+	TestsRepo           *testsRepoData    `yaml:"testsrepo"`
+	ManualOnly          bool              `yaml:"manualonly"`
+	ReleaseDate         string            `yaml:"releasedate"`
+	CloseDate           string            `yaml:"closedate"`
+	ExtraCredit         []string          `yaml:"extracredit"`
+	ExtraCreditCap      uint              `yaml:"extracreditcap"`
+	ShowPoints          *bool             `yaml:"showpoints"`
+	RequiredFiles       []string          `yaml:"requiredfiles"`
+	ReviewerStrategy    string            `yaml:"reviewerstrategy"`
+	NotifyOnResult      *bool             `yaml:"notifyonresult"`
+	PlagiarismCheck     bool              `yaml:"plagiarismcheck"`
+	SimilarityThreshold uint              `yaml:"similaritythreshold"`
+	Network             string            `yaml:"network"`
+	AllowedHosts        []string          `yaml:"allowedhosts"`
+	CourseWeight        uint32            `yaml:"courseweight"`
+	Parallel            int               `yaml:"parallel"`
+	DiffMode            string            `yaml:"diffmode"`
+	TestFormat          string            `yaml:"testformat"`
+	LatePenalty         uint32            `yaml:"latepenalty"`
+	OutputLimit         uint32            `yaml:"outputlimit"`
+	RetryOnInfra        uint32            `yaml:"retryoninfra"`
+	Deadlines           *deadlinesData    `yaml:"deadlines"`
+	TimeZone            string            `yaml:"timezone"`
+	DockerImage         string            `yaml:"dockerimage"`
+	Requires            []string          `yaml:"requires"`
+	MaxAttempts         uint32            `yaml:"maxattempts"`
+	CooldownMinutes     uint32            `yaml:"cooldownminutes"`
+	MaxReruns           uint32            `yaml:"maxreruns"`
+	HiddenTests         []string          `yaml:"hiddentests"`
+	RandomSeed          bool              `yaml:"randomseed"`
+	Secrets             map[string]string `yaml:"secrets"`
+	Approval            *approvalData     `yaml:"approval"`
+	Limits              *limitsData       `yaml:"limits"`
+	Coverage            *coverageData     `yaml:"coverage"`
+	Analysis            []*analysisData   `yaml:"analysis"`
+	Matrix              []*matrixData     `yaml:"matrix"`
+}
+
+// approvalData holds the auto approval policies of an assignment, which
+// apply in addition to the score limit when autoapprove is enabled.
+type approvalData struct {
+	ConsecutiveRuns uint32   `yaml:"consecutiveruns"`
+	BeforeDeadline  bool     `yaml:"beforedeadline"`
+	ManualReview    bool     `yaml:"manualreview"`
+	Tests           []string `yaml:"tests"`
+}
+
+// limitsData holds the resource limits of the containers running an assignment's tests.
+// Memory and disk sizes are given as in docker, e.g., 512m or 1g.
+type limitsData struct {
+	CPUs   float64 `yaml:"cpus"`
+	Memory string  `yaml:"memory"`
+	Pids   uint32  `yaml:"pids"`
+	Disk   string  `yaml:"disk"`
+}
+
+// apply sets the resource limits of the given assignment, returning an error if a limit is invalid.
+func (l *limitsData) apply(assignment *pb.Assignment) error {
+	if l == nil {
+		return nil
+	}
+	if l.CPUs < 0 || (l.CPUs > 0 && l.CPUs < minCPUs) {
+		return fmt.Errorf("cpus %v must be at least %v", l.CPUs, minCPUs)
+	}
+	assignment.CpuLimit = uint32(math.Round(l.CPUs * 1000))
+	if l.Memory != "" {
+		memory, err := units.RAMInBytes(l.Memory)
+		if err != nil {
+			return fmt.Errorf("invalid memory: %w", err)
+		}
+		if memory < ci.MinMemoryLimit {
+			return fmt.Errorf("memory %s must be at least %s", l.Memory, units.BytesSize(ci.MinMemoryLimit))
+		}
+		assignment.MemoryLimit = uint64(memory)
+	}
+	assignment.PidsLimit = l.Pids
+	if l.Disk != "" {
+		disk, err := units.RAMInBytes(l.Disk)
+		if err != nil {
+			return fmt.Errorf("invalid disk: %w", err)
+		}
+		if disk <= 0 {
+			return fmt.Errorf("disk %s must be positive", l.Disk)
+		}
+		assignment.DiskLimit = uint64(disk)
+	}
+	return nil
+}
+
+// coverageData holds the test coverage settings of an assignment. The coverage profile
+// is a file written by the tests to the artifacts folder, in Go's coverage profile format or LCOV.
+type coverageData struct {
+	Profile string `yaml:"profile"`
+	Weight  uint32 `yaml:"weight"`
+	Target  uint32 `yaml:"target"`
+}
+
+// apply sets the coverage settings of the given assignment, returning an error if a setting is invalid.
+func (c *coverageData) apply(assignment *pb.Assignment) error {
+	if c == nil {
+		return nil
+	}
+	profile := c.Profile
+	if profile == "" {
+		profile = ci.DefaultCoverageProfile
+	}
+	if clean := path.Clean(profile); path.IsAbs(profile) || clean == ".." || strings.HasPrefix(clean, "../") {
+		return fmt.Errorf("profile %q is outside the artifacts folder", profile)
+	}
+	if c.Target > 100 {
+		return fmt.Errorf("target %d is above 100", c.Target)
+	}
+	assignment.CoverageProfile = path.Clean(profile)
+	assignment.CoverageWeight = c.Weight
+	assignment.CoverageTarget = c.Target
+	return nil
+}
+
+// analysisData holds a static analysis check run before an assignment's tests,
+// given by either a built-in tool or a shell command.
+type analysisData struct {
+	Name    string `yaml:"name"`
+	Tool    string `yaml:"tool"`
+	Command string `yaml:"command"`
+	Weight  uint32 `yaml:"weight"`
+	Penalty uint32 `yaml:"penalty"`
+}
+
+// analysisNamePattern matches the valid names of static analysis checks.
+var analysisNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// analysisChecks returns the given static analysis checks, returning an error if a check is invalid.
+func analysisChecks(data []*analysisData) ([]*pb.AnalysisCheck, error) {
+	var checks []*pb.AnalysisCheck
+	names := make(map[string]bool)
+	for _, d := range data {
+		name, command := d.Name, d.Command
+		switch {
+		case d.Tool != "" && command != "":
+			return nil, fmt.Errorf("check %s must not have both a tool and a command", d.Tool)
+		case d.Tool != "":
+			toolCommand, ok := ci.AnalysisTool(d.Tool)
+			if !ok {
+				return nil, fmt.Errorf("unknown tool %q", d.Tool)
+			}
+			command = toolCommand
+			if name == "" {
+				name = d.Tool
+			}
+		case strings.TrimSpace(command) == "":
+			return nil, fmt.Errorf("check %s must have a tool or a command", name)
+		}
+		if !analysisNamePattern.MatchString(name) {
+			return nil, fmt.Errorf("invalid check name %q", name)
+		}
+		if names[name] {
+			return nil, fmt.Errorf("check %s is defined more than once", name)
+		}
+		names[name] = true
+		if d.Penalty > 100 {
+			return nil, fmt.Errorf("check %s: penalty %d is above 100", name, d.Penalty)
+		}
+		checks = append(checks, &pb.AnalysisCheck{
+			Name:    name,
+			Command: strings.TrimSpace(command),
+			Weight:  int32(d.Weight),
+			Penalty: d.Penalty,
+		})
+	}
+	return checks, nil
+}
+
+// matrixData holds an entry of an assignment's build matrix: a docker image in which to run the tests.
+type matrixData struct {
+	Name  string `yaml:"name"`
+	Image string `yaml:"image"`
+}
+
+// matrixNamePattern matches the valid names of build matrix entries.
+var matrixNamePattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+// buildMatrix returns the given build matrix entries as comma-separated name=image
+// entries, returning an error if an entry is invalid.
+func buildMatrix(data []*matrixData) (string, error) {
+	if len(data) > maxMatrixEntries {
+		return "", fmt.Errorf("%d entries is above %d", len(data), maxMatrixEntries)
+	}
+	entries := make([]string, 0, len(data))
+	names := make(map[string]bool)
+	for _, d := range data {
+		if !matrixNamePattern.MatchString(d.Name) {
+			return "", fmt.Errorf("invalid entry name %q", d.Name)
+		}
+		if names[d.Name] {
+			return "", fmt.Errorf("entry %s is defined more than once", d.Name)
+		}
+		names[d.Name] = true
+		if d.Image == "" || strings.ContainsAny(d.Image, " \t\n,=") {
+			return "", fmt.Errorf("entry %s: invalid image %q", d.Name, d.Image)
+		}
+		entries = append(entries, d.Name+"="+d.Image)
+	}
+	return strings.Join(entries, ","), nil
+}
+
+// deadlinesData holds the deadlines of an assignment, as an alternative
+// to specifying the deadline and close date separately.
+type deadlinesData struct {
+	Soft       string `yaml:"soft"`
+	Hard       string `yaml:"hard"`
+	GraceHours uint32 `yaml:"gracehours"`
+}
+
+// graceHours returns the grace period in hours, or zero if no deadlines are given.
+func (d *deadlinesData) graceHours() uint32 {
+	if d == nil {
+		return 0
+	}
+	return d.GraceHours
+}
+
+// testsRepoData holds the reference to an external repository holding
+// the tests for an assignment, e.g., a private repository pulled at grade time.
+type testsRepoData struct {
+	URL string `yaml:"url"`
+	Ref string `yaml:"ref"`
+}
+
+// courseData holds course-wide information found in the tests repository.
+type courseData struct {
+	dockerfile   string             // contents of the Dockerfile in the scripts folder
+	dockerDirs   map[string]string  // folders of the assignments with their own Dockerfile, by assignment name
+	gradingScale *GradingScale      // grading scale in the repository root; nil if none
+	lateDays     *uint32            // free late days per student in the repository root's course.yml; nil if none
+	timeZone     string             // time zone for showing deadlines in the repository root's course.yml; empty if none
+	modules      []*pb.CourseModule // modules in the repository root's modules.yml; nil if none
+	warnings     ValidationErrors   // problems with the assignment files that do not prevent updating the assignments
 }
 
 // TODO(meling) this func should be renamed now that it does more than parseAssignments
 
 // ParseAssignments recursively walks the given directory and parses
-// any 'assignment.yml' files found and returns an array of assignments.
-func parseAssignments(dir string, courseID uint64) ([]*pb.Assignment, string, error) {
+// any 'assignment.yml' files found and returns an array of assignments,
+// unless the assignments are defined by an 'assignments.yml' manifest,
+// along with course-wide information, such as the Dockerfile, grading scale and late days.
+// The assignment files are validated in the same walk; problems with the assignment
+// files are returned together as ValidationErrors, while warnings, such as unknown
+// keys, are returned with the course-wide information.
+func parseAssignments(dir string, courseID uint64) ([]*pb.Assignment, *courseData, error) {
 	// check if directory exist
 	if _, err := os.Stat(dir); os.IsNotExist(err) {
-		return nil, "", err
+		return nil, nil, err
 	}
 
-	var assignments []*pb.Assignment
+	v := newAssignmentValidator()
+	// assignments defined in the manifest, if any, replace per-folder assignment files
+	assignments, err := readManifest(dir, courseID, v)
+	if err != nil {
+		return nil, nil, err
+	}
+	manifest := assignments != nil
 	var defaultScript string
-	var courseDockerfile string
-	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+	course := &courseData{dockerDirs: make(map[string]string)}
+	// assignment Dockerfiles precede the assignment files in the walk; hence, they are added after the walk
+	dockerfiles := make(map[string]string)
+	// assignments whose assignment file has problems; their other files are skipped
+	invalid := make(map[string]bool)
+	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			// Walk unable to read path; stop walking the tree
 			return err
 		}
+		if info.IsDir() {
+			if depth := walkDepth(dir, path); depth > MaxWalkDepth {
+				return fmt.Errorf("directory %s exceeds maximum depth %d", path, MaxWalkDepth)
+			}
+		}
 		assignmentName := filepath.Base(filepath.Dir(path))
 		if !info.IsDir() {
 			filename := filepath.Base(path)
+			if filename == scriptFile {
+				v.scripts[assignmentName] = true
+			}
+			if invalid[assignmentName] {
+				return nil
+			}
+			if isTaskFile(filename) {
+				contents, err := ioutil.ReadFile(path)
+				if err != nil {
+					return err
+				}
+				return readTaskFile(contents, filename, assignmentName, assignments)
+			}
 			var contents []byte
 			switch filename {
-			case target, targetYaml, criteriaFile, scriptFile, dockerfile:
+			case gradingFile, courseFile, modulesFile:
+				if !isTestsRepoRoot(dir, filepath.Dir(path)) {
+					// only a grading scale, course metadata or modules in the root applies to the course
+					return nil
+				}
+				fallthrough
+			case target, targetYaml, targetJSON, targetTOML, criteriaFile, pointsFile, scriptFile, dockerfile:
 				contents, err = ioutil.ReadFile(path)
 				if err != nil {
 					return err
@@ -71,10 +379,30 @@ func parseAssignments(dir string, courseID uint64) ([]*pb.Assignment, string, er
 				return nil
 			}
 			switch filename {
-			case target, targetYaml:
+			case target, targetYaml, targetJSON, targetTOML:
+				if manifest {
+					return fmt.Errorf("assignment %s is defined in both %s and %s", assignmentName, manifestFile, filename)
+				}
+				if findAssignmentByName(assignments, assignmentName) != nil {
+					return fmt.Errorf("assignment %s is defined by more than one assignment file", assignmentName)
+				}
+				// problems are collected, such that all problems are reported at once
+				file := relPath(dir, path)
+				contents, err = normalizeAssignmentFile(filename, contents)
+				if err != nil {
+					v.add(file, "", "%v", err)
+					invalid[assignmentName] = true
+					return nil
+				}
+				if !v.checkFile(file, assignmentName, contents) {
+					invalid[assignmentName] = true
+					return nil
+				}
 				assignment, err := readAssignmentFile(contents, assignmentName, courseID)
 				if err != nil {
-					return err
+					v.add(file, "", "%v", err)
+					invalid[assignmentName] = true
+					return nil
 				}
 				assignments = append(assignments, assignment)
 
@@ -83,6 +411,11 @@ func parseAssignments(dir string, courseID uint64) ([]*pb.Assignment, string, er
 					return err
 				}
 
+			case pointsFile:
+				if err := updatePointsFromFile(contents, assignmentName, assignments); err != nil {
+					return err
+				}
+
 			case scriptFile:
 				script, err := readScriptFile(contents, assignmentName, assignments)
 				if err != nil {
@@ -91,13 +424,78 @@ func parseAssignments(dir string, courseID uint64) ([]*pb.Assignment, string, er
 				defaultScript = script
 
 			case dockerfile:
-				courseDockerfile = string(contents)
+				if assignmentName == scriptFolder || isTestsRepoRoot(dir, filepath.Dir(path)) {
+					course.dockerfile = string(contents)
+					return nil
+				}
+				dockerfiles[assignmentName] = string(contents)
+				course.dockerDirs[assignmentName] = filepath.Dir(path)
+
+			case gradingFile:
+				scale, err := readGradingScaleFile(contents)
+				if err != nil {
+					return err
+				}
+				course.gradingScale = scale
+
+			case courseFile:
+				metadata, err := readCourseFile(contents)
+				if err != nil {
+					return err
+				}
+				course.lateDays = metadata.LateDays
+				course.timeZone = metadata.DisplayTimeZone
+
+			case modulesFile:
+				modules, err := readModulesFile(contents)
+				if err != nil {
+					return err
+				}
+				course.modules = modules
 			}
 		}
 		return nil
 	})
 	if err != nil {
-		return nil, "", err
+		return nil, nil, err
+	}
+	if err := v.finish(); err != nil {
+		return nil, nil, err
+	}
+	course.warnings = v.warnings
+	for assignmentName, contents := range dockerfiles {
+		assignment := findAssignmentByName(assignments, assignmentName)
+		if assignment == nil {
+			return nil, nil, fmt.Errorf("could not find assignment %s for %s", assignmentName, dockerfile)
+		}
+		assignment.Dockerfile = contents
+	}
+	if err := checkUnits(assignments); err != nil {
+		return nil, nil, err
+	}
+	if err := checkManualOnly(assignments); err != nil {
+		return nil, nil, err
+	}
+	if err := checkDateOrder(assignments); err != nil {
+		return nil, nil, err
+	}
+	if err := checkPrerequisites(assignments); err != nil {
+		return nil, nil, err
+	}
+	if err := checkModules(course.modules, assignments); err != nil {
+		return nil, nil, err
+	}
+
+	// if no auto approve score limit is defined for an assignment;
+	// use the course-wide default, if any, or else the default
+	scoreLimit := uint32(defaultAutoApproveScoreLimit)
+	if limit := course.gradingScale.defaultScoreLimit(); limit > 0 {
+		scoreLimit = limit
+	}
+	for _, assignment := range assignments {
+		if assignment.ScoreLimit < 1 {
+			assignment.ScoreLimit = scoreLimit
+		}
 	}
 
 	// if there is a script in `scripts` folder, save it for every assignment
@@ -109,11 +507,50 @@ func parseAssignments(dir string, courseID uint64) ([]*pb.Assignment, string, er
 			}
 		}
 	}
-	return assignments, courseDockerfile, nil
+	if err := checkAnalysisScripts(assignments); err != nil {
+		return nil, nil, err
+	}
+	return assignments, course, nil
+}
+
+// isTestsRepoRoot returns true if path is the root of the tests repository
+// cloned into dir, or dir itself.
+func isTestsRepoRoot(dir, path string) bool {
+	return path == dir || path == filepath.Join(dir, pb.TestsRepo)
 }
 
+// walkDepth returns the number of directory levels of path below root.
+func walkDepth(root, path string) int {
+	rel, err := filepath.Rel(root, path)
+	if err != nil || rel == "." {
+		return 0
+	}
+	return strings.Count(rel, string(filepath.Separator)) + 1
+}
+
+// FixDeadline returns the given date in the layout used for storing deadlines,
+// or an "Invalid date format" string if the date is not in an accepted layout.
 func FixDeadline(in string) string {
-	wantLayout := pb.TimeLayout
+	deadline, err := parseDeadline(in)
+	if err != nil {
+		return "Invalid date format: " + in
+	}
+	return deadline
+}
+
+// parseDeadline returns the given date in the layout used for storing deadlines,
+// or an error if the date is not in one of the accepted layouts.
+func parseDeadline(in string) (string, error) {
+	t, err := parseTime(in, time.UTC)
+	if err != nil {
+		return "", err
+	}
+	return t.Format(pb.TimeLayout), nil
+}
+
+// parseTime returns the given date interpreted in the given location,
+// or an error if the date is not in one of the accepted layouts.
+func parseTime(in string, loc *time.Location) (time.Time, error) {
 	acceptedLayouts := []string{
 		"2006-1-2T15:04:05",
 		"2006-1-2 15:04:05",
@@ -139,13 +576,13 @@ func FixDeadline(in string) string {
 		"2-1-2006 3:04:05pm",
 	}
 	for _, layout := range acceptedLayouts {
-		t, err := time.Parse(layout, in)
+		t, err := time.ParseInLocation(layout, in, loc)
 		if err != nil {
 			continue
 		}
-		return t.Format(wantLayout)
+		return t, nil
 	}
-	return "Invalid date format: " + in
+	return time.Time{}, fmt.Errorf("invalid date format %q; use YYYY-MM-DD HH:MM or DD-MM-YYYY HH:MM", in)
 }
 
 func updateCriteriaFromFile(criteria []byte, assignmentName string, assignments []*pb.Assignment) error {
@@ -157,10 +594,59 @@ func updateCriteriaFromFile(criteria []byte, assignmentName string, assignments
 	if assignment == nil {
 		return fmt.Errorf("could not find assignment %s for benchmark in %q", assignmentName, criteriaFile)
 	}
+	if err := validateBenchmarks(benchmarks, assignmentName); err != nil {
+		return err
+	}
 	assignment.GradingBenchmarks = benchmarks
 	return nil
 }
 
+// testPoints holds the points for a single test in a 'points.json' file.
+type testPoints struct {
+	MaxScore      int32  `json:"maxscore"`
+	Weight        int32  `json:"weight"`
+	PassThreshold uint32 `json:"passthreshold"`
+}
+
+// updatePointsFromFile records the points in the given contents of a 'points.json'
+// file, which maps test names to their max score and weight, as the authoritative
+// points for the assignment's tests. The max score and weight must not be negative;
+// if zero, the max score or weight reported by the test is used. The file may also
+// give a pass threshold for each test, as a percentage of the test's max score.
+func updatePointsFromFile(contents []byte, assignmentName string, assignments []*pb.Assignment) error {
+	var points map[string]testPoints
+	if err := json.Unmarshal(contents, &points); err != nil {
+		return fmt.Errorf("could not unmarshal %s: %s", pointsFile, err)
+	}
+	assignment := findAssignmentByName(assignments, assignmentName)
+	if assignment == nil {
+		return fmt.Errorf("could not find assignment %s for points in %q", assignmentName, pointsFile)
+	}
+	testNames := make([]string, 0, len(points))
+	for testName := range points {
+		testNames = append(testNames, testName)
+	}
+	sort.Strings(testNames)
+	for _, testName := range testNames {
+		p := points[testName]
+		switch {
+		case testName == "":
+			return fmt.Errorf("assignment %s: empty test name in %q", assignmentName, pointsFile)
+		case p.MaxScore < 0:
+			return fmt.Errorf("assignment %s: test %s has negative maxscore %d in %q", assignmentName, testName, p.MaxScore, pointsFile)
+		case p.Weight < 0:
+			return fmt.Errorf("assignment %s: test %s has negative weight %d in %q", assignmentName, testName, p.Weight, pointsFile)
+		case p.PassThreshold > 100:
+			return fmt.Errorf("assignment %s: test %s has passthreshold %d above 100 in %q", assignmentName, testName, p.PassThreshold, pointsFile)
+		}
+		test := testConfig(assignment, testName)
+		test.MaxScore = p.MaxScore
+		test.Weight = p.Weight
+		test.PassThreshold = p.PassThreshold
+	}
+	return nil
+}
+
 func readScriptFile(contents []byte, assignmentName string, assignments []*pb.Assignment) (string, error) {
 	if assignmentName != scriptFolder {
 		assignment := findAssignmentByName(assignments, assignmentName)
@@ -179,28 +665,413 @@ func readAssignmentFile(contents []byte, assignmentName string, courseID uint64)
 	if err != nil {
 		return nil, fmt.Errorf("error unmarshalling assignment: %w", err)
 	}
-	// if no auto approve score limit is defined; use the default
-	if newAssignment.ScoreLimit < 1 {
-		newAssignment.ScoreLimit = defaultAutoApproveScoreLimit
-	}
+	return makeAssignment(newAssignment, assignmentName, courseID)
+}
 
+// makeAssignment returns the assignment described by the given assignment data,
+// or an error if the assignment data is invalid.
+func makeAssignment(newAssignment assignmentData, assignmentName string, courseID uint64) (*pb.Assignment, error) {
+	if newAssignment.Language != "" && !ci.IsSupportedLanguage(newAssignment.Language) {
+		return nil, fmt.Errorf("assignment %s: unknown language %q; supported languages: %s",
+			assignmentName, newAssignment.Language, strings.Join(ci.SupportedLanguages(), ", "))
+	}
+	if newAssignment.ReviewerStrategy != "" && !isKnownReviewerStrategy(newAssignment.ReviewerStrategy) {
+		return nil, fmt.Errorf("assignment %s: unknown reviewerstrategy %q; known strategies: %s",
+			assignmentName, newAssignment.ReviewerStrategy, strings.Join(reviewerStrategies, ", "))
+	}
+	switch newAssignment.Network {
+	case "", pb.NetworkNone, pb.NetworkInternal, pb.NetworkLimited, pb.NetworkFull:
+	default:
+		return nil, fmt.Errorf("assignment %s: unknown network policy %q; known policies: %s, %s, %s, %s",
+			assignmentName, newAssignment.Network, pb.NetworkNone, pb.NetworkInternal, pb.NetworkLimited, pb.NetworkFull)
+	}
+	if len(newAssignment.AllowedHosts) > 0 && newAssignment.Network != "" && newAssignment.Network != pb.NetworkLimited {
+		return nil, fmt.Errorf("assignment %s: allowedhosts requires network %s", assignmentName, pb.NetworkLimited)
+	}
+	for _, host := range newAssignment.AllowedHosts {
+		if !allowedHost.MatchString(host) {
+			return nil, fmt.Errorf("assignment %s: invalid host %q in allowedhosts; use a host name, e.g., pypi.org or *.example.com", assignmentName, host)
+		}
+	}
+	switch newAssignment.DiffMode {
+	case "", score.DiffExact, score.DiffTrimmed, score.DiffNormalized:
+	default:
+		return nil, fmt.Errorf("assignment %s: unknown diffmode %q; known modes: %s",
+			assignmentName, newAssignment.DiffMode, strings.Join(score.DiffModes, ", "))
+	}
+	switch newAssignment.TestFormat {
+	case "", pb.TestFormatText, pb.TestFormatJSON:
+	default:
+		return nil, fmt.Errorf("assignment %s: unknown testformat %q; known formats: %s, %s",
+			assignmentName, newAssignment.TestFormat, pb.TestFormatText, pb.TestFormatJSON)
+	}
+	if newAssignment.LatePenalty > 100 {
+		return nil, fmt.Errorf("assignment %s: latepenalty %d is above 100", assignmentName, newAssignment.LatePenalty)
+	}
+	if limit := newAssignment.OutputLimit; limit != 0 && (limit < ci.MinOutputLimit || limit > ci.MaxOutputLimit) {
+		return nil, fmt.Errorf("assignment %s: outputlimit %d must be between %d and %d bytes",
+			assignmentName, limit, ci.MinOutputLimit, ci.MaxOutputLimit)
+	}
+	if newAssignment.RetryOnInfra > maxRetryOnInfra {
+		return nil, fmt.Errorf("assignment %s: retryoninfra %d is above %d", assignmentName, newAssignment.RetryOnInfra, maxRetryOnInfra)
+	}
+	if d := newAssignment.Deadlines; d != nil {
+		if d.Soft != "" && newAssignment.Deadline != "" {
+			return nil, fmt.Errorf("assignment %s: deadline and deadlines.soft must not both be set", assignmentName)
+		}
+		if d.Hard != "" && newAssignment.CloseDate != "" {
+			return nil, fmt.Errorf("assignment %s: closedate and deadlines.hard must not both be set", assignmentName)
+		}
+		if d.GraceHours > maxGraceHours {
+			return nil, fmt.Errorf("assignment %s: deadlines.gracehours %d is above %d", assignmentName, d.GraceHours, maxGraceHours)
+		}
+		if d.Soft != "" {
+			newAssignment.Deadline = d.Soft
+		}
+		if d.Hard != "" {
+			newAssignment.CloseDate = d.Hard
+		}
+	}
+	if strings.ContainsAny(newAssignment.DockerImage, " \t\n") {
+		return nil, fmt.Errorf("assignment %s: invalid dockerimage %q", assignmentName, newAssignment.DockerImage)
+	}
+	// deadlines are given in the assignment's time zone, or else the server's local time zone
+	location := time.Local
+	if newAssignment.TimeZone != "" {
+		loc, err := time.LoadLocation(newAssignment.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("assignment %s: unknown timezone %q", assignmentName, newAssignment.TimeZone)
+		}
+		location = loc
+	}
+	if newAssignment.Parallel < 0 {
+		return nil, fmt.Errorf("assignment %s: parallel %d is negative", assignmentName, newAssignment.Parallel)
+	}
+	if newAssignment.SimilarityThreshold > 100 {
+		return nil, fmt.Errorf("assignment %s: similaritythreshold %d is above 100", assignmentName, newAssignment.SimilarityThreshold)
+	}
+	if newAssignment.SimilarityThreshold > 0 && !newAssignment.PlagiarismCheck {
+		return nil, fmt.Errorf("assignment %s: similaritythreshold requires plagiarismcheck", assignmentName)
+	}
+	if repo := newAssignment.TestsRepo; repo != nil {
+		if err := checkTestsRepo(repo.URL, repo.Ref); err != nil {
+			return nil, fmt.Errorf("assignment %s: invalid testsrepo: %w", assignmentName, err)
+		}
+	}
 	// AssignmentID field from the parsed yaml is used to set Order, not assignment ID,
 	// or it will cause a database constraint violation (IDs must be unique)
 	// The Name field below is the folder name of the assignment.
 	assignment := &pb.Assignment{
-		CourseID:         courseID,
-		Deadline:         FixDeadline(newAssignment.Deadline),
-		Name:             assignmentName,
-		Order:            uint32(newAssignment.AssignmentID),
-		AutoApprove:      newAssignment.AutoApprove,
-		ScoreLimit:       uint32(newAssignment.ScoreLimit),
-		IsGroupLab:       newAssignment.IsGroupLab,
-		Reviewers:        uint32(newAssignment.Reviewers),
-		ContainerTimeout: uint32(newAssignment.ContainerTimeout),
+		CourseID:            courseID,
+		Deadline:            FixDeadline(newAssignment.Deadline),
+		Name:                assignmentName,
+		Order:               uint32(newAssignment.AssignmentID),
+		AutoApprove:         newAssignment.AutoApprove,
+		ScoreLimit:          uint32(newAssignment.ScoreLimit),
+		IsGroupLab:          newAssignment.IsGroupLab,
+		Reviewers:           uint32(newAssignment.Reviewers),
+		ContainerTimeout:    uint32(newAssignment.ContainerTimeout),
+		PartOf:              newAssignment.PartOf,
+		Language:            newAssignment.Language,
+		Verbose:             newAssignment.Verbose,
+		Retries:             uint32(newAssignment.Retries),
+		ManualOnly:          newAssignment.ManualOnly,
+		ReviewerStrategy:    newAssignment.ReviewerStrategy,
+		PlagiarismCheck:     newAssignment.PlagiarismCheck,
+		SimilarityThreshold: uint32(newAssignment.SimilarityThreshold),
+		Network:             newAssignment.Network,
+		CourseWeight:        newAssignment.CourseWeight,
+		MaxParallel:         uint32(newAssignment.Parallel),
+		DiffMode:            newAssignment.DiffMode,
+		TestFormat:          newAssignment.TestFormat,
+		LatePenalty:         newAssignment.LatePenalty,
+		OutputLimit:         newAssignment.OutputLimit,
+		RetryOnInfra:        newAssignment.RetryOnInfra,
+		GraceHours:          newAssignment.Deadlines.graceHours(),
+		TimeZone:            newAssignment.TimeZone,
+		DockerImage:         newAssignment.DockerImage,
+		MaxAttempts:         newAssignment.MaxAttempts,
+		CooldownMinutes:     newAssignment.CooldownMinutes,
+		MaxReruns:           newAssignment.MaxReruns,
+		RandomSeed:          newAssignment.RandomSeed,
+		// points are shown unless explicitly disabled
+		HidePoints: newAssignment.ShowPoints != nil && !*newAssignment.ShowPoints,
+		// students are notified unless explicitly disabled
+		MuteNotifications: newAssignment.NotifyOnResult != nil && !*newAssignment.NotifyOnResult,
+	}
+	if deadline, err := parseTime(newAssignment.Deadline, location); err == nil {
+		assignment.DeadlineTime = timestamppb.New(deadline)
+	}
+	if releaseDate, err := parseTime(newAssignment.ReleaseDate, location); err == nil {
+		assignment.ReleaseDate = timestamppb.New(releaseDate)
+	}
+	if closeDate, err := parseTime(newAssignment.CloseDate, location); err == nil {
+		assignment.CloseDate = timestamppb.New(closeDate)
+	}
+	if repo := newAssignment.TestsRepo; repo != nil {
+		assignment.TestsRepoURL = repo.URL
+		assignment.TestsRepoRef = repo.Ref
+	}
+	for _, testName := range newAssignment.RetryTests {
+		if testName == "" {
+			return nil, fmt.Errorf("assignment %s: empty test name in retrytests", assignmentName)
+		}
+		testConfig(assignment, testName).Retryable = true
+	}
+	for _, testName := range newAssignment.ExtraCredit {
+		if testName == "" {
+			return nil, fmt.Errorf("assignment %s: empty test name in extracredit", assignmentName)
+		}
+		testConfig(assignment, testName).ExtraCredit = true
+	}
+	for _, testName := range newAssignment.HiddenTests {
+		if testName == "" {
+			return nil, fmt.Errorf("assignment %s: empty test name in hiddentests", assignmentName)
+		}
+		testConfig(assignment, testName).Hidden = true
+	}
+	if err := newAssignment.Limits.apply(assignment); err != nil {
+		return nil, fmt.Errorf("assignment %s: invalid limits: %w", assignmentName, err)
+	}
+	if err := newAssignment.Coverage.apply(assignment); err != nil {
+		return nil, fmt.Errorf("assignment %s: invalid coverage: %w", assignmentName, err)
+	}
+	checks, err := analysisChecks(newAssignment.Analysis)
+	if err != nil {
+		return nil, fmt.Errorf("assignment %s: invalid analysis: %w", assignmentName, err)
+	}
+	assignment.AnalysisChecks = checks
+	matrix, err := buildMatrix(newAssignment.Matrix)
+	if err != nil {
+		return nil, fmt.Errorf("assignment %s: invalid matrix: %w", assignmentName, err)
+	}
+	assignment.Matrix = matrix
+	if approval := newAssignment.Approval; approval != nil {
+		assignment.ApprovalRuns = approval.ConsecutiveRuns
+		assignment.ApproveBeforeDeadline = approval.BeforeDeadline
+		assignment.ManualReview = approval.ManualReview
+		for _, testName := range approval.Tests {
+			if testName == "" {
+				return nil, fmt.Errorf("assignment %s: empty test name in approval tests", assignmentName)
+			}
+			testConfig(assignment, testName).RequiredForApproval = true
+		}
+	}
+	if len(newAssignment.ExtraCredit) > 0 {
+		switch {
+		case newAssignment.ExtraCreditCap == 0:
+			assignment.ExtraCreditCap = defaultExtraCreditCap
+		case newAssignment.ExtraCreditCap < 100:
+			return nil, fmt.Errorf("assignment %s: extracreditcap %d must be at least 100", assignmentName, newAssignment.ExtraCreditCap)
+		default:
+			assignment.ExtraCreditCap = uint32(newAssignment.ExtraCreditCap)
+		}
+	}
+	for _, fileName := range newAssignment.RequiredFiles {
+		if err := checkRequiredFile(fileName); err != nil {
+			return nil, fmt.Errorf("assignment %s: invalid requiredfiles: %w", assignmentName, err)
+		}
+	}
+	assignment.RequiredFiles = strings.Join(newAssignment.RequiredFiles, ",")
+	for _, name := range newAssignment.Requires {
+		if name == "" || strings.Contains(name, ",") {
+			return nil, fmt.Errorf("assignment %s: invalid assignment name %q in requires", assignmentName, name)
+		}
+	}
+	assignment.Requires = strings.Join(newAssignment.Requires, ",")
+	assignment.AllowedHosts = strings.ToLower(strings.Join(newAssignment.AllowedHosts, ","))
+	secrets, err := secretVariables(newAssignment.Secrets)
+	if err != nil {
+		return nil, fmt.Errorf("assignment %s: %w", assignmentName, err)
+	}
+	assignment.Secrets = secrets
+	// if retryable tests are declared without retries; use the default
+	if len(newAssignment.RetryTests) > 0 && assignment.Retries < 1 {
+		assignment.Retries = defaultRetries
 	}
 	return assignment, nil
 }
 
+// envVariable matches the names of environment variables that may hold secrets.
+var envVariable = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// secretVariables returns the given course secret names, keyed by the environment variable
+// holding each secret, as comma-separated VARIABLE=name pairs, sorted by variable name.
+// The variables must be valid environment variable names not reserved by QuickFeed,
+// and the secret names must be non-empty and must not contain commas or equal signs.
+func secretVariables(secrets map[string]string) (string, error) {
+	variables := make([]string, 0, len(secrets))
+	for variable, name := range secrets {
+		if !envVariable.MatchString(variable) || strings.HasPrefix(variable, "QUICKFEED_") {
+			return "", fmt.Errorf("invalid environment variable %q in secrets", variable)
+		}
+		if name == "" || strings.ContainsAny(name, ",=") {
+			return "", fmt.Errorf("invalid secret name %q for %s in secrets", name, variable)
+		}
+		variables = append(variables, variable)
+	}
+	sort.Strings(variables)
+	pairs := make([]string, len(variables))
+	for i, variable := range variables {
+		pairs[i] = variable + "=" + secrets[variable]
+	}
+	return strings.Join(pairs, ","), nil
+}
+
+// allowedHost matches the host names accepted in allowedhosts, optionally
+// prefixed by *. to match any subdomain; URLs and ports are not accepted.
+var allowedHost = regexp.MustCompile(`^(\*\.)?[A-Za-z0-9-]+(\.[A-Za-z0-9-]+)*$`)
+
+// scpLikeURL matches the scp-like syntax for ssh URLs accepted by git,
+// e.g., git@github.com:org/tests.git.
+var scpLikeURL = regexp.MustCompile(`^[\w.-]+@[\w.-]+:[\w./~-]+$`)
+
+// checkTestsRepo returns an error if the given URL and ref do not form
+// a well-formed reference to a git repository. The ref is optional;
+// if empty, the repository's default branch is used.
+func checkTestsRepo(repoURL, ref string) error {
+	if repoURL == "" {
+		return errors.New("missing url")
+	}
+	if !scpLikeURL.MatchString(repoURL) {
+		u, err := url.Parse(repoURL)
+		if err != nil {
+			return fmt.Errorf("malformed url %q: %w", repoURL, err)
+		}
+		switch u.Scheme {
+		case "https", "http", "ssh", "git":
+		default:
+			return fmt.Errorf("url %q: unsupported scheme %q", repoURL, u.Scheme)
+		}
+		if u.Host == "" || strings.Trim(u.Path, "/") == "" {
+			return fmt.Errorf("url %q: missing host or repository path", repoURL)
+		}
+	}
+	if ref == "" {
+		return nil
+	}
+	// a subset of the rules enforced by git check-ref-format
+	if strings.HasPrefix(ref, "-") || strings.HasPrefix(ref, "/") || strings.HasSuffix(ref, "/") ||
+		strings.HasSuffix(ref, ".") || strings.HasSuffix(ref, ".lock") ||
+		strings.Contains(ref, "..") || strings.Contains(ref, "//") || strings.Contains(ref, "@{") ||
+		strings.ContainsAny(ref, " ~^:?*[\\\t\n") {
+		return fmt.Errorf("malformed ref %q", ref)
+	}
+	return nil
+}
+
+// checkRequiredFile returns an error if the given file name cannot name
+// a file within a submission's repository.
+func checkRequiredFile(fileName string) error {
+	switch {
+	case strings.TrimSpace(fileName) == "":
+		return errors.New("empty file name")
+	case strings.Contains(fileName, ","):
+		return fmt.Errorf("file name %q contains a comma", fileName)
+	case path.IsAbs(fileName) || path.Clean(fileName) == ".." || strings.HasPrefix(path.Clean(fileName), "../"):
+		return fmt.Errorf("file name %q is outside the repository", fileName)
+	}
+	return nil
+}
+
+// checkAnalysisScripts returns an error if an assignment has static analysis checks,
+// but its run.sh script does not run them. The default test scripts run the checks.
+func checkAnalysisScripts(assignments []*pb.Assignment) error {
+	for _, assignment := range assignments {
+		script := assignment.GetScriptFile()
+		if len(assignment.GetAnalysisChecks()) > 0 && script != "" && !ci.RunsAnalysis(script) {
+			return fmt.Errorf("assignment %s: analysis requires the %s script to include {{ .Analysis }}", assignment.GetName(), scriptFile)
+		}
+	}
+	return nil
+}
+
+// checkUnits returns an error if an assignment is declared to be part of
+// a unit whose heading assignment does not exist, or if the members of a unit
+// do not agree with the heading assignment on deadline and group lab setting.
+// Units cannot be nested; the heading assignment cannot itself be part of another unit.
+func checkUnits(assignments []*pb.Assignment) error {
+	for _, assignment := range assignments {
+		partOf := assignment.GetPartOf()
+		if partOf == "" {
+			continue
+		}
+		if partOf == assignment.GetName() {
+			return fmt.Errorf("assignment %s cannot be part of itself", assignment.GetName())
+		}
+		head := findAssignmentByName(assignments, partOf)
+		if head == nil {
+			return fmt.Errorf("assignment %s is part of unknown assignment %s", assignment.GetName(), partOf)
+		}
+		if head.GetPartOf() != "" {
+			return fmt.Errorf("assignment %s is part of %s, which is itself part of %s", assignment.GetName(), partOf, head.GetPartOf())
+		}
+		if head.GetDeadline() != assignment.GetDeadline() {
+			return fmt.Errorf("assignment %s must have the same deadline as %s", assignment.GetName(), partOf)
+		}
+		if head.GetIsGroupLab() != assignment.GetIsGroupLab() {
+			return fmt.Errorf("assignment %s must have the same isgrouplab setting as %s", assignment.GetName(), partOf)
+		}
+	}
+	return nil
+}
+
+// checkManualOnly returns an error if a manual-only assignment has no grading
+// benchmarks, since such an assignment can only be graded by its benchmarks.
+func checkManualOnly(assignments []*pb.Assignment) error {
+	for _, assignment := range assignments {
+		if assignment.GetManualOnly() && len(assignment.GetGradingBenchmarks()) == 0 {
+			return fmt.Errorf("assignment %s is manualonly, but has no grading benchmarks in %q", assignment.GetName(), criteriaFile)
+		}
+	}
+	return nil
+}
+
+// checkDateOrder returns an error if the release date, deadline and close date
+// of an assignment are not in order, that is, releasedate <= deadline <= closedate.
+// Only the dates that are set are compared; dates are shown in the assignment's time zone.
+func checkDateOrder(assignments []*pb.Assignment) error {
+	for _, assignment := range assignments {
+		dates := []struct {
+			field string
+			value *timestamppb.Timestamp
+		}{
+			{"releasedate", assignment.GetReleaseDate()},
+			{"deadline", assignment.GetDeadlineTime()},
+			{"closedate", assignment.GetCloseDate()},
+		}
+		loc := assignment.Location(time.Local)
+		var prevField string
+		var prev time.Time
+		for _, date := range dates {
+			if date.value == nil {
+				// dates that are not set or invalid are not compared
+				continue
+			}
+			t := date.value.AsTime().In(loc)
+			if prevField != "" && t.Before(prev) {
+				return fmt.Errorf("assignment %s: %s %s is before %s %s", assignment.GetName(),
+					date.field, t.Format(pb.TimeLayout), prevField, prev.Format(pb.TimeLayout))
+			}
+			prevField, prev = date.field, t
+		}
+	}
+	return nil
+}
+
+// testConfig returns the configuration for the given test of the assignment.
+// If the assignment has no configuration for the test, a new one is added.
+func testConfig(assignment *pb.Assignment, testName string) *pb.TestConfig {
+	for _, test := range assignment.Tests {
+		if test.TestName == testName {
+			return test
+		}
+	}
+	test := &pb.TestConfig{TestName: testName}
+	assignment.Tests = append(assignment.Tests, test)
+	return test
+}
+
 func findAssignmentByName(assignments []*pb.Assignment, name string) *pb.Assignment {
 	var found *pb.Assignment
 	for _, assignment := range assignments {
@@ -5,12 +5,16 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/kit/score"
 	"github.com/google/go-cmp/cmp"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestParseWithInvalidDir(t *testing.T) {
@@ -144,17 +148,19 @@ func TestParse(t *testing.T) {
 	// We expect assignment names to be set based on
 	// assignment folder names.
 	wantAssignment1 := &pb.Assignment{
-		Name:        "lab1",
-		Deadline:    "2017-08-27T12:00:00",
-		ScriptFile:  "Script for Lab1",
-		AutoApprove: false,
-		Order:       1,
-		ScoreLimit:  80,
+		Name:         "lab1",
+		Deadline:     "2017-08-27T12:00:00",
+		DeadlineTime: timestamppb.New(time.Date(2017, 8, 27, 12, 0, 0, 0, time.Local)),
+		ScriptFile:   "Script for Lab1",
+		AutoApprove:  false,
+		Order:        1,
+		ScoreLimit:   80,
 	}
 
 	wantAssignment2 := &pb.Assignment{
 		Name:              "lab2",
 		Deadline:          "2018-08-27T12:00:00",
+		DeadlineTime:      timestamppb.New(time.Date(2018, 8, 27, 12, 0, 0, 0, time.Local)),
 		ScriptFile:        "Default script",
 		AutoApprove:       false,
 		Order:             2,
@@ -162,15 +168,15 @@ func TestParse(t *testing.T) {
 		GradingBenchmarks: wantCriteria,
 	}
 
-	assignments, dockerfile, err := parseAssignments(testsDir, 0)
+	assignments, data, err := parseAssignments(testsDir, 0)
 	if err != nil {
 		t.Fatal(err)
 	}
 	if len(assignments) != 2 {
 		t.Errorf("len(assignments) = %d, want %d", len(assignments), 2)
 	}
-	if dockerfile != df {
-		t.Errorf("Incorrect dockerfile\n Want: %s\n Got: %s\n", df, dockerfile)
+	if data.dockerfile != df {
+		t.Errorf("Incorrect dockerfile\n Want: %s\n Got: %s\n", df, data.dockerfile)
 	}
 	if diff := cmp.Diff(assignments[0], wantAssignment1, protocmp.Transform()); diff != "" {
 		t.Errorf("parseAssignments() mismatch (-want +got):\n%s", diff)
@@ -205,24 +211,39 @@ func TestParseUnknownFields(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+	err = ioutil.WriteFile(filepath.Join(testsDir, "lab1", "run.sh"), []byte(script1), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
 
 	// We expect assignment names to be set based on
 	// assignment folder names.
 	wantAssignment1 := &pb.Assignment{
-		Name:        "lab1",
-		Deadline:    "2017-08-27T12:00:00",
-		AutoApprove: false,
-		Order:       1,
-		ScoreLimit:  80,
+		Name:         "lab1",
+		Deadline:     "2017-08-27T12:00:00",
+		DeadlineTime: timestamppb.New(time.Date(2017, 8, 27, 12, 0, 0, 0, time.Local)),
+		AutoApprove:  false,
+		Order:        1,
+		ScoreLimit:   80,
+		ScriptFile:   script1,
 	}
 
-	assignments, _, err := parseAssignments(testsDir, 0)
+	assignments, data, err := parseAssignments(testsDir, 0)
 	if err != nil {
 		t.Fatal(err)
 	}
 	if len(assignments) != 1 {
 		t.Errorf("len(assignments) = %d, want %d", len(assignments), 1)
 	}
+	// unknown fields are reported as warnings
+	wantWarnings := []string{
+		"lab1/assignment.yaml: expected_effort: unknown key",
+		"lab1/assignment.yaml: grading: unknown key",
+		"lab1/assignment.yaml: subject: unknown key",
+	}
+	if diff := cmp.Diff(wantWarnings, errorStrings(data.warnings)); diff != "" {
+		t.Errorf("parseAssignments() warnings mismatch (-want +got):\n%s", diff)
+	}
 	if diff := cmp.Diff(assignments[0], wantAssignment1, protocmp.Transform()); diff != "" {
 		t.Errorf("parseAssignments() mismatch (-want +got):\n%s", diff)
 	}
@@ -318,3 +339,1475 @@ func TestFixDeadline(t *testing.T) {
 		}
 	}
 }
+
+// createTestsRepo creates a temporary tests repository with the given files.
+// The files map is keyed by the file's path relative to the repository root.
+// Unless the files include a run.sh script, a default script is added to the
+// scripts folder, such that the assignments have a script to run their tests.
+func createTestsRepo(t *testing.T, files map[string]string) string {
+	t.Helper()
+	testsDir, err := ioutil.TempDir("", pb.TestsRepo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(testsDir) })
+	if !hasScriptFile(files) {
+		defaultScript := filepath.Join(testsDir, scriptFolder, scriptFile)
+		if err := os.MkdirAll(filepath.Dir(defaultScript), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(defaultScript, []byte(defaultTestScript), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for path, contents := range files {
+		path = filepath.Join(testsDir, path)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(contents), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return testsDir
+}
+
+// defaultTestScript is the script added to the tests repositories created by
+// createTestsRepo, unless they include a run.sh script.
+const defaultTestScript = "#image/quickfeed:go\n{{ .Analysis }}\n"
+
+// hasScriptFile returns true if the given files include a run.sh script.
+func hasScriptFile(files map[string]string) bool {
+	for path := range files {
+		if filepath.Base(path) == scriptFile {
+			return true
+		}
+	}
+	return false
+}
+
+func TestParseUnits(t *testing.T) {
+	const (
+		part1 = `assignmentid: 3
+deadline: "27-08-2018 12:00"
+isgrouplab: true
+`
+		part2 = `assignmentid: 4
+deadline: "27-08-2018 12:00"
+isgrouplab: true
+partof: lab3
+`
+	)
+	testsDir := createTestsRepo(t, map[string]string{
+		"lab3/assignment.yml": part1,
+		"lab4/assignment.yml": part2,
+	})
+	assignments, _, err := parseAssignments(testsDir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(assignments) != 2 {
+		t.Fatalf("len(assignments) = %d, want %d", len(assignments), 2)
+	}
+	for _, assignment := range assignments {
+		if got := assignment.Unit(); got != "lab3" {
+			t.Errorf("%s.Unit() = %q, want %q", assignment.GetName(), got, "lab3")
+		}
+	}
+	if got := assignments[1].GetPartOf(); got != "lab3" {
+		t.Errorf("PartOf = %q, want %q", got, "lab3")
+	}
+}
+
+func TestParseUnitsInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+	}{
+		{
+			name: "dangling member reference",
+			files: map[string]string{
+				"lab1/assignment.yml": "assignmentid: 1\ndeadline: \"27-08-2018 12:00\"\n",
+				"lab2/assignment.yml": "assignmentid: 2\ndeadline: \"27-08-2018 12:00\"\npartof: lab9\n",
+			},
+		},
+		{
+			name: "inconsistent deadline",
+			files: map[string]string{
+				"lab1/assignment.yml": "assignmentid: 1\ndeadline: \"27-08-2018 12:00\"\n",
+				"lab2/assignment.yml": "assignmentid: 2\ndeadline: \"28-08-2018 12:00\"\npartof: lab1\n",
+			},
+		},
+		{
+			name: "inconsistent group lab",
+			files: map[string]string{
+				"lab1/assignment.yml": "assignmentid: 1\ndeadline: \"27-08-2018 12:00\"\n",
+				"lab2/assignment.yml": "assignmentid: 2\ndeadline: \"27-08-2018 12:00\"\nisgrouplab: true\npartof: lab1\n",
+			},
+		},
+		{
+			name: "nested unit",
+			files: map[string]string{
+				"lab1/assignment.yml": "assignmentid: 1\ndeadline: \"27-08-2018 12:00\"\n",
+				"lab2/assignment.yml": "assignmentid: 2\ndeadline: \"27-08-2018 12:00\"\npartof: lab1\n",
+				"lab3/assignment.yml": "assignmentid: 3\ndeadline: \"27-08-2018 12:00\"\npartof: lab2\n",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testsDir := createTestsRepo(t, tt.files)
+			if _, _, err := parseAssignments(testsDir, 0); err == nil {
+				t.Error("parseAssignments() succeeded, want error")
+			}
+		})
+	}
+}
+
+func TestParseLanguage(t *testing.T) {
+	for _, lang := range ci.SupportedLanguages() {
+		t.Run(lang, func(t *testing.T) {
+			testsDir := createTestsRepo(t, map[string]string{
+				"lab1/assignment.yml": "assignmentid: 1\ndeadline: \"27-08-2018 12:00\"\nlanguage: " + lang + "\n",
+			})
+			assignments, _, err := parseAssignments(testsDir, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := assignments[0].GetLanguage(); got != lang {
+				t.Errorf("Language = %q, want %q", got, lang)
+			}
+		})
+	}
+	t.Run("unknown", func(t *testing.T) {
+		testsDir := createTestsRepo(t, map[string]string{
+			"lab1/assignment.yml": "assignmentid: 1\ndeadline: \"27-08-2018 12:00\"\nlanguage: cobol\n",
+		})
+		if _, _, err := parseAssignments(testsDir, 0); err == nil {
+			t.Error("parseAssignments() succeeded for unknown language, want error")
+		}
+	})
+}
+
+func TestParseVerbose(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": "assignmentid: 1\ndeadline: \"27-08-2018 12:00\"\nverbose: true\n",
+		"lab2/assignment.yml": "assignmentid: 2\ndeadline: \"27-08-2018 12:00\"\n",
+	})
+	assignments, _, err := parseAssignments(testsDir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !assignments[0].GetVerbose() {
+		t.Errorf("%s: Verbose = false, want true", assignments[0].GetName())
+	}
+	if assignments[1].GetVerbose() {
+		t.Errorf("%s: Verbose = true, want false", assignments[1].GetName())
+	}
+}
+
+func TestParseMaxWalkDepth(t *testing.T) {
+	deep := strings.Repeat("a/", MaxWalkDepth)
+	testsDir := createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml":           "assignmentid: 1\ndeadline: \"27-08-2018 12:00\"\n",
+		"lab1/" + deep + "deep_test.go": "package deep",
+		"lab2/assignment.yml":           "assignmentid: 2\ndeadline: \"27-08-2018 12:00\"\n",
+	})
+	if _, _, err := parseAssignments(testsDir, 0); err == nil {
+		t.Errorf("parseAssignments() succeeded for tree deeper than %d levels, want error", MaxWalkDepth)
+	}
+
+	shallow := strings.Repeat("a/", MaxWalkDepth-2)
+	testsDir = createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml":              "assignmentid: 1\ndeadline: \"27-08-2018 12:00\"\n",
+		"lab1/" + shallow + "deep_test.go": "package deep",
+	})
+	if _, _, err := parseAssignments(testsDir, 0); err != nil {
+		t.Errorf("parseAssignments() failed for tree within %d levels: %v", MaxWalkDepth, err)
+	}
+}
+
+func TestParseRetryTests(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": `assignmentid: 1
+deadline: "27-08-2018 12:00"
+retries: 3
+retrytests:
+  - TestNetwork
+  - TestDisk
+`,
+		"lab2/assignment.yml": `assignmentid: 2
+deadline: "27-08-2018 12:00"
+retrytests:
+  - TestNetwork
+`,
+		"lab3/assignment.yml": `assignmentid: 3
+deadline: "27-08-2018 12:00"
+`,
+	})
+	assignments, _, err := parseAssignments(testsDir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		wantRetries   uint32
+		wantRetryable []string
+	}{
+		{wantRetries: 3, wantRetryable: []string{"TestNetwork", "TestDisk"}},
+		{wantRetries: defaultRetries, wantRetryable: []string{"TestNetwork"}},
+		{wantRetries: 0, wantRetryable: nil},
+	}
+	for i, test := range tests {
+		if got := assignments[i].GetRetries(); got != test.wantRetries {
+			t.Errorf("%s: Retries = %d, want %d", assignments[i].GetName(), got, test.wantRetries)
+		}
+		if diff := cmp.Diff(test.wantRetryable, assignments[i].RetryableTests()); diff != "" {
+			t.Errorf("%s: RetryableTests() mismatch (-want +got):\n%s", assignments[i].GetName(), diff)
+		}
+	}
+}
+
+func TestParseTestsRepo(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": `assignmentid: 1
+deadline: "27-08-2018 12:00"
+testsrepo:
+  url: https://github.com/dat320/private-tests.git
+  ref: v1.2
+`,
+		"lab2/assignment.yml": `assignmentid: 2
+deadline: "27-08-2018 12:00"
+testsrepo:
+  url: git@github.com:dat320/private-tests.git
+`,
+		"lab3/assignment.yml": `assignmentid: 3
+deadline: "27-08-2018 12:00"
+`,
+	})
+	assignments, _, err := parseAssignments(testsDir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		wantURL string
+		wantRef string
+	}{
+		{wantURL: "https://github.com/dat320/private-tests.git", wantRef: "v1.2"},
+		{wantURL: "git@github.com:dat320/private-tests.git", wantRef: ""},
+		{wantURL: "", wantRef: ""},
+	}
+	for i, test := range tests {
+		if got := assignments[i].GetTestsRepoURL(); got != test.wantURL {
+			t.Errorf("%s: TestsRepoURL = %q, want %q", assignments[i].GetName(), got, test.wantURL)
+		}
+		if got := assignments[i].GetTestsRepoRef(); got != test.wantRef {
+			t.Errorf("%s: TestsRepoRef = %q, want %q", assignments[i].GetName(), got, test.wantRef)
+		}
+	}
+}
+
+func TestParseTestsRepoInvalid(t *testing.T) {
+	tests := []struct {
+		name      string
+		testsRepo string
+	}{
+		{name: "missing url", testsRepo: "  ref: main\n"},
+		{name: "unsupported scheme", testsRepo: "  url: ftp://github.com/dat320/private-tests.git\n"},
+		{name: "missing path", testsRepo: "  url: https://github.com/\n"},
+		{name: "not a url", testsRepo: "  url: private-tests\n"},
+		{name: "malformed ref", testsRepo: "  url: https://github.com/dat320/private-tests.git\n  ref: main..dev\n"},
+		{name: "ref with space", testsRepo: "  url: https://github.com/dat320/private-tests.git\n  ref: my branch\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testsDir := createTestsRepo(t, map[string]string{
+				"lab1/assignment.yml": "assignmentid: 1\ndeadline: \"27-08-2018 12:00\"\ntestsrepo:\n" + tt.testsRepo,
+			})
+			if _, _, err := parseAssignments(testsDir, 0); err == nil {
+				t.Error("parseAssignments() succeeded, want error for malformed testsrepo")
+			}
+		})
+	}
+}
+
+func TestParseManualOnly(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		"essay/assignment.yml": `assignmentid: 1
+deadline: "27-08-2018 12:00"
+manualonly: true
+`,
+		"essay/criteria.json": criteria,
+		"lab2/assignment.yml": `assignmentid: 2
+deadline: "27-08-2018 12:00"
+`,
+	})
+	assignments, _, err := parseAssignments(testsDir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, assignment := range assignments {
+		wantManual := assignment.GetName() == "essay"
+		if got := assignment.GetManualOnly(); got != wantManual {
+			t.Errorf("%s: ManualOnly = %t, want %t", assignment.GetName(), got, wantManual)
+		}
+		if got := assignment.GradedManually(); got != wantManual {
+			t.Errorf("%s: GradedManually() = %t, want %t", assignment.GetName(), got, wantManual)
+		}
+	}
+}
+
+func TestParseManualOnlyWithoutBenchmarks(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		"essay/assignment.yml": `assignmentid: 1
+deadline: "27-08-2018 12:00"
+manualonly: true
+`,
+	})
+	_, _, err := parseAssignments(testsDir, 0)
+	if err == nil || !strings.Contains(err.Error(), "no grading benchmarks") {
+		t.Errorf("parseAssignments() = %v, want error for manualonly assignment without benchmarks", err)
+	}
+}
+
+func TestParseDateOrder(t *testing.T) {
+	const (
+		release  = `releasedate: "20-08-2018 12:00"` + "\n"
+		deadline = `deadline: "27-08-2018 12:00"` + "\n"
+		closing  = `closedate: "03-09-2018 12:00"` + "\n"
+		early    = `"13-08-2018 12:00"`
+		late     = `"10-09-2018 12:00"`
+	)
+	tests := []struct {
+		name    string
+		dates   string
+		wantErr string
+	}{
+		{name: "all ordered", dates: release + deadline + closing},
+		{name: "release and deadline", dates: release + deadline},
+		{name: "deadline and close", dates: deadline + closing},
+		{name: "release and close", dates: release + closing},
+		{name: "all equal", dates: `releasedate: "27-08-2018 12:00"` + "\n" + deadline + `closedate: "27-08-2018 12:00"` + "\n"},
+		{name: "deadline before release", dates: "releasedate: " + late + "\n" + deadline, wantErr: "deadline 2018-08-27T12:00:00 is before releasedate 2018-09-10T12:00:00"},
+		{name: "close before deadline", dates: deadline + "closedate: " + early + "\n", wantErr: "closedate 2018-08-13T12:00:00 is before deadline 2018-08-27T12:00:00"},
+		{name: "close before release", dates: "releasedate: " + late + "\n" + closing, wantErr: "closedate 2018-09-03T12:00:00 is before releasedate 2018-09-10T12:00:00"},
+		{name: "invalid release date", dates: "releasedate: tomorrow\n" + deadline, wantErr: `releasedate: invalid date format "tomorrow"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testsDir := createTestsRepo(t, map[string]string{
+				"lab1/assignment.yml": "assignmentid: 1\n" + tt.dates,
+			})
+			_, _, err := parseAssignments(testsDir, 0)
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Errorf("parseAssignments() = %v, want nil", err)
+			case tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)):
+				t.Errorf("parseAssignments() = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseExtraCredit(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": `assignmentid: 1
+deadline: "27-08-2018 12:00"
+extracredit:
+  - TestBonus
+  - TestChallenge
+extracreditcap: 110
+`,
+		"lab2/assignment.yml": `assignmentid: 2
+deadline: "27-08-2018 12:00"
+retrytests:
+  - TestBonus
+extracredit:
+  - TestBonus
+`,
+		"lab3/assignment.yml": `assignmentid: 3
+deadline: "27-08-2018 12:00"
+`,
+	})
+	assignments, _, err := parseAssignments(testsDir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		wantCap         uint32
+		wantExtraCredit []string
+	}{
+		{wantCap: 110, wantExtraCredit: []string{"TestBonus", "TestChallenge"}},
+		{wantCap: defaultExtraCreditCap, wantExtraCredit: []string{"TestBonus"}},
+		{wantCap: 0, wantExtraCredit: nil},
+	}
+	for i, test := range tests {
+		if got := assignments[i].GetExtraCreditCap(); got != test.wantCap {
+			t.Errorf("%s: ExtraCreditCap = %d, want %d", assignments[i].GetName(), got, test.wantCap)
+		}
+		if diff := cmp.Diff(test.wantExtraCredit, assignments[i].ExtraCreditTests()); diff != "" {
+			t.Errorf("%s: ExtraCreditTests() mismatch (-want +got):\n%s", assignments[i].GetName(), diff)
+		}
+	}
+	// a test may be both retryable and extra credit
+	if len(assignments[1].GetTests()) != 1 || !assignments[1].GetTests()[0].GetRetryable() {
+		t.Errorf("%s: Tests = %v, want single retryable extra credit test", assignments[1].GetName(), assignments[1].GetTests())
+	}
+}
+
+func TestParseExtraCreditInvalidCap(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": `assignmentid: 1
+deadline: "27-08-2018 12:00"
+extracredit:
+  - TestBonus
+extracreditcap: 90
+`,
+	})
+	if _, _, err := parseAssignments(testsDir, 0); err == nil {
+		t.Error("parseAssignments() succeeded, want error for extracreditcap below 100")
+	}
+}
+
+func TestParseShowPoints(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": `assignmentid: 1
+deadline: "27-08-2018 12:00"
+showpoints: false
+`,
+		"lab2/assignment.yml": `assignmentid: 2
+deadline: "27-08-2018 12:00"
+showpoints: true
+`,
+		"lab3/assignment.yml": `assignmentid: 3
+deadline: "27-08-2018 12:00"
+`,
+	})
+	assignments, _, err := parseAssignments(testsDir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, want := range []bool{true, false, false} {
+		if got := assignments[i].GetHidePoints(); got != want {
+			t.Errorf("%s: HidePoints = %t, want %t", assignments[i].GetName(), got, want)
+		}
+	}
+}
+
+func TestParseRequiredFiles(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": `assignmentid: 1
+deadline: "27-08-2018 12:00"
+requiredfiles:
+  - report.pdf
+  - docs/design.md
+`,
+		"lab2/assignment.yml": `assignmentid: 2
+deadline: "27-08-2018 12:00"
+`,
+	})
+	assignments, _, err := parseAssignments(testsDir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, want := range [][]string{{"report.pdf", "docs/design.md"}, nil} {
+		if diff := cmp.Diff(want, assignments[i].RequiredFileNames()); diff != "" {
+			t.Errorf("%s: RequiredFileNames() mismatch (-want +got):\n%s", assignments[i].GetName(), diff)
+		}
+	}
+}
+
+func TestParseRequiredFilesInvalid(t *testing.T) {
+	for name, fileName := range map[string]string{
+		"empty":   `""`,
+		"blank":   `"  "`,
+		"comma":   `"a,b.txt"`,
+		"abs":     `/etc/passwd`,
+		"outside": `../report.pdf`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			testsDir := createTestsRepo(t, map[string]string{
+				"lab1/assignment.yml": `assignmentid: 1
+deadline: "27-08-2018 12:00"
+requiredfiles:
+  - report.pdf
+  - ` + fileName + "\n",
+			})
+			if _, _, err := parseAssignments(testsDir, 0); err == nil {
+				t.Errorf("parseAssignments() succeeded, want error for required file %s", fileName)
+			}
+		})
+	}
+}
+
+func TestParseReviewerStrategy(t *testing.T) {
+	for _, strategy := range []string{RoundRobin, Random, LeastLoaded, ""} {
+		t.Run(strategy, func(t *testing.T) {
+			contents := `assignmentid: 1
+deadline: "27-08-2018 12:00"
+reviewers: 2
+`
+			if strategy != "" {
+				contents += "reviewerstrategy: " + strategy + "\n"
+			}
+			testsDir := createTestsRepo(t, map[string]string{"lab1/assignment.yml": contents})
+			assignments, _, err := parseAssignments(testsDir, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := assignments[0].GetReviewerStrategy(); got != strategy {
+				t.Errorf("ReviewerStrategy = %q, want %q", got, strategy)
+			}
+		})
+	}
+}
+
+func TestParseReviewerStrategyUnknown(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": `assignmentid: 1
+deadline: "27-08-2018 12:00"
+reviewers: 2
+reviewerstrategy: alphabetical
+`,
+	})
+	_, _, err := parseAssignments(testsDir, 0)
+	if err == nil || !strings.Contains(err.Error(), `unknown reviewerstrategy "alphabetical"`) {
+		t.Errorf("parseAssignments() error = %v, want unknown reviewerstrategy error", err)
+	}
+}
+
+func TestParseNotifyOnResult(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": `assignmentid: 1
+deadline: "27-08-2018 12:00"
+notifyonresult: false
+`,
+		"lab2/assignment.yml": `assignmentid: 2
+deadline: "27-08-2018 12:00"
+notifyonresult: true
+`,
+		"lab3/assignment.yml": `assignmentid: 3
+deadline: "27-08-2018 12:00"
+`,
+	})
+	assignments, _, err := parseAssignments(testsDir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, want := range []bool{false, true, true} {
+		if got := assignments[i].NotifyOnResult(); got != want {
+			t.Errorf("%s: NotifyOnResult() = %t, want %t", assignments[i].GetName(), got, want)
+		}
+	}
+}
+
+func TestParsePoints(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": `assignmentid: 1
+deadline: "27-08-2018 12:00"
+retrytests:
+  - TestB
+`,
+		"lab1/points.json": `{
+  "TestA": {"maxscore": 10, "weight": 2},
+  "TestB": {"maxscore": 5},
+  "TestC": {"passthreshold": 70}
+}`,
+	})
+	assignments, _, err := parseAssignments(testsDir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*pb.TestConfig{
+		{TestName: "TestB", Retryable: true, MaxScore: 5},
+		{TestName: "TestA", MaxScore: 10, Weight: 2},
+		{TestName: "TestC", PassThreshold: 70},
+	}
+	if diff := cmp.Diff(want, assignments[0].GetTests(), protocmp.Transform()); diff != "" {
+		t.Errorf("parseAssignments() tests mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestParsePointsInvalid(t *testing.T) {
+	for name, points := range map[string]string{
+		"negative maxscore": `{"TestA": {"maxscore": -10, "weight": 1}}`,
+		"passthreshold":     `{"TestA": {"maxscore": 10, "passthreshold": 110}}`,
+		"negative weight":   `{"TestA": {"maxscore": 10, "weight": -1}}`,
+		"malformed":         `{"TestA": 10}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			testsDir := createTestsRepo(t, map[string]string{
+				"lab1/assignment.yml": `assignmentid: 1
+deadline: "27-08-2018 12:00"
+`,
+				"lab1/points.json": points,
+			})
+			if _, _, err := parseAssignments(testsDir, 0); err == nil {
+				t.Errorf("parseAssignments() succeeded, want error for %s", name)
+			}
+		})
+	}
+}
+
+func TestParsePlagiarismCheck(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": `assignmentid: 1
+deadline: "27-08-2018 12:00"
+plagiarismcheck: true
+similaritythreshold: 75
+`,
+		"lab2/assignment.yml": `assignmentid: 2
+deadline: "27-08-2018 12:00"
+plagiarismcheck: true
+`,
+		"lab3/assignment.yml": `assignmentid: 3
+deadline: "27-08-2018 12:00"
+plagiarismcheck: false
+`,
+	})
+	assignments, _, err := parseAssignments(testsDir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wants := []struct {
+		check     bool
+		threshold uint32
+	}{
+		{check: true, threshold: 75},
+		{check: true, threshold: 0},
+		{check: false, threshold: 0},
+	}
+	for i, want := range wants {
+		if got := assignments[i]; got.GetPlagiarismCheck() != want.check || got.GetSimilarityThreshold() != want.threshold {
+			t.Errorf("%s: PlagiarismCheck, SimilarityThreshold = %t, %d, want %t, %d", got.GetName(),
+				got.GetPlagiarismCheck(), got.GetSimilarityThreshold(), want.check, want.threshold)
+		}
+	}
+}
+
+func TestParsePlagiarismCheckInvalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings string
+		wantErr  string
+	}{
+		{name: "threshold above 100", settings: "plagiarismcheck: true\nsimilaritythreshold: 101\n", wantErr: "similaritythreshold 101 is above 100"},
+		{name: "threshold without check", settings: "similaritythreshold: 50\n", wantErr: "similaritythreshold requires plagiarismcheck"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			testsDir := createTestsRepo(t, map[string]string{
+				"lab1/assignment.yml": "assignmentid: 1\ndeadline: \"27-08-2018 12:00\"\n" + test.settings,
+			})
+			_, _, err := parseAssignments(testsDir, 0)
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Errorf("parseAssignments() error = %v, want error containing %q", err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseNetwork(t *testing.T) {
+	for _, network := range []string{pb.NetworkNone, pb.NetworkInternal, pb.NetworkLimited, pb.NetworkFull, ""} {
+		t.Run(network, func(t *testing.T) {
+			contents := "assignmentid: 1\ndeadline: \"27-08-2018 12:00\"\n"
+			if network != "" {
+				contents += "network: " + network + "\n"
+			}
+			testsDir := createTestsRepo(t, map[string]string{"lab1/assignment.yml": contents})
+			assignments, _, err := parseAssignments(testsDir, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := network
+			if want == "" {
+				want = pb.NetworkLimited
+			}
+			if got := assignments[0].NetworkPolicy(); got != want {
+				t.Errorf("NetworkPolicy() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestParseNetworkInvalid(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": `assignmentid: 1
+deadline: "27-08-2018 12:00"
+network: host
+`,
+	})
+	_, _, err := parseAssignments(testsDir, 0)
+	if err == nil || !strings.Contains(err.Error(), `unknown network policy "host"`) {
+		t.Errorf("parseAssignments() error = %v, want unknown network policy error", err)
+	}
+}
+
+func TestParseAllowedHosts(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings string
+		want     []string
+		wantErr  string
+	}{
+		{name: "default registries", settings: "network: limited\n", want: []string{"proxy.golang.org", "sum.golang.org", "pypi.org", "files.pythonhosted.org", "registry.npmjs.org"}},
+		{name: "allowlist", settings: "network: limited\nallowedhosts: [pypi.org, \"*.GitHub.com\"]\n", want: []string{"pypi.org", "*.github.com"}},
+		{name: "default network", settings: "allowedhosts: [pypi.org]\n", want: []string{"pypi.org"}},
+		{name: "without limited network", settings: "network: full\nallowedhosts: [pypi.org]\n", wantErr: "allowedhosts requires network limited"},
+		{name: "url", settings: "network: limited\nallowedhosts: [\"https://pypi.org\"]\n", wantErr: `invalid host "https://pypi.org"`},
+		{name: "port", settings: "network: limited\nallowedhosts: [\"pypi.org:443\"]\n", wantErr: `invalid host "pypi.org:443"`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			testsDir := createTestsRepo(t, map[string]string{
+				"lab1/assignment.yml": "assignmentid: 1\ndeadline: \"27-08-2018 12:00\"\n" + test.settings,
+			})
+			assignments, _, err := parseAssignments(testsDir, 0)
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Errorf("parseAssignments() error = %v, want error containing %q", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.want, assignments[0].AllowedHostNames()); diff != "" {
+				t.Errorf("AllowedHostNames() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+const weightedCriteria = `[
+	{
+		"heading": "Design",
+		"weight": 20,
+		"criteria": [
+			{"description": "Clear package structure", "points": 5},
+			{"description": "Documented exported API", "points": 15}
+		]
+	},
+	{
+		"heading": "Report",
+		"weight": 10,
+		"criteria": [
+			{"description": "Explains the approach"},
+			{"description": "Discusses alternatives"}
+		]
+	}
+]`
+
+func TestParseBenchmarkWeights(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": "assignmentid: 1\ndeadline: \"27-08-2018 12:00\"\n",
+		"lab1/criteria.json":  weightedCriteria,
+	})
+	assignments, _, err := parseAssignments(testsDir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	benchmarks := assignments[0].GetGradingBenchmarks()
+	wantWeights := []int32{20, 10}
+	if len(benchmarks) != len(wantWeights) {
+		t.Fatalf("got %d benchmarks, want %d", len(benchmarks), len(wantWeights))
+	}
+	for i, bm := range benchmarks {
+		if bm.GetWeight() != wantWeights[i] {
+			t.Errorf("%s: Weight = %d, want %d", bm.GetHeading(), bm.GetWeight(), wantWeights[i])
+		}
+	}
+
+	// Design: 15 of 20 points; Report: 1 of 2 criteria; tests: 80%
+	review := &pb.Review{GradingBenchmarks: benchmarks}
+	benchmarks[0].Criteria[1].Grade = pb.GradingCriterion_PASSED
+	benchmarks[1].Criteria[0].Grade = pb.GradingCriterion_PASSED
+	// (80*70 + 75*20 + 50*10) / 100 = 76
+	if got := review.CombinedGrade(80); got != 76 {
+		t.Errorf("CombinedGrade(80) = %d, want 76", got)
+	}
+}
+
+func TestParseBenchmarkWeightsInvalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		criteria string
+		wantErr  string
+	}{
+		{name: "negative weight", criteria: `[{"heading": "Design", "weight": -10, "criteria": [{"description": "Clear"}]}]`, wantErr: "negative weight -10"},
+		{name: "weight without criteria", criteria: `[{"heading": "Design", "weight": 10}]`, wantErr: "no criteria"},
+		{name: "total above 100", criteria: `[{"heading": "Design", "weight": 60, "criteria": [{"description": "Clear"}]}, {"heading": "Report", "weight": 50, "criteria": [{"description": "Clear"}]}]`, wantErr: "add up to 110"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testsDir := createTestsRepo(t, map[string]string{
+				"lab1/assignment.yml": "assignmentid: 1\ndeadline: \"27-08-2018 12:00\"\n",
+				"lab1/criteria.json":  tt.criteria,
+			})
+			_, _, err := parseAssignments(testsDir, 0)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("parseAssignments() error = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseParallel(t *testing.T) {
+	tests := []struct {
+		name     string
+		parallel string
+		want     int
+	}{
+		{name: "unset", parallel: "", want: 1},
+		{name: "serial", parallel: "parallel: 1\n", want: 1},
+		{name: "parallel", parallel: "parallel: 4\n", want: 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testsDir := createTestsRepo(t, map[string]string{
+				"lab1/assignment.yml": "assignmentid: 1\ndeadline: \"27-08-2018 12:00\"\n" + tt.parallel,
+			})
+			assignments, _, err := parseAssignments(testsDir, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := assignments[0].Parallelism(); got != tt.want {
+				t.Errorf("Parallelism() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseParallelInvalid(t *testing.T) {
+	for _, parallel := range []string{"-2", "yes"} {
+		t.Run(parallel, func(t *testing.T) {
+			testsDir := createTestsRepo(t, map[string]string{
+				"lab1/assignment.yml": "assignmentid: 1\ndeadline: \"27-08-2018 12:00\"\nparallel: " + parallel + "\n",
+			})
+			if _, _, err := parseAssignments(testsDir, 0); err == nil {
+				t.Errorf("parseAssignments() succeeded, want error for parallel: %s", parallel)
+			}
+		})
+	}
+}
+
+func TestParseDiffMode(t *testing.T) {
+	for _, diffMode := range []string{score.DiffExact, score.DiffTrimmed, score.DiffNormalized, ""} {
+		t.Run(diffMode, func(t *testing.T) {
+			contents := "assignmentid: 1\ndeadline: \"27-08-2018 12:00\"\n"
+			if diffMode != "" {
+				contents += "diffmode: " + diffMode + "\n"
+			}
+			testsDir := createTestsRepo(t, map[string]string{"lab1/assignment.yml": contents})
+			assignments, _, err := parseAssignments(testsDir, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := assignments[0].GetDiffMode(); got != diffMode {
+				t.Errorf("DiffMode = %q, want %q", got, diffMode)
+			}
+		})
+	}
+}
+
+func TestParseDiffModeInvalid(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": `assignmentid: 1
+deadline: "27-08-2018 12:00"
+diffmode: fuzzy
+`,
+	})
+	_, _, err := parseAssignments(testsDir, 0)
+	if err == nil || !strings.Contains(err.Error(), `unknown diffmode "fuzzy"`) {
+		t.Errorf("parseAssignments() error = %v, want unknown diffmode error", err)
+	}
+}
+
+func TestParseTestFormat(t *testing.T) {
+	for _, testFormat := range []string{pb.TestFormatText, pb.TestFormatJSON, ""} {
+		t.Run(testFormat, func(t *testing.T) {
+			contents := "assignmentid: 1\ndeadline: \"27-08-2018 12:00\"\n"
+			if testFormat != "" {
+				contents += "testformat: " + testFormat + "\n"
+			}
+			testsDir := createTestsRepo(t, map[string]string{"lab1/assignment.yml": contents})
+			assignments, _, err := parseAssignments(testsDir, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := assignments[0].GetTestFormat(); got != testFormat {
+				t.Errorf("TestFormat = %q, want %q", got, testFormat)
+			}
+		})
+	}
+}
+
+func TestParseTestFormatInvalid(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": `assignmentid: 1
+deadline: "27-08-2018 12:00"
+testformat: junit
+`,
+	})
+	_, _, err := parseAssignments(testsDir, 0)
+	if err == nil || !strings.Contains(err.Error(), `unknown testformat "junit"`) {
+		t.Errorf("parseAssignments() error = %v, want unknown testformat error", err)
+	}
+}
+
+func TestParseOutputLimit(t *testing.T) {
+	tests := []struct {
+		name        string
+		outputLimit string
+		want        uint32
+		wantErr     bool
+	}{
+		{name: "unset", outputLimit: "", want: 0},
+		{name: "within bounds", outputLimit: "outputlimit: 5000\n", want: 5000},
+		{name: "too small", outputLimit: "outputlimit: 10\n", wantErr: true},
+		{name: "too large", outputLimit: "outputlimit: 5000000\n", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testsDir := createTestsRepo(t, map[string]string{
+				"lab1/assignment.yml": "assignmentid: 1\ndeadline: \"27-08-2018 12:00\"\n" + tt.outputLimit,
+			})
+			assignments, _, err := parseAssignments(testsDir, 0)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseAssignments() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if err == nil && assignments[0].GetOutputLimit() != tt.want {
+				t.Errorf("OutputLimit = %d, want %d", assignments[0].GetOutputLimit(), tt.want)
+			}
+		})
+	}
+}
+
+func TestParseLimits(t *testing.T) {
+	tests := []struct {
+		name    string
+		limits  string
+		want    *pb.Assignment
+		wantErr bool
+	}{
+		{name: "unset", limits: "", want: &pb.Assignment{}},
+		{name: "all limits", limits: "limits:\n  cpus: 1.5\n  memory: 512m\n  pids: 100\n  disk: 1g\n",
+			want: &pb.Assignment{CpuLimit: 1500, MemoryLimit: 512 << 20, PidsLimit: 100, DiskLimit: 1 << 30}},
+		{name: "fraction of a cpu", limits: "limits:\n  cpus: 0.25\n", want: &pb.Assignment{CpuLimit: 250}},
+		{name: "too few cpus", limits: "limits:\n  cpus: 0.001\n", wantErr: true},
+		{name: "negative cpus", limits: "limits:\n  cpus: -1\n", wantErr: true},
+		{name: "too little memory", limits: "limits:\n  memory: 1m\n", wantErr: true},
+		{name: "malformed memory", limits: "limits:\n  memory: lots\n", wantErr: true},
+		{name: "malformed disk", limits: "limits:\n  disk: 1x\n", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testsDir := createTestsRepo(t, map[string]string{
+				"lab1/assignment.yml": "assignmentid: 1\ndeadline: \"27-08-2018 12:00\"\n" + tt.limits,
+			})
+			assignments, _, err := parseAssignments(testsDir, 0)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseAssignments() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			got := assignments[0]
+			if got.GetCpuLimit() != tt.want.GetCpuLimit() || got.GetMemoryLimit() != tt.want.GetMemoryLimit() ||
+				got.GetPidsLimit() != tt.want.GetPidsLimit() || got.GetDiskLimit() != tt.want.GetDiskLimit() {
+				t.Errorf("limits = (%d, %d, %d, %d), want (%d, %d, %d, %d)",
+					got.GetCpuLimit(), got.GetMemoryLimit(), got.GetPidsLimit(), got.GetDiskLimit(),
+					tt.want.GetCpuLimit(), tt.want.GetMemoryLimit(), tt.want.GetPidsLimit(), tt.want.GetDiskLimit())
+			}
+		})
+	}
+}
+
+func TestParseCoverage(t *testing.T) {
+	tests := []struct {
+		name     string
+		coverage string
+		want     *pb.Assignment
+		wantErr  bool
+	}{
+		{name: "unset", coverage: "", want: &pb.Assignment{}},
+		{name: "default profile", coverage: "coverage:\n  weight: 10\n", want: &pb.Assignment{CoverageProfile: "coverage.out", CoverageWeight: 10}},
+		{name: "all settings", coverage: "coverage:\n  profile: cover/./lcov.info\n  weight: 5\n  target: 80\n",
+			want: &pb.Assignment{CoverageProfile: "cover/lcov.info", CoverageWeight: 5, CoverageTarget: 80}},
+		{name: "target above 100", coverage: "coverage:\n  target: 101\n", wantErr: true},
+		{name: "absolute profile", coverage: "coverage:\n  profile: /tmp/coverage.out\n", wantErr: true},
+		{name: "profile outside artifacts", coverage: "coverage:\n  profile: ../coverage.out\n", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testsDir := createTestsRepo(t, map[string]string{
+				"lab1/assignment.yml": "assignmentid: 1\ndeadline: \"27-08-2018 12:00\"\n" + tt.coverage,
+			})
+			assignments, _, err := parseAssignments(testsDir, 0)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseAssignments() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			got := assignments[0]
+			if got.GetCoverageProfile() != tt.want.GetCoverageProfile() || got.GetCoverageWeight() != tt.want.GetCoverageWeight() ||
+				got.GetCoverageTarget() != tt.want.GetCoverageTarget() {
+				t.Errorf("coverage = (%q, %d, %d), want (%q, %d, %d)",
+					got.GetCoverageProfile(), got.GetCoverageWeight(), got.GetCoverageTarget(),
+					tt.want.GetCoverageProfile(), tt.want.GetCoverageWeight(), tt.want.GetCoverageTarget())
+			}
+		})
+	}
+}
+
+func TestParseAnalysis(t *testing.T) {
+	const yml = "assignmentid: 1\ndeadline: \"27-08-2018 12:00\"\nlanguage: go\n"
+	tests := []struct {
+		name     string
+		analysis string
+		script   string // run.sh in the assignment folder; empty for the default script
+		want     []*pb.AnalysisCheck
+		wantErr  bool
+	}{
+		{name: "unset", analysis: "", want: nil},
+		{name: "tools and commands", analysis: "analysis:\n  - tool: govet\n    weight: 5\n  - name: fmt\n    command: test -z \"$(gofmt -l .)\"\n    penalty: 20\n",
+			want: []*pb.AnalysisCheck{
+				{Name: "govet", Command: "go vet ./...", Weight: 5},
+				{Name: "fmt", Command: `test -z "$(gofmt -l .)"`, Penalty: 20},
+			}},
+		{name: "named tool", analysis: "analysis:\n  - name: lint\n    tool: golangci-lint\n",
+			want: []*pb.AnalysisCheck{{Name: "lint", Command: "golangci-lint run --out-format=line-number ./..."}}},
+		{name: "script runs analysis", analysis: "analysis:\n  - tool: govet\n", script: "#image/golang\n{{ .Analysis }}\ngo test ./...\n",
+			want: []*pb.AnalysisCheck{{Name: "govet", Command: "go vet ./..."}}},
+		{name: "script without analysis", analysis: "analysis:\n  - tool: govet\n", script: "#image/golang\ngo test ./...\n", wantErr: true},
+		{name: "unknown tool", analysis: "analysis:\n  - tool: pylint\n", wantErr: true},
+		{name: "tool and command", analysis: "analysis:\n  - tool: govet\n    command: go vet\n", wantErr: true},
+		{name: "no tool or command", analysis: "analysis:\n  - name: vet\n", wantErr: true},
+		{name: "invalid name", analysis: "analysis:\n  - name: go vet\n    command: go vet ./...\n", wantErr: true},
+		{name: "duplicate name", analysis: "analysis:\n  - tool: govet\n  - tool: govet\n", wantErr: true},
+		{name: "penalty above 100", analysis: "analysis:\n  - tool: govet\n    penalty: 101\n", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := map[string]string{"lab1/assignment.yml": yml + tt.analysis}
+			if tt.script != "" {
+				files["lab1/run.sh"] = tt.script
+			}
+			assignments, _, err := parseAssignments(createTestsRepo(t, files), 0)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseAssignments() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tt.want, assignments[0].GetAnalysisChecks(), protocmp.Transform()); diff != "" {
+				t.Errorf("AnalysisChecks mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseRetryOnInfra(t *testing.T) {
+	tests := []struct {
+		name         string
+		retryOnInfra string
+		want         uint32
+		wantErr      bool
+	}{
+		{name: "unset", retryOnInfra: "", want: 0},
+		{name: "set", retryOnInfra: "retryoninfra: 3\n", want: 3},
+		{name: "too many", retryOnInfra: "retryoninfra: 10\n", wantErr: true},
+		{name: "negative", retryOnInfra: "retryoninfra: -1\n", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testsDir := createTestsRepo(t, map[string]string{
+				"lab1/assignment.yml": "assignmentid: 1\ndeadline: \"27-08-2018 12:00\"\n" + tt.retryOnInfra,
+			})
+			assignments, _, err := parseAssignments(testsDir, 0)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseAssignments() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if err == nil && assignments[0].GetRetryOnInfra() != tt.want {
+				t.Errorf("RetryOnInfra = %d, want %d", assignments[0].GetRetryOnInfra(), tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDeadlines(t *testing.T) {
+	tests := []struct {
+		name          string
+		yml           string
+		wantDeadline  string
+		wantCloseDate string
+		wantGrace     uint32
+		wantErr       bool
+	}{
+		{
+			name:         "deadline only",
+			yml:          "deadline: \"27-08-2018 12:00\"\n",
+			wantDeadline: "2018-08-27T12:00:00",
+		},
+		{
+			name:          "soft and hard deadlines",
+			yml:           "deadlines:\n  soft: \"27-08-2018 12:00\"\n  hard: \"30-08-2018 12:00\"\n  gracehours: 6\n",
+			wantDeadline:  "2018-08-27T12:00:00",
+			wantCloseDate: "2018-08-30T12:00:00",
+			wantGrace:     6,
+		},
+		{
+			name:    "deadline and soft deadline",
+			yml:     "deadline: \"27-08-2018 12:00\"\ndeadlines:\n  soft: \"27-08-2018 12:00\"\n",
+			wantErr: true,
+		},
+		{
+			name:    "close date and hard deadline",
+			yml:     "closedate: \"30-08-2018 12:00\"\ndeadlines:\n  soft: \"27-08-2018 12:00\"\n  hard: \"30-08-2018 12:00\"\n",
+			wantErr: true,
+		},
+		{
+			name:    "hard before soft deadline",
+			yml:     "deadlines:\n  soft: \"27-08-2018 12:00\"\n  hard: \"26-08-2018 12:00\"\n",
+			wantErr: true,
+		},
+		{
+			name:    "grace period too long",
+			yml:     "deadlines:\n  soft: \"27-08-2018 12:00\"\n  gracehours: 200\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testsDir := createTestsRepo(t, map[string]string{
+				"lab1/assignment.yml": "assignmentid: 1\n" + tt.yml,
+			})
+			assignments, _, err := parseAssignments(testsDir, 0)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseAssignments() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			a := assignments[0]
+			var closeDate string
+			if a.GetCloseDate() != nil {
+				closeDate = a.GetCloseDate().AsTime().In(time.Local).Format(pb.TimeLayout)
+			}
+			if a.GetDeadline() != tt.wantDeadline || closeDate != tt.wantCloseDate || a.GetGraceHours() != tt.wantGrace {
+				t.Errorf("deadline, closedate, gracehours = %q, %q, %d, want %q, %q, %d",
+					a.GetDeadline(), closeDate, a.GetGraceHours(), tt.wantDeadline, tt.wantCloseDate, tt.wantGrace)
+			}
+		})
+	}
+}
+
+func TestParseAssignmentFileFormats(t *testing.T) {
+	tests := []struct {
+		name     string
+		filename string
+		contents string
+		wantErr  bool
+	}{
+		{
+			name:     "yaml",
+			filename: "assignment.yml",
+			contents: "assignmentid: 1\ndeadline: \"27-08-2018 12:00\"\nautoapprove: true\nretrytests:\n  - TestFlaky\ntestsrepo:\n  url: https://github.com/org/tests.git\n",
+		},
+		{
+			name:     "json",
+			filename: "assignment.json",
+			contents: `{"assignmentid": 1, "deadline": "27-08-2018 12:00", "autoapprove": true, "retrytests": ["TestFlaky"], "testsrepo": {"url": "https://github.com/org/tests.git"}}`,
+		},
+		{
+			name:     "toml",
+			filename: "assignment.toml",
+			contents: "assignmentid = 1\ndeadline = 2018-08-27T12:00:00\nautoapprove = true\nretrytests = [\"TestFlaky\"]\n\n[testsrepo]\nurl = \"https://github.com/org/tests.git\"\n",
+		},
+		{
+			name:     "toml with string deadline",
+			filename: "assignment.toml",
+			contents: "assignmentid = 1\ndeadline = \"27-08-2018 12:00\"\nautoapprove = true\nretrytests = [\"TestFlaky\"]\ntestsrepo = { url = \"https://github.com/org/tests.git\" }\n",
+		},
+		{
+			name:     "malformed json",
+			filename: "assignment.json",
+			contents: `{"assignmentid": 1,}`,
+			wantErr:  true,
+		},
+		{
+			name:     "malformed toml",
+			filename: "assignment.toml",
+			contents: "assignmentid = \n",
+			wantErr:  true,
+		},
+	}
+	want := &pb.Assignment{
+		Name:         "lab1",
+		Order:        1,
+		Deadline:     "2018-08-27T12:00:00",
+		DeadlineTime: timestamppb.New(time.Date(2018, 8, 27, 12, 0, 0, 0, time.Local)),
+		AutoApprove:  true,
+		ScoreLimit:   defaultAutoApproveScoreLimit,
+		Retries:      defaultRetries,
+		Tests:        []*pb.TestConfig{{TestName: "TestFlaky", Retryable: true}},
+		TestsRepoURL: "https://github.com/org/tests.git",
+		ScriptFile:   defaultTestScript,
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testsDir := createTestsRepo(t, map[string]string{
+				"lab1/" + tt.filename: tt.contents,
+			})
+			assignments, _, err := parseAssignments(testsDir, 0)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseAssignments() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if len(assignments) != 1 {
+				t.Fatalf("parseAssignments() returned %d assignments, want 1", len(assignments))
+			}
+			if diff := cmp.Diff(want, assignments[0], protocmp.Transform()); diff != "" {
+				t.Errorf("parseAssignments() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseAssignmentFileDefinedTwice(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml":  "assignmentid: 1\n",
+		"lab1/assignment.json": `{"assignmentid": 1}`,
+	})
+	if _, _, err := parseAssignments(testsDir, 0); err == nil {
+		t.Error("parseAssignments() succeeded for assignment defined by two files, want error")
+	}
+}
+
+func TestParseTimeZone(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	testsDir := createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": "assignmentid: 1\ndeadline: \"27-08-2018 12:00\"\ntimezone: America/New_York\n",
+	})
+	assignments, _, err := parseAssignments(testsDir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := assignments[0]
+	want := time.Date(2018, 8, 27, 12, 0, 0, 0, newYork)
+	if got := a.GetDeadlineTime().AsTime(); !got.Equal(want) {
+		t.Errorf("deadline = %v, want %v", got, want)
+	}
+	if a.GetDeadline() != "2018-08-27T12:00:00" || a.GetTimeZone() != "America/New_York" {
+		t.Errorf("deadline, timezone = %q, %q, want %q, %q", a.GetDeadline(), a.GetTimeZone(), "2018-08-27T12:00:00", "America/New_York")
+	}
+
+	testsDir = createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": "assignmentid: 1\ndeadline: \"27-08-2018 12:00\"\ntimezone: Mars/Olympus\n",
+	})
+	if _, _, err := parseAssignments(testsDir, 0); err == nil {
+		t.Error("parseAssignments() succeeded for unknown timezone, want error")
+	}
+}
+
+func TestParseAssignmentDockerfiles(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		"scripts/Dockerfile":  "FROM ubuntu",
+		"lab1/assignment.yml": "assignmentid: 1\n",
+		"lab1/Dockerfile":     "FROM rust",
+		"lab2/assignment.yml": "assignmentid: 2\ndockerimage: python:3.9\n",
+	})
+	assignments, data, err := parseAssignments(testsDir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data.dockerfile != "FROM ubuntu" {
+		t.Errorf("course Dockerfile = %q, want %q", data.dockerfile, "FROM ubuntu")
+	}
+	if got, want := data.dockerDirs["lab1"], filepath.Join(testsDir, "lab1"); got != want {
+		t.Errorf("Dockerfile folder of lab1 = %q, want %q", got, want)
+	}
+	if got := assignments[0].GetDockerfile(); got != "FROM rust" {
+		t.Errorf("Dockerfile of lab1 = %q, want %q", got, "FROM rust")
+	}
+	if got := assignments[1].GetDockerImage(); got != "python:3.9" {
+		t.Errorf("dockerimage of lab2 = %q, want %q", got, "python:3.9")
+	}
+
+	testsDir = createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": "assignmentid: 1\ndockerimage: \"python 3\"\n",
+	})
+	if _, _, err := parseAssignments(testsDir, 0); err == nil {
+		t.Error("parseAssignments() succeeded for invalid dockerimage, want error")
+	}
+	testsDir = createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": "assignmentid: 1\n",
+		"lab9/Dockerfile":     "FROM rust",
+	})
+	if _, _, err := parseAssignments(testsDir, 0); err == nil {
+		t.Error("parseAssignments() succeeded for Dockerfile without assignment, want error")
+	}
+}
+
+func TestParseHiddenTests(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": "assignmentid: 1\nhiddentests: [TestLarge, TestEdgeCases]\nretrytests: [TestLarge]\n",
+		"lab2/assignment.yml": "assignmentid: 2\n",
+	})
+	assignments, _, err := parseAssignments(testsDir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"TestLarge", "TestEdgeCases"}, assignments[0].HiddenTests()); diff != "" {
+		t.Errorf("HiddenTests() mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"TestLarge"}, assignments[0].RetryableTests()); diff != "" {
+		t.Errorf("RetryableTests() mismatch (-want +got):\n%s", diff)
+	}
+	if hidden := assignments[1].HiddenTests(); len(hidden) != 0 {
+		t.Errorf("HiddenTests() = %v, want none", hidden)
+	}
+
+	testsDir = createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": "assignmentid: 1\nhiddentests: [\"\"]\n",
+	})
+	if _, _, err := parseAssignments(testsDir, 0); err == nil {
+		t.Error("parseAssignments() succeeded for empty test name in hiddentests, want error")
+	}
+}
+
+func TestParseRandomSeed(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": "assignmentid: 1\nrandomseed: true\n",
+		"lab2/assignment.yml": "assignmentid: 2\n",
+	})
+	assignments, _, err := parseAssignments(testsDir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !assignments[0].GetRandomSeed() {
+		t.Errorf("%s: RandomSeed = false, want true", assignments[0].GetName())
+	}
+	if assignments[1].GetRandomSeed() {
+		t.Errorf("%s: RandomSeed = true, want false", assignments[1].GetName())
+	}
+}
+
+func TestParseSecrets(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": "assignmentid: 1\nsecrets:\n  API_KEY: apikey\n  DB_PASSWORD: dbpassword\n",
+		"lab2/assignment.yml": "assignmentid: 2\n",
+	})
+	assignments, _, err := parseAssignments(testsDir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := assignments[0].GetSecrets(), "API_KEY=apikey,DB_PASSWORD=dbpassword"; got != want {
+		t.Errorf("%s: Secrets = %q, want %q", assignments[0].GetName(), got, want)
+	}
+	wantVariables := map[string]string{"API_KEY": "apikey", "DB_PASSWORD": "dbpassword"}
+	if diff := cmp.Diff(wantVariables, assignments[0].SecretVariables()); diff != "" {
+		t.Errorf("SecretVariables() mismatch (-want +got):\n%s", diff)
+	}
+	if variables := assignments[1].SecretVariables(); variables != nil {
+		t.Errorf("%s: SecretVariables() = %v, want nil", assignments[1].GetName(), variables)
+	}
+
+	for _, secrets := range []string{
+		"1KEY: apikey",
+		"API-KEY: apikey",
+		"QUICKFEED_SEED: seed",
+		"API_KEY: \"\"",
+		"API_KEY: \"api,key\"",
+		"API_KEY: \"api=key\"",
+	} {
+		testsDir := createTestsRepo(t, map[string]string{
+			"lab1/assignment.yml": "assignmentid: 1\nsecrets:\n  " + secrets + "\n",
+		})
+		if _, _, err := parseAssignments(testsDir, 0); err == nil {
+			t.Errorf("parseAssignments() succeeded for secrets {%s}, want error", secrets)
+		}
+	}
+}
+
+func TestParseApproval(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": `assignmentid: 1
+autoapprove: true
+approval:
+  consecutiveruns: 3
+  beforedeadline: true
+  tests: [TestCore, TestAPI]
+`,
+		"lab2/assignment.yml": "assignmentid: 2\nautoapprove: true\napproval:\n  manualreview: true\n",
+	})
+	assignments, _, err := parseAssignments(testsDir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lab1, lab2 := assignments[0], assignments[1]
+	if lab1.GetApprovalRuns() != 3 || !lab1.GetApproveBeforeDeadline() || lab1.GetManualReview() {
+		t.Errorf("lab1: ApprovalRuns = %d, ApproveBeforeDeadline = %t, ManualReview = %t, want 3, true, false",
+			lab1.GetApprovalRuns(), lab1.GetApproveBeforeDeadline(), lab1.GetManualReview())
+	}
+	if diff := cmp.Diff([]string{"TestCore", "TestAPI"}, lab1.ApprovalTests()); diff != "" {
+		t.Errorf("ApprovalTests() mismatch (-want +got):\n%s", diff)
+	}
+	if !lab2.GetManualReview() || lab2.GetApprovalRuns() != 0 || len(lab2.ApprovalTests()) != 0 {
+		t.Errorf("lab2: ManualReview = %t, ApprovalRuns = %d, ApprovalTests() = %v, want true, 0, none",
+			lab2.GetManualReview(), lab2.GetApprovalRuns(), lab2.ApprovalTests())
+	}
+
+	testsDir = createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": "assignmentid: 1\nautoapprove: true\napproval:\n  tests: [\"\"]\n",
+	})
+	if _, _, err := parseAssignments(testsDir, 0); err == nil {
+		t.Error("parseAssignments() succeeded for empty test name in approval tests, want error")
+	}
+	testsDir = createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": "assignmentid: 1\nlanguage: go\napproval:\n  consecutiveruns: 2\n",
+	})
+	if _, _, err := parseAssignments(testsDir, 0); err == nil {
+		t.Error("parseAssignments() succeeded for approval without autoapprove, want error")
+	}
+}
+
+func TestParseMatrix(t *testing.T) {
+	tests := []struct {
+		name    string
+		matrix  string
+		want    []pb.MatrixEntry
+		wantErr bool
+	}{
+		{name: "unset", matrix: "", want: nil},
+		{name: "entries", matrix: "matrix:\n  - name: go1.19\n    image: golang:1.19\n  - name: go1.20\n    image: golang:1.20-alpine\n",
+			want: []pb.MatrixEntry{{Name: "go1.19", Image: "golang:1.19"}, {Name: "go1.20", Image: "golang:1.20-alpine"}}},
+		{name: "too many entries", matrix: "matrix:\n" + strings.Repeat("  - name: a\n    image: golang\n", 6), wantErr: true},
+		{name: "duplicate name", matrix: "matrix:\n  - name: go\n    image: golang:1.19\n  - name: go\n    image: golang:1.20\n", wantErr: true},
+		{name: "invalid name", matrix: "matrix:\n  - name: go 1.19\n    image: golang:1.19\n", wantErr: true},
+		{name: "missing image", matrix: "matrix:\n  - name: go1.19\n", wantErr: true},
+		{name: "invalid image", matrix: "matrix:\n  - name: go1.19\n    image: golang:1.19,alpine\n", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testsDir := createTestsRepo(t, map[string]string{
+				"lab1/assignment.yml": "assignmentid: 1\ndeadline: \"27-08-2018 12:00\"\n" + tt.matrix,
+			})
+			assignments, _, err := parseAssignments(testsDir, 0)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseAssignments() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tt.want, assignments[0].BuildMatrix()); diff != "" {
+				t.Errorf("BuildMatrix() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package assignments
 import (
 	"context"
 	"testing"
+	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/scm"
@@ -34,3 +35,30 @@ func TestFetchAssignments(t *testing.T) {
 		t.Logf("assignment: %v", assignment)
 	}
 }
+
+func TestTimeUntilDeadline(t *testing.T) {
+	now := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		deadline string
+		want     time.Duration
+		wantErr  bool
+	}{
+		{name: "future", deadline: "2021-03-03T12:00:00", want: 48 * time.Hour},
+		{name: "now", deadline: "2021-03-01T12:00:00", want: 0},
+		{name: "past", deadline: "2021-03-01T11:30:00", want: -30 * time.Minute},
+		{name: "invalid", deadline: "Invalid date format: tomorrow", wantErr: true},
+		{name: "missing", deadline: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TimeUntilDeadline(&pb.Assignment{Name: "lab1", Deadline: tt.deadline}, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TimeUntilDeadline() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("TimeUntilDeadline() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
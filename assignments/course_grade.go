@@ -0,0 +1,99 @@
+package assignments
+
+import (
+	"fmt"
+	"math"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// CourseGrade returns the final course grade computed from the given grades,
+// keyed by assignment name, where each assignment's grade is weighted by its
+// share of the course grade, as given by its 'courseweight'. The weights are
+// normalized if they do not add up to 100, and if no assignment has a weight,
+// all assignments count equally. Assignments without a grade count as zero.
+// An error is returned if a grade is given for an unknown assignment.
+func CourseGrade(assignmentGrades map[string]uint32, assignments []*pb.Assignment) (uint32, error) {
+	weights := make(map[string]uint32, len(assignments))
+	var totalWeight uint64
+	for _, assignment := range assignments {
+		weights[assignment.GetName()] = assignment.GetCourseWeight()
+		totalWeight += uint64(assignment.GetCourseWeight())
+	}
+	for name := range assignmentGrades {
+		if _, ok := weights[name]; !ok {
+			return 0, fmt.Errorf("grade for unknown assignment %s", name)
+		}
+	}
+	if len(assignments) == 0 {
+		return 0, nil
+	}
+	if totalWeight == 0 {
+		// no weights given; all assignments count equally
+		for name := range weights {
+			weights[name] = 1
+		}
+		totalWeight = uint64(len(weights))
+	}
+	var weightedSum float64
+	for name, grade := range assignmentGrades {
+		weightedSum += float64(grade) * float64(weights[name])
+	}
+	return uint32(math.Round(weightedSum / float64(totalWeight))), nil
+}
+
+// CourseGradeByModules returns the final course grade computed from the given
+// grades, keyed by assignment name, along with the grade of each module. Each
+// module's grade is computed by CourseGrade from the grades of its assignments,
+// and the course grade is the modules' grades weighted by their 'weight'. The
+// module weights are normalized if they do not add up to 100, and if no module
+// has a weight, all modules count equally. Assignments that do not belong to
+// a module do not count, nor do a module's assignments that no longer exist.
+// If there are no modules, the course grade is computed by CourseGrade from
+// all assignments.
+func CourseGradeByModules(assignmentGrades map[string]uint32, assignments []*pb.Assignment, modules []*pb.CourseModule) (uint32, []*pb.ModuleGrade, error) {
+	if len(modules) == 0 {
+		grade, err := CourseGrade(assignmentGrades, assignments)
+		return grade, nil, err
+	}
+	for name := range assignmentGrades {
+		if findAssignmentByName(assignments, name) == nil {
+			return 0, nil, fmt.Errorf("grade for unknown assignment %s", name)
+		}
+	}
+	moduleGrades := make([]*pb.ModuleGrade, 0, len(modules))
+	// each module is weighted as a pseudo-assignment by CourseGrade
+	grades := make(map[string]uint32, len(modules))
+	weighted := make([]*pb.Assignment, 0, len(modules))
+	for _, module := range modules {
+		var moduleAssignments []*pb.Assignment
+		moduleAssignmentGrades := make(map[string]uint32)
+		for _, name := range module.AssignmentNames() {
+			assignment := findAssignmentByName(assignments, name)
+			if assignment == nil {
+				// the assignment was removed from the tests repository after the module was recorded
+				continue
+			}
+			moduleAssignments = append(moduleAssignments, assignment)
+			if grade, ok := assignmentGrades[name]; ok {
+				moduleAssignmentGrades[name] = grade
+			}
+		}
+		grade, err := CourseGrade(moduleAssignmentGrades, moduleAssignments)
+		if err != nil {
+			return 0, nil, err
+		}
+		moduleGrades = append(moduleGrades, &pb.ModuleGrade{
+			Name:   module.GetName(),
+			Weight: module.GetWeight(),
+			Grade:  grade,
+		})
+		grades[module.GetName()] = grade
+		weighted = append(weighted, &pb.Assignment{Name: module.GetName(), CourseWeight: module.GetWeight()})
+	}
+	grade, err := CourseGrade(grades, weighted)
+	if err != nil {
+		return 0, nil, err
+	}
+	return grade, moduleGrades, nil
+}
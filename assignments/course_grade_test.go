@@ -0,0 +1,164 @@
+package assignments
+
+import (
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestParseCourseWeight(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": "assignmentid: 1\ndeadline: \"27-08-2018 12:00\"\ncourseweight: 30\n",
+		"lab2/assignment.yml": "assignmentid: 2\ndeadline: \"27-08-2018 12:00\"\ncourseweight: 70\n",
+		"lab3/assignment.yml": "assignmentid: 3\ndeadline: \"27-08-2018 12:00\"\n",
+	})
+	assignments, _, err := parseAssignments(testsDir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantWeights := map[string]uint32{"lab1": 30, "lab2": 70, "lab3": 0}
+	for _, assignment := range assignments {
+		if got, want := assignment.GetCourseWeight(), wantWeights[assignment.GetName()]; got != want {
+			t.Errorf("%s: CourseWeight = %d, want %d", assignment.GetName(), got, want)
+		}
+	}
+
+	grade, err := CourseGrade(map[string]uint32{"lab1": 50, "lab2": 100, "lab3": 20}, assignments)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 0.3*50 + 0.7*100; lab3 does not count
+	if grade != 85 {
+		t.Errorf("CourseGrade() = %d, want 85", grade)
+	}
+}
+
+func TestCourseGrade(t *testing.T) {
+	weighted := []*pb.Assignment{
+		{Name: "lab1", CourseWeight: 20},
+		{Name: "lab2", CourseWeight: 30},
+		{Name: "exam", CourseWeight: 50},
+	}
+	tests := []struct {
+		name        string
+		grades      map[string]uint32
+		assignments []*pb.Assignment
+		want        uint32
+		wantErr     bool
+	}{
+		{name: "weights add up to 100", grades: map[string]uint32{"lab1": 100, "lab2": 80, "exam": 60}, assignments: weighted, want: 74},
+		{name: "missing grade", grades: map[string]uint32{"lab1": 100, "lab2": 80}, assignments: weighted, want: 44},
+		{name: "normalized weights", grades: map[string]uint32{"lab1": 90, "lab2": 60}, assignments: []*pb.Assignment{
+			{Name: "lab1", CourseWeight: 1},
+			{Name: "lab2", CourseWeight: 2},
+		}, want: 70},
+		{name: "no weights", grades: map[string]uint32{"lab1": 90, "lab2": 60}, assignments: []*pb.Assignment{
+			{Name: "lab1"},
+			{Name: "lab2"},
+		}, want: 75},
+		{name: "no assignments", grades: map[string]uint32{}, assignments: nil, want: 0},
+		{name: "unknown assignment", grades: map[string]uint32{"lab1": 100, "lab9": 80}, assignments: weighted, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CourseGrade(tt.grades, tt.assignments)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CourseGrade() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("CourseGrade() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCourseGradeByModules(t *testing.T) {
+	assignments := []*pb.Assignment{
+		{Name: "lab1", CourseWeight: 1},
+		{Name: "lab2", CourseWeight: 3},
+		{Name: "project"},
+		{Name: "quiz"},
+	}
+	modules := []*pb.CourseModule{
+		{Name: "labs", Weight: 60, Assignments: "lab1,lab2"},
+		{Name: "project", Weight: 40, Assignments: "project"},
+	}
+	tests := []struct {
+		name        string
+		grades      map[string]uint32
+		modules     []*pb.CourseModule
+		want        uint32
+		wantModules []*pb.ModuleGrade
+		wantErr     bool
+	}{
+		{
+			name:    "weighted modules",
+			grades:  map[string]uint32{"lab1": 40, "lab2": 80, "project": 90, "quiz": 100},
+			modules: modules,
+			// labs: 0.25*40 + 0.75*80 = 70; course: 0.6*70 + 0.4*90 = 78; quiz does not count
+			want: 78,
+			wantModules: []*pb.ModuleGrade{
+				{Name: "labs", Weight: 60, Grade: 70},
+				{Name: "project", Weight: 40, Grade: 90},
+			},
+		},
+		{
+			name:   "no module weights",
+			grades: map[string]uint32{"lab1": 40, "lab2": 80, "project": 90},
+			modules: []*pb.CourseModule{
+				{Name: "labs", Assignments: "lab1,lab2"},
+				{Name: "project", Assignments: "project"},
+			},
+			want: 80,
+			wantModules: []*pb.ModuleGrade{
+				{Name: "labs", Grade: 70},
+				{Name: "project", Grade: 90},
+			},
+		},
+		{
+			name:    "missing grades",
+			grades:  map[string]uint32{"project": 50},
+			modules: modules,
+			want:    20,
+			wantModules: []*pb.ModuleGrade{
+				{Name: "labs", Weight: 60, Grade: 0},
+				{Name: "project", Weight: 40, Grade: 50},
+			},
+		},
+		{
+			name:   "no modules",
+			grades: map[string]uint32{"lab1": 40, "lab2": 80},
+			// 0.25*40 + 0.75*80
+			want: 70,
+		},
+		{
+			name:    "unknown assignment",
+			grades:  map[string]uint32{"lab9": 100},
+			modules: modules,
+			wantErr: true,
+		},
+		{
+			name:        "removed module assignment",
+			grades:      map[string]uint32{"lab1": 100},
+			modules:     []*pb.CourseModule{{Name: "labs", Assignments: "lab1,lab9"}},
+			want:        100,
+			wantModules: []*pb.ModuleGrade{{Name: "labs", Grade: 100}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotModules, err := CourseGradeByModules(tt.grades, assignments, tt.modules)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CourseGradeByModules() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("CourseGradeByModules() = %d, want %d", got, tt.want)
+			}
+			if diff := cmp.Diff(tt.wantModules, gotModules, protocmp.Transform()); diff != "" {
+				t.Errorf("CourseGradeByModules() module grades mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package assignments
+
+import (
+	"fmt"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// maxCriteriaPoints is the highest total of the points given for the criteria of an
+// assignment, since the review score is the sum of the points of the passed criteria.
+const maxCriteriaPoints = 100
+
+// validateBenchmarks returns an error if the given benchmarks for the given assignment,
+// as parsed from a 'criteria.json' file, are invalid. Each benchmark must have a unique
+// heading and at least one criterion, and the criteria of each benchmark must have unique,
+// non-empty descriptions. Either all or none of a benchmark's criteria may give points,
+// and the points of all criteria must not add up to more than 100. The weights must
+// not be negative, and must not add up to more than 100.
+func validateBenchmarks(benchmarks []*pb.GradingBenchmark, assignmentName string) error {
+	headings := make(map[string]bool)
+	var totalWeight int32
+	var totalPoints uint64
+	for _, bm := range benchmarks {
+		heading := bm.GetHeading()
+		switch {
+		case heading == "":
+			return fmt.Errorf("benchmark for assignment %s in %q has no heading", assignmentName, criteriaFile)
+		case headings[heading]:
+			return fmt.Errorf("benchmark %q for assignment %s in %q is defined more than once", heading, assignmentName, criteriaFile)
+		case bm.GetWeight() < 0:
+			return fmt.Errorf("benchmark %q for assignment %s in %q has negative weight %d", heading, assignmentName, criteriaFile, bm.GetWeight())
+		case len(bm.GetCriteria()) == 0:
+			return fmt.Errorf("benchmark %q for assignment %s in %q has no criteria", heading, assignmentName, criteriaFile)
+		}
+		headings[heading] = true
+		totalWeight += bm.GetWeight()
+
+		descriptions := make(map[string]bool)
+		withPoints := 0
+		for _, c := range bm.GetCriteria() {
+			description := c.GetDescription()
+			switch {
+			case description == "":
+				return fmt.Errorf("benchmark %q for assignment %s in %q has a criterion without description", heading, assignmentName, criteriaFile)
+			case descriptions[description]:
+				return fmt.Errorf("benchmark %q for assignment %s in %q has criterion %q more than once", heading, assignmentName, criteriaFile, description)
+			}
+			descriptions[description] = true
+			if c.GetPoints() > 0 {
+				withPoints++
+			}
+			totalPoints += c.GetPoints()
+		}
+		if withPoints > 0 && withPoints < len(bm.GetCriteria()) {
+			return fmt.Errorf("benchmark %q for assignment %s in %q gives points for only %d of %d criteria; give points for all or none",
+				heading, assignmentName, criteriaFile, withPoints, len(bm.GetCriteria()))
+		}
+	}
+	if totalWeight > 100 {
+		return fmt.Errorf("benchmark weights for assignment %s in %q add up to %d; must not exceed 100", assignmentName, criteriaFile, totalWeight)
+	}
+	if totalPoints > maxCriteriaPoints {
+		return fmt.Errorf("criteria points for assignment %s in %q add up to %d; must not exceed %d", assignmentName, criteriaFile, totalPoints, maxCriteriaPoints)
+	}
+	return nil
+}
+
+// mergeBenchmarks assigns the IDs of the given stored benchmarks and their criteria
+// to the matching parsed benchmarks and criteria, such that changes to a criteria file
+// update the stored benchmarks in place, and reviews are not lost. Benchmarks are
+// matched by heading, and criteria by description within a matched benchmark.
+// Parsed benchmarks and criteria without a match get a zero ID.
+func mergeBenchmarks(stored, parsed []*pb.GradingBenchmark) {
+	byHeading := make(map[string]*pb.GradingBenchmark, len(stored))
+	for _, bm := range stored {
+		byHeading[bm.GetHeading()] = bm
+	}
+	for _, bm := range parsed {
+		byDescription := make(map[string]*pb.GradingCriterion)
+		bm.ID = 0
+		if storedBenchmark, ok := byHeading[bm.GetHeading()]; ok {
+			bm.ID = storedBenchmark.GetID()
+			for _, c := range storedBenchmark.GetCriteria() {
+				byDescription[c.GetDescription()] = c
+			}
+		}
+		for _, c := range bm.GetCriteria() {
+			c.ID = 0
+			c.BenchmarkID = bm.GetID()
+			if storedCriterion, ok := byDescription[c.GetDescription()]; ok {
+				c.ID = storedCriterion.GetID()
+			}
+		}
+	}
+}
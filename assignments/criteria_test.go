@@ -0,0 +1,74 @@
+package assignments
+
+import (
+	"strings"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestParseCriteriaInvalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		criteria string
+		wantErr  string
+	}{
+		{name: "no heading", criteria: `[{"criteria": [{"description": "Clear"}]}]`, wantErr: "has no heading"},
+		{name: "duplicate heading", criteria: `[{"heading": "Design", "criteria": [{"description": "Clear"}]}, {"heading": "Design", "criteria": [{"description": "Tidy"}]}]`, wantErr: "defined more than once"},
+		{name: "no criteria", criteria: `[{"heading": "Design", "criteria": []}]`, wantErr: "no criteria"},
+		{name: "no description", criteria: `[{"heading": "Design", "criteria": [{"points": 5}]}]`, wantErr: "without description"},
+		{name: "duplicate description", criteria: `[{"heading": "Design", "criteria": [{"description": "Clear"}, {"description": "Clear"}]}]`, wantErr: "more than once"},
+		{name: "points for some criteria", criteria: `[{"heading": "Design", "criteria": [{"description": "Clear", "points": 5}, {"description": "Tidy"}]}]`, wantErr: "only 1 of 2 criteria"},
+		{name: "points above 100", criteria: `[{"heading": "Design", "criteria": [{"description": "Clear", "points": 60}]}, {"heading": "Report", "criteria": [{"description": "Clear", "points": 50}]}]`, wantErr: "add up to 110"},
+		{name: "unknown field type", criteria: `[{"heading": "Design", "criteria": "Clear"}]`, wantErr: "could not unmarshal"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testsDir := createTestsRepo(t, map[string]string{
+				"lab1/assignment.yml": "assignmentid: 1\ndeadline: \"27-08-2018 12:00\"\n",
+				"lab1/criteria.json":  tt.criteria,
+			})
+			_, _, err := parseAssignments(testsDir, 0)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("parseAssignments() error = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMergeBenchmarks(t *testing.T) {
+	stored := []*pb.GradingBenchmark{
+		{ID: 1, AssignmentID: 7, Heading: "Design", Criteria: []*pb.GradingCriterion{
+			{ID: 10, BenchmarkID: 1, Description: "Clear", Points: 5},
+			{ID: 11, BenchmarkID: 1, Description: "Tidy", Points: 5},
+		}},
+		{ID: 2, AssignmentID: 7, Heading: "Report", Criteria: []*pb.GradingCriterion{
+			{ID: 20, BenchmarkID: 2, Description: "Explains"},
+		}},
+	}
+	parsed := []*pb.GradingBenchmark{
+		{Heading: "Design", Criteria: []*pb.GradingCriterion{
+			{Description: "Clear", Points: 10},
+			{Description: "Documented", Points: 10},
+		}},
+		{Heading: "Testing", Criteria: []*pb.GradingCriterion{
+			{Description: "Covers edge cases"},
+		}},
+	}
+	mergeBenchmarks(stored, parsed)
+	want := []*pb.GradingBenchmark{
+		{ID: 1, Heading: "Design", Criteria: []*pb.GradingCriterion{
+			// the changed points are kept, along with the criterion's ID
+			{ID: 10, BenchmarkID: 1, Description: "Clear", Points: 10},
+			{BenchmarkID: 1, Description: "Documented", Points: 10},
+		}},
+		{Heading: "Testing", Criteria: []*pb.GradingCriterion{
+			{Description: "Covers edge cases"},
+		}},
+	}
+	if diff := cmp.Diff(want, parsed, protocmp.Transform()); diff != "" {
+		t.Errorf("mergeBenchmarks() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,27 @@
+package assignments
+
+import (
+	"fmt"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// deadlineDisplayLayout is the layout used for showing deadlines to students.
+const deadlineDisplayLayout = "2006-01-02 15:04 MST"
+
+// DeadlineInZone returns the assignment's deadline rendered in the given IANA
+// time zone, e.g., Europe/Oslo. A stored deadline without a time zone is interpreted
+// in the server's local time zone, as when checking whether the deadline has passed.
+// An error is returned if the time zone is unknown or the stored deadline is malformed.
+func DeadlineInZone(a *pb.Assignment, zone string) (string, error) {
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		return "", fmt.Errorf("unknown time zone %q: %w", zone, err)
+	}
+	deadline, err := a.DeadlineIn(time.Local)
+	if err != nil {
+		return "", fmt.Errorf("assignment %s: malformed deadline %q: %w", a.GetName(), a.GetDeadline(), err)
+	}
+	return deadline.In(loc).Format(deadlineDisplayLayout), nil
+}
@@ -0,0 +1,60 @@
+package assignments
+
+import (
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+func TestParseDisplayTimeZone(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		"course.yml":          "displaytimezone: Europe/Oslo\n",
+		"lab1/assignment.yml": "assignmentid: 1\ndeadline: \"27-08-2018 12:00\"\n",
+	})
+	_, data, err := parseAssignments(testsDir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data.timeZone != "Europe/Oslo" {
+		t.Errorf("parseAssignments() time zone = %q, want %q", data.timeZone, "Europe/Oslo")
+	}
+}
+
+func TestParseDisplayTimeZoneInvalid(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		"course.yml":          "displaytimezone: Mars/Olympus_Mons\n",
+		"lab1/assignment.yml": "assignmentid: 1\ndeadline: \"27-08-2018 12:00\"\n",
+	})
+	if _, _, err := parseAssignments(testsDir, 0); err == nil {
+		t.Error("parseAssignments() succeeded, want error for unknown time zone")
+	}
+}
+
+func TestDeadlineInZone(t *testing.T) {
+	// the stored deadline is interpreted in the assignment's time zone
+	assignment := &pb.Assignment{Name: "lab1", Deadline: "2018-08-27T12:00:00", TimeZone: "UTC"}
+	tests := []struct {
+		zone string
+		want string
+	}{
+		{zone: "UTC", want: "2018-08-27 12:00 UTC"},
+		{zone: "Europe/Oslo", want: "2018-08-27 14:00 CEST"},
+		{zone: "America/New_York", want: "2018-08-27 08:00 EDT"},
+		{zone: "Asia/Tokyo", want: "2018-08-27 21:00 JST"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.zone, func(t *testing.T) {
+			got, err := DeadlineInZone(assignment, tt.zone)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("DeadlineInZone(%q) = %q, want %q", tt.zone, got, tt.want)
+			}
+		})
+	}
+
+	if _, err := DeadlineInZone(assignment, "Mars/Olympus_Mons"); err == nil {
+		t.Error("DeadlineInZone(Mars/Olympus_Mons) succeeded, want error for unknown time zone")
+	}
+}
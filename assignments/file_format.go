@@ -0,0 +1,59 @@
+package assignments
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	pb "github.com/autograde/quickfeed/ag"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	targetJSON = "assignment.json"
+	targetTOML = "assignment.toml"
+)
+
+// normalizeAssignmentFile returns the contents of the given assignment file as YAML,
+// such that JSON and TOML assignment files are parsed into the same assignmentData,
+// with the same keys, as YAML assignment files. The contents of YAML files are
+// returned unchanged.
+func normalizeAssignmentFile(filename string, contents []byte) ([]byte, error) {
+	var data map[string]interface{}
+	switch filename {
+	case targetJSON:
+		if err := json.Unmarshal(contents, &data); err != nil {
+			return nil, fmt.Errorf("error unmarshalling %s: %w", filename, err)
+		}
+	case targetTOML:
+		if _, err := toml.Decode(string(contents), &data); err != nil {
+			return nil, fmt.Errorf("error unmarshalling %s: %w", filename, err)
+		}
+	default:
+		return contents, nil
+	}
+	return yaml.Marshal(formatDates(data))
+}
+
+// formatDates returns the given value with TOML dates, which are decoded as
+// time values, replaced by strings in the layout used for storing deadlines.
+func formatDates(value interface{}) interface{} {
+	switch v := value.(type) {
+	case time.Time:
+		return v.Format(pb.TimeLayout)
+	case map[string]interface{}:
+		for key, elem := range v {
+			v[key] = formatDates(elem)
+		}
+	case []interface{}:
+		for i, elem := range v {
+			v[i] = formatDates(elem)
+		}
+	case []map[string]interface{}:
+		for _, elem := range v {
+			formatDates(elem)
+		}
+	}
+	return value
+}
@@ -0,0 +1,36 @@
+package assignments
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// folderNumber matches the numeric suffix of an assignment folder name, e.g., 'lab2'.
+var folderNumber = regexp.MustCompile(`(\d+)$`)
+
+// checkFolderOrders returns a warning for each assignment whose folder name ends
+// with a number different from its declared order ('assignmentid'), which is
+// likely a copy-paste error. The check is a heuristic; assignments whose folder
+// names do not end with a number are ignored, and mismatches are not errors.
+func checkFolderOrders(assignments []*pb.Assignment) []string {
+	var warnings []string
+	for _, assignment := range assignments {
+		match := folderNumber.FindStringSubmatch(assignment.GetName())
+		if match == nil {
+			continue
+		}
+		number, err := strconv.ParseUint(match[1], 10, 32)
+		if err != nil {
+			// too large to be an order; not worth a warning
+			continue
+		}
+		if uint32(number) != assignment.GetOrder() {
+			warnings = append(warnings, fmt.Sprintf("assignment folder %s has order %d; did you mean 'assignmentid: %d'?",
+				assignment.GetName(), assignment.GetOrder(), number))
+		}
+	}
+	return warnings
+}
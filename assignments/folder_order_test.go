@@ -0,0 +1,56 @@
+package assignments
+
+import (
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+func TestCheckFolderOrders(t *testing.T) {
+	tests := []struct {
+		name   string
+		folder string
+		order  uint32
+		want   int
+	}{
+		{name: "matching", folder: "lab1", order: 1, want: 0},
+		{name: "matching two digits", folder: "lab12", order: 12, want: 0},
+		{name: "matching leading zero", folder: "assignment03", order: 3, want: 0},
+		{name: "no number", folder: "essay", order: 4, want: 0},
+		{name: "mismatching", folder: "lab2", order: 1, want: 1},
+		{name: "mismatching number only", folder: "3", order: 2, want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warnings := checkFolderOrders([]*pb.Assignment{{Name: tt.folder, Order: tt.order}})
+			if len(warnings) != tt.want {
+				t.Errorf("checkFolderOrders(%s, %d) = %q, want %d warning(s)", tt.folder, tt.order, warnings, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckFolderOrdersParsed(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": "assignmentid: 1\ndeadline: \"27-08-2018 12:00\"\n",
+		"lab2/assignment.yml": "assignmentid: 3\ndeadline: \"27-08-2018 12:00\"\n",
+		"lab3/assignment.yml": "assignmentid: 2\ndeadline: \"27-08-2018 12:00\"\n",
+	})
+	assignments, _, err := parseAssignments(testsDir, 0)
+	if err != nil {
+		t.Fatalf("parseAssignments() returned an error for mismatching folder orders: %v", err)
+	}
+	want := []string{
+		"assignment folder lab2 has order 3; did you mean 'assignmentid: 2'?",
+		"assignment folder lab3 has order 2; did you mean 'assignmentid: 3'?",
+	}
+	got := checkFolderOrders(assignments)
+	if len(got) != len(want) {
+		t.Fatalf("checkFolderOrders() = %q, want %q", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("checkFolderOrders()[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package assignments
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"gopkg.in/yaml.v2"
+)
+
+const maxGrade = 100
+
+// GradingScale holds the course-wide grading scale, as defined by the
+// 'grading.yml' file in the root of the tests repository.
+type GradingScale struct {
+	// Grades are the letter grade bands, which together must cover
+	// the scores 0-100 without overlapping.
+	Grades []*LetterGrade `yaml:"grades"`
+	// ScoreLimit is the default score limit for auto approval,
+	// used for assignments that do not define their own score limit.
+	ScoreLimit uint32 `yaml:"scorelimit"`
+}
+
+// LetterGrade is a letter grade awarded for scores in the range [Min, Max].
+type LetterGrade struct {
+	Letter string `yaml:"letter"`
+	Min    uint32 `yaml:"min"`
+	Max    uint32 `yaml:"max"`
+}
+
+// Letter returns the letter grade for the given score, or the empty
+// string if the score is not covered by the grading scale.
+func (g *GradingScale) Letter(score uint32) string {
+	for _, grade := range g.Grades {
+		if grade.Min <= score && score <= grade.Max {
+			return grade.Letter
+		}
+	}
+	return ""
+}
+
+// defaultScoreLimit returns the course-wide default score limit for
+// auto approval, or zero if there is no grading scale.
+func (g *GradingScale) defaultScoreLimit() uint32 {
+	if g == nil {
+		return 0
+	}
+	return g.ScoreLimit
+}
+
+// readGradingScaleFile returns the grading scale in the given contents
+// of a 'grading.yml' file, or an error if the grading scale is invalid.
+func readGradingScaleFile(contents []byte) (*GradingScale, error) {
+	var scale GradingScale
+	if err := yaml.Unmarshal(contents, &scale); err != nil {
+		return nil, fmt.Errorf("error unmarshalling grading scale: %w", err)
+	}
+	if err := scale.validate(); err != nil {
+		return nil, fmt.Errorf("invalid grading scale in %q: %w", gradingFile, err)
+	}
+	return &scale, nil
+}
+
+// validate returns an error if the letter grade bands do not cover
+// the scores 0-100 exactly once, or if the score limit is above 100.
+// The bands are sorted by increasing scores.
+func (g *GradingScale) validate() error {
+	if g.ScoreLimit > maxGrade {
+		return fmt.Errorf("scorelimit %d is above %d", g.ScoreLimit, maxGrade)
+	}
+	if len(g.Grades) == 0 {
+		return errors.New("no letter grades")
+	}
+	sort.Slice(g.Grades, func(i, j int) bool {
+		return g.Grades[i].Min < g.Grades[j].Min
+	})
+	next := uint32(0) // lowest score not yet covered
+	for i, grade := range g.Grades {
+		if grade.Letter == "" {
+			return fmt.Errorf("letter grade for %d-%d has no letter", grade.Min, grade.Max)
+		}
+		if grade.Min > grade.Max {
+			return fmt.Errorf("letter grade %s: min %d is above max %d", grade.Letter, grade.Min, grade.Max)
+		}
+		if grade.Min > next {
+			return fmt.Errorf("scores %d-%d are not covered by any letter grade", next, grade.Min-1)
+		}
+		if i > 0 && grade.Min < next {
+			return fmt.Errorf("letter grades %s and %s overlap", g.Grades[i-1].Letter, grade.Letter)
+		}
+		next = grade.Max + 1
+	}
+	if next <= maxGrade {
+		return fmt.Errorf("scores %d-%d are not covered by any letter grade", next, maxGrade)
+	}
+	if next > maxGrade+1 {
+		return fmt.Errorf("letter grade %s: max %d is above %d", g.Grades[len(g.Grades)-1].Letter, next-1, maxGrade)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package assignments
+
+import (
+	"strings"
+	"testing"
+)
+
+const gradingScale = `scorelimit: 60
+grades:
+  - letter: A
+    min: 90
+    max: 100
+  - letter: B
+    min: 80
+    max: 89
+  - letter: C
+    min: 60
+    max: 79
+  - letter: F
+    min: 0
+    max: 59
+`
+
+func TestParseGradingScale(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		"grading.yml": gradingScale,
+		"lab1/assignment.yml": `assignmentid: 1
+deadline: "27-08-2018 12:00"
+`,
+		"lab2/assignment.yml": `assignmentid: 2
+deadline: "27-08-2018 12:00"
+scorelimit: 90
+`,
+		// grading scales outside the root are ignored
+		"lab2/grading.yml": "grades: invalid",
+	})
+	assignments, data, err := parseAssignments(testsDir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	scale := data.gradingScale
+	if scale == nil {
+		t.Fatal("parseAssignments() returned no grading scale")
+	}
+	for score, want := range map[uint32]string{0: "F", 59: "F", 60: "C", 85: "B", 90: "A", 100: "A", 101: ""} {
+		if got := scale.Letter(score); got != want {
+			t.Errorf("Letter(%d) = %q, want %q", score, got, want)
+		}
+	}
+	// lab1 uses the course-wide score limit, while lab2 overrides it
+	for i, want := range []uint32{60, 90} {
+		if got := assignments[i].GetScoreLimit(); got != want {
+			t.Errorf("%s: ScoreLimit = %d, want %d", assignments[i].GetName(), got, want)
+		}
+	}
+}
+
+func TestParseGradingScaleInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		scale   string
+		wantErr string
+	}{
+		{name: "overlap", scale: `grades:
+  - {letter: A, min: 80, max: 100}
+  - {letter: B, min: 50, max: 80}
+  - {letter: F, min: 0, max: 49}
+`, wantErr: "letter grades B and A overlap"},
+		{name: "gap", scale: `grades:
+  - {letter: A, min: 80, max: 100}
+  - {letter: F, min: 0, max: 49}
+`, wantErr: "scores 50-79 are not covered"},
+		{name: "missing top", scale: `grades:
+  - {letter: A, min: 50, max: 99}
+  - {letter: F, min: 0, max: 49}
+`, wantErr: "scores 100-100 are not covered"},
+		{name: "missing bottom", scale: `grades:
+  - {letter: A, min: 50, max: 100}
+  - {letter: F, min: 1, max: 49}
+`, wantErr: "scores 0-0 are not covered"},
+		{name: "above 100", scale: `grades:
+  - {letter: A, min: 50, max: 110}
+  - {letter: F, min: 0, max: 49}
+`, wantErr: "max 110 is above 100"},
+		{name: "inverted band", scale: `grades:
+  - {letter: A, min: 100, max: 50}
+  - {letter: F, min: 0, max: 49}
+`, wantErr: "min 100 is above max 50"},
+		{name: "no grades", scale: "scorelimit: 50\n", wantErr: "no letter grades"},
+		{name: "score limit above 100", scale: "scorelimit: 120\n", wantErr: "scorelimit 120 is above 100"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testsDir := createTestsRepo(t, map[string]string{
+				"grading.yml":         tt.scale,
+				"lab1/assignment.yml": "assignmentid: 1\n",
+			})
+			_, _, err := parseAssignments(testsDir, 0)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("parseAssignments() = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package assignments
+
+import (
+	"fmt"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"gopkg.in/yaml.v2"
+)
+
+const day = 24 * time.Hour
+
+// courseMetadata holds course-wide settings, as defined by the
+// 'course.yml' file in the root of the tests repository.
+// This is only used for parsing the 'course.yml' file.
+type courseMetadata struct {
+	// LateDays is the number of free late days each student may spend
+	// across all assignments in the course; nil if not defined.
+	LateDays *uint32 `yaml:"latedays"`
+	// DisplayTimeZone is the IANA time zone in which deadlines are shown
+	// to students, e.g., Europe/Oslo; empty if not defined.
+	DisplayTimeZone string `yaml:"displaytimezone"`
+}
+
+// readCourseFile returns the course metadata in the given contents of a 'course.yml' file.
+func readCourseFile(contents []byte) (*courseMetadata, error) {
+	var metadata courseMetadata
+	if err := yaml.Unmarshal(contents, &metadata); err != nil {
+		return nil, fmt.Errorf("error unmarshalling %q: %w", courseFile, err)
+	}
+	if metadata.DisplayTimeZone != "" {
+		if _, err := time.LoadLocation(metadata.DisplayTimeZone); err != nil {
+			return nil, fmt.Errorf("invalid displaytimezone in %q: %w", courseFile, err)
+		}
+	}
+	return &metadata, nil
+}
+
+// LateDayDecision is the outcome of a submission with respect to the course's late days.
+type LateDayDecision int
+
+const (
+	// NotLate means that the submission was made before the deadline.
+	NotLate LateDayDecision = iota
+	// FreeLateDays means that the submission spends free late days.
+	FreeLateDays
+	// LatePenalty means that the submission incurs a late penalty,
+	// since the student has too few free late days left.
+	LatePenalty
+)
+
+func (d LateDayDecision) String() string {
+	switch d {
+	case NotLate:
+		return "not late"
+	case FreeLateDays:
+		return "free late days"
+	case LatePenalty:
+		return "late penalty"
+	}
+	return fmt.Sprintf("LateDayDecision(%d)", int(d))
+}
+
+// DecideLateDays returns whether a submission to the given assignment at the given time
+// spends free late days or incurs a late penalty, along with the number of days the
+// submission is late. Any started day after the deadline counts as a late day.
+// The lateDays is the course's budget of free late days, and spentDays is the number
+// of late days the student has already spent on other assignments; the caller is
+// responsible for recording the days spent if the submission uses free late days.
+// A submission incurs a penalty if the remaining free late days do not cover all
+// its late days. An error is returned if the assignment's deadline cannot be parsed.
+func DecideLateDays(a *pb.Assignment, submitted time.Time, lateDays, spentDays uint32) (LateDayDecision, uint32, error) {
+	sinceDeadline, err := a.SinceDeadline(submitted)
+	if err != nil {
+		return NotLate, 0, fmt.Errorf("invalid deadline for assignment %s: %w", a.GetName(), err)
+	}
+	if sinceDeadline <= 0 {
+		return NotLate, 0, nil
+	}
+	daysLate := uint32((sinceDeadline + day - 1) / day)
+	if spentDays+daysLate > lateDays {
+		return LatePenalty, daysLate, nil
+	}
+	return FreeLateDays, daysLate, nil
+}
@@ -0,0 +1,75 @@
+package assignments
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+func TestParseLateDays(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		"course.yml": "latedays: 5\n",
+		"lab1/assignment.yml": `assignmentid: 1
+deadline: "27-08-2018 12:00"
+`,
+		// course metadata outside the root is ignored
+		"lab1/course.yml": "latedays: 10\n",
+	})
+	_, data, err := parseAssignments(testsDir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data.lateDays == nil || *data.lateDays != 5 {
+		t.Errorf("parseAssignments() late days = %v, want 5", data.lateDays)
+	}
+}
+
+func TestParseWithoutLateDays(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": `assignmentid: 1
+deadline: "27-08-2018 12:00"
+`,
+	})
+	_, data, err := parseAssignments(testsDir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data.lateDays != nil {
+		t.Errorf("parseAssignments() late days = %d, want none", *data.lateDays)
+	}
+}
+
+func TestDecideLateDays(t *testing.T) {
+	deadline := time.Date(2021, 9, 1, 23, 59, 0, 0, time.UTC)
+	assignment := &pb.Assignment{Name: "lab1", Deadline: deadline.Format(pb.TimeLayout)}
+	tests := []struct {
+		name         string
+		submitted    time.Time
+		spentDays    uint32
+		want         LateDayDecision
+		wantDaysLate uint32
+	}{
+		{name: "before deadline", submitted: deadline.Add(-time.Hour), spentDays: 5, want: NotLate, wantDaysLate: 0},
+		{name: "one hour late", submitted: deadline.Add(time.Hour), spentDays: 0, want: FreeLateDays, wantDaysLate: 1},
+		{name: "two days late", submitted: deadline.Add(2 * day), spentDays: 1, want: FreeLateDays, wantDaysLate: 2},
+		{name: "spends last free day", submitted: deadline.Add(time.Hour), spentDays: 2, want: FreeLateDays, wantDaysLate: 1},
+		{name: "no free days left", submitted: deadline.Add(time.Hour), spentDays: 3, want: LatePenalty, wantDaysLate: 1},
+		{name: "too few free days left", submitted: deadline.Add(day + time.Hour), spentDays: 2, want: LatePenalty, wantDaysLate: 2},
+	}
+	const lateDays = 3
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, daysLate, err := DecideLateDays(assignment, test.submitted, lateDays, test.spentDays)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != test.want || daysLate != test.wantDaysLate {
+				t.Errorf("DecideLateDays() = (%v, %d), want (%v, %d)", got, daysLate, test.want, test.wantDaysLate)
+			}
+		})
+	}
+	if _, _, err := DecideLateDays(&pb.Assignment{Name: "lab2", Deadline: "tomorrow"}, deadline, lateDays, 0); err == nil {
+		t.Error("DecideLateDays() with invalid deadline succeeded, want error")
+	}
+}
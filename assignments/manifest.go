@@ -0,0 +1,72 @@
+package assignments
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"gopkg.in/yaml.v2"
+)
+
+// readManifest returns the assignments defined by the 'assignments.yml' manifest
+// in the root of the tests repository cloned into dir, or nil if there is no manifest.
+// The manifest maps the name of each assignment's folder to the assignment's
+// metadata, in the same format as an 'assignment.yml' file. The assignments are
+// sorted by name, matching the order in which per-folder assignment files are parsed.
+// An error is returned if the manifest is invalid, or if an assignment's folder
+// does not exist in the root of the tests repository. The manifest is checked by
+// the given validator, and the problems found are returned as ValidationErrors.
+func readManifest(dir string, courseID uint64, v *assignmentValidator) ([]*pb.Assignment, error) {
+	for _, root := range []string{filepath.Join(dir, pb.TestsRepo), dir} {
+		path := filepath.Join(root, manifestFile)
+		contents, err := ioutil.ReadFile(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		if !v.checkManifest(relPath(dir, path), contents) {
+			return nil, v.errs
+		}
+		return readManifestFile(contents, root, courseID)
+	}
+	return nil, nil
+}
+
+// readManifestFile returns the assignments defined by the given contents
+// of the 'assignments.yml' manifest found in root.
+func readManifestFile(contents []byte, root string, courseID uint64) ([]*pb.Assignment, error) {
+	var manifest map[string]assignmentData
+	if err := yaml.Unmarshal(contents, &manifest); err != nil {
+		return nil, fmt.Errorf("error unmarshalling %s: %w", manifestFile, err)
+	}
+	if len(manifest) == 0 {
+		return nil, fmt.Errorf("%s defines no assignments", manifestFile)
+	}
+	names := make([]string, 0, len(manifest))
+	for name := range manifest {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	assignments := make([]*pb.Assignment, 0, len(names))
+	for _, name := range names {
+		if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/\`) {
+			return nil, fmt.Errorf("%s: invalid assignment folder %q", manifestFile, name)
+		}
+		if info, err := os.Stat(filepath.Join(root, name)); err != nil || !info.IsDir() {
+			return nil, fmt.Errorf("%s: assignment %s has no matching folder", manifestFile, name)
+		}
+		assignment, err := makeAssignment(manifest[name], name, courseID)
+		if err != nil {
+			return nil, err
+		}
+		assignments = append(assignments, assignment)
+	}
+	return assignments, nil
+}
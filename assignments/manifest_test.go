@@ -0,0 +1,129 @@
+package assignments
+
+import (
+	"strings"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+const manifest = `lab2:
+  assignmentid: 2
+  deadline: "12-12-2021 23:59"
+  isgrouplab: true
+  reviewers: 1
+lab1:
+  assignmentid: 1
+  deadline: "27-08-2021 12:00"
+  autoapprove: true
+  scorelimit: 90
+`
+
+func TestParseManifest(t *testing.T) {
+	perFolderDir := createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": `assignmentid: 1
+deadline: "27-08-2021 12:00"
+autoapprove: true
+scorelimit: 90
+`,
+		"lab2/assignment.yml": `assignmentid: 2
+deadline: "12-12-2021 23:59"
+isgrouplab: true
+reviewers: 1
+`,
+		"lab2/criteria.json": `[{"heading": "Code quality", "criteria": [{"description": "Readable code", "points": 5}]}]`,
+	})
+	manifestDir := createTestsRepo(t, map[string]string{
+		"assignments.yml":    manifest,
+		"lab1/README.md":     "# Lab 1",
+		"lab2/criteria.json": `[{"heading": "Code quality", "criteria": [{"description": "Readable code", "points": 5}]}]`,
+	})
+	want, _, err := parseAssignments(perFolderDir, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, _, err := parseAssignments(manifestDir, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("parseAssignments() with manifest mismatch (-want +got):\n%s", diff)
+	}
+	if len(got) != 2 || len(got[1].GetGradingBenchmarks()) != 1 {
+		t.Errorf("parseAssignments() = %v, want lab1 and lab2 with grading criteria", got)
+	}
+}
+
+func TestParseManifestInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		wantErr string
+	}{
+		{
+			name: "missing folder",
+			files: map[string]string{
+				"assignments.yml": manifest,
+				"lab1/README.md":  "# Lab 1",
+			},
+			wantErr: "assignment lab2 has no matching folder",
+		},
+		{
+			name: "also defined per folder",
+			files: map[string]string{
+				"assignments.yml":     manifest,
+				"lab1/assignment.yml": "assignmentid: 1\n",
+				"lab2/README.md":      "# Lab 2",
+			},
+			wantErr: "assignment lab1 is defined in both assignments.yml and assignment.yml",
+		},
+		{
+			name: "nested folder",
+			files: map[string]string{
+				"assignments.yml":  "labs/lab1:\n  assignmentid: 1\n",
+				"labs/lab1/run.sh": "#image/quickfeed:go",
+			},
+			wantErr: `invalid assignment folder "labs/lab1"`,
+		},
+		{
+			name: "no assignments",
+			files: map[string]string{
+				"assignments.yml": "",
+			},
+			wantErr: "assignments.yml defines no assignments",
+		},
+		{
+			name: "invalid assignment",
+			files: map[string]string{
+				"assignments.yml": "lab1:\n  assignmentid: 1\n  language: cobol\n",
+				"lab1/README.md":  "# Lab 1",
+			},
+			wantErr: `assignment lab1: unknown language "cobol"`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			testsDir := createTestsRepo(t, test.files)
+			_, _, err := parseAssignments(testsDir, 1)
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Errorf("parseAssignments() error = %v, want error containing %q", err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseManifestInTestsFolder(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		pb.TestsRepo + "/assignments.yml": "lab1:\n  assignmentid: 1\n",
+		pb.TestsRepo + "/lab1/README.md":  "# Lab 1",
+	})
+	assignments, _, err := parseAssignments(testsDir, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(assignments) != 1 || assignments[0].GetName() != "lab1" {
+		t.Errorf("parseAssignments() = %v, want lab1", assignments)
+	}
+}
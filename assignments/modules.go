@@ -0,0 +1,68 @@
+package assignments
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"gopkg.in/yaml.v2"
+)
+
+// moduleData holds a course module, as defined in the 'modules.yml' file
+// in the root of the tests repository, keyed by the module's name.
+type moduleData struct {
+	Weight      uint32   `yaml:"weight"`
+	Assignments []string `yaml:"assignments"`
+}
+
+// readModulesFile returns the course modules in the given contents of a 'modules.yml' file,
+// sorted by name, or an error if a module is invalid.
+func readModulesFile(contents []byte) ([]*pb.CourseModule, error) {
+	var data map[string]moduleData
+	if err := yaml.Unmarshal(contents, &data); err != nil {
+		return nil, fmt.Errorf("error unmarshalling %q: %w", modulesFile, err)
+	}
+	names := make([]string, 0, len(data))
+	for name := range data {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	modules := make([]*pb.CourseModule, 0, len(names))
+	for _, name := range names {
+		module := data[name]
+		if len(module.Assignments) == 0 {
+			return nil, fmt.Errorf("module %s in %q has no assignments", name, modulesFile)
+		}
+		for _, assignmentName := range module.Assignments {
+			if assignmentName == "" || strings.Contains(assignmentName, ",") {
+				return nil, fmt.Errorf("module %s in %q: invalid assignment name %q", name, modulesFile, assignmentName)
+			}
+		}
+		modules = append(modules, &pb.CourseModule{
+			Name:        name,
+			Weight:      module.Weight,
+			Assignments: strings.Join(module.Assignments, ","),
+		})
+	}
+	return modules, nil
+}
+
+// checkModules returns an error if a module lists an unknown assignment,
+// or if an assignment belongs to more than one module.
+func checkModules(modules []*pb.CourseModule, assignments []*pb.Assignment) error {
+	moduleOf := make(map[string]string)
+	for _, module := range modules {
+		for _, name := range module.AssignmentNames() {
+			if findAssignmentByName(assignments, name) == nil {
+				return fmt.Errorf("module %s in %q has unknown assignment %s", module.GetName(), modulesFile, name)
+			}
+			if other, ok := moduleOf[name]; ok {
+				return fmt.Errorf("assignment %s belongs to both module %s and module %s in %q", name, other, module.GetName(), modulesFile)
+			}
+			moduleOf[name] = module.GetName()
+		}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package assignments
+
+import (
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestParseModules(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml":    "assignmentid: 1\n",
+		"lab2/assignment.yml":    "assignmentid: 2\n",
+		"project/assignment.yml": "assignmentid: 3\n",
+		"modules.yml":            "project:\n  weight: 40\n  assignments: [project]\nlabs:\n  weight: 60\n  assignments:\n    - lab1\n    - lab2\n",
+	})
+	_, data, err := parseAssignments(testsDir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*pb.CourseModule{
+		{Name: "labs", Weight: 60, Assignments: "lab1,lab2"},
+		{Name: "project", Weight: 40, Assignments: "project"},
+	}
+	if diff := cmp.Diff(want, data.modules, protocmp.Transform()); diff != "" {
+		t.Errorf("parseAssignments() modules mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestParseWithoutModules(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": "assignmentid: 1\n",
+		// only a modules.yml in the root applies to the course
+		"lab1/modules.yml": "labs:\n  assignments: [lab1]\n",
+	})
+	_, data, err := parseAssignments(testsDir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data.modules != nil {
+		t.Errorf("parseAssignments() modules = %v, want none", data.modules)
+	}
+}
+
+func TestParseModulesInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		modules string
+	}{
+		{"no assignments", "labs:\n  weight: 60\n"},
+		{"unknown assignment", "labs:\n  assignments: [lab1, lab9]\n"},
+		{"empty name", "labs:\n  assignments: [\"\"]\n"},
+		{"comma in name", "labs:\n  assignments: [\"lab1,lab2\"]\n"},
+		{"assignment in two modules", "labs:\n  assignments: [lab1, lab2]\nproject:\n  assignments: [lab2]\n"},
+		{"malformed", "labs: [lab1]\n"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			testsDir := createTestsRepo(t, map[string]string{
+				"lab1/assignment.yml": "assignmentid: 1\n",
+				"lab2/assignment.yml": "assignmentid: 2\n",
+				"modules.yml":         test.modules,
+			})
+			if _, _, err := parseAssignments(testsDir, 0); err == nil {
+				t.Error("parseAssignments() succeeded for invalid modules, want error")
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package assignments
+
+import (
+	"fmt"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// checkPrerequisites returns an error if an assignment requires an assignment
+// that does not exist, or if the prerequisites form a cycle, since the
+// assignments in the cycle could never be unlocked.
+func checkPrerequisites(assignments []*pb.Assignment) error {
+	requires := make(map[string][]string, len(assignments))
+	for _, assignment := range assignments {
+		requires[assignment.GetName()] = assignment.Prerequisites()
+	}
+	for _, assignment := range assignments {
+		for _, name := range assignment.Prerequisites() {
+			if _, ok := requires[name]; !ok {
+				return fmt.Errorf("assignment %s: requires unknown assignment %s", assignment.GetName(), name)
+			}
+		}
+	}
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(assignments))
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case visiting:
+			return fmt.Errorf("assignment %s: requires itself through its prerequisites", name)
+		case visited:
+			return nil
+		}
+		state[name] = visiting
+		for _, prerequisite := range requires[name] {
+			if err := visit(prerequisite); err != nil {
+				return err
+			}
+		}
+		state[name] = visited
+		return nil
+	}
+	for _, assignment := range assignments {
+		if err := visit(assignment.GetName()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
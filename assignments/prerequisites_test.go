@@ -0,0 +1,51 @@
+package assignments
+
+import "testing"
+
+func TestParsePrerequisites(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": "assignmentid: 1\n",
+		"lab2/assignment.yml": "assignmentid: 2\nrequires: [lab1]\n",
+		"lab3/assignment.yml": "assignmentid: 3\nrequires:\n  - lab1\n  - lab2\n",
+	})
+	assignments, _, err := parseAssignments(testsDir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"", "lab1", "lab1,lab2"}
+	for i, assignment := range assignments {
+		if got := assignment.GetRequires(); got != want[i] {
+			t.Errorf("requires of %s = %q, want %q", assignment.GetName(), got, want[i])
+		}
+	}
+}
+
+func TestParsePrerequisitesInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+	}{
+		{"unknown assignment", map[string]string{
+			"lab1/assignment.yml": "assignmentid: 1\nrequires: [lab0]\n",
+		}},
+		{"requires itself", map[string]string{
+			"lab1/assignment.yml": "assignmentid: 1\nrequires: [lab1]\n",
+		}},
+		{"cycle", map[string]string{
+			"lab1/assignment.yml": "assignmentid: 1\nrequires: [lab3]\n",
+			"lab2/assignment.yml": "assignmentid: 2\nrequires: [lab1]\n",
+			"lab3/assignment.yml": "assignmentid: 3\nrequires: [lab2]\n",
+		}},
+		{"empty name", map[string]string{
+			"lab1/assignment.yml": "assignmentid: 1\nrequires: [\"\"]\n",
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			testsDir := createTestsRepo(t, test.files)
+			if _, _, err := parseAssignments(testsDir, 0); err == nil {
+				t.Error("parseAssignments() succeeded for invalid requires, want error")
+			}
+		})
+	}
+}
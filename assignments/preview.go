@@ -0,0 +1,68 @@
+package assignments
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/scm"
+	"go.uber.org/zap"
+)
+
+// ValidateCourseRepository clones the tests repository of the given course, and
+// returns the assignments parsed from it, how they differ from the course's stored
+// assignments, and any problems found, as a preview of updating the course's
+// assignments from the tests repository. Neither the database nor the course's
+// docker images are changed. An error is returned only if the tests repository
+// cannot be cloned or the stored assignments cannot be read; problems with the
+// assignment files are reported in the returned validation.
+func ValidateCourseRepository(ctx context.Context, logger *zap.SugaredLogger, db database.Database, sc scm.SCM, course *pb.Course) (*pb.CourseRepositoryValidation, error) {
+	cloneDir, err := cloneTestsRepo(ctx, logger, sc, course)
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(cloneDir)
+	return validateTestsRepo(db, course, cloneDir)
+}
+
+// validateTestsRepo checks the tests repository cloned into dir for the given course,
+// as done by ValidateCourseRepository.
+func validateTestsRepo(db database.Database, course *pb.Course, dir string) (*pb.CourseRepositoryValidation, error) {
+	validation := &pb.CourseRepositoryValidation{CourseID: course.GetID()}
+	assignments, data, err := parseAssignments(dir, course.GetID())
+	if err != nil {
+		var report ValidationErrors
+		if !errors.As(err, &report) {
+			validation.Errors = append(validation.Errors, err.Error())
+			return validation, nil
+		}
+		for _, e := range report {
+			validation.Errors = append(validation.Errors, e.Error())
+		}
+		return validation, nil
+	}
+	stored, err := storedAssignments(db, course.GetID())
+	if err != nil {
+		return nil, err
+	}
+	updated, removed := diffAssignments(stored, assignments)
+	validation.Assignments = assignments
+	validation.ChangedAssignments = assignmentNames(updated)
+	validation.RemovedAssignments = assignmentNames(removed)
+	for _, warning := range data.warnings {
+		validation.Warnings = append(validation.Warnings, warning.Error())
+	}
+	validation.Warnings = append(validation.Warnings, checkFolderOrders(assignments)...)
+	return validation, nil
+}
+
+// assignmentNames returns the names of the given assignments.
+func assignmentNames(assignments []*pb.Assignment) []string {
+	names := make([]string, 0, len(assignments))
+	for _, assignment := range assignments {
+		names = append(names, assignment.GetName())
+	}
+	return names
+}
@@ -0,0 +1,82 @@
+package assignments
+
+import (
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestValidateTestsRepo(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+	admin := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{}
+	qtest.CreateCourse(t, db, admin, course)
+
+	testsDir := createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": "assignmentid: 1\ndeadline: 2022-09-01 23:59\nlanguage: go\n",
+		"lab2/assignment.yml": "assignmentid: 2\ndeadline: 2022-09-15 23:59\nlanguage: go\n",
+		"lab3/assignment.yml": "assignmentid: 3\ndeadline: 2022-09-30 23:59\nlanguage: go\n",
+	})
+	parsed, _, err := parseAssignments(testsDir, course.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateAssignments(parsed); err != nil {
+		t.Fatal(err)
+	}
+
+	// lab2 is changed, lab3 is removed, and lab5 is added with a mismatched assignmentid;
+	// lab1 has an unknown key, which is a warning rather than a problem
+	testsDir = createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": "assignmentid: 1\ndeadline: 2022-09-01 23:59\nlanguage: go\nsubject: Go\n",
+		"lab2/assignment.yml": "assignmentid: 2\ndeadline: 2022-09-22 23:59\nlanguage: go\n",
+		"lab5/assignment.yml": "assignmentid: 4\ndeadline: 2022-10-15 23:59\nlanguage: go\n",
+	})
+	validation, err := validateTestsRepo(db, course, testsDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"lab1", "lab2", "lab5"}, assignmentNames(validation.GetAssignments())); diff != "" {
+		t.Errorf("Assignments mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"lab2", "lab5"}, validation.GetChangedAssignments()); diff != "" {
+		t.Errorf("ChangedAssignments mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"lab3"}, validation.GetRemovedAssignments()); diff != "" {
+		t.Errorf("RemovedAssignments mismatch (-want +got):\n%s", diff)
+	}
+	if len(validation.GetWarnings()) != 2 || len(validation.GetErrors()) != 0 {
+		t.Errorf("Warnings = %v, Errors = %v, want warnings for lab1 and lab5", validation.GetWarnings(), validation.GetErrors())
+	}
+
+	// the preview does not change the stored assignments
+	stored, err := storedAssignments(db, course.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"lab1", "lab2", "lab3"}, assignmentNames(stored)); diff != "" {
+		t.Errorf("stored assignments mismatch (-want +got):\n%s", diff)
+	}
+
+	testsDir = createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": "assignmentid: 1\ndeadline: tomorrow\nlanguage: go\n",
+		"lab2/assignment.yml": "assignmentid: 2\nscorelimit: 120\nlanguage: go\n",
+	})
+	validation, err = validateTestsRepo(db, course, testsDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantErrors := []string{
+		`lab1/assignment.yml: deadline: invalid date format "tomorrow"; use YYYY-MM-DD HH:MM or DD-MM-YYYY HH:MM`,
+		"lab2/assignment.yml: scorelimit: 120 is above 100",
+	}
+	if diff := cmp.Diff(wantErrors, validation.GetErrors()); diff != "" {
+		t.Errorf("Errors mismatch (-want +got):\n%s", diff)
+	}
+	if len(validation.GetAssignments()) != 0 {
+		t.Errorf("Assignments = %v, want none for invalid assignment files", assignmentNames(validation.GetAssignments()))
+	}
+}
@@ -0,0 +1,192 @@
+package assignments
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/notify"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// DefaultReminders are the times before an assignment's deadline at which
+// students are reminded of the deadline, unless configured otherwise.
+var DefaultReminders = []time.Duration{48 * time.Hour, 2 * time.Hour}
+
+// ReminderScheduler reminds the students enrolled in a course of the approaching
+// deadlines of the course's assignments, at given times before each deadline.
+// Students whose latest submission has been approved or has reached the
+// assignment's score limit are not reminded, and neither are students with
+// a deadline extension.
+type ReminderScheduler struct {
+	logger    *zap.SugaredLogger
+	db        database.Database
+	notifier  notify.Notifier
+	reminders []time.Duration
+}
+
+// NewReminderScheduler returns a scheduler that sends reminders through the given notifier
+// at the given times before each deadline, or at the DefaultReminders if none are given.
+func NewReminderScheduler(logger *zap.Logger, db database.Database, notifier notify.Notifier, reminders ...time.Duration) *ReminderScheduler {
+	if len(reminders) == 0 {
+		reminders = DefaultReminders
+	}
+	return &ReminderScheduler{
+		logger:    logger.Sugar(),
+		db:        db,
+		notifier:  notifier,
+		reminders: reminders,
+	}
+}
+
+// ParseReminders returns the times before a deadline in the given comma-separated
+// list of durations, e.g., "48h,2h". The durations must be positive.
+func ParseReminders(list string) ([]time.Duration, error) {
+	var reminders []time.Duration
+	for _, s := range strings.Split(list, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return nil, err
+		}
+		if d <= 0 {
+			return nil, fmt.Errorf("reminder %s must be before the deadline", s)
+		}
+		reminders = append(reminders, d)
+	}
+	return reminders, nil
+}
+
+// Run sends the reminders that fall due, checking every interval until the
+// context is canceled. Reminders that fall due while the scheduler is not
+// running are not sent.
+func (r *ReminderScheduler) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	last := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			r.Remind(ctx, last, now)
+			last = now
+		}
+	}
+}
+
+// Remind sends the reminders that fall due after from and no later than to.
+func (r *ReminderScheduler) Remind(ctx context.Context, from, to time.Time) {
+	courses, err := r.db.GetCourses()
+	if err != nil {
+		r.logger.Errorf("Failed to get courses for deadline reminders: %v", err)
+		return
+	}
+	for _, course := range courses {
+		assignments, err := r.db.GetAssignmentsByCourse(course.GetID(), false)
+		if err != nil {
+			r.logger.Errorf("Failed to get assignments for %s: %v", course.GetCode(), err)
+			continue
+		}
+		for _, assignment := range assignments {
+			deadline, err := assignment.DeadlineIn(time.Local)
+			if err != nil {
+				r.logger.Debugf("Skipping deadline reminders for assignment %s: %v", assignment.GetName(), err)
+				continue
+			}
+			for _, reminder := range r.reminders {
+				if due := deadline.Add(-reminder); due.After(from) && !due.After(to) {
+					r.remind(ctx, course, assignment, deadline)
+					break
+				}
+			}
+		}
+	}
+}
+
+// remind notifies the course's students who have not yet completed the given
+// assignment, that its deadline is approaching.
+func (r *ReminderScheduler) remind(ctx context.Context, course *pb.Course, assignment *pb.Assignment, deadline time.Time) {
+	students, err := r.db.GetEnrollmentsByCourse(course.GetID(), pb.Enrollment_STUDENT)
+	if err != nil {
+		r.logger.Errorf("Failed to get students of %s for deadline reminders: %v", course.GetCode(), err)
+		return
+	}
+	submissions, err := r.db.GetSubmissions(&pb.Submission{AssignmentID: assignment.GetID()})
+	if err != nil {
+		r.logger.Errorf("Failed to get submissions for assignment %s: %v", assignment.GetName(), err)
+		return
+	}
+	completed := completedBy(assignment, submissions)
+	subject := fmt.Sprintf("%s: %s is due %s", course.GetCode(), assignment.GetName(), deadline.Format(pb.TimeLayout))
+	body := fmt.Sprintf("The deadline for %s in %s is %s, and you have not yet completed the assignment.",
+		assignment.GetName(), course.GetName(), deadline.Format(pb.TimeLayout))
+	var reminded int
+	for _, student := range students {
+		// students without a group are always reminded of group assignments
+		if owner := ownerOf(assignment, student.GetUserID(), student.GetGroupID()); owner != (submissionOwner{}) {
+			if completed[owner] || r.hasExtension(assignment, owner) {
+				continue
+			}
+		}
+		notification := &notify.Notification{User: student.GetUser(), Subject: subject, Body: body}
+		if err := r.notifier.Notify(ctx, notification); err != nil {
+			r.logger.Errorf("Failed to remind %s of assignment %s: %v", student.GetUser().GetLogin(), assignment.GetName(), err)
+			continue
+		}
+		reminded++
+	}
+	r.logger.Debugf("Reminded %d of %d students in %s of the deadline for %s", reminded, len(students), course.GetCode(), assignment.GetName())
+}
+
+// hasExtension returns true if the given owner has a deadline extension for the given assignment.
+func (r *ReminderScheduler) hasExtension(assignment *pb.Assignment, owner submissionOwner) bool {
+	_, err := r.db.GetDeadlineExtension(&pb.DeadlineExtension{
+		AssignmentID: assignment.GetID(),
+		UserID:       owner.userID,
+		GroupID:      owner.groupID,
+	})
+	if err != nil && err != gorm.ErrRecordNotFound {
+		r.logger.Errorf("Failed to get deadline extension for assignment %s: %v", assignment.GetName(), err)
+	}
+	return err == nil
+}
+
+// submissionOwner identifies the student or group submitting for an assignment.
+type submissionOwner struct {
+	userID, groupID uint64
+}
+
+// ownerOf returns the owner of the given student's submissions for the given assignment,
+// which is the student's group for group assignments.
+func ownerOf(assignment *pb.Assignment, userID, groupID uint64) submissionOwner {
+	if assignment.GetIsGroupLab() {
+		return submissionOwner{groupID: groupID}
+	}
+	return submissionOwner{userID: userID}
+}
+
+// completedBy returns the owners whose latest submission for the given assignment
+// has been approved or has reached the assignment's score limit.
+func completedBy(assignment *pb.Assignment, submissions []*pb.Submission) map[submissionOwner]bool {
+	latest := make(map[submissionOwner]*pb.Submission)
+	for _, submission := range submissions {
+		owner := ownerOf(assignment, submission.GetUserID(), submission.GetGroupID())
+		if prev, ok := latest[owner]; !ok || submission.GetID() > prev.GetID() {
+			latest[owner] = submission
+		}
+	}
+	completed := make(map[submissionOwner]bool)
+	for owner, submission := range latest {
+		limit := assignment.GetScoreLimit()
+		completed[owner] = submission.IsApproved() || (limit > 0 && submission.GetScore() >= limit)
+	}
+	return completed
+}
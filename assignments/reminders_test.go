@@ -0,0 +1,120 @@
+package assignments
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/notify"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// recorder records the IDs of the users notified.
+type recorder struct {
+	userIDs []uint64
+}
+
+func (r *recorder) Notify(_ context.Context, notification *notify.Notification) error {
+	r.userIDs = append(r.userIDs, notification.User.GetID())
+	return nil
+}
+
+func TestRemind(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{}
+	qtest.CreateCourse(t, db, teacher, course)
+	var students []*pb.User
+	for i := uint64(2); i <= 6; i++ {
+		student := qtest.CreateFakeUser(t, db, i)
+		qtest.EnrollStudent(t, db, student, course)
+		students = append(students, student)
+	}
+	group := &pb.Group{CourseID: course.ID, Name: "group", Users: students[3:5]}
+	if err := db.CreateGroup(group); err != nil {
+		t.Fatal(err)
+	}
+
+	deadline := time.Now().Add(72 * time.Hour).Truncate(time.Minute)
+	lab1 := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, ScoreLimit: 80, DeadlineTime: timestamppb.New(deadline)}
+	lab2 := &pb.Assignment{CourseID: course.ID, Name: "lab2", Order: 2, ScoreLimit: 80, IsGroupLab: true, DeadlineTime: timestamppb.New(deadline)}
+	for _, assignment := range []*pb.Assignment{lab1, lab2} {
+		if err := db.CreateAssignment(assignment); err != nil {
+			t.Fatal(err)
+		}
+	}
+	submissions := []*pb.Submission{
+		// student 0 has reached the score limit for lab1
+		{AssignmentID: lab1.ID, UserID: students[0].ID, Score: 90},
+		// student 1 has not
+		{AssignmentID: lab1.ID, UserID: students[1].ID, Score: 50},
+		// the group has reached the score limit for lab2
+		{AssignmentID: lab2.ID, GroupID: group.ID, Score: 80},
+	}
+	for _, submission := range submissions {
+		if err := db.CreateSubmission(submission); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// student 2 has a deadline extension for lab1
+	if err := db.UpdateDeadlineExtension(&pb.DeadlineExtension{AssignmentID: lab1.ID, UserID: students[2].ID, Deadline: "2030-01-01T23:59:00"}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		from, to  time.Time
+		wantUsers []uint64
+	}{
+		{
+			name: "48h before deadline",
+			from: deadline.Add(-49 * time.Hour), to: deadline.Add(-48 * time.Hour),
+			// lab1: students 1, 3 and 4; lab2: students 0, 1 and 2, who have no group
+			wantUsers: []uint64{students[0].ID, students[1].ID, students[1].ID, students[2].ID, students[3].ID, students[4].ID},
+		},
+		{
+			name: "2h before deadline",
+			from: deadline.Add(-2*time.Hour - time.Minute), to: deadline.Add(-2 * time.Hour),
+			wantUsers: []uint64{students[0].ID, students[1].ID, students[1].ID, students[2].ID, students[3].ID, students[4].ID},
+		},
+		{
+			name: "no reminder due",
+			from: deadline.Add(-48 * time.Hour), to: deadline.Add(-2*time.Hour - time.Minute),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notifier := &recorder{}
+			scheduler := NewReminderScheduler(zap.NewNop(), db, notifier)
+			scheduler.Remind(context.Background(), tt.from, tt.to)
+			sort.Slice(notifier.userIDs, func(i, j int) bool { return notifier.userIDs[i] < notifier.userIDs[j] })
+			if diff := cmp.Diff(tt.wantUsers, notifier.userIDs); diff != "" {
+				t.Errorf("Remind() notified users mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseReminders(t *testing.T) {
+	reminders, err := ParseReminders("48h, 2h30m")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]time.Duration{48 * time.Hour, 150 * time.Minute}, reminders); diff != "" {
+		t.Errorf("ParseReminders() mismatch (-want +got):\n%s", diff)
+	}
+	if reminders, err := ParseReminders(""); err != nil || len(reminders) != 0 {
+		t.Errorf("ParseReminders(\"\") = %v, %v, want no reminders", reminders, err)
+	}
+	for _, list := range []string{"2 days", "-2h", "0s"} {
+		if _, err := ParseReminders(list); err == nil {
+			t.Errorf("ParseReminders(%q) succeeded, want error", list)
+		}
+	}
+}
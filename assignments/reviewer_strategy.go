@@ -0,0 +1,25 @@
+package assignments
+
+// Strategies for distributing the review workload among the teaching staff,
+// as specified by the 'reviewerstrategy' field of an 'assignment.yml' file.
+const (
+	// RoundRobin assigns submissions to reviewers in turn.
+	RoundRobin = "roundrobin"
+	// Random assigns submissions to randomly selected reviewers.
+	Random = "random"
+	// LeastLoaded assigns submissions to the reviewers with the fewest pending reviews.
+	LeastLoaded = "leastloaded"
+)
+
+// reviewerStrategies is the list of known reviewer strategies.
+var reviewerStrategies = []string{RoundRobin, Random, LeastLoaded}
+
+// isKnownReviewerStrategy returns true if the given reviewer strategy is known.
+func isKnownReviewerStrategy(strategy string) bool {
+	for _, s := range reviewerStrategies {
+		if s == strategy {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,62 @@
+package assignments
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+// storedAssignments returns the course's assignments recorded in the database,
+// with their test configurations and tasks.
+func storedAssignments(db database.Database, courseID uint64) ([]*pb.Assignment, error) {
+	assignments, err := db.GetAssignmentsByCourse(courseID, false)
+	if err != nil {
+		return nil, err
+	}
+	stored := make([]*pb.Assignment, 0, len(assignments))
+	for _, assignment := range assignments {
+		a, err := db.GetAssignment(&pb.Assignment{ID: assignment.GetID()})
+		if err != nil {
+			return nil, err
+		}
+		stored = append(stored, a)
+	}
+	return stored, nil
+}
+
+// diffAssignments compares the assignments parsed from the tests repository with
+// the stored assignments, matching them by order, as done by CreateAssignment.
+// It returns the parsed assignments that are new or have changed, and the stored
+// assignments no longer found in the tests repository. Parsed assignments that
+// match a stored assignment are given the stored assignment's ID.
+// Grading benchmarks are not compared, since they are updated separately.
+func diffAssignments(stored, parsed []*pb.Assignment) (updated, removed []*pb.Assignment) {
+	storedByOrder := make(map[uint32]*pb.Assignment, len(stored))
+	for _, assignment := range stored {
+		storedByOrder[assignment.GetOrder()] = assignment
+	}
+	for _, assignment := range parsed {
+		old, ok := storedByOrder[assignment.GetOrder()]
+		if !ok {
+			updated = append(updated, assignment)
+			continue
+		}
+		delete(storedByOrder, assignment.GetOrder())
+		assignment.ID = old.GetID()
+		if !cmp.Equal(old, assignment, cmp.Options{
+			protocmp.Transform(),
+			protocmp.IgnoreFields(&pb.Assignment{}, "ID", "CourseID", "submissions", "gradingBenchmarks"),
+			protocmp.IgnoreFields(&pb.TestConfig{}, "ID", "AssignmentID"),
+			protocmp.IgnoreFields(&pb.Task{}, "ID", "AssignmentID"),
+		}) {
+			updated = append(updated, assignment)
+		}
+	}
+	for _, assignment := range stored {
+		if _, ok := storedByOrder[assignment.GetOrder()]; ok {
+			removed = append(removed, assignment)
+		}
+	}
+	return updated, removed
+}
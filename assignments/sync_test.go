@@ -0,0 +1,73 @@
+package assignments
+
+import (
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDiffAssignments(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+	admin := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{}
+	qtest.CreateCourse(t, db, admin, course)
+
+	testsDir := createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": "assignmentid: 1\ndeadline: 2022-09-01 23:59\nautoapprove: true\n",
+		"lab1/task-hello.md":  "# Hello\nPrint Hello, World!\n",
+		"lab2/assignment.yml": "assignmentid: 2\ndeadline: 2022-09-15 23:59\n",
+		"lab3/assignment.yml": "assignmentid: 3\ndeadline: 2022-09-30 23:59\n",
+	})
+	parsed, _, err := parseAssignments(testsDir, course.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateAssignments(parsed); err != nil {
+		t.Fatal(err)
+	}
+
+	// lab2 is changed, lab3 is removed, and lab4 is added
+	testsDir = createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": "assignmentid: 1\ndeadline: 2022-09-01 23:59\nautoapprove: true\n",
+		"lab1/task-hello.md":  "# Hello\nPrint Hello, World!\n",
+		"lab2/assignment.yml": "assignmentid: 2\ndeadline: 2022-09-22 23:59\n",
+		"lab4/assignment.yml": "assignmentid: 4\ndeadline: 2022-10-15 23:59\n",
+	})
+	parsed, _, err = parseAssignments(testsDir, course.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	stored, err := storedAssignments(db, course.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	updated, removed := diffAssignments(stored, parsed)
+	if diff := cmp.Diff([]string{"lab2", "lab4"}, assignmentNames(updated)); diff != "" {
+		t.Errorf("diffAssignments() updated mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"lab3"}, assignmentNames(removed)); diff != "" {
+		t.Errorf("diffAssignments() removed mismatch (-want +got):\n%s", diff)
+	}
+	if parsed[0].GetID() != stored[0].GetID() {
+		t.Errorf("ID of lab1 = %d, want stored ID %d", parsed[0].GetID(), stored[0].GetID())
+	}
+
+	if err := db.UpdateAssignments(updated); err != nil {
+		t.Fatal(err)
+	}
+	for _, assignment := range removed {
+		if err := db.DeleteAssignment(assignment); err != nil {
+			t.Fatal(err)
+		}
+	}
+	stored, err = storedAssignments(db, course.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated, removed := diffAssignments(stored, parsed); len(updated) != 0 || len(removed) != 0 {
+		t.Errorf("diffAssignments() after update = %v, %v; want no changes", assignmentNames(updated), assignmentNames(removed))
+	}
+}
@@ -0,0 +1,140 @@
+package assignments
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/scm"
+	"go.uber.org/zap"
+)
+
+const (
+	taskFilePrefix = "task-"
+	taskFileSuffix = ".md"
+)
+
+// isTaskFile returns true if the given file name is the name of a task file, e.g., task-hello_world.md.
+func isTaskFile(filename string) bool {
+	return strings.HasPrefix(filename, taskFilePrefix) && strings.HasSuffix(filename, taskFileSuffix) &&
+		len(filename) > len(taskFilePrefix)+len(taskFileSuffix)
+}
+
+// readTaskFile adds the task in the given contents of a task file to the given assignment.
+func readTaskFile(contents []byte, filename, assignmentName string, assignments []*pb.Assignment) error {
+	assignment := findAssignmentByName(assignments, assignmentName)
+	if assignment == nil {
+		return fmt.Errorf("could not find assignment %s for task file %s", assignmentName, filename)
+	}
+	task, err := parseTask(contents, filename)
+	if err != nil {
+		return fmt.Errorf("assignment %s: %w", assignmentName, err)
+	}
+	assignment.Tasks = append(assignment.Tasks, task)
+	return nil
+}
+
+// parseTask returns the task in the given contents of a task file. The first line
+// of the file must be a markdown heading, which becomes the task's title, while
+// the remainder of the file becomes the task's body.
+func parseTask(contents []byte, filename string) (*pb.Task, error) {
+	lines := strings.SplitN(strings.TrimSpace(string(contents)), "\n", 2)
+	heading := strings.TrimSpace(lines[0])
+	if !strings.HasPrefix(heading, "#") {
+		return nil, fmt.Errorf("task file %s must start with a markdown heading for the task's title", filename)
+	}
+	title := strings.TrimSpace(strings.TrimLeft(heading, "#"))
+	if title == "" {
+		return nil, fmt.Errorf("task file %s has an empty title", filename)
+	}
+	var body string
+	if len(lines) > 1 {
+		body = strings.TrimSpace(lines[1])
+	}
+	return &pb.Task{
+		Name:  strings.TrimSuffix(strings.TrimPrefix(filename, taskFilePrefix), taskFileSuffix),
+		Title: title,
+		Body:  body,
+	}, nil
+}
+
+// synchronizeTasks creates an issue for each task of the given assignments in the
+// repositories of the course's students, or of its groups for group assignments.
+// Issues whose task has changed since the issue was created are updated.
+// Failures are logged for each repository, allowing the other repositories to be synchronized.
+func synchronizeTasks(ctx context.Context, logger *zap.SugaredLogger, db database.Database, sc scm.SCM, course *pb.Course, assignments []*pb.Assignment) error {
+	repoTypes := map[pb.Repository_Type]bool{}
+	for _, assignment := range assignments {
+		if len(assignment.GetTasks()) == 0 {
+			continue
+		}
+		if assignment.GetIsGroupLab() {
+			repoTypes[pb.Repository_GROUP] = true
+		} else {
+			repoTypes[pb.Repository_USER] = true
+		}
+	}
+	for repoType := range repoTypes {
+		repos, err := db.GetRepositories(&pb.Repository{OrganizationID: course.GetOrganizationID(), RepoType: repoType})
+		if err != nil {
+			return fmt.Errorf("failed to get repositories: %w", err)
+		}
+		for _, repo := range repos {
+			if err := synchronizeRepoTasks(ctx, db, sc, course, repo, assignments); err != nil {
+				logger.Errorf("%s: failed to synchronize tasks with repository %s: %v", course.GetCode(), repo.GetHTMLURL(), err)
+			}
+		}
+	}
+	return nil
+}
+
+// synchronizeRepoTasks creates or updates the issues for the tasks of the given
+// assignments in the given repository.
+func synchronizeRepoTasks(ctx context.Context, db database.Database, sc scm.SCM, course *pb.Course, repo *pb.Repository, assignments []*pb.Assignment) error {
+	stored, err := db.GetIssues(repo.GetID())
+	if err != nil {
+		return fmt.Errorf("failed to get issues: %w", err)
+	}
+	issues := make(map[string]*pb.Issue, len(stored))
+	for _, issue := range stored {
+		issues[fmt.Sprintf("%d/%s", issue.GetAssignmentID(), issue.GetTaskName())] = issue
+	}
+	for _, assignment := range assignments {
+		if assignment.GetIsGroupLab() != (repo.GetRepoType() == pb.Repository_GROUP) {
+			continue
+		}
+		for _, task := range assignment.GetTasks() {
+			issue, ok := issues[fmt.Sprintf("%d/%s", assignment.GetID(), task.GetName())]
+			if ok && issue.GetTitle() == task.GetTitle() && issue.GetBody() == task.GetBody() {
+				continue
+			}
+			opt := &scm.IssueOptions{
+				Organization: course.GetOrganizationPath(),
+				Repository:   path.Base(repo.GetHTMLURL()),
+				Title:        task.GetTitle(),
+				Body:         task.GetBody(),
+			}
+			var scmIssue *scm.Issue
+			if ok {
+				opt.Number = int(issue.GetIssueNumber())
+				scmIssue, err = sc.UpdateIssue(ctx, opt)
+			} else {
+				issue = &pb.Issue{RepositoryID: repo.GetID(), AssignmentID: assignment.GetID(), TaskName: task.GetName()}
+				scmIssue, err = sc.CreateIssue(ctx, opt)
+			}
+			if err != nil {
+				return fmt.Errorf("task %s of assignment %s: %w", task.GetName(), assignment.GetName(), err)
+			}
+			issue.IssueNumber = uint64(scmIssue.Number)
+			issue.Title = task.GetTitle()
+			issue.Body = task.GetBody()
+			if err := db.UpdateIssue(issue); err != nil {
+				return fmt.Errorf("failed to save issue for task %s of assignment %s: %w", task.GetName(), assignment.GetName(), err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package assignments
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/scm"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestParseTasks(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml":       "assignmentid: 1\n",
+		"lab1/task-hello.md":        "# Hello World\n\nPrint *Hello, World!*.\n",
+		"lab1/task-go_questions.md": "## Go Questions\nAnswer the questions in `questions.md`.",
+		"lab1/notes.md":             "# Not a task\n",
+		"lab2/assignment.yml":       "assignmentid: 2\n",
+	})
+	assignments, _, err := parseAssignments(testsDir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantTasks := []*pb.Task{
+		{Name: "go_questions", Title: "Go Questions", Body: "Answer the questions in `questions.md`."},
+		{Name: "hello", Title: "Hello World", Body: "Print *Hello, World!*."},
+	}
+	if diff := cmp.Diff(wantTasks, assignments[0].GetTasks(), protocmp.Transform()); diff != "" {
+		t.Errorf("tasks of lab1 mismatch (-want +got):\n%s", diff)
+	}
+	if len(assignments[1].GetTasks()) != 0 {
+		t.Errorf("tasks of lab2 = %v, want none", assignments[1].GetTasks())
+	}
+}
+
+func TestParseTasksInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+	}{
+		{"no heading", map[string]string{
+			"lab1/assignment.yml": "assignmentid: 1\n",
+			"lab1/task-hello.md":  "Print Hello, World!\n",
+		}},
+		{"empty title", map[string]string{
+			"lab1/assignment.yml": "assignmentid: 1\n",
+			"lab1/task-hello.md":  "#\nPrint Hello, World!\n",
+		}},
+		{"no assignment", map[string]string{
+			"lab1/assignment.yml": "assignmentid: 1\n",
+			"lab9/task-hello.md":  "# Hello\n",
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			testsDir := createTestsRepo(t, test.files)
+			if _, _, err := parseAssignments(testsDir, 0); err == nil {
+				t.Error("parseAssignments() succeeded for invalid task file, want error")
+			}
+		})
+	}
+}
+
+func TestSynchronizeTasks(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+	admin := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Code: "DAT320", OrganizationID: 1, OrganizationPath: "dat320-2022"}
+	qtest.CreateCourse(t, db, admin, course)
+	student := qtest.CreateFakeUser(t, db, 2)
+	qtest.EnrollStudent(t, db, student, course)
+	group := &pb.Group{Name: "group1", CourseID: course.ID, Users: []*pb.User{student}}
+	if err := db.CreateGroup(group); err != nil {
+		t.Fatal(err)
+	}
+	for _, repo := range []*pb.Repository{
+		{OrganizationID: 1, RepositoryID: 1, UserID: student.ID, HTMLURL: "https://github.com/dat320-2022/student-labs", RepoType: pb.Repository_USER},
+		{OrganizationID: 1, RepositoryID: 2, GroupID: group.ID, HTMLURL: "https://github.com/dat320-2022/group1", RepoType: pb.Repository_GROUP},
+	} {
+		if err := db.CreateRepository(repo); err != nil {
+			t.Fatal(err)
+		}
+	}
+	assignments := []*pb.Assignment{
+		{ID: 1, Name: "lab1", Tasks: []*pb.Task{
+			{Name: "hello", Title: "Hello World", Body: "Print *Hello, World!*."},
+			{Name: "go_questions", Title: "Go Questions", Body: "Answer the questions."},
+		}},
+		{ID: 2, Name: "lab2", IsGroupLab: true, Tasks: []*pb.Task{
+			{Name: "network", Title: "Network", Body: "Implement a UDP server."},
+		}},
+	}
+
+	ctx := context.Background()
+	logger := zap.NewNop().Sugar()
+	sc := scm.NewFakeSCMClient()
+	if err := synchronizeTasks(ctx, logger, db, sc, course, assignments); err != nil {
+		t.Fatal(err)
+	}
+	// changing a task updates its issue, while unchanged tasks are left alone
+	assignments[0].Tasks[1].Body = "Answer the questions in `questions.md`."
+	if err := synchronizeTasks(ctx, logger, db, sc, course, assignments); err != nil {
+		t.Fatal(err)
+	}
+
+	wantIssues := map[string][]*scm.Issue{
+		"dat320-2022/student-labs": {
+			{ID: 1, Number: 1, Title: "Hello World", Body: "Print *Hello, World!*."},
+			{ID: 2, Number: 2, Title: "Go Questions", Body: "Answer the questions in `questions.md`."},
+		},
+		"dat320-2022/group1": {
+			{ID: 1, Number: 1, Title: "Network", Body: "Implement a UDP server."},
+		},
+	}
+	if diff := cmp.Diff(wantIssues, sc.Issues); diff != "" {
+		t.Errorf("issues mismatch (-want +got):\n%s", diff)
+	}
+	issues, err := db.GetIssues(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantStored := []*pb.Issue{
+		{RepositoryID: 1, AssignmentID: 1, TaskName: "hello", IssueNumber: 1, Title: "Hello World", Body: "Print *Hello, World!*."},
+		{RepositoryID: 1, AssignmentID: 1, TaskName: "go_questions", IssueNumber: 2, Title: "Go Questions", Body: "Answer the questions in `questions.md`."},
+	}
+	if diff := cmp.Diff(wantStored, issues, protocmp.Transform(), protocmp.IgnoreFields(&pb.Issue{}, "ID")); diff != "" {
+		t.Errorf("GetIssues() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,288 @@
+package assignments
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"gopkg.in/yaml.v2"
+)
+
+// ValidationError describes a problem with an assignment file in the tests repository.
+type ValidationError struct {
+	File  string // path of the file, relative to the cloned tests repository
+	Field string // key of the offending field; empty if the problem concerns the whole file
+	Msg   string
+}
+
+func (e *ValidationError) Error() string {
+	if e.Field == "" {
+		return fmt.Sprintf("%s: %s", e.File, e.Msg)
+	}
+	return fmt.Sprintf("%s: %s: %s", e.File, e.Field, e.Msg)
+}
+
+// ValidationErrors holds all problems found in the assignment files by parseAssignments.
+type ValidationErrors []*ValidationError
+
+func (errs ValidationErrors) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "found %d problem(s) in assignment files:", len(errs))
+	for _, e := range errs {
+		b.WriteString("\n\t")
+		b.WriteString(e.Error())
+	}
+	return b.String()
+}
+
+// assignmentValidator checks the assignment files found while parsing the tests repository.
+// Rather than stopping at the first problem, and accepting some mistakes silently, such as
+// a malformed deadline, the validator collects the problems of all assignments, so that
+// teachers can fix all problems at once. The following problems are reported:
+//
+//   - malformed YAML
+//   - dates in a format not accepted for deadlines, and unknown time zones
+//   - the same assignmentid used by more than one assignment
+//   - a scorelimit above 100
+//   - approval policies for an assignment without autoapprove
+//   - resource limits that are malformed or below docker's minimum
+//   - secrets with invalid environment variables or secret names
+//   - a missing run.sh script, when the assignment has no language
+//     providing a default script and no default script is found in the
+//     scripts folder
+//
+// Keys not known to QuickFeed, e.g., misspelled keys, are reported as warnings,
+// since they do not prevent the assignments from being updated.
+type assignmentValidator struct {
+	scripts  map[string]bool          // names of folders holding a run.sh script
+	orders   map[uint][]fieldLocation // locations of the assignmentid of each assignment using it
+	needs    []scriptLocation         // assignments that need a run.sh script
+	errs     ValidationErrors
+	warnings ValidationErrors
+}
+
+func newAssignmentValidator() *assignmentValidator {
+	return &assignmentValidator{
+		scripts: make(map[string]bool),
+		orders:  make(map[uint][]fieldLocation),
+	}
+}
+
+// scriptLocation identifies the assignment file of an assignment that needs a run.sh script.
+type scriptLocation struct {
+	file, name string
+}
+
+// fieldLocation identifies a field of an assignment file.
+type fieldLocation struct {
+	file, field string
+}
+
+func (v *assignmentValidator) add(file, field, format string, args ...interface{}) {
+	v.errs = append(v.errs, &ValidationError{File: file, Field: field, Msg: fmt.Sprintf(format, args...)})
+}
+
+func (v *assignmentValidator) warn(file, field, format string, args ...interface{}) {
+	v.warnings = append(v.warnings, &ValidationError{File: file, Field: field, Msg: fmt.Sprintf(format, args...)})
+}
+
+// checkFile checks the given contents of an assignment file, normalized to YAML,
+// and returns true if no problems were found.
+func (v *assignmentValidator) checkFile(file, name string, contents []byte) bool {
+	found := len(v.errs)
+	var raw map[string]interface{}
+	if err := yaml.Unmarshal(contents, &raw); err != nil {
+		v.add(file, "", "malformed YAML: %v", err)
+		return false
+	}
+	var data assignmentData
+	if err := yaml.Unmarshal(contents, &data); err != nil {
+		v.add(file, "", "malformed assignment: %v", err)
+		return false
+	}
+	v.checkAssignment(file, "", name, raw, data)
+	return len(v.errs) == found
+}
+
+// finish checks the assignments against each other, and for their scripts, once all
+// files have been seen. Returns the problems found, if any, as ValidationErrors.
+func (v *assignmentValidator) finish() error {
+	for _, need := range v.needs {
+		if !v.scripts[need.name] && !v.scripts[scriptFolder] {
+			v.add(need.file, "", "assignment %s has no %s script; add one to the assignment's folder or to the %s folder, or set language",
+				need.name, scriptFile, scriptFolder)
+		}
+	}
+	v.checkOrders()
+	if len(v.errs) > 0 {
+		return v.errs
+	}
+	return nil
+}
+
+// relPath returns the path relative to dir, for reporting.
+func relPath(dir, path string) string {
+	if rel, err := filepath.Rel(dir, path); err == nil {
+		return filepath.ToSlash(rel)
+	}
+	return path
+}
+
+// checkManifest checks each assignment defined in the given manifest file,
+// and returns true if no problems were found.
+func (v *assignmentValidator) checkManifest(file string, contents []byte) bool {
+	found := len(v.errs)
+	var raw map[string]map[string]interface{}
+	if err := yaml.Unmarshal(contents, &raw); err != nil {
+		v.add(file, "", "malformed YAML: %v", err)
+		return false
+	}
+	var manifest map[string]assignmentData
+	if err := yaml.Unmarshal(contents, &manifest); err != nil {
+		v.add(file, "", "malformed manifest: %v", err)
+		return false
+	}
+	names := make([]string, 0, len(manifest))
+	for name := range manifest {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		v.checkAssignment(file, name+".", name, raw[name], manifest[name])
+	}
+	return len(v.errs) == found
+}
+
+// checkAssignment checks the given assignment's data, as parsed from the given file.
+// The prefix is prepended to the reported field names, to identify the assignment
+// in a manifest file.
+func (v *assignmentValidator) checkAssignment(file, prefix, name string, raw map[string]interface{}, data assignmentData) {
+	for _, key := range unknownKeys(raw, reflect.TypeOf(data), prefix) {
+		if informationalKeys[strings.TrimPrefix(key, prefix)] {
+			continue
+		}
+		v.warn(file, key, "unknown key")
+	}
+	dates := []struct {
+		field, value string
+	}{
+		{"deadline", data.Deadline},
+		{"releasedate", data.ReleaseDate},
+		{"closedate", data.CloseDate},
+	}
+	if d := data.Deadlines; d != nil {
+		dates = append(dates, []struct {
+			field, value string
+		}{
+			{"deadlines.soft", d.Soft},
+			{"deadlines.hard", d.Hard},
+		}...)
+	}
+	for _, date := range dates {
+		if date.value == "" {
+			continue
+		}
+		if _, err := parseDeadline(date.value); err != nil {
+			v.add(file, prefix+date.field, "%v", err)
+		}
+	}
+	if data.TimeZone != "" {
+		if _, err := time.LoadLocation(data.TimeZone); err != nil {
+			v.add(file, prefix+"timezone", "unknown time zone %q; use an IANA time zone, e.g., Europe/Oslo", data.TimeZone)
+		}
+	}
+	if data.ScoreLimit > 100 {
+		v.add(file, prefix+"scorelimit", "%d is above 100", data.ScoreLimit)
+	}
+	if _, err := secretVariables(data.Secrets); err != nil {
+		v.add(file, prefix+"secrets", "%v", err)
+	}
+	if err := data.Limits.apply(&pb.Assignment{}); err != nil {
+		v.add(file, prefix+"limits", "%v", err)
+	}
+	if data.Approval != nil && !data.AutoApprove {
+		v.add(file, prefix+"approval", "approval policies have no effect unless autoapprove is true")
+	}
+	if data.AssignmentID > 0 {
+		v.orders[data.AssignmentID] = append(v.orders[data.AssignmentID], fieldLocation{file, prefix + "assignmentid"})
+	}
+	if !data.ManualOnly && data.Language == "" {
+		// the scripts are checked once all files have been seen
+		v.needs = append(v.needs, scriptLocation{file, name})
+	}
+}
+
+// checkOrders reports each assignmentid used by more than one assignment.
+func (v *assignmentValidator) checkOrders() {
+	ids := make([]uint, 0, len(v.orders))
+	for id := range v.orders {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	for _, id := range ids {
+		locations := v.orders[id]
+		if len(locations) < 2 {
+			continue
+		}
+		for _, location := range locations {
+			v.add(location.file, location.field, "%d is used by %d assignments", id, len(locations))
+		}
+	}
+}
+
+// informationalKeys are keys used in assignment files by the documentation and
+// by other tools, such as the assignment's title, which QuickFeed ignores.
+var informationalKeys = map[string]bool{
+	"name":     true,
+	"title":    true,
+	"hoursmin": true,
+	"hoursmax": true,
+}
+
+// unknownKeys returns the keys of the given YAML mapping, prefixed by prefix,
+// that do not match a yaml tag of the given struct type. The keys of nested
+// mappings for struct fields are checked recursively.
+func unknownKeys(raw map[string]interface{}, typ reflect.Type, prefix string) []string {
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	fields := make(map[string]reflect.Type)
+	for i := 0; i < typ.NumField(); i++ {
+		tag := strings.Split(typ.Field(i).Tag.Get("yaml"), ",")[0]
+		fields[tag] = typ.Field(i).Type
+	}
+	keys := make([]string, 0, len(raw))
+	for key := range raw {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var unknown []string
+	for _, key := range keys {
+		fieldType, ok := fields[key]
+		if !ok {
+			unknown = append(unknown, prefix+key)
+			continue
+		}
+		nested, ok := raw[key].(map[interface{}]interface{})
+		if !ok {
+			continue
+		}
+		if fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if fieldType.Kind() != reflect.Struct {
+			continue
+		}
+		nestedRaw := make(map[string]interface{}, len(nested))
+		for k, v := range nested {
+			nestedRaw[fmt.Sprint(k)] = v
+		}
+		unknown = append(unknown, unknownKeys(nestedRaw, fieldType, prefix+key+".")...)
+	}
+	return unknown
+}
@@ -0,0 +1,123 @@
+package assignments
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestValidateAssignments(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": `assignmentid: 1
+title: "Introduction to Unix"
+deadline: "27-08-2018 12:00"
+scorelimit: 80
+hoursmin: 6
+`,
+		"lab1/run.sh": "#image/quickfeed:go\n",
+		"lab2/assignment.yml": `assignmentid: 1
+dedline: "27-08-2018 12:00"
+scorelimit: 120
+deadlines:
+  soft: "tomorrow"
+  grace: 2
+`,
+		"lab3/assignment.yml": `assignmentid: 3
+deadline: "27-08-2018 12:00"
+language: python
+secrets:
+  QUICKFEED_TOKEN: token
+limits:
+  memory: 1k
+`,
+		"lab4/assignment.json": `{"assignmentid": 4, "language": "go", "autoaprove": true, "timezone": "Mars/Olympus"}`,
+	})
+
+	_, _, err := parseAssignments(testsDir, 0)
+	var report ValidationErrors
+	if !errors.As(err, &report) {
+		t.Fatalf("parseAssignments() = %v, want ValidationErrors", err)
+	}
+	want := []string{
+		`lab2/assignment.yml: deadlines.soft: invalid date format "tomorrow"; use YYYY-MM-DD HH:MM or DD-MM-YYYY HH:MM`,
+		"lab2/assignment.yml: scorelimit: 120 is above 100",
+		`lab3/assignment.yml: secrets: invalid environment variable "QUICKFEED_TOKEN" in secrets`,
+		"lab3/assignment.yml: limits: memory 1k must be at least 6MiB",
+		`lab4/assignment.json: timezone: unknown time zone "Mars/Olympus"; use an IANA time zone, e.g., Europe/Oslo`,
+		"lab2/assignment.yml: assignment lab2 has no run.sh script; add one to the assignment's folder or to the scripts folder, or set language",
+		"lab1/assignment.yml: assignmentid: 1 is used by 2 assignments",
+		"lab2/assignment.yml: assignmentid: 1 is used by 2 assignments",
+	}
+	if diff := cmp.Diff(want, errorStrings(report)); diff != "" {
+		t.Errorf("parseAssignments() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestValidateAssignmentsWarnings(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": `assignmentid: 1
+title: "Introduction to Unix"
+deadline: "27-08-2018 12:00"
+dedline: "27-08-2018 12:00"
+deadlines:
+  grace: 2
+`,
+		"lab1/run.sh":          "#image/quickfeed:go\n",
+		"lab2/assignment.json": `{"assignmentid": 2, "language": "go", "autoaprove": true}`,
+	})
+	// unknown keys do not prevent the assignments from being parsed
+	assignments, data, err := parseAssignments(testsDir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(assignments) != 2 {
+		t.Errorf("parseAssignments() = %d assignments, want 2", len(assignments))
+	}
+	want := []string{
+		"lab1/assignment.yml: deadlines.grace: unknown key",
+		"lab1/assignment.yml: dedline: unknown key",
+		"lab2/assignment.json: autoaprove: unknown key",
+	}
+	if diff := cmp.Diff(want, errorStrings(data.warnings)); diff != "" {
+		t.Errorf("parseAssignments() warnings mismatch (-want +got):\n%s", diff)
+	}
+}
+
+// errorStrings returns the messages of the given validation errors.
+func errorStrings(errs ValidationErrors) []string {
+	messages := make([]string, 0, len(errs))
+	for _, e := range errs {
+		messages = append(messages, e.Error())
+	}
+	return messages
+}
+
+func TestValidateAssignmentsManifest(t *testing.T) {
+	testsDir := createTestsRepo(t, map[string]string{
+		"assignments.yml": `lab1:
+  assignmentid: 1
+  deadline: "27-08-2018 12:00"
+lab2:
+  assignmentid: 2
+  closedate: "someday"
+`,
+		"lab1/README.md": "# Lab 1\n",
+		"lab2/README.md": "# Lab 2\n",
+		"scripts/run.sh": "#image/quickfeed:go\n",
+	})
+	_, _, err := parseAssignments(testsDir, 0)
+	want := `found 1 problem(s) in assignment files:
+	assignments.yml: lab2.closedate: invalid date format "someday"; use YYYY-MM-DD HH:MM or DD-MM-YYYY HH:MM`
+	if err == nil || err.Error() != want {
+		t.Errorf("parseAssignments() = %v, want %s", err, want)
+	}
+
+	valid := createTestsRepo(t, map[string]string{
+		"lab1/assignment.yml": "assignmentid: 1\ndeadline: \"27-08-2018 12:00\"\n",
+		"scripts/run.sh":      "#image/quickfeed:go\n",
+	})
+	if _, _, err := parseAssignments(valid, 0); err != nil {
+		t.Errorf("parseAssignments() = %v, want nil", err)
+	}
+}
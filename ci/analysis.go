@@ -0,0 +1,197 @@
+package ci
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/kit/score"
+)
+
+// analysisTools maps the built-in static analysis tools to their commands.
+// The tools must be installed in the image running the tests.
+var analysisTools = map[string]string{
+	"govet":         "go vet ./...",
+	"staticcheck":   "staticcheck ./...",
+	"golangci-lint": "golangci-lint run --out-format=line-number ./...",
+}
+
+// AnalysisTool returns the command of the given built-in static analysis tool,
+// and false if the tool is unknown.
+func AnalysisTool(tool string) (string, bool) {
+	command, ok := analysisTools[tool]
+	return command, ok
+}
+
+// RunsAnalysis returns true if the given script template runs the assignment's static analysis checks.
+func RunsAnalysis(script string) bool {
+	return strings.Contains(script, ".Analysis")
+}
+
+// maxAnnotations is the maximum number of findings of each check stored with a submission.
+// The check's score is computed from all its findings.
+const maxAnnotations = 100
+
+// findingPattern matches a finding reported as file:line: message or file:line:column: message,
+// optionally preceded by the tool's name, e.g., vet: fib.go:12:2: unreachable code.
+var findingPattern = regexp.MustCompile(`^(?:[\w-]+: )?([^\s:]+):(\d+)(?::\d+)?: (.+)$`)
+
+// analysisScript returns the shell commands running the given checks, in order. Each line
+// of a check's output is prefixed by a marker holding the given session secret and the
+// check's name, and a final marker line shows that the check has completed.
+func analysisScript(checks []*pb.AnalysisCheck, secret string) string {
+	var b strings.Builder
+	for _, check := range checks {
+		name := check.GetName()
+		fmt.Fprintf(&b, "printf \"\\n*** Running Static Analysis: %s ***\\n\\n\"\n", name)
+		fmt.Fprintf(&b, "{ %s ; } 2>&1 | sed 's|^|%s|'\n", check.GetCommand(), analysisPrefix(secret, name))
+		fmt.Fprintf(&b, "echo '%s'\n", analysisDone(secret, name))
+	}
+	return b.String()
+}
+
+// analysisPrefix returns the marker of the given check's output lines.
+func analysisPrefix(secret, check string) string {
+	return secret + "/" + check + ": "
+}
+
+// analysisDone returns the marker line showing that the given check has completed.
+func analysisDone(secret, check string) string {
+	return secret + "/" + check + "."
+}
+
+// unmarkAnalysisLine returns the given output line without its static analysis marker, if any,
+// and the name of the check that printed it. A marker line showing that a check has completed
+// is reported as done, and should be left out of the output.
+func unmarkAnalysisLine(line, secret string) (unmarked, check string, done bool) {
+	if secret == "" || !strings.HasPrefix(line, secret+"/") {
+		return line, "", false
+	}
+	rest := line[len(secret)+1:]
+	if i := strings.Index(rest, ": "); i > 0 {
+		return rest[i+2:], rest[:i], false
+	}
+	if strings.HasSuffix(rest, ".") {
+		return "", strings.TrimSuffix(rest, "."), true
+	}
+	return line, "", false
+}
+
+// analysisOutput holds the findings of the static analysis checks of a test run.
+type analysisOutput struct {
+	findings  map[string][]*pb.Annotation // stored findings by check name
+	counts    map[string]int              // number of findings by check name
+	completed map[string]bool             // completed checks
+}
+
+// extractAnalysis returns the given output with the static analysis markers removed,
+// along with the findings of the checks that printed the marked lines.
+func extractAnalysis(out, secret string) (string, *analysisOutput) {
+	analysis := &analysisOutput{
+		findings:  make(map[string][]*pb.Annotation),
+		counts:    make(map[string]int),
+		completed: make(map[string]bool),
+	}
+	lines := strings.Split(out, "\n")
+	kept := lines[:0]
+	for _, line := range lines {
+		unmarked, check, done := unmarkAnalysisLine(line, secret)
+		switch {
+		case done:
+			analysis.completed[check] = true
+			continue
+		case check != "":
+			if finding, ok := parseFinding(check, unmarked); ok {
+				if analysis.counts[check] < maxAnnotations {
+					analysis.findings[check] = append(analysis.findings[check], finding)
+				}
+				analysis.counts[check]++
+			}
+		}
+		kept = append(kept, unmarked)
+	}
+	return strings.Join(kept, "\n"), analysis
+}
+
+// parseFinding returns the finding of the given check reported by the given output line,
+// and false if the line does not report a finding.
+func parseFinding(check, line string) (*pb.Annotation, bool) {
+	m := findingPattern.FindStringSubmatch(strings.TrimSpace(line))
+	if m == nil {
+		return nil, false
+	}
+	lineNum, err := strconv.ParseUint(m[2], 10, 32)
+	if err != nil {
+		return nil, false
+	}
+	return &pb.Annotation{
+		CheckName: check,
+		File:      strings.TrimPrefix(m[1], "./"),
+		Line:      uint32(lineNum),
+		Message:   m[3],
+	}, true
+}
+
+// incomplete returns the names of the given checks that did not complete.
+func (a *analysisOutput) incomplete(checks []*pb.AnalysisCheck) []string {
+	var names []string
+	for _, check := range checks {
+		if !a.completed[check.GetName()] {
+			names = append(names, check.GetName())
+		}
+	}
+	return names
+}
+
+// annotations returns the stored findings of all checks, ordered by check, file and line.
+func (a *analysisOutput) annotations() []*pb.Annotation {
+	var annotations []*pb.Annotation
+	if a == nil {
+		return annotations
+	}
+	for _, findings := range a.findings {
+		annotations = append(annotations, findings...)
+	}
+	sort.SliceStable(annotations, func(i, j int) bool {
+		x, y := annotations[i], annotations[j]
+		if x.GetCheckName() != y.GetCheckName() {
+			return x.GetCheckName() < y.GetCheckName()
+		}
+		if x.GetFile() != y.GetFile() {
+			return x.GetFile() < y.GetFile()
+		}
+		return x.GetLine() < y.GetLine()
+	})
+	return annotations
+}
+
+// addAnalysisScores adds a score entry to the results for each of the assignment's checks
+// with a weight. A check earns the full score without findings, and loses the check's
+// penalty for each finding. A check that did not complete earns no score.
+func addAnalysisScores(assignment *pb.Assignment, results *score.Results, analysis *analysisOutput) {
+	for _, check := range assignment.GetAnalysisChecks() {
+		if check.GetWeight() <= 0 {
+			continue
+		}
+		penalty := int32(check.GetPenalty())
+		if penalty == 0 {
+			penalty = 100
+		}
+		points := int32(0)
+		if analysis != nil && analysis.completed[check.GetName()] {
+			points = 100 - penalty*int32(analysis.counts[check.GetName()])
+			if points < 0 {
+				points = 0
+			}
+		}
+		results.Scores = append(results.Scores, &score.Score{
+			TestName: check.GetName(),
+			Score:    points,
+			MaxScore: 100,
+			Weight:   check.GetWeight(),
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package ci
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/kit/score"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestAnalysisScript(t *testing.T) {
+	const secret = "0123abcd"
+	checks := []*pb.AnalysisCheck{
+		{Name: "vet", Command: `printf '# example.com/lab1\n./fib.go:12:2: unreachable code\nvet: sort.go:3: missing return\n'`},
+		{Name: "lint", Command: "echo 'all good'; exit 1"},
+	}
+	out, err := exec.Command("/bin/sh", "-c", analysisScript(checks, secret)+"echo 'PASS: TestFib'").CombinedOutput()
+	if err != nil {
+		t.Fatalf("failed to run analysis script: %v\n%s", err, out)
+	}
+	log, analysis := extractAnalysis(string(out), secret)
+	if strings.Contains(log, secret) {
+		t.Errorf("extractAnalysis() output contains session secret:\n%s", log)
+	}
+	for _, line := range []string{"./fib.go:12:2: unreachable code", "all good", "PASS: TestFib"} {
+		if !strings.Contains(log, line+"\n") {
+			t.Errorf("extractAnalysis() output does not contain %q:\n%s", line, log)
+		}
+	}
+	want := []*pb.Annotation{
+		{CheckName: "vet", File: "fib.go", Line: 12, Message: "unreachable code"},
+		{CheckName: "vet", File: "sort.go", Line: 3, Message: "missing return"},
+	}
+	if diff := cmp.Diff(want, analysis.annotations(), protocmp.Transform()); diff != "" {
+		t.Errorf("annotations() mismatch (-want +got):\n%s", diff)
+	}
+	if !analysis.completed["vet"] || !analysis.completed["lint"] {
+		t.Errorf("completed = %v, want vet and lint", analysis.completed)
+	}
+	if incomplete := analysis.incomplete(append(checks, &pb.AnalysisCheck{Name: "staticcheck"})); len(incomplete) != 1 || incomplete[0] != "staticcheck" {
+		t.Errorf("incomplete() = %v, want [staticcheck]", incomplete)
+	}
+}
+
+func TestParseFinding(t *testing.T) {
+	tests := []struct {
+		line string
+		want *pb.Annotation // nil if the line is not a finding
+	}{
+		{line: "fib.go:12:2: unreachable code", want: &pb.Annotation{CheckName: "vet", File: "fib.go", Line: 12, Message: "unreachable code"}},
+		{line: "./lab1/fib.go:7: exported function Fib should have comment (golint)", want: &pb.Annotation{CheckName: "vet", File: "lab1/fib.go", Line: 7, Message: "exported function Fib should have comment (golint)"}},
+		{line: "vet: fib.go:3:1: expected declaration", want: &pb.Annotation{CheckName: "vet", File: "fib.go", Line: 3, Message: "expected declaration"}},
+		{line: "# example.com/lab1"},
+		{line: "\treturn fib(n-1) + fib(n-2)"},
+		{line: "fib.go: no line number"},
+	}
+	for _, tt := range tests {
+		got, ok := parseFinding("vet", tt.line)
+		if ok != (tt.want != nil) {
+			t.Errorf("parseFinding(%q) = %v, want finding %t", tt.line, ok, tt.want != nil)
+			continue
+		}
+		if diff := cmp.Diff(tt.want, got, protocmp.Transform()); ok && diff != "" {
+			t.Errorf("parseFinding(%q) mismatch (-want +got):\n%s", tt.line, diff)
+		}
+	}
+}
+
+func TestExtractAnalysisLimit(t *testing.T) {
+	var out strings.Builder
+	for i := 0; i < maxAnnotations+5; i++ {
+		out.WriteString("s3/vet: fib.go:1: finding\n")
+	}
+	out.WriteString("s3/vet.\n")
+	_, analysis := extractAnalysis(out.String(), "s3")
+	if n := len(analysis.annotations()); n != maxAnnotations {
+		t.Errorf("len(annotations()) = %d, want %d", n, maxAnnotations)
+	}
+	if n := analysis.counts["vet"]; n != maxAnnotations+5 {
+		t.Errorf("counts[vet] = %d, want %d", n, maxAnnotations+5)
+	}
+}
+
+func TestAddAnalysisScores(t *testing.T) {
+	assignment := &pb.Assignment{AnalysisChecks: []*pb.AnalysisCheck{
+		{Name: "vet", Weight: 2},
+		{Name: "lint", Weight: 1, Penalty: 10},
+		{Name: "strict", Weight: 1, Penalty: 60},
+		{Name: "advisory"},
+		{Name: "missing", Weight: 1},
+	}}
+	analysis := &analysisOutput{
+		counts:    map[string]int{"lint": 3, "strict": 2, "advisory": 7},
+		completed: map[string]bool{"vet": true, "lint": true, "strict": true, "advisory": true},
+	}
+	results := &score.Results{}
+	addAnalysisScores(assignment, results, analysis)
+	want := []*score.Score{
+		{TestName: "vet", Score: 100, MaxScore: 100, Weight: 2},
+		{TestName: "lint", Score: 70, MaxScore: 100, Weight: 1},
+		{TestName: "strict", Score: 0, MaxScore: 100, Weight: 1},
+		{TestName: "missing", Score: 0, MaxScore: 100, Weight: 1},
+	}
+	if diff := cmp.Diff(want, results.Scores, protocmp.Transform()); diff != "" {
+		t.Errorf("addAnalysisScores() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,40 @@
+package ci
+
+import (
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/kit/score"
+	"go.uber.org/zap"
+)
+
+// approvalRun returns the test run with the given results and score, as evaluated by
+// the assignment's approval policies, given the newest submission for the assignment.
+func approvalRun(logger *zap.SugaredLogger, assignment *pb.Assignment, newest *pb.Submission, result *score.Results, score uint32, rebuild bool) *pb.ApprovalRun {
+	run := &pb.ApprovalRun{
+		Score:  penalizedScore(logger, assignment, result.BuildInfo, score),
+		Scores: result.Scores,
+	}
+	// build dates are recorded in the server's local time; an unknown
+	// build date prevents approval only if required before the deadline
+	if builtAt, err := time.ParseInLocation(pb.TimeLayout, result.BuildInfo.GetBuildDate(), time.Local); err == nil {
+		run.BuiltAt = builtAt
+	}
+	run.Streak = passStreak(assignment, newest, run, rebuild)
+	return run
+}
+
+// passStreak returns the number of consecutive passing test runs for the assignment,
+// including the given run, given the newest submission. A rebuild replaces the
+// newest submission's run, and thus does not extend its streak.
+func passStreak(assignment *pb.Assignment, newest *pb.Submission, run *pb.ApprovalRun, rebuild bool) uint32 {
+	switch {
+	case !assignment.Passes(run):
+		return 0
+	case rebuild && newest.GetPassStreak() > 0:
+		return newest.GetPassStreak()
+	case rebuild:
+		return 1
+	}
+	return newest.GetPassStreak() + 1
+}
@@ -0,0 +1,31 @@
+package ci
+
+import (
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+func TestPassStreak(t *testing.T) {
+	assignment := &pb.Assignment{AutoApprove: true, ScoreLimit: 80, ApprovalRuns: 2}
+	passing, failing := &pb.ApprovalRun{Score: 90}, &pb.ApprovalRun{Score: 50}
+	tests := []struct {
+		name    string
+		newest  *pb.Submission
+		run     *pb.ApprovalRun
+		rebuild bool
+		want    uint32
+	}{
+		{name: "first passing run", newest: nil, run: passing, want: 1},
+		{name: "second passing run", newest: &pb.Submission{PassStreak: 1}, run: passing, want: 2},
+		{name: "failing run", newest: &pb.Submission{PassStreak: 2}, run: failing, want: 0},
+		{name: "passing rebuild", newest: &pb.Submission{PassStreak: 2}, run: passing, rebuild: true, want: 2},
+		{name: "passing rebuild of failing run", newest: &pb.Submission{}, run: passing, rebuild: true, want: 1},
+		{name: "failing rebuild", newest: &pb.Submission{PassStreak: 2}, run: failing, rebuild: true, want: 0},
+	}
+	for _, test := range tests {
+		if got := passStreak(assignment, test.newest, test.run, test.rebuild); got != test.want {
+			t.Errorf("%s: passStreak() = %d, want %d", test.name, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package ci
+
+import (
+	"archive/tar"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"strings"
+
+	pb "github.com/autograde/quickfeed/ag"
+	units "github.com/docker/go-units"
+)
+
+// ArtifactsDir is the folder of the test container to which the tests may write files,
+// such as coverage reports, rendered plots, or compiled binaries, to be stored with the submission.
+const ArtifactsDir = "/artifacts"
+
+// maxArtifactsSize is the maximum total size in bytes of the artifacts stored with a submission.
+var maxArtifactsSize int64 = 10 << 20
+
+// extractArtifacts returns the regular files in the given tar archive of the artifacts folder,
+// named by their paths relative to the folder. Files that do not fit within the given limit
+// on the total size of the artifacts are left out, and reported by the returned error.
+func extractArtifacts(archive io.Reader, limit int64) ([]*pb.Artifact, error) {
+	var artifacts []*pb.Artifact
+	var leftOut []string
+	var total int64
+	tr := tar.NewReader(archive)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return artifacts, fmt.Errorf("failed to read artifacts: %w", err)
+		}
+		name := artifactName(hdr.Name)
+		if name == "" || !hdr.FileInfo().Mode().IsRegular() {
+			continue
+		}
+		if total+hdr.Size > limit {
+			leftOut = append(leftOut, name)
+			continue
+		}
+		content, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return artifacts, fmt.Errorf("failed to read artifact %s: %w", name, err)
+		}
+		total += int64(len(content))
+		artifacts = append(artifacts, &pb.Artifact{
+			Name:    name,
+			Size:    uint64(len(content)),
+			Content: content,
+		})
+	}
+	if len(leftOut) > 0 {
+		return artifacts, fmt.Errorf("artifacts exceeding the limit of %s were not stored: %s",
+			units.BytesSize(float64(limit)), strings.Join(leftOut, ", "))
+	}
+	return artifacts, nil
+}
+
+// artifactName returns the path relative to the artifacts folder of the given entry
+// of an archive of the folder, or the empty string for the folder itself.
+func artifactName(entry string) string {
+	// entries are prefixed by the folder's base name, e.g., artifacts/coverage.html
+	name := strings.TrimPrefix(path.Clean("/"+entry), "/")
+	if i := strings.Index(name, "/"); i >= 0 {
+		return name[i+1:]
+	}
+	return ""
+}
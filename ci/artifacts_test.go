@@ -0,0 +1,99 @@
+package ci
+
+import (
+	"archive/tar"
+	"bytes"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+// artifactsArchive returns a tar archive of an artifacts folder holding the given files,
+// laid out as copied from a container.
+func artifactsArchive(t *testing.T, files ...string) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	headers := []*tar.Header{
+		{Name: "artifacts/", Typeflag: tar.TypeDir, Mode: 0o755},
+		{Name: "artifacts/plots/", Typeflag: tar.TypeDir, Mode: 0o755},
+		{Name: "artifacts/latest", Typeflag: tar.TypeSymlink, Linkname: "plots", Mode: 0o777},
+	}
+	for _, h := range headers {
+		if err := tw.WriteHeader(h); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i := 0; i < len(files); i += 2 {
+		name, content := files[i], files[i+1]
+		if err := tw.WriteHeader(&tar.Header{Name: "artifacts/" + name, Typeflag: tar.TypeReg, Mode: 0o644, Size: int64(len(content))}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return &buf
+}
+
+func TestExtractArtifacts(t *testing.T) {
+	archive := artifactsArchive(t,
+		"coverage.html", "<html>covered</html>",
+		"plots/loss.png", "png",
+	)
+	got, err := extractArtifacts(archive, maxArtifactsSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*pb.Artifact{
+		{Name: "coverage.html", Size: 20, Content: []byte("<html>covered</html>")},
+		{Name: "plots/loss.png", Size: 3, Content: []byte("png")},
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("extractArtifacts() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestExtractArtifactsLimit(t *testing.T) {
+	archive := artifactsArchive(t,
+		"small.txt", "12345",
+		"large.bin", "1234567890",
+		"tiny.txt", "1",
+	)
+	got, err := extractArtifacts(archive, 8)
+	const wantErr = "artifacts exceeding the limit of 8B were not stored: large.bin"
+	if err == nil || err.Error() != wantErr {
+		t.Errorf("extractArtifacts() error = %v, want %s", err, wantErr)
+	}
+	// files that fit within the limit are kept
+	want := []*pb.Artifact{
+		{Name: "small.txt", Size: 5, Content: []byte("12345")},
+		{Name: "tiny.txt", Size: 1, Content: []byte("1")},
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("extractArtifacts() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestArtifactName(t *testing.T) {
+	tests := []struct {
+		entry, want string
+	}{
+		{"artifacts", ""},
+		{"artifacts/", ""},
+		{"artifacts/coverage.html", "coverage.html"},
+		{"artifacts/plots/loss.png", "plots/loss.png"},
+		{"artifacts/../../etc/passwd", "passwd"},
+		{"/artifacts/./report.txt", "report.txt"},
+	}
+	for _, test := range tests {
+		if got := artifactName(test.entry); got != test.want {
+			t.Errorf("artifactName(%q) = %q, want %q", test.entry, got, test.want)
+		}
+	}
+}
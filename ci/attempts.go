@@ -0,0 +1,107 @@
+package ci
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// RateLimited returns true if the tests should not be run for the run's
+// submission, since the owner of the run's repository has used all the
+// attempts allowed for the assignment, or ran the tests less than the
+// assignment's cooldown period ago. The previous results are kept, and
+// the build log of the newest submission records why the run's commit
+// was not tested.
+func RateLimited(logger *zap.SugaredLogger, db database.Database, rData *RunData) bool {
+	if rData.Assignment.GetMaxAttempts() == 0 && rData.Assignment.GetCooldownMinutes() == 0 {
+		return false
+	}
+	newest, err := db.GetSubmission(&pb.Submission{
+		AssignmentID: rData.Assignment.GetID(),
+		UserID:       rData.Repo.GetUserID(),
+		GroupID:      rData.Repo.GetGroupID(),
+	})
+	if err != nil {
+		if err != gorm.ErrRecordNotFound {
+			logger.Errorf("Failed to get submission for assignment %s: %v", rData.Assignment.GetName(), err)
+		}
+		return false
+	}
+	if reason := rateLimit(rData.Assignment, newest, time.Now()); reason != "" {
+		logger.Debugf("Not running tests for %s, assignment %s: %s", rData.JobOwner, rData.Assignment.GetName(), reason)
+		buildLog := rateLimitedLog(newest.GetBuildInfo().GetBuildLog(), rData.CommitID, reason)
+		if err := db.UpdateBuildLog(newest.GetID(), buildLog); err != nil {
+			logger.Errorf("Failed to record rate limited run for user %s, assignment %s: %v", rData.JobOwner, rData.Assignment.GetName(), err)
+		}
+		return true
+	}
+	return false
+}
+
+// rateLimit returns the reason for not running the tests at the given time,
+// given the newest submission for the assignment, or an empty string if the
+// tests may be run.
+func rateLimit(assignment *pb.Assignment, newest *pb.Submission, now time.Time) string {
+	if max := assignment.GetMaxAttempts(); max > 0 && newest.GetAttempts() >= max {
+		return fmt.Sprintf("all %d attempts used", max)
+	}
+	cooldown := time.Duration(assignment.GetCooldownMinutes()) * time.Minute
+	if cooldown == 0 || newest.GetAttempts() == 0 {
+		return ""
+	}
+	// build dates are recorded in the server's local time
+	lastRun, err := time.ParseInLocation(pb.TimeLayout, newest.GetBuildInfo().GetBuildDate(), time.Local)
+	if err != nil {
+		return ""
+	}
+	if wait := lastRun.Add(cooldown).Sub(now); wait > 0 {
+		return fmt.Sprintf("cooldown period ends in %v", wait.Round(time.Second))
+	}
+	return ""
+}
+
+// rateLimitedPrefix starts the build log message of a commit that was not tested.
+const rateLimitedPrefix = "Commit not tested: "
+
+// rateLimitedLog returns the given build log of the newest submission, preceded by a
+// message that the given commit was not tested for the given reason. The message
+// replaces the message of a previous commit that was not tested, if any.
+func rateLimitedLog(buildLog, commitID, reason string) string {
+	if strings.HasPrefix(buildLog, rateLimitedPrefix) {
+		// drop the message of the previous commit
+		if i := strings.Index(buildLog, "\n\n"); i >= 0 {
+			buildLog = buildLog[i+2:]
+		} else {
+			buildLog = ""
+		}
+	}
+	message := fmt.Sprintf("%s%s: %s; the results are from an earlier commit.", rateLimitedPrefix, commitID, reason)
+	if buildLog == "" {
+		return message
+	}
+	return message + "\n\n" + buildLog
+}
+
+// reruns returns the number of times the tests of the submission's commit have been
+// re-run, given the newest submission; the count restarts when a push is tested.
+// Re-runs are counted when requested, since they are queued before they are recorded.
+func reruns(newest *pb.Submission, rebuild bool) uint32 {
+	if rebuild {
+		return newest.GetReruns()
+	}
+	return 0
+}
+
+// attempts returns the number of test runs for the assignment, including the
+// current run, given the newest submission.
+func attempts(newest *pb.Submission, rebuild bool) uint32 {
+	if rebuild {
+		return newest.GetAttempts()
+	}
+	return newest.GetAttempts() + 1
+}
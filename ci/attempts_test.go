@@ -0,0 +1,109 @@
+package ci
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/kit/score"
+	"go.uber.org/zap"
+)
+
+func TestRateLimit(t *testing.T) {
+	lastRun := time.Date(2022, 11, 10, 13, 0, 0, 0, time.Local)
+	submission := func(attempts uint32) *pb.Submission {
+		return &pb.Submission{
+			Attempts:  attempts,
+			BuildInfo: &score.BuildInfo{BuildDate: lastRun.Format(pb.TimeLayout)},
+		}
+	}
+	tests := []struct {
+		name       string
+		assignment *pb.Assignment
+		newest     *pb.Submission
+		now        time.Time
+		wantReason string
+	}{
+		{"no limits", &pb.Assignment{}, submission(10), lastRun, ""},
+		{"no submission", &pb.Assignment{MaxAttempts: 3, CooldownMinutes: 30}, nil, lastRun, ""},
+		{"attempts left", &pb.Assignment{MaxAttempts: 3}, submission(2), lastRun, ""},
+		{"all attempts used", &pb.Assignment{MaxAttempts: 3}, submission(3), lastRun, "all 3 attempts used"},
+		{"within cooldown", &pb.Assignment{CooldownMinutes: 30}, submission(1), lastRun.Add(10 * time.Minute), "cooldown period ends in 20m0s"},
+		{"after cooldown", &pb.Assignment{CooldownMinutes: 30}, submission(1), lastRun.Add(30 * time.Minute), ""},
+		{"no test run yet", &pb.Assignment{CooldownMinutes: 30}, submission(0), lastRun, ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := rateLimit(test.assignment, test.newest, test.now)
+			if (got == "") != (test.wantReason == "") || !strings.Contains(got, test.wantReason) {
+				t.Errorf("rateLimit() = %q, want %q", got, test.wantReason)
+			}
+		})
+	}
+}
+
+func TestRateLimited(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	course := &pb.Course{}
+	admin := qtest.CreateFakeUser(t, db, 1)
+	qtest.CreateCourse(t, db, admin, course)
+	lab1 := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, MaxAttempts: 1}
+	if err := db.CreateAssignment(lab1); err != nil {
+		t.Fatal(err)
+	}
+	runData := &RunData{
+		Course:     course,
+		Assignment: lab1,
+		Repo:       &pb.Repository{UserID: admin.ID},
+		CommitID:   "abc123",
+		JobOwner:   "test",
+	}
+	if RateLimited(zap.NewNop().Sugar(), db, runData) {
+		t.Error("RateLimited() = true, want false without submissions")
+	}
+	tested := &pb.Submission{
+		AssignmentID: lab1.ID,
+		UserID:       admin.ID,
+		Score:        80,
+		Attempts:     1,
+		BuildInfo:    &score.BuildInfo{BuildDate: time.Now().Format(pb.TimeLayout), BuildLog: "--- PASS: TestFib"},
+	}
+	if err := db.CreateSubmission(tested); err != nil {
+		t.Fatal(err)
+	}
+	for _, commitID := range []string{"abc123", "def456"} {
+		runData.CommitID = commitID
+		if !RateLimited(zap.NewNop().Sugar(), db, runData) {
+			t.Fatalf("RateLimited(%s) = false, want true with all attempts used", commitID)
+		}
+	}
+	submission, err := db.GetSubmission(&pb.Submission{AssignmentID: lab1.ID, UserID: admin.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if submission.GetID() != tested.GetID() || submission.GetScore() != 80 {
+		t.Errorf("newest submission = %d with score %d, want the tested submission %d with score 80", submission.GetID(), submission.GetScore(), tested.GetID())
+	}
+	wantLog := "Commit not tested: def456: all 1 attempts used; the results are from an earlier commit.\n\n--- PASS: TestFib"
+	if got := submission.GetBuildInfo().GetBuildLog(); got != wantLog {
+		t.Errorf("build log = %q, want %q", got, wantLog)
+	}
+}
+
+func TestReruns(t *testing.T) {
+	newest := &pb.Submission{Reruns: 2}
+	if got := reruns(newest, true); got != 2 {
+		t.Errorf("reruns(rebuild) = %d, want %d", got, 2)
+	}
+	// a push is a new commit, whose re-runs are counted from zero
+	if got := reruns(newest, false); got != 0 {
+		t.Errorf("reruns(push) = %d, want %d", got, 0)
+	}
+	if got := reruns(nil, true); got != 0 {
+		t.Errorf("reruns(no submission) = %d, want %d", got, 0)
+	}
+}
@@ -0,0 +1,69 @@
+package ci
+
+import (
+	"strings"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// allowances for preparing the docker image before the tests can run
+const (
+	imagePullAllowance  = 2 * time.Minute
+	imageBuildAllowance = 10 * time.Minute
+)
+
+// EstimateGradingBudget returns an upper bound on the time needed to grade
+// a submission for the given assignment, allowing the scheduler to reserve
+// capacity. The budget covers the container timeout for each test run,
+// including reruns of retryable tests, and an allowance for preparing
+// the docker image, which is larger if the image must be built.
+func EstimateGradingBudget(a *pb.Assignment) time.Duration {
+	runs := 1
+	if len(a.RetryableTests()) > 0 {
+		runs += int(a.GetRetries())
+	}
+	budget := time.Duration(runs) * assignmentTimeout(a)
+	if needsImageBuild(a) {
+		return budget + imageBuildAllowance
+	}
+	return budget + imagePullAllowance
+}
+
+// assignmentTimeout returns the container timeout for the given assignment.
+func assignmentTimeout(a *pb.Assignment) time.Duration {
+	if t := a.GetContainerTimeout(); t > 0 {
+		return time.Duration(t) * time.Minute
+	}
+	return containerTimeout
+}
+
+// needsImageBuild returns true if the docker image used by the assignment
+// must be built from the assignment's or the course's Dockerfile. Only the images
+// used by the default scripts of the supported languages are assumed to be pulled
+// from a registry; other images are assumed to be built for the course.
+func needsImageBuild(a *pb.Assignment) bool {
+	if a.GetDockerfile() != "" {
+		return true
+	}
+	image := a.GetDockerImage()
+	if image == "" {
+		script := a.GetScriptFile()
+		if script == "" {
+			script = DefaultScript(a.GetLanguage())
+		}
+		firstLine := strings.SplitN(script, "\n", 2)[0]
+		parts := strings.Split(firstLine, "#image/")
+		if len(parts) < 2 {
+			// no image; parsing the script fails before any image is needed
+			return false
+		}
+		image = strings.TrimSpace(parts[1])
+	}
+	for _, l := range languages {
+		if l.image == image {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,69 @@
+package ci
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+func TestEstimateGradingBudget(t *testing.T) {
+	tests := []struct {
+		name       string
+		assignment *pb.Assignment
+		want       time.Duration
+	}{
+		{
+			name:       "default script",
+			assignment: &pb.Assignment{Language: "go"},
+			want:       containerTimeout + imagePullAllowance,
+		},
+		{
+			name:       "default image with custom timeout",
+			assignment: &pb.Assignment{ScriptFile: "#image/python:3\npython -m unittest", ContainerTimeout: 3},
+			want:       3*time.Minute + imagePullAllowance,
+		},
+		{
+			name:       "course image built from Dockerfile",
+			assignment: &pb.Assignment{ScriptFile: "#image/qf101\nstart.sh", ContainerTimeout: 3},
+			want:       3*time.Minute + imageBuildAllowance,
+		},
+		{
+			name:       "assignment image built from its Dockerfile",
+			assignment: &pb.Assignment{Language: "go", Dockerfile: "FROM golang:1.17"},
+			want:       containerTimeout + imageBuildAllowance,
+		},
+		{
+			name:       "assignment image replacing the course image",
+			assignment: &pb.Assignment{ScriptFile: "#image/qf101\nstart.sh", DockerImage: "python:3"},
+			want:       containerTimeout + imagePullAllowance,
+		},
+		{
+			name: "retries without retryable tests",
+			assignment: &pb.Assignment{
+				Language:         "go",
+				ContainerTimeout: 2,
+				Retries:          2,
+				Tests:            []*pb.TestConfig{{TestName: "TestA"}},
+			},
+			want: 2*time.Minute + imagePullAllowance,
+		},
+		{
+			name: "retryable tests with course image",
+			assignment: &pb.Assignment{
+				ScriptFile:       "#image/qf101\nstart.sh",
+				ContainerTimeout: 2,
+				Retries:          2,
+				Tests:            []*pb.TestConfig{{TestName: "TestA", Retryable: true}},
+			},
+			want: 3*2*time.Minute + imageBuildAllowance,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := EstimateGradingBudget(test.assignment); got != test.want {
+				t.Errorf("EstimateGradingBudget() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
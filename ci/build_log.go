@@ -0,0 +1,210 @@
+package ci
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/autograde/quickfeed/kit/score"
+)
+
+// subscriberBuffer is the number of output chunks buffered for each subscriber
+// to a build log; slower subscribers are disconnected rather than slowing the tests.
+const subscriberBuffer = 256
+
+// BuildLogKey identifies the test run of an assignment for a student's or a group's repository.
+type BuildLogKey struct {
+	AssignmentID uint64
+	UserID       uint64
+	GroupID      uint64
+}
+
+// buildLogKey returns the key of the test run for the given run data.
+func buildLogKey(rData *RunData) BuildLogKey {
+	return BuildLogKey{
+		AssignmentID: rData.Assignment.GetID(),
+		UserID:       rData.Repo.GetUserID(),
+		GroupID:      rData.Repo.GetGroupID(),
+	}
+}
+
+// BuildLogs holds the output of the test runs in progress, such that
+// it can be streamed to students and teachers while the tests run.
+type BuildLogs struct {
+	mu      sync.Mutex
+	streams map[BuildLogKey]*buildStream
+}
+
+// NewBuildLogs returns an empty set of build logs.
+func NewBuildLogs() *BuildLogs {
+	return &BuildLogs{streams: make(map[BuildLogKey]*buildStream)}
+}
+
+// start returns a new build log for the test run with the given key,
+// replacing the build log of an earlier test run with the same key.
+func (b *BuildLogs) start(key BuildLogKey) *buildStream {
+	stream := &buildStream{subscribers: make(map[chan string]struct{})}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if old, ok := b.streams[key]; ok {
+		old.close()
+	}
+	b.streams[key] = stream
+	return stream
+}
+
+// finish ends the given build log for the test run with the given key.
+func (b *BuildLogs) finish(key BuildLogKey, stream *buildStream) {
+	stream.close()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.streams[key] == stream {
+		delete(b.streams, key)
+	}
+}
+
+// Subscribe returns the output so far of the test run in progress with the given key,
+// and a channel receiving its later output, which is closed when the test run completes.
+// The returned function must be called when the subscriber is no longer interested.
+// Returns false if no test run with the given key is in progress.
+func (b *BuildLogs) Subscribe(key BuildLogKey) (string, <-chan string, func(), bool) {
+	b.mu.Lock()
+	stream, ok := b.streams[key]
+	b.mu.Unlock()
+	if !ok {
+		return "", nil, nil, false
+	}
+	return stream.subscribe()
+}
+
+// buildStream is the build log of a test run in progress.
+type buildStream struct {
+	mu          sync.Mutex
+	log         strings.Builder
+	subscribers map[chan string]struct{}
+	closed      bool
+}
+
+// Write appends p to the build log, and sends it to the subscribers.
+func (s *buildStream) Write(p []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return len(p), nil
+	}
+	s.log.Write(p)
+	for ch := range s.subscribers {
+		select {
+		case ch <- string(p):
+		default:
+			// the subscriber is too slow; it may subscribe again to get the full log
+			delete(s.subscribers, ch)
+			close(ch)
+		}
+	}
+	return len(p), nil
+}
+
+// subscribe returns the build log so far, and a channel receiving later output.
+func (s *buildStream) subscribe() (string, <-chan string, func(), bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return "", nil, nil, false
+	}
+	ch := make(chan string, subscriberBuffer)
+	s.subscribers[ch] = struct{}{}
+	cancel := func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if _, ok := s.subscribers[ch]; ok {
+			delete(s.subscribers, ch)
+			close(ch)
+		}
+	}
+	return s.log.String(), ch, cancel, true
+}
+
+// close disconnects the subscribers and discards later output.
+func (s *buildStream) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	for ch := range s.subscribers {
+		delete(s.subscribers, ch)
+		close(ch)
+	}
+}
+
+// logFilter is a writer passing the test output on to another writer line by line,
+// as it would appear in the build log: score lines and lines revealing the session
+// secret are dropped, the values of the course secrets are redacted, and the output
+// is truncated at the given limit. Since the output may be followed by students,
+// the output of hidden tests is also dropped. A go test -json event stream is
+// passed on as the output of its events.
+type logFilter struct {
+	w         io.Writer
+	secret    string
+	secrets   map[string]string
+	hidden    *score.TestOutputFilter
+	events    bool // the output is a go test -json event stream
+	remaining int
+	partial   bytes.Buffer
+}
+
+// newLogFilter returns a filter writing at most limit bytes of the test output to w,
+// without the output of the given hidden tests.
+func newLogFilter(w io.Writer, secret string, secrets map[string]string, hiddenTests []string, limit int) *logFilter {
+	return &logFilter{w: w, secret: secret, secrets: secrets, hidden: score.NewTestOutputFilter(hiddenTests), remaining: limit}
+}
+
+// Write passes the complete lines of p, and any earlier partial line, on to the underlying writer.
+func (f *logFilter) Write(p []byte) (int, error) {
+	f.partial.Write(p)
+	for {
+		i := bytes.IndexByte(f.partial.Bytes(), '\n')
+		if i < 0 {
+			return len(p), nil
+		}
+		line := string(f.partial.Next(i + 1))
+		f.writeLine(strings.TrimSuffix(line, "\n"))
+	}
+}
+
+// flush passes on the final line of the output, if it does not end with a newline.
+func (f *logFilter) flush() {
+	if f.partial.Len() > 0 {
+		f.writeLine(f.partial.String())
+		f.partial.Reset()
+	}
+}
+
+// writeLine passes the given line on to the underlying writer, unless filtered.
+func (f *logFilter) writeLine(line string) {
+	if f.events {
+		if out, ok := score.EventOutput(line); ok {
+			if out == "" {
+				return
+			}
+			line = out
+		}
+	}
+	line, _, done := unmarkAnalysisLine(line, f.secret)
+	if done {
+		return
+	}
+	if f.remaining <= 0 || score.HasPrefix(line) || strings.Contains(line, score.ScoreLineMarker) ||
+		(f.secret != "" && strings.Contains(line, f.secret)) || !f.hidden.Keep(line) {
+		return
+	}
+	line = redactSecrets(line, f.secrets) + "\n"
+	if len(line) > f.remaining {
+		line = truncateMsg
+		f.remaining = 0
+	} else {
+		f.remaining -= len(line)
+	}
+	// failing to stream the output does not affect the test run
+	_, _ = io.WriteString(f.w, line)
+}
@@ -0,0 +1,128 @@
+package ci
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLogFilter(t *testing.T) {
+	var out strings.Builder
+	f := newLogFilter(&out, "session-secret", map[string]string{"API_KEY": "s3cr3t"}, nil, 1000)
+	writes := []string{
+		"=== RUN   TestFib\n--- PASS: Test",
+		"Fib (0.00s)\n",
+		`{"Secret":"session-secret","TestName":"TestFib","Score":10,"MaxScore":10,"Weight":1}` + "\n",
+		"env: QUICKFEED_SESSION_SECRET=session-secret\n",
+		"using key s3cr3t\n",
+		"session-secret/vet: fib.go:3:1: unreachable code\n",
+		"session-secret/vet.\n",
+		"PASS",
+	}
+	for _, w := range writes {
+		if _, err := f.Write([]byte(w)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want := "=== RUN   TestFib\n--- PASS: TestFib (0.00s)\nusing key [REDACTED]\nfib.go:3:1: unreachable code\n"
+	if got := out.String(); got != want {
+		t.Errorf("logFilter output = %q, want %q", got, want)
+	}
+	f.flush()
+	if got := out.String(); got != want+"PASS\n" {
+		t.Errorf("logFilter output after flush = %q, want %q", got, want+"PASS\n")
+	}
+}
+
+func TestLogFilterLimit(t *testing.T) {
+	var out strings.Builder
+	f := newLogFilter(&out, "", nil, nil, 20)
+	f.Write([]byte(strings.Repeat("0123456789\n", 5)))
+	want := "0123456789\n" + truncateMsg
+	if got := out.String(); got != want {
+		t.Errorf("logFilter output = %q, want %q", got, want)
+	}
+}
+
+func TestLogFilterHiddenTests(t *testing.T) {
+	var out strings.Builder
+	f := newLogFilter(&out, "", nil, []string{"TestHidden"}, 1000)
+	f.Write([]byte("=== RUN   TestFib\n--- PASS: TestFib (0.00s)\n=== RUN   TestHidden\n    hidden_test.go:12: got 5, want 8\n" +
+		"--- FAIL: TestHidden (0.00s)\n=== RUN   TestSum\n--- PASS: TestSum (0.00s)\nFAIL\n"))
+	want := "=== RUN   TestFib\n--- PASS: TestFib (0.00s)\n=== RUN   TestSum\n--- PASS: TestSum (0.00s)\nFAIL\n"
+	if got := out.String(); got != want {
+		t.Errorf("logFilter output = %q, want %q", got, want)
+	}
+}
+
+func TestLogFilterEvents(t *testing.T) {
+	var out strings.Builder
+	f := newLogFilter(&out, "session-secret", nil, []string{"TestHidden"}, 1000)
+	f.events = true
+	f.Write([]byte(`# lab1 [lab1.test]
+{"Action":"run","Package":"lab1","Test":"TestFib"}
+{"Action":"output","Package":"lab1","Test":"TestFib","Output":"=== RUN   TestFib\n"}
+{"Action":"output","Package":"lab1","Test":"TestFib","Output":"{\"Secret\":\"session-secret\",\"TestName\":\"TestFib\",\"Score\":1,\"MaxScore\":1,\"Weight\":1}\n"}
+{"Action":"output","Package":"lab1","Test":"TestFib","Output":"--- PASS: TestFib (0.00s)\n"}
+{"Action":"pass","Package":"lab1","Test":"TestFib","Elapsed":0}
+{"Action":"output","Package":"lab1","Test":"TestHidden","Output":"=== RUN   TestHidden\n"}
+{"Action":"output","Package":"lab1","Test":"TestHidden","Output":"    hidden_test.go:12: got 5, want 8\n"}
+{"Action":"output","Package":"lab1","Output":"PASS\n"}
+`))
+	want := "# lab1 [lab1.test]\n=== RUN   TestFib\n--- PASS: TestFib (0.00s)\nPASS\n"
+	if got := out.String(); got != want {
+		t.Errorf("logFilter output = %q, want %q", got, want)
+	}
+}
+
+func TestBuildLogs(t *testing.T) {
+	logs := NewBuildLogs()
+	key := BuildLogKey{AssignmentID: 1, UserID: 2}
+	if _, _, _, ok := logs.Subscribe(key); ok {
+		t.Fatal("Subscribe() succeeded without test run in progress")
+	}
+
+	stream := logs.start(key)
+	stream.Write([]byte("=== RUN   TestFib\n"))
+	log, updates, cancel, ok := logs.Subscribe(key)
+	if !ok {
+		t.Fatal("Subscribe() failed with test run in progress")
+	}
+	defer cancel()
+	if log != "=== RUN   TestFib\n" {
+		t.Errorf("Subscribe() log = %q, want output so far", log)
+	}
+	if _, _, _, ok := logs.Subscribe(BuildLogKey{AssignmentID: 1, GroupID: 2}); ok {
+		t.Error("Subscribe() succeeded for other test run")
+	}
+
+	stream.Write([]byte("--- PASS: TestFib (0.00s)\n"))
+	if got := <-updates; got != "--- PASS: TestFib (0.00s)\n" {
+		t.Errorf("update = %q, want %q", got, "--- PASS: TestFib (0.00s)\n")
+	}
+	logs.finish(key, stream)
+	if _, ok := <-updates; ok {
+		t.Error("updates not closed when test run completed")
+	}
+	if _, _, _, ok := logs.Subscribe(key); ok {
+		t.Error("Subscribe() succeeded after test run completed")
+	}
+}
+
+func TestBuildLogsSlowSubscriber(t *testing.T) {
+	logs := NewBuildLogs()
+	key := BuildLogKey{AssignmentID: 1, UserID: 2}
+	stream := logs.start(key)
+	defer logs.finish(key, stream)
+	_, updates, cancel, _ := logs.Subscribe(key)
+	defer cancel()
+	for i := 0; i <= subscriberBuffer; i++ {
+		stream.Write([]byte("line\n"))
+	}
+	received := 0
+	for range updates {
+		received++
+	}
+	if received != subscriberBuffer {
+		t.Errorf("slow subscriber received %d updates before being disconnected, want %d", received, subscriberBuffer)
+	}
+}
@@ -2,6 +2,7 @@ package ci
 
 import (
 	"context"
+	"io"
 )
 
 // Job describes how to execute a CI job.
@@ -14,6 +15,33 @@ type Job struct {
 	Dockerfile string
 	// Commands is a list of shell commands to run as part of the job.
 	Commands []string
+	// Verbose, if true, captures the job's standard error in addition to standard output.
+	Verbose bool
+	// OutputLimit is the maximum size in bytes of the job's output to keep;
+	// zero for the default limit.
+	OutputLimit int
+	// Env is a list of environment variables, in the form VARIABLE=value,
+	// set for the job's commands in addition to those of the image.
+	Env []string
+	// Limits are the resources available to the job's container.
+	Limits ResourceLimits
+	// Network is the network policy of the job's container, one of the network policies
+	// of package ag, or empty for docker's default network.
+	Network string
+	// AllowedHosts are the hosts that the job's container may access under the limited network policy.
+	AllowedHosts []string
+	// Output, if not nil, receives the job's output while the job runs,
+	// in addition to the output returned when the job completes.
+	Output io.Writer
+	// Artifacts, if not nil, is given a tar archive of the ArtifactsDir folder
+	// of the job's container when the job completes.
+	Artifacts func(archive io.Reader)
+	// CoverageProfile is the path in the job's container of the coverage profile written
+	// by the tests, outside the ArtifactsDir folder; empty if coverage is not collected.
+	CoverageProfile string
+	// Coverage, if not nil, is given the contents of the job's coverage profile when the
+	// job completes, or nil if the tests did not write the profile.
+	Coverage func(profile []byte)
 }
 
 // Runner contains methods for running user provided code in isolation.
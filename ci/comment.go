@@ -0,0 +1,109 @@
+package ci
+
+import (
+	"fmt"
+	"strings"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/kit/score"
+)
+
+const (
+	passEmoji = "✅"
+	failEmoji = "❌"
+	// maxCommentLogLines is the number of trailing build log lines shown in a comment for a failed build.
+	maxCommentLogLines = 20
+)
+
+// MarkdownComment returns a Markdown summary of the results of running the given
+// assignment's tests, suitable for posting as a pull request comment. The summary
+// contains a table of the tests with their pass/fail status, the computed grade,
+// the build status, and the execution time. If the assignment hides points from
+// students, only the pass/fail status of each test is shown. The assignment's
+// hidden tests and their output are omitted, since comments are posted when the
+// tests are run, typically before the deadline. If the build failed,
+// or no tests were run, the summary contains the tail of the build log instead.
+func MarkdownComment(r *score.Results, a *pb.Assignment) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "## Test results for %s\n\n", a.GetName())
+	if buildFailed(r) {
+		b.WriteString("**Build:** " + failEmoji + " failed")
+		writeExecTime(&b, r)
+		b.WriteString("\n")
+		if len(r.Errors) > 0 {
+			b.WriteString("\n")
+			for _, err := range r.Errors {
+				fmt.Fprintf(&b, "- %v\n", err)
+			}
+		}
+		buildLog := score.WithoutTestOutput(r.BuildInfo.GetBuildLog(), a.HiddenTests())
+		if log := tailLines(buildLog, maxCommentLogLines); log != "" {
+			fmt.Fprintf(&b, "\n```\n%s\n```\n", log)
+		}
+		return b.String()
+	}
+
+	r = r.WithoutTests(a.HiddenTests())
+	hidePoints := a.GetHidePoints()
+	if hidePoints {
+		b.WriteString("| Test | Status |\n|------|--------|\n")
+	} else {
+		b.WriteString("| Test | Status | Score | Weight |\n|------|--------|-------|--------|\n")
+	}
+	for _, sc := range r.Scores {
+		status := failEmoji
+		if a.IsPassing(sc) {
+			status = passEmoji
+		}
+		if hidePoints {
+			fmt.Fprintf(&b, "| %s | %s |\n", sc.GetTestName(), status)
+		} else {
+			fmt.Fprintf(&b, "| %s | %s | %d/%d | %d |\n", sc.GetTestName(), status, sc.GetScore(), sc.GetMaxScore(), sc.GetWeight())
+		}
+	}
+	b.WriteString("\n")
+	if !hidePoints {
+		fmt.Fprintf(&b, "**Grade:** %d%%", grade(r, a))
+		if limit := a.GetScoreLimit(); limit > 0 {
+			fmt.Fprintf(&b, " (required: %d%%)", limit)
+		}
+		b.WriteString("\n\n")
+	}
+	b.WriteString("**Build:** " + passEmoji + " succeeded")
+	writeExecTime(&b, r)
+	b.WriteString("\n")
+	return b.String()
+}
+
+// buildFailed returns true if the results contain no scores,
+// which happens when the tests failed to compile or run.
+func buildFailed(r *score.Results) bool {
+	return len(r.Scores) == 0
+}
+
+// grade returns the score of the results, including extra credit if the assignment has any.
+func grade(r *score.Results, a *pb.Assignment) uint32 {
+	if extraCredit := a.ExtraCreditTests(); len(extraCredit) > 0 {
+		return r.SumWithExtraCredit(extraCredit, a.GetExtraCreditCap())
+	}
+	return r.Sum()
+}
+
+func writeExecTime(b *strings.Builder, r *score.Results) {
+	if r.BuildInfo.GetExecTime() > 0 {
+		fmt.Fprintf(b, " in %v", r.ExecTime())
+	}
+}
+
+// tailLines returns the last n lines of the given log.
+func tailLines(log string, n int) string {
+	log = strings.TrimSpace(log)
+	if log == "" {
+		return ""
+	}
+	lines := strings.Split(log, "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,112 @@
+package ci
+
+import (
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/kit/score"
+	"github.com/google/go-cmp/cmp"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func TestMarkdownComment(t *testing.T) {
+	assignment := &pb.Assignment{Name: "lab1", ScoreLimit: 80}
+	buildInfo := &score.BuildInfo{
+		BuildDate: "2021-09-15T10:11:12",
+		BuildLog:  "running tests\nall done",
+		ExecTime:  (1500 * time.Millisecond).Milliseconds(),
+	}
+	tests := []struct {
+		golden     string
+		assignment *pb.Assignment
+		results    *score.Results
+	}{
+		{
+			golden:     "comment_pass",
+			assignment: assignment,
+			results: &score.Results{
+				BuildInfo: buildInfo,
+				Scores: []*score.Score{
+					{TestName: "TestFib", Score: 10, MaxScore: 10, Weight: 1},
+					{TestName: "TestSum", Score: 5, MaxScore: 5, Weight: 1},
+				},
+			},
+		},
+		{
+			golden:     "comment_fail",
+			assignment: assignment,
+			results: &score.Results{
+				BuildInfo: buildInfo,
+				Scores: []*score.Score{
+					{TestName: "TestFib", Score: 10, MaxScore: 10, Weight: 1},
+					{TestName: "TestSum", Score: 2, MaxScore: 5, Weight: 1},
+				},
+			},
+		},
+		{
+			golden:     "comment_fail_hidden_points",
+			assignment: &pb.Assignment{Name: "lab1", ScoreLimit: 80, HidePoints: true},
+			results: &score.Results{
+				BuildInfo: buildInfo,
+				Scores: []*score.Score{
+					{TestName: "TestFib", Score: 10, MaxScore: 10, Weight: 1},
+					{TestName: "TestSum", Score: 2, MaxScore: 5, Weight: 1},
+				},
+			},
+		},
+		{
+			golden: "comment_hidden_tests",
+			assignment: &pb.Assignment{Name: "lab1", ScoreLimit: 80, Tests: []*pb.TestConfig{
+				{TestName: "TestSum", Hidden: true},
+			}},
+			results: &score.Results{
+				BuildInfo: buildInfo,
+				Scores: []*score.Score{
+					{TestName: "TestFib", Score: 10, MaxScore: 10, Weight: 1},
+					{TestName: "TestSum", Score: 2, MaxScore: 5, Weight: 1},
+				},
+			},
+		},
+		{
+			golden:     "comment_build_error",
+			assignment: assignment,
+			results: &score.Results{
+				BuildInfo: &score.BuildInfo{
+					BuildDate: "2021-09-15T10:11:12",
+					BuildLog:  "# lab1\n./fib.go:7:2: undefined: fibonacci",
+					ExecTime:  (800 * time.Millisecond).Milliseconds(),
+				},
+				Errors: []error{errors.New("exit status 2")},
+			},
+		},
+		{
+			golden:     "comment_no_build_info",
+			assignment: assignment,
+			results:    &score.Results{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.golden, func(t *testing.T) {
+			got := MarkdownComment(test.results, test.assignment)
+			goldenFile := filepath.Join("testdata", test.golden+".md")
+			if *update {
+				if err := os.WriteFile(goldenFile, []byte(got), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(goldenFile)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(string(want), got); diff != "" {
+				t.Errorf("MarkdownComment() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+package ci
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"path"
+	"strconv"
+	"strings"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/kit/score"
+)
+
+// DefaultCoverageProfile is the name of the coverage profile written by the tests,
+// unless the assignment names another file.
+const DefaultCoverageProfile = "coverage.out"
+
+// CoverageTestName is the test name of the score entry for the coverage of an assignment's tests.
+const CoverageTestName = "Coverage"
+
+// coverageProfile returns the path in the test container of the assignment's coverage
+// profile, or the empty string if coverage is not collected. The profile is written
+// outside the artifacts folder, which the student's code may write to, to a path
+// named by the test run's secret, and is read by the runner when the tests complete.
+func coverageProfile(assignment *pb.Assignment, secret string) string {
+	if assignment.GetCoverageProfile() == "" {
+		return ""
+	}
+	return path.Join("/tmp", "quickfeed-"+secret+"-"+path.Base(assignment.GetCoverageProfile()))
+}
+
+// readCoverageProfile returns the contents of the coverage profile read from r,
+// or nil if the profile cannot be read. At most maxArtifactsSize bytes are read.
+func readCoverageProfile(r io.Reader) []byte {
+	profile, err := ioutil.ReadAll(io.LimitReader(r, maxArtifactsSize))
+	if err != nil {
+		return nil
+	}
+	return profile
+}
+
+// readCoverageArchive returns the contents of the coverage profile in the given tar archive,
+// as copied from a container, or nil if the archive holds no regular file.
+func readCoverageArchive(archive io.Reader) []byte {
+	tr := tar.NewReader(archive)
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			return nil
+		}
+		if hdr.FileInfo().Mode().IsRegular() {
+			return readCoverageProfile(tr)
+		}
+	}
+}
+
+// measureCoverage returns the coverage percentage reported by the given contents of the
+// assignment's coverage profile. Returns zero if coverage is not collected, and an error
+// if the profile is missing or cannot be parsed.
+func measureCoverage(assignment *pb.Assignment, profile []byte) (float64, error) {
+	name := assignment.GetCoverageProfile()
+	if name == "" {
+		return 0, nil
+	}
+	if profile == nil {
+		return 0, fmt.Errorf("coverage profile %s not found", name)
+	}
+	percent, err := parseCoverage(profile)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse coverage profile %s: %w", name, err)
+	}
+	return percent, nil
+}
+
+// withCoverageArtifact returns the given artifacts with the assignment's coverage profile,
+// replacing an artifact of the same name written by the tests.
+func withCoverageArtifact(assignment *pb.Assignment, artifacts []*pb.Artifact, profile []byte) []*pb.Artifact {
+	name := assignment.GetCoverageProfile()
+	if name == "" || profile == nil {
+		return artifacts
+	}
+	kept := make([]*pb.Artifact, 0, len(artifacts)+1)
+	for _, artifact := range artifacts {
+		if artifact.GetName() != name {
+			kept = append(kept, artifact)
+		}
+	}
+	return append(kept, &pb.Artifact{Name: name, Size: uint64(len(profile)), Content: profile})
+}
+
+// parseCoverage returns the coverage percentage of the given coverage profile,
+// which is either a Go coverage profile or an LCOV trace file.
+func parseCoverage(profile []byte) (float64, error) {
+	if bytes.HasPrefix(profile, []byte("mode:")) {
+		return parseGoCoverage(profile)
+	}
+	return parseLCOV(profile)
+}
+
+// parseGoCoverage returns the percentage of statements covered according to the given
+// Go coverage profile, as reported by go test -cover. Blocks that occur more than once,
+// e.g., in concatenated profiles, are covered if covered by any occurrence.
+func parseGoCoverage(profile []byte) (float64, error) {
+	type block struct {
+		statements int
+		covered    bool
+	}
+	blocks := make(map[string]*block)
+	scanner := bufio.NewScanner(bytes.NewReader(profile))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "mode:") {
+			continue
+		}
+		// file.go:startLine.startCol,endLine.endCol numStatements count
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			return 0, fmt.Errorf("invalid line %q", line)
+		}
+		statements, err := strconv.Atoi(fields[1])
+		if err != nil {
+			return 0, fmt.Errorf("invalid number of statements in line %q", line)
+		}
+		count, err := strconv.Atoi(fields[2])
+		if err != nil {
+			return 0, fmt.Errorf("invalid count in line %q", line)
+		}
+		b, ok := blocks[fields[0]]
+		if !ok {
+			b = &block{statements: statements}
+			blocks[fields[0]] = b
+		}
+		b.covered = b.covered || count > 0
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+	var total, covered int
+	for _, b := range blocks {
+		total += b.statements
+		if b.covered {
+			covered += b.statements
+		}
+	}
+	return percentage(covered, total)
+}
+
+// parseLCOV returns the percentage of lines covered according to the given LCOV trace file.
+func parseLCOV(profile []byte) (float64, error) {
+	var total, covered int
+	scanner := bufio.NewScanner(bytes.NewReader(profile))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		var n *int
+		switch {
+		case strings.HasPrefix(line, "LF:"):
+			n = &total
+		case strings.HasPrefix(line, "LH:"):
+			n = &covered
+		default:
+			continue
+		}
+		lines, err := strconv.Atoi(line[3:])
+		if err != nil {
+			return 0, fmt.Errorf("invalid line %q", line)
+		}
+		*n += lines
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+	return percentage(covered, total)
+}
+
+// percentage returns covered as a percentage of total, rounded to one decimal.
+func percentage(covered, total int) (float64, error) {
+	if total == 0 {
+		return 0, errors.New("no statements or lines to cover")
+	}
+	return math.Round(float64(covered)*1000/float64(total)) / 10, nil
+}
+
+// addCoverageScore adds a score entry for the given coverage percentage to the results,
+// if the assignment gives weight to coverage. The full score is obtained by reaching
+// the assignment's coverage target, or 100% coverage if the assignment has no target.
+func addCoverageScore(assignment *pb.Assignment, results *score.Results, percent float64) {
+	if assignment.GetCoverageProfile() == "" || assignment.GetCoverageWeight() == 0 {
+		return
+	}
+	target := float64(assignment.GetCoverageTarget())
+	if target == 0 {
+		target = 100
+	}
+	results.Scores = append(results.Scores, &score.Score{
+		TestName: CoverageTestName,
+		Score:    int32(math.Round(math.Min(percent/target, 1) * 100)),
+		MaxScore: 100,
+		Weight:   int32(assignment.GetCoverageWeight()),
+	})
+}
@@ -0,0 +1,166 @@
+package ci
+
+import (
+	"strings"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/kit/score"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+const goProfile = `mode: set
+example.com/lab1/fib.go:3.20,4.12 1 1
+example.com/lab1/fib.go:4.12,6.3 2 1
+example.com/lab1/fib.go:7.2,7.30 1 0
+mode: set
+example.com/lab1/fib.go:7.2,7.30 1 1
+example.com/lab1/sort.go:3.20,9.2 6 0
+`
+
+const lcovProfile = `TN:
+SF:/quickfeed/assignments/lab1/fib.py
+DA:1,1
+DA:2,0
+LF:4
+LH:3
+end_of_record
+SF:/quickfeed/assignments/lab1/sort.py
+LF:6
+LH:2
+end_of_record
+`
+
+func TestParseCoverage(t *testing.T) {
+	tests := []struct {
+		name    string
+		profile string
+		want    float64
+		wantErr bool
+	}{
+		// statements 4 of 10 are covered; the block of line 7 is covered by its second occurrence
+		{name: "go", profile: goProfile, want: 40},
+		{name: "lcov", profile: lcovProfile, want: 50},
+		{name: "lcov/rounded", profile: "LF:3\nLH:2\n", want: 66.7},
+		{name: "go/empty", profile: "mode: set\n", wantErr: true},
+		{name: "go/invalid", profile: "mode: set\nfib.go:3.20,4.12 one 1\n", wantErr: true},
+		{name: "lcov/invalid", profile: "LF:x\n", wantErr: true},
+		{name: "unknown", profile: "<coverage/>", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseCoverage([]byte(tt.profile))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseCoverage() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("parseCoverage() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMeasureCoverage(t *testing.T) {
+	if got, err := measureCoverage(&pb.Assignment{}, []byte(goProfile)); err != nil || got != 0 {
+		t.Errorf("measureCoverage() without profile = %v, %v, want 0, <nil>", got, err)
+	}
+	if got, err := measureCoverage(&pb.Assignment{CoverageProfile: "cover/coverage.out"}, []byte(goProfile)); err != nil || got != 40 {
+		t.Errorf("measureCoverage() = %v, %v, want 40, <nil>", got, err)
+	}
+	if _, err := measureCoverage(&pb.Assignment{CoverageProfile: "coverage.out"}, nil); err == nil {
+		t.Error("measureCoverage() with missing profile succeeded, want error")
+	}
+	if _, err := measureCoverage(&pb.Assignment{CoverageProfile: "plot.png"}, []byte("PNG")); err == nil {
+		t.Error("measureCoverage() with invalid profile succeeded, want error")
+	}
+}
+
+func TestWithCoverageArtifact(t *testing.T) {
+	artifacts := []*pb.Artifact{
+		{Name: "plot.png", Size: 3, Content: []byte("PNG")},
+		{Name: "coverage.out", Size: 6, Content: []byte("forged")},
+	}
+	assignment := &pb.Assignment{CoverageProfile: "coverage.out"}
+	want := []*pb.Artifact{
+		{Name: "plot.png", Size: 3, Content: []byte("PNG")},
+		{Name: "coverage.out", Size: uint64(len(goProfile)), Content: []byte(goProfile)},
+	}
+	if diff := cmp.Diff(want, withCoverageArtifact(assignment, artifacts, []byte(goProfile)), protocmp.Transform()); diff != "" {
+		t.Errorf("withCoverageArtifact() mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(artifacts, withCoverageArtifact(assignment, artifacts, nil), protocmp.Transform()); diff != "" {
+		t.Errorf("withCoverageArtifact() without profile mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestAddCoverageScore(t *testing.T) {
+	tests := []struct {
+		name       string
+		assignment *pb.Assignment
+		percent    float64
+		want       *score.Score // nil if no score entry is added
+	}{
+		{name: "no coverage", assignment: &pb.Assignment{CoverageWeight: 10}, percent: 50},
+		{name: "no weight", assignment: &pb.Assignment{CoverageProfile: "coverage.out"}, percent: 50},
+		{
+			name:       "no target",
+			assignment: &pb.Assignment{CoverageProfile: "coverage.out", CoverageWeight: 10},
+			percent:    66.7,
+			want:       &score.Score{TestName: CoverageTestName, Score: 67, MaxScore: 100, Weight: 10},
+		},
+		{
+			name:       "below target",
+			assignment: &pb.Assignment{CoverageProfile: "coverage.out", CoverageWeight: 5, CoverageTarget: 80},
+			percent:    40,
+			want:       &score.Score{TestName: CoverageTestName, Score: 50, MaxScore: 100, Weight: 5},
+		},
+		{
+			name:       "above target",
+			assignment: &pb.Assignment{CoverageProfile: "coverage.out", CoverageWeight: 5, CoverageTarget: 80},
+			percent:    95,
+			want:       &score.Score{TestName: CoverageTestName, Score: 100, MaxScore: 100, Weight: 5},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := &score.Results{Scores: []*score.Score{{TestName: "TestFib", Score: 1, MaxScore: 1, Weight: 1}}}
+			addCoverageScore(tt.assignment, results, tt.percent)
+			if tt.want == nil {
+				if len(results.Scores) != 1 {
+					t.Errorf("addCoverageScore() added %v, want no score entry", results.Scores[1:])
+				}
+				return
+			}
+			if len(results.Scores) != 2 {
+				t.Fatalf("addCoverageScore() = %d score entries, want 2", len(results.Scores))
+			}
+			got := results.Scores[1]
+			if got.TestName != tt.want.TestName || got.Score != tt.want.Score || got.MaxScore != tt.want.MaxScore || got.Weight != tt.want.Weight {
+				t.Errorf("addCoverageScore() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDefaultScriptCoverage(t *testing.T) {
+	assignment := &pb.Assignment{Name: "lab1", Language: "go"}
+	info := newAssignmentInfo(&pb.Course{}, assignment, "cloneURL", "testURL")
+	j, err := parseScriptTemplate(info)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if script := strings.Join(j.Commands, "\n"); strings.Contains(script, "-coverprofile") {
+		t.Errorf("script collects coverage without a coverage profile:\n%s", script)
+	}
+	assignment.CoverageProfile = DefaultCoverageProfile
+	info = newAssignmentInfo(&pb.Course{}, assignment, "cloneURL", "testURL")
+	j, err = parseScriptTemplate(info)
+	if err != nil {
+		t.Fatal(err)
+	}
+	profile := "/tmp/quickfeed-" + info.RandomSecret + "-coverage.out"
+	if script := strings.Join(j.Commands, "\n"); !strings.Contains(script, "-coverprofile="+profile+" ") {
+		t.Errorf("script does not write coverage profile to %s:\n%s", profile, script)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"io"
 	"io/ioutil"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/docker/docker/api/types"
@@ -31,6 +32,11 @@ var (
 type Docker struct {
 	client *client.Client
 	logger *zap.SugaredLogger
+	images imageCache
+
+	networkMu sync.Mutex
+	gateway   string          // gateway address of the internal network; empty until needed
+	proxy     *allowlistProxy // proxy for jobs under the limited network policy; nil until needed
 }
 
 // NewDockerCI returns a runner to run CI tests.
@@ -50,6 +56,11 @@ func (d *Docker) Close() error {
 	if d.logger != nil {
 		d.logger.Sync()
 	}
+	d.networkMu.Lock()
+	if d.proxy != nil {
+		d.proxy.Close()
+	}
+	d.networkMu.Unlock()
 	return d.client.Close()
 }
 
@@ -60,7 +71,13 @@ func (d *Docker) Run(ctx context.Context, job *Job) (string, error) {
 		return "", fmt.Errorf("cannot run job: %s; docker client not initialized", job.Name)
 	}
 
-	resp, err := d.createImage(ctx, job)
+	networkEnv, release, err := d.networkEnv(ctx, job)
+	if err != nil {
+		return "", err
+	}
+	defer release()
+
+	resp, err := d.createImage(ctx, job, networkEnv)
 	if err != nil {
 		return "", err
 	}
@@ -69,14 +86,28 @@ func (d *Docker) Run(ctx context.Context, job *Job) (string, error) {
 		return "", err
 	}
 
+	if job.Output != nil {
+		streamed := d.streamLogs(ctx, job, resp.ID)
+		// the container's output ends when it stops, also when stopped due to a timeout
+		defer func() { <-streamed }()
+	}
+
 	msg, err := d.waitForContainer(ctx, job, resp.ID)
 	if err != nil {
 		return msg, err
 	}
+	oomKilled := d.oomKilled(ctx, job, resp.ID)
+	if job.Artifacts != nil {
+		d.copyArtifacts(ctx, job, resp.ID)
+	}
+	if job.Coverage != nil {
+		d.copyCoverage(ctx, job, resp.ID)
+	}
 
 	// extract the logs before removing the container below
 	logReader, err := d.client.ContainerLogs(ctx, resp.ID, types.ContainerLogsOptions{
 		ShowStdout: true,
+		ShowStderr: job.Verbose,
 	})
 	if err != nil {
 		return "", err
@@ -89,22 +120,103 @@ func (d *Docker) Run(ctx context.Context, job *Job) (string, error) {
 	}
 
 	var stdout bytes.Buffer
-	if _, err := stdcopy.StdCopy(&stdout, ioutil.Discard, logReader); err != nil {
+	var stderr io.Writer = ioutil.Discard
+	if job.Verbose {
+		// interleave standard error with standard output
+		stderr = &stdout
+	}
+	if _, err := stdcopy.StdCopy(&stdout, stderr, logReader); err != nil {
 		return "", err
 	}
-	if stdout.Len() > maxLogSize+lastSegmentSize {
-		return truncateLog(&stdout, maxLogSize, lastSegmentSize, maxToScan), nil
+	out, violation := jobOutput(job, &stdout, oomKilled)
+	if violation != "" {
+		d.logger.Infof("Container image '%s' for %s: %s", job.Image, job.Name, violation)
+	}
+	return out, nil
+}
+
+// streamLogs copies the container's output to the job's output while the container
+// runs, and returns a channel that is closed when the container's output ends.
+func (d *Docker) streamLogs(ctx context.Context, job *Job, respID string) <-chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		logReader, err := d.client.ContainerLogs(ctx, respID, types.ContainerLogsOptions{
+			ShowStdout: true,
+			ShowStderr: job.Verbose,
+			Follow:     true,
+		})
+		if err != nil {
+			d.logger.Errorf("Failed to stream output of container image '%s' for %s: %v", job.Image, job.Name, err)
+			return
+		}
+		defer logReader.Close()
+		var stderr io.Writer = ioutil.Discard
+		if job.Verbose {
+			stderr = job.Output
+		}
+		if _, err := stdcopy.StdCopy(job.Output, stderr, logReader); err != nil && ctx.Err() == nil {
+			d.logger.Errorf("Failed to stream output of container image '%s' for %s: %v", job.Image, job.Name, err)
+		}
+	}()
+	return done
+}
+
+// oomKilled returns true if the stopped container was killed for running out of memory.
+func (d *Docker) oomKilled(ctx context.Context, job *Job, respID string) bool {
+	inspect, err := d.client.ContainerInspect(ctx, respID)
+	if err != nil {
+		d.logger.Errorf("Failed to inspect container image '%s' for %s: %v", job.Image, job.Name, err)
+		return false
 	}
-	return stdout.String(), nil
+	return inspect.State != nil && inspect.State.OOMKilled
 }
 
-// createImage creates an image for the given job.
-func (d *Docker) createImage(ctx context.Context, job *Job) (*container.ContainerCreateCreatedBody, error) {
+// copyArtifacts passes an archive of the artifacts folder of the stopped container to the job.
+func (d *Docker) copyArtifacts(ctx context.Context, job *Job, respID string) {
+	archive, _, err := d.client.CopyFromContainer(ctx, respID, ArtifactsDir)
+	if err != nil {
+		// the tests need not write any artifacts
+		if !client.IsErrNotFound(err) {
+			d.logger.Errorf("Failed to copy artifacts from container image '%s' for %s: %v", job.Image, job.Name, err)
+		}
+		return
+	}
+	defer archive.Close()
+	job.Artifacts(archive)
+}
+
+// copyCoverage passes the coverage profile of the stopped container to the job.
+func (d *Docker) copyCoverage(ctx context.Context, job *Job, respID string) {
+	archive, _, err := d.client.CopyFromContainer(ctx, respID, job.CoverageProfile)
+	if err != nil {
+		// the tests may have failed before writing the profile
+		if !client.IsErrNotFound(err) {
+			d.logger.Errorf("Failed to copy coverage profile from container image '%s' for %s: %v", job.Image, job.Name, err)
+		}
+		job.Coverage(nil)
+		return
+	}
+	defer archive.Close()
+	job.Coverage(readCoverageArchive(archive))
+}
+
+// createImage creates an image for the given job, whose container is
+// given the job's environment variables and the given network variables.
+func (d *Docker) createImage(ctx context.Context, job *Job, networkEnv []string) (*container.ContainerCreateCreatedBody, error) {
+	d.prepareImage(ctx, job)
+	env := append(append([]string{}, job.Env...), networkEnv...)
+	commands := job.Commands
+	if job.Artifacts != nil {
+		// the image's user may lack permission to create the folder, in which case the tests cannot write artifacts
+		commands = append([]string{"mkdir -p " + ArtifactsDir + " 2>/dev/null"}, commands...)
+	}
 	create := func() (container.ContainerCreateCreatedBody, error) {
 		return d.client.ContainerCreate(ctx, &container.Config{
 			Image: job.Image,
-			Cmd:   []string{"/bin/bash", "-c", strings.Join(job.Commands, "\n")},
-		}, nil, nil, nil, job.Name)
+			Cmd:   []string{"/bin/bash", "-c", strings.Join(commands, "\n")},
+			Env:   env,
+		}, hostConfig(job), nil, nil, job.Name)
 	}
 
 	resp, err := create()
@@ -116,6 +228,7 @@ func (d *Docker) createImage(ctx context.Context, job *Job) (*container.Containe
 			if err := d.buildImage(ctx, job.Dockerfile, job.Image); err != nil {
 				return nil, err
 			}
+			d.images.add(job.Image, job.Dockerfile)
 		}
 		resp, err = create()
 		if err != nil {
@@ -125,6 +238,36 @@ func (d *Docker) createImage(ctx context.Context, job *Job) (*container.Containe
 	return &resp, err
 }
 
+// prepareImage rebuilds the job's image if it was built from another version of the job's
+// Dockerfile, such that tests are not run in a stale image. Images that are up to date
+// are recorded, such that later jobs reuse them without inspecting them. Missing images
+// are pulled or built when creating the container.
+func (d *Docker) prepareImage(ctx context.Context, job *Job) {
+	if job.Dockerfile == "" || d.images.isCurrent(job.Image, job.Dockerfile) {
+		return
+	}
+	inspect, _, err := d.client.ImageInspectWithRaw(ctx, job.Image)
+	if err != nil {
+		if !client.IsErrNotFound(err) {
+			d.logger.Errorf("Failed to inspect image '%s' for %s: %v", job.Image, job.Name, err)
+		}
+		return
+	}
+	var labels map[string]string
+	if inspect.Config != nil {
+		labels = inspect.Config.Labels
+	}
+	if isStale(labels, job.Dockerfile) {
+		d.logger.Infof("Rebuilding image '%s' for %s from its changed Dockerfile", job.Image, job.Name)
+		if err := d.buildImage(ctx, job.Dockerfile, job.Image); err != nil {
+			// the stale image is better than none; it is rebuilt on the next assignment update
+			d.logger.Errorf("Failed to rebuild image '%s' for %s: %v", job.Image, job.Name, err)
+			return
+		}
+	}
+	d.images.add(job.Image, job.Dockerfile)
+}
+
 // waitForContainer waits until the container stops or context times out.
 func (d *Docker) waitForContainer(ctx context.Context, job *Job, respID string) (string, error) {
 	statusCh, errCh := d.client.ContainerWait(ctx, respID, container.WaitConditionNotRunning)
@@ -197,6 +340,7 @@ func (d *Docker) buildImage(ctx context.Context, dockerfile string, image string
 		Context:    reader,
 		Dockerfile: "Dockerfile",
 		Tags:       []string{image},
+		Labels:     map[string]string{dockerfileLabel: DockerfileHash(dockerfile)},
 	}
 	res, err := d.client.ImageBuild(ctx, reader, opts)
 	if err != nil {
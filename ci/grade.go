@@ -0,0 +1,98 @@
+package ci
+
+import (
+	"fmt"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/kit/score"
+)
+
+// GradeReport summarizes the grading of a submission's test output.
+type GradeReport struct {
+	// Results holds the score objects extracted from the test output,
+	// including a failed score object for each missing test.
+	Results *score.Results
+	// MissingTests are the names of the assignment's tests that did not report a score.
+	MissingTests []string
+	// SecretLeaked is true if the test output revealed the session secret.
+	SecretLeaked bool
+	// Score is the grade computed from the test results.
+	Score uint32
+	// LatePenalty is the number of points deducted from the score for a late submission.
+	LatePenalty uint32
+	// Grade is the score after deducting the late penalty.
+	Grade uint32
+	// Status is the submission's status, as decided by auto approval.
+	Status pb.Submission_Status
+}
+
+// GradeSubmission grades the given output from running the assignment's tests
+// for a submission made at the given time. The score objects are extracted from
+// the output and validated against the session secret, and graded by GradeResults.
+// An error is returned if the assignment's deadline is malformed.
+func GradeSubmission(output, secret string, a *pb.Assignment, submittedAt time.Time) (*GradeReport, error) {
+	results := extractResults(a, output, secret, 0)
+	leaked := results.SecretLeaked(secret)
+	report, err := GradeResults(results, a, submittedAt)
+	if err != nil {
+		return nil, err
+	}
+	report.SecretLeaked = leaked
+	return report, nil
+}
+
+// GradeResults grades the given results of the assignment's tests for a submission
+// made at the given time. The results are completed as for the test runs of RunTests,
+// adding failed score objects for missing tests and applying the assignment's test
+// points. The grade is computed from the completed results, reduced by the late
+// penalty, and used to decide whether to auto approve the submission, evaluated as
+// a single test run. An error is returned if the assignment's deadline is malformed.
+func GradeResults(results *score.Results, a *pb.Assignment, submittedAt time.Time) (*GradeReport, error) {
+	report := &GradeReport{
+		Results:      results,
+		MissingTests: completeResults(a, results),
+	}
+	report.Score = grade(results, a)
+	penalty, err := a.LatePenaltyPoints(submittedAt, report.Score)
+	if err != nil {
+		return nil, fmt.Errorf("invalid deadline for assignment %s: %w", a.GetName(), err)
+	}
+	report.LatePenalty = penalty
+	report.Grade = report.Score - penalty
+	report.Status = a.ApprovalStatus(nil, &pb.ApprovalRun{
+		Score:   report.Grade,
+		Scores:  results.Scores,
+		BuiltAt: submittedAt,
+		Streak:  1,
+	})
+	return report, nil
+}
+
+// completeResults adds a failed score object to the given results for each of the
+// assignment's tests that did not report a score, and then applies the assignment's
+// test points. Returns the names of the missing tests. If no test reported a score,
+// the tests failed to build or run, and no score objects are added, such that the
+// results are reported as a failed build.
+func completeResults(a *pb.Assignment, results *score.Results) []string {
+	var missing []string
+	if len(results.Scores) > 0 {
+		expected := make([]*score.Score, len(a.GetTests()))
+		for i, test := range a.GetTests() {
+			expected[i] = &score.Score{TestName: test.GetTestName(), MaxScore: 1, Weight: 1}
+		}
+		missing = results.AddMissing(expected)
+	}
+	applyTestPoints(a, results)
+	return missing
+}
+
+// hasScore returns true if the results hold a score object for the given test.
+func hasScore(results *score.Results, testName string) bool {
+	for _, sc := range results.Scores {
+		if sc.GetTestName() == testName {
+			return true
+		}
+	}
+	return false
+}
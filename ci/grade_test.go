@@ -0,0 +1,139 @@
+package ci
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/kit/score"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestGradeSubmission(t *testing.T) {
+	const secret = "59fd5fe1c4f741604c1beeab875b9c789d2a7c73"
+	scoreLine := func(secret, testName string, score, maxScore int) string {
+		return fmt.Sprintf(`{"Secret":%q,"TestName":%q,"Score":%d,"MaxScore":%d,"Weight":1}`, secret, testName, score, maxScore)
+	}
+	deadline := time.Date(2021, 9, 15, 23, 59, 0, 0, time.UTC)
+	assignment := &pb.Assignment{
+		Name:        "lab1",
+		Deadline:    deadline.Format(pb.TimeLayout),
+		AutoApprove: true,
+		ScoreLimit:  80,
+		LatePenalty: 10,
+		Tests: []*pb.TestConfig{
+			{TestName: "TestFibonacci", MaxScore: 20, Weight: 2},
+			{TestName: "TestSum", Weight: 1},
+			{TestName: "TestTriangular", Weight: 1},
+		},
+	}
+	output := strings.Join([]string{
+		"=== RUN   TestFibonacci",
+		scoreLine(secret, "TestFibonacci", 10, 10),
+		"--- PASS: TestFibonacci (0.00s)",
+		"=== RUN   TestSum",
+		scoreLine(secret, "TestSum", 3, 4),
+		"--- FAIL: TestSum (0.00s)",
+		"=== RUN   TestTriangular",
+		scoreLine(secret, "TestTriangular", 5, 5),
+		"--- PASS: TestTriangular (0.00s)",
+		"FAIL",
+		"exit status 1",
+		"FAIL\tdat320/lab1\t0.009s",
+	}, "\n")
+
+	tests := []struct {
+		name        string
+		output      string
+		submittedAt time.Time
+		want        *GradeReport
+	}{
+		{
+			name:        "on time",
+			output:      output,
+			submittedAt: deadline.Add(-time.Hour),
+			// (2*100% + 75% + 100%) / 4 = 93.75%
+			want: &GradeReport{Score: 94, Grade: 94, Status: pb.Submission_APPROVED},
+		},
+		{
+			name:        "two days late",
+			output:      output,
+			submittedAt: deadline.Add(25 * time.Hour),
+			want:        &GradeReport{Score: 94, LatePenalty: 20, Grade: 74, Status: pb.Submission_NONE},
+		},
+		{
+			name:        "missing test",
+			output:      strings.Replace(output, scoreLine(secret, "TestTriangular", 5, 5), "panic: runtime error: index out of range", 1),
+			submittedAt: deadline.Add(-time.Hour),
+			// (2*100% + 75% + 0%) / 4 = 68.75%
+			want: &GradeReport{MissingTests: []string{"TestTriangular"}, Score: 69, Grade: 69, Status: pb.Submission_NONE},
+		},
+		{
+			name:        "forged score",
+			output:      strings.Replace(output, scoreLine(secret, "TestSum", 3, 4), scoreLine("guessed", "TestSum", 4, 4), 1),
+			submittedAt: deadline.Add(-time.Hour),
+			// (2*100% + 0% + 100%) / 4 = 75%
+			want: &GradeReport{MissingTests: []string{"TestSum"}, Score: 75, Grade: 75, Status: pb.Submission_NONE},
+		},
+		{
+			name:        "leaked secret",
+			output:      output + "\nsecret: " + secret,
+			submittedAt: deadline.Add(-time.Hour),
+			want:        &GradeReport{SecretLeaked: true, Score: 94, Grade: 94, Status: pb.Submission_APPROVED},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := GradeSubmission(test.output, secret, assignment, test.submittedAt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got.Results.Scores) != len(assignment.Tests) {
+				t.Errorf("GradeSubmission() got %d scores, want %d", len(got.Results.Scores), len(assignment.Tests))
+			}
+			for _, sc := range got.Results.Scores {
+				if sc.GetSecret() != "" {
+					t.Errorf("GradeSubmission() did not redact the secret of %s", sc.GetTestName())
+				}
+			}
+			got.Results = nil
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("GradeSubmission() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	invalid := &pb.Assignment{Name: "lab2", Deadline: "not a date"}
+	if _, err := GradeSubmission(output, secret, invalid, deadline); err == nil {
+		t.Error("GradeSubmission() succeeded, want error for malformed deadline")
+	}
+}
+
+func TestCompleteResults(t *testing.T) {
+	assignment := &pb.Assignment{
+		Tests: []*pb.TestConfig{
+			{TestName: "TestFibonacci", MaxScore: 20, Weight: 2},
+			{TestName: "TestSum"},
+		},
+	}
+	results := score.NewResults(&score.Score{TestName: "TestFibonacci", Score: 5, MaxScore: 10, Weight: 1})
+	if missing := completeResults(assignment, results); !cmp.Equal(missing, []string{"TestSum"}) {
+		t.Errorf("completeResults() = %v, want [TestSum]", missing)
+	}
+	want := []*score.Score{
+		{TestName: "TestFibonacci", Score: 10, MaxScore: 20, Weight: 2},
+		{TestName: "TestSum", MaxScore: 1, Weight: 1},
+	}
+	if diff := cmp.Diff(want, results.Scores, protocmp.Transform()); diff != "" {
+		t.Errorf("completeResults() mismatch (-want +got):\n%s", diff)
+	}
+
+	// a failed build reports no scores, and remains a failed build
+	failed := score.NewResults()
+	if missing := completeResults(assignment, failed); len(missing) != 0 || len(failed.Scores) != 0 {
+		t.Errorf("completeResults() of failed build = %v with %d scores, want no scores", missing, len(failed.Scores))
+	}
+}
@@ -0,0 +1,59 @@
+package ci
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+)
+
+// dockerfileLabel is the label of images built by QuickFeed, recording
+// the hash of the Dockerfile that the image was built from.
+const dockerfileLabel = "org.quickfeed.dockerfile"
+
+// DockerfileHash returns the hash of the given Dockerfile contents, which is
+// recorded in the label of the images built from the Dockerfile.
+func DockerfileHash(dockerfile string) string {
+	sum := sha256.Sum256([]byte(dockerfile))
+	return hex.EncodeToString(sum[:])
+}
+
+// BuildImageCommand returns the command building the image with the given name from
+// the given Dockerfile, found in the current directory, labeled with the Dockerfile's
+// hash, such that runners can reuse the image until the Dockerfile changes.
+func BuildImageCommand(image, dockerfile string) string {
+	return fmt.Sprintf("docker build --label %s=%s -t %s .", dockerfileLabel, DockerfileHash(dockerfile), image)
+}
+
+// isStale returns true if the image with the given labels was built by QuickFeed
+// from another Dockerfile than the given one. Images not built by QuickFeed, such
+// as images pulled from a registry, are never stale.
+func isStale(labels map[string]string, dockerfile string) bool {
+	hash, ok := labels[dockerfileLabel]
+	return ok && hash != DockerfileHash(dockerfile)
+}
+
+// imageCache records the images known to be up to date with their Dockerfile,
+// such that the image need not be inspected for each test run.
+type imageCache struct {
+	mu     sync.Mutex
+	hashes map[string]string // image name -> hash of the Dockerfile
+}
+
+// isCurrent returns true if the given image is known to be up to date with the given Dockerfile.
+func (c *imageCache) isCurrent(image, dockerfile string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	hash, ok := c.hashes[image]
+	return ok && hash == DockerfileHash(dockerfile)
+}
+
+// add records that the given image is up to date with the given Dockerfile.
+func (c *imageCache) add(image, dockerfile string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.hashes == nil {
+		c.hashes = make(map[string]string)
+	}
+	c.hashes[image] = DockerfileHash(dockerfile)
+}
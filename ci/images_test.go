@@ -0,0 +1,54 @@
+package ci
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBuildImageCommand(t *testing.T) {
+	const dockerfile = "FROM golang:latest\n"
+	got := BuildImageCommand("dat320-lab1", dockerfile)
+	want := "docker build --label org.quickfeed.dockerfile=" + DockerfileHash(dockerfile) + " -t dat320-lab1 ."
+	if got != want {
+		t.Errorf("BuildImageCommand() = %q, want %q", got, want)
+	}
+	if DockerfileHash(dockerfile) == DockerfileHash(strings.ToUpper(dockerfile)) {
+		t.Error("DockerfileHash() of different Dockerfiles are equal")
+	}
+}
+
+func TestIsStale(t *testing.T) {
+	const dockerfile = "FROM golang:latest\n"
+	tests := []struct {
+		name   string
+		labels map[string]string
+		want   bool
+	}{
+		{name: "pulled image", labels: nil, want: false},
+		{name: "other labels", labels: map[string]string{"maintainer": "quickfeed"}, want: false},
+		{name: "same Dockerfile", labels: map[string]string{dockerfileLabel: DockerfileHash(dockerfile)}, want: false},
+		{name: "changed Dockerfile", labels: map[string]string{dockerfileLabel: DockerfileHash("FROM golang:1.16\n")}, want: true},
+	}
+	for _, test := range tests {
+		if got := isStale(test.labels, dockerfile); got != test.want {
+			t.Errorf("isStale(%s) = %t, want %t", test.name, got, test.want)
+		}
+	}
+}
+
+func TestImageCache(t *testing.T) {
+	var cache imageCache
+	if cache.isCurrent("dat320", "FROM golang") {
+		t.Error("isCurrent() = true for empty cache, want false")
+	}
+	cache.add("dat320", "FROM golang")
+	if !cache.isCurrent("dat320", "FROM golang") {
+		t.Error("isCurrent() = false for added image, want true")
+	}
+	if cache.isCurrent("dat320", "FROM python") {
+		t.Error("isCurrent() = true for changed Dockerfile, want false")
+	}
+	if cache.isCurrent("dat520", "FROM golang") {
+		t.Error("isCurrent() = true for other image, want false")
+	}
+}
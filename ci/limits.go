@@ -0,0 +1,76 @@
+package ci
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/docker/docker/api/types/container"
+	units "github.com/docker/go-units"
+)
+
+// MinMemoryLimit is the smallest memory limit accepted by docker.
+const MinMemoryLimit = 6 << 20 // bytes
+
+// limitExceeded starts the messages added to the output of jobs whose container exceeded
+// one of its resource limits; score.BuildInfo.FailureReason recognizes these messages.
+const limitExceeded = "resource limit exceeded"
+
+// ResourceLimits are the resources available to a job's container; zero means no limit.
+type ResourceLimits struct {
+	NanoCPUs int64 // CPU quota in billionths of a CPU
+	Memory   int64 // bytes
+	Pids     int64 // processes and threads
+	Disk     int64 // bytes of writable disk space
+}
+
+// resourceLimits returns the resource limits of the containers running the given assignment's tests.
+func resourceLimits(a *pb.Assignment) ResourceLimits {
+	return ResourceLimits{
+		NanoCPUs: int64(a.GetCpuLimit()) * 1_000_000,
+		Memory:   int64(a.GetMemoryLimit()),
+		Pids:     int64(a.GetPidsLimit()),
+		Disk:     int64(a.GetDiskLimit()),
+	}
+}
+
+// hostConfig returns the host configuration of the given job's container,
+// which enforces the job's resource limits and network policy.
+func hostConfig(job *Job) *container.HostConfig {
+	limits := job.Limits
+	hc := &container.HostConfig{NetworkMode: networkMode(job.Network)}
+	hc.NanoCPUs = limits.NanoCPUs
+	if limits.Memory > 0 {
+		hc.Memory = limits.Memory
+		// disallow swap, such that the memory limit cannot be evaded
+		hc.MemorySwap = limits.Memory
+	}
+	if limits.Pids > 0 {
+		hc.PidsLimit = &limits.Pids
+	}
+	if limits.Disk > 0 {
+		// requires a storage driver supporting quotas, e.g., overlay2 on xfs with pquota
+		hc.StorageOpt = map[string]string{"size": strconv.FormatInt(limits.Disk, 10)}
+	}
+	return hc
+}
+
+// limitViolation returns a message describing the resource limit exceeded by the
+// container of a job with the given limits, output and OOM kill status, or the empty
+// string if no limit was exceeded. Since processes hitting the limits on processes
+// or disk space are not killed, these violations are recognized from the errors
+// reported in the output.
+func limitViolation(limits ResourceLimits, out string, oomKilled bool) string {
+	switch {
+	case oomKilled && limits.Memory > 0:
+		return fmt.Sprintf("Container killed: %s: memory limit of %s", limitExceeded, units.BytesSize(float64(limits.Memory)))
+	case oomKilled:
+		return fmt.Sprintf("Container killed: %s: out of memory", limitExceeded)
+	case limits.Pids > 0 && strings.Contains(out, "Resource temporarily unavailable"):
+		return fmt.Sprintf("Tests failed: %s: process limit of %d", limitExceeded, limits.Pids)
+	case limits.Disk > 0 && (strings.Contains(out, "no space left on device") || strings.Contains(out, "disk quota exceeded")):
+		return fmt.Sprintf("Tests failed: %s: disk limit of %s", limitExceeded, units.BytesSize(float64(limits.Disk)))
+	}
+	return ""
+}
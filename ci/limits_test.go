@@ -0,0 +1,70 @@
+package ci
+
+import (
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/docker/docker/api/types/container"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestHostConfig(t *testing.T) {
+	assignment := &pb.Assignment{CpuLimit: 1500, MemoryLimit: 64 << 20, PidsLimit: 100, DiskLimit: 1 << 30}
+	hc := hostConfig(&Job{Limits: resourceLimits(assignment)})
+	if hc.NanoCPUs != 1_500_000_000 {
+		t.Errorf("NanoCPUs = %d, want 1500000000", hc.NanoCPUs)
+	}
+	if hc.Memory != 64<<20 || hc.MemorySwap != 64<<20 {
+		t.Errorf("Memory, MemorySwap = %d, %d, want %d", hc.Memory, hc.MemorySwap, 64<<20)
+	}
+	if hc.PidsLimit == nil || *hc.PidsLimit != 100 {
+		t.Errorf("PidsLimit = %v, want 100", hc.PidsLimit)
+	}
+	if diff := cmp.Diff(map[string]string{"size": "1073741824"}, hc.StorageOpt); diff != "" {
+		t.Errorf("StorageOpt mismatch (-want +got):\n%s", diff)
+	}
+
+	hc = hostConfig(&Job{Limits: resourceLimits(&pb.Assignment{})})
+	if hc.NanoCPUs != 0 || hc.Memory != 0 || hc.MemorySwap != 0 || hc.PidsLimit != nil || hc.StorageOpt != nil {
+		t.Errorf("hostConfig() without limits = %+v, want no limits", hc.Resources)
+	}
+
+	for _, test := range []struct {
+		network string
+		want    container.NetworkMode
+	}{
+		{network: pb.NetworkNone, want: "none"},
+		{network: pb.NetworkInternal, want: internalNetwork},
+		{network: pb.NetworkLimited, want: internalNetwork},
+		{network: pb.NetworkFull, want: ""},
+	} {
+		if got := hostConfig(&Job{Network: test.network}).NetworkMode; got != test.want {
+			t.Errorf("hostConfig(%s).NetworkMode = %q, want %q", test.network, got, test.want)
+		}
+	}
+}
+
+func TestLimitViolation(t *testing.T) {
+	limits := ResourceLimits{Memory: 64 << 20, Pids: 100, Disk: 10 << 20}
+	tests := []struct {
+		name      string
+		limits    ResourceLimits
+		out       string
+		oomKilled bool
+		want      string
+	}{
+		{name: "no violation", limits: limits, out: "PASS", want: ""},
+		{name: "oom killed", limits: limits, out: "=== RUN TestFib", oomKilled: true, want: "Container killed: resource limit exceeded: memory limit of 64MiB"},
+		{name: "oom killed without limit", out: "=== RUN TestFib", oomKilled: true, want: "Container killed: resource limit exceeded: out of memory"},
+		{name: "fork bomb", limits: limits, out: "bash: fork: retry: Resource temporarily unavailable", want: "Tests failed: resource limit exceeded: process limit of 100"},
+		{name: "disk full", limits: limits, out: "write out.txt: no space left on device", want: "Tests failed: resource limit exceeded: disk limit of 10MiB"},
+		{name: "host disk full", out: "write out.txt: no space left on device", want: ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := limitViolation(test.limits, test.out, test.oomKilled); got != test.want {
+				t.Errorf("limitViolation() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package ci
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// jobCommand returns the command running the given script of a job locally in the
+// given directory, along with the directory on this machine holding the root of the
+// file system seen by the script, which is the empty string, since the script sees
+// this machine's file system. The script runs in its own process group, such that it
+// can be stopped along with the processes it starts. The job's network policy is not
+// enforced on macOS.
+func jobCommand(job *Job, dir, script string) (*exec.Cmd, string, error) {
+	cmd := exec.Command("/bin/sh", "-c", script)
+	cmd.Dir = dir
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	return cmd, "", nil
+}
+
+// startJob starts the given command returned by jobCommand.
+func startJob(cmd *exec.Cmd) error {
+	return cmd.Start()
+}
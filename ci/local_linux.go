@@ -0,0 +1,67 @@
+package ci
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"syscall"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// jobCommand returns the command running the given script of a job locally in the
+// given directory, along with the directory on this machine holding the root of the
+// file system seen by the script, which must be removed once the command completes.
+// The script runs in a sandbox set up by runSandbox, in its own user, mount and PID
+// namespaces, and in its own process group, such that it can be stopped along with
+// the processes it starts. Jobs that are not given full network access also run in
+// their own network namespace, whose only network interface is a loopback interface
+// that is down.
+func jobCommand(job *Job, dir, script string) (*exec.Cmd, string, error) {
+	root, err := ioutil.TempDir("", "quickfeed-root-")
+	if err != nil {
+		return nil, "", err
+	}
+	cmd := &exec.Cmd{
+		Path: "/proc/self/exe",
+		Args: []string{sandboxArg0, root, dir, script},
+		Dir:  dir,
+	}
+	attr := &syscall.SysProcAttr{
+		Setpgid:    true,
+		Cloneflags: syscall.CLONE_NEWUSER | syscall.CLONE_NEWNS | syscall.CLONE_NEWPID,
+		// keep the user's own identity inside the user namespace
+		UidMappings: []syscall.SysProcIDMap{{ContainerID: os.Getuid(), HostID: os.Getuid(), Size: 1}},
+		GidMappings: []syscall.SysProcIDMap{{ContainerID: os.Getgid(), HostID: os.Getgid(), Size: 1}},
+	}
+	if job.Network != "" && job.Network != pb.NetworkFull {
+		attr.Cloneflags |= syscall.CLONE_NEWNET
+	}
+	cmd.SysProcAttr = attr
+	return cmd, root, nil
+}
+
+// startJob starts the given command returned by jobCommand, and waits until the
+// sandbox has been set up. An error is returned if the sandbox cannot be set up,
+// in which case the command has been stopped.
+func startJob(cmd *exec.Cmd) error {
+	r, w, err := os.Pipe()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	// the sandbox reports errors on the pipe, which is closed when the script starts
+	cmd.ExtraFiles = []*os.File{w}
+	err = cmd.Start()
+	w.Close()
+	if err != nil {
+		return err
+	}
+	msg, _ := ioutil.ReadAll(r)
+	if len(msg) > 0 {
+		_ = cmd.Wait()
+		return fmt.Errorf("%s", msg)
+	}
+	return nil
+}
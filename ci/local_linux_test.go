@@ -0,0 +1,86 @@
+package ci_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLocalNetworkPolicy(t *testing.T) {
+	// the network interfaces visible to the job, one per line after two header lines
+	const script = `tail -n +3 /proc/net/dev | cut -d: -f1 | tr -d ' '`
+	local := ci.Local{TempDir: true}
+	for _, test := range []struct {
+		network      string
+		wantLoopback bool // true if the loopback interface is the only one
+	}{
+		{network: pb.NetworkNone, wantLoopback: true},
+		{network: pb.NetworkLimited, wantLoopback: true},
+		{network: pb.NetworkFull, wantLoopback: false},
+	} {
+		out, err := local.Run(context.Background(), &ci.Job{
+			Commands: []string{script},
+			Network:  test.network,
+		})
+		if errors.Is(err, syscall.EPERM) {
+			t.Skipf("Run(network=%s) requires user namespaces: %v", test.network, err)
+		}
+		if err != nil {
+			t.Fatalf("Run(network=%s) failed: %v", test.network, err)
+		}
+		interfaces := strings.Fields(out)
+		onlyLoopback := len(interfaces) == 1 && interfaces[0] == "lo"
+		if onlyLoopback != test.wantLoopback {
+			t.Errorf("Run(network=%s) interfaces = %v, want only loopback: %t", test.network, interfaces, test.wantLoopback)
+		}
+	}
+}
+
+func TestLocalFileSystem(t *testing.T) {
+	// files of this machine outside the sandbox, in /tmp and in the working directory
+	hostFile := filepath.Join(t.TempDir(), "secret")
+	if err := os.WriteFile(hostFile, []byte("secret"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	visible := func(path string) string {
+		return fmt.Sprintf(`test -e %s && echo visible || echo hidden`, path)
+	}
+	writable := func(path string) string {
+		return fmt.Sprintf(`touch %s 2>/dev/null && echo writable || echo read-only`, path)
+	}
+	local := ci.Local{TempDir: true}
+	out, err := local.Run(context.Background(), &ci.Job{
+		Commands: []string{
+			visible(hostFile),
+			visible(filepath.Join(wd, "local_linux_test.go")),
+			visible("/usr/bin/env"),
+			writable("/usr/quickfeed-test"),
+			writable("/quickfeed-test"),
+			writable(`"$QUICKFEED_DIR/quickfeed-test"`),
+			writable("/tmp/quickfeed-test"),
+		},
+	})
+	if errors.Is(err, syscall.EPERM) {
+		t.Skipf("Run() requires user namespaces: %v", err)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"hidden", "hidden", "visible", "read-only", "read-only", "writable", "writable"}
+	if diff := cmp.Diff(want, strings.Fields(out)); diff != "" {
+		t.Errorf("Run() mismatch (-want +got):\n%s", diff)
+	}
+}
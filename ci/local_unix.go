@@ -3,22 +3,148 @@
 package ci
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
+	"syscall"
 )
 
 // Local is an implementation of the CI interface executing code locally.
-type Local struct{}
+type Local struct {
+	// TempDir, if true, runs each job in its own temporary directory, such that tests can be run
+	// without docker. The directory is also the home directory of the job's commands, which run with
+	// an environment holding only the job's variables and those needed to run commands. The job's
+	// memory and file size limits are enforced by the shell. As in a container, the exit status of
+	// the job's commands does not fail the job. On Linux, the commands run in a sandbox, seeing only
+	// the system directories, read-only, along with the job's directory and a /tmp directory of their
+	// own, and jobs that are not given full network access run without network access. On macOS, the
+	// jobs are not isolated from the host's file system: the commands can read and write any file
+	// that the user running them can.
+	TempDir bool
+}
 
 // Run implements the CI interface. This method blocks until the job has been
 // completed or an error occurs, e.g., the context times out.
 func (l *Local) Run(ctx context.Context, job *Job) (string, error) {
-	// TODO: Execute tests in something like ioutil.TempDir(os.TempDir(), "local-ci").
+	if l.TempDir {
+		return l.runInTempDir(ctx, job)
+	}
 	cmd := exec.Command("/bin/sh", "-c", strings.Join(job.Commands, "\n"))
-	b, err := cmd.Output()
+	if len(job.Env) > 0 {
+		cmd.Env = append(os.Environ(), job.Env...)
+	}
+	if job.Output != nil {
+		// pass the output on while the commands run
+		var out bytes.Buffer
+		cmd.Stdout = io.MultiWriter(&out, job.Output)
+		if job.Verbose {
+			cmd.Stderr = cmd.Stdout
+		}
+		if err := cmd.Run(); err != nil {
+			return "", err
+		}
+		return out.String(), nil
+	}
+	run := cmd.Output
+	if job.Verbose {
+		run = cmd.CombinedOutput
+	}
+	b, err := run()
 	if err != nil {
 		return "", err
 	}
 	return string(b), nil
 }
+
+// runInTempDir runs the job in a temporary directory, stopping the job's commands when the context times out.
+func (l *Local) runInTempDir(ctx context.Context, job *Job) (string, error) {
+	dir, err := ioutil.TempDir("", "quickfeed-ci-")
+	if err != nil {
+		return "", fmt.Errorf("cannot run job: %s; failed to create working directory: %w", job.Name, err)
+	}
+	defer os.RemoveAll(dir)
+
+	commands := append(limitCommands(job.Limits), job.Commands...)
+	cmd, root, err := jobCommand(job, dir, strings.Join(commands, "\n"))
+	if err != nil {
+		return "", fmt.Errorf("cannot run job: %s; failed to create root directory: %w", job.Name, err)
+	}
+	defer os.RemoveAll(root)
+	cmd.Env = append(localEnv(dir), job.Env...)
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+	if job.Output != nil {
+		// pass the output on while the commands run
+		cmd.Stdout = io.MultiWriter(&stdout, job.Output)
+	}
+	if job.Verbose {
+		cmd.Stderr = cmd.Stdout
+	}
+	if err := startJob(cmd); err != nil {
+		return "", fmt.Errorf("cannot run job: %s; %w", job.Name, err)
+	}
+	done := make(chan error, 1)
+	go func() { done <- cmd.Wait() }()
+	select {
+	case err := <-done:
+		var exitErr *exec.ExitError
+		if err != nil && !errors.As(err, &exitErr) {
+			return "", err
+		}
+		if job.Coverage != nil {
+			job.Coverage(readLocalCoverage(filepath.Join(root, job.CoverageProfile)))
+		}
+	case <-ctx.Done():
+		// stop the job's commands along with the processes they started
+		_ = syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+		<-done
+		return "Test timeout. Please check for infinite loops or other slowness.", ctx.Err()
+	}
+	out, _ := jobOutput(job, &stdout, false)
+	return out, nil
+}
+
+// readLocalCoverage returns the contents of the coverage profile at the given path,
+// or nil if the tests did not write the profile. The profile is removed.
+func readLocalCoverage(profile string) []byte {
+	f, err := os.Open(profile)
+	if err != nil {
+		return nil
+	}
+	defer os.Remove(profile)
+	defer f.Close()
+	return readCoverageProfile(f)
+}
+
+// localEnv returns the environment of the commands of a job run in the given temporary directory.
+func localEnv(dir string) []string {
+	return []string{
+		"PATH=" + os.Getenv("PATH"),
+		"HOME=" + dir,
+		"TMPDIR=" + dir,
+		"QUICKFEED_DIR=" + dir,
+		"LANG=C.UTF-8",
+	}
+}
+
+// limitCommands returns the shell commands enforcing the given resource limits
+// for the commands that follow them. Limits on CPU and processes are not enforced.
+func limitCommands(limits ResourceLimits) []string {
+	var commands []string
+	if limits.Memory > 0 {
+		// virtual memory, in kilobytes
+		commands = append(commands, fmt.Sprintf("ulimit -v %d", limits.Memory>>10))
+	}
+	if limits.Disk > 0 {
+		// size of each written file, in blocks of 512 bytes
+		commands = append(commands, fmt.Sprintf("ulimit -f %d", limits.Disk>>9))
+	}
+	return commands
+}
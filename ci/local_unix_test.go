@@ -2,7 +2,12 @@ package ci_test
 
 import (
 	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/autograde/quickfeed/ci"
 )
@@ -25,3 +30,126 @@ func TestLocal(t *testing.T) {
 		t.Errorf("have %#v want %#v", out, wantOut)
 	}
 }
+
+func TestLocalVerbose(t *testing.T) {
+	const script = `printf "hello "; printf "world" 1>&2`
+
+	local := ci.Local{}
+	for _, test := range []struct {
+		verbose bool
+		wantOut string
+	}{
+		{verbose: false, wantOut: "hello "},
+		{verbose: true, wantOut: "hello world"},
+	} {
+		out, err := local.Run(context.Background(), &ci.Job{
+			Commands: []string{script},
+			Verbose:  test.verbose,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out != test.wantOut {
+			t.Errorf("Run(verbose=%t) = %#v, want %#v", test.verbose, out, test.wantOut)
+		}
+	}
+}
+
+func TestLocalEnv(t *testing.T) {
+	local := ci.Local{}
+	out, err := local.Run(context.Background(), &ci.Job{
+		Commands: []string{`printf "$API_KEY"`},
+		Env:      []string{"API_KEY=secret"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != "secret" {
+		t.Errorf("have %#v want %#v", out, "secret")
+	}
+}
+
+func TestLocalTempDir(t *testing.T) {
+	t.Setenv("QUICKFEED_TEST_SECRET", "host secret")
+	local := ci.Local{TempDir: true}
+	out, err := local.Run(context.Background(), &ci.Job{
+		Commands: []string{`printf "%s|%s|%s" "$PWD" "$HOME" "$QUICKFEED_TEST_SECRET"`},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	parts := strings.Split(out, "|")
+	if len(parts) != 3 {
+		t.Fatalf("Run() = %q, want working directory, home directory and host variable", out)
+	}
+	dir, home, secret := parts[0], parts[1], parts[2]
+	if !strings.Contains(filepath.Base(dir), "quickfeed-ci-") || dir != home {
+		t.Errorf("Run() working directory = %q, home = %q, want the same temporary directory", dir, home)
+	}
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Errorf("Run() did not remove working directory %s", dir)
+	}
+	if secret != "" {
+		t.Errorf("Run() passed host variable to the job: %q", secret)
+	}
+}
+
+func TestLocalTempDirExitStatus(t *testing.T) {
+	local := ci.Local{TempDir: true}
+	out, err := local.Run(context.Background(), &ci.Job{
+		Commands: []string{`printf "FAIL"`, `exit 1`},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != "FAIL" {
+		t.Errorf("have %#v want %#v", out, "FAIL")
+	}
+}
+
+func TestLocalTempDirTimeout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	local := ci.Local{TempDir: true}
+	start := time.Now()
+	// the child process holding the output open must be stopped too
+	_, err := local.Run(ctx, &ci.Job{
+		Commands: []string{`sleep 10 & sleep 10`},
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Run() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Run() returned after %v, want shortly after the timeout", elapsed)
+	}
+}
+
+func TestLocalTempDirCoverage(t *testing.T) {
+	// as in a container, the profile is written to /tmp, which the job may not share with this machine
+	profile := "/tmp/quickfeed-test-coverage.out"
+	local := ci.Local{TempDir: true}
+	var got []byte
+	job := &ci.Job{
+		Commands:        []string{`printf "mode: set" > ` + profile},
+		CoverageProfile: profile,
+		Coverage:        func(contents []byte) { got = contents },
+	}
+	if _, err := local.Run(context.Background(), job); err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "mode: set" {
+		t.Errorf("Run() coverage profile = %q, want %q", got, "mode: set")
+	}
+	if _, err := os.Stat(profile); !os.IsNotExist(err) {
+		t.Errorf("Run() did not remove coverage profile %s", profile)
+	}
+
+	job.Commands = []string{"true"}
+	got = []byte("not called")
+	if _, err := local.Run(context.Background(), job); err != nil {
+		t.Fatal(err)
+	}
+	if got != nil {
+		t.Errorf("Run() coverage profile = %q, want nil for missing profile", got)
+	}
+}
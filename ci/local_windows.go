@@ -1,19 +1,48 @@
 package ci
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"io"
+	"os"
 	"os/exec"
 	"strings"
 )
 
 // Local is an implementation of the CI interface executing code locally.
-type Local struct{}
+type Local struct {
+	// TempDir, if true, runs each job in its own temporary directory; this is not supported on Windows.
+	TempDir bool
+}
 
 // Run implements the CI interface. This method blocks until the job has been
 // completed or an error occurs, e.g., the context times out.
 func (l *Local) Run(ctx context.Context, job *Job) (string, error) {
+	if l.TempDir {
+		return "", fmt.Errorf("cannot run job: %s; jobs in temporary directories are not supported on Windows", job.Name)
+	}
 	cmd := exec.Command("bash", "-c", strings.Join(job.Commands, "\n"))
-	b, err := cmd.Output()
+	if len(job.Env) > 0 {
+		cmd.Env = append(os.Environ(), job.Env...)
+	}
+	if job.Output != nil {
+		// pass the output on while the commands run
+		var out bytes.Buffer
+		cmd.Stdout = io.MultiWriter(&out, job.Output)
+		if job.Verbose {
+			cmd.Stderr = cmd.Stdout
+		}
+		if err := cmd.Run(); err != nil {
+			return "", err
+		}
+		return out.String(), nil
+	}
+	run := cmd.Output
+	if job.Verbose {
+		run = cmd.CombinedOutput
+	}
+	b, err := run()
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,126 @@
+package ci
+
+import (
+	"fmt"
+	"strings"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/kit/score"
+	"go.uber.org/zap"
+)
+
+// MatrixVariable is the environment variable holding the name of
+// the build matrix entry for which the tests run.
+const MatrixVariable = "QUICKFEED_MATRIX"
+
+// matrixRun holds the results of running the tests for an entry of a build matrix.
+type matrixRun struct {
+	entry   pb.MatrixEntry
+	results *score.Results // nil if the tests could not be run
+}
+
+// runMatrix runs the tests once for each entry of the given build matrix, in order, and
+// returns the results aggregated over the entries, along with the execution data of the
+// first entry whose tests could be run, which holds the results of each entry. Artifacts,
+// coverage and static analysis findings are taken from that entry. Returns nil if the
+// tests could not be run for any entry.
+func runMatrix(logger *zap.SugaredLogger, runner Runner, info *AssignmentInfo, rData *RunData, matrix []pb.MatrixEntry) (*execData, *score.Results) {
+	var first *execData
+	runs := make([]*matrixRun, 0, len(matrix))
+	for _, entry := range matrix {
+		entryInfo := *info
+		entryInfo.Matrix, entryInfo.matrixImage = entry.Name, entry.Image
+		logger.Debugf("Running tests for %s in matrix entry %s (%s)", rData.JobOwner, entry.Name, entry.Image)
+		ed, results := runSuite(logger, runner, &entryInfo, rData)
+		if first == nil {
+			first = ed
+		}
+		runs = append(runs, &matrixRun{entry: entry, results: results})
+	}
+	if first == nil {
+		return nil, nil
+	}
+	first.matrix = matrixResults(runs)
+	return first, aggregateMatrix(runs)
+}
+
+// matrixResults returns the result of each of the given runs, for storing with the submission.
+func matrixResults(runs []*matrixRun) []*pb.MatrixResult {
+	results := make([]*pb.MatrixResult, 0, len(runs))
+	for _, run := range runs {
+		result := &pb.MatrixResult{Name: run.entry.Name, Image: run.entry.Image}
+		if run.results == nil {
+			result.BuildLog = "failed to run the tests"
+		} else {
+			result.Score = run.results.Sum()
+			result.BuildLog = run.results.BuildInfo.GetBuildLog()
+			result.ExecTime = run.results.BuildInfo.GetExecTime()
+			result.TimedOut = run.results.BuildInfo.GetTimedOut()
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// aggregateMatrix returns the results of the given runs for the entries of a build matrix,
+// in which each test's score is its lowest score over the entries, such that a test only
+// passes if it passes for every entry. A test without a score for an entry, e.g., because
+// the tests could not be run in the entry's image, obtains no points. The build log holds
+// the build log of each entry, and the execution time is the total over the entries.
+// At least one of the runs must have results.
+func aggregateMatrix(runs []*matrixRun) *score.Results {
+	aggregated := &score.Results{BuildInfo: &score.BuildInfo{}}
+	lowest := make(map[string]*score.Score)
+	var logs []string
+	for _, run := range runs {
+		header := fmt.Sprintf("*** Matrix entry %s (%s) ***", run.entry.Name, run.entry.Image)
+		if run.results == nil {
+			logs = append(logs, header, "failed to run the tests")
+			continue
+		}
+		buildInfo := run.results.BuildInfo
+		if aggregated.BuildInfo.BuildDate == "" {
+			aggregated.BuildInfo.BuildDate = buildInfo.GetBuildDate()
+		}
+		aggregated.BuildInfo.ExecTime += buildInfo.GetExecTime()
+		aggregated.BuildInfo.TimedOut = aggregated.BuildInfo.TimedOut || buildInfo.GetTimedOut()
+		aggregated.Errors = append(aggregated.Errors, run.results.Errors...)
+		logs = append(logs, header, buildInfo.GetBuildLog())
+		for _, sc := range run.results.Scores {
+			prev, ok := lowest[sc.GetTestName()]
+			if !ok {
+				aggregated.Scores = append(aggregated.Scores, sc)
+			}
+			if !ok || scoreRatio(sc) < scoreRatio(prev) {
+				lowest[sc.GetTestName()] = sc
+			}
+		}
+	}
+	aggregated.BuildInfo.BuildLog = strings.Join(logs, "\n")
+	for i, sc := range aggregated.Scores {
+		sc = lowest[sc.GetTestName()]
+		if !hasScoreForAll(runs, sc.GetTestName()) {
+			sc = &score.Score{TestName: sc.GetTestName(), MaxScore: sc.GetMaxScore(), Weight: sc.GetWeight()}
+		}
+		aggregated.Scores[i] = sc
+	}
+	return aggregated
+}
+
+// scoreRatio returns the share of the max score obtained by the given score.
+func scoreRatio(sc *score.Score) float64 {
+	if sc.GetMaxScore() <= 0 {
+		return float64(sc.GetScore())
+	}
+	return float64(sc.GetScore()) / float64(sc.GetMaxScore())
+}
+
+// hasScoreForAll returns true if each of the given runs has a score for the given test.
+func hasScoreForAll(runs []*matrixRun, testName string) bool {
+	for _, run := range runs {
+		if run.results == nil || !hasScore(run.results, testName) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,154 @@
+package ci
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/kit/score"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+// matrixRunner returns the output given for the job's image, and records the jobs it runs.
+// Jobs for images without output fail to run.
+type matrixRunner struct {
+	out  map[string]string
+	mu   sync.Mutex
+	jobs []*Job
+}
+
+func (r *matrixRunner) Run(_ context.Context, job *Job) (string, error) {
+	r.mu.Lock()
+	r.jobs = append(r.jobs, job)
+	r.mu.Unlock()
+	out, ok := r.out[job.Image]
+	if !ok {
+		return "", errors.New("image not found")
+	}
+	return out, nil
+}
+
+func scoreLine(testName string, points int) string {
+	return fmt.Sprintf(`{"Secret":"session-secret","TestName":%q,"Score":%d,"MaxScore":1,"Weight":1}`, testName, points)
+}
+
+func TestRunMatrix(t *testing.T) {
+	info := &AssignmentInfo{
+		AssignmentName: "lab1",
+		Script:         "#image/quickfeed:go\ngo test ./...",
+		RandomSecret:   "session-secret",
+	}
+	rData := &RunData{
+		Course:     &pb.Course{Code: "DAT320"},
+		Assignment: &pb.Assignment{Name: "lab1", Matrix: "go1.19=golang:1.19,go1.20=golang:1.20"},
+		Repo:       &pb.Repository{},
+		JobOwner:   "muggles",
+	}
+	runner := &matrixRunner{out: map[string]string{
+		"golang:1.19": "go1.19 output\n" + scoreLine("TestFib", 1) + "\n" + scoreLine("TestGenerics", 0) + "\n",
+		"golang:1.20": "go1.20 output\n" + scoreLine("TestFib", 1) + "\n" + scoreLine("TestGenerics", 1) + "\n",
+	}}
+	ed, results := runMatrix(zap.NewNop().Sugar(), runner, info, rData, rData.Assignment.BuildMatrix())
+	if ed == nil {
+		t.Fatal("runMatrix() could not run the tests")
+	}
+	if len(runner.jobs) != 2 {
+		t.Fatalf("runMatrix() ran %d jobs, want 2", len(runner.jobs))
+	}
+	for i, entry := range rData.Assignment.BuildMatrix() {
+		job := runner.jobs[i]
+		if job.Image != entry.Image || job.Dockerfile != "" {
+			t.Errorf("job %d: image = %q (Dockerfile %q), want %q", i, job.Image, job.Dockerfile, entry.Image)
+		}
+		if env := strings.Join(job.Env, " "); !strings.Contains(env, MatrixVariable+"="+entry.Name) {
+			t.Errorf("job %d: env = %q, want %s=%s", i, env, MatrixVariable, entry.Name)
+		}
+	}
+	// TestGenerics fails for go1.19
+	if got := results.Sum(); got != 50 {
+		t.Errorf("Sum() = %d, want 50", got)
+	}
+	wantMatrix := []*pb.MatrixResult{
+		{Name: "go1.19", Image: "golang:1.19", Score: 50, BuildLog: "go1.19 output"},
+		{Name: "go1.20", Image: "golang:1.20", Score: 100, BuildLog: "go1.20 output"},
+	}
+	if diff := cmp.Diff(wantMatrix, ed.matrix, protocmp.Transform(), protocmp.IgnoreFields(&pb.MatrixResult{}, "execTime")); diff != "" {
+		t.Errorf("matrix results mismatch (-want +got):\n%s", diff)
+	}
+	wantLog := "*** Matrix entry go1.19 (golang:1.19) ***\ngo1.19 output\n*** Matrix entry go1.20 (golang:1.20) ***\ngo1.20 output"
+	if log := results.BuildInfo.GetBuildLog(); log != wantLog {
+		t.Errorf("BuildLog = %q, want %q", log, wantLog)
+	}
+
+	// without images for any entry, the tests cannot be run
+	runner = &matrixRunner{}
+	if ed, _ := runMatrix(zap.NewNop().Sugar(), runner, info, rData, rData.Assignment.BuildMatrix()); ed != nil {
+		t.Error("runMatrix() without images succeeded, want no results")
+	}
+}
+
+func TestAggregateMatrix(t *testing.T) {
+	fib := func(points int32) *score.Score {
+		return &score.Score{TestName: "TestFib", Score: points, MaxScore: 10, Weight: 1}
+	}
+	sort := &score.Score{TestName: "TestSort", Score: 5, MaxScore: 5, Weight: 2}
+	run := func(name string, timedOut bool, scores ...*score.Score) *matrixRun {
+		return &matrixRun{
+			entry: pb.MatrixEntry{Name: name, Image: "image:" + name},
+			results: &score.Results{
+				BuildInfo: &score.BuildInfo{BuildDate: "2022-11-10T13:00:" + name, BuildLog: name, ExecTime: 100, TimedOut: timedOut},
+				Scores:    scores,
+			},
+		}
+	}
+	tests := []struct {
+		name         string
+		runs         []*matrixRun
+		wantScores   []*score.Score
+		wantLog      string
+		wantTimedOut bool
+	}{
+		{
+			name:       "lowest score",
+			runs:       []*matrixRun{run("a", false, fib(10), sort), run("b", false, fib(4), sort), run("c", false, fib(7), sort)},
+			wantScores: []*score.Score{fib(4), sort},
+			wantLog:    "*** Matrix entry a (image:a) ***\na\n*** Matrix entry b (image:b) ***\nb\n*** Matrix entry c (image:c) ***\nc",
+		},
+		{
+			name:         "missing score",
+			runs:         []*matrixRun{run("a", false, fib(10), sort), run("b", true, fib(10))},
+			wantScores:   []*score.Score{fib(10), {TestName: "TestSort", MaxScore: 5, Weight: 2}},
+			wantLog:      "*** Matrix entry a (image:a) ***\na\n*** Matrix entry b (image:b) ***\nb",
+			wantTimedOut: true,
+		},
+		{
+			name:       "entry not run",
+			runs:       []*matrixRun{run("a", false, fib(10)), {entry: pb.MatrixEntry{Name: "b", Image: "image:b"}}},
+			wantScores: []*score.Score{fib(0)},
+			wantLog:    "*** Matrix entry a (image:a) ***\na\n*** Matrix entry b (image:b) ***\nfailed to run the tests",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := aggregateMatrix(tt.runs)
+			if diff := cmp.Diff(tt.wantScores, got.Scores, protocmp.Transform()); diff != "" {
+				t.Errorf("Scores mismatch (-want +got):\n%s", diff)
+			}
+			if got.BuildInfo.GetBuildLog() != tt.wantLog {
+				t.Errorf("BuildLog = %q, want %q", got.BuildInfo.GetBuildLog(), tt.wantLog)
+			}
+			if got.BuildInfo.GetBuildDate() != "2022-11-10T13:00:a" {
+				t.Errorf("BuildDate = %q, want the build date of the first entry", got.BuildInfo.GetBuildDate())
+			}
+			if got.BuildInfo.GetTimedOut() != tt.wantTimedOut {
+				t.Errorf("TimedOut = %t, want %t", got.BuildInfo.GetTimedOut(), tt.wantTimedOut)
+			}
+		})
+	}
+}
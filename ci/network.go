@@ -0,0 +1,103 @@
+package ci
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+)
+
+// internalNetwork is the docker network of the containers under the internal and
+// limited network policies; it has no route to hosts outside the docker host.
+const internalNetwork = "quickfeed-internal"
+
+// networkMode returns the network mode of containers under the given network policy.
+func networkMode(policy string) container.NetworkMode {
+	switch policy {
+	case pb.NetworkNone:
+		return "none"
+	case pb.NetworkInternal, pb.NetworkLimited:
+		return internalNetwork
+	}
+	// docker's default network, with unrestricted access
+	return ""
+}
+
+// allowedHosts returns the hosts that the job running the given assignment's tests may access
+// under the limited network policy. Besides the assignment's allowed hosts, these are the hosts
+// of the student and tests repositories, such that the job's script can clone the repositories.
+func allowedHosts(assignment *pb.Assignment, info *AssignmentInfo) []string {
+	hosts := append([]string{}, assignment.AllowedHostNames()...)
+	allowed := make(map[string]bool)
+	for _, host := range hosts {
+		allowed[host] = true
+	}
+	for _, repoURL := range []string{info.GetURL, info.TestURL} {
+		u, err := url.Parse(repoURL)
+		if err != nil || u.Hostname() == "" || allowed[u.Hostname()] {
+			continue
+		}
+		allowed[u.Hostname()] = true
+		hosts = append(hosts, u.Hostname())
+	}
+	return hosts
+}
+
+// networkEnv prepares the network of the given job's container, and returns the environment
+// variables giving the container access to the network, along with a function releasing the
+// access when the job is done. Jobs under the limited network policy reach their allowed
+// hosts through the proxy on the internal network's gateway.
+func (d *Docker) networkEnv(ctx context.Context, job *Job) ([]string, func(), error) {
+	if job.Network != pb.NetworkInternal && job.Network != pb.NetworkLimited {
+		return nil, func() {}, nil
+	}
+	d.networkMu.Lock()
+	defer d.networkMu.Unlock()
+	if d.gateway == "" {
+		gateway, err := d.internalGateway(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		d.gateway = gateway
+	}
+	if job.Network == pb.NetworkInternal {
+		return nil, func() {}, nil
+	}
+	if d.proxy == nil {
+		proxy, err := startAllowlistProxy(d.logger, d.gateway)
+		if err != nil {
+			return nil, nil, err
+		}
+		d.proxy = proxy
+	}
+	proxyURL, release := d.proxy.register(job.AllowedHosts)
+	return proxyEnv(proxyURL), release, nil
+}
+
+// internalGateway returns the gateway address of the internal network, creating the network if needed.
+func (d *Docker) internalGateway(ctx context.Context) (string, error) {
+	resource, err := d.client.NetworkInspect(ctx, internalNetwork, types.NetworkInspectOptions{})
+	if client.IsErrNotFound(err) {
+		d.logger.Infof("Creating internal network '%s' for test containers", internalNetwork)
+		if _, err := d.client.NetworkCreate(ctx, internalNetwork, types.NetworkCreate{
+			CheckDuplicate: true,
+			Internal:       true,
+		}); err != nil {
+			return "", fmt.Errorf("failed to create network %s: %w", internalNetwork, err)
+		}
+		resource, err = d.client.NetworkInspect(ctx, internalNetwork, types.NetworkInspectOptions{})
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to inspect network %s: %w", internalNetwork, err)
+	}
+	for _, config := range resource.IPAM.Config {
+		if config.Gateway != "" {
+			return config.Gateway, nil
+		}
+	}
+	return "", fmt.Errorf("network %s has no gateway", internalNetwork)
+}
@@ -0,0 +1,111 @@
+// +build darwin linux
+
+package ci
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAllowedHosts(t *testing.T) {
+	info := &AssignmentInfo{
+		GetURL:  "https://github.com/dat320/meling-labs.git",
+		TestURL: "https://github.com/dat320/tests.git",
+	}
+	for _, test := range []struct {
+		allowedHosts string
+		want         []string
+	}{
+		{allowedHosts: "", want: append((&pb.Assignment{}).AllowedHostNames(), "github.com")},
+		{allowedHosts: "pypi.org", want: []string{"pypi.org", "github.com"}},
+		{allowedHosts: "pypi.org,github.com", want: []string{"pypi.org", "github.com"}},
+	} {
+		assignment := &pb.Assignment{Network: pb.NetworkLimited, AllowedHosts: test.allowedHosts}
+		if diff := cmp.Diff(test.want, allowedHosts(assignment, info)); diff != "" {
+			t.Errorf("allowedHosts(%q) mismatch (-want +got):\n%s", test.allowedHosts, diff)
+		}
+	}
+}
+
+// TestRunDefaultScriptFullNetwork runs the default script of an assignment with full
+// network access, which must be able to clone the student and tests repositories.
+// The local runner cannot reach the hosts allowed under the default limited policy.
+// The tests' coverage profile is read from outside the artifacts folder.
+func TestRunDefaultScriptFullNetwork(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds the tests without a build cache")
+	}
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("requires git")
+	}
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("requires go")
+	}
+	root := t.TempDir()
+	createBareRepo(t, root, "assignments", map[string]string{
+		"lab1/go.mod": "module lab1\n\ngo 1.17\n",
+		"lab1/fib.go": "package lab1\n\nfunc fib(n int) int {\n\tif n < 2 {\n\t\treturn n\n\t}\n\treturn fib(n-1) + fib(n-2)\n}\n",
+	})
+	createBareRepo(t, root, "tests", map[string]string{
+		"lab1/fib_test.go": "package lab1\n\nimport \"testing\"\n\nfunc TestFib(t *testing.T) {\n\tif fib(10) != 55 {\n\t\tt.Error(\"fib(10) != 55\")\n\t}\n}\n",
+	})
+	// serve the repositories over git's dumb HTTP protocol
+	server := httptest.NewServer(http.FileServer(http.Dir(root)))
+	defer server.Close()
+
+	course := &pb.Course{Code: "DAT320"}
+	assignment := &pb.Assignment{Name: "lab1", Language: "go", Network: pb.NetworkFull, CoverageProfile: DefaultCoverageProfile}
+	info := newAssignmentInfo(course, assignment, server.URL+"/assignments.git", server.URL+"/tests.git")
+	rData := &RunData{
+		Course:     course,
+		Assignment: assignment,
+		Repo:       &pb.Repository{},
+		JobOwner:   "muggles",
+	}
+	ed, err := runTests(&Local{TempDir: true}, info, rData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(ed.out, "--- PASS: TestFib") {
+		t.Errorf("runTests() output = %q, want passing TestFib", ed.out)
+	}
+	if ed.coverage != 100 {
+		t.Errorf("runTests() coverage = %v, want 100", ed.coverage)
+	}
+}
+
+// createBareRepo creates a bare git repository name.git in dir, holding the given files,
+// and prepares it to be served over git's dumb HTTP protocol.
+func createBareRepo(t *testing.T, dir, name string, files map[string]string) {
+	t.Helper()
+	work := filepath.Join(t.TempDir(), name)
+	for path, contents := range files {
+		path = filepath.Join(work, path)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	bare := filepath.Join(dir, name+".git")
+	for _, args := range [][]string{
+		{"-C", work, "init", "--quiet"},
+		{"-C", work, "add", "."},
+		{"-C", work, "-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "--quiet", "-m", "init"},
+		{"clone", "--quiet", "--bare", work, bare},
+		{"-C", bare, "update-server-info"},
+	} {
+		if out, err := exec.Command("git", args...).CombinedOutput(); err != nil {
+			t.Fatalf("git %s failed: %v\n%s", strings.Join(args, " "), err, out)
+		}
+	}
+}
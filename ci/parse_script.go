@@ -19,16 +19,35 @@ type AssignmentInfo struct {
 	GetURL             string
 	TestURL            string
 	RandomSecret       string
+	Seed               uint64 // seed for randomizing the test inputs; zero if not randomized
+	CoverageProfile    string // path outside the artifacts folder to which the tests write their coverage profile; empty if coverage is not collected
+	Analysis           string // commands running the assignment's static analysis checks; empty if none
+	Matrix             string // name of the build matrix entry the tests run for; empty without a build matrix
+	JSON               bool   // the tests print go test's -json event stream rather than verbose output
+	// secrets are the course secrets given to the tests, keyed by environment variable;
+	// they are not exported, to keep them out of the script template.
+	secrets map[string]string
+	// matrixImage is the docker image of the build matrix entry the tests run for.
+	matrixImage string
 }
 
 func newAssignmentInfo(course *pb.Course, assignment *pb.Assignment, cloneURL, testURL string) *AssignmentInfo {
+	script := assignment.GetScriptFile()
+	if script == "" {
+		// use the language's default script if no script file was provided
+		script = DefaultScript(assignment.GetLanguage())
+	}
+	secret := rand.String()
 	return &AssignmentInfo{
 		AssignmentName:     assignment.GetName(),
-		Script:             assignment.ScriptFile,
+		Script:             script,
 		CreatorAccessToken: course.GetAccessToken(),
 		GetURL:             cloneURL,
 		TestURL:            testURL,
-		RandomSecret:       rand.String(),
+		RandomSecret:       secret,
+		CoverageProfile:    coverageProfile(assignment, secret),
+		Analysis:           analysisScript(assignment.GetAnalysisChecks(), secret),
+		JSON:               assignment.JSONTestOutput(),
 	}
 }
 
@@ -55,3 +74,18 @@ func parseScriptTemplate(info *AssignmentInfo) (*Job, error) {
 	}
 	return &Job{Image: parts[1], Commands: s[1:]}, nil
 }
+
+// jobImage returns the docker image to run the assignment's tests in, and the
+// Dockerfile to build the image from if it is missing. An assignment with its own
+// Dockerfile uses the image built from it; otherwise, the assignment's docker
+// image, if any, replaces the given image from the script, and the image is
+// built from the course's Dockerfile.
+func jobImage(course *pb.Course, assignment *pb.Assignment, scriptImage string) (image, dockerfile string) {
+	if assignment.GetDockerfile() != "" {
+		return assignment.BuildImageName(course.GetCode()), assignment.GetDockerfile()
+	}
+	if assignment.GetDockerImage() != "" {
+		return assignment.GetDockerImage(), course.GetDockerfile()
+	}
+	return scriptImage, course.GetDockerfile()
+}
@@ -3,6 +3,7 @@ package ci
 import (
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 
 	pb "github.com/autograde/quickfeed/ag"
@@ -52,3 +53,51 @@ func TestParseScript(t *testing.T) {
 		fmt.Println(j.Image)
 	}
 }
+
+func TestParseDefaultScript(t *testing.T) {
+	for _, lang := range SupportedLanguages() {
+		assignment := &pb.Assignment{Name: "lab1", Language: lang}
+		info := newAssignmentInfo(&pb.Course{}, assignment, "cloneURL", "testURL")
+		j, err := parseScriptTemplate(info)
+		if err != nil {
+			t.Fatalf("parseScriptTemplate(%s): %v", lang, err)
+		}
+		if want := languages[lang].image; j.Image != want {
+			t.Errorf("Image = %q, want %q", j.Image, want)
+		}
+	}
+	assignment := &pb.Assignment{Name: "lab1", Language: "go", TestFormat: pb.TestFormatJSON}
+	j, err := parseScriptTemplate(newAssignmentInfo(&pb.Course{}, assignment, "cloneURL", "testURL"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if script := strings.Join(j.Commands, "\n"); !strings.Contains(script, "go test -json ") {
+		t.Errorf("default go script with json test format does not run go test -json:\n%s", script)
+	}
+	if script := DefaultScript("cobol"); script != "" {
+		t.Errorf("DefaultScript(cobol) = %q, want empty string", script)
+	}
+}
+
+func TestJobImage(t *testing.T) {
+	course := &pb.Course{Code: "DAT320", Dockerfile: "FROM ubuntu"}
+	tests := []struct {
+		name           string
+		assignment     *pb.Assignment
+		wantImage      string
+		wantDockerfile string
+	}{
+		{name: "script image", assignment: &pb.Assignment{Name: "lab1"}, wantImage: "dat320", wantDockerfile: "FROM ubuntu"},
+		{name: "assignment image", assignment: &pb.Assignment{Name: "lab2", DockerImage: "python:3"}, wantImage: "python:3", wantDockerfile: "FROM ubuntu"},
+		{name: "assignment Dockerfile", assignment: &pb.Assignment{Name: "Lab3", Dockerfile: "FROM rust"}, wantImage: "dat320-lab3", wantDockerfile: "FROM rust"},
+		{name: "assignment Dockerfile and image", assignment: &pb.Assignment{Name: "lab4", Dockerfile: "FROM rust", DockerImage: "rust:1.60"}, wantImage: "dat320-lab4", wantDockerfile: "FROM rust"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			image, dockerfile := jobImage(course, test.assignment, "dat320")
+			if image != test.wantImage || dockerfile != test.wantDockerfile {
+				t.Errorf("jobImage() = (%q, %q), want (%q, %q)", image, dockerfile, test.wantImage, test.wantDockerfile)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package ci
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/autograde/quickfeed/kit/score"
+)
+
+// partialOutput records the output of a job while the job runs, such that the scores and
+// log output reported by the tests before a timeout are kept when the job's container is
+// stopped. The output is recorded up to half the size limit; beyond that, only score lines
+// are recorded, until the size limit is reached.
+type partialOutput struct {
+	mu      sync.Mutex
+	max     int
+	out     bytes.Buffer
+	full    bool   // true if output beyond half the size limit has been written
+	line    []byte // incomplete line written beyond half the size limit
+	discard bool   // true if the rest of the incomplete line is too long to be a score line
+}
+
+// newPartialOutput returns a recorder of the output of a job, with the given size limit in bytes.
+func newPartialOutput(max int) *partialOutput {
+	return &partialOutput{max: max}
+}
+
+// Write records the given output of the job.
+func (p *partialOutput) Write(b []byte) (int, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	n := len(b)
+	if !p.full {
+		room := p.max/2 - p.out.Len()
+		if len(b) <= room {
+			p.out.Write(b)
+			return n, nil
+		}
+		// record the complete lines that fit; the score lines of the rest are recorded below
+		keep := bytes.LastIndexByte(b[:room], '\n') + 1
+		p.out.Write(b[:keep])
+		b = b[keep:]
+		p.full = true
+	}
+	for len(b) > 0 {
+		i := bytes.IndexByte(b, '\n')
+		if i < 0 {
+			p.appendLine(b)
+			break
+		}
+		p.appendLine(b[:i+1])
+		b = b[i+1:]
+		if !p.discard && score.HasPrefix(string(p.line)) && p.out.Len()+len(p.line) <= p.max {
+			p.out.Write(p.line)
+		}
+		p.line = p.line[:0]
+		p.discard = false
+	}
+	return n, nil
+}
+
+// appendLine appends the given part of a line to the incomplete line,
+// unless the line grows too long to be recorded.
+func (p *partialOutput) appendLine(b []byte) {
+	if p.discard {
+		return
+	}
+	if len(p.line)+len(b) > p.max-p.out.Len() {
+		p.discard = true
+		p.line = p.line[:0]
+		return
+	}
+	p.line = append(p.line, b...)
+}
+
+// output returns the recorded output, truncated at the given limit
+// in the same way as the output of a completed job.
+func (p *partialOutput) output(limit int) string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.out.Len() > limit+lastSegmentSize {
+		return truncateLog(&p.out, limit, lastSegmentSize, maxToScan)
+	}
+	return p.out.String()
+}
@@ -0,0 +1,71 @@
+package ci
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/kit/score"
+)
+
+func TestPartialOutput(t *testing.T) {
+	const scoreLine = `{"Secret":"secret","TestName":"TestFib","Score":10,"MaxScore":10,"Weight":1}`
+	p := newPartialOutput(200)
+	// output is recorded up to half the limit, in complete lines
+	fmt.Fprint(p, "=== RUN TestFib\n", strings.Repeat("x", 80), "\n", "--- PASS")
+	// beyond that, only score lines are recorded, also when written in parts
+	fmt.Fprint(p, ": TestFib\n", scoreLine[:20])
+	fmt.Fprint(p, scoreLine[20:], "\n", "=== RUN TestLoop\n")
+	// score lines that do not fit within the limit are dropped
+	fmt.Fprint(p, scoreLine, "\n")
+
+	want := "=== RUN TestFib\n" + strings.Repeat("x", 80) + "\n" + scoreLine + "\n"
+	if got := p.output(maxLogSize); got != want {
+		t.Errorf("output() = %q, want %q", got, want)
+	}
+}
+
+// timeoutRunner writes the given output as the job runs, and then times out.
+type timeoutRunner struct {
+	out string
+}
+
+func (r timeoutRunner) Run(_ context.Context, job *Job) (string, error) {
+	fmt.Fprint(job.Output, r.out)
+	return "Container timeout. Please check for infinite loops or other slowness.", fmt.Errorf("failed to stop container: %w", context.DeadlineExceeded)
+}
+
+func TestRunTestsTimeout(t *testing.T) {
+	const secret = "session-secret"
+	info := &AssignmentInfo{
+		AssignmentName: "lab1",
+		Script:         "#image/quickfeed:go\ngo test ./...",
+		RandomSecret:   secret,
+	}
+	rData := &RunData{
+		Course:     &pb.Course{Code: "DAT320"},
+		Assignment: &pb.Assignment{Name: "lab1"},
+		Repo:       &pb.Repository{},
+		JobOwner:   "muggles",
+	}
+	runner := timeoutRunner{out: "=== RUN TestFib\n" +
+		`{"Secret":"session-secret","TestName":"TestFib","Score":10,"MaxScore":10,"Weight":1}` + "\n" +
+		"=== RUN TestLoop\n"}
+	ed, err := runTests(runner, info, rData)
+	if err == nil {
+		t.Fatal("runTests() succeeded, want timeout error")
+	}
+	if !ed.timedOut {
+		t.Error("runTests() did not report the timeout")
+	}
+	results := score.ExtractResults(ed.out, secret, ed.execTime)
+	if len(results.Scores) != 1 || results.Scores[0].GetTestName() != "TestFib" || results.Scores[0].GetScore() != 10 {
+		t.Errorf("ExtractResults() = %v, want the score of TestFib reported before the timeout", results.Scores)
+	}
+	wantLog := "=== RUN TestFib\n=== RUN TestLoop\nContainer timeout. Please check for infinite loops or other slowness."
+	if log := results.BuildInfo.GetBuildLog(); log != wantLog {
+		t.Errorf("BuildLog = %q, want %q", log, wantLog)
+	}
+}
@@ -0,0 +1,133 @@
+package ci
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/kit/score"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// RejectLocked records a submission without running the tests if the run's
+// assignment is locked, that is, if some of its prerequisites are not yet
+// approved for the owner of the run's repository. The submission's build log
+// names the prerequisites that must be approved first. Returns true if the
+// assignment is locked, and false if the submission should be processed.
+func RejectLocked(logger *zap.SugaredLogger, db database.Database, rData *RunData) bool {
+	if len(rData.Assignment.Prerequisites()) == 0 {
+		return false
+	}
+	locked, err := unapprovedPrerequisites(db, rData)
+	if err != nil {
+		// let the submission through, rather than rejecting it due to a database error
+		logger.Errorf("Failed to check prerequisites for assignment %s: %v", rData.Assignment.GetName(), err)
+		return false
+	}
+	if len(locked) == 0 {
+		return false
+	}
+	logger.Debugf("Assignment %s is locked for %s; prerequisites not approved: %v", rData.Assignment.GetName(), rData.JobOwner, locked)
+	submission := &pb.Submission{
+		AssignmentID: rData.Assignment.GetID(),
+		BuildInfo: &score.BuildInfo{
+			BuildDate: time.Now().Format(pb.TimeLayout),
+			BuildLog:  lockedMessage(rData.Assignment, locked),
+			ExecTime:  1,
+		},
+		CommitHash: rData.CommitID,
+		UserID:     rData.Repo.GetUserID(),
+		GroupID:    rData.Repo.GetGroupID(),
+	}
+	if err := db.CreateSubmission(submission); err != nil {
+		logger.Errorf("Failed to save rejected submission for user %s, assignment %s: %v", rData.JobOwner, rData.Assignment.GetName(), err)
+	}
+	return true
+}
+
+// lockedMessage returns the build log message for a submission to the given
+// assignment, which is locked by the given unapproved prerequisites.
+func lockedMessage(assignment *pb.Assignment, locked []string) string {
+	return fmt.Sprintf("Submissions for %s are not accepted until the following assignments are approved: %s",
+		assignment.GetName(), strings.Join(locked, ", "))
+}
+
+// unapprovedPrerequisites returns the names of the prerequisites of the run's assignment
+// that are not approved for the owner of the run's repository, in the declared order.
+// An individual prerequisite of a group assignment must be approved for every member
+// of the group, while a group prerequisite of an individual assignment must be approved
+// for the student's group.
+func unapprovedPrerequisites(db database.Database, rData *RunData) ([]string, error) {
+	assignments, err := db.GetAssignmentsByCourse(rData.Course.GetID(), false)
+	if err != nil {
+		return nil, err
+	}
+	byName := make(map[string]*pb.Assignment, len(assignments))
+	for _, assignment := range assignments {
+		byName[assignment.GetName()] = assignment
+	}
+	var locked []string
+	for _, name := range rData.Assignment.Prerequisites() {
+		prerequisite, ok := byName[name]
+		if !ok {
+			// the parser rejects unknown prerequisites; an assignment removed since is no obstacle
+			continue
+		}
+		approved, err := prerequisiteApproved(db, rData, prerequisite)
+		if err != nil {
+			return nil, err
+		}
+		if !approved {
+			locked = append(locked, name)
+		}
+	}
+	return locked, nil
+}
+
+// prerequisiteApproved returns true if the given prerequisite is approved for the owner of the run's repository.
+func prerequisiteApproved(db database.Database, rData *RunData, prerequisite *pb.Assignment) (bool, error) {
+	userID, groupID := rData.Repo.GetUserID(), rData.Repo.GetGroupID()
+	switch {
+	case groupID > 0 && !prerequisite.GetIsGroupLab():
+		users, err := db.GetGroupUsers(groupID)
+		if err != nil {
+			return false, err
+		}
+		for _, user := range users {
+			if ok, err := isApproved(db, prerequisite.GetID(), user.GetID(), 0); err != nil || !ok {
+				return false, err
+			}
+		}
+		return len(users) > 0, nil
+	case groupID == 0 && prerequisite.GetIsGroupLab():
+		enrollment, err := db.GetEnrollmentByCourseAndUser(rData.Course.GetID(), userID)
+		if err != nil {
+			return false, err
+		}
+		if enrollment.GetGroupID() == 0 {
+			return false, nil
+		}
+		return isApproved(db, prerequisite.GetID(), 0, enrollment.GetGroupID())
+	}
+	return isApproved(db, prerequisite.GetID(), userID, groupID)
+}
+
+// isApproved returns true if the latest submission by the given user or group
+// for the given assignment is approved.
+func isApproved(db database.Database, assignmentID, userID, groupID uint64) (bool, error) {
+	submission, err := db.GetSubmission(&pb.Submission{
+		AssignmentID: assignmentID,
+		UserID:       userID,
+		GroupID:      groupID,
+	})
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return submission.GetStatus() == pb.Submission_APPROVED, nil
+}
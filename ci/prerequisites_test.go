@@ -0,0 +1,70 @@
+package ci
+
+import (
+	"strings"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"go.uber.org/zap"
+)
+
+func TestRejectLocked(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	course := &pb.Course{}
+	admin := qtest.CreateFakeUser(t, db, 1)
+	qtest.CreateCourse(t, db, admin, course)
+
+	lab1 := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	lab2 := &pb.Assignment{CourseID: course.ID, Name: "lab2", Order: 2, Requires: "lab1"}
+	for _, assignment := range []*pb.Assignment{lab1, lab2} {
+		if err := db.CreateAssignment(assignment); err != nil {
+			t.Fatal(err)
+		}
+	}
+	runData := &RunData{
+		Course:     course,
+		Assignment: lab2,
+		Repo:       &pb.Repository{UserID: admin.ID},
+		CommitID:   "abc123",
+		JobOwner:   "test",
+	}
+
+	// lab1 has no submission yet
+	if !RejectLocked(zap.NewNop().Sugar(), db, runData) {
+		t.Error("RejectLocked() = false, want true for unapproved prerequisite")
+	}
+	submission, err := db.GetSubmission(&pb.Submission{AssignmentID: lab2.ID, UserID: admin.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if log := submission.GetBuildInfo().GetBuildLog(); !strings.Contains(log, "lab1") {
+		t.Errorf("build log = %q, want rejection naming lab1", log)
+	}
+
+	// lab1 is submitted, but not approved
+	lab1Submission := &pb.Submission{AssignmentID: lab1.ID, UserID: admin.ID, Score: 50}
+	if err := db.CreateSubmission(lab1Submission); err != nil {
+		t.Fatal(err)
+	}
+	if !RejectLocked(zap.NewNop().Sugar(), db, runData) {
+		t.Error("RejectLocked() = false, want true for submitted but unapproved prerequisite")
+	}
+
+	// lab1 is approved
+	lab1Submission.Status = pb.Submission_APPROVED
+	if err := db.UpdateSubmission(lab1Submission); err != nil {
+		t.Fatal(err)
+	}
+	if RejectLocked(zap.NewNop().Sugar(), db, runData) {
+		t.Error("RejectLocked() = true, want false for approved prerequisite")
+	}
+
+	// lab1 has no prerequisites
+	runData.Assignment = lab1
+	if RejectLocked(zap.NewNop().Sugar(), db, runData) {
+		t.Error("RejectLocked() = true, want false for assignment without prerequisites")
+	}
+}
@@ -0,0 +1,223 @@
+package ci
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// proxyDialTimeout is the time allowed for the proxy to connect to an allowed host.
+const proxyDialTimeout = 10 * time.Second
+
+// hopHeaders are the headers that apply to a single connection, and therefore
+// are not forwarded by the proxy.
+var hopHeaders = []string{
+	"Connection",
+	"Proxy-Connection",
+	"Keep-Alive",
+	"Proxy-Authenticate",
+	"Proxy-Authorization",
+	"Te",
+	"Trailer",
+	"Transfer-Encoding",
+	"Upgrade",
+}
+
+// allowlistProxy is an HTTP forward proxy giving the containers of jobs under the
+// limited network policy access to their allowed hosts only. The containers run on
+// an internal network without a route to external hosts, and reach the proxy on the
+// network's gateway. Each job is given a token, passed as the user name in the proxy
+// URL, identifying the hosts allowed for the job.
+type allowlistProxy struct {
+	logger    *zap.SugaredLogger
+	addr      string // host:port that the proxy listens on
+	server    *http.Server
+	transport *http.Transport
+
+	mu      sync.Mutex
+	allowed map[string][]string // token -> allowed hosts
+}
+
+// startAllowlistProxy starts a proxy listening on an arbitrary port of the given host.
+func startAllowlistProxy(logger *zap.SugaredLogger, host string) (*allowlistProxy, error) {
+	listener, err := net.Listen("tcp", net.JoinHostPort(host, "0"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to start network proxy: %w", err)
+	}
+	p := &allowlistProxy{
+		logger: logger,
+		addr:   listener.Addr().String(),
+		// the proxy itself must not use the proxy settings of the server's environment
+		transport: &http.Transport{Proxy: nil, DialContext: (&net.Dialer{Timeout: proxyDialTimeout}).DialContext},
+		allowed:   make(map[string][]string),
+	}
+	p.server = &http.Server{Handler: p}
+	go func() {
+		if err := p.server.Serve(listener); err != nil && err != http.ErrServerClosed {
+			logger.Errorf("Network proxy stopped: %v", err)
+		}
+	}()
+	return p, nil
+}
+
+// Close stops the proxy.
+func (p *allowlistProxy) Close() error {
+	return p.server.Close()
+}
+
+// register allows the given hosts for a job, and returns the proxy URL to be used by
+// the job's container, along with a function revoking access when the job is done.
+func (p *allowlistProxy) register(hosts []string) (string, func()) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		// the system's source of randomness should never fail
+		panic(err)
+	}
+	token := hex.EncodeToString(b)
+	p.mu.Lock()
+	p.allowed[token] = hosts
+	p.mu.Unlock()
+	return "http://" + token + "@" + p.addr, func() {
+		p.mu.Lock()
+		delete(p.allowed, token)
+		p.mu.Unlock()
+	}
+}
+
+// allowedHosts returns the hosts allowed for the job making the given request,
+// and false if the request does not carry the token of a registered job.
+func (p *allowlistProxy) allowedHosts(r *http.Request) ([]string, bool) {
+	// the token is the user name in the proxy URL, sent as basic authentication
+	auth := r.Header.Get("Proxy-Authorization")
+	if !strings.HasPrefix(auth, "Basic ") {
+		return nil, false
+	}
+	fake := &http.Request{Header: http.Header{"Authorization": []string{auth}}}
+	token, _, ok := fake.BasicAuth()
+	if !ok {
+		return nil, false
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	hosts, ok := p.allowed[token]
+	return hosts, ok
+}
+
+// ServeHTTP forwards requests to allowed hosts, either by tunneling
+// CONNECT requests, as used for HTTPS, or by forwarding plain HTTP requests.
+func (p *allowlistProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	hosts, ok := p.allowedHosts(r)
+	if !ok {
+		w.Header().Set("Proxy-Authenticate", `Basic realm="quickfeed"`)
+		http.Error(w, "unknown test run", http.StatusProxyAuthRequired)
+		return
+	}
+	if host := r.URL.Hostname(); !hostAllowed(hosts, host) {
+		p.logger.Debugf("Network proxy denied access to %s", host)
+		http.Error(w, fmt.Sprintf("access to %s is not allowed by the assignment's network policy", host), http.StatusForbidden)
+		return
+	}
+	if r.Method == http.MethodConnect {
+		p.tunnel(w, r)
+		return
+	}
+	p.forward(w, r)
+}
+
+// tunnel connects the client to the requested host, and copies data both ways.
+func (p *allowlistProxy) tunnel(w http.ResponseWriter, r *http.Request) {
+	dst, err := net.DialTimeout("tcp", r.URL.Host, proxyDialTimeout)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		dst.Close()
+		http.Error(w, "tunneling not supported", http.StatusInternalServerError)
+		return
+	}
+	src, _, err := hijacker.Hijack()
+	if err != nil {
+		dst.Close()
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if _, err := io.WriteString(src, "HTTP/1.1 200 Connection established\r\n\r\n"); err != nil {
+		src.Close()
+		dst.Close()
+		return
+	}
+	go func() {
+		defer dst.Close()
+		io.Copy(dst, src)
+	}()
+	go func() {
+		defer src.Close()
+		io.Copy(src, dst)
+	}()
+}
+
+// forward sends the request to the requested host, and copies the response to the client.
+func (p *allowlistProxy) forward(w http.ResponseWriter, r *http.Request) {
+	out := r.Clone(r.Context())
+	out.RequestURI = ""
+	for _, header := range hopHeaders {
+		out.Header.Del(header)
+	}
+	resp, err := p.transport.RoundTrip(out)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer resp.Body.Close()
+	for _, header := range hopHeaders {
+		resp.Header.Del(header)
+	}
+	for key, values := range resp.Header {
+		for _, value := range values {
+			w.Header().Add(key, value)
+		}
+	}
+	w.WriteHeader(resp.StatusCode)
+	io.Copy(w, resp.Body)
+}
+
+// hostAllowed returns true if the given host matches one of the allowed hosts,
+// where an allowed host of the form *.example.com matches any subdomain.
+func hostAllowed(allowed []string, host string) bool {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	for _, pattern := range allowed {
+		if strings.HasPrefix(pattern, "*.") {
+			if strings.HasSuffix(host, pattern[1:]) {
+				return true
+			}
+			continue
+		}
+		if host == pattern {
+			return true
+		}
+	}
+	return false
+}
+
+// proxyEnv returns the environment variables directing a container's HTTP clients to the given proxy URL.
+func proxyEnv(proxyURL string) []string {
+	noProxy := "localhost,127.0.0.1"
+	return []string{
+		"HTTP_PROXY=" + proxyURL,
+		"HTTPS_PROXY=" + proxyURL,
+		"http_proxy=" + proxyURL,
+		"https_proxy=" + proxyURL,
+		"NO_PROXY=" + noProxy,
+		"no_proxy=" + noProxy,
+	}
+}
@@ -0,0 +1,82 @@
+package ci
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+func TestHostAllowed(t *testing.T) {
+	allowed := []string{"pypi.org", "*.github.com"}
+	tests := []struct {
+		host string
+		want bool
+	}{
+		{host: "pypi.org", want: true},
+		{host: "PyPI.org.", want: true},
+		{host: "files.pypi.org", want: false},
+		{host: "api.github.com", want: true},
+		{host: "github.com", want: false},
+		{host: "evilgithub.com", want: false},
+		{host: "example.com", want: false},
+	}
+	for _, test := range tests {
+		if got := hostAllowed(allowed, test.host); got != test.want {
+			t.Errorf("hostAllowed(%q) = %t, want %t", test.host, got, test.want)
+		}
+	}
+}
+
+func TestAllowlistProxy(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("hello"))
+	})
+	plain := httptest.NewServer(handler)
+	defer plain.Close()
+	tls := httptest.NewTLSServer(handler)
+	defer tls.Close()
+
+	proxy, err := startAllowlistProxy(zap.NewNop().Sugar(), "127.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer proxy.Close()
+	allowedURL, release := proxy.register([]string{"127.0.0.1"})
+	deniedURL, releaseDenied := proxy.register([]string{"pypi.org"})
+	defer releaseDenied()
+
+	get := func(proxyURL, target string) (int, string) {
+		t.Helper()
+		u, err := url.Parse(proxyURL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		transport := tls.Client().Transport.(*http.Transport).Clone()
+		transport.Proxy = http.ProxyURL(u)
+		resp, err := (&http.Client{Transport: transport}).Get(target)
+		if err != nil {
+			// a refused CONNECT request is reported as an error
+			return 0, err.Error()
+		}
+		defer resp.Body.Close()
+		body, _ := ioutil.ReadAll(resp.Body)
+		return resp.StatusCode, string(body)
+	}
+
+	for _, target := range []string{plain.URL, tls.URL} {
+		if status, body := get(allowedURL, target); status != http.StatusOK || body != "hello" {
+			t.Errorf("GET %s through proxy = %d %q, want 200 \"hello\"", target, status, body)
+		}
+		if status, body := get(deniedURL, target); status == http.StatusOK {
+			t.Errorf("GET %s through proxy for other hosts = %d %q, want denied", target, status, body)
+		}
+	}
+	release()
+	if status, _ := get(allowedURL, plain.URL); status != http.StatusProxyAuthRequired {
+		t.Errorf("GET through proxy after release = %d, want %d", status, http.StatusProxyAuthRequired)
+	}
+}
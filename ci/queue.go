@@ -0,0 +1,180 @@
+package ci
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+	"go.uber.org/zap"
+)
+
+// QueueLimits are the maximum numbers of test runs executing concurrently.
+type QueueLimits struct {
+	Total     int // test runs overall
+	PerRepo   int // test runs for the same student or group repository
+	PerCourse int // test runs in the same course
+}
+
+// DefaultQueueLimits leave room for other courses when one course's deadline is
+// near, and prevent a single student or group from occupying several containers.
+var DefaultQueueLimits = QueueLimits{Total: 10, PerRepo: 1, PerCourse: 5}
+
+// queuedRun is a test run waiting in, or started by, the queue.
+type queuedRun struct {
+	data *RunData
+	seq  uint64        // order of arrival
+	done chan struct{} // closed when the run completes
+}
+
+// Queue runs tests in the order of their assignments' deadlines, such that runs for
+// assignments whose deadline is near are started first, and otherwise in the order of
+// arrival. Runs are started as long as the queue's limits permit; a run exceeding the
+// limits of its repository or course waits, without holding back other runs.
+type Queue struct {
+	logger   *zap.SugaredLogger
+	limits   QueueLimits
+	runTests func(*RunData)
+	logs     *BuildLogs
+
+	mu       sync.Mutex
+	seq      uint64
+	pending  []*queuedRun
+	running  int
+	byRepo   map[uint64]int // running test runs by repository ID
+	byCourse map[uint64]int // running test runs by course ID
+}
+
+// NewQueue returns a queue running tests with the given runner within the given limits.
+func NewQueue(logger *zap.SugaredLogger, db database.Database, runner Runner, limits QueueLimits) *Queue {
+	return &Queue{
+		logger: logger,
+		limits: limits,
+		runTests: func(rData *RunData) {
+			RunTests(logger, db, runner, rData)
+		},
+		logs:     NewBuildLogs(),
+		byRepo:   make(map[uint64]int),
+		byCourse: make(map[uint64]int),
+	}
+}
+
+// BuildLogs returns the build logs of the test runs started by the queue.
+func (q *Queue) BuildLogs() *BuildLogs {
+	return q.logs
+}
+
+// Enqueue adds a test run for the given run data to the queue, and returns
+// a channel that is closed when the run completes. It does not block.
+func (q *Queue) Enqueue(rData *RunData) <-chan struct{} {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.seq++
+	run := &queuedRun{data: rData, seq: q.seq, done: make(chan struct{})}
+	q.pending = append(q.pending, run)
+	q.logger.Debugf("Queued tests for %s (%d pending, %d running)", rData.JobOwner, len(q.pending), q.running)
+	q.schedule(time.Now())
+	return run.done
+}
+
+// Status returns the number of pending and running test runs in the given course, and
+// the position among the course's pending runs of the first run for the given user's
+// repository or the given group's repository, if any.
+func (q *Queue) Status(courseID, userID, groupID uint64) *pb.SubmissionQueue {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	status := &pb.SubmissionQueue{Running: uint32(q.byCourse[courseID])}
+	for _, run := range q.ordered(time.Now()) {
+		if run.data.Course.GetID() != courseID {
+			continue
+		}
+		status.Pending++
+		repo := run.data.Repo
+		owned := (userID > 0 && repo.GetUserID() == userID) || (groupID > 0 && repo.GetGroupID() == groupID)
+		if owned && status.Position == 0 {
+			status.Position = status.Pending
+		}
+	}
+	return status
+}
+
+// ordered returns the pending runs in the order they should be started.
+// The caller must hold the queue's lock.
+func (q *Queue) ordered(now time.Time) []*queuedRun {
+	runs := append([]*queuedRun{}, q.pending...)
+	sort.SliceStable(runs, func(i, j int) bool {
+		return runsBefore(runs[i], runs[j], now)
+	})
+	return runs
+}
+
+// runsBefore returns true if run a should be started before run b: runs for assignments
+// with an upcoming deadline come first, the nearest deadline first, followed by runs for
+// assignments without a deadline or whose deadline has passed, in the order of arrival.
+func runsBefore(a, b *queuedRun, now time.Time) bool {
+	untilA, upcomingA := untilDeadline(a.data.Assignment, now)
+	untilB, upcomingB := untilDeadline(b.data.Assignment, now)
+	switch {
+	case upcomingA && upcomingB && untilA != untilB:
+		return untilA < untilB
+	case upcomingA != upcomingB:
+		return upcomingA
+	}
+	return a.seq < b.seq
+}
+
+// untilDeadline returns the time until the assignment's deadline,
+// and false if the assignment has no deadline or it has passed.
+func untilDeadline(a *pb.Assignment, now time.Time) (time.Duration, bool) {
+	sinceDeadline, err := a.SinceDeadline(now)
+	if err != nil || sinceDeadline > 0 {
+		return 0, false
+	}
+	return -sinceDeadline, true
+}
+
+// schedule starts the pending runs permitted by the queue's limits, in order.
+// The caller must hold the queue's lock.
+func (q *Queue) schedule(now time.Time) {
+	if len(q.pending) == 0 || q.running >= q.limits.Total {
+		return
+	}
+	var waiting []*queuedRun
+	for _, run := range q.ordered(now) {
+		repoID, courseID := run.data.Repo.GetID(), run.data.Course.GetID()
+		if q.running >= q.limits.Total || q.byRepo[repoID] >= q.limits.PerRepo || q.byCourse[courseID] >= q.limits.PerCourse {
+			waiting = append(waiting, run)
+			continue
+		}
+		q.running++
+		q.byRepo[repoID]++
+		q.byCourse[courseID]++
+		go q.run(run)
+	}
+	// keep the waiting runs in order of arrival; the order of starting is decided when scheduling
+	sort.Slice(waiting, func(i, j int) bool { return waiting[i].seq < waiting[j].seq })
+	q.pending = waiting
+}
+
+// run runs the tests of the given run, and schedules the pending runs when done.
+func (q *Queue) run(run *queuedRun) {
+	defer close(run.done)
+	key := buildLogKey(run.data)
+	stream := q.logs.start(key)
+	run.data.Output = stream
+	q.runTests(run.data)
+	q.logs.finish(key, stream)
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	repoID, courseID := run.data.Repo.GetID(), run.data.Course.GetID()
+	q.running--
+	if q.byRepo[repoID]--; q.byRepo[repoID] == 0 {
+		delete(q.byRepo, repoID)
+	}
+	if q.byCourse[courseID]--; q.byCourse[courseID] == 0 {
+		delete(q.byCourse, courseID)
+	}
+	q.schedule(time.Now())
+}
@@ -0,0 +1,102 @@
+package ci
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestRunsBefore(t *testing.T) {
+	now := time.Now()
+	run := func(seq uint64, deadline time.Time) *queuedRun {
+		assignment := &pb.Assignment{}
+		if !deadline.IsZero() {
+			assignment.DeadlineTime = timestamppb.New(deadline)
+		}
+		return &queuedRun{data: &RunData{Assignment: assignment}, seq: seq}
+	}
+	tests := []struct {
+		name string
+		a, b *queuedRun
+		want bool
+	}{
+		{name: "arrival order", a: run(1, time.Time{}), b: run(2, time.Time{}), want: true},
+		{name: "later arrival", a: run(2, time.Time{}), b: run(1, time.Time{}), want: false},
+		{name: "nearer deadline", a: run(2, now.Add(time.Hour)), b: run(1, now.Add(24*time.Hour)), want: true},
+		{name: "farther deadline", a: run(1, now.Add(24*time.Hour)), b: run(2, now.Add(time.Hour)), want: false},
+		{name: "upcoming deadline before none", a: run(2, now.Add(24*time.Hour)), b: run(1, time.Time{}), want: true},
+		{name: "passed deadline after upcoming", a: run(1, now.Add(-time.Hour)), b: run(2, now.Add(time.Hour)), want: false},
+		{name: "passed deadline in arrival order", a: run(1, now.Add(-time.Hour)), b: run(2, time.Time{}), want: true},
+	}
+	for _, test := range tests {
+		if got := runsBefore(test.a, test.b, now); got != test.want {
+			t.Errorf("runsBefore(%s) = %t, want %t", test.name, got, test.want)
+		}
+	}
+}
+
+func TestQueue(t *testing.T) {
+	course1, course2 := &pb.Course{ID: 1}, &pb.Course{ID: 2}
+	repoA := &pb.Repository{ID: 1, UserID: 1}
+	repoB := &pb.Repository{ID: 2, UserID: 2}
+	repoC := &pb.Repository{ID: 3, GroupID: 1}
+	noDeadline := &pb.Assignment{}
+	soon := &pb.Assignment{DeadlineTime: timestamppb.New(time.Now().Add(time.Hour))}
+
+	started := make(chan string)
+	release := make(map[string]chan struct{})
+	for _, owner := range []string{"a1", "a2", "b1", "c1"} {
+		release[owner] = make(chan struct{})
+	}
+	q := NewQueue(zap.NewNop().Sugar(), nil, nil, QueueLimits{Total: 2, PerRepo: 1, PerCourse: 2})
+	q.runTests = func(rData *RunData) {
+		started <- rData.JobOwner
+		<-release[rData.JobOwner]
+	}
+	expectStarted := func(want string) {
+		t.Helper()
+		select {
+		case got := <-started:
+			if got != want {
+				t.Fatalf("started %s, want %s", got, want)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("%s not started", want)
+		}
+	}
+
+	a1 := q.Enqueue(&RunData{Course: course1, Assignment: noDeadline, Repo: repoA, JobOwner: "a1"})
+	expectStarted("a1")
+	// a2 waits for a1, since both are for repository A
+	q.Enqueue(&RunData{Course: course1, Assignment: noDeadline, Repo: repoA, JobOwner: "a2"})
+	q.Enqueue(&RunData{Course: course1, Assignment: noDeadline, Repo: repoB, JobOwner: "b1"})
+	expectStarted("b1")
+	// c1 waits, since two runs are running, but starts before a2 due to its deadline
+	q.Enqueue(&RunData{Course: course2, Assignment: soon, Repo: repoC, JobOwner: "c1"})
+
+	want := &pb.SubmissionQueue{Pending: 1, Running: 2, Position: 1}
+	if diff := cmp.Diff(want, q.Status(course1.ID, repoA.UserID, 0), protocmp.Transform()); diff != "" {
+		t.Errorf("Status() mismatch (-want +got):\n%s", diff)
+	}
+	want = &pb.SubmissionQueue{Pending: 1, Running: 2}
+	if diff := cmp.Diff(want, q.Status(course1.ID, repoB.UserID, 0), protocmp.Transform()); diff != "" {
+		t.Errorf("Status() for user without pending runs mismatch (-want +got):\n%s", diff)
+	}
+	want = &pb.SubmissionQueue{Pending: 1, Position: 1}
+	if diff := cmp.Diff(want, q.Status(course2.ID, 0, repoC.GroupID), protocmp.Transform()); diff != "" {
+		t.Errorf("Status() for group mismatch (-want +got):\n%s", diff)
+	}
+
+	close(release["a1"])
+	expectStarted("c1")
+	<-a1
+	close(release["b1"])
+	expectStarted("a2")
+	close(release["c1"])
+	close(release["a2"])
+}
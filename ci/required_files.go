@@ -0,0 +1,27 @@
+package ci
+
+import (
+	"path"
+	"path/filepath"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// MissingRequiredFiles returns the names of the assignment's required files that
+// are not among the given present files, in the order declared by the assignment.
+// File names are relative to the root of the submission's repository. This allows
+// the runner to fail fast, before building and running the tests of a submission
+// that cannot be graded.
+func MissingRequiredFiles(a *pb.Assignment, present []string) []string {
+	found := make(map[string]bool, len(present))
+	for _, fileName := range present {
+		found[path.Clean(filepath.ToSlash(fileName))] = true
+	}
+	var missing []string
+	for _, fileName := range a.RequiredFileNames() {
+		if !found[path.Clean(fileName)] {
+			missing = append(missing, fileName)
+		}
+	}
+	return missing
+}
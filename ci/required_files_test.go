@@ -0,0 +1,32 @@
+package ci
+
+import (
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMissingRequiredFiles(t *testing.T) {
+	assignment := &pb.Assignment{RequiredFiles: "report.pdf,docs/design.md,main.go"}
+	tests := []struct {
+		name       string
+		assignment *pb.Assignment
+		present    []string
+		want       []string
+	}{
+		{name: "all present", assignment: assignment, present: []string{"main.go", "./docs/design.md", "report.pdf", "README.md"}, want: nil},
+		{name: "one missing", assignment: assignment, present: []string{"main.go", "docs/design.md"}, want: []string{"report.pdf"}},
+		{name: "wrong folder", assignment: assignment, present: []string{"design.md", "report.pdf", "main.go"}, want: []string{"docs/design.md"}},
+		{name: "none present", assignment: assignment, present: nil, want: []string{"report.pdf", "docs/design.md", "main.go"}},
+		{name: "no required files", assignment: &pb.Assignment{}, present: []string{"main.go"}, want: nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := MissingRequiredFiles(test.assignment, test.present)
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("MissingRequiredFiles() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -2,7 +2,11 @@ package ci
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"math"
+	"strings"
 	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
@@ -21,6 +25,11 @@ type RunData struct {
 	CommitID   string
 	JobOwner   string
 	Rebuild    bool
+	// TriggeredBy is the login of the user who re-ran the tests; empty for a push.
+	TriggeredBy string
+	// Output, if not nil, receives the test output while the tests run,
+	// filtered as in the build log.
+	Output io.Writer
 }
 
 // String returns a string representation of the run data structure
@@ -31,67 +40,278 @@ func (r RunData) String(secret string) string {
 // RunTests runs the assignment specified in the provided RunData structure.
 func RunTests(logger *zap.SugaredLogger, db database.Database, runner Runner, rData *RunData) {
 	info := newAssignmentInfo(rData.Course, rData.Assignment, rData.Repo.GetHTMLURL(), rData.Repo.GetTestURL())
+	if rData.Assignment.GetRandomSeed() {
+		info.Seed = testSeed(rData)
+	}
+	secrets, err := testSecrets(db, rData)
+	if err != nil {
+		logger.Errorf("Failed to run tests for %s: %v", rData.JobOwner, err)
+		return
+	}
+	info.secrets = secrets
 	logger.Debugf("Running tests for %s", rData.JobOwner)
-	ed, err := runTests(runner, info, rData)
+	var ed *execData
+	var results *score.Results
+	if matrix := rData.Assignment.BuildMatrix(); len(matrix) > 0 {
+		ed, results = runMatrix(logger, runner, info, rData, matrix)
+	} else {
+		ed, results = runSuite(logger, runner, info, rData)
+	}
+	if ed == nil {
+		return
+	}
+	// record the seed, so that the run can be reproduced
+	results.BuildInfo.Seed = info.Seed
+	results.BuildInfo.TriggeredBy = rData.TriggeredBy
+	addCoverageScore(rData.Assignment, results, ed.coverage)
+	addAnalysisScores(rData.Assignment, results, ed.analysis)
+	truncateTestDetails(results, outputLimit(rData.Assignment))
+	logger.Debug("ci.RunTests", zap.Any("Results", log.IndentJson(results)))
+	recordResults(logger, db, rData, results, ed)
+}
+
+type execData struct {
+	out       string
+	execTime  time.Duration
+	timedOut  bool
+	artifacts []*pb.Artifact
+	coverage  float64 // coverage percentage; zero if coverage is not collected
+	analysis  *analysisOutput
+	matrix    []*pb.MatrixResult // results for each entry of the build matrix; nil without a build matrix
+}
+
+// runSuite runs the tests, retrying on infrastructure failures and rerunning failed
+// retryable tests, and returns the execution data of the first run along with the
+// results reconciled over all runs, in which the assignment's tests that did not
+// report a score have failed. If the test output revealed the session secret, all
+// tests have failed. Returns nil if the tests could not be run.
+func runSuite(logger *zap.SugaredLogger, runner Runner, info *AssignmentInfo, rData *RunData) (*execData, *score.Results) {
+	ed, err := runTestsRetryingOnInfra(logger, runner, info, rData)
 	if err != nil {
 		logger.Errorf("Failed to run tests: %v", err)
 		if ed == nil {
-			return
+			return nil, nil
 		}
-		// we only get here if err was a timeout, so that we can log 'out' to the user
+		// we only get here if err was a timeout, so that we can log 'out' to the user,
+		// including the scores reported before the timeout
 	}
-	results := score.ExtractResults(ed.out, info.RandomSecret, ed.execTime)
+	results := extractResults(rData.Assignment, ed.out, info.RandomSecret, ed.execTime)
+	results.BuildInfo.TimedOut = ed.timedOut
 	if len(results.Errors) > 0 {
 		for _, err := range results.Errors {
 			logger.Errorf("Failed to extract results: %v", err)
 		}
 	}
-	logger.Debug("ci.RunTests", zap.Any("Results", log.IndentJson(results)))
-	recordResults(logger, db, rData, results)
+	results = rerunTests(logger, runner, info, rData, results)
+	completeResults(rData.Assignment, results)
+	if failLeakedRun(results, info.RandomSecret) {
+		logger.Warnf("Tests for %s revealed the session secret; failing all tests", rData.JobOwner)
+	}
+	return ed, results
 }
 
-type execData struct {
-	out      string
-	execTime time.Duration
+// extractResults returns the results extracted from the given output of running the
+// assignment's tests, which is a go test -json event stream if the assignment's test
+// format is json. If the event stream cannot be read, the results are extracted from
+// the output as text, along with the error.
+func extractResults(a *pb.Assignment, out, secret string, execTime time.Duration) *score.Results {
+	if !a.JSONTestOutput() {
+		return score.ExtractResults(out, secret, execTime)
+	}
+	results, err := score.ExtractResultsJSON(strings.NewReader(out), secret, execTime)
+	if err != nil {
+		results = score.ExtractResults(out, secret, execTime)
+		results.Errors = append(results.Errors, err)
+	}
+	return results
 }
 
 // runTests returns execData struct.
 // An error is returned if the execution fails, or times out.
-// If a timeout is the cause of the error, we also return an output string to the user.
+// If a timeout is the cause of the error, we also return the output recorded before the timeout.
 func runTests(runner Runner, info *AssignmentInfo, rData *RunData) (*execData, error) {
 	job, err := parseScriptTemplate(info)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse script template: %w", err)
 	}
 
+	job.Image, job.Dockerfile = jobImage(rData.Course, rData.Assignment, job.Image)
 	job.Name = rData.String(info.RandomSecret[:6])
+	job.Verbose = rData.Assignment.GetVerbose()
+	job.OutputLimit = outputLimit(rData.Assignment)
+	job.Env = secretEnv(info.secrets)
+	if info.Matrix != "" {
+		// the matrix entry's image replaces the assignment's image, and is pulled if missing
+		job.Image, job.Dockerfile = info.matrixImage, ""
+		job.Name += "-" + info.Matrix
+		job.Env = append(job.Env, MatrixVariable+"="+info.Matrix)
+	}
+	job.Limits = resourceLimits(rData.Assignment)
+	job.Network = rData.Assignment.NetworkPolicy()
+	job.AllowedHosts = allowedHosts(rData.Assignment, info)
+	partial := newPartialOutput(maxToScan)
+	job.Output = partial
+	if rData.Output != nil {
+		var hidden []string
+		if !rData.Assignment.RevealsHiddenTests(time.Now()) {
+			hidden = rData.Assignment.HiddenTests()
+		}
+		output := newLogFilter(rData.Output, info.RandomSecret, info.secrets, hidden, job.OutputLimit)
+		output.events = rData.Assignment.JSONTestOutput()
+		defer output.flush()
+		job.Output = io.MultiWriter(partial, output)
+	}
+	var artifacts []*pb.Artifact
+	var artifactsErr error
+	job.Artifacts = func(archive io.Reader) {
+		artifacts, artifactsErr = extractArtifacts(archive, maxArtifactsSize)
+	}
+	var profile []byte
+	if info.CoverageProfile != "" {
+		job.CoverageProfile = info.CoverageProfile
+		job.Coverage = func(contents []byte) { profile = contents }
+	}
 	start := time.Now()
 
-	timeout := containerTimeout
-	t := rData.Assignment.GetContainerTimeout()
-	if t > 0 {
-		timeout = time.Duration(t) * time.Minute
-	}
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	ctx, cancel := context.WithTimeout(context.Background(), assignmentTimeout(rData.Assignment))
 	defer cancel()
 
 	out, err := runner.Run(ctx, job)
+	timedOut := errors.Is(err, context.DeadlineExceeded)
+	if timedOut {
+		// keep the scores and output reported before the job was stopped, followed by the runner's timeout message
+		out = partial.output(job.OutputLimit) + "\n" + out
+	}
+	out = redactSecrets(out, info.secrets)
 	if err != nil && out == "" {
 		return nil, fmt.Errorf("test execution failed: %w", err)
 	}
+	out, analysis := extractAnalysis(out, info.RandomSecret)
+	if incomplete := analysis.incomplete(rData.Assignment.GetAnalysisChecks()); len(incomplete) > 0 {
+		// tell the student that the checks' findings are unknown
+		out += "\nstatic analysis did not complete: " + strings.Join(incomplete, ", ") + "\n"
+	}
+	if artifactsErr != nil {
+		// tell the student which artifacts are missing
+		out += "\n" + artifactsErr.Error() + "\n"
+	}
+	coverage, coverageErr := measureCoverage(rData.Assignment, profile)
+	if coverageErr != nil {
+		// tell the student why the coverage is missing
+		out += "\n" + coverageErr.Error() + "\n"
+	}
+	artifacts = withCoverageArtifact(rData.Assignment, artifacts, profile)
 	// this may return a timeout error as well
-	return &execData{out: out, execTime: time.Since(start)}, err
+	return &execData{
+		out:       out,
+		execTime:  time.Since(start),
+		timedOut:  timedOut,
+		artifacts: artifacts,
+		coverage:  coverage,
+		analysis:  analysis,
+	}, err
+}
+
+// runTestsRetryingOnInfra runs the tests, and reruns them up to the assignment's
+// number of infrastructure retries as long as the runs fail due to a problem with
+// the grading infrastructure, as opposed to a problem with the student's code.
+func runTestsRetryingOnInfra(logger *zap.SugaredLogger, runner Runner, info *AssignmentInfo, rData *RunData) (*execData, error) {
+	for attempt := uint32(0); ; attempt++ {
+		ed, err := runTests(runner, info, rData)
+		if !shouldRetryOnInfra(rData.Assignment, runBuildInfo(ed, err), attempt) {
+			return ed, err
+		}
+		logger.Debugf("Rerunning tests for %s after infrastructure failure (retry %d): %v", rData.JobOwner, attempt+1, err)
+	}
 }
 
-// recordResults for the assignment given by the run data structure.
-func recordResults(logger *zap.SugaredLogger, db database.Database, rData *RunData, result *score.Results) {
+// shouldRetryOnInfra returns true if the tests should be rerun after the given
+// number of retries, since the run with the given build info failed due to a
+// problem with the grading infrastructure.
+func shouldRetryOnInfra(a *pb.Assignment, buildInfo *score.BuildInfo, retries uint32) bool {
+	return retries < a.GetRetryOnInfra() && buildInfo.FailureReason().IsInfra()
+}
+
+// runBuildInfo returns the build info of a test run with the given output and
+// error, where the error, if any, is included in the build log.
+func runBuildInfo(ed *execData, err error) *score.BuildInfo {
+	var lines []string
+	if ed != nil {
+		lines = append(lines, ed.out)
+	}
+	if err != nil {
+		lines = append(lines, err.Error())
+	}
+	return &score.BuildInfo{BuildLog: strings.Join(lines, "\n")}
+}
+
+// rerunTests reruns the tests, up to the assignment's number of retries, as long as
+// one of the assignment's retryable tests has failed in all runs so far.
+// Returns the results reconciled over all runs.
+func rerunTests(logger *zap.SugaredLogger, runner Runner, info *AssignmentInfo, rData *RunData, results *score.Results) *score.Results {
+	retryable := rData.Assignment.RetryableTests()
+	runs := []*score.Results{results}
+	for i := uint32(0); i < rData.Assignment.GetRetries(); i++ {
+		if !hasFailedTest(score.Reconcile(retryable, runs...), retryable) {
+			break
+		}
+		logger.Debugf("Rerunning tests for %s (retry %d)", rData.JobOwner, i+1)
+		ed, err := runTests(runner, info, rData)
+		if err != nil {
+			logger.Errorf("Failed to rerun tests: %v", err)
+			if ed == nil {
+				break
+			}
+		}
+		runs = append(runs, extractResults(rData.Assignment, ed.out, info.RandomSecret, ed.execTime))
+	}
+	return score.Reconcile(retryable, runs...)
+}
+
+// hasFailedTest returns true if one of the given tests failed.
+func hasFailedTest(results *score.Results, testNames []string) bool {
+	for _, sc := range results.Scores {
+		for _, testName := range testNames {
+			if sc.GetTestName() == testName && !sc.IsPassing() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// applyTestPoints replaces the max score and weight reported by the tests with
+// the assignment's authoritative points, if any, from the tests' configuration.
+// The obtained score is scaled to the authoritative max score.
+func applyTestPoints(assignment *pb.Assignment, results *score.Results) {
+	for _, test := range assignment.GetTests() {
+		for _, sc := range results.Scores {
+			if sc.GetTestName() != test.GetTestName() {
+				continue
+			}
+			if maxScore := test.GetMaxScore(); maxScore > 0 && sc.GetMaxScore() > 0 && maxScore != sc.GetMaxScore() {
+				scaled := math.Round(float64(sc.GetScore()) * float64(maxScore) / float64(sc.GetMaxScore()))
+				sc.Score = int32(math.Min(scaled, float64(maxScore)))
+				sc.MaxScore = maxScore
+			}
+			if weight := test.GetWeight(); weight > 0 {
+				sc.Weight = weight
+			}
+		}
+	}
+}
+
+// recordResults for the assignment given by the run data structure, along with the
+// coverage and static analysis findings of the given test run, replacing the artifacts
+// of the submission by those of the test run.
+func recordResults(logger *zap.SugaredLogger, db database.Database, rData *RunData, result *score.Results, ed *execData) {
 	// Sanity check of the result object
 	if result == nil || result.BuildInfo == nil {
 		logger.Errorf("No build info found; faulty Results object received: %v", result)
 		return
 	}
 
-	assignment := rData.Assignment
+	assignment := extendedAssignment(logger, db, rData)
 	logger.Debugf("Fetching most recent submission for assignment %d", assignment.GetID())
 	submissionQuery := &pb.Submission{
 		AssignmentID: assignment.GetID(),
@@ -115,17 +335,26 @@ func recordResults(logger *zap.SugaredLogger, db database.Database, rData *RunDa
 		}
 	}
 
-	score := result.Sum()
+	result.SetDeltas(previousResults(newest, rData.Rebuild))
+
+	score := grade(result, assignment)
+	run := approvalRun(logger, assignment, newest, result, score, rData.Rebuild)
 	newSubmission := &pb.Submission{
-		ID:           newest.GetID(),
-		AssignmentID: assignment.GetID(),
-		CommitHash:   rData.CommitID,
-		Score:        score,
-		BuildInfo:    result.BuildInfo,
-		Scores:       result.Scores,
-		UserID:       rData.Repo.GetUserID(),
-		GroupID:      rData.Repo.GetGroupID(),
-		Status:       assignment.IsApproved(newest, score),
+		ID:              newest.GetID(),
+		AssignmentID:    assignment.GetID(),
+		CommitHash:      rData.CommitID,
+		Score:           score,
+		BuildInfo:       result.BuildInfo,
+		Scores:          result.Scores,
+		UserID:          rData.Repo.GetUserID(),
+		GroupID:         rData.Repo.GetGroupID(),
+		Status:          assignment.ApprovalStatus(newest, run),
+		Attempts:        attempts(newest, rData.Rebuild),
+		Reruns:          reruns(newest, rData.Rebuild),
+		PassStreak:      run.Streak,
+		CoveragePercent: ed.coverage,
+		Annotations:     ed.analysis.annotations(),
+		MatrixResults:   ed.matrix,
 	}
 	err = db.CreateSubmission(newSubmission)
 	if err != nil {
@@ -133,14 +362,73 @@ func recordResults(logger *zap.SugaredLogger, db database.Database, rData *RunDa
 		return
 	}
 	logger.Debugf("Created submission for assignment '%s' with score %d, status %s", assignment.GetName(), score, newSubmission.GetStatus())
+	if err := db.UpdateArtifacts(newSubmission.GetID(), ed.artifacts); err != nil {
+		logger.Errorf("Failed to store artifacts of submission for assignment '%s': %v", assignment.GetName(), err)
+	}
+	if policy := assignment.ApprovalDeniedBy(run); assignment.GetAutoApprove() && policy != "" {
+		logger.Debugf("Submission for assignment '%s' not auto approved by policy %s", assignment.GetName(), policy)
+	}
 	if !rData.Rebuild {
-		updateSlipDays(logger, db, rData.Assignment, newSubmission)
+		updateSlipDays(logger, db, assignment, newSubmission)
+	}
+}
+
+// extendedAssignment returns the run's assignment with the deadline replaced by
+// the extension granted to the owner of the run's repository, if any.
+func extendedAssignment(logger *zap.SugaredLogger, db database.Database, rData *RunData) *pb.Assignment {
+	ext, err := db.GetDeadlineExtension(&pb.DeadlineExtension{
+		AssignmentID: rData.Assignment.GetID(),
+		UserID:       rData.Repo.GetUserID(),
+		GroupID:      rData.Repo.GetGroupID(),
+	})
+	if err != nil {
+		if err != gorm.ErrRecordNotFound {
+			logger.Errorf("Failed to get deadline extension for assignment %s: %v", rData.Assignment.GetName(), err)
+		}
+		return rData.Assignment
+	}
+	logger.Debugf("Using extended deadline %s for assignment %s", ext.GetDeadline(), rData.Assignment.GetName())
+	return rData.Assignment.WithExtension(ext)
+}
+
+// penalizedScore returns the given score less the assignment's late penalty for a
+// submission built at the build date of the given build info, for deciding whether
+// to approve the submission. The score is returned unchanged if the build date or
+// the assignment's deadlines cannot be parsed.
+func penalizedScore(logger *zap.SugaredLogger, assignment *pb.Assignment, buildInfo *score.BuildInfo, score uint32) uint32 {
+	// build dates are recorded in the server's local time
+	buildTime, err := time.ParseInLocation(pb.TimeLayout, buildInfo.GetBuildDate(), time.Local)
+	if err != nil {
+		logger.Errorf("Failed to parse time from build date (%s): %v", buildInfo.GetBuildDate(), err)
+		return score
+	}
+	penalty, err := assignment.LatePenaltyPoints(buildTime, score)
+	if err != nil {
+		logger.Errorf("Failed to compute late penalty for assignment %s: %v", assignment.GetName(), err)
+		return score
+	}
+	return score - penalty
+}
+
+// previousResults returns the results of the student's previous submission,
+// against which the score deltas of a new submission are computed. A rebuild
+// replaces the results of the newest submission; hence, its deltas remain
+// relative to the submission before it.
+func previousResults(newest *pb.Submission, rebuild bool) *score.Results {
+	prev := &score.Results{}
+	for _, sc := range newest.GetScores() {
+		prevScore := sc.GetScore()
+		if rebuild {
+			prevScore -= sc.GetDelta()
+		}
+		prev.Scores = append(prev.Scores, &score.Score{TestName: sc.GetTestName(), Score: prevScore})
 	}
+	return prev
 }
 
 func updateSlipDays(logger *zap.SugaredLogger, db database.Database, assignment *pb.Assignment, submission *pb.Submission) {
 	buildDate := submission.GetBuildInfo().GetBuildDate()
-	buildTime, err := time.Parse(pb.TimeLayout, buildDate)
+	buildTime, err := time.ParseInLocation(pb.TimeLayout, buildDate, time.Local)
 	if err != nil {
 		logger.Errorf("Failed to parse time from build date (%s): %v", buildDate, err)
 		return
@@ -2,6 +2,7 @@ package ci
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	pb "github.com/autograde/quickfeed/ag"
@@ -98,6 +99,7 @@ func TestRecordResults(t *testing.T) {
 		BuildDate: "2022-11-10T13:00:00",
 		BuildLog:  "Testing",
 		ExecTime:  33333,
+		Seed:      42,
 	}
 	testScores := []*score.Score{
 		{
@@ -122,7 +124,7 @@ func TestRecordResults(t *testing.T) {
 		JobOwner: "test",
 	}
 
-	recordResults(zap.NewNop().Sugar(), db, runData, results)
+	recordResults(zap.NewNop().Sugar(), db, runData, results, &execData{coverage: 72.5})
 	submission, err := db.GetSubmission(&pb.Submission{AssignmentID: assignment.ID, UserID: admin.ID})
 	if err != nil {
 		t.Fatal(err)
@@ -136,11 +138,20 @@ func TestRecordResults(t *testing.T) {
 	if diff := cmp.Diff(buildInfo.BuildDate, submission.BuildInfo.BuildDate); diff != "" {
 		t.Errorf("Incorrect build date. Want: %s, got %s", buildInfo.BuildDate, submission.BuildInfo.BuildDate)
 	}
+	if submission.BuildInfo.Seed != buildInfo.Seed {
+		t.Errorf("Incorrect seed. Want: %d, got %d", buildInfo.Seed, submission.BuildInfo.Seed)
+	}
+	if submission.Attempts != 1 {
+		t.Errorf("Incorrect number of attempts: want %d, got %d", 1, submission.Attempts)
+	}
+	if submission.CoveragePercent != 72.5 {
+		t.Errorf("Incorrect coverage: want %v, got %v", 72.5, submission.CoveragePercent)
+	}
 
 	// Updating submission after deadline: build info and slip days must be updated
 	newBuildDate := "2022-11-12T13:00:00"
 	results.BuildInfo.BuildDate = newBuildDate
-	recordResults(zap.NewNop().Sugar(), db, runData, results)
+	recordResults(zap.NewNop().Sugar(), db, runData, results, &execData{})
 
 	enrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, admin.ID)
 	if err != nil {
@@ -161,7 +172,7 @@ func TestRecordResults(t *testing.T) {
 	runData.Rebuild = true
 	results.BuildInfo.BuildDate = "2022-11-13T13:00:00"
 	slipDaysBeforeUpdate := enrollment.RemainingSlipDays(course)
-	recordResults(zap.NewNop().Sugar(), db, runData, results)
+	recordResults(zap.NewNop().Sugar(), db, runData, results, &execData{})
 	updatedEnrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, admin.ID)
 	if err != nil {
 		t.Fatal(err)
@@ -169,4 +180,126 @@ func TestRecordResults(t *testing.T) {
 	if updatedEnrollment.RemainingSlipDays(course) != slipDaysBeforeUpdate {
 		t.Errorf("Incorrect number of slip days: expected %d, got %d", slipDaysBeforeUpdate, updatedEnrollment.RemainingSlipDays(course))
 	}
+	rebuiltSubmission, err := db.GetSubmission(&pb.Submission{AssignmentID: assignment.ID, UserID: admin.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rebuiltSubmission.Attempts != 2 {
+		t.Errorf("Incorrect number of attempts after rebuild: want %d, got %d", 2, rebuiltSubmission.Attempts)
+	}
+}
+
+func TestExtractResults(t *testing.T) {
+	const secret = "session-secret"
+	events := `{"Action":"output","Package":"lab1","Test":"TestFib","Output":"=== RUN   TestFib\n"}
+{"Action":"output","Package":"lab1","Test":"TestFib","Output":"{\"Secret\":\"session-secret\",\"TestName\":\"TestFib\",\"Score\":0,\"MaxScore\":1,\"Weight\":1}\n"}
+{"Action":"output","Package":"lab1","Test":"TestFib","Output":"    fib_test.go:10: fib(3) = 1, want 2\n"}
+{"Action":"output","Package":"lab1","Test":"TestFib","Output":"--- FAIL: TestFib (0.00s)\n"}
+{"Action":"fail","Package":"lab1","Test":"TestFib","Elapsed":0}
+`
+	results := extractResults(&pb.Assignment{TestFormat: pb.TestFormatJSON}, events, secret, 0)
+	if len(results.Errors) > 0 {
+		t.Fatal(results.Errors[0])
+	}
+	if len(results.Scores) != 1 || results.Scores[0].GetTestDetails() == "" {
+		t.Errorf("extractResults(json) scores = %v, want TestFib with test details", results.Scores)
+	}
+	if want := "=== RUN   TestFib\n    fib_test.go:10: fib(3) = 1, want 2\n--- FAIL: TestFib (0.00s)"; results.BuildInfo.GetBuildLog() != want {
+		t.Errorf("extractResults(json) build log = %q, want %q", results.BuildInfo.GetBuildLog(), want)
+	}
+
+	// the event stream is only read as such for assignments with the json test format
+	results = extractResults(&pb.Assignment{}, events, secret, 0)
+	if len(results.Scores) != 0 {
+		t.Errorf("extractResults(text) scores = %v, want none", results.Scores)
+	}
+}
+
+func TestApplyTestPoints(t *testing.T) {
+	assignment := &pb.Assignment{
+		Tests: []*pb.TestConfig{
+			{TestName: "TestA", MaxScore: 10, Weight: 3},
+			{TestName: "TestB", Weight: 2},
+			{TestName: "TestC", Retryable: true},
+		},
+	}
+	results := score.NewResults(
+		&score.Score{TestName: "TestA", Score: 3, MaxScore: 4, Weight: 1},
+		&score.Score{TestName: "TestB", Score: 1, MaxScore: 2, Weight: 1},
+		&score.Score{TestName: "TestC", Score: 5, MaxScore: 5, Weight: 1},
+		&score.Score{TestName: "TestD", Score: 0, MaxScore: 5, Weight: 1},
+	)
+	applyTestPoints(assignment, results)
+	want := []*score.Score{
+		{TestName: "TestA", Score: 8, MaxScore: 10, Weight: 3},
+		{TestName: "TestB", Score: 1, MaxScore: 2, Weight: 2},
+		{TestName: "TestC", Score: 5, MaxScore: 5, Weight: 1},
+		{TestName: "TestD", Score: 0, MaxScore: 5, Weight: 1},
+	}
+	if diff := cmp.Diff(want, results.Scores, protocmp.Transform()); diff != "" {
+		t.Errorf("applyTestPoints() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestShouldRetryOnInfra(t *testing.T) {
+	assignment := &pb.Assignment{Name: "lab1", RetryOnInfra: 2}
+	imagePull := runBuildInfo(nil, errors.New("Error response from daemon: pull access denied for quickfeed/go"))
+	compileError := runBuildInfo(&execData{out: "# lab1\n./fib.go:7:2: undefined: fibonacci\nFAIL\tlab1 [build failed]"}, nil)
+	timeout := runBuildInfo(&execData{out: "=== RUN   TestFib"}, context.DeadlineExceeded)
+	passing := runBuildInfo(&execData{out: "ok  \tlab1\t0.012s"}, nil)
+
+	tests := []struct {
+		name       string
+		assignment *pb.Assignment
+		buildInfo  *score.BuildInfo
+		retries    uint32
+		want       bool
+	}{
+		{name: "image pull failure", assignment: assignment, buildInfo: imagePull, retries: 0, want: true},
+		{name: "image pull failure, last retry", assignment: assignment, buildInfo: imagePull, retries: 1, want: true},
+		{name: "image pull failure, retries spent", assignment: assignment, buildInfo: imagePull, retries: 2, want: false},
+		{name: "image pull failure, no retries", assignment: &pb.Assignment{Name: "lab1"}, buildInfo: imagePull, retries: 0, want: false},
+		{name: "compile error", assignment: assignment, buildInfo: compileError, retries: 0, want: false},
+		{name: "timeout", assignment: assignment, buildInfo: timeout, retries: 0, want: false},
+		{name: "passing", assignment: assignment, buildInfo: passing, retries: 0, want: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := shouldRetryOnInfra(test.assignment, test.buildInfo, test.retries); got != test.want {
+				t.Errorf("shouldRetryOnInfra(%v, %d) = %t, want %t", test.buildInfo.FailureReason(), test.retries, got, test.want)
+			}
+		})
+	}
+}
+
+func TestPreviousResults(t *testing.T) {
+	newest := &pb.Submission{
+		Scores: []*score.Score{
+			{TestName: "TestA", Score: 8, MaxScore: 10, Weight: 1, Delta: 3},
+			{TestName: "TestB", Score: 2, MaxScore: 10, Weight: 1, Delta: -4},
+		},
+	}
+	tests := []struct {
+		name    string
+		rebuild bool
+		want    []int32
+	}{
+		{name: "new push", rebuild: false, want: []int32{8, 2}},
+		{name: "rebuild", rebuild: true, want: []int32{5, 6}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			prev := previousResults(newest, test.rebuild)
+			var got []int32
+			for _, sc := range prev.Scores {
+				got = append(got, sc.GetScore())
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("previousResults() scores mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+	if prev := previousResults(nil, false); len(prev.Scores) != 0 {
+		t.Errorf("previousResults(nil) = %v, want no scores", prev.Scores)
+	}
 }
@@ -0,0 +1,233 @@
+package ci
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+)
+
+// sandboxArg0 is the name under which jobCommand starts the running program
+// to set up the sandbox of a job before running the job's script.
+const sandboxArg0 = "quickfeed-ci-sandbox"
+
+// systemDirs are the directories holding the programs and libraries used by the
+// scripts of jobs run locally, which are visible, read-only, inside the sandbox.
+var systemDirs = []string{"/bin", "/sbin", "/lib", "/lib32", "/lib64", "/libx32", "/usr", "/etc", "/opt"}
+
+// devices are the device files visible inside the sandbox.
+var devices = []string{"/dev/null", "/dev/zero", "/dev/full", "/dev/random", "/dev/urandom"}
+
+func init() {
+	if len(os.Args) == 4 && os.Args[0] == sandboxArg0 {
+		runSandbox(os.Args[1], os.Args[2], os.Args[3])
+	}
+}
+
+// runSandbox sets up the sandbox of a job, rooted at the given root directory,
+// and replaces the running program by the shell running the given script in the
+// given job directory. Errors setting up the sandbox are reported to jobCommand's
+// startJob on file descriptor 3, which is closed when the script starts.
+func runSandbox(root, dir, script string) {
+	errs := os.NewFile(3, "sandbox errors")
+	if err := setupSandbox(root, dir); err != nil {
+		fmt.Fprintf(errs, "failed to set up sandbox: %v", err)
+		os.Exit(1)
+	}
+	syscall.CloseOnExec(3)
+	err := syscall.Exec("/bin/sh", []string{"/bin/sh", "-c", script}, os.Environ())
+	fmt.Fprintf(errs, "failed to run script: %v", err)
+	os.Exit(1)
+}
+
+// setupSandbox replaces the file system seen by the running program by a file system
+// rooted at the given root directory, holding the system directories, the directories
+// in PATH, and a few device files, all read-only, along with the job directory and a
+// /tmp directory, which are writable. The /tmp directory is the tmp directory of the
+// root directory, such that files written to /tmp remain available after the job.
+// The running program must be in its own user, mount and PID namespaces.
+func setupSandbox(root, dir string) error {
+	// keep the mounts below from propagating to the host's mount namespace
+	if err := syscall.Mount("", "/", "", syscall.MS_REC|syscall.MS_PRIVATE, ""); err != nil {
+		return fmt.Errorf("make mounts private: %w", err)
+	}
+	// the new root must be a mount point
+	if err := bind(root, root); err != nil {
+		return err
+	}
+	var bound []string
+	for _, path := range append(systemDirs, filepath.SplitList(os.Getenv("PATH"))...) {
+		if !filepath.IsAbs(path) || within(path, bound) {
+			continue
+		}
+		if err := bindReadOnly(root, path); err != nil {
+			return err
+		}
+		bound = append(bound, path)
+	}
+	// the resolver configuration may be a link to a file outside /etc
+	if resolvConf, err := filepath.EvalSymlinks("/etc/resolv.conf"); err == nil && !within(resolvConf, bound) {
+		if err := bindReadOnly(root, resolvConf); err != nil {
+			return err
+		}
+	}
+	for _, device := range devices {
+		if err := bindDevice(root, device); err != nil {
+			return err
+		}
+	}
+	for name, target := range map[string]string{"fd": "/proc/self/fd", "stdin": "/proc/self/fd/0", "stdout": "/proc/self/fd/1", "stderr": "/proc/self/fd/2"} {
+		if err := os.Symlink(target, filepath.Join(root, "dev", name)); err != nil {
+			return err
+		}
+	}
+	procDir := filepath.Join(root, "proc")
+	if err := os.Mkdir(procDir, 0o555); err != nil {
+		return err
+	}
+	// without access to the processes of this machine, only those of the job
+	// are listed; if proc cannot be mounted, e.g., in a container, it is left out
+	_ = syscall.Mount("proc", procDir, "proc", syscall.MS_NOSUID|syscall.MS_NODEV|syscall.MS_NOEXEC, "")
+
+	tmpDir := filepath.Join(root, "tmp")
+	if err := os.Mkdir(tmpDir, 0o700); err != nil {
+		return err
+	}
+	if err := bind(tmpDir, tmpDir); err != nil {
+		return err
+	}
+	jobDir := filepath.Join(root, dir)
+	if err := os.MkdirAll(jobDir, 0o700); err != nil {
+		return err
+	}
+	if err := bind(dir, jobDir); err != nil {
+		return err
+	}
+	if err := remountReadOnly(root); err != nil {
+		return err
+	}
+
+	// replace the root, and detach the host's file system stacked on top of it
+	if err := os.Chdir(root); err != nil {
+		return err
+	}
+	if err := syscall.PivotRoot(".", "."); err != nil {
+		return fmt.Errorf("pivot root: %w", err)
+	}
+	if err := syscall.Unmount(".", syscall.MNT_DETACH); err != nil {
+		return fmt.Errorf("unmount host file system: %w", err)
+	}
+	return os.Chdir(dir)
+}
+
+// within returns true if the given path is one of the given directories, or below one of them.
+func within(path string, dirs []string) bool {
+	for _, dir := range dirs {
+		if path == dir || strings.HasPrefix(path, dir+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// bind mounts the given source file or directory at the given target.
+func bind(source, target string) error {
+	if err := syscall.Mount(source, target, "", syscall.MS_BIND|syscall.MS_REC, ""); err != nil {
+		return fmt.Errorf("bind %s: %w", source, err)
+	}
+	return nil
+}
+
+// bindReadOnly mounts the given file or directory, read-only, at the same path below
+// the given root directory. Links in the root directory, such as /bin linking to
+// usr/bin, are recreated below the root directory. Missing paths are left out.
+func bindReadOnly(root, path string) error {
+	info, err := os.Lstat(path)
+	if err != nil {
+		return nil
+	}
+	target := filepath.Join(root, path)
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return err
+	}
+	if info.Mode()&os.ModeSymlink != 0 && filepath.Dir(path) == "/" {
+		link, err := os.Readlink(path)
+		if err != nil {
+			return err
+		}
+		return os.Symlink(link, target)
+	}
+	if err := createMountPoint(path, target); err != nil {
+		return err
+	}
+	if err := bind(path, target); err != nil {
+		return err
+	}
+	return remountReadOnly(target)
+}
+
+// bindDevice mounts the given device file at the same path below the given root directory.
+func bindDevice(root, device string) error {
+	target := filepath.Join(root, device)
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return err
+	}
+	if err := createMountPoint(device, target); err != nil {
+		return err
+	}
+	return bind(device, target)
+}
+
+// createMountPoint creates the target on which the given path is to be mounted:
+// a directory if the path is a directory, and an empty file otherwise.
+func createMountPoint(path, target string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if info.IsDir() {
+		return os.MkdirAll(target, 0o755)
+	}
+	f, err := os.OpenFile(target, os.O_CREATE, 0o644)
+	if err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+// remountReadOnly makes the mount at the given path read-only. The mount's other flags
+// are kept, since a mount inherited from another user namespace cannot drop them.
+func remountReadOnly(path string) error {
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(path, &st); err != nil {
+		return err
+	}
+	flags := uintptr(syscall.MS_REMOUNT | syscall.MS_BIND | syscall.MS_RDONLY)
+	for stFlag, msFlag := range map[int64]uintptr{
+		stNoSuid:     syscall.MS_NOSUID,
+		stNoDev:      syscall.MS_NODEV,
+		stNoExec:     syscall.MS_NOEXEC,
+		stNoAtime:    syscall.MS_NOATIME,
+		stNoDirAtime: syscall.MS_NODIRATIME,
+		stRelatime:   syscall.MS_RELATIME,
+	} {
+		if int64(st.Flags)&stFlag != 0 {
+			flags |= msFlag
+		}
+	}
+	if err := syscall.Mount("", path, "", flags, ""); err != nil {
+		return fmt.Errorf("remount %s read-only: %w", path, err)
+	}
+	return nil
+}
+
+// The mount flags reported by statfs, which are not defined by package syscall.
+const (
+	stNoSuid     = 0x2
+	stNoDev      = 0x4
+	stNoExec     = 0x8
+	stNoAtime    = 0x400
+	stNoDirAtime = 0x800
+	stRelatime   = 0x1000
+)
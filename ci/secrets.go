@@ -0,0 +1,76 @@
+package ci
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/kit/score"
+)
+
+// redacted replaces the values of secrets in the test output.
+const redacted = "[REDACTED]"
+
+// testSecrets returns the course secrets given to the tests of the run's assignment,
+// keyed by the environment variable holding each secret. An error is returned if
+// a secret is not found among the course's secrets.
+func testSecrets(db database.Database, rData *RunData) (map[string]string, error) {
+	variables := rData.Assignment.SecretVariables()
+	if len(variables) == 0 {
+		return nil, nil
+	}
+	values, err := db.GetCourseSecrets(rData.Course.GetID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get secrets for course %s: %w", rData.Course.GetCode(), err)
+	}
+	secrets := make(map[string]string, len(variables))
+	for variable, name := range variables {
+		value, ok := values[name]
+		if !ok {
+			return nil, fmt.Errorf("secret %s for assignment %s not found in course %s", name, rData.Assignment.GetName(), rData.Course.GetCode())
+		}
+		secrets[variable] = value
+	}
+	return secrets, nil
+}
+
+// secretEnv returns the given secrets as environment variables, sorted by name.
+func secretEnv(secrets map[string]string) []string {
+	env := make([]string, 0, len(secrets))
+	for variable, value := range secrets {
+		env = append(env, variable+"="+value)
+	}
+	sort.Strings(env)
+	return env
+}
+
+// redactSecrets returns the given test output with the values of the given secrets replaced,
+// such that tests printing their environment do not reveal the secrets to students.
+func redactSecrets(out string, secrets map[string]string) string {
+	for _, value := range secrets {
+		if value != "" {
+			out = strings.ReplaceAll(out, value, redacted)
+		}
+	}
+	return out
+}
+
+// leakedSecretMsg is appended to the build log of a test run whose output revealed the session secret.
+const leakedSecretMsg = "The test output revealed the session secret; all tests have failed."
+
+// failLeakedRun fails every test of the given results if the test output revealed the
+// given session secret, since the student's code may then have forged score objects.
+// The secret is redacted from the build log and the tests' details, such that it is
+// not shown to students. Returns true if the secret was leaked.
+func failLeakedRun(results *score.Results, secret string) bool {
+	if !results.SecretLeaked(secret) {
+		return false
+	}
+	results.BuildInfo.BuildLog = strings.ReplaceAll(results.BuildInfo.GetBuildLog(), secret, redacted) + "\n" + leakedSecretMsg
+	for _, sc := range results.Scores {
+		sc.Score = 0
+		sc.TestDetails = strings.ReplaceAll(sc.GetTestDetails(), secret, redacted)
+	}
+	return true
+}
@@ -0,0 +1,87 @@
+package ci
+
+import (
+	"strings"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/kit/score"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTestSecrets(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	course := &pb.Course{}
+	admin := qtest.CreateFakeUser(t, db, 1)
+	qtest.CreateCourse(t, db, admin, course)
+	if err := db.UpdateCourseSecret(&pb.CourseSecret{CourseID: course.ID, Name: "apikey", Value: "s3cr3t"}); err != nil {
+		t.Fatal(err)
+	}
+	runData := func(secrets string) *RunData {
+		return &RunData{
+			Course:     course,
+			Assignment: &pb.Assignment{CourseID: course.ID, Name: "lab1", Secrets: secrets},
+		}
+	}
+
+	secrets, err := testSecrets(db, runData(""))
+	if err != nil || secrets != nil {
+		t.Errorf("testSecrets() = %v, %v, want no secrets", secrets, err)
+	}
+	secrets, err = testSecrets(db, runData("API_KEY=apikey"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(map[string]string{"API_KEY": "s3cr3t"}, secrets); diff != "" {
+		t.Errorf("testSecrets() mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"API_KEY=s3cr3t"}, secretEnv(secrets)); diff != "" {
+		t.Errorf("secretEnv() mismatch (-want +got):\n%s", diff)
+	}
+	if _, err := testSecrets(db, runData("API_KEY=apikey,TOKEN=token")); err == nil {
+		t.Error("testSecrets() succeeded for unknown secret, want error")
+	}
+}
+
+func TestRedactSecrets(t *testing.T) {
+	secrets := map[string]string{"API_KEY": "s3cr3t", "EMPTY": ""}
+	out := "API_KEY=s3cr3t\nconnecting with s3cr3t\nEMPTY=\n"
+	want := "API_KEY=[REDACTED]\nconnecting with [REDACTED]\nEMPTY=\n"
+	if got := redactSecrets(out, secrets); got != want {
+		t.Errorf("redactSecrets() = %q, want %q", got, want)
+	}
+}
+
+func TestFailLeakedRun(t *testing.T) {
+	const secret = "sessionsecret"
+	results := &score.Results{
+		BuildInfo: &score.BuildInfo{BuildLog: "env: QUICKFEED_SESSION_SECRET=sessionsecret"},
+		Scores: []*score.Score{
+			{TestName: "TestA", Score: 10, MaxScore: 10, TestDetails: "printed sessionsecret"},
+			{TestName: "TestB", Score: 5, MaxScore: 10},
+		},
+	}
+	if !failLeakedRun(results, secret) {
+		t.Fatal("failLeakedRun() = false, want true")
+	}
+	wantLog := "env: QUICKFEED_SESSION_SECRET=[REDACTED]\n" + leakedSecretMsg
+	if got := results.BuildInfo.GetBuildLog(); got != wantLog {
+		t.Errorf("build log = %q, want %q", got, wantLog)
+	}
+	for _, sc := range results.Scores {
+		if sc.GetScore() != 0 || strings.Contains(sc.GetTestDetails(), secret) {
+			t.Errorf("%s: score = %d, details = %q, want failed test without the secret", sc.GetTestName(), sc.GetScore(), sc.GetTestDetails())
+		}
+	}
+
+	clean := &score.Results{
+		BuildInfo: &score.BuildInfo{BuildLog: "ok"},
+		Scores:    []*score.Score{{TestName: "TestA", Score: 10, MaxScore: 10}},
+	}
+	if failLeakedRun(clean, secret) || clean.Scores[0].GetScore() != 10 {
+		t.Errorf("failLeakedRun() failed the tests of a run that did not reveal the secret")
+	}
+}
@@ -0,0 +1,54 @@
+package ci
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+func TestTestSeed(t *testing.T) {
+	runData := func(assignmentID, userID, groupID uint64) *RunData {
+		return &RunData{
+			Course:     &pb.Course{ID: 1},
+			Assignment: &pb.Assignment{ID: assignmentID},
+			Repo:       &pb.Repository{UserID: userID, GroupID: groupID},
+		}
+	}
+	seed := testSeed(runData(1, 1, 0))
+	if seed == 0 || seed > math.MaxInt64 {
+		t.Errorf("testSeed() = %d, want a non-zero int64", seed)
+	}
+	if again := testSeed(runData(1, 1, 0)); again != seed {
+		t.Errorf("testSeed() = %d for the same student, want %d", again, seed)
+	}
+	seeds := map[uint64]bool{seed: true}
+	for _, rData := range []*RunData{runData(1, 2, 0), runData(2, 1, 0), runData(1, 0, 1)} {
+		other := testSeed(rData)
+		if seeds[other] {
+			t.Errorf("testSeed(%v) = %d, want a seed different from the other runs", rData.Repo, other)
+		}
+		seeds[other] = true
+	}
+}
+
+func TestParseDefaultScriptSeed(t *testing.T) {
+	assignment := &pb.Assignment{Name: "lab1", Language: "go"}
+	info := newAssignmentInfo(&pb.Course{}, assignment, "cloneURL", "testURL")
+	j, err := parseScriptTemplate(info)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if script := strings.Join(j.Commands, "\n"); strings.Contains(script, "QUICKFEED_SEED") {
+		t.Errorf("script sets QUICKFEED_SEED without a seed:\n%s", script)
+	}
+	info.Seed = 42
+	j, err = parseScriptTemplate(info)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if script := strings.Join(j.Commands, "\n"); !strings.Contains(script, "QUICKFEED_SEED=42 ") {
+		t.Errorf("script does not set QUICKFEED_SEED=42:\n%s", script)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"strings"
 
+	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/kit/score"
 )
 
@@ -13,6 +14,55 @@ const truncateMsg = `
 
 `
 
+// Bounds on an assignment's output limit, in bytes.
+const (
+	MinOutputLimit = 1_000
+	MaxOutputLimit = 1_000_000
+)
+
+// outputLimit returns the maximum size in bytes of the test output
+// to keep for the given assignment.
+func outputLimit(a *pb.Assignment) int {
+	if limit := a.GetOutputLimit(); limit > 0 {
+		return int(limit)
+	}
+	return maxLogSize
+}
+
+// jobOutput returns the given output of a completed job, truncated at the job's output limit,
+// followed by a message describing the resource limit exceeded by the job, if any, which is
+// also returned separately.
+func jobOutput(job *Job, stdout *bytes.Buffer, oomKilled bool) (out, violation string) {
+	limit := job.OutputLimit
+	if limit == 0 {
+		limit = maxLogSize
+	}
+	// look for limit violations in the full output, before truncating it
+	out = stdout.String()
+	violation = limitViolation(job.Limits, out, oomKilled)
+	if stdout.Len() > limit+lastSegmentSize {
+		out = truncateLog(stdout, limit, lastSegmentSize, maxToScan)
+	}
+	if violation != "" {
+		out += "\n" + violation + "\n"
+	}
+	return out, violation
+}
+
+// truncateTestDetails truncates the test details of each score object that
+// exceed the given limit, at the nearest line before the limit, such that
+// a test printing excessive output does not bloat the stored results.
+func truncateTestDetails(results *score.Results, limit int) {
+	for _, sc := range results.Scores {
+		details := sc.GetTestDetails()
+		if len(details) <= limit {
+			continue
+		}
+		keep := strings.LastIndex(details[:limit], "\n") + 1
+		sc.TestDetails = details[:keep] + strings.TrimLeft(truncateMsg, "\n")
+	}
+}
+
 // truncateLog returns the log output truncated at the nearest line before the truncate point.
 // The returned log includes score lines found in the middle segment unless the middle segment's size exceeds max.
 // The returned log also includes the last segment of size given by last.
@@ -7,6 +7,8 @@ import (
 	"strings"
 	"testing"
 
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/kit/score"
 	"github.com/google/go-cmp/cmp"
 )
 
@@ -67,3 +69,28 @@ func TestLogTruncate(t *testing.T) {
 		}
 	}
 }
+
+func TestTruncateTestDetails(t *testing.T) {
+	line := strings.Repeat("x", 99) + "\n"
+	tests := []struct {
+		name       string
+		assignment *pb.Assignment
+		details    string
+		want       string
+	}{
+		{name: "default limit, short", assignment: &pb.Assignment{}, details: line, want: line},
+		{name: "default limit, long", assignment: &pb.Assignment{}, details: strings.Repeat(line, 400), want: strings.Repeat(line, 300) + "... truncated output ...\n\n"},
+		{name: "assignment limit, short", assignment: &pb.Assignment{OutputLimit: 1_000}, details: strings.Repeat(line, 10), want: strings.Repeat(line, 10)},
+		{name: "assignment limit, long", assignment: &pb.Assignment{OutputLimit: 1_000}, details: strings.Repeat(line, 11), want: strings.Repeat(line, 10) + "... truncated output ...\n\n"},
+		{name: "assignment limit, mid-line", assignment: &pb.Assignment{OutputLimit: 1_050}, details: strings.Repeat(line, 11), want: strings.Repeat(line, 10) + "... truncated output ...\n\n"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			results := score.NewResults(&score.Score{TestName: "TestVerbose", Score: 1, MaxScore: 1, Weight: 1, TestDetails: test.details})
+			truncateTestDetails(results, outputLimit(test.assignment))
+			if diff := cmp.Diff(test.want, results.Scores[0].GetTestDetails()); diff != "" {
+				t.Errorf("truncateTestDetails() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -46,6 +46,10 @@ type Database interface {
 	GetCoursesByUser(userID uint64, statuses ...pb.Enrollment_UserStatus) ([]*pb.Course, error)
 	// UpdateCourse updates course information.
 	UpdateCourse(*pb.Course) error
+	// UpdateCourseSecret creates or updates the given course secret; a secret without a value is deleted.
+	UpdateCourseSecret(*pb.CourseSecret) error
+	// GetCourseSecrets returns the secrets of the given course, keyed by name.
+	GetCourseSecrets(courseID uint64) (map[string]string, error)
 
 	// CreateEnrollment creates a new pending enrollment.
 	CreateEnrollment(*pb.Enrollment) error
@@ -70,6 +74,9 @@ type Database interface {
 	DeleteGroup(uint64) error
 	// GetGroup returns the group with the specified group ID.
 	GetGroup(uint64) (*pb.Group, error)
+	// GetGroupUsers returns the users that are members of the group with the specified group ID,
+	// including members that are no longer enrolled in the group's course.
+	GetGroupUsers(groupID uint64) ([]*pb.User, error)
 	// GetGroupsByCourse returns the groups for the given course.
 	GetGroupsByCourse(courseID uint64, statuses ...pb.Group_GroupStatus) ([]*pb.Group, error)
 
@@ -81,6 +88,8 @@ type Database interface {
 	GetAssignmentsByCourse(uint64, bool) ([]*pb.Assignment, error)
 	// UpdateAssignments updates the specified list of assignments.
 	UpdateAssignments([]*pb.Assignment) error
+	// DeleteAssignment deletes the given assignment, unless it has submissions.
+	DeleteAssignment(*pb.Assignment) error
 	// CreateBenchmark creates a new grading benchmark.
 	CreateBenchmark(*pb.GradingBenchmark) error
 	// UpdateBenchmark updates the given benchmark.
@@ -93,6 +102,9 @@ type Database interface {
 	UpdateCriterion(*pb.GradingCriterion) error
 	// DeleteCriterion deletes the given criterion.
 	DeleteCriterion(*pb.GradingCriterion) error
+	// UpdateGradingCriteria replaces the grading benchmarks of the given assignment,
+	// keeping the benchmarks and criteria with matching IDs.
+	UpdateGradingCriteria(assignmentID uint64, benchmarks []*pb.GradingBenchmark) error
 
 	// CreateSubmission creates a new submission record or updates the most
 	// recent submission, as defined by the provided submissionQuery.
@@ -111,6 +123,30 @@ type Database interface {
 	UpdateSubmission(*pb.Submission) error
 	// UpdateSubmissions releases and/or approves all submissions with a certain score
 	UpdateSubmissions(uint64, *pb.Submission) error
+	// CountRerun records a re-run of the tests of the given submission,
+	// unless the submission has been re-run the given maximum number of times.
+	CountRerun(submissionID uint64, maxReruns uint32) error
+	// UpdateBuildLog replaces the build log of the given submission.
+	UpdateBuildLog(submissionID uint64, buildLog string) error
+	// UpdateArtifacts replaces the artifacts of the given submission.
+	UpdateArtifacts(submissionID uint64, artifacts []*pb.Artifact) error
+	// GetArtifacts returns the artifacts of the given submission, without their contents.
+	GetArtifacts(submissionID uint64) ([]*pb.Artifact, error)
+	// GetArtifact returns the artifact of the given submission with the given name.
+	GetArtifact(submissionID uint64, name string) (*pb.Artifact, error)
+	// UpdateDeadlineExtension creates or updates the deadline extension
+	// of the given student or group for the given assignment.
+	UpdateDeadlineExtension(*pb.DeadlineExtension) error
+	// GetDeadlineExtension returns the deadline extension matching the given query.
+	GetDeadlineExtension(query *pb.DeadlineExtension) (*pb.DeadlineExtension, error)
+	// UpdateIssue creates or updates the issue describing a task in a repository.
+	UpdateIssue(*pb.Issue) error
+	// GetIssues returns the issues describing tasks in the given repository.
+	GetIssues(repositoryID uint64) ([]*pb.Issue, error)
+	// UpdateCourseModules replaces the modules of the given course.
+	UpdateCourseModules(courseID uint64, modules []*pb.CourseModule) error
+	// GetCourseModules returns the modules of the given course.
+	GetCourseModules(courseID uint64) ([]*pb.CourseModule, error)
 	// CreateReview adds a new submission review.
 	CreateReview(*pb.Review) error
 	// UpdateReview updates the given review.
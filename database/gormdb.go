@@ -57,7 +57,17 @@ func NewGormDB(path string, logger *zap.Logger) (*GormDB, error) {
 		&pb.Course{},
 		&pb.Enrollment{},
 		&pb.Assignment{},
+		&pb.TestConfig{},
+		&pb.AnalysisCheck{},
+		&pb.Task{},
+		&pb.Issue{},
 		&pb.Submission{},
+		&pb.Annotation{},
+		&pb.MatrixResult{},
+		&pb.DeadlineExtension{},
+		&pb.CourseModule{},
+		&pb.CourseSecret{},
+		&pb.Artifact{},
 		&pb.Group{},
 		&pb.Repository{},
 		&pb.UsedSlipDays{},
@@ -0,0 +1,42 @@
+package database
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+	"gorm.io/gorm"
+)
+
+// UpdateArtifacts replaces the recorded artifacts of the given submission.
+func (db *GormDB) UpdateArtifacts(submissionID uint64, artifacts []*pb.Artifact) error {
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("submission_id = ?", submissionID).Delete(&pb.Artifact{}).Error; err != nil {
+			return err
+		}
+		if len(artifacts) == 0 {
+			return nil
+		}
+		for _, artifact := range artifacts {
+			artifact.ID = 0
+			artifact.SubmissionID = submissionID
+		}
+		return tx.Create(artifacts).Error
+	})
+}
+
+// GetArtifacts returns the artifacts of the given submission ordered by name,
+// without their contents.
+func (db *GormDB) GetArtifacts(submissionID uint64) ([]*pb.Artifact, error) {
+	var artifacts []*pb.Artifact
+	if err := db.conn.Omit("content").Where("submission_id = ?", submissionID).Order("name").Find(&artifacts).Error; err != nil {
+		return nil, err
+	}
+	return artifacts, nil
+}
+
+// GetArtifact returns the artifact of the given submission with the given name.
+func (db *GormDB) GetArtifact(submissionID uint64, name string) (*pb.Artifact, error) {
+	var artifact pb.Artifact
+	if err := db.conn.Where("submission_id = ? AND name = ?", submissionID, name).First(&artifact).Error; err != nil {
+		return nil, err
+	}
+	return &artifact, nil
+}
@@ -0,0 +1,75 @@
+package database_test
+
+import (
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestGormDBUpdateArtifacts(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{}
+	qtest.CreateCourse(t, db, admin, course)
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	submission := &pb.Submission{AssignmentID: assignment.ID, UserID: admin.ID}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.UpdateArtifacts(submission.ID, []*pb.Artifact{
+		{Name: "old.txt", Size: 3, Content: []byte("old")},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	// updating the artifacts replaces the previous ones
+	if err := db.UpdateArtifacts(submission.ID, []*pb.Artifact{
+		{Name: "plots/loss.png", Size: 3, Content: []byte("png")},
+		{Name: "coverage.html", Size: 4, Content: []byte("html")},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// artifacts are listed by name, without their contents
+	got, err := db.GetArtifacts(submission.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*pb.Artifact{
+		{SubmissionID: submission.ID, Name: "coverage.html", Size: 4},
+		{SubmissionID: submission.ID, Name: "plots/loss.png", Size: 3},
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform(), protocmp.IgnoreFields(&pb.Artifact{}, "ID")); diff != "" {
+		t.Errorf("GetArtifacts() mismatch (-want +got):\n%s", diff)
+	}
+
+	artifact, err := db.GetArtifact(submission.ID, "plots/loss.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(artifact.GetContent()) != "png" {
+		t.Errorf("GetArtifact() content = %q, want %q", artifact.GetContent(), "png")
+	}
+	if _, err := db.GetArtifact(submission.ID, "old.txt"); err == nil {
+		t.Error("GetArtifact() returned a replaced artifact")
+	}
+
+	if err := db.UpdateArtifacts(submission.ID, nil); err != nil {
+		t.Fatal(err)
+	}
+	got, err = db.GetArtifacts(submission.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 0 {
+		t.Errorf("GetArtifacts() = %v, want none", got)
+	}
+}
@@ -1,10 +1,15 @@
 package database
 
 import (
+	"errors"
+
 	pb "github.com/autograde/quickfeed/ag"
 	"gorm.io/gorm"
 )
 
+// ErrAssignmentHasSubmissions is returned when deleting an assignment with submissions.
+var ErrAssignmentHasSubmissions = errors.New("cannot delete assignment with submissions")
+
 /// Assignments ///
 
 // CreateAssignment creates a new assignment record.
@@ -24,28 +29,143 @@ func (db *GormDB) CreateAssignment(assignment *pb.Assignment) error {
 		return gorm.ErrRecordNotFound
 	}
 
-	return db.conn.
+	// FirstOrCreate overwrites the dates with the stored ones, since the
+	// updated values in the map of column values bypass the serializer
+	deadlineTime, releaseDate, closeDate := assignment.DeadlineTime, assignment.ReleaseDate, assignment.CloseDate
+	if err := db.conn.
+		Omit("Tests", "Tasks").
 		Where(pb.Assignment{
 			CourseID: assignment.CourseID,
 			Order:    assignment.Order,
 		}).
 		Assign(map[string]interface{}{
-			"name":              assignment.Name,
-			"order":             assignment.Order,
-			"script_file":       assignment.ScriptFile,
-			"deadline":          assignment.Deadline,
-			"auto_approve":      assignment.AutoApprove,
-			"score_limit":       assignment.ScoreLimit,
-			"is_group_lab":      assignment.IsGroupLab,
-			"reviewers":         assignment.Reviewers,
-			"container_timeout": assignment.ContainerTimeout,
-		}).FirstOrCreate(assignment).Error
+			"name":                    assignment.Name,
+			"order":                   assignment.Order,
+			"script_file":             assignment.ScriptFile,
+			"deadline":                assignment.Deadline,
+			"auto_approve":            assignment.AutoApprove,
+			"score_limit":             assignment.ScoreLimit,
+			"is_group_lab":            assignment.IsGroupLab,
+			"reviewers":               assignment.Reviewers,
+			"container_timeout":       assignment.ContainerTimeout,
+			"part_of":                 assignment.PartOf,
+			"language":                assignment.Language,
+			"verbose":                 assignment.Verbose,
+			"retries":                 assignment.Retries,
+			"tests_repo_url":          assignment.TestsRepoURL,
+			"tests_repo_ref":          assignment.TestsRepoRef,
+			"manual_only":             assignment.ManualOnly,
+			"release_date":            timestampValue(assignment.ReleaseDate),
+			"close_date":              timestampValue(assignment.CloseDate),
+			"extra_credit_cap":        assignment.ExtraCreditCap,
+			"hide_points":             assignment.HidePoints,
+			"required_files":          assignment.RequiredFiles,
+			"reviewer_strategy":       assignment.ReviewerStrategy,
+			"mute_notifications":      assignment.MuteNotifications,
+			"plagiarism_check":        assignment.PlagiarismCheck,
+			"similarity_threshold":    assignment.SimilarityThreshold,
+			"network":                 assignment.Network,
+			"course_weight":           assignment.CourseWeight,
+			"max_parallel":            assignment.MaxParallel,
+			"diff_mode":               assignment.DiffMode,
+			"late_penalty":            assignment.LatePenalty,
+			"output_limit":            assignment.OutputLimit,
+			"retry_on_infra":          assignment.RetryOnInfra,
+			"grace_hours":             assignment.GraceHours,
+			"time_zone":               assignment.TimeZone,
+			"deadline_time":           timestampValue(assignment.DeadlineTime),
+			"dockerfile":              assignment.Dockerfile,
+			"docker_image":            assignment.DockerImage,
+			"requires":                assignment.Requires,
+			"max_attempts":            assignment.MaxAttempts,
+			"cooldown_minutes":        assignment.CooldownMinutes,
+			"random_seed":             assignment.RandomSeed,
+			"secrets":                 assignment.Secrets,
+			"approval_runs":           assignment.ApprovalRuns,
+			"approve_before_deadline": assignment.ApproveBeforeDeadline,
+			"manual_review":           assignment.ManualReview,
+			"cpu_limit":               assignment.CpuLimit,
+			"memory_limit":            assignment.MemoryLimit,
+			"pids_limit":              assignment.PidsLimit,
+			"disk_limit":              assignment.DiskLimit,
+			"allowed_hosts":           assignment.AllowedHosts,
+			"max_reruns":              assignment.MaxReruns,
+			"coverage_profile":        assignment.CoverageProfile,
+			"coverage_weight":         assignment.CoverageWeight,
+			"coverage_target":         assignment.CoverageTarget,
+			"matrix":                  assignment.Matrix,
+			"test_format":             assignment.TestFormat,
+		}).FirstOrCreate(assignment).Error; err != nil {
+		return err
+	}
+	assignment.DeadlineTime, assignment.ReleaseDate, assignment.CloseDate = deadlineTime, releaseDate, closeDate
+	if err := db.updateTestConfigs(assignment); err != nil {
+		return err
+	}
+	if err := db.updateAnalysisChecks(assignment); err != nil {
+		return err
+	}
+	return db.updateTasks(assignment)
+}
+
+// updateTestConfigs replaces the recorded test configurations for the given assignment.
+func (db *GormDB) updateTestConfigs(assignment *pb.Assignment) error {
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("assignment_id = ?", assignment.GetID()).Delete(&pb.TestConfig{}).Error; err != nil {
+			return err
+		}
+		if len(assignment.GetTests()) == 0 {
+			return nil
+		}
+		for _, test := range assignment.GetTests() {
+			test.ID = 0
+			test.AssignmentID = assignment.GetID()
+		}
+		return tx.Create(assignment.GetTests()).Error
+	})
+}
+
+// updateAnalysisChecks replaces the recorded static analysis checks for the given assignment.
+func (db *GormDB) updateAnalysisChecks(assignment *pb.Assignment) error {
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("assignment_id = ?", assignment.GetID()).Delete(&pb.AnalysisCheck{}).Error; err != nil {
+			return err
+		}
+		if len(assignment.GetAnalysisChecks()) == 0 {
+			return nil
+		}
+		for _, check := range assignment.GetAnalysisChecks() {
+			check.ID = 0
+			check.AssignmentID = assignment.GetID()
+		}
+		return tx.Create(assignment.GetAnalysisChecks()).Error
+	})
+}
+
+// updateTasks replaces the recorded tasks for the given assignment.
+func (db *GormDB) updateTasks(assignment *pb.Assignment) error {
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("assignment_id = ?", assignment.GetID()).Delete(&pb.Task{}).Error; err != nil {
+			return err
+		}
+		if len(assignment.GetTasks()) == 0 {
+			return nil
+		}
+		for _, task := range assignment.GetTasks() {
+			task.ID = 0
+			task.AssignmentID = assignment.GetID()
+		}
+		return tx.Create(assignment.GetTasks()).Error
+	})
 }
 
 // GetAssignment returns assignment with the given ID.
 func (db *GormDB) GetAssignment(query *pb.Assignment) (*pb.Assignment, error) {
 	var assignment pb.Assignment
 	if err := db.conn.Where(query).
+		Preload("Tests").
+		Preload("AnalysisChecks").
+		Preload("Tasks").
 		Preload("GradingBenchmarks").
 		Preload("GradingBenchmarks.Criteria").
 		First(&assignment).Error; err != nil {
@@ -95,6 +215,31 @@ func (db *GormDB) UpdateAssignments(assignments []*pb.Assignment) error {
 	return nil
 }
 
+// DeleteAssignment deletes the given assignment along with its test configurations,
+// tasks, deadline extensions and grading benchmarks. Assignments with submissions
+// are not deleted, since that would also delete the students' results.
+func (db *GormDB) DeleteAssignment(assignment *pb.Assignment) error {
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		var submissions int64
+		if err := tx.Model(&pb.Submission{}).Where("assignment_id = ?", assignment.GetID()).Count(&submissions).Error; err != nil {
+			return err
+		}
+		if submissions > 0 {
+			return ErrAssignmentHasSubmissions
+		}
+		benchmarks := tx.Model(&pb.GradingBenchmark{}).Select("id").Where("assignment_id = ?", assignment.GetID())
+		if err := tx.Where("benchmark_id IN (?)", benchmarks).Delete(&pb.GradingCriterion{}).Error; err != nil {
+			return err
+		}
+		for _, model := range []interface{}{&pb.GradingBenchmark{}, &pb.TestConfig{}, &pb.Task{}, &pb.DeadlineExtension{}} {
+			if err := tx.Where("assignment_id = ?", assignment.GetID()).Delete(model).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Delete(&pb.Assignment{}, assignment.GetID()).Error
+	})
+}
+
 // GetAssignmentsWithSubmissions returns all course assignments
 // of requested type with preloaded submissions.
 func (db *GormDB) GetAssignmentsWithSubmissions(courseID uint64, submissionType pb.SubmissionsForCourseRequest_Type, withBuildInfo bool) ([]*pb.Assignment, error) {
@@ -104,9 +249,16 @@ func (db *GormDB) GetAssignmentsWithSubmissions(courseID uint64, submissionType
 		Preload("Submissions.Reviews").
 		Preload("Submissions.Reviews.GradingBenchmarks").
 		Preload("Submissions.Reviews.GradingBenchmarks.Criteria").
-		Preload("Submissions.Scores")
+		Preload("Submissions.Scores").
+		Preload("Submissions.Annotations")
 	if withBuildInfo {
-		m.Preload("Submissions.BuildInfo")
+		m.Preload("Submissions.BuildInfo").
+			Preload("Submissions.MatrixResults")
+	} else {
+		// the build matrix results without their build logs
+		m.Preload("Submissions.MatrixResults", func(tx *gorm.DB) *gorm.DB {
+			return tx.Omit("build_log")
+		})
 	}
 	if err := m.Where(&pb.Assignment{CourseID: courseID}).
 		Order("'order'").
@@ -166,6 +318,52 @@ func (db *GormDB) DeleteCriterion(query *pb.GradingCriterion) error {
 	return db.conn.Delete(query).Error
 }
 
+// UpdateGradingCriteria replaces the grading benchmarks of the given assignment with
+// the given benchmarks. Benchmarks and criteria with the ID of a stored benchmark or
+// criterion are updated in place, those without an ID are created, and the stored
+// benchmarks and criteria that are not among the given ones are deleted.
+// The benchmarks of reviews are not changed.
+func (db *GormDB) UpdateGradingCriteria(assignmentID uint64, benchmarks []*pb.GradingBenchmark) error {
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		keptBenchmarks := []uint64{0}
+		keptCriteria := []uint64{0}
+		for _, bm := range benchmarks {
+			if bm.GetID() > 0 {
+				keptBenchmarks = append(keptBenchmarks, bm.GetID())
+			}
+			for _, c := range bm.GetCriteria() {
+				if c.GetID() > 0 {
+					keptCriteria = append(keptCriteria, c.GetID())
+				}
+			}
+		}
+		stored := tx.Model(&pb.GradingBenchmark{}).Select("id").
+			Where("assignment_id = ? AND review_id = ?", assignmentID, 0)
+		if err := tx.Where("benchmark_id IN (?) AND id NOT IN (?)", stored, keptCriteria).
+			Delete(&pb.GradingCriterion{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("assignment_id = ? AND review_id = ? AND id NOT IN (?)", assignmentID, 0, keptBenchmarks).
+			Delete(&pb.GradingBenchmark{}).Error; err != nil {
+			return err
+		}
+		for _, bm := range benchmarks {
+			bm.AssignmentID = assignmentID
+			bm.ReviewID = 0
+			if err := tx.Omit("Criteria").Save(bm).Error; err != nil {
+				return err
+			}
+			for _, c := range bm.GetCriteria() {
+				c.BenchmarkID = bm.GetID()
+				if err := tx.Save(c).Error; err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
 // GetBenchmarks returns all benchmarks and associated criteria for a given assignment ID
 func (db *GormDB) GetBenchmarks(query *pb.Assignment) ([]*pb.GradingBenchmark, error) {
 	var benchmarks []*pb.GradingBenchmark
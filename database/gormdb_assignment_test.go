@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
 	"github.com/autograde/quickfeed/internal/qtest"
 	"github.com/autograde/quickfeed/kit/score"
 	"github.com/google/go-cmp/cmp"
@@ -158,6 +159,12 @@ func TestGetAssignmentsWithSubmissions(t *testing.T) {
 			{TestName: "TestBigNum", MaxScore: 100, Score: 60, Weight: 10},
 			{TestName: "TestDigNum", MaxScore: 100, Score: 70, Weight: 10},
 		},
+		Annotations: []*pb.Annotation{
+			{CheckName: "vet", File: "bignum.go", Line: 12, Message: "unreachable code"},
+		},
+		MatrixResults: []*pb.MatrixResult{
+			{Name: "go1.17", Image: "golang:1.17", Score: 42, BuildLog: "ok"},
+		},
 	}
 	if err := db.CreateSubmission(wantStruct); err != nil {
 		t.Fatal(err)
@@ -172,6 +179,16 @@ func TestGetAssignmentsWithSubmissions(t *testing.T) {
 		t.Errorf("GetAssignmentsWithSubmissions() mismatch (-want +got):\n%s", diff)
 	}
 
+	// without build info, the matrix results are loaded without their build logs
+	assignments, err = db.GetAssignmentsWithSubmissions(course.ID, pb.SubmissionsForCourseRequest_ALL, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	results := assignments[0].GetSubmissions()[0].GetMatrixResults()
+	if len(results) != 1 || results[0].GetScore() != 42 || results[0].GetBuildLog() != "" {
+		t.Errorf("GetAssignmentsWithSubmissions() without build info: matrix results = %v, want score 42 without build log", results)
+	}
+
 	// Submission with Review
 	wantReview := &pb.Submission{
 		AssignmentID: assignment.ID,
@@ -302,3 +319,233 @@ func TestUpdateBenchmarks(t *testing.T) {
 		}
 	}
 }
+
+func TestGormDBUpdateGradingCriteria(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	user, _, assignment := setupCourseAssignment(t, db)
+	initial := []*pb.GradingBenchmark{
+		{Heading: "Design", Criteria: []*pb.GradingCriterion{
+			{Description: "Clear", Points: 5},
+			{Description: "Tidy", Points: 5},
+		}},
+		{Heading: "Report", Criteria: []*pb.GradingCriterion{
+			{Description: "Explains"},
+		}},
+	}
+	if err := db.UpdateGradingCriteria(assignment.ID, initial); err != nil {
+		t.Fatal(err)
+	}
+	// a review holds its own copy of the benchmarks
+	submission := &pb.Submission{AssignmentID: assignment.ID, UserID: user.ID}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+	review := &pb.Review{SubmissionID: submission.ID, ReviewerID: user.ID, GradingBenchmarks: []*pb.GradingBenchmark{
+		{AssignmentID: assignment.ID, Heading: "Report", Criteria: []*pb.GradingCriterion{
+			{Description: "Explains", Grade: pb.GradingCriterion_PASSED},
+		}},
+	}}
+	if err := db.CreateReview(review); err != nil {
+		t.Fatal(err)
+	}
+
+	design := initial[0]
+	updated := []*pb.GradingBenchmark{
+		{ID: design.ID, Heading: "Design", Comment: "Updated", Criteria: []*pb.GradingCriterion{
+			{ID: design.Criteria[0].ID, Description: "Clear", Points: 0},
+			{Description: "Documented"},
+		}},
+		{Heading: "Testing", Criteria: []*pb.GradingCriterion{
+			{Description: "Covers edge cases"},
+		}},
+	}
+	if err := db.UpdateGradingCriteria(assignment.ID, updated); err != nil {
+		t.Fatal(err)
+	}
+	got, err := db.GetBenchmarks(assignment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(updated, got, protocmp.Transform()); diff != "" {
+		t.Errorf("GetBenchmarks() mismatch (-want +got):\n%s", diff)
+	}
+	if got[0].GetID() != design.GetID() || got[0].Criteria[0].GetID() != design.Criteria[0].GetID() {
+		t.Errorf("UpdateGradingCriteria() changed the IDs of benchmark %q", design.GetHeading())
+	}
+
+	gotSubmission, err := db.GetSubmission(&pb.Submission{ID: submission.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(gotSubmission.GetReviews()) != 1 {
+		t.Fatalf("GetSubmission() has %d reviews, want 1", len(gotSubmission.GetReviews()))
+	}
+	if diff := cmp.Diff(review.GradingBenchmarks, gotSubmission.GetReviews()[0].GetGradingBenchmarks(), protocmp.Transform()); diff != "" {
+		t.Errorf("review benchmarks mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestGormDBAssignmentTestConfigs(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 10)
+	qtest.CreateCourse(t, db, admin, &pb.Course{})
+
+	assignment := &pb.Assignment{
+		CourseID: 1,
+		Order:    1,
+		Retries:  2,
+		Tests: []*pb.TestConfig{
+			{TestName: "TestNetwork", Retryable: true},
+			{TestName: "TestDisk", Retryable: true},
+		},
+	}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	// update the assignment with a different set of tests
+	assignment = &pb.Assignment{
+		CourseID: 1,
+		Order:    1,
+		Retries:  1,
+		Tests: []*pb.TestConfig{
+			{TestName: "TestNetwork", Retryable: true},
+		},
+	}
+	if err := db.UpdateAssignments([]*pb.Assignment{assignment}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := db.GetAssignment(&pb.Assignment{ID: assignment.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.GetRetries() != 1 {
+		t.Errorf("Retries = %d, want %d", got.GetRetries(), 1)
+	}
+	if diff := cmp.Diff([]string{"TestNetwork"}, got.RetryableTests()); diff != "" {
+		t.Errorf("RetryableTests() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestGormDBAssignmentAnalysisChecks(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 10)
+	qtest.CreateCourse(t, db, admin, &pb.Course{})
+
+	assignment := &pb.Assignment{
+		CourseID: 1,
+		Order:    1,
+		AnalysisChecks: []*pb.AnalysisCheck{
+			{Name: "govet", Command: "go vet ./...", Weight: 5},
+			{Name: "lint", Command: "golangci-lint run ./..."},
+		},
+	}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	// update the assignment with a different set of checks
+	assignment = &pb.Assignment{
+		CourseID:       1,
+		Order:          1,
+		AnalysisChecks: []*pb.AnalysisCheck{{Name: "govet", Command: "go vet ./...", Penalty: 10}},
+	}
+	if err := db.UpdateAssignments([]*pb.Assignment{assignment}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := db.GetAssignment(&pb.Assignment{ID: assignment.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*pb.AnalysisCheck{{Name: "govet", Command: "go vet ./...", Penalty: 10}}
+	if diff := cmp.Diff(want, got.GetAnalysisChecks(), protocmp.Transform(), protocmp.IgnoreFields(&pb.AnalysisCheck{}, "ID", "AssignmentID")); diff != "" {
+		t.Errorf("AnalysisChecks mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestGormDBAssignmentTasks(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 10)
+	qtest.CreateCourse(t, db, admin, &pb.Course{})
+
+	assignment := &pb.Assignment{
+		CourseID: 1,
+		Order:    1,
+		Tasks: []*pb.Task{
+			{Name: "hello", Title: "Hello World", Body: "Print Hello, World!"},
+			{Name: "questions", Title: "Go Questions", Body: "Answer the questions."},
+		},
+	}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	// update the assignment with a different set of tasks
+	assignment = &pb.Assignment{
+		CourseID: 1,
+		Order:    1,
+		Tasks: []*pb.Task{
+			{Name: "hello", Title: "Hello Gopher", Body: "Print Hello, Gopher!"},
+		},
+	}
+	if err := db.UpdateAssignments([]*pb.Assignment{assignment}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := db.GetAssignment(&pb.Assignment{ID: assignment.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.GetTasks()) != 1 {
+		t.Fatalf("len(Tasks) = %d, want %d", len(got.GetTasks()), 1)
+	}
+	wantTasks := []*pb.Task{
+		{ID: got.GetTasks()[0].GetID(), AssignmentID: assignment.ID, Name: "hello", Title: "Hello Gopher", Body: "Print Hello, Gopher!"},
+	}
+	if diff := cmp.Diff(wantTasks, got.GetTasks(), protocmp.Transform()); diff != "" {
+		t.Errorf("Tasks mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestGormDBDeleteAssignment(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	user, course, assignment := setupCourseAssignment(t, db)
+	removed := &pb.Assignment{
+		CourseID: course.ID,
+		Order:    2,
+		Tests:    []*pb.TestConfig{{TestName: "TestNetwork", Retryable: true}},
+		Tasks:    []*pb.Task{{Name: "hello", Title: "Hello World"}},
+		GradingBenchmarks: []*pb.GradingBenchmark{
+			{Heading: "Code quality", Criteria: []*pb.GradingCriterion{{Description: "Readable"}}},
+		},
+	}
+	if err := db.CreateAssignment(removed); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.DeleteAssignment(removed); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.GetAssignment(&pb.Assignment{ID: removed.ID}); err != gorm.ErrRecordNotFound {
+		t.Errorf("GetAssignment() after DeleteAssignment() = %v, want %v", err, gorm.ErrRecordNotFound)
+	}
+	if benchmarks, err := db.GetBenchmarks(removed); err != nil || len(benchmarks) != 0 {
+		t.Errorf("GetBenchmarks() after DeleteAssignment() = %v, %v; want no benchmarks", benchmarks, err)
+	}
+
+	// an assignment with submissions is kept
+	if err := db.CreateSubmission(&pb.Submission{AssignmentID: assignment.ID, UserID: user.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.DeleteAssignment(assignment); err != database.ErrAssignmentHasSubmissions {
+		t.Errorf("DeleteAssignment() = %v, want %v", err, database.ErrAssignmentHasSubmissions)
+	}
+	if _, err := db.GetAssignment(&pb.Assignment{ID: assignment.ID}); err != nil {
+		t.Errorf("GetAssignment() after failed DeleteAssignment() = %v, want nil", err)
+	}
+}
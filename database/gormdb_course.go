@@ -165,3 +165,31 @@ func (db *GormDB) UpdateCourse(course *pb.Course) error {
 		Where(&pb.Course{ID: course.GetID()}).
 		Updates(course).Error
 }
+
+// UpdateCourseSecret creates or updates the given course secret.
+// A secret without a value is deleted.
+func (db *GormDB) UpdateCourseSecret(secret *pb.CourseSecret) error {
+	query := &pb.CourseSecret{
+		CourseID: secret.GetCourseID(),
+		Name:     secret.GetName(),
+	}
+	if secret.GetValue() == "" {
+		return db.conn.Where(query).Delete(&pb.CourseSecret{}).Error
+	}
+	return db.conn.Where(query).
+		Assign(&pb.CourseSecret{Value: secret.GetValue()}).
+		FirstOrCreate(secret).Error
+}
+
+// GetCourseSecrets returns the secrets of the given course, keyed by name.
+func (db *GormDB) GetCourseSecrets(courseID uint64) (map[string]string, error) {
+	var secrets []*pb.CourseSecret
+	if err := db.conn.Where(&pb.CourseSecret{CourseID: courseID}).Find(&secrets).Error; err != nil {
+		return nil, err
+	}
+	values := make(map[string]string, len(secrets))
+	for _, secret := range secrets {
+		values[secret.GetName()] = secret.GetValue()
+	}
+	return values, nil
+}
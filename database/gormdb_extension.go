@@ -0,0 +1,40 @@
+package database
+
+import (
+	"errors"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"gorm.io/gorm"
+)
+
+// ErrInvalidExtension is returned if the deadline extension specify both UserID and GroupID or neither.
+var ErrInvalidExtension = errors.New("deadline extension must specify exactly one of UserID or GroupID")
+
+// UpdateDeadlineExtension creates or updates the deadline extension
+// of the given student or group for the given assignment.
+func (db *GormDB) UpdateDeadlineExtension(ext *pb.DeadlineExtension) error {
+	if (ext.GetUserID() == 0) == (ext.GetGroupID() == 0) {
+		return ErrInvalidExtension
+	}
+	query := &pb.DeadlineExtension{
+		AssignmentID: ext.GetAssignmentID(),
+		UserID:       ext.GetUserID(),
+		GroupID:      ext.GetGroupID(),
+	}
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where(query).First(query).Error; err != nil && err != gorm.ErrRecordNotFound {
+			return err // will rollback transaction
+		}
+		ext.ID = query.GetID()
+		return tx.Save(ext).Error
+	})
+}
+
+// GetDeadlineExtension returns the deadline extension matching the given query.
+func (db *GormDB) GetDeadlineExtension(query *pb.DeadlineExtension) (*pb.DeadlineExtension, error) {
+	var ext pb.DeadlineExtension
+	if err := db.conn.Where(query).First(&ext).Error; err != nil {
+		return nil, err
+	}
+	return &ext, nil
+}
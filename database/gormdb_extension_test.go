@@ -0,0 +1,54 @@
+package database_test
+
+import (
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+	"gorm.io/gorm"
+)
+
+func TestGormDBUpdateDeadlineExtension(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{}
+	qtest.CreateCourse(t, db, admin, course)
+	assignment := &pb.Assignment{CourseID: course.ID, Order: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.UpdateDeadlineExtension(&pb.DeadlineExtension{AssignmentID: assignment.ID}); err != database.ErrInvalidExtension {
+		t.Errorf("have error '%v' wanted '%v'", err, database.ErrInvalidExtension)
+	}
+
+	ext := &pb.DeadlineExtension{AssignmentID: assignment.ID, UserID: admin.ID, Deadline: "2021-02-01T23:59:00"}
+	if err := db.UpdateDeadlineExtension(ext); err != nil {
+		t.Fatal(err)
+	}
+	// granting a new extension replaces the previous one
+	want := &pb.DeadlineExtension{AssignmentID: assignment.ID, UserID: admin.ID, Deadline: "2021-02-08T23:59:00"}
+	if err := db.UpdateDeadlineExtension(want); err != nil {
+		t.Fatal(err)
+	}
+	if want.ID != ext.ID {
+		t.Errorf("UpdateDeadlineExtension() created extension %d, want update of extension %d", want.ID, ext.ID)
+	}
+
+	got, err := db.GetDeadlineExtension(&pb.DeadlineExtension{AssignmentID: assignment.ID, UserID: admin.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("GetDeadlineExtension() mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, err := db.GetDeadlineExtension(&pb.DeadlineExtension{AssignmentID: assignment.ID, GroupID: 1}); err != gorm.ErrRecordNotFound {
+		t.Errorf("have error '%v' wanted '%v'", err, gorm.ErrRecordNotFound)
+	}
+}
@@ -160,6 +160,16 @@ func (db *GormDB) GetGroup(groupID uint64) (*pb.Group, error) {
 	return &group, nil
 }
 
+// GetGroupUsers returns the users that are members of the group with the specified group ID,
+// including members that are no longer enrolled in the group's course.
+func (db *GormDB) GetGroupUsers(groupID uint64) ([]*pb.User, error) {
+	var users []*pb.User
+	if err := db.conn.Model(&pb.Group{ID: groupID}).Association("Users").Find(&users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
 // GetGroupsByCourse returns the groups for the given course.
 func (db *GormDB) GetGroupsByCourse(courseID uint64, statuses ...pb.Group_GroupStatus) ([]*pb.Group, error) {
 	if len(statuses) == 0 {
@@ -0,0 +1,32 @@
+package database
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+	"gorm.io/gorm"
+)
+
+// UpdateIssue creates or updates the issue describing the given task in the given
+// repository; a repository has at most one issue for each task of an assignment.
+func (db *GormDB) UpdateIssue(issue *pb.Issue) error {
+	query := &pb.Issue{
+		RepositoryID: issue.GetRepositoryID(),
+		AssignmentID: issue.GetAssignmentID(),
+		TaskName:     issue.GetTaskName(),
+	}
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where(query).First(query).Error; err != nil && err != gorm.ErrRecordNotFound {
+			return err // will rollback transaction
+		}
+		issue.ID = query.GetID()
+		return tx.Save(issue).Error
+	})
+}
+
+// GetIssues returns the issues describing tasks in the given repository.
+func (db *GormDB) GetIssues(repositoryID uint64) ([]*pb.Issue, error) {
+	var issues []*pb.Issue
+	if err := db.conn.Where(&pb.Issue{RepositoryID: repositoryID}).Find(&issues).Error; err != nil {
+		return nil, err
+	}
+	return issues, nil
+}
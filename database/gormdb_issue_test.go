@@ -0,0 +1,41 @@
+package database_test
+
+import (
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestGormDBUpdateIssue(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	if err := db.UpdateIssue(&pb.Issue{RepositoryID: 1, AssignmentID: 1, TaskName: "hello", IssueNumber: 1, Title: "Hello"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateIssue(&pb.Issue{RepositoryID: 1, AssignmentID: 1, TaskName: "world", IssueNumber: 2, Title: "World"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateIssue(&pb.Issue{RepositoryID: 2, AssignmentID: 1, TaskName: "hello", IssueNumber: 1, Title: "Hello"}); err != nil {
+		t.Fatal(err)
+	}
+	// updating the issue for the same task replaces the previous one
+	if err := db.UpdateIssue(&pb.Issue{RepositoryID: 1, AssignmentID: 1, TaskName: "hello", IssueNumber: 1, Title: "Hello World", Body: "Print Hello World"}); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := db.GetIssues(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*pb.Issue{
+		{ID: 1, RepositoryID: 1, AssignmentID: 1, TaskName: "hello", IssueNumber: 1, Title: "Hello World", Body: "Print Hello World"},
+		{ID: 2, RepositoryID: 1, AssignmentID: 1, TaskName: "world", IssueNumber: 2, Title: "World"},
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("GetIssues() mismatch (-want +got):\n%s", diff)
+	}
+}
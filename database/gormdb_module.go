@@ -0,0 +1,32 @@
+package database
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+	"gorm.io/gorm"
+)
+
+// UpdateCourseModules replaces the recorded modules of the given course.
+func (db *GormDB) UpdateCourseModules(courseID uint64, modules []*pb.CourseModule) error {
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("course_id = ?", courseID).Delete(&pb.CourseModule{}).Error; err != nil {
+			return err
+		}
+		if len(modules) == 0 {
+			return nil
+		}
+		for _, module := range modules {
+			module.ID = 0
+			module.CourseID = courseID
+		}
+		return tx.Create(modules).Error
+	})
+}
+
+// GetCourseModules returns the modules of the given course, in the order they were recorded.
+func (db *GormDB) GetCourseModules(courseID uint64) ([]*pb.CourseModule, error) {
+	var modules []*pb.CourseModule
+	if err := db.conn.Where("course_id = ?", courseID).Order("id").Find(&modules).Error; err != nil {
+		return nil, err
+	}
+	return modules, nil
+}
@@ -0,0 +1,74 @@
+package database_test
+
+import (
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestGormDBUpdateCourseModules(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{}
+	qtest.CreateCourse(t, db, admin, course)
+	other := &pb.Course{OrganizationID: 2}
+	qtest.CreateCourse(t, db, admin, other)
+
+	modules, err := db.GetCourseModules(course.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(modules) != 0 {
+		t.Errorf("GetCourseModules() = %v, want none", modules)
+	}
+
+	if err := db.UpdateCourseModules(course.ID, []*pb.CourseModule{
+		{Name: "exam", Weight: 100, Assignments: "exam"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateCourseModules(other.ID, []*pb.CourseModule{
+		{Name: "labs", Weight: 100, Assignments: "lab1"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	// updating the modules replaces the previous ones
+	want := []*pb.CourseModule{
+		{Name: "labs", Weight: 60, Assignments: "lab1,lab2"},
+		{Name: "project", Weight: 40, Assignments: "project"},
+	}
+	if err := db.UpdateCourseModules(course.ID, want); err != nil {
+		t.Fatal(err)
+	}
+	got, err := db.GetCourseModules(course.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("GetCourseModules() mismatch (-want +got):\n%s", diff)
+	}
+
+	if err := db.UpdateCourseModules(course.ID, nil); err != nil {
+		t.Fatal(err)
+	}
+	got, err = db.GetCourseModules(course.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 0 {
+		t.Errorf("GetCourseModules() = %v, want none", got)
+	}
+	// the other course's modules are kept
+	got, err = db.GetCourseModules(other.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].GetName() != "labs" {
+		t.Errorf("GetCourseModules(%d) = %v, want module labs", other.ID, got)
+	}
+}
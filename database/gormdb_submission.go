@@ -14,6 +14,8 @@ var (
 	ErrInvalidSubmission = errors.New("submission must specify exactly one of UserID or GroupID")
 	// ErrInvalidAssignmentID is returned if assignment is not specified.
 	ErrInvalidAssignmentID = errors.New("cannot create submission without an associated assignment")
+	// ErrRerunLimit is returned if the submission has been re-run the maximum number of times.
+	ErrRerunLimit = errors.New("submission has been re-run the maximum number of times")
 )
 
 // CreateSubmission creates a new submission record or updates the most
@@ -52,12 +54,24 @@ func (db *GormDB) CreateSubmission(submission *pb.Submission) error {
 			if err := tx.Where("submission_id = ?", submission.ID).Delete(&score.BuildInfo{}).Error; err != nil {
 				return err // will rollback transaction
 			}
+			if err := tx.Where("submission_id = ?", submission.ID).Delete(&pb.Annotation{}).Error; err != nil {
+				return err // will rollback transaction
+			}
+			if err := tx.Where("submission_id = ?", submission.ID).Delete(&pb.MatrixResult{}).Error; err != nil {
+				return err // will rollback transaction
+			}
 			if submission.BuildInfo != nil {
 				submission.BuildInfo.SubmissionID = submission.ID
 			}
 			for _, sc := range submission.Scores {
 				sc.SubmissionID = submission.ID
 			}
+			for _, annotation := range submission.Annotations {
+				annotation.SubmissionID = submission.ID
+			}
+			for _, result := range submission.MatrixResults {
+				result.SubmissionID = submission.ID
+			}
 		}
 		if err := tx.Save(submission).Error; err != nil {
 			return err // will rollback transaction
@@ -118,6 +132,8 @@ func (db *GormDB) GetSubmission(query *pb.Submission) (*pb.Submission, error) {
 	if err := db.conn.Preload("Reviews").
 		Preload("BuildInfo").
 		Preload("Scores").
+		Preload("Annotations").
+		Preload("MatrixResults").
 		Preload("Reviews.GradingBenchmarks").
 		Preload("Reviews.GradingBenchmarks.Criteria").
 		Where(query).Last(&submission).Error; err != nil {
@@ -164,6 +180,30 @@ func (db *GormDB) UpdateSubmission(query *pb.Submission) error {
 	return db.conn.Save(query).Error
 }
 
+// CountRerun increments the number of re-runs of the given submission,
+// unless the submission has been re-run the given maximum number of times.
+// The number is checked and incremented atomically, such that concurrent
+// requests cannot exceed the maximum.
+func (db *GormDB) CountRerun(submissionID uint64, maxReruns uint32) error {
+	result := db.conn.Model(&pb.Submission{}).
+		Where("id = ? AND reruns < ?", submissionID, maxReruns).
+		Update("reruns", gorm.Expr("reruns + 1"))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrRerunLimit
+	}
+	return nil
+}
+
+// UpdateBuildLog replaces the build log of the given submission, keeping its other build info.
+func (db *GormDB) UpdateBuildLog(submissionID uint64, buildLog string) error {
+	return db.conn.Model(&score.BuildInfo{}).
+		Where("submission_id = ?", submissionID).
+		Update("build_log", buildLog).Error
+}
+
 // UpdateSubmissions approves and/or releases all submissions that have score
 // equal or above the provided score for the given assignment ID
 func (db *GormDB) UpdateSubmissions(courseID uint64, query *pb.Submission) error {
@@ -232,11 +232,13 @@ func TestGormDBInsertSubmissions(t *testing.T) {
 		t.Fatalf("have %d submissions want %d", len(submissions), 1)
 	}
 	want := &pb.Submission{
-		ID:           submissions[0].ID,
-		AssignmentID: assignment.ID,
-		UserID:       user.ID,
-		Reviews:      []*pb.Review{},
-		Scores:       []*score.Score{},
+		ID:            submissions[0].ID,
+		AssignmentID:  assignment.ID,
+		UserID:        user.ID,
+		Reviews:       []*pb.Review{},
+		Scores:        []*score.Score{},
+		Annotations:   []*pb.Annotation{},
+		MatrixResults: []*pb.MatrixResult{},
 	}
 	if !reflect.DeepEqual(submissions[0], want) {
 		t.Errorf("have %#v want %#v", submissions[0], want)
@@ -490,3 +492,81 @@ func TestGormDBCreateUpdateWithBuildInfoAndScores(t *testing.T) {
 		t.Fatal("expected error: record not found")
 	}
 }
+
+func TestGormDBCountRerun(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	user := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{}
+	qtest.CreateCourse(t, db, user, course)
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	submission := &pb.Submission{AssignmentID: assignment.ID, UserID: user.ID}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := db.CountRerun(submission.ID, 2); err != nil {
+			t.Fatalf("CountRerun() #%d failed: %v", i+1, err)
+		}
+	}
+	if err := db.CountRerun(submission.ID, 2); err != database.ErrRerunLimit {
+		t.Errorf("CountRerun() error = %v, want %v", err, database.ErrRerunLimit)
+	}
+	got, err := db.GetSubmission(&pb.Submission{ID: submission.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.GetReruns() != 2 {
+		t.Errorf("Reruns = %d, want %d", got.GetReruns(), 2)
+	}
+	// without re-runs allowed, none are counted
+	if err := db.CountRerun(submission.ID, 0); err != database.ErrRerunLimit {
+		t.Errorf("CountRerun() error = %v, want %v", err, database.ErrRerunLimit)
+	}
+}
+
+func TestGormDBSubmissionAnnotations(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	user := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{}
+	qtest.CreateCourse(t, db, user, course)
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	submission := &pb.Submission{
+		AssignmentID: assignment.ID,
+		UserID:       user.ID,
+		Annotations: []*pb.Annotation{
+			{CheckName: "govet", File: "fib.go", Line: 12, Message: "unreachable code"},
+			{CheckName: "govet", File: "sort.go", Line: 3, Message: "missing return"},
+		},
+	}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+	// a new test run of the submission replaces its annotations
+	want := []*pb.Annotation{{CheckName: "govet", File: "sort.go", Line: 3, Message: "missing return"}}
+	if err := db.CreateSubmission(&pb.Submission{
+		ID:           submission.ID,
+		AssignmentID: assignment.ID,
+		UserID:       user.ID,
+		Annotations:  []*pb.Annotation{{CheckName: "govet", File: "sort.go", Line: 3, Message: "missing return"}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := db.GetSubmission(&pb.Submission{ID: submission.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, got.GetAnnotations(), protocmp.Transform(), protocmp.IgnoreFields(&pb.Annotation{}, "ID", "SubmissionID")); diff != "" {
+		t.Errorf("Annotations mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -748,6 +748,38 @@ func TestGormDBUpdateCourse(t *testing.T) {
 	}
 }
 
+func TestGormDBUpdateCourseSecret(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{}
+	qtest.CreateCourse(t, db, admin, course)
+
+	for _, secret := range []*pb.CourseSecret{
+		{CourseID: course.ID, Name: "apikey", Value: "old"},
+		{CourseID: course.ID, Name: "apikey", Value: "new"},
+		{CourseID: course.ID, Name: "token", Value: "t0k3n"},
+		{CourseID: course.ID, Name: "password", Value: "pw"},
+		{CourseID: course.ID, Name: "password"},
+	} {
+		if err := db.UpdateCourseSecret(secret); err != nil {
+			t.Fatal(err)
+		}
+	}
+	gotSecrets, err := db.GetCourseSecrets(course.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantSecrets := map[string]string{"apikey": "new", "token": "t0k3n"}
+	if diff := cmp.Diff(wantSecrets, gotSecrets); diff != "" {
+		t.Errorf("GetCourseSecrets() mismatch (-want +got):\n%s", diff)
+	}
+	if otherSecrets, err := db.GetCourseSecrets(course.ID + 1); err != nil || len(otherSecrets) != 0 {
+		t.Errorf("GetCourseSecrets(%d) = %v, %v, want no secrets", course.ID+1, otherSecrets, err)
+	}
+}
+
 func TestGormDBGetEmptyRepo(t *testing.T) {
 	db, cleanup := qtest.TestDB(t)
 	defer cleanup()
@@ -0,0 +1,51 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm/schema"
+)
+
+func init() {
+	schema.RegisterSerializer("timestamp", TimestampSerializer{})
+}
+
+// TimestampSerializer stores protobuf timestamps as datetime columns.
+// It is used by fields with the tag gorm:"serializer:timestamp;type:datetime".
+// A nil timestamp is stored as NULL.
+type TimestampSerializer struct{}
+
+// Scan sets the timestamp field from the datetime database value.
+func (TimestampSerializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue interface{}) error {
+	if dbValue == nil {
+		return nil
+	}
+	t, ok := dbValue.(time.Time)
+	if !ok {
+		return fmt.Errorf("failed to scan %T into timestamp field %s", dbValue, field.Name)
+	}
+	field.ReflectValueOf(ctx, dst).Set(reflect.ValueOf(timestamppb.New(t)))
+	return nil
+}
+
+// Value returns the datetime database value of the timestamp field.
+func (TimestampSerializer) Value(ctx context.Context, field *schema.Field, dst reflect.Value, fieldValue interface{}) (interface{}, error) {
+	ts, ok := fieldValue.(*timestamppb.Timestamp)
+	if !ok || ts == nil {
+		return nil, nil
+	}
+	return ts.AsTime(), nil
+}
+
+// timestampValue returns the datetime database value of the given timestamp,
+// for updates with a map of column values, which bypass the serializer.
+func timestampValue(ts *timestamppb.Timestamp) interface{} {
+	if ts == nil {
+		return nil
+	}
+	return ts.AsTime()
+}
@@ -0,0 +1,70 @@
+package database_test
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGormDBAssignmentDeadlineTime(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{}
+	qtest.CreateCourse(t, db, admin, course)
+
+	oslo, err := time.LoadLocation("Europe/Oslo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.Date(2021, 8, 30, 23, 59, 0, 0, oslo)
+	releaseDate, closeDate := deadline.Add(-7*24*time.Hour), deadline.Add(72*time.Hour)
+	lab1 := &pb.Assignment{
+		CourseID: course.ID, Order: 1, TimeZone: "Europe/Oslo", DeadlineTime: timestamppb.New(deadline),
+		ReleaseDate: timestamppb.New(releaseDate), CloseDate: timestamppb.New(closeDate),
+	}
+	lab2 := &pb.Assignment{CourseID: course.ID, Order: 2}
+	if err := db.UpdateAssignments([]*pb.Assignment{lab1, lab2}); err != nil {
+		t.Fatal(err)
+	}
+	if !lab1.GetDeadlineTime().AsTime().Equal(deadline) {
+		t.Errorf("UpdateAssignments() changed deadline to %v, want %v", lab1.GetDeadlineTime().AsTime(), deadline)
+	}
+	got, err := db.GetAssignment(&pb.Assignment{ID: lab1.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.GetDeadlineTime().AsTime().Equal(deadline) || got.GetTimeZone() != "Europe/Oslo" {
+		t.Errorf("GetAssignment() deadline = %v in %q, want %v in %q", got.GetDeadlineTime().AsTime(), got.GetTimeZone(), deadline, "Europe/Oslo")
+	}
+	if !got.GetReleaseDate().AsTime().Equal(releaseDate) || !got.GetCloseDate().AsTime().Equal(closeDate) {
+		t.Errorf("GetAssignment() release and close dates = %v, %v, want %v, %v",
+			got.GetReleaseDate().AsTime(), got.GetCloseDate().AsTime(), releaseDate, closeDate)
+	}
+	got, err = db.GetAssignment(&pb.Assignment{ID: lab2.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.DeadlineTime != nil || got.ReleaseDate != nil || got.CloseDate != nil {
+		t.Errorf("GetAssignment() deadline, release and close dates = %v, %v, %v, want nil",
+			got.GetDeadlineTime(), got.GetReleaseDate(), got.GetCloseDate())
+	}
+
+	// updating the assignment replaces its deadline
+	extended := deadline.Add(48 * time.Hour)
+	lab1 = &pb.Assignment{CourseID: course.ID, Order: 1, TimeZone: "Europe/Oslo", DeadlineTime: timestamppb.New(extended)}
+	if err := db.UpdateAssignments([]*pb.Assignment{lab1}); err != nil {
+		t.Fatal(err)
+	}
+	got, err = db.GetAssignment(&pb.Assignment{ID: lab1.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.GetDeadlineTime().AsTime().Equal(extended) {
+		t.Errorf("GetAssignment() deadline = %v, want %v", got.GetDeadlineTime().AsTime(), extended)
+	}
+}
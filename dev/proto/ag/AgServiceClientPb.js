@@ -64,12 +64,24 @@ var AutograderServiceClient = /** @class */ (function () {
         this.methodInfoUpdateCourseVisibility = new grpcWeb.MethodDescriptor('/ag.AutograderService/UpdateCourseVisibility', grpcWeb.MethodType.UNARY, ag_ag_pb.Enrollment, ag_ag_pb.Void, function (request) {
             return request.serializeBinary();
         }, ag_ag_pb.Void.deserializeBinary);
+        this.methodInfoUpdateCourseSecret = new grpcWeb.MethodDescriptor('/ag.AutograderService/UpdateCourseSecret', grpcWeb.MethodType.UNARY, ag_ag_pb.CourseSecret, ag_ag_pb.Void, function (request) {
+            return request.serializeBinary();
+        }, ag_ag_pb.Void.deserializeBinary);
         this.methodInfoGetAssignments = new grpcWeb.MethodDescriptor('/ag.AutograderService/GetAssignments', grpcWeb.MethodType.UNARY, ag_ag_pb.CourseRequest, ag_ag_pb.Assignments, function (request) {
             return request.serializeBinary();
         }, ag_ag_pb.Assignments.deserializeBinary);
         this.methodInfoUpdateAssignments = new grpcWeb.MethodDescriptor('/ag.AutograderService/UpdateAssignments', grpcWeb.MethodType.UNARY, ag_ag_pb.CourseRequest, ag_ag_pb.Void, function (request) {
             return request.serializeBinary();
         }, ag_ag_pb.Void.deserializeBinary);
+        this.methodInfoValidateCourseRepository = new grpcWeb.MethodDescriptor('/ag.AutograderService/ValidateCourseRepository', grpcWeb.MethodType.UNARY, ag_ag_pb.CourseRequest, ag_ag_pb.CourseRepositoryValidation, function (request) {
+            return request.serializeBinary();
+        }, ag_ag_pb.CourseRepositoryValidation.deserializeBinary);
+        this.methodInfoExportAssignments = new grpcWeb.MethodDescriptor('/ag.AutograderService/ExportAssignments', grpcWeb.MethodType.UNARY, ag_ag_pb.AssignmentArchiveRequest, ag_ag_pb.AssignmentArchive, function (request) {
+            return request.serializeBinary();
+        }, ag_ag_pb.AssignmentArchive.deserializeBinary);
+        this.methodInfoImportAssignments = new grpcWeb.MethodDescriptor('/ag.AutograderService/ImportAssignments', grpcWeb.MethodType.UNARY, ag_ag_pb.AssignmentArchive, ag_ag_pb.Assignments, function (request) {
+            return request.serializeBinary();
+        }, ag_ag_pb.Assignments.deserializeBinary);
         this.methodInfoGetEnrollmentsByUser = new grpcWeb.MethodDescriptor('/ag.AutograderService/GetEnrollmentsByUser', grpcWeb.MethodType.UNARY, ag_ag_pb.EnrollmentStatusRequest, ag_ag_pb.Enrollments, function (request) {
             return request.serializeBinary();
         }, ag_ag_pb.Enrollments.deserializeBinary);
@@ -103,6 +115,33 @@ var AutograderServiceClient = /** @class */ (function () {
         this.methodInfoRebuildSubmissions = new grpcWeb.MethodDescriptor('/ag.AutograderService/RebuildSubmissions', grpcWeb.MethodType.UNARY, ag_ag_pb.AssignmentRequest, ag_ag_pb.Void, function (request) {
             return request.serializeBinary();
         }, ag_ag_pb.Void.deserializeBinary);
+        this.methodInfoRegradeAssignment = new grpcWeb.MethodDescriptor('/ag.AutograderService/RegradeAssignment', grpcWeb.MethodType.UNARY, ag_ag_pb.AssignmentRequest, ag_ag_pb.RegradeProgress, function (request) {
+            return request.serializeBinary();
+        }, ag_ag_pb.RegradeProgress.deserializeBinary);
+        this.methodInfoGetRegradeProgress = new grpcWeb.MethodDescriptor('/ag.AutograderService/GetRegradeProgress', grpcWeb.MethodType.UNARY, ag_ag_pb.AssignmentRequest, ag_ag_pb.RegradeProgress, function (request) {
+            return request.serializeBinary();
+        }, ag_ag_pb.RegradeProgress.deserializeBinary);
+        this.methodInfoRerunSubmission = new grpcWeb.MethodDescriptor('/ag.AutograderService/RerunSubmission', grpcWeb.MethodType.UNARY, ag_ag_pb.AssignmentRequest, ag_ag_pb.Submission, function (request) {
+            return request.serializeBinary();
+        }, ag_ag_pb.Submission.deserializeBinary);
+        this.methodInfoGetSubmissionQueue = new grpcWeb.MethodDescriptor('/ag.AutograderService/GetSubmissionQueue', grpcWeb.MethodType.UNARY, ag_ag_pb.CourseRequest, ag_ag_pb.SubmissionQueue, function (request) {
+            return request.serializeBinary();
+        }, ag_ag_pb.SubmissionQueue.deserializeBinary);
+        this.methodInfoStreamBuildLog = new grpcWeb.MethodDescriptor('/ag.AutograderService/StreamBuildLog', grpcWeb.MethodType.SERVER_STREAMING, ag_ag_pb.BuildLogRequest, ag_ag_pb.BuildLogChunk, function (request) {
+            return request.serializeBinary();
+        }, ag_ag_pb.BuildLogChunk.deserializeBinary);
+        this.methodInfoGetArtifacts = new grpcWeb.MethodDescriptor('/ag.AutograderService/GetArtifacts', grpcWeb.MethodType.UNARY, ag_ag_pb.ArtifactRequest, ag_ag_pb.Artifacts, function (request) {
+            return request.serializeBinary();
+        }, ag_ag_pb.Artifacts.deserializeBinary);
+        this.methodInfoGetArtifact = new grpcWeb.MethodDescriptor('/ag.AutograderService/GetArtifact', grpcWeb.MethodType.UNARY, ag_ag_pb.ArtifactRequest, ag_ag_pb.Artifact, function (request) {
+            return request.serializeBinary();
+        }, ag_ag_pb.Artifact.deserializeBinary);
+        this.methodInfoGrantDeadlineExtension = new grpcWeb.MethodDescriptor('/ag.AutograderService/GrantDeadlineExtension', grpcWeb.MethodType.UNARY, ag_ag_pb.DeadlineExtensionRequest, ag_ag_pb.DeadlineExtension, function (request) {
+            return request.serializeBinary();
+        }, ag_ag_pb.DeadlineExtension.deserializeBinary);
+        this.methodInfoGetCourseGrade = new grpcWeb.MethodDescriptor('/ag.AutograderService/GetCourseGrade', grpcWeb.MethodType.UNARY, ag_ag_pb.CourseGradeRequest, ag_ag_pb.CourseGrade, function (request) {
+            return request.serializeBinary();
+        }, ag_ag_pb.CourseGrade.deserializeBinary);
         this.methodInfoCreateBenchmark = new grpcWeb.MethodDescriptor('/ag.AutograderService/CreateBenchmark', grpcWeb.MethodType.UNARY, ag_ag_pb.GradingBenchmark, ag_ag_pb.GradingBenchmark, function (request) {
             return request.serializeBinary();
         }, ag_ag_pb.GradingBenchmark.deserializeBinary);
@@ -288,6 +327,14 @@ var AutograderServiceClient = /** @class */ (function () {
         return this.client_.unaryCall(this.hostname_ +
             '/ag.AutograderService/UpdateCourseVisibility', request, metadata || {}, this.methodInfoUpdateCourseVisibility);
     };
+    AutograderServiceClient.prototype.updateCourseSecret = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(this.hostname_ +
+                '/ag.AutograderService/UpdateCourseSecret', request, metadata || {}, this.methodInfoUpdateCourseSecret, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/ag.AutograderService/UpdateCourseSecret', request, metadata || {}, this.methodInfoUpdateCourseSecret);
+    };
     AutograderServiceClient.prototype.getAssignments = function (request, metadata, callback) {
         if (callback !== undefined) {
             return this.client_.rpcCall(this.hostname_ +
@@ -304,6 +351,30 @@ var AutograderServiceClient = /** @class */ (function () {
         return this.client_.unaryCall(this.hostname_ +
             '/ag.AutograderService/UpdateAssignments', request, metadata || {}, this.methodInfoUpdateAssignments);
     };
+    AutograderServiceClient.prototype.validateCourseRepository = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(this.hostname_ +
+                '/ag.AutograderService/ValidateCourseRepository', request, metadata || {}, this.methodInfoValidateCourseRepository, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/ag.AutograderService/ValidateCourseRepository', request, metadata || {}, this.methodInfoValidateCourseRepository);
+    };
+    AutograderServiceClient.prototype.exportAssignments = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(this.hostname_ +
+                '/ag.AutograderService/ExportAssignments', request, metadata || {}, this.methodInfoExportAssignments, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/ag.AutograderService/ExportAssignments', request, metadata || {}, this.methodInfoExportAssignments);
+    };
+    AutograderServiceClient.prototype.importAssignments = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(this.hostname_ +
+                '/ag.AutograderService/ImportAssignments', request, metadata || {}, this.methodInfoImportAssignments, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/ag.AutograderService/ImportAssignments', request, metadata || {}, this.methodInfoImportAssignments);
+    };
     AutograderServiceClient.prototype.getEnrollmentsByUser = function (request, metadata, callback) {
         if (callback !== undefined) {
             return this.client_.rpcCall(this.hostname_ +
@@ -392,6 +463,74 @@ var AutograderServiceClient = /** @class */ (function () {
         return this.client_.unaryCall(this.hostname_ +
             '/ag.AutograderService/RebuildSubmissions', request, metadata || {}, this.methodInfoRebuildSubmissions);
     };
+    AutograderServiceClient.prototype.regradeAssignment = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(this.hostname_ +
+                '/ag.AutograderService/RegradeAssignment', request, metadata || {}, this.methodInfoRegradeAssignment, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/ag.AutograderService/RegradeAssignment', request, metadata || {}, this.methodInfoRegradeAssignment);
+    };
+    AutograderServiceClient.prototype.getRegradeProgress = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(this.hostname_ +
+                '/ag.AutograderService/GetRegradeProgress', request, metadata || {}, this.methodInfoGetRegradeProgress, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/ag.AutograderService/GetRegradeProgress', request, metadata || {}, this.methodInfoGetRegradeProgress);
+    };
+    AutograderServiceClient.prototype.rerunSubmission = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(this.hostname_ +
+                '/ag.AutograderService/RerunSubmission', request, metadata || {}, this.methodInfoRerunSubmission, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/ag.AutograderService/RerunSubmission', request, metadata || {}, this.methodInfoRerunSubmission);
+    };
+    AutograderServiceClient.prototype.getSubmissionQueue = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(this.hostname_ +
+                '/ag.AutograderService/GetSubmissionQueue', request, metadata || {}, this.methodInfoGetSubmissionQueue, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/ag.AutograderService/GetSubmissionQueue', request, metadata || {}, this.methodInfoGetSubmissionQueue);
+    };
+    AutograderServiceClient.prototype.streamBuildLog = function (request, metadata) {
+        return this.client_.serverStreaming(this.hostname_ +
+            '/ag.AutograderService/StreamBuildLog', request, metadata || {}, this.methodInfoStreamBuildLog);
+    };
+    AutograderServiceClient.prototype.getArtifacts = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(this.hostname_ +
+                '/ag.AutograderService/GetArtifacts', request, metadata || {}, this.methodInfoGetArtifacts, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/ag.AutograderService/GetArtifacts', request, metadata || {}, this.methodInfoGetArtifacts);
+    };
+    AutograderServiceClient.prototype.getArtifact = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(this.hostname_ +
+                '/ag.AutograderService/GetArtifact', request, metadata || {}, this.methodInfoGetArtifact, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/ag.AutograderService/GetArtifact', request, metadata || {}, this.methodInfoGetArtifact);
+    };
+    AutograderServiceClient.prototype.grantDeadlineExtension = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(this.hostname_ +
+                '/ag.AutograderService/GrantDeadlineExtension', request, metadata || {}, this.methodInfoGrantDeadlineExtension, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/ag.AutograderService/GrantDeadlineExtension', request, metadata || {}, this.methodInfoGrantDeadlineExtension);
+    };
+    AutograderServiceClient.prototype.getCourseGrade = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(this.hostname_ +
+                '/ag.AutograderService/GetCourseGrade', request, metadata || {}, this.methodInfoGetCourseGrade, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/ag.AutograderService/GetCourseGrade', request, metadata || {}, this.methodInfoGetCourseGrade);
+    };
     AutograderServiceClient.prototype.createBenchmark = function (request, metadata, callback) {
         if (callback !== undefined) {
             return this.client_.rpcCall(this.hostname_ +
@@ -766,6 +766,49 @@ export class AutograderServiceClient {
     this.methodInfoUpdateCourseVisibility);
   }
 
+  methodInfoUpdateCourseSecret = new grpcWeb.MethodDescriptor(
+    '/ag.AutograderService/UpdateCourseSecret',
+    grpcWeb.MethodType.UNARY,
+    ag_ag_pb.CourseSecret,
+    ag_ag_pb.Void,
+    (request: ag_ag_pb.CourseSecret) => {
+      return request.serializeBinary();
+    },
+    ag_ag_pb.Void.deserializeBinary
+  );
+
+  updateCourseSecret(
+    request: ag_ag_pb.CourseSecret,
+    metadata: grpcWeb.Metadata | null): Promise<ag_ag_pb.Void>;
+
+  updateCourseSecret(
+    request: ag_ag_pb.CourseSecret,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.RpcError,
+               response: ag_ag_pb.Void) => void): grpcWeb.ClientReadableStream<ag_ag_pb.Void>;
+
+  updateCourseSecret(
+    request: ag_ag_pb.CourseSecret,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.RpcError,
+               response: ag_ag_pb.Void) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        this.hostname_ +
+          '/ag.AutograderService/UpdateCourseSecret',
+        request,
+        metadata || {},
+        this.methodInfoUpdateCourseSecret,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/ag.AutograderService/UpdateCourseSecret',
+    request,
+    metadata || {},
+    this.methodInfoUpdateCourseSecret);
+  }
+
   methodInfoGetAssignments = new grpcWeb.MethodDescriptor(
     '/ag.AutograderService/GetAssignments',
     grpcWeb.MethodType.UNARY,
@@ -852,6 +895,135 @@ export class AutograderServiceClient {
     this.methodInfoUpdateAssignments);
   }
 
+  methodInfoValidateCourseRepository = new grpcWeb.MethodDescriptor(
+    '/ag.AutograderService/ValidateCourseRepository',
+    grpcWeb.MethodType.UNARY,
+    ag_ag_pb.CourseRequest,
+    ag_ag_pb.CourseRepositoryValidation,
+    (request: ag_ag_pb.CourseRequest) => {
+      return request.serializeBinary();
+    },
+    ag_ag_pb.CourseRepositoryValidation.deserializeBinary
+  );
+
+  validateCourseRepository(
+    request: ag_ag_pb.CourseRequest,
+    metadata: grpcWeb.Metadata | null): Promise<ag_ag_pb.CourseRepositoryValidation>;
+
+  validateCourseRepository(
+    request: ag_ag_pb.CourseRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.RpcError,
+               response: ag_ag_pb.CourseRepositoryValidation) => void): grpcWeb.ClientReadableStream<ag_ag_pb.CourseRepositoryValidation>;
+
+  validateCourseRepository(
+    request: ag_ag_pb.CourseRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.RpcError,
+               response: ag_ag_pb.CourseRepositoryValidation) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        this.hostname_ +
+          '/ag.AutograderService/ValidateCourseRepository',
+        request,
+        metadata || {},
+        this.methodInfoValidateCourseRepository,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/ag.AutograderService/ValidateCourseRepository',
+    request,
+    metadata || {},
+    this.methodInfoValidateCourseRepository);
+  }
+
+  methodInfoExportAssignments = new grpcWeb.MethodDescriptor(
+    '/ag.AutograderService/ExportAssignments',
+    grpcWeb.MethodType.UNARY,
+    ag_ag_pb.AssignmentArchiveRequest,
+    ag_ag_pb.AssignmentArchive,
+    (request: ag_ag_pb.AssignmentArchiveRequest) => {
+      return request.serializeBinary();
+    },
+    ag_ag_pb.AssignmentArchive.deserializeBinary
+  );
+
+  exportAssignments(
+    request: ag_ag_pb.AssignmentArchiveRequest,
+    metadata: grpcWeb.Metadata | null): Promise<ag_ag_pb.AssignmentArchive>;
+
+  exportAssignments(
+    request: ag_ag_pb.AssignmentArchiveRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.RpcError,
+               response: ag_ag_pb.AssignmentArchive) => void): grpcWeb.ClientReadableStream<ag_ag_pb.AssignmentArchive>;
+
+  exportAssignments(
+    request: ag_ag_pb.AssignmentArchiveRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.RpcError,
+               response: ag_ag_pb.AssignmentArchive) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        this.hostname_ +
+          '/ag.AutograderService/ExportAssignments',
+        request,
+        metadata || {},
+        this.methodInfoExportAssignments,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/ag.AutograderService/ExportAssignments',
+    request,
+    metadata || {},
+    this.methodInfoExportAssignments);
+  }
+
+  methodInfoImportAssignments = new grpcWeb.MethodDescriptor(
+    '/ag.AutograderService/ImportAssignments',
+    grpcWeb.MethodType.UNARY,
+    ag_ag_pb.AssignmentArchive,
+    ag_ag_pb.Assignments,
+    (request: ag_ag_pb.AssignmentArchive) => {
+      return request.serializeBinary();
+    },
+    ag_ag_pb.Assignments.deserializeBinary
+  );
+
+  importAssignments(
+    request: ag_ag_pb.AssignmentArchive,
+    metadata: grpcWeb.Metadata | null): Promise<ag_ag_pb.Assignments>;
+
+  importAssignments(
+    request: ag_ag_pb.AssignmentArchive,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.RpcError,
+               response: ag_ag_pb.Assignments) => void): grpcWeb.ClientReadableStream<ag_ag_pb.Assignments>;
+
+  importAssignments(
+    request: ag_ag_pb.AssignmentArchive,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.RpcError,
+               response: ag_ag_pb.Assignments) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        this.hostname_ +
+          '/ag.AutograderService/ImportAssignments',
+        request,
+        metadata || {},
+        this.methodInfoImportAssignments,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/ag.AutograderService/ImportAssignments',
+    request,
+    metadata || {},
+    this.methodInfoImportAssignments);
+  }
+
   methodInfoGetEnrollmentsByUser = new grpcWeb.MethodDescriptor(
     '/ag.AutograderService/GetEnrollmentsByUser',
     grpcWeb.MethodType.UNARY,
@@ -1325,6 +1497,372 @@ export class AutograderServiceClient {
     this.methodInfoRebuildSubmissions);
   }
 
+  methodInfoRegradeAssignment = new grpcWeb.MethodDescriptor(
+    '/ag.AutograderService/RegradeAssignment',
+    grpcWeb.MethodType.UNARY,
+    ag_ag_pb.AssignmentRequest,
+    ag_ag_pb.RegradeProgress,
+    (request: ag_ag_pb.AssignmentRequest) => {
+      return request.serializeBinary();
+    },
+    ag_ag_pb.RegradeProgress.deserializeBinary
+  );
+
+  regradeAssignment(
+    request: ag_ag_pb.AssignmentRequest,
+    metadata: grpcWeb.Metadata | null): Promise<ag_ag_pb.RegradeProgress>;
+
+  regradeAssignment(
+    request: ag_ag_pb.AssignmentRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.RpcError,
+               response: ag_ag_pb.RegradeProgress) => void): grpcWeb.ClientReadableStream<ag_ag_pb.RegradeProgress>;
+
+  regradeAssignment(
+    request: ag_ag_pb.AssignmentRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.RpcError,
+               response: ag_ag_pb.RegradeProgress) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        this.hostname_ +
+          '/ag.AutograderService/RegradeAssignment',
+        request,
+        metadata || {},
+        this.methodInfoRegradeAssignment,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/ag.AutograderService/RegradeAssignment',
+    request,
+    metadata || {},
+    this.methodInfoRegradeAssignment);
+  }
+
+  methodInfoGetRegradeProgress = new grpcWeb.MethodDescriptor(
+    '/ag.AutograderService/GetRegradeProgress',
+    grpcWeb.MethodType.UNARY,
+    ag_ag_pb.AssignmentRequest,
+    ag_ag_pb.RegradeProgress,
+    (request: ag_ag_pb.AssignmentRequest) => {
+      return request.serializeBinary();
+    },
+    ag_ag_pb.RegradeProgress.deserializeBinary
+  );
+
+  getRegradeProgress(
+    request: ag_ag_pb.AssignmentRequest,
+    metadata: grpcWeb.Metadata | null): Promise<ag_ag_pb.RegradeProgress>;
+
+  getRegradeProgress(
+    request: ag_ag_pb.AssignmentRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.RpcError,
+               response: ag_ag_pb.RegradeProgress) => void): grpcWeb.ClientReadableStream<ag_ag_pb.RegradeProgress>;
+
+  getRegradeProgress(
+    request: ag_ag_pb.AssignmentRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.RpcError,
+               response: ag_ag_pb.RegradeProgress) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        this.hostname_ +
+          '/ag.AutograderService/GetRegradeProgress',
+        request,
+        metadata || {},
+        this.methodInfoGetRegradeProgress,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/ag.AutograderService/GetRegradeProgress',
+    request,
+    metadata || {},
+    this.methodInfoGetRegradeProgress);
+  }
+
+  methodInfoRerunSubmission = new grpcWeb.MethodDescriptor(
+    '/ag.AutograderService/RerunSubmission',
+    grpcWeb.MethodType.UNARY,
+    ag_ag_pb.AssignmentRequest,
+    ag_ag_pb.Submission,
+    (request: ag_ag_pb.AssignmentRequest) => {
+      return request.serializeBinary();
+    },
+    ag_ag_pb.Submission.deserializeBinary
+  );
+
+  rerunSubmission(
+    request: ag_ag_pb.AssignmentRequest,
+    metadata: grpcWeb.Metadata | null): Promise<ag_ag_pb.Submission>;
+
+  rerunSubmission(
+    request: ag_ag_pb.AssignmentRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.RpcError,
+               response: ag_ag_pb.Submission) => void): grpcWeb.ClientReadableStream<ag_ag_pb.Submission>;
+
+  rerunSubmission(
+    request: ag_ag_pb.AssignmentRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.RpcError,
+               response: ag_ag_pb.Submission) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        this.hostname_ +
+          '/ag.AutograderService/RerunSubmission',
+        request,
+        metadata || {},
+        this.methodInfoRerunSubmission,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/ag.AutograderService/RerunSubmission',
+    request,
+    metadata || {},
+    this.methodInfoRerunSubmission);
+  }
+
+  methodInfoGetSubmissionQueue = new grpcWeb.MethodDescriptor(
+    '/ag.AutograderService/GetSubmissionQueue',
+    grpcWeb.MethodType.UNARY,
+    ag_ag_pb.CourseRequest,
+    ag_ag_pb.SubmissionQueue,
+    (request: ag_ag_pb.CourseRequest) => {
+      return request.serializeBinary();
+    },
+    ag_ag_pb.SubmissionQueue.deserializeBinary
+  );
+
+  getSubmissionQueue(
+    request: ag_ag_pb.CourseRequest,
+    metadata: grpcWeb.Metadata | null): Promise<ag_ag_pb.SubmissionQueue>;
+
+  getSubmissionQueue(
+    request: ag_ag_pb.CourseRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.RpcError,
+               response: ag_ag_pb.SubmissionQueue) => void): grpcWeb.ClientReadableStream<ag_ag_pb.SubmissionQueue>;
+
+  getSubmissionQueue(
+    request: ag_ag_pb.CourseRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.RpcError,
+               response: ag_ag_pb.SubmissionQueue) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        this.hostname_ +
+          '/ag.AutograderService/GetSubmissionQueue',
+        request,
+        metadata || {},
+        this.methodInfoGetSubmissionQueue,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/ag.AutograderService/GetSubmissionQueue',
+    request,
+    metadata || {},
+    this.methodInfoGetSubmissionQueue);
+  }
+
+  methodInfoStreamBuildLog = new grpcWeb.MethodDescriptor(
+    '/ag.AutograderService/StreamBuildLog',
+    grpcWeb.MethodType.SERVER_STREAMING,
+    ag_ag_pb.BuildLogRequest,
+    ag_ag_pb.BuildLogChunk,
+    (request: ag_ag_pb.BuildLogRequest) => {
+      return request.serializeBinary();
+    },
+    ag_ag_pb.BuildLogChunk.deserializeBinary
+  );
+
+  streamBuildLog(
+    request: ag_ag_pb.BuildLogRequest,
+    metadata?: grpcWeb.Metadata) {
+    return this.client_.serverStreaming(
+      this.hostname_ +
+        '/ag.AutograderService/StreamBuildLog',
+      request,
+      metadata || {},
+      this.methodInfoStreamBuildLog);
+  }
+
+  methodInfoGetArtifacts = new grpcWeb.MethodDescriptor(
+    '/ag.AutograderService/GetArtifacts',
+    grpcWeb.MethodType.UNARY,
+    ag_ag_pb.ArtifactRequest,
+    ag_ag_pb.Artifacts,
+    (request: ag_ag_pb.ArtifactRequest) => {
+      return request.serializeBinary();
+    },
+    ag_ag_pb.Artifacts.deserializeBinary
+  );
+
+  getArtifacts(
+    request: ag_ag_pb.ArtifactRequest,
+    metadata: grpcWeb.Metadata | null): Promise<ag_ag_pb.Artifacts>;
+
+  getArtifacts(
+    request: ag_ag_pb.ArtifactRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.RpcError,
+               response: ag_ag_pb.Artifacts) => void): grpcWeb.ClientReadableStream<ag_ag_pb.Artifacts>;
+
+  getArtifacts(
+    request: ag_ag_pb.ArtifactRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.RpcError,
+               response: ag_ag_pb.Artifacts) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        this.hostname_ +
+          '/ag.AutograderService/GetArtifacts',
+        request,
+        metadata || {},
+        this.methodInfoGetArtifacts,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/ag.AutograderService/GetArtifacts',
+    request,
+    metadata || {},
+    this.methodInfoGetArtifacts);
+  }
+
+  methodInfoGetArtifact = new grpcWeb.MethodDescriptor(
+    '/ag.AutograderService/GetArtifact',
+    grpcWeb.MethodType.UNARY,
+    ag_ag_pb.ArtifactRequest,
+    ag_ag_pb.Artifact,
+    (request: ag_ag_pb.ArtifactRequest) => {
+      return request.serializeBinary();
+    },
+    ag_ag_pb.Artifact.deserializeBinary
+  );
+
+  getArtifact(
+    request: ag_ag_pb.ArtifactRequest,
+    metadata: grpcWeb.Metadata | null): Promise<ag_ag_pb.Artifact>;
+
+  getArtifact(
+    request: ag_ag_pb.ArtifactRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.RpcError,
+               response: ag_ag_pb.Artifact) => void): grpcWeb.ClientReadableStream<ag_ag_pb.Artifact>;
+
+  getArtifact(
+    request: ag_ag_pb.ArtifactRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.RpcError,
+               response: ag_ag_pb.Artifact) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        this.hostname_ +
+          '/ag.AutograderService/GetArtifact',
+        request,
+        metadata || {},
+        this.methodInfoGetArtifact,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/ag.AutograderService/GetArtifact',
+    request,
+    metadata || {},
+    this.methodInfoGetArtifact);
+  }
+
+  methodInfoGrantDeadlineExtension = new grpcWeb.MethodDescriptor(
+    '/ag.AutograderService/GrantDeadlineExtension',
+    grpcWeb.MethodType.UNARY,
+    ag_ag_pb.DeadlineExtensionRequest,
+    ag_ag_pb.DeadlineExtension,
+    (request: ag_ag_pb.DeadlineExtensionRequest) => {
+      return request.serializeBinary();
+    },
+    ag_ag_pb.DeadlineExtension.deserializeBinary
+  );
+
+  grantDeadlineExtension(
+    request: ag_ag_pb.DeadlineExtensionRequest,
+    metadata: grpcWeb.Metadata | null): Promise<ag_ag_pb.DeadlineExtension>;
+
+  grantDeadlineExtension(
+    request: ag_ag_pb.DeadlineExtensionRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.RpcError,
+               response: ag_ag_pb.DeadlineExtension) => void): grpcWeb.ClientReadableStream<ag_ag_pb.DeadlineExtension>;
+
+  grantDeadlineExtension(
+    request: ag_ag_pb.DeadlineExtensionRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.RpcError,
+               response: ag_ag_pb.DeadlineExtension) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        this.hostname_ +
+          '/ag.AutograderService/GrantDeadlineExtension',
+        request,
+        metadata || {},
+        this.methodInfoGrantDeadlineExtension,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/ag.AutograderService/GrantDeadlineExtension',
+    request,
+    metadata || {},
+    this.methodInfoGrantDeadlineExtension);
+  }
+
+  methodInfoGetCourseGrade = new grpcWeb.MethodDescriptor(
+    '/ag.AutograderService/GetCourseGrade',
+    grpcWeb.MethodType.UNARY,
+    ag_ag_pb.CourseGradeRequest,
+    ag_ag_pb.CourseGrade,
+    (request: ag_ag_pb.CourseGradeRequest) => {
+      return request.serializeBinary();
+    },
+    ag_ag_pb.CourseGrade.deserializeBinary
+  );
+
+  getCourseGrade(
+    request: ag_ag_pb.CourseGradeRequest,
+    metadata: grpcWeb.Metadata | null): Promise<ag_ag_pb.CourseGrade>;
+
+  getCourseGrade(
+    request: ag_ag_pb.CourseGradeRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.RpcError,
+               response: ag_ag_pb.CourseGrade) => void): grpcWeb.ClientReadableStream<ag_ag_pb.CourseGrade>;
+
+  getCourseGrade(
+    request: ag_ag_pb.CourseGradeRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.RpcError,
+               response: ag_ag_pb.CourseGrade) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        this.hostname_ +
+          '/ag.AutograderService/GetCourseGrade',
+        request,
+        metadata || {},
+        this.methodInfoGetCourseGrade,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/ag.AutograderService/GetCourseGrade',
+    request,
+    metadata || {},
+    this.methodInfoGetCourseGrade);
+  }
+
   methodInfoCreateBenchmark = new grpcWeb.MethodDescriptor(
     '/ag.AutograderService/CreateBenchmark',
     grpcWeb.MethodType.UNARY,
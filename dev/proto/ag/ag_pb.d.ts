@@ -1,5 +1,6 @@
 import * as jspb from 'google-protobuf'
 
+import * as google_protobuf_timestamp_pb from 'google-protobuf/google/protobuf/timestamp_pb';
 import * as kit_score_score_pb from '../kit/score/score_pb';
 
 
@@ -234,6 +235,9 @@ export class Course extends jspb.Message {
   clearGroupsList(): Course;
   addGroups(value?: Group, index?: number): Group;
 
+  getDisplaytimezone(): string;
+  setDisplaytimezone(value: string): Course;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): Course.AsObject;
   static toObject(includeInstance: boolean, msg: Course): Course.AsObject;
@@ -259,6 +263,7 @@ export namespace Course {
     enrollmentsList: Array<Enrollment.AsObject>,
     assignmentsList: Array<Assignment.AsObject>,
     groupsList: Array<Group.AsObject>,
+    displaytimezone: string,
   }
 }
 
@@ -597,6 +602,168 @@ export class Assignment extends jspb.Message {
   getContainertimeout(): number;
   setContainertimeout(value: number): Assignment;
 
+  getPartof(): string;
+  setPartof(value: string): Assignment;
+
+  getLanguage(): string;
+  setLanguage(value: string): Assignment;
+
+  getVerbose(): boolean;
+  setVerbose(value: boolean): Assignment;
+
+  getRetries(): number;
+  setRetries(value: number): Assignment;
+
+  getTestsList(): Array<TestConfig>;
+  setTestsList(value: Array<TestConfig>): Assignment;
+  clearTestsList(): Assignment;
+  addTests(value?: TestConfig, index?: number): TestConfig;
+
+  getTestsrepourl(): string;
+  setTestsrepourl(value: string): Assignment;
+
+  getTestsreporef(): string;
+  setTestsreporef(value: string): Assignment;
+
+  getManualonly(): boolean;
+  setManualonly(value: boolean): Assignment;
+
+  getReleasedate(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setReleasedate(value?: google_protobuf_timestamp_pb.Timestamp): Assignment;
+  hasReleasedate(): boolean;
+  clearReleasedate(): Assignment;
+
+  getClosedate(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setClosedate(value?: google_protobuf_timestamp_pb.Timestamp): Assignment;
+  hasClosedate(): boolean;
+  clearClosedate(): Assignment;
+
+  getExtracreditcap(): number;
+  setExtracreditcap(value: number): Assignment;
+
+  getHidepoints(): boolean;
+  setHidepoints(value: boolean): Assignment;
+
+  getRequiredfiles(): string;
+  setRequiredfiles(value: string): Assignment;
+
+  getReviewerstrategy(): string;
+  setReviewerstrategy(value: string): Assignment;
+
+  getMutenotifications(): boolean;
+  setMutenotifications(value: boolean): Assignment;
+
+  getPlagiarismcheck(): boolean;
+  setPlagiarismcheck(value: boolean): Assignment;
+
+  getSimilaritythreshold(): number;
+  setSimilaritythreshold(value: number): Assignment;
+
+  getNetwork(): string;
+  setNetwork(value: string): Assignment;
+
+  getCourseweight(): number;
+  setCourseweight(value: number): Assignment;
+
+  getMaxparallel(): number;
+  setMaxparallel(value: number): Assignment;
+
+  getDiffmode(): string;
+  setDiffmode(value: string): Assignment;
+
+  getLatepenalty(): number;
+  setLatepenalty(value: number): Assignment;
+
+  getOutputlimit(): number;
+  setOutputlimit(value: number): Assignment;
+
+  getRetryoninfra(): number;
+  setRetryoninfra(value: number): Assignment;
+
+  getGracehours(): number;
+  setGracehours(value: number): Assignment;
+
+  getTimezone(): string;
+  setTimezone(value: string): Assignment;
+
+  getDeadlinetime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setDeadlinetime(value?: google_protobuf_timestamp_pb.Timestamp): Assignment;
+  hasDeadlinetime(): boolean;
+  clearDeadlinetime(): Assignment;
+
+  getDockerfile(): string;
+  setDockerfile(value: string): Assignment;
+
+  getDockerimage(): string;
+  setDockerimage(value: string): Assignment;
+
+  getTasksList(): Array<Task>;
+  setTasksList(value: Array<Task>): Assignment;
+  clearTasksList(): Assignment;
+  addTasks(value?: Task, index?: number): Task;
+
+  getRequires(): string;
+  setRequires(value: string): Assignment;
+
+  getMaxattempts(): number;
+  setMaxattempts(value: number): Assignment;
+
+  getCooldownminutes(): number;
+  setCooldownminutes(value: number): Assignment;
+
+  getRandomseed(): boolean;
+  setRandomseed(value: boolean): Assignment;
+
+  getSecrets(): string;
+  setSecrets(value: string): Assignment;
+
+  getApprovalruns(): number;
+  setApprovalruns(value: number): Assignment;
+
+  getApprovebeforedeadline(): boolean;
+  setApprovebeforedeadline(value: boolean): Assignment;
+
+  getManualreview(): boolean;
+  setManualreview(value: boolean): Assignment;
+
+  getCpulimit(): number;
+  setCpulimit(value: number): Assignment;
+
+  getMemorylimit(): number;
+  setMemorylimit(value: number): Assignment;
+
+  getPidslimit(): number;
+  setPidslimit(value: number): Assignment;
+
+  getDisklimit(): number;
+  setDisklimit(value: number): Assignment;
+
+  getAllowedhosts(): string;
+  setAllowedhosts(value: string): Assignment;
+
+  getMaxreruns(): number;
+  setMaxreruns(value: number): Assignment;
+
+  getCoverageprofile(): string;
+  setCoverageprofile(value: string): Assignment;
+
+  getCoverageweight(): number;
+  setCoverageweight(value: number): Assignment;
+
+  getCoveragetarget(): number;
+  setCoveragetarget(value: number): Assignment;
+
+  getAnalysischecksList(): Array<AnalysisCheck>;
+  setAnalysischecksList(value: Array<AnalysisCheck>): Assignment;
+  clearAnalysischecksList(): Assignment;
+  addAnalysischecks(value?: AnalysisCheck, index?: number): AnalysisCheck;
+
+  getMatrix(): string;
+  setMatrix(value: string): Assignment;
+
+  getTestformat(): string;
+  setTestformat(value: string): Assignment;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): Assignment.AsObject;
   static toObject(includeInstance: boolean, msg: Assignment): Assignment.AsObject;
@@ -620,6 +787,148 @@ export namespace Assignment {
     submissionsList: Array<Submission.AsObject>,
     gradingbenchmarksList: Array<GradingBenchmark.AsObject>,
     containertimeout: number,
+    partof: string,
+    language: string,
+    verbose: boolean,
+    retries: number,
+    testsList: Array<TestConfig.AsObject>,
+    testsrepourl: string,
+    testsreporef: string,
+    manualonly: boolean,
+    releasedate?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    closedate?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    extracreditcap: number,
+    hidepoints: boolean,
+    requiredfiles: string,
+    reviewerstrategy: string,
+    mutenotifications: boolean,
+    plagiarismcheck: boolean,
+    similaritythreshold: number,
+    network: string,
+    courseweight: number,
+    maxparallel: number,
+    diffmode: string,
+    latepenalty: number,
+    outputlimit: number,
+    retryoninfra: number,
+    gracehours: number,
+    timezone: string,
+    deadlinetime?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    dockerfile: string,
+    dockerimage: string,
+    tasksList: Array<Task.AsObject>,
+    requires: string,
+    maxattempts: number,
+    cooldownminutes: number,
+    randomseed: boolean,
+    secrets: string,
+    approvalruns: number,
+    approvebeforedeadline: boolean,
+    manualreview: boolean,
+    cpulimit: number,
+    memorylimit: number,
+    pidslimit: number,
+    disklimit: number,
+    allowedhosts: string,
+    maxreruns: number,
+    coverageprofile: string,
+    coverageweight: number,
+    coveragetarget: number,
+    analysischecksList: Array<AnalysisCheck.AsObject>,
+    matrix: string,
+    testformat: string,
+  }
+}
+
+export class TestConfig extends jspb.Message {
+  getId(): number;
+  setId(value: number): TestConfig;
+
+  getAssignmentid(): number;
+  setAssignmentid(value: number): TestConfig;
+
+  getTestname(): string;
+  setTestname(value: string): TestConfig;
+
+  getRetryable(): boolean;
+  setRetryable(value: boolean): TestConfig;
+
+  getExtracredit(): boolean;
+  setExtracredit(value: boolean): TestConfig;
+
+  getMaxscore(): number;
+  setMaxscore(value: number): TestConfig;
+
+  getWeight(): number;
+  setWeight(value: number): TestConfig;
+
+  getPassthreshold(): number;
+  setPassthreshold(value: number): TestConfig;
+
+  getHidden(): boolean;
+  setHidden(value: boolean): TestConfig;
+
+  getRequiredforapproval(): boolean;
+  setRequiredforapproval(value: boolean): TestConfig;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): TestConfig.AsObject;
+  static toObject(includeInstance: boolean, msg: TestConfig): TestConfig.AsObject;
+  static serializeBinaryToWriter(message: TestConfig, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): TestConfig;
+  static deserializeBinaryFromReader(message: TestConfig, reader: jspb.BinaryReader): TestConfig;
+}
+
+export namespace TestConfig {
+  export type AsObject = {
+    id: number,
+    assignmentid: number,
+    testname: string,
+    retryable: boolean,
+    extracredit: boolean,
+    maxscore: number,
+    weight: number,
+    passthreshold: number,
+    hidden: boolean,
+    requiredforapproval: boolean,
+  }
+}
+
+export class AnalysisCheck extends jspb.Message {
+  getId(): number;
+  setId(value: number): AnalysisCheck;
+
+  getAssignmentid(): number;
+  setAssignmentid(value: number): AnalysisCheck;
+
+  getName(): string;
+  setName(value: string): AnalysisCheck;
+
+  getCommand(): string;
+  setCommand(value: string): AnalysisCheck;
+
+  getWeight(): number;
+  setWeight(value: number): AnalysisCheck;
+
+  getPenalty(): number;
+  setPenalty(value: number): AnalysisCheck;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AnalysisCheck.AsObject;
+  static toObject(includeInstance: boolean, msg: AnalysisCheck): AnalysisCheck.AsObject;
+  static serializeBinaryToWriter(message: AnalysisCheck, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AnalysisCheck;
+  static deserializeBinaryFromReader(message: AnalysisCheck, reader: jspb.BinaryReader): AnalysisCheck;
+}
+
+export namespace AnalysisCheck {
+  export type AsObject = {
+    id: number,
+    assignmentid: number,
+    name: string,
+    command: string,
+    weight: number,
+    penalty: number,
   }
 }
 
@@ -643,6 +952,82 @@ export namespace Assignments {
   }
 }
 
+export class Task extends jspb.Message {
+  getId(): number;
+  setId(value: number): Task;
+
+  getAssignmentid(): number;
+  setAssignmentid(value: number): Task;
+
+  getName(): string;
+  setName(value: string): Task;
+
+  getTitle(): string;
+  setTitle(value: string): Task;
+
+  getBody(): string;
+  setBody(value: string): Task;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): Task.AsObject;
+  static toObject(includeInstance: boolean, msg: Task): Task.AsObject;
+  static serializeBinaryToWriter(message: Task, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Task;
+  static deserializeBinaryFromReader(message: Task, reader: jspb.BinaryReader): Task;
+}
+
+export namespace Task {
+  export type AsObject = {
+    id: number,
+    assignmentid: number,
+    name: string,
+    title: string,
+    body: string,
+  }
+}
+
+export class Issue extends jspb.Message {
+  getId(): number;
+  setId(value: number): Issue;
+
+  getRepositoryid(): number;
+  setRepositoryid(value: number): Issue;
+
+  getAssignmentid(): number;
+  setAssignmentid(value: number): Issue;
+
+  getTaskname(): string;
+  setTaskname(value: string): Issue;
+
+  getIssuenumber(): number;
+  setIssuenumber(value: number): Issue;
+
+  getTitle(): string;
+  setTitle(value: string): Issue;
+
+  getBody(): string;
+  setBody(value: string): Issue;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): Issue.AsObject;
+  static toObject(includeInstance: boolean, msg: Issue): Issue.AsObject;
+  static serializeBinaryToWriter(message: Issue, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Issue;
+  static deserializeBinaryFromReader(message: Issue, reader: jspb.BinaryReader): Issue;
+}
+
+export namespace Issue {
+  export type AsObject = {
+    id: number,
+    repositoryid: number,
+    assignmentid: number,
+    taskname: string,
+    issuenumber: number,
+    title: string,
+    body: string,
+  }
+}
+
 export class Submission extends jspb.Message {
   getId(): number;
   setId(value: number): Submission;
@@ -686,6 +1071,28 @@ export class Submission extends jspb.Message {
   clearScoresList(): Submission;
   addScores(value?: kit_score_score_pb.Score, index?: number): kit_score_score_pb.Score;
 
+  getAttempts(): number;
+  setAttempts(value: number): Submission;
+
+  getPassstreak(): number;
+  setPassstreak(value: number): Submission;
+
+  getReruns(): number;
+  setReruns(value: number): Submission;
+
+  getCoveragepercent(): number;
+  setCoveragepercent(value: number): Submission;
+
+  getAnnotationsList(): Array<Annotation>;
+  setAnnotationsList(value: Array<Annotation>): Submission;
+  clearAnnotationsList(): Submission;
+  addAnnotations(value?: Annotation, index?: number): Annotation;
+
+  getMatrixresultsList(): Array<MatrixResult>;
+  setMatrixresultsList(value: Array<MatrixResult>): Submission;
+  clearMatrixresultsList(): Submission;
+  addMatrixresults(value?: MatrixResult, index?: number): MatrixResult;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): Submission.AsObject;
   static toObject(includeInstance: boolean, msg: Submission): Submission.AsObject;
@@ -708,6 +1115,12 @@ export namespace Submission {
     reviewsList: Array<Review.AsObject>,
     buildinfo?: kit_score_score_pb.BuildInfo.AsObject,
     scoresList: Array<kit_score_score_pb.Score.AsObject>,
+    attempts: number,
+    passstreak: number,
+    reruns: number,
+    coveragepercent: number,
+    annotationsList: Array<Annotation.AsObject>,
+    matrixresultsList: Array<MatrixResult.AsObject>,
   }
 
   export enum Status { 
@@ -718,6 +1131,90 @@ export namespace Submission {
   }
 }
 
+export class MatrixResult extends jspb.Message {
+  getId(): number;
+  setId(value: number): MatrixResult;
+
+  getSubmissionid(): number;
+  setSubmissionid(value: number): MatrixResult;
+
+  getName(): string;
+  setName(value: string): MatrixResult;
+
+  getImage(): string;
+  setImage(value: string): MatrixResult;
+
+  getScore(): number;
+  setScore(value: number): MatrixResult;
+
+  getBuildlog(): string;
+  setBuildlog(value: string): MatrixResult;
+
+  getExectime(): number;
+  setExectime(value: number): MatrixResult;
+
+  getTimedout(): boolean;
+  setTimedout(value: boolean): MatrixResult;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): MatrixResult.AsObject;
+  static toObject(includeInstance: boolean, msg: MatrixResult): MatrixResult.AsObject;
+  static serializeBinaryToWriter(message: MatrixResult, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): MatrixResult;
+  static deserializeBinaryFromReader(message: MatrixResult, reader: jspb.BinaryReader): MatrixResult;
+}
+
+export namespace MatrixResult {
+  export type AsObject = {
+    id: number,
+    submissionid: number,
+    name: string,
+    image: string,
+    score: number,
+    buildlog: string,
+    exectime: number,
+    timedout: boolean,
+  }
+}
+
+export class Annotation extends jspb.Message {
+  getId(): number;
+  setId(value: number): Annotation;
+
+  getSubmissionid(): number;
+  setSubmissionid(value: number): Annotation;
+
+  getCheckname(): string;
+  setCheckname(value: string): Annotation;
+
+  getFile(): string;
+  setFile(value: string): Annotation;
+
+  getLine(): number;
+  setLine(value: number): Annotation;
+
+  getMessage(): string;
+  setMessage(value: string): Annotation;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): Annotation.AsObject;
+  static toObject(includeInstance: boolean, msg: Annotation): Annotation.AsObject;
+  static serializeBinaryToWriter(message: Annotation, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Annotation;
+  static deserializeBinaryFromReader(message: Annotation, reader: jspb.BinaryReader): Annotation;
+}
+
+export namespace Annotation {
+  export type AsObject = {
+    id: number,
+    submissionid: number,
+    checkname: string,
+    file: string,
+    line: number,
+    message: string,
+  }
+}
+
 export class Submissions extends jspb.Message {
   getSubmissionsList(): Array<Submission>;
   setSubmissionsList(value: Array<Submission>): Submissions;
@@ -738,6 +1235,218 @@ export namespace Submissions {
   }
 }
 
+export class Artifact extends jspb.Message {
+  getId(): number;
+  setId(value: number): Artifact;
+
+  getSubmissionid(): number;
+  setSubmissionid(value: number): Artifact;
+
+  getName(): string;
+  setName(value: string): Artifact;
+
+  getSize(): number;
+  setSize(value: number): Artifact;
+
+  getContent(): Uint8Array | string;
+  getContent_asU8(): Uint8Array;
+  getContent_asB64(): string;
+  setContent(value: Uint8Array | string): Artifact;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): Artifact.AsObject;
+  static toObject(includeInstance: boolean, msg: Artifact): Artifact.AsObject;
+  static serializeBinaryToWriter(message: Artifact, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Artifact;
+  static deserializeBinaryFromReader(message: Artifact, reader: jspb.BinaryReader): Artifact;
+}
+
+export namespace Artifact {
+  export type AsObject = {
+    id: number,
+    submissionid: number,
+    name: string,
+    size: number,
+    content: Uint8Array | string,
+  }
+}
+
+export class Artifacts extends jspb.Message {
+  getArtifactsList(): Array<Artifact>;
+  setArtifactsList(value: Array<Artifact>): Artifacts;
+  clearArtifactsList(): Artifacts;
+  addArtifacts(value?: Artifact, index?: number): Artifact;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): Artifacts.AsObject;
+  static toObject(includeInstance: boolean, msg: Artifacts): Artifacts.AsObject;
+  static serializeBinaryToWriter(message: Artifacts, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Artifacts;
+  static deserializeBinaryFromReader(message: Artifacts, reader: jspb.BinaryReader): Artifacts;
+}
+
+export namespace Artifacts {
+  export type AsObject = {
+    artifactsList: Array<Artifact.AsObject>,
+  }
+}
+
+export class DeadlineExtension extends jspb.Message {
+  getId(): number;
+  setId(value: number): DeadlineExtension;
+
+  getAssignmentid(): number;
+  setAssignmentid(value: number): DeadlineExtension;
+
+  getUserid(): number;
+  setUserid(value: number): DeadlineExtension;
+
+  getGroupid(): number;
+  setGroupid(value: number): DeadlineExtension;
+
+  getDeadline(): string;
+  setDeadline(value: string): DeadlineExtension;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): DeadlineExtension.AsObject;
+  static toObject(includeInstance: boolean, msg: DeadlineExtension): DeadlineExtension.AsObject;
+  static serializeBinaryToWriter(message: DeadlineExtension, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): DeadlineExtension;
+  static deserializeBinaryFromReader(message: DeadlineExtension, reader: jspb.BinaryReader): DeadlineExtension;
+}
+
+export namespace DeadlineExtension {
+  export type AsObject = {
+    id: number,
+    assignmentid: number,
+    userid: number,
+    groupid: number,
+    deadline: string,
+  }
+}
+
+export class CourseSecret extends jspb.Message {
+  getId(): number;
+  setId(value: number): CourseSecret;
+
+  getCourseid(): number;
+  setCourseid(value: number): CourseSecret;
+
+  getName(): string;
+  setName(value: string): CourseSecret;
+
+  getValue(): string;
+  setValue(value: string): CourseSecret;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CourseSecret.AsObject;
+  static toObject(includeInstance: boolean, msg: CourseSecret): CourseSecret.AsObject;
+  static serializeBinaryToWriter(message: CourseSecret, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CourseSecret;
+  static deserializeBinaryFromReader(message: CourseSecret, reader: jspb.BinaryReader): CourseSecret;
+}
+
+export namespace CourseSecret {
+  export type AsObject = {
+    id: number,
+    courseid: number,
+    name: string,
+    value: string,
+  }
+}
+
+export class CourseModule extends jspb.Message {
+  getId(): number;
+  setId(value: number): CourseModule;
+
+  getCourseid(): number;
+  setCourseid(value: number): CourseModule;
+
+  getName(): string;
+  setName(value: string): CourseModule;
+
+  getWeight(): number;
+  setWeight(value: number): CourseModule;
+
+  getAssignments(): string;
+  setAssignments(value: string): CourseModule;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CourseModule.AsObject;
+  static toObject(includeInstance: boolean, msg: CourseModule): CourseModule.AsObject;
+  static serializeBinaryToWriter(message: CourseModule, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CourseModule;
+  static deserializeBinaryFromReader(message: CourseModule, reader: jspb.BinaryReader): CourseModule;
+}
+
+export namespace CourseModule {
+  export type AsObject = {
+    id: number,
+    courseid: number,
+    name: string,
+    weight: number,
+    assignments: string,
+  }
+}
+
+export class ModuleGrade extends jspb.Message {
+  getName(): string;
+  setName(value: string): ModuleGrade;
+
+  getWeight(): number;
+  setWeight(value: number): ModuleGrade;
+
+  getGrade(): number;
+  setGrade(value: number): ModuleGrade;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ModuleGrade.AsObject;
+  static toObject(includeInstance: boolean, msg: ModuleGrade): ModuleGrade.AsObject;
+  static serializeBinaryToWriter(message: ModuleGrade, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ModuleGrade;
+  static deserializeBinaryFromReader(message: ModuleGrade, reader: jspb.BinaryReader): ModuleGrade;
+}
+
+export namespace ModuleGrade {
+  export type AsObject = {
+    name: string,
+    weight: number,
+    grade: number,
+  }
+}
+
+export class CourseGrade extends jspb.Message {
+  getCourseid(): number;
+  setCourseid(value: number): CourseGrade;
+
+  getUserid(): number;
+  setUserid(value: number): CourseGrade;
+
+  getGrade(): number;
+  setGrade(value: number): CourseGrade;
+
+  getModulesList(): Array<ModuleGrade>;
+  setModulesList(value: Array<ModuleGrade>): CourseGrade;
+  clearModulesList(): CourseGrade;
+  addModules(value?: ModuleGrade, index?: number): ModuleGrade;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CourseGrade.AsObject;
+  static toObject(includeInstance: boolean, msg: CourseGrade): CourseGrade.AsObject;
+  static serializeBinaryToWriter(message: CourseGrade, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CourseGrade;
+  static deserializeBinaryFromReader(message: CourseGrade, reader: jspb.BinaryReader): CourseGrade;
+}
+
+export namespace CourseGrade {
+  export type AsObject = {
+    courseid: number,
+    userid: number,
+    grade: number,
+    modulesList: Array<ModuleGrade.AsObject>,
+  }
+}
+
 export class GradingBenchmark extends jspb.Message {
   getId(): number;
   setId(value: number): GradingBenchmark;
@@ -759,6 +1468,9 @@ export class GradingBenchmark extends jspb.Message {
   clearCriteriaList(): GradingBenchmark;
   addCriteria(value?: GradingCriterion, index?: number): GradingCriterion;
 
+  getWeight(): number;
+  setWeight(value: number): GradingBenchmark;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): GradingBenchmark.AsObject;
   static toObject(includeInstance: boolean, msg: GradingBenchmark): GradingBenchmark.AsObject;
@@ -775,6 +1487,7 @@ export namespace GradingBenchmark {
     heading: string,
     comment: string,
     criteriaList: Array<GradingCriterion.AsObject>,
+    weight: number,
   }
 }
 
@@ -952,6 +1665,114 @@ export namespace CourseRequest {
   }
 }
 
+export class BuildLogRequest extends jspb.Message {
+  getCourseid(): number;
+  setCourseid(value: number): BuildLogRequest;
+
+  getAssignmentid(): number;
+  setAssignmentid(value: number): BuildLogRequest;
+
+  getUserid(): number;
+  setUserid(value: number): BuildLogRequest;
+
+  getGroupid(): number;
+  setGroupid(value: number): BuildLogRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): BuildLogRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: BuildLogRequest): BuildLogRequest.AsObject;
+  static serializeBinaryToWriter(message: BuildLogRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): BuildLogRequest;
+  static deserializeBinaryFromReader(message: BuildLogRequest, reader: jspb.BinaryReader): BuildLogRequest;
+}
+
+export namespace BuildLogRequest {
+  export type AsObject = {
+    courseid: number,
+    assignmentid: number,
+    userid: number,
+    groupid: number,
+  }
+}
+
+export class BuildLogChunk extends jspb.Message {
+  getLog(): string;
+  setLog(value: string): BuildLogChunk;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): BuildLogChunk.AsObject;
+  static toObject(includeInstance: boolean, msg: BuildLogChunk): BuildLogChunk.AsObject;
+  static serializeBinaryToWriter(message: BuildLogChunk, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): BuildLogChunk;
+  static deserializeBinaryFromReader(message: BuildLogChunk, reader: jspb.BinaryReader): BuildLogChunk;
+}
+
+export namespace BuildLogChunk {
+  export type AsObject = {
+    log: string,
+  }
+}
+
+export class RegradeProgress extends jspb.Message {
+  getAssignmentid(): number;
+  setAssignmentid(value: number): RegradeProgress;
+
+  getTriggeredby(): string;
+  setTriggeredby(value: string): RegradeProgress;
+
+  getTotal(): number;
+  setTotal(value: number): RegradeProgress;
+
+  getCompleted(): number;
+  setCompleted(value: number): RegradeProgress;
+
+  getFailed(): number;
+  setFailed(value: number): RegradeProgress;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RegradeProgress.AsObject;
+  static toObject(includeInstance: boolean, msg: RegradeProgress): RegradeProgress.AsObject;
+  static serializeBinaryToWriter(message: RegradeProgress, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RegradeProgress;
+  static deserializeBinaryFromReader(message: RegradeProgress, reader: jspb.BinaryReader): RegradeProgress;
+}
+
+export namespace RegradeProgress {
+  export type AsObject = {
+    assignmentid: number,
+    triggeredby: string,
+    total: number,
+    completed: number,
+    failed: number,
+  }
+}
+
+export class SubmissionQueue extends jspb.Message {
+  getPending(): number;
+  setPending(value: number): SubmissionQueue;
+
+  getRunning(): number;
+  setRunning(value: number): SubmissionQueue;
+
+  getPosition(): number;
+  setPosition(value: number): SubmissionQueue;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SubmissionQueue.AsObject;
+  static toObject(includeInstance: boolean, msg: SubmissionQueue): SubmissionQueue.AsObject;
+  static serializeBinaryToWriter(message: SubmissionQueue, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SubmissionQueue;
+  static deserializeBinaryFromReader(message: SubmissionQueue, reader: jspb.BinaryReader): SubmissionQueue;
+}
+
+export namespace SubmissionQueue {
+  export type AsObject = {
+    pending: number,
+    running: number,
+    position: number,
+  }
+}
+
 export class UserRequest extends jspb.Message {
   getUserid(): number;
   setUserid(value: number): UserRequest;
@@ -1250,6 +2071,32 @@ export namespace UpdateSubmissionsRequest {
   }
 }
 
+export class ArtifactRequest extends jspb.Message {
+  getCourseid(): number;
+  setCourseid(value: number): ArtifactRequest;
+
+  getSubmissionid(): number;
+  setSubmissionid(value: number): ArtifactRequest;
+
+  getName(): string;
+  setName(value: string): ArtifactRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ArtifactRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ArtifactRequest): ArtifactRequest.AsObject;
+  static serializeBinaryToWriter(message: ArtifactRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ArtifactRequest;
+  static deserializeBinaryFromReader(message: ArtifactRequest, reader: jspb.BinaryReader): ArtifactRequest;
+}
+
+export namespace ArtifactRequest {
+  export type AsObject = {
+    courseid: number,
+    submissionid: number,
+    name: string,
+  }
+}
+
 export class SubmissionReviewersRequest extends jspb.Message {
   getSubmissionid(): number;
   setSubmissionid(value: number): SubmissionReviewersRequest;
@@ -1502,6 +2349,148 @@ export namespace AssignmentRequest {
   }
 }
 
+export class DeadlineExtensionRequest extends jspb.Message {
+  getCourseid(): number;
+  setCourseid(value: number): DeadlineExtensionRequest;
+
+  getExtension(): DeadlineExtension | undefined;
+  setExtension(value?: DeadlineExtension): DeadlineExtensionRequest;
+  hasExtension(): boolean;
+  clearExtension(): DeadlineExtensionRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): DeadlineExtensionRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: DeadlineExtensionRequest): DeadlineExtensionRequest.AsObject;
+  static serializeBinaryToWriter(message: DeadlineExtensionRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): DeadlineExtensionRequest;
+  static deserializeBinaryFromReader(message: DeadlineExtensionRequest, reader: jspb.BinaryReader): DeadlineExtensionRequest;
+}
+
+export namespace DeadlineExtensionRequest {
+  export type AsObject = {
+    courseid: number,
+    extension?: DeadlineExtension.AsObject,
+  }
+}
+
+export class CourseGradeRequest extends jspb.Message {
+  getCourseid(): number;
+  setCourseid(value: number): CourseGradeRequest;
+
+  getUserid(): number;
+  setUserid(value: number): CourseGradeRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CourseGradeRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: CourseGradeRequest): CourseGradeRequest.AsObject;
+  static serializeBinaryToWriter(message: CourseGradeRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CourseGradeRequest;
+  static deserializeBinaryFromReader(message: CourseGradeRequest, reader: jspb.BinaryReader): CourseGradeRequest;
+}
+
+export namespace CourseGradeRequest {
+  export type AsObject = {
+    courseid: number,
+    userid: number,
+  }
+}
+
+export class CourseRepositoryValidation extends jspb.Message {
+  getCourseid(): number;
+  setCourseid(value: number): CourseRepositoryValidation;
+
+  getAssignmentsList(): Array<Assignment>;
+  setAssignmentsList(value: Array<Assignment>): CourseRepositoryValidation;
+  clearAssignmentsList(): CourseRepositoryValidation;
+  addAssignments(value?: Assignment, index?: number): Assignment;
+
+  getChangedassignmentsList(): Array<string>;
+  setChangedassignmentsList(value: Array<string>): CourseRepositoryValidation;
+  clearChangedassignmentsList(): CourseRepositoryValidation;
+  addChangedassignments(value: string, index?: number): CourseRepositoryValidation;
+
+  getRemovedassignmentsList(): Array<string>;
+  setRemovedassignmentsList(value: Array<string>): CourseRepositoryValidation;
+  clearRemovedassignmentsList(): CourseRepositoryValidation;
+  addRemovedassignments(value: string, index?: number): CourseRepositoryValidation;
+
+  getWarningsList(): Array<string>;
+  setWarningsList(value: Array<string>): CourseRepositoryValidation;
+  clearWarningsList(): CourseRepositoryValidation;
+  addWarnings(value: string, index?: number): CourseRepositoryValidation;
+
+  getErrorsList(): Array<string>;
+  setErrorsList(value: Array<string>): CourseRepositoryValidation;
+  clearErrorsList(): CourseRepositoryValidation;
+  addErrors(value: string, index?: number): CourseRepositoryValidation;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CourseRepositoryValidation.AsObject;
+  static toObject(includeInstance: boolean, msg: CourseRepositoryValidation): CourseRepositoryValidation.AsObject;
+  static serializeBinaryToWriter(message: CourseRepositoryValidation, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CourseRepositoryValidation;
+  static deserializeBinaryFromReader(message: CourseRepositoryValidation, reader: jspb.BinaryReader): CourseRepositoryValidation;
+}
+
+export namespace CourseRepositoryValidation {
+  export type AsObject = {
+    courseid: number,
+    assignmentsList: Array<Assignment.AsObject>,
+    changedassignmentsList: Array<string>,
+    removedassignmentsList: Array<string>,
+    warningsList: Array<string>,
+    errorsList: Array<string>,
+  }
+}
+
+export class AssignmentArchiveRequest extends jspb.Message {
+  getCourseid(): number;
+  setCourseid(value: number): AssignmentArchiveRequest;
+
+  getAssignmentnamesList(): Array<string>;
+  setAssignmentnamesList(value: Array<string>): AssignmentArchiveRequest;
+  clearAssignmentnamesList(): AssignmentArchiveRequest;
+  addAssignmentnames(value: string, index?: number): AssignmentArchiveRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AssignmentArchiveRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: AssignmentArchiveRequest): AssignmentArchiveRequest.AsObject;
+  static serializeBinaryToWriter(message: AssignmentArchiveRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AssignmentArchiveRequest;
+  static deserializeBinaryFromReader(message: AssignmentArchiveRequest, reader: jspb.BinaryReader): AssignmentArchiveRequest;
+}
+
+export namespace AssignmentArchiveRequest {
+  export type AsObject = {
+    courseid: number,
+    assignmentnamesList: Array<string>,
+  }
+}
+
+export class AssignmentArchive extends jspb.Message {
+  getCourseid(): number;
+  setCourseid(value: number): AssignmentArchive;
+
+  getArchive(): Uint8Array | string;
+  getArchive_asU8(): Uint8Array;
+  getArchive_asB64(): string;
+  setArchive(value: Uint8Array | string): AssignmentArchive;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AssignmentArchive.AsObject;
+  static toObject(includeInstance: boolean, msg: AssignmentArchive): AssignmentArchive.AsObject;
+  static serializeBinaryToWriter(message: AssignmentArchive, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AssignmentArchive;
+  static deserializeBinaryFromReader(message: AssignmentArchive, reader: jspb.BinaryReader): AssignmentArchive;
+}
+
+export namespace AssignmentArchive {
+  export type AsObject = {
+    courseid: number,
+    archive: Uint8Array | string,
+  }
+}
+
 export class Void extends jspb.Message {
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): Void.AsObject;
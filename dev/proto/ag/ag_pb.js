@@ -21,18 +21,36 @@ var global = (function() {
   return Function('return this')();
 }.call(null));
 
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
+goog.object.extend(proto, google_protobuf_timestamp_pb);
 var kit_score_score_pb = require('../kit/score/score_pb.js');
 goog.object.extend(proto, kit_score_score_pb);
+goog.exportSymbol('proto.ag.AnalysisCheck', null, global);
+goog.exportSymbol('proto.ag.Annotation', null, global);
+goog.exportSymbol('proto.ag.Artifact', null, global);
+goog.exportSymbol('proto.ag.ArtifactRequest', null, global);
+goog.exportSymbol('proto.ag.Artifacts', null, global);
 goog.exportSymbol('proto.ag.Assignment', null, global);
+goog.exportSymbol('proto.ag.AssignmentArchive', null, global);
+goog.exportSymbol('proto.ag.AssignmentArchiveRequest', null, global);
 goog.exportSymbol('proto.ag.AssignmentRequest', null, global);
 goog.exportSymbol('proto.ag.Assignments', null, global);
 goog.exportSymbol('proto.ag.AuthorizationResponse', null, global);
 goog.exportSymbol('proto.ag.Benchmarks', null, global);
+goog.exportSymbol('proto.ag.BuildLogChunk', null, global);
+goog.exportSymbol('proto.ag.BuildLogRequest', null, global);
 goog.exportSymbol('proto.ag.Course', null, global);
+goog.exportSymbol('proto.ag.CourseGrade', null, global);
+goog.exportSymbol('proto.ag.CourseGradeRequest', null, global);
+goog.exportSymbol('proto.ag.CourseModule', null, global);
+goog.exportSymbol('proto.ag.CourseRepositoryValidation', null, global);
 goog.exportSymbol('proto.ag.CourseRequest', null, global);
+goog.exportSymbol('proto.ag.CourseSecret', null, global);
 goog.exportSymbol('proto.ag.CourseSubmissions', null, global);
 goog.exportSymbol('proto.ag.CourseUserRequest', null, global);
 goog.exportSymbol('proto.ag.Courses', null, global);
+goog.exportSymbol('proto.ag.DeadlineExtension', null, global);
+goog.exportSymbol('proto.ag.DeadlineExtensionRequest', null, global);
 goog.exportSymbol('proto.ag.Enrollment', null, global);
 goog.exportSymbol('proto.ag.Enrollment.DisplayState', null, global);
 goog.exportSymbol('proto.ag.Enrollment.UserStatus', null, global);
@@ -48,12 +66,16 @@ goog.exportSymbol('proto.ag.Group', null, global);
 goog.exportSymbol('proto.ag.Group.GroupStatus', null, global);
 goog.exportSymbol('proto.ag.GroupRequest', null, global);
 goog.exportSymbol('proto.ag.Groups', null, global);
+goog.exportSymbol('proto.ag.Issue', null, global);
+goog.exportSymbol('proto.ag.MatrixResult', null, global);
+goog.exportSymbol('proto.ag.ModuleGrade', null, global);
 goog.exportSymbol('proto.ag.OrgRequest', null, global);
 goog.exportSymbol('proto.ag.Organization', null, global);
 goog.exportSymbol('proto.ag.Organizations', null, global);
 goog.exportSymbol('proto.ag.Provider', null, global);
 goog.exportSymbol('proto.ag.Providers', null, global);
 goog.exportSymbol('proto.ag.RebuildRequest', null, global);
+goog.exportSymbol('proto.ag.RegradeProgress', null, global);
 goog.exportSymbol('proto.ag.RemoteIdentity', null, global);
 goog.exportSymbol('proto.ag.Repositories', null, global);
 goog.exportSymbol('proto.ag.Repository', null, global);
@@ -66,11 +88,14 @@ goog.exportSymbol('proto.ag.Status', null, global);
 goog.exportSymbol('proto.ag.Submission', null, global);
 goog.exportSymbol('proto.ag.Submission.Status', null, global);
 goog.exportSymbol('proto.ag.SubmissionLink', null, global);
+goog.exportSymbol('proto.ag.SubmissionQueue', null, global);
 goog.exportSymbol('proto.ag.SubmissionRequest', null, global);
 goog.exportSymbol('proto.ag.SubmissionReviewersRequest', null, global);
 goog.exportSymbol('proto.ag.Submissions', null, global);
 goog.exportSymbol('proto.ag.SubmissionsForCourseRequest', null, global);
 goog.exportSymbol('proto.ag.SubmissionsForCourseRequest.Type', null, global);
+goog.exportSymbol('proto.ag.Task', null, global);
+goog.exportSymbol('proto.ag.TestConfig', null, global);
 goog.exportSymbol('proto.ag.URLRequest', null, global);
 goog.exportSymbol('proto.ag.UpdateSubmissionRequest', null, global);
 goog.exportSymbol('proto.ag.UpdateSubmissionsRequest', null, global);
@@ -394,6 +419,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.ag.Assignment.displayName = 'proto.ag.Assignment';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.TestConfig = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.TestConfig, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.TestConfig.displayName = 'proto.ag.TestConfig';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.AnalysisCheck = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.AnalysisCheck, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.AnalysisCheck.displayName = 'proto.ag.AnalysisCheck';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -425,16 +492,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.Submission = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.Submission.repeatedFields_, null);
+proto.ag.Task = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ag.Submission, jspb.Message);
+goog.inherits(proto.ag.Task, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.Submission.displayName = 'proto.ag.Submission';
+  proto.ag.Task.displayName = 'proto.ag.Task';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -446,16 +513,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.Submissions = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.Submissions.repeatedFields_, null);
+proto.ag.Issue = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ag.Submissions, jspb.Message);
+goog.inherits(proto.ag.Issue, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.Submissions.displayName = 'proto.ag.Submissions';
+  proto.ag.Issue.displayName = 'proto.ag.Issue';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -467,16 +534,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.GradingBenchmark = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.GradingBenchmark.repeatedFields_, null);
+proto.ag.Submission = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.Submission.repeatedFields_, null);
 };
-goog.inherits(proto.ag.GradingBenchmark, jspb.Message);
+goog.inherits(proto.ag.Submission, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.GradingBenchmark.displayName = 'proto.ag.GradingBenchmark';
+  proto.ag.Submission.displayName = 'proto.ag.Submission';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -488,16 +555,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.Benchmarks = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.Benchmarks.repeatedFields_, null);
+proto.ag.MatrixResult = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ag.Benchmarks, jspb.Message);
+goog.inherits(proto.ag.MatrixResult, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.Benchmarks.displayName = 'proto.ag.Benchmarks';
+  proto.ag.MatrixResult.displayName = 'proto.ag.MatrixResult';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -509,16 +576,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.GradingCriterion = function(opt_data) {
+proto.ag.Annotation = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ag.GradingCriterion, jspb.Message);
+goog.inherits(proto.ag.Annotation, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.GradingCriterion.displayName = 'proto.ag.GradingCriterion';
+  proto.ag.Annotation.displayName = 'proto.ag.Annotation';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -530,16 +597,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.Review = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.Review.repeatedFields_, null);
+proto.ag.Submissions = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.Submissions.repeatedFields_, null);
 };
-goog.inherits(proto.ag.Review, jspb.Message);
+goog.inherits(proto.ag.Submissions, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.Review.displayName = 'proto.ag.Review';
+  proto.ag.Submissions.displayName = 'proto.ag.Submissions';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -551,16 +618,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.Reviewers = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.Reviewers.repeatedFields_, null);
+proto.ag.Artifact = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ag.Reviewers, jspb.Message);
+goog.inherits(proto.ag.Artifact, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.Reviewers.displayName = 'proto.ag.Reviewers';
+  proto.ag.Artifact.displayName = 'proto.ag.Artifact';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -572,16 +639,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.ReviewRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.ag.Artifacts = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.Artifacts.repeatedFields_, null);
 };
-goog.inherits(proto.ag.ReviewRequest, jspb.Message);
+goog.inherits(proto.ag.Artifacts, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.ReviewRequest.displayName = 'proto.ag.ReviewRequest';
+  proto.ag.Artifacts.displayName = 'proto.ag.Artifacts';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -593,16 +660,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.CourseRequest = function(opt_data) {
+proto.ag.DeadlineExtension = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ag.CourseRequest, jspb.Message);
+goog.inherits(proto.ag.DeadlineExtension, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.CourseRequest.displayName = 'proto.ag.CourseRequest';
+  proto.ag.DeadlineExtension.displayName = 'proto.ag.DeadlineExtension';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -614,16 +681,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.UserRequest = function(opt_data) {
+proto.ag.CourseSecret = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ag.UserRequest, jspb.Message);
+goog.inherits(proto.ag.CourseSecret, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.UserRequest.displayName = 'proto.ag.UserRequest';
+  proto.ag.CourseSecret.displayName = 'proto.ag.CourseSecret';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -635,16 +702,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.GetGroupRequest = function(opt_data) {
+proto.ag.CourseModule = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ag.GetGroupRequest, jspb.Message);
+goog.inherits(proto.ag.CourseModule, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.GetGroupRequest.displayName = 'proto.ag.GetGroupRequest';
+  proto.ag.CourseModule.displayName = 'proto.ag.CourseModule';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -656,16 +723,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.GroupRequest = function(opt_data) {
+proto.ag.ModuleGrade = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ag.GroupRequest, jspb.Message);
+goog.inherits(proto.ag.ModuleGrade, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.GroupRequest.displayName = 'proto.ag.GroupRequest';
+  proto.ag.ModuleGrade.displayName = 'proto.ag.ModuleGrade';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -677,16 +744,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.Provider = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.ag.CourseGrade = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.CourseGrade.repeatedFields_, null);
 };
-goog.inherits(proto.ag.Provider, jspb.Message);
+goog.inherits(proto.ag.CourseGrade, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.Provider.displayName = 'proto.ag.Provider';
+  proto.ag.CourseGrade.displayName = 'proto.ag.CourseGrade';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -698,16 +765,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.OrgRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.ag.GradingBenchmark = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.GradingBenchmark.repeatedFields_, null);
 };
-goog.inherits(proto.ag.OrgRequest, jspb.Message);
+goog.inherits(proto.ag.GradingBenchmark, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.OrgRequest.displayName = 'proto.ag.OrgRequest';
+  proto.ag.GradingBenchmark.displayName = 'proto.ag.GradingBenchmark';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -719,16 +786,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.Organization = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.ag.Benchmarks = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.Benchmarks.repeatedFields_, null);
 };
-goog.inherits(proto.ag.Organization, jspb.Message);
+goog.inherits(proto.ag.Benchmarks, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.Organization.displayName = 'proto.ag.Organization';
+  proto.ag.Benchmarks.displayName = 'proto.ag.Benchmarks';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -740,16 +807,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.Organizations = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.Organizations.repeatedFields_, null);
+proto.ag.GradingCriterion = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ag.Organizations, jspb.Message);
+goog.inherits(proto.ag.GradingCriterion, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.Organizations.displayName = 'proto.ag.Organizations';
+  proto.ag.GradingCriterion.displayName = 'proto.ag.GradingCriterion';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -761,16 +828,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.EnrollmentRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.EnrollmentRequest.repeatedFields_, null);
+proto.ag.Review = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.Review.repeatedFields_, null);
 };
-goog.inherits(proto.ag.EnrollmentRequest, jspb.Message);
+goog.inherits(proto.ag.Review, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.EnrollmentRequest.displayName = 'proto.ag.EnrollmentRequest';
+  proto.ag.Review.displayName = 'proto.ag.Review';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -782,16 +849,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.EnrollmentStatusRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.EnrollmentStatusRequest.repeatedFields_, null);
+proto.ag.Reviewers = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.Reviewers.repeatedFields_, null);
 };
-goog.inherits(proto.ag.EnrollmentStatusRequest, jspb.Message);
+goog.inherits(proto.ag.Reviewers, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.EnrollmentStatusRequest.displayName = 'proto.ag.EnrollmentStatusRequest';
+  proto.ag.Reviewers.displayName = 'proto.ag.Reviewers';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -803,16 +870,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.SubmissionRequest = function(opt_data) {
+proto.ag.ReviewRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ag.SubmissionRequest, jspb.Message);
+goog.inherits(proto.ag.ReviewRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.SubmissionRequest.displayName = 'proto.ag.SubmissionRequest';
+  proto.ag.ReviewRequest.displayName = 'proto.ag.ReviewRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -824,16 +891,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.UpdateSubmissionRequest = function(opt_data) {
+proto.ag.CourseRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ag.UpdateSubmissionRequest, jspb.Message);
+goog.inherits(proto.ag.CourseRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.UpdateSubmissionRequest.displayName = 'proto.ag.UpdateSubmissionRequest';
+  proto.ag.CourseRequest.displayName = 'proto.ag.CourseRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -845,16 +912,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.UpdateSubmissionsRequest = function(opt_data) {
+proto.ag.BuildLogRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ag.UpdateSubmissionsRequest, jspb.Message);
+goog.inherits(proto.ag.BuildLogRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.UpdateSubmissionsRequest.displayName = 'proto.ag.UpdateSubmissionsRequest';
+  proto.ag.BuildLogRequest.displayName = 'proto.ag.BuildLogRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -866,16 +933,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.SubmissionReviewersRequest = function(opt_data) {
+proto.ag.BuildLogChunk = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ag.SubmissionReviewersRequest, jspb.Message);
+goog.inherits(proto.ag.BuildLogChunk, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.SubmissionReviewersRequest.displayName = 'proto.ag.SubmissionReviewersRequest';
+  proto.ag.BuildLogChunk.displayName = 'proto.ag.BuildLogChunk';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -887,16 +954,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.Providers = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.Providers.repeatedFields_, null);
+proto.ag.RegradeProgress = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ag.Providers, jspb.Message);
+goog.inherits(proto.ag.RegradeProgress, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.Providers.displayName = 'proto.ag.Providers';
+  proto.ag.RegradeProgress.displayName = 'proto.ag.RegradeProgress';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -908,16 +975,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.URLRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.URLRequest.repeatedFields_, null);
+proto.ag.SubmissionQueue = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ag.URLRequest, jspb.Message);
+goog.inherits(proto.ag.SubmissionQueue, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.URLRequest.displayName = 'proto.ag.URLRequest';
+  proto.ag.SubmissionQueue.displayName = 'proto.ag.SubmissionQueue';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -929,16 +996,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.RepositoryRequest = function(opt_data) {
+proto.ag.UserRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ag.RepositoryRequest, jspb.Message);
+goog.inherits(proto.ag.UserRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.RepositoryRequest.displayName = 'proto.ag.RepositoryRequest';
+  proto.ag.UserRequest.displayName = 'proto.ag.UserRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -950,16 +1017,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.Repositories = function(opt_data) {
+proto.ag.GetGroupRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ag.Repositories, jspb.Message);
+goog.inherits(proto.ag.GetGroupRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.Repositories.displayName = 'proto.ag.Repositories';
+  proto.ag.GetGroupRequest.displayName = 'proto.ag.GetGroupRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -971,16 +1038,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.AuthorizationResponse = function(opt_data) {
+proto.ag.GroupRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ag.AuthorizationResponse, jspb.Message);
+goog.inherits(proto.ag.GroupRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.AuthorizationResponse.displayName = 'proto.ag.AuthorizationResponse';
+  proto.ag.GroupRequest.displayName = 'proto.ag.GroupRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -992,16 +1059,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.Status = function(opt_data) {
+proto.ag.Provider = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ag.Status, jspb.Message);
+goog.inherits(proto.ag.Provider, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.Status.displayName = 'proto.ag.Status';
+  proto.ag.Provider.displayName = 'proto.ag.Provider';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1013,16 +1080,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.SubmissionsForCourseRequest = function(opt_data) {
+proto.ag.OrgRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ag.SubmissionsForCourseRequest, jspb.Message);
+goog.inherits(proto.ag.OrgRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.SubmissionsForCourseRequest.displayName = 'proto.ag.SubmissionsForCourseRequest';
+  proto.ag.OrgRequest.displayName = 'proto.ag.OrgRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1034,16 +1101,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.RebuildRequest = function(opt_data) {
+proto.ag.Organization = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ag.RebuildRequest, jspb.Message);
+goog.inherits(proto.ag.Organization, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.RebuildRequest.displayName = 'proto.ag.RebuildRequest';
+  proto.ag.Organization.displayName = 'proto.ag.Organization';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1055,16 +1122,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.CourseUserRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.ag.Organizations = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.Organizations.repeatedFields_, null);
 };
-goog.inherits(proto.ag.CourseUserRequest, jspb.Message);
+goog.inherits(proto.ag.Organizations, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.CourseUserRequest.displayName = 'proto.ag.CourseUserRequest';
+  proto.ag.Organizations.displayName = 'proto.ag.Organizations';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1076,16 +1143,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.AssignmentRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.ag.EnrollmentRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.EnrollmentRequest.repeatedFields_, null);
 };
-goog.inherits(proto.ag.AssignmentRequest, jspb.Message);
+goog.inherits(proto.ag.EnrollmentRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.AssignmentRequest.displayName = 'proto.ag.AssignmentRequest';
+  proto.ag.EnrollmentRequest.displayName = 'proto.ag.EnrollmentRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1097,42 +1164,7190 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.Void = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.ag.EnrollmentStatusRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.EnrollmentStatusRequest.repeatedFields_, null);
 };
-goog.inherits(proto.ag.Void, jspb.Message);
+goog.inherits(proto.ag.EnrollmentStatusRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.Void.displayName = 'proto.ag.Void';
+  proto.ag.EnrollmentStatusRequest.displayName = 'proto.ag.EnrollmentStatusRequest';
 }
-
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.ag.User.repeatedFields_ = [8,9];
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
-/**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.ag.User.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.User.toObject(opt_includeInstance, this);
+proto.ag.SubmissionRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.SubmissionRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.SubmissionRequest.displayName = 'proto.ag.SubmissionRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.UpdateSubmissionRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.UpdateSubmissionRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.UpdateSubmissionRequest.displayName = 'proto.ag.UpdateSubmissionRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.UpdateSubmissionsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.UpdateSubmissionsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.UpdateSubmissionsRequest.displayName = 'proto.ag.UpdateSubmissionsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.ArtifactRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.ArtifactRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.ArtifactRequest.displayName = 'proto.ag.ArtifactRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.SubmissionReviewersRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.SubmissionReviewersRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.SubmissionReviewersRequest.displayName = 'proto.ag.SubmissionReviewersRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.Providers = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.Providers.repeatedFields_, null);
+};
+goog.inherits(proto.ag.Providers, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.Providers.displayName = 'proto.ag.Providers';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.URLRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.URLRequest.repeatedFields_, null);
+};
+goog.inherits(proto.ag.URLRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.URLRequest.displayName = 'proto.ag.URLRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.RepositoryRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.RepositoryRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.RepositoryRequest.displayName = 'proto.ag.RepositoryRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.Repositories = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.Repositories, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.Repositories.displayName = 'proto.ag.Repositories';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.AuthorizationResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.AuthorizationResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.AuthorizationResponse.displayName = 'proto.ag.AuthorizationResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.Status = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.Status, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.Status.displayName = 'proto.ag.Status';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.SubmissionsForCourseRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.SubmissionsForCourseRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.SubmissionsForCourseRequest.displayName = 'proto.ag.SubmissionsForCourseRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.RebuildRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.RebuildRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.RebuildRequest.displayName = 'proto.ag.RebuildRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.CourseUserRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.CourseUserRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.CourseUserRequest.displayName = 'proto.ag.CourseUserRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.AssignmentRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.AssignmentRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.AssignmentRequest.displayName = 'proto.ag.AssignmentRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.DeadlineExtensionRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.DeadlineExtensionRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.DeadlineExtensionRequest.displayName = 'proto.ag.DeadlineExtensionRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.CourseGradeRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.CourseGradeRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.CourseGradeRequest.displayName = 'proto.ag.CourseGradeRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.CourseRepositoryValidation = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.CourseRepositoryValidation.repeatedFields_, null);
+};
+goog.inherits(proto.ag.CourseRepositoryValidation, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.CourseRepositoryValidation.displayName = 'proto.ag.CourseRepositoryValidation';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.AssignmentArchiveRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.AssignmentArchiveRequest.repeatedFields_, null);
+};
+goog.inherits(proto.ag.AssignmentArchiveRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.AssignmentArchiveRequest.displayName = 'proto.ag.AssignmentArchiveRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.AssignmentArchive = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.AssignmentArchive, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.AssignmentArchive.displayName = 'proto.ag.AssignmentArchive';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.Void = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.Void, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.Void.displayName = 'proto.ag.Void';
+}
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.User.repeatedFields_ = [8,9];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.User.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.User.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.User} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.User.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    isadmin: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    name: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    studentid: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    email: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    avatarurl: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    login: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    remoteidentitiesList: jspb.Message.toObjectList(msg.getRemoteidentitiesList(),
+    proto.ag.RemoteIdentity.toObject, includeInstance),
+    enrollmentsList: jspb.Message.toObjectList(msg.getEnrollmentsList(),
+    proto.ag.Enrollment.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.User}
+ */
+proto.ag.User.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.User;
+  return proto.ag.User.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.User} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.User}
+ */
+proto.ag.User.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsadmin(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStudentid(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEmail(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAvatarurl(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setLogin(value);
+      break;
+    case 8:
+      var value = new proto.ag.RemoteIdentity;
+      reader.readMessage(value,proto.ag.RemoteIdentity.deserializeBinaryFromReader);
+      msg.addRemoteidentities(value);
+      break;
+    case 9:
+      var value = new proto.ag.Enrollment;
+      reader.readMessage(value,proto.ag.Enrollment.deserializeBinaryFromReader);
+      msg.addEnrollments(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.User.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.User.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.User} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.User.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getIsadmin();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getStudentid();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getEmail();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getAvatarurl();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getLogin();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getRemoteidentitiesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      8,
+      f,
+      proto.ag.RemoteIdentity.serializeBinaryToWriter
+    );
+  }
+  f = message.getEnrollmentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      9,
+      f,
+      proto.ag.Enrollment.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.ag.User.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.User} returns this
+ */
+proto.ag.User.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional bool isAdmin = 2;
+ * @return {boolean}
+ */
+proto.ag.User.prototype.getIsadmin = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ag.User} returns this
+ */
+proto.ag.User.prototype.setIsadmin = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+/**
+ * optional string name = 3;
+ * @return {string}
+ */
+proto.ag.User.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.User} returns this
+ */
+proto.ag.User.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string studentID = 4;
+ * @return {string}
+ */
+proto.ag.User.prototype.getStudentid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.User} returns this
+ */
+proto.ag.User.prototype.setStudentid = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string email = 5;
+ * @return {string}
+ */
+proto.ag.User.prototype.getEmail = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.User} returns this
+ */
+proto.ag.User.prototype.setEmail = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional string avatarURL = 6;
+ * @return {string}
+ */
+proto.ag.User.prototype.getAvatarurl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.User} returns this
+ */
+proto.ag.User.prototype.setAvatarurl = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional string login = 7;
+ * @return {string}
+ */
+proto.ag.User.prototype.getLogin = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.User} returns this
+ */
+proto.ag.User.prototype.setLogin = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * repeated RemoteIdentity remoteIdentities = 8;
+ * @return {!Array<!proto.ag.RemoteIdentity>}
+ */
+proto.ag.User.prototype.getRemoteidentitiesList = function() {
+  return /** @type{!Array<!proto.ag.RemoteIdentity>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.RemoteIdentity, 8));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.RemoteIdentity>} value
+ * @return {!proto.ag.User} returns this
+*/
+proto.ag.User.prototype.setRemoteidentitiesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 8, value);
+};
+
+
+/**
+ * @param {!proto.ag.RemoteIdentity=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.RemoteIdentity}
+ */
+proto.ag.User.prototype.addRemoteidentities = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 8, opt_value, proto.ag.RemoteIdentity, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.User} returns this
+ */
+proto.ag.User.prototype.clearRemoteidentitiesList = function() {
+  return this.setRemoteidentitiesList([]);
+};
+
+
+/**
+ * repeated Enrollment enrollments = 9;
+ * @return {!Array<!proto.ag.Enrollment>}
+ */
+proto.ag.User.prototype.getEnrollmentsList = function() {
+  return /** @type{!Array<!proto.ag.Enrollment>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.Enrollment, 9));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.Enrollment>} value
+ * @return {!proto.ag.User} returns this
+*/
+proto.ag.User.prototype.setEnrollmentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 9, value);
+};
+
+
+/**
+ * @param {!proto.ag.Enrollment=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.Enrollment}
+ */
+proto.ag.User.prototype.addEnrollments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 9, opt_value, proto.ag.Enrollment, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.User} returns this
+ */
+proto.ag.User.prototype.clearEnrollmentsList = function() {
+  return this.setEnrollmentsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.Users.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.Users.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Users.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.Users} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Users.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    usersList: jspb.Message.toObjectList(msg.getUsersList(),
+    proto.ag.User.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.Users}
+ */
+proto.ag.Users.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.Users;
+  return proto.ag.Users.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.Users} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.Users}
+ */
+proto.ag.Users.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.ag.User;
+      reader.readMessage(value,proto.ag.User.deserializeBinaryFromReader);
+      msg.addUsers(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.Users.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.Users.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.Users} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Users.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUsersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.ag.User.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated User users = 1;
+ * @return {!Array<!proto.ag.User>}
+ */
+proto.ag.Users.prototype.getUsersList = function() {
+  return /** @type{!Array<!proto.ag.User>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.User, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.User>} value
+ * @return {!proto.ag.Users} returns this
+*/
+proto.ag.Users.prototype.setUsersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.ag.User=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.User}
+ */
+proto.ag.Users.prototype.addUsers = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.User, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.Users} returns this
+ */
+proto.ag.Users.prototype.clearUsersList = function() {
+  return this.setUsersList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.RemoteIdentity.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.RemoteIdentity.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.RemoteIdentity} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.RemoteIdentity.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    provider: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    remoteid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    accesstoken: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    userid: jspb.Message.getFieldWithDefault(msg, 5, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.RemoteIdentity}
+ */
+proto.ag.RemoteIdentity.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.RemoteIdentity;
+  return proto.ag.RemoteIdentity.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.RemoteIdentity} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.RemoteIdentity}
+ */
+proto.ag.RemoteIdentity.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setProvider(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setRemoteid(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAccesstoken(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setUserid(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.RemoteIdentity.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.RemoteIdentity.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.RemoteIdentity} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.RemoteIdentity.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getProvider();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getRemoteid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getAccesstoken();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getUserid();
+  if (f !== 0) {
+    writer.writeUint64(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.ag.RemoteIdentity.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.RemoteIdentity} returns this
+ */
+proto.ag.RemoteIdentity.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional string provider = 2;
+ * @return {string}
+ */
+proto.ag.RemoteIdentity.prototype.getProvider = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.RemoteIdentity} returns this
+ */
+proto.ag.RemoteIdentity.prototype.setProvider = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 remoteID = 3;
+ * @return {number}
+ */
+proto.ag.RemoteIdentity.prototype.getRemoteid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.RemoteIdentity} returns this
+ */
+proto.ag.RemoteIdentity.prototype.setRemoteid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional string accessToken = 4;
+ * @return {string}
+ */
+proto.ag.RemoteIdentity.prototype.getAccesstoken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.RemoteIdentity} returns this
+ */
+proto.ag.RemoteIdentity.prototype.setAccesstoken = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional uint64 userID = 5;
+ * @return {number}
+ */
+proto.ag.RemoteIdentity.prototype.getUserid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.RemoteIdentity} returns this
+ */
+proto.ag.RemoteIdentity.prototype.setUserid = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.Group.repeatedFields_ = [6,7];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.Group.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Group.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.Group} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Group.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    courseid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    teamid: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    status: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    usersList: jspb.Message.toObjectList(msg.getUsersList(),
+    proto.ag.User.toObject, includeInstance),
+    enrollmentsList: jspb.Message.toObjectList(msg.getEnrollmentsList(),
+    proto.ag.Enrollment.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.Group}
+ */
+proto.ag.Group.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.Group;
+  return proto.ag.Group.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.Group} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.Group}
+ */
+proto.ag.Group.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setTeamid(value);
+      break;
+    case 5:
+      var value = /** @type {!proto.ag.Group.GroupStatus} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    case 6:
+      var value = new proto.ag.User;
+      reader.readMessage(value,proto.ag.User.deserializeBinaryFromReader);
+      msg.addUsers(value);
+      break;
+    case 7:
+      var value = new proto.ag.Enrollment;
+      reader.readMessage(value,proto.ag.Enrollment.deserializeBinaryFromReader);
+      msg.addEnrollments(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.Group.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.Group.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.Group} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Group.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getTeamid();
+  if (f !== 0) {
+    writer.writeUint64(
+      4,
+      f
+    );
+  }
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      5,
+      f
+    );
+  }
+  f = message.getUsersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      6,
+      f,
+      proto.ag.User.serializeBinaryToWriter
+    );
+  }
+  f = message.getEnrollmentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      7,
+      f,
+      proto.ag.Enrollment.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.ag.Group.GroupStatus = {
+  PENDING: 0,
+  APPROVED: 1
+};
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.ag.Group.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Group} returns this
+ */
+proto.ag.Group.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.ag.Group.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Group} returns this
+ */
+proto.ag.Group.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 courseID = 3;
+ * @return {number}
+ */
+proto.ag.Group.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Group} returns this
+ */
+proto.ag.Group.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional uint64 teamID = 4;
+ * @return {number}
+ */
+proto.ag.Group.prototype.getTeamid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Group} returns this
+ */
+proto.ag.Group.prototype.setTeamid = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional GroupStatus status = 5;
+ * @return {!proto.ag.Group.GroupStatus}
+ */
+proto.ag.Group.prototype.getStatus = function() {
+  return /** @type {!proto.ag.Group.GroupStatus} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {!proto.ag.Group.GroupStatus} value
+ * @return {!proto.ag.Group} returns this
+ */
+proto.ag.Group.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 5, value);
+};
+
+
+/**
+ * repeated User users = 6;
+ * @return {!Array<!proto.ag.User>}
+ */
+proto.ag.Group.prototype.getUsersList = function() {
+  return /** @type{!Array<!proto.ag.User>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.User, 6));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.User>} value
+ * @return {!proto.ag.Group} returns this
+*/
+proto.ag.Group.prototype.setUsersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 6, value);
+};
+
+
+/**
+ * @param {!proto.ag.User=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.User}
+ */
+proto.ag.Group.prototype.addUsers = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 6, opt_value, proto.ag.User, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.Group} returns this
+ */
+proto.ag.Group.prototype.clearUsersList = function() {
+  return this.setUsersList([]);
+};
+
+
+/**
+ * repeated Enrollment enrollments = 7;
+ * @return {!Array<!proto.ag.Enrollment>}
+ */
+proto.ag.Group.prototype.getEnrollmentsList = function() {
+  return /** @type{!Array<!proto.ag.Enrollment>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.Enrollment, 7));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.Enrollment>} value
+ * @return {!proto.ag.Group} returns this
+*/
+proto.ag.Group.prototype.setEnrollmentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 7, value);
+};
+
+
+/**
+ * @param {!proto.ag.Enrollment=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.Enrollment}
+ */
+proto.ag.Group.prototype.addEnrollments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 7, opt_value, proto.ag.Enrollment, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.Group} returns this
+ */
+proto.ag.Group.prototype.clearEnrollmentsList = function() {
+  return this.setEnrollmentsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.Groups.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.Groups.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Groups.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.Groups} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Groups.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    groupsList: jspb.Message.toObjectList(msg.getGroupsList(),
+    proto.ag.Group.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.Groups}
+ */
+proto.ag.Groups.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.Groups;
+  return proto.ag.Groups.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.Groups} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.Groups}
+ */
+proto.ag.Groups.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.ag.Group;
+      reader.readMessage(value,proto.ag.Group.deserializeBinaryFromReader);
+      msg.addGroups(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.Groups.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.Groups.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.Groups} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Groups.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getGroupsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.ag.Group.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated Group groups = 1;
+ * @return {!Array<!proto.ag.Group>}
+ */
+proto.ag.Groups.prototype.getGroupsList = function() {
+  return /** @type{!Array<!proto.ag.Group>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.Group, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.Group>} value
+ * @return {!proto.ag.Groups} returns this
+*/
+proto.ag.Groups.prototype.setGroupsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.ag.Group=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.Group}
+ */
+proto.ag.Groups.prototype.addGroups = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.Group, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.Groups} returns this
+ */
+proto.ag.Groups.prototype.clearGroupsList = function() {
+  return this.setGroupsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.Course.repeatedFields_ = [13,14,15];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.Course.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Course.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.Course} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Course.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    coursecreatorid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    name: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    code: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    year: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    tag: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    provider: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    organizationid: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    organizationpath: jspb.Message.getFieldWithDefault(msg, 9, ""),
+    slipdays: jspb.Message.getFieldWithDefault(msg, 10, 0),
+    dockerfile: jspb.Message.getFieldWithDefault(msg, 11, ""),
+    enrolled: jspb.Message.getFieldWithDefault(msg, 12, 0),
+    enrollmentsList: jspb.Message.toObjectList(msg.getEnrollmentsList(),
+    proto.ag.Enrollment.toObject, includeInstance),
+    assignmentsList: jspb.Message.toObjectList(msg.getAssignmentsList(),
+    proto.ag.Assignment.toObject, includeInstance),
+    groupsList: jspb.Message.toObjectList(msg.getGroupsList(),
+    proto.ag.Group.toObject, includeInstance),
+    displaytimezone: jspb.Message.getFieldWithDefault(msg, 16, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.Course}
+ */
+proto.ag.Course.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.Course;
+  return proto.ag.Course.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.Course} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.Course}
+ */
+proto.ag.Course.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCoursecreatorid(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCode(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setYear(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTag(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setProvider(value);
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setOrganizationid(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOrganizationpath(value);
+      break;
+    case 10:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setSlipdays(value);
+      break;
+    case 11:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDockerfile(value);
+      break;
+    case 12:
+      var value = /** @type {!proto.ag.Enrollment.UserStatus} */ (reader.readEnum());
+      msg.setEnrolled(value);
+      break;
+    case 13:
+      var value = new proto.ag.Enrollment;
+      reader.readMessage(value,proto.ag.Enrollment.deserializeBinaryFromReader);
+      msg.addEnrollments(value);
+      break;
+    case 14:
+      var value = new proto.ag.Assignment;
+      reader.readMessage(value,proto.ag.Assignment.deserializeBinaryFromReader);
+      msg.addAssignments(value);
+      break;
+    case 15:
+      var value = new proto.ag.Group;
+      reader.readMessage(value,proto.ag.Group.deserializeBinaryFromReader);
+      msg.addGroups(value);
+      break;
+    case 16:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDisplaytimezone(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.Course.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.Course.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.Course} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Course.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getCoursecreatorid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getCode();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getYear();
+  if (f !== 0) {
+    writer.writeUint32(
+      5,
+      f
+    );
+  }
+  f = message.getTag();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getProvider();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getOrganizationid();
+  if (f !== 0) {
+    writer.writeUint64(
+      8,
+      f
+    );
+  }
+  f = message.getOrganizationpath();
+  if (f.length > 0) {
+    writer.writeString(
+      9,
+      f
+    );
+  }
+  f = message.getSlipdays();
+  if (f !== 0) {
+    writer.writeUint32(
+      10,
+      f
+    );
+  }
+  f = message.getDockerfile();
+  if (f.length > 0) {
+    writer.writeString(
+      11,
+      f
+    );
+  }
+  f = message.getEnrolled();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      12,
+      f
+    );
+  }
+  f = message.getEnrollmentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      13,
+      f,
+      proto.ag.Enrollment.serializeBinaryToWriter
+    );
+  }
+  f = message.getAssignmentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      14,
+      f,
+      proto.ag.Assignment.serializeBinaryToWriter
+    );
+  }
+  f = message.getGroupsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      15,
+      f,
+      proto.ag.Group.serializeBinaryToWriter
+    );
+  }
+  f = message.getDisplaytimezone();
+  if (f.length > 0) {
+    writer.writeString(
+      16,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.ag.Course.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Course} returns this
+ */
+proto.ag.Course.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 courseCreatorID = 2;
+ * @return {number}
+ */
+proto.ag.Course.prototype.getCoursecreatorid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Course} returns this
+ */
+proto.ag.Course.prototype.setCoursecreatorid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string name = 3;
+ * @return {string}
+ */
+proto.ag.Course.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Course} returns this
+ */
+proto.ag.Course.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string code = 4;
+ * @return {string}
+ */
+proto.ag.Course.prototype.getCode = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Course} returns this
+ */
+proto.ag.Course.prototype.setCode = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional uint32 year = 5;
+ * @return {number}
+ */
+proto.ag.Course.prototype.getYear = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Course} returns this
+ */
+proto.ag.Course.prototype.setYear = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional string tag = 6;
+ * @return {string}
+ */
+proto.ag.Course.prototype.getTag = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Course} returns this
+ */
+proto.ag.Course.prototype.setTag = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional string provider = 7;
+ * @return {string}
+ */
+proto.ag.Course.prototype.getProvider = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Course} returns this
+ */
+proto.ag.Course.prototype.setProvider = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * optional uint64 organizationID = 8;
+ * @return {number}
+ */
+proto.ag.Course.prototype.getOrganizationid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Course} returns this
+ */
+proto.ag.Course.prototype.setOrganizationid = function(value) {
+  return jspb.Message.setProto3IntField(this, 8, value);
+};
+
+
+/**
+ * optional string organizationPath = 9;
+ * @return {string}
+ */
+proto.ag.Course.prototype.getOrganizationpath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Course} returns this
+ */
+proto.ag.Course.prototype.setOrganizationpath = function(value) {
+  return jspb.Message.setProto3StringField(this, 9, value);
+};
+
+
+/**
+ * optional uint32 slipDays = 10;
+ * @return {number}
+ */
+proto.ag.Course.prototype.getSlipdays = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Course} returns this
+ */
+proto.ag.Course.prototype.setSlipdays = function(value) {
+  return jspb.Message.setProto3IntField(this, 10, value);
+};
+
+
+/**
+ * optional string dockerfile = 11;
+ * @return {string}
+ */
+proto.ag.Course.prototype.getDockerfile = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 11, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Course} returns this
+ */
+proto.ag.Course.prototype.setDockerfile = function(value) {
+  return jspb.Message.setProto3StringField(this, 11, value);
+};
+
+
+/**
+ * optional Enrollment.UserStatus enrolled = 12;
+ * @return {!proto.ag.Enrollment.UserStatus}
+ */
+proto.ag.Course.prototype.getEnrolled = function() {
+  return /** @type {!proto.ag.Enrollment.UserStatus} */ (jspb.Message.getFieldWithDefault(this, 12, 0));
+};
+
+
+/**
+ * @param {!proto.ag.Enrollment.UserStatus} value
+ * @return {!proto.ag.Course} returns this
+ */
+proto.ag.Course.prototype.setEnrolled = function(value) {
+  return jspb.Message.setProto3EnumField(this, 12, value);
+};
+
+
+/**
+ * repeated Enrollment enrollments = 13;
+ * @return {!Array<!proto.ag.Enrollment>}
+ */
+proto.ag.Course.prototype.getEnrollmentsList = function() {
+  return /** @type{!Array<!proto.ag.Enrollment>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.Enrollment, 13));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.Enrollment>} value
+ * @return {!proto.ag.Course} returns this
+*/
+proto.ag.Course.prototype.setEnrollmentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 13, value);
+};
+
+
+/**
+ * @param {!proto.ag.Enrollment=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.Enrollment}
+ */
+proto.ag.Course.prototype.addEnrollments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 13, opt_value, proto.ag.Enrollment, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.Course} returns this
+ */
+proto.ag.Course.prototype.clearEnrollmentsList = function() {
+  return this.setEnrollmentsList([]);
+};
+
+
+/**
+ * repeated Assignment assignments = 14;
+ * @return {!Array<!proto.ag.Assignment>}
+ */
+proto.ag.Course.prototype.getAssignmentsList = function() {
+  return /** @type{!Array<!proto.ag.Assignment>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.Assignment, 14));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.Assignment>} value
+ * @return {!proto.ag.Course} returns this
+*/
+proto.ag.Course.prototype.setAssignmentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 14, value);
+};
+
+
+/**
+ * @param {!proto.ag.Assignment=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.Assignment}
+ */
+proto.ag.Course.prototype.addAssignments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 14, opt_value, proto.ag.Assignment, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.Course} returns this
+ */
+proto.ag.Course.prototype.clearAssignmentsList = function() {
+  return this.setAssignmentsList([]);
+};
+
+
+/**
+ * repeated Group groups = 15;
+ * @return {!Array<!proto.ag.Group>}
+ */
+proto.ag.Course.prototype.getGroupsList = function() {
+  return /** @type{!Array<!proto.ag.Group>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.Group, 15));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.Group>} value
+ * @return {!proto.ag.Course} returns this
+*/
+proto.ag.Course.prototype.setGroupsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 15, value);
+};
+
+
+/**
+ * @param {!proto.ag.Group=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.Group}
+ */
+proto.ag.Course.prototype.addGroups = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 15, opt_value, proto.ag.Group, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.Course} returns this
+ */
+proto.ag.Course.prototype.clearGroupsList = function() {
+  return this.setGroupsList([]);
+};
+
+
+/**
+ * optional string displayTimeZone = 16;
+ * @return {string}
+ */
+proto.ag.Course.prototype.getDisplaytimezone = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 16, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Course} returns this
+ */
+proto.ag.Course.prototype.setDisplaytimezone = function(value) {
+  return jspb.Message.setProto3StringField(this, 16, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.Courses.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.Courses.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Courses.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.Courses} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Courses.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    coursesList: jspb.Message.toObjectList(msg.getCoursesList(),
+    proto.ag.Course.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.Courses}
+ */
+proto.ag.Courses.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.Courses;
+  return proto.ag.Courses.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.Courses} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.Courses}
+ */
+proto.ag.Courses.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.ag.Course;
+      reader.readMessage(value,proto.ag.Course.deserializeBinaryFromReader);
+      msg.addCourses(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.Courses.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.Courses.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.Courses} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Courses.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCoursesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.ag.Course.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated Course courses = 1;
+ * @return {!Array<!proto.ag.Course>}
+ */
+proto.ag.Courses.prototype.getCoursesList = function() {
+  return /** @type{!Array<!proto.ag.Course>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.Course, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.Course>} value
+ * @return {!proto.ag.Courses} returns this
+*/
+proto.ag.Courses.prototype.setCoursesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.ag.Course=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.Course}
+ */
+proto.ag.Courses.prototype.addCourses = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.Course, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.Courses} returns this
+ */
+proto.ag.Courses.prototype.clearCoursesList = function() {
+  return this.setCoursesList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.Repository.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Repository.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.Repository} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Repository.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    organizationid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    repositoryid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    userid: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    groupid: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    htmlurl: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    repotype: jspb.Message.getFieldWithDefault(msg, 7, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.Repository}
+ */
+proto.ag.Repository.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.Repository;
+  return proto.ag.Repository.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.Repository} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.Repository}
+ */
+proto.ag.Repository.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setOrganizationid(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setRepositoryid(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setUserid(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setGroupid(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setHtmlurl(value);
+      break;
+    case 7:
+      var value = /** @type {!proto.ag.Repository.Type} */ (reader.readEnum());
+      msg.setRepotype(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.Repository.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.Repository.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.Repository} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Repository.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getOrganizationid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getRepositoryid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getUserid();
+  if (f !== 0) {
+    writer.writeUint64(
+      4,
+      f
+    );
+  }
+  f = message.getGroupid();
+  if (f !== 0) {
+    writer.writeUint64(
+      5,
+      f
+    );
+  }
+  f = message.getHtmlurl();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getRepotype();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      7,
+      f
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.ag.Repository.Type = {
+  NONE: 0,
+  COURSEINFO: 1,
+  ASSIGNMENTS: 2,
+  TESTS: 3,
+  USER: 4,
+  GROUP: 5
+};
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.ag.Repository.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Repository} returns this
+ */
+proto.ag.Repository.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 organizationID = 2;
+ * @return {number}
+ */
+proto.ag.Repository.prototype.getOrganizationid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Repository} returns this
+ */
+proto.ag.Repository.prototype.setOrganizationid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 repositoryID = 3;
+ * @return {number}
+ */
+proto.ag.Repository.prototype.getRepositoryid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Repository} returns this
+ */
+proto.ag.Repository.prototype.setRepositoryid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional uint64 userID = 4;
+ * @return {number}
+ */
+proto.ag.Repository.prototype.getUserid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Repository} returns this
+ */
+proto.ag.Repository.prototype.setUserid = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional uint64 groupID = 5;
+ * @return {number}
+ */
+proto.ag.Repository.prototype.getGroupid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Repository} returns this
+ */
+proto.ag.Repository.prototype.setGroupid = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional string HTMLURL = 6;
+ * @return {string}
+ */
+proto.ag.Repository.prototype.getHtmlurl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Repository} returns this
+ */
+proto.ag.Repository.prototype.setHtmlurl = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional Type repoType = 7;
+ * @return {!proto.ag.Repository.Type}
+ */
+proto.ag.Repository.prototype.getRepotype = function() {
+  return /** @type {!proto.ag.Repository.Type} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {!proto.ag.Repository.Type} value
+ * @return {!proto.ag.Repository} returns this
+ */
+proto.ag.Repository.prototype.setRepotype = function(value) {
+  return jspb.Message.setProto3EnumField(this, 7, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.Enrollment.repeatedFields_ = [14];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.Enrollment.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Enrollment.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.Enrollment} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Enrollment.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    courseid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    userid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    groupid: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    hasteacherscopes: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
+    user: (f = msg.getUser()) && proto.ag.User.toObject(includeInstance, f),
+    course: (f = msg.getCourse()) && proto.ag.Course.toObject(includeInstance, f),
+    group: (f = msg.getGroup()) && proto.ag.Group.toObject(includeInstance, f),
+    status: jspb.Message.getFieldWithDefault(msg, 9, 0),
+    state: jspb.Message.getFieldWithDefault(msg, 10, 0),
+    slipdaysremaining: jspb.Message.getFieldWithDefault(msg, 11, 0),
+    lastactivitydate: jspb.Message.getFieldWithDefault(msg, 12, ""),
+    totalapproved: jspb.Message.getFieldWithDefault(msg, 13, 0),
+    usedslipdaysList: jspb.Message.toObjectList(msg.getUsedslipdaysList(),
+    proto.ag.UsedSlipDays.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.Enrollment}
+ */
+proto.ag.Enrollment.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.Enrollment;
+  return proto.ag.Enrollment.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.Enrollment} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.Enrollment}
+ */
+proto.ag.Enrollment.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setUserid(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setGroupid(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setHasteacherscopes(value);
+      break;
+    case 6:
+      var value = new proto.ag.User;
+      reader.readMessage(value,proto.ag.User.deserializeBinaryFromReader);
+      msg.setUser(value);
+      break;
+    case 7:
+      var value = new proto.ag.Course;
+      reader.readMessage(value,proto.ag.Course.deserializeBinaryFromReader);
+      msg.setCourse(value);
+      break;
+    case 8:
+      var value = new proto.ag.Group;
+      reader.readMessage(value,proto.ag.Group.deserializeBinaryFromReader);
+      msg.setGroup(value);
+      break;
+    case 9:
+      var value = /** @type {!proto.ag.Enrollment.UserStatus} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    case 10:
+      var value = /** @type {!proto.ag.Enrollment.DisplayState} */ (reader.readEnum());
+      msg.setState(value);
+      break;
+    case 11:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setSlipdaysremaining(value);
+      break;
+    case 12:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setLastactivitydate(value);
+      break;
+    case 13:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setTotalapproved(value);
+      break;
+    case 14:
+      var value = new proto.ag.UsedSlipDays;
+      reader.readMessage(value,proto.ag.UsedSlipDays.deserializeBinaryFromReader);
+      msg.addUsedslipdays(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.Enrollment.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.Enrollment.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.Enrollment} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Enrollment.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getUserid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getGroupid();
+  if (f !== 0) {
+    writer.writeUint64(
+      4,
+      f
+    );
+  }
+  f = message.getHasteacherscopes();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+  f = message.getUser();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      proto.ag.User.serializeBinaryToWriter
+    );
+  }
+  f = message.getCourse();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      proto.ag.Course.serializeBinaryToWriter
+    );
+  }
+  f = message.getGroup();
+  if (f != null) {
+    writer.writeMessage(
+      8,
+      f,
+      proto.ag.Group.serializeBinaryToWriter
+    );
+  }
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      9,
+      f
+    );
+  }
+  f = message.getState();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      10,
+      f
+    );
+  }
+  f = message.getSlipdaysremaining();
+  if (f !== 0) {
+    writer.writeUint32(
+      11,
+      f
+    );
+  }
+  f = message.getLastactivitydate();
+  if (f.length > 0) {
+    writer.writeString(
+      12,
+      f
+    );
+  }
+  f = message.getTotalapproved();
+  if (f !== 0) {
+    writer.writeUint64(
+      13,
+      f
+    );
+  }
+  f = message.getUsedslipdaysList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      14,
+      f,
+      proto.ag.UsedSlipDays.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.ag.Enrollment.UserStatus = {
+  NONE: 0,
+  PENDING: 1,
+  STUDENT: 2,
+  TEACHER: 3
+};
+
+/**
+ * @enum {number}
+ */
+proto.ag.Enrollment.DisplayState = {
+  UNSET: 0,
+  HIDDEN: 1,
+  VISIBLE: 2,
+  FAVORITE: 3
+};
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.ag.Enrollment.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Enrollment} returns this
+ */
+proto.ag.Enrollment.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 courseID = 2;
+ * @return {number}
+ */
+proto.ag.Enrollment.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Enrollment} returns this
+ */
+proto.ag.Enrollment.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 userID = 3;
+ * @return {number}
+ */
+proto.ag.Enrollment.prototype.getUserid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Enrollment} returns this
+ */
+proto.ag.Enrollment.prototype.setUserid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional uint64 groupID = 4;
+ * @return {number}
+ */
+proto.ag.Enrollment.prototype.getGroupid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Enrollment} returns this
+ */
+proto.ag.Enrollment.prototype.setGroupid = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional bool hasTeacherScopes = 5;
+ * @return {boolean}
+ */
+proto.ag.Enrollment.prototype.getHasteacherscopes = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ag.Enrollment} returns this
+ */
+proto.ag.Enrollment.prototype.setHasteacherscopes = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
+};
+
+
+/**
+ * optional User user = 6;
+ * @return {?proto.ag.User}
+ */
+proto.ag.Enrollment.prototype.getUser = function() {
+  return /** @type{?proto.ag.User} */ (
+    jspb.Message.getWrapperField(this, proto.ag.User, 6));
+};
+
+
+/**
+ * @param {?proto.ag.User|undefined} value
+ * @return {!proto.ag.Enrollment} returns this
+*/
+proto.ag.Enrollment.prototype.setUser = function(value) {
+  return jspb.Message.setWrapperField(this, 6, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ag.Enrollment} returns this
+ */
+proto.ag.Enrollment.prototype.clearUser = function() {
+  return this.setUser(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ag.Enrollment.prototype.hasUser = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+/**
+ * optional Course course = 7;
+ * @return {?proto.ag.Course}
+ */
+proto.ag.Enrollment.prototype.getCourse = function() {
+  return /** @type{?proto.ag.Course} */ (
+    jspb.Message.getWrapperField(this, proto.ag.Course, 7));
+};
+
+
+/**
+ * @param {?proto.ag.Course|undefined} value
+ * @return {!proto.ag.Enrollment} returns this
+*/
+proto.ag.Enrollment.prototype.setCourse = function(value) {
+  return jspb.Message.setWrapperField(this, 7, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ag.Enrollment} returns this
+ */
+proto.ag.Enrollment.prototype.clearCourse = function() {
+  return this.setCourse(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ag.Enrollment.prototype.hasCourse = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+/**
+ * optional Group group = 8;
+ * @return {?proto.ag.Group}
+ */
+proto.ag.Enrollment.prototype.getGroup = function() {
+  return /** @type{?proto.ag.Group} */ (
+    jspb.Message.getWrapperField(this, proto.ag.Group, 8));
+};
+
+
+/**
+ * @param {?proto.ag.Group|undefined} value
+ * @return {!proto.ag.Enrollment} returns this
+*/
+proto.ag.Enrollment.prototype.setGroup = function(value) {
+  return jspb.Message.setWrapperField(this, 8, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ag.Enrollment} returns this
+ */
+proto.ag.Enrollment.prototype.clearGroup = function() {
+  return this.setGroup(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ag.Enrollment.prototype.hasGroup = function() {
+  return jspb.Message.getField(this, 8) != null;
+};
+
+
+/**
+ * optional UserStatus status = 9;
+ * @return {!proto.ag.Enrollment.UserStatus}
+ */
+proto.ag.Enrollment.prototype.getStatus = function() {
+  return /** @type {!proto.ag.Enrollment.UserStatus} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+};
+
+
+/**
+ * @param {!proto.ag.Enrollment.UserStatus} value
+ * @return {!proto.ag.Enrollment} returns this
+ */
+proto.ag.Enrollment.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 9, value);
+};
+
+
+/**
+ * optional DisplayState state = 10;
+ * @return {!proto.ag.Enrollment.DisplayState}
+ */
+proto.ag.Enrollment.prototype.getState = function() {
+  return /** @type {!proto.ag.Enrollment.DisplayState} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+};
+
+
+/**
+ * @param {!proto.ag.Enrollment.DisplayState} value
+ * @return {!proto.ag.Enrollment} returns this
+ */
+proto.ag.Enrollment.prototype.setState = function(value) {
+  return jspb.Message.setProto3EnumField(this, 10, value);
+};
+
+
+/**
+ * optional uint32 slipDaysRemaining = 11;
+ * @return {number}
+ */
+proto.ag.Enrollment.prototype.getSlipdaysremaining = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Enrollment} returns this
+ */
+proto.ag.Enrollment.prototype.setSlipdaysremaining = function(value) {
+  return jspb.Message.setProto3IntField(this, 11, value);
+};
+
+
+/**
+ * optional string lastActivityDate = 12;
+ * @return {string}
+ */
+proto.ag.Enrollment.prototype.getLastactivitydate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 12, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Enrollment} returns this
+ */
+proto.ag.Enrollment.prototype.setLastactivitydate = function(value) {
+  return jspb.Message.setProto3StringField(this, 12, value);
+};
+
+
+/**
+ * optional uint64 totalApproved = 13;
+ * @return {number}
+ */
+proto.ag.Enrollment.prototype.getTotalapproved = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 13, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Enrollment} returns this
+ */
+proto.ag.Enrollment.prototype.setTotalapproved = function(value) {
+  return jspb.Message.setProto3IntField(this, 13, value);
+};
+
+
+/**
+ * repeated UsedSlipDays usedSlipDays = 14;
+ * @return {!Array<!proto.ag.UsedSlipDays>}
+ */
+proto.ag.Enrollment.prototype.getUsedslipdaysList = function() {
+  return /** @type{!Array<!proto.ag.UsedSlipDays>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.UsedSlipDays, 14));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.UsedSlipDays>} value
+ * @return {!proto.ag.Enrollment} returns this
+*/
+proto.ag.Enrollment.prototype.setUsedslipdaysList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 14, value);
+};
+
+
+/**
+ * @param {!proto.ag.UsedSlipDays=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.UsedSlipDays}
+ */
+proto.ag.Enrollment.prototype.addUsedslipdays = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 14, opt_value, proto.ag.UsedSlipDays, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.Enrollment} returns this
+ */
+proto.ag.Enrollment.prototype.clearUsedslipdaysList = function() {
+  return this.setUsedslipdaysList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.UsedSlipDays.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.UsedSlipDays.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.UsedSlipDays} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.UsedSlipDays.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    enrollmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    usedslipdays: jspb.Message.getFieldWithDefault(msg, 4, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.UsedSlipDays}
+ */
+proto.ag.UsedSlipDays.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.UsedSlipDays;
+  return proto.ag.UsedSlipDays.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.UsedSlipDays} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.UsedSlipDays}
+ */
+proto.ag.UsedSlipDays.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setEnrollmentid(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setUsedslipdays(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.UsedSlipDays.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.UsedSlipDays.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.UsedSlipDays} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.UsedSlipDays.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getEnrollmentid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getUsedslipdays();
+  if (f !== 0) {
+    writer.writeUint32(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.ag.UsedSlipDays.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.UsedSlipDays} returns this
+ */
+proto.ag.UsedSlipDays.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 enrollmentID = 2;
+ * @return {number}
+ */
+proto.ag.UsedSlipDays.prototype.getEnrollmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.UsedSlipDays} returns this
+ */
+proto.ag.UsedSlipDays.prototype.setEnrollmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 assignmentID = 3;
+ * @return {number}
+ */
+proto.ag.UsedSlipDays.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.UsedSlipDays} returns this
+ */
+proto.ag.UsedSlipDays.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional uint32 usedSlipDays = 4;
+ * @return {number}
+ */
+proto.ag.UsedSlipDays.prototype.getUsedslipdays = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.UsedSlipDays} returns this
+ */
+proto.ag.UsedSlipDays.prototype.setUsedslipdays = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.Enrollments.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.Enrollments.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Enrollments.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.Enrollments} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Enrollments.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enrollmentsList: jspb.Message.toObjectList(msg.getEnrollmentsList(),
+    proto.ag.Enrollment.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.Enrollments}
+ */
+proto.ag.Enrollments.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.Enrollments;
+  return proto.ag.Enrollments.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.Enrollments} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.Enrollments}
+ */
+proto.ag.Enrollments.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.ag.Enrollment;
+      reader.readMessage(value,proto.ag.Enrollment.deserializeBinaryFromReader);
+      msg.addEnrollments(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.Enrollments.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.Enrollments.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.Enrollments} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Enrollments.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnrollmentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.ag.Enrollment.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated Enrollment enrollments = 1;
+ * @return {!Array<!proto.ag.Enrollment>}
+ */
+proto.ag.Enrollments.prototype.getEnrollmentsList = function() {
+  return /** @type{!Array<!proto.ag.Enrollment>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.Enrollment, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.Enrollment>} value
+ * @return {!proto.ag.Enrollments} returns this
+*/
+proto.ag.Enrollments.prototype.setEnrollmentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.ag.Enrollment=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.Enrollment}
+ */
+proto.ag.Enrollments.prototype.addEnrollments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.Enrollment, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.Enrollments} returns this
+ */
+proto.ag.Enrollments.prototype.clearEnrollmentsList = function() {
+  return this.setEnrollmentsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.SubmissionLink.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.SubmissionLink.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.SubmissionLink} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.SubmissionLink.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    assignment: (f = msg.getAssignment()) && proto.ag.Assignment.toObject(includeInstance, f),
+    submission: (f = msg.getSubmission()) && proto.ag.Submission.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.SubmissionLink}
+ */
+proto.ag.SubmissionLink.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.SubmissionLink;
+  return proto.ag.SubmissionLink.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.SubmissionLink} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.SubmissionLink}
+ */
+proto.ag.SubmissionLink.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.ag.Assignment;
+      reader.readMessage(value,proto.ag.Assignment.deserializeBinaryFromReader);
+      msg.setAssignment(value);
+      break;
+    case 2:
+      var value = new proto.ag.Submission;
+      reader.readMessage(value,proto.ag.Submission.deserializeBinaryFromReader);
+      msg.setSubmission(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.SubmissionLink.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.SubmissionLink.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.SubmissionLink} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.SubmissionLink.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAssignment();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.ag.Assignment.serializeBinaryToWriter
+    );
+  }
+  f = message.getSubmission();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.ag.Submission.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional Assignment assignment = 1;
+ * @return {?proto.ag.Assignment}
+ */
+proto.ag.SubmissionLink.prototype.getAssignment = function() {
+  return /** @type{?proto.ag.Assignment} */ (
+    jspb.Message.getWrapperField(this, proto.ag.Assignment, 1));
+};
+
+
+/**
+ * @param {?proto.ag.Assignment|undefined} value
+ * @return {!proto.ag.SubmissionLink} returns this
+*/
+proto.ag.SubmissionLink.prototype.setAssignment = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ag.SubmissionLink} returns this
+ */
+proto.ag.SubmissionLink.prototype.clearAssignment = function() {
+  return this.setAssignment(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ag.SubmissionLink.prototype.hasAssignment = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional Submission submission = 2;
+ * @return {?proto.ag.Submission}
+ */
+proto.ag.SubmissionLink.prototype.getSubmission = function() {
+  return /** @type{?proto.ag.Submission} */ (
+    jspb.Message.getWrapperField(this, proto.ag.Submission, 2));
+};
+
+
+/**
+ * @param {?proto.ag.Submission|undefined} value
+ * @return {!proto.ag.SubmissionLink} returns this
+*/
+proto.ag.SubmissionLink.prototype.setSubmission = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ag.SubmissionLink} returns this
+ */
+proto.ag.SubmissionLink.prototype.clearSubmission = function() {
+  return this.setSubmission(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ag.SubmissionLink.prototype.hasSubmission = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.EnrollmentLink.repeatedFields_ = [3];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.EnrollmentLink.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.EnrollmentLink.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.EnrollmentLink} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.EnrollmentLink.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enrollment: (f = msg.getEnrollment()) && proto.ag.Enrollment.toObject(includeInstance, f),
+    submissionsList: jspb.Message.toObjectList(msg.getSubmissionsList(),
+    proto.ag.SubmissionLink.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.EnrollmentLink}
+ */
+proto.ag.EnrollmentLink.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.EnrollmentLink;
+  return proto.ag.EnrollmentLink.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.EnrollmentLink} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.EnrollmentLink}
+ */
+proto.ag.EnrollmentLink.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 2:
+      var value = new proto.ag.Enrollment;
+      reader.readMessage(value,proto.ag.Enrollment.deserializeBinaryFromReader);
+      msg.setEnrollment(value);
+      break;
+    case 3:
+      var value = new proto.ag.SubmissionLink;
+      reader.readMessage(value,proto.ag.SubmissionLink.deserializeBinaryFromReader);
+      msg.addSubmissions(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.EnrollmentLink.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.EnrollmentLink.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.EnrollmentLink} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.EnrollmentLink.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnrollment();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.ag.Enrollment.serializeBinaryToWriter
+    );
+  }
+  f = message.getSubmissionsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.ag.SubmissionLink.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional Enrollment enrollment = 2;
+ * @return {?proto.ag.Enrollment}
+ */
+proto.ag.EnrollmentLink.prototype.getEnrollment = function() {
+  return /** @type{?proto.ag.Enrollment} */ (
+    jspb.Message.getWrapperField(this, proto.ag.Enrollment, 2));
+};
+
+
+/**
+ * @param {?proto.ag.Enrollment|undefined} value
+ * @return {!proto.ag.EnrollmentLink} returns this
+*/
+proto.ag.EnrollmentLink.prototype.setEnrollment = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ag.EnrollmentLink} returns this
+ */
+proto.ag.EnrollmentLink.prototype.clearEnrollment = function() {
+  return this.setEnrollment(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ag.EnrollmentLink.prototype.hasEnrollment = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * repeated SubmissionLink submissions = 3;
+ * @return {!Array<!proto.ag.SubmissionLink>}
+ */
+proto.ag.EnrollmentLink.prototype.getSubmissionsList = function() {
+  return /** @type{!Array<!proto.ag.SubmissionLink>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.SubmissionLink, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.SubmissionLink>} value
+ * @return {!proto.ag.EnrollmentLink} returns this
+*/
+proto.ag.EnrollmentLink.prototype.setSubmissionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.ag.SubmissionLink=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.SubmissionLink}
+ */
+proto.ag.EnrollmentLink.prototype.addSubmissions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.ag.SubmissionLink, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.EnrollmentLink} returns this
+ */
+proto.ag.EnrollmentLink.prototype.clearSubmissionsList = function() {
+  return this.setSubmissionsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.CourseSubmissions.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.CourseSubmissions.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.CourseSubmissions.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.CourseSubmissions} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.CourseSubmissions.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    course: (f = msg.getCourse()) && proto.ag.Course.toObject(includeInstance, f),
+    linksList: jspb.Message.toObjectList(msg.getLinksList(),
+    proto.ag.EnrollmentLink.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.CourseSubmissions}
+ */
+proto.ag.CourseSubmissions.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.CourseSubmissions;
+  return proto.ag.CourseSubmissions.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.CourseSubmissions} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.CourseSubmissions}
+ */
+proto.ag.CourseSubmissions.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.ag.Course;
+      reader.readMessage(value,proto.ag.Course.deserializeBinaryFromReader);
+      msg.setCourse(value);
+      break;
+    case 2:
+      var value = new proto.ag.EnrollmentLink;
+      reader.readMessage(value,proto.ag.EnrollmentLink.deserializeBinaryFromReader);
+      msg.addLinks(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.CourseSubmissions.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.CourseSubmissions.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.CourseSubmissions} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.CourseSubmissions.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCourse();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.ag.Course.serializeBinaryToWriter
+    );
+  }
+  f = message.getLinksList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.ag.EnrollmentLink.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional Course course = 1;
+ * @return {?proto.ag.Course}
+ */
+proto.ag.CourseSubmissions.prototype.getCourse = function() {
+  return /** @type{?proto.ag.Course} */ (
+    jspb.Message.getWrapperField(this, proto.ag.Course, 1));
+};
+
+
+/**
+ * @param {?proto.ag.Course|undefined} value
+ * @return {!proto.ag.CourseSubmissions} returns this
+*/
+proto.ag.CourseSubmissions.prototype.setCourse = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ag.CourseSubmissions} returns this
+ */
+proto.ag.CourseSubmissions.prototype.clearCourse = function() {
+  return this.setCourse(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ag.CourseSubmissions.prototype.hasCourse = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * repeated EnrollmentLink links = 2;
+ * @return {!Array<!proto.ag.EnrollmentLink>}
+ */
+proto.ag.CourseSubmissions.prototype.getLinksList = function() {
+  return /** @type{!Array<!proto.ag.EnrollmentLink>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.EnrollmentLink, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.EnrollmentLink>} value
+ * @return {!proto.ag.CourseSubmissions} returns this
+*/
+proto.ag.CourseSubmissions.prototype.setLinksList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.ag.EnrollmentLink=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.EnrollmentLink}
+ */
+proto.ag.CourseSubmissions.prototype.addLinks = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.ag.EnrollmentLink, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.CourseSubmissions} returns this
+ */
+proto.ag.CourseSubmissions.prototype.clearLinksList = function() {
+  return this.setLinksList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.Assignment.repeatedFields_ = [11,12,18,43,61];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.Assignment.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Assignment.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.Assignment} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Assignment.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    courseid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    name: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    scriptfile: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    deadline: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    autoapprove: jspb.Message.getBooleanFieldWithDefault(msg, 6, false),
+    order: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    isgrouplab: jspb.Message.getBooleanFieldWithDefault(msg, 8, false),
+    scorelimit: jspb.Message.getFieldWithDefault(msg, 9, 0),
+    reviewers: jspb.Message.getFieldWithDefault(msg, 10, 0),
+    submissionsList: jspb.Message.toObjectList(msg.getSubmissionsList(),
+    proto.ag.Submission.toObject, includeInstance),
+    gradingbenchmarksList: jspb.Message.toObjectList(msg.getGradingbenchmarksList(),
+    proto.ag.GradingBenchmark.toObject, includeInstance),
+    containertimeout: jspb.Message.getFieldWithDefault(msg, 13, 0),
+    partof: jspb.Message.getFieldWithDefault(msg, 14, ""),
+    language: jspb.Message.getFieldWithDefault(msg, 15, ""),
+    verbose: jspb.Message.getBooleanFieldWithDefault(msg, 16, false),
+    retries: jspb.Message.getFieldWithDefault(msg, 17, 0),
+    testsList: jspb.Message.toObjectList(msg.getTestsList(),
+    proto.ag.TestConfig.toObject, includeInstance),
+    testsrepourl: jspb.Message.getFieldWithDefault(msg, 19, ""),
+    testsreporef: jspb.Message.getFieldWithDefault(msg, 20, ""),
+    manualonly: jspb.Message.getBooleanFieldWithDefault(msg, 21, false),
+    releasedate: (f = msg.getReleasedate()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    closedate: (f = msg.getClosedate()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    extracreditcap: jspb.Message.getFieldWithDefault(msg, 24, 0),
+    hidepoints: jspb.Message.getBooleanFieldWithDefault(msg, 25, false),
+    requiredfiles: jspb.Message.getFieldWithDefault(msg, 26, ""),
+    reviewerstrategy: jspb.Message.getFieldWithDefault(msg, 27, ""),
+    mutenotifications: jspb.Message.getBooleanFieldWithDefault(msg, 28, false),
+    plagiarismcheck: jspb.Message.getBooleanFieldWithDefault(msg, 29, false),
+    similaritythreshold: jspb.Message.getFieldWithDefault(msg, 30, 0),
+    network: jspb.Message.getFieldWithDefault(msg, 31, ""),
+    courseweight: jspb.Message.getFieldWithDefault(msg, 32, 0),
+    maxparallel: jspb.Message.getFieldWithDefault(msg, 33, 0),
+    diffmode: jspb.Message.getFieldWithDefault(msg, 34, ""),
+    latepenalty: jspb.Message.getFieldWithDefault(msg, 35, 0),
+    outputlimit: jspb.Message.getFieldWithDefault(msg, 36, 0),
+    retryoninfra: jspb.Message.getFieldWithDefault(msg, 37, 0),
+    gracehours: jspb.Message.getFieldWithDefault(msg, 38, 0),
+    timezone: jspb.Message.getFieldWithDefault(msg, 39, ""),
+    deadlinetime: (f = msg.getDeadlinetime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    dockerfile: jspb.Message.getFieldWithDefault(msg, 41, ""),
+    dockerimage: jspb.Message.getFieldWithDefault(msg, 42, ""),
+    tasksList: jspb.Message.toObjectList(msg.getTasksList(),
+    proto.ag.Task.toObject, includeInstance),
+    requires: jspb.Message.getFieldWithDefault(msg, 44, ""),
+    maxattempts: jspb.Message.getFieldWithDefault(msg, 45, 0),
+    cooldownminutes: jspb.Message.getFieldWithDefault(msg, 46, 0),
+    randomseed: jspb.Message.getBooleanFieldWithDefault(msg, 47, false),
+    secrets: jspb.Message.getFieldWithDefault(msg, 48, ""),
+    approvalruns: jspb.Message.getFieldWithDefault(msg, 49, 0),
+    approvebeforedeadline: jspb.Message.getBooleanFieldWithDefault(msg, 50, false),
+    manualreview: jspb.Message.getBooleanFieldWithDefault(msg, 51, false),
+    cpulimit: jspb.Message.getFieldWithDefault(msg, 52, 0),
+    memorylimit: jspb.Message.getFieldWithDefault(msg, 53, 0),
+    pidslimit: jspb.Message.getFieldWithDefault(msg, 54, 0),
+    disklimit: jspb.Message.getFieldWithDefault(msg, 55, 0),
+    allowedhosts: jspb.Message.getFieldWithDefault(msg, 56, ""),
+    maxreruns: jspb.Message.getFieldWithDefault(msg, 57, 0),
+    coverageprofile: jspb.Message.getFieldWithDefault(msg, 58, ""),
+    coverageweight: jspb.Message.getFieldWithDefault(msg, 59, 0),
+    coveragetarget: jspb.Message.getFieldWithDefault(msg, 60, 0),
+    analysischecksList: jspb.Message.toObjectList(msg.getAnalysischecksList(),
+    proto.ag.AnalysisCheck.toObject, includeInstance),
+    matrix: jspb.Message.getFieldWithDefault(msg, 62, ""),
+    testformat: jspb.Message.getFieldWithDefault(msg, 63, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.Assignment}
+ */
+proto.ag.Assignment.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.Assignment;
+  return proto.ag.Assignment.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.Assignment} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.Assignment}
+ */
+proto.ag.Assignment.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setScriptfile(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDeadline(value);
+      break;
+    case 6:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setAutoapprove(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setOrder(value);
+      break;
+    case 8:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsgrouplab(value);
+      break;
+    case 9:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setScorelimit(value);
+      break;
+    case 10:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setReviewers(value);
+      break;
+    case 11:
+      var value = new proto.ag.Submission;
+      reader.readMessage(value,proto.ag.Submission.deserializeBinaryFromReader);
+      msg.addSubmissions(value);
+      break;
+    case 12:
+      var value = new proto.ag.GradingBenchmark;
+      reader.readMessage(value,proto.ag.GradingBenchmark.deserializeBinaryFromReader);
+      msg.addGradingbenchmarks(value);
+      break;
+    case 13:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setContainertimeout(value);
+      break;
+    case 14:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPartof(value);
+      break;
+    case 15:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setLanguage(value);
+      break;
+    case 16:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setVerbose(value);
+      break;
+    case 17:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRetries(value);
+      break;
+    case 18:
+      var value = new proto.ag.TestConfig;
+      reader.readMessage(value,proto.ag.TestConfig.deserializeBinaryFromReader);
+      msg.addTests(value);
+      break;
+    case 19:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTestsrepourl(value);
+      break;
+    case 20:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTestsreporef(value);
+      break;
+    case 21:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setManualonly(value);
+      break;
+    case 22:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setReleasedate(value);
+      break;
+    case 23:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setClosedate(value);
+      break;
+    case 24:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setExtracreditcap(value);
+      break;
+    case 25:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setHidepoints(value);
+      break;
+    case 26:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRequiredfiles(value);
+      break;
+    case 27:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setReviewerstrategy(value);
+      break;
+    case 28:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setMutenotifications(value);
+      break;
+    case 29:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setPlagiarismcheck(value);
+      break;
+    case 30:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setSimilaritythreshold(value);
+      break;
+    case 31:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNetwork(value);
+      break;
+    case 32:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setCourseweight(value);
+      break;
+    case 33:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setMaxparallel(value);
+      break;
+    case 34:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDiffmode(value);
+      break;
+    case 35:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setLatepenalty(value);
+      break;
+    case 36:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setOutputlimit(value);
+      break;
+    case 37:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRetryoninfra(value);
+      break;
+    case 38:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setGracehours(value);
+      break;
+    case 39:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTimezone(value);
+      break;
+    case 40:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setDeadlinetime(value);
+      break;
+    case 41:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDockerfile(value);
+      break;
+    case 42:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDockerimage(value);
+      break;
+    case 43:
+      var value = new proto.ag.Task;
+      reader.readMessage(value,proto.ag.Task.deserializeBinaryFromReader);
+      msg.addTasks(value);
+      break;
+    case 44:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRequires(value);
+      break;
+    case 45:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setMaxattempts(value);
+      break;
+    case 46:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setCooldownminutes(value);
+      break;
+    case 47:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setRandomseed(value);
+      break;
+    case 48:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSecrets(value);
+      break;
+    case 49:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setApprovalruns(value);
+      break;
+    case 50:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setApprovebeforedeadline(value);
+      break;
+    case 51:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setManualreview(value);
+      break;
+    case 52:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setCpulimit(value);
+      break;
+    case 53:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setMemorylimit(value);
+      break;
+    case 54:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setPidslimit(value);
+      break;
+    case 55:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setDisklimit(value);
+      break;
+    case 56:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAllowedhosts(value);
+      break;
+    case 57:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setMaxreruns(value);
+      break;
+    case 58:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCoverageprofile(value);
+      break;
+    case 59:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setCoverageweight(value);
+      break;
+    case 60:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setCoveragetarget(value);
+      break;
+    case 61:
+      var value = new proto.ag.AnalysisCheck;
+      reader.readMessage(value,proto.ag.AnalysisCheck.deserializeBinaryFromReader);
+      msg.addAnalysischecks(value);
+      break;
+    case 62:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMatrix(value);
+      break;
+    case 63:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTestformat(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.Assignment.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.Assignment.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.Assignment} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Assignment.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getScriptfile();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getDeadline();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getAutoapprove();
+  if (f) {
+    writer.writeBool(
+      6,
+      f
+    );
+  }
+  f = message.getOrder();
+  if (f !== 0) {
+    writer.writeUint32(
+      7,
+      f
+    );
+  }
+  f = message.getIsgrouplab();
+  if (f) {
+    writer.writeBool(
+      8,
+      f
+    );
+  }
+  f = message.getScorelimit();
+  if (f !== 0) {
+    writer.writeUint32(
+      9,
+      f
+    );
+  }
+  f = message.getReviewers();
+  if (f !== 0) {
+    writer.writeUint32(
+      10,
+      f
+    );
+  }
+  f = message.getSubmissionsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      11,
+      f,
+      proto.ag.Submission.serializeBinaryToWriter
+    );
+  }
+  f = message.getGradingbenchmarksList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      12,
+      f,
+      proto.ag.GradingBenchmark.serializeBinaryToWriter
+    );
+  }
+  f = message.getContainertimeout();
+  if (f !== 0) {
+    writer.writeUint32(
+      13,
+      f
+    );
+  }
+  f = message.getPartof();
+  if (f.length > 0) {
+    writer.writeString(
+      14,
+      f
+    );
+  }
+  f = message.getLanguage();
+  if (f.length > 0) {
+    writer.writeString(
+      15,
+      f
+    );
+  }
+  f = message.getVerbose();
+  if (f) {
+    writer.writeBool(
+      16,
+      f
+    );
+  }
+  f = message.getRetries();
+  if (f !== 0) {
+    writer.writeUint32(
+      17,
+      f
+    );
+  }
+  f = message.getTestsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      18,
+      f,
+      proto.ag.TestConfig.serializeBinaryToWriter
+    );
+  }
+  f = message.getTestsrepourl();
+  if (f.length > 0) {
+    writer.writeString(
+      19,
+      f
+    );
+  }
+  f = message.getTestsreporef();
+  if (f.length > 0) {
+    writer.writeString(
+      20,
+      f
+    );
+  }
+  f = message.getManualonly();
+  if (f) {
+    writer.writeBool(
+      21,
+      f
+    );
+  }
+  f = message.getReleasedate();
+  if (f != null) {
+    writer.writeMessage(
+      22,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getClosedate();
+  if (f != null) {
+    writer.writeMessage(
+      23,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getExtracreditcap();
+  if (f !== 0) {
+    writer.writeUint32(
+      24,
+      f
+    );
+  }
+  f = message.getHidepoints();
+  if (f) {
+    writer.writeBool(
+      25,
+      f
+    );
+  }
+  f = message.getRequiredfiles();
+  if (f.length > 0) {
+    writer.writeString(
+      26,
+      f
+    );
+  }
+  f = message.getReviewerstrategy();
+  if (f.length > 0) {
+    writer.writeString(
+      27,
+      f
+    );
+  }
+  f = message.getMutenotifications();
+  if (f) {
+    writer.writeBool(
+      28,
+      f
+    );
+  }
+  f = message.getPlagiarismcheck();
+  if (f) {
+    writer.writeBool(
+      29,
+      f
+    );
+  }
+  f = message.getSimilaritythreshold();
+  if (f !== 0) {
+    writer.writeUint32(
+      30,
+      f
+    );
+  }
+  f = message.getNetwork();
+  if (f.length > 0) {
+    writer.writeString(
+      31,
+      f
+    );
+  }
+  f = message.getCourseweight();
+  if (f !== 0) {
+    writer.writeUint32(
+      32,
+      f
+    );
+  }
+  f = message.getMaxparallel();
+  if (f !== 0) {
+    writer.writeUint32(
+      33,
+      f
+    );
+  }
+  f = message.getDiffmode();
+  if (f.length > 0) {
+    writer.writeString(
+      34,
+      f
+    );
+  }
+  f = message.getLatepenalty();
+  if (f !== 0) {
+    writer.writeUint32(
+      35,
+      f
+    );
+  }
+  f = message.getOutputlimit();
+  if (f !== 0) {
+    writer.writeUint32(
+      36,
+      f
+    );
+  }
+  f = message.getRetryoninfra();
+  if (f !== 0) {
+    writer.writeUint32(
+      37,
+      f
+    );
+  }
+  f = message.getGracehours();
+  if (f !== 0) {
+    writer.writeUint32(
+      38,
+      f
+    );
+  }
+  f = message.getTimezone();
+  if (f.length > 0) {
+    writer.writeString(
+      39,
+      f
+    );
+  }
+  f = message.getDeadlinetime();
+  if (f != null) {
+    writer.writeMessage(
+      40,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getDockerfile();
+  if (f.length > 0) {
+    writer.writeString(
+      41,
+      f
+    );
+  }
+  f = message.getDockerimage();
+  if (f.length > 0) {
+    writer.writeString(
+      42,
+      f
+    );
+  }
+  f = message.getTasksList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      43,
+      f,
+      proto.ag.Task.serializeBinaryToWriter
+    );
+  }
+  f = message.getRequires();
+  if (f.length > 0) {
+    writer.writeString(
+      44,
+      f
+    );
+  }
+  f = message.getMaxattempts();
+  if (f !== 0) {
+    writer.writeUint32(
+      45,
+      f
+    );
+  }
+  f = message.getCooldownminutes();
+  if (f !== 0) {
+    writer.writeUint32(
+      46,
+      f
+    );
+  }
+  f = message.getRandomseed();
+  if (f) {
+    writer.writeBool(
+      47,
+      f
+    );
+  }
+  f = message.getSecrets();
+  if (f.length > 0) {
+    writer.writeString(
+      48,
+      f
+    );
+  }
+  f = message.getApprovalruns();
+  if (f !== 0) {
+    writer.writeUint32(
+      49,
+      f
+    );
+  }
+  f = message.getApprovebeforedeadline();
+  if (f) {
+    writer.writeBool(
+      50,
+      f
+    );
+  }
+  f = message.getManualreview();
+  if (f) {
+    writer.writeBool(
+      51,
+      f
+    );
+  }
+  f = message.getCpulimit();
+  if (f !== 0) {
+    writer.writeUint32(
+      52,
+      f
+    );
+  }
+  f = message.getMemorylimit();
+  if (f !== 0) {
+    writer.writeUint64(
+      53,
+      f
+    );
+  }
+  f = message.getPidslimit();
+  if (f !== 0) {
+    writer.writeUint32(
+      54,
+      f
+    );
+  }
+  f = message.getDisklimit();
+  if (f !== 0) {
+    writer.writeUint64(
+      55,
+      f
+    );
+  }
+  f = message.getAllowedhosts();
+  if (f.length > 0) {
+    writer.writeString(
+      56,
+      f
+    );
+  }
+  f = message.getMaxreruns();
+  if (f !== 0) {
+    writer.writeUint32(
+      57,
+      f
+    );
+  }
+  f = message.getCoverageprofile();
+  if (f.length > 0) {
+    writer.writeString(
+      58,
+      f
+    );
+  }
+  f = message.getCoverageweight();
+  if (f !== 0) {
+    writer.writeUint32(
+      59,
+      f
+    );
+  }
+  f = message.getCoveragetarget();
+  if (f !== 0) {
+    writer.writeUint32(
+      60,
+      f
+    );
+  }
+  f = message.getAnalysischecksList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      61,
+      f,
+      proto.ag.AnalysisCheck.serializeBinaryToWriter
+    );
+  }
+  f = message.getMatrix();
+  if (f.length > 0) {
+    writer.writeString(
+      62,
+      f
+    );
+  }
+  f = message.getTestformat();
+  if (f.length > 0) {
+    writer.writeString(
+      63,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.ag.Assignment.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 CourseID = 2;
+ * @return {number}
+ */
+proto.ag.Assignment.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string name = 3;
+ * @return {string}
+ */
+proto.ag.Assignment.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string scriptFile = 4;
+ * @return {string}
+ */
+proto.ag.Assignment.prototype.getScriptfile = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setScriptfile = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string deadline = 5;
+ * @return {string}
+ */
+proto.ag.Assignment.prototype.getDeadline = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setDeadline = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional bool autoApprove = 6;
+ * @return {boolean}
+ */
+proto.ag.Assignment.prototype.getAutoapprove = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setAutoapprove = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 6, value);
+};
+
+
+/**
+ * optional uint32 order = 7;
+ * @return {number}
+ */
+proto.ag.Assignment.prototype.getOrder = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setOrder = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
+};
+
+
+/**
+ * optional bool isGroupLab = 8;
+ * @return {boolean}
+ */
+proto.ag.Assignment.prototype.getIsgrouplab = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 8, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setIsgrouplab = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 8, value);
+};
+
+
+/**
+ * optional uint32 scoreLimit = 9;
+ * @return {number}
+ */
+proto.ag.Assignment.prototype.getScorelimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setScorelimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 9, value);
+};
+
+
+/**
+ * optional uint32 reviewers = 10;
+ * @return {number}
+ */
+proto.ag.Assignment.prototype.getReviewers = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setReviewers = function(value) {
+  return jspb.Message.setProto3IntField(this, 10, value);
+};
+
+
+/**
+ * repeated Submission submissions = 11;
+ * @return {!Array<!proto.ag.Submission>}
+ */
+proto.ag.Assignment.prototype.getSubmissionsList = function() {
+  return /** @type{!Array<!proto.ag.Submission>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.Submission, 11));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.Submission>} value
+ * @return {!proto.ag.Assignment} returns this
+*/
+proto.ag.Assignment.prototype.setSubmissionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 11, value);
+};
+
+
+/**
+ * @param {!proto.ag.Submission=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.Submission}
+ */
+proto.ag.Assignment.prototype.addSubmissions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 11, opt_value, proto.ag.Submission, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.clearSubmissionsList = function() {
+  return this.setSubmissionsList([]);
+};
+
+
+/**
+ * repeated GradingBenchmark gradingBenchmarks = 12;
+ * @return {!Array<!proto.ag.GradingBenchmark>}
+ */
+proto.ag.Assignment.prototype.getGradingbenchmarksList = function() {
+  return /** @type{!Array<!proto.ag.GradingBenchmark>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.GradingBenchmark, 12));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.GradingBenchmark>} value
+ * @return {!proto.ag.Assignment} returns this
+*/
+proto.ag.Assignment.prototype.setGradingbenchmarksList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 12, value);
+};
+
+
+/**
+ * @param {!proto.ag.GradingBenchmark=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.GradingBenchmark}
+ */
+proto.ag.Assignment.prototype.addGradingbenchmarks = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 12, opt_value, proto.ag.GradingBenchmark, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.clearGradingbenchmarksList = function() {
+  return this.setGradingbenchmarksList([]);
+};
+
+
+/**
+ * optional uint32 containerTimeout = 13;
+ * @return {number}
+ */
+proto.ag.Assignment.prototype.getContainertimeout = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 13, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setContainertimeout = function(value) {
+  return jspb.Message.setProto3IntField(this, 13, value);
+};
+
+
+/**
+ * optional string partOf = 14;
+ * @return {string}
+ */
+proto.ag.Assignment.prototype.getPartof = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 14, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setPartof = function(value) {
+  return jspb.Message.setProto3StringField(this, 14, value);
+};
+
+
+/**
+ * optional string language = 15;
+ * @return {string}
+ */
+proto.ag.Assignment.prototype.getLanguage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 15, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setLanguage = function(value) {
+  return jspb.Message.setProto3StringField(this, 15, value);
+};
+
+
+/**
+ * optional bool verbose = 16;
+ * @return {boolean}
+ */
+proto.ag.Assignment.prototype.getVerbose = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 16, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setVerbose = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 16, value);
+};
+
+
+/**
+ * optional uint32 retries = 17;
+ * @return {number}
+ */
+proto.ag.Assignment.prototype.getRetries = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 17, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setRetries = function(value) {
+  return jspb.Message.setProto3IntField(this, 17, value);
+};
+
+
+/**
+ * repeated TestConfig tests = 18;
+ * @return {!Array<!proto.ag.TestConfig>}
+ */
+proto.ag.Assignment.prototype.getTestsList = function() {
+  return /** @type{!Array<!proto.ag.TestConfig>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.TestConfig, 18));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.TestConfig>} value
+ * @return {!proto.ag.Assignment} returns this
+*/
+proto.ag.Assignment.prototype.setTestsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 18, value);
+};
+
+
+/**
+ * @param {!proto.ag.TestConfig=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.TestConfig}
+ */
+proto.ag.Assignment.prototype.addTests = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 18, opt_value, proto.ag.TestConfig, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.clearTestsList = function() {
+  return this.setTestsList([]);
+};
+
+
+/**
+ * optional string testsRepoURL = 19;
+ * @return {string}
+ */
+proto.ag.Assignment.prototype.getTestsrepourl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 19, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setTestsrepourl = function(value) {
+  return jspb.Message.setProto3StringField(this, 19, value);
+};
+
+
+/**
+ * optional string testsRepoRef = 20;
+ * @return {string}
+ */
+proto.ag.Assignment.prototype.getTestsreporef = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 20, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setTestsreporef = function(value) {
+  return jspb.Message.setProto3StringField(this, 20, value);
+};
+
+
+/**
+ * optional bool manualOnly = 21;
+ * @return {boolean}
+ */
+proto.ag.Assignment.prototype.getManualonly = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 21, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setManualonly = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 21, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp releaseDate = 22;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.ag.Assignment.prototype.getReleasedate = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 22));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.ag.Assignment} returns this
+*/
+proto.ag.Assignment.prototype.setReleasedate = function(value) {
+  return jspb.Message.setWrapperField(this, 22, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.clearReleasedate = function() {
+  return this.setReleasedate(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ag.Assignment.prototype.hasReleasedate = function() {
+  return jspb.Message.getField(this, 22) != null;
+};
+
+
+/**
+ * optional google.protobuf.Timestamp closeDate = 23;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.ag.Assignment.prototype.getClosedate = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 23));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.ag.Assignment} returns this
+*/
+proto.ag.Assignment.prototype.setClosedate = function(value) {
+  return jspb.Message.setWrapperField(this, 23, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.clearClosedate = function() {
+  return this.setClosedate(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ag.Assignment.prototype.hasClosedate = function() {
+  return jspb.Message.getField(this, 23) != null;
+};
+
+
+/**
+ * optional uint32 extraCreditCap = 24;
+ * @return {number}
+ */
+proto.ag.Assignment.prototype.getExtracreditcap = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 24, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setExtracreditcap = function(value) {
+  return jspb.Message.setProto3IntField(this, 24, value);
+};
+
+
+/**
+ * optional bool hidePoints = 25;
+ * @return {boolean}
+ */
+proto.ag.Assignment.prototype.getHidepoints = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 25, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setHidepoints = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 25, value);
+};
+
+
+/**
+ * optional string requiredFiles = 26;
+ * @return {string}
+ */
+proto.ag.Assignment.prototype.getRequiredfiles = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 26, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setRequiredfiles = function(value) {
+  return jspb.Message.setProto3StringField(this, 26, value);
+};
+
+
+/**
+ * optional string reviewerStrategy = 27;
+ * @return {string}
+ */
+proto.ag.Assignment.prototype.getReviewerstrategy = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 27, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setReviewerstrategy = function(value) {
+  return jspb.Message.setProto3StringField(this, 27, value);
+};
+
+
+/**
+ * optional bool muteNotifications = 28;
+ * @return {boolean}
+ */
+proto.ag.Assignment.prototype.getMutenotifications = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 28, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setMutenotifications = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 28, value);
+};
+
+
+/**
+ * optional bool plagiarismCheck = 29;
+ * @return {boolean}
+ */
+proto.ag.Assignment.prototype.getPlagiarismcheck = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 29, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setPlagiarismcheck = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 29, value);
+};
+
+
+/**
+ * optional uint32 similarityThreshold = 30;
+ * @return {number}
+ */
+proto.ag.Assignment.prototype.getSimilaritythreshold = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 30, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setSimilaritythreshold = function(value) {
+  return jspb.Message.setProto3IntField(this, 30, value);
+};
+
+
+/**
+ * optional string network = 31;
+ * @return {string}
+ */
+proto.ag.Assignment.prototype.getNetwork = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 31, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setNetwork = function(value) {
+  return jspb.Message.setProto3StringField(this, 31, value);
+};
+
+
+/**
+ * optional uint32 courseWeight = 32;
+ * @return {number}
+ */
+proto.ag.Assignment.prototype.getCourseweight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 32, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setCourseweight = function(value) {
+  return jspb.Message.setProto3IntField(this, 32, value);
+};
+
+
+/**
+ * optional uint32 maxParallel = 33;
+ * @return {number}
+ */
+proto.ag.Assignment.prototype.getMaxparallel = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 33, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setMaxparallel = function(value) {
+  return jspb.Message.setProto3IntField(this, 33, value);
+};
+
+
+/**
+ * optional string diffMode = 34;
+ * @return {string}
+ */
+proto.ag.Assignment.prototype.getDiffmode = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 34, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setDiffmode = function(value) {
+  return jspb.Message.setProto3StringField(this, 34, value);
+};
+
+
+/**
+ * optional uint32 latePenalty = 35;
+ * @return {number}
+ */
+proto.ag.Assignment.prototype.getLatepenalty = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 35, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setLatepenalty = function(value) {
+  return jspb.Message.setProto3IntField(this, 35, value);
+};
+
+
+/**
+ * optional uint32 outputLimit = 36;
+ * @return {number}
+ */
+proto.ag.Assignment.prototype.getOutputlimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 36, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setOutputlimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 36, value);
+};
+
+
+/**
+ * optional uint32 retryOnInfra = 37;
+ * @return {number}
+ */
+proto.ag.Assignment.prototype.getRetryoninfra = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 37, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setRetryoninfra = function(value) {
+  return jspb.Message.setProto3IntField(this, 37, value);
+};
+
+
+/**
+ * optional uint32 graceHours = 38;
+ * @return {number}
+ */
+proto.ag.Assignment.prototype.getGracehours = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 38, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setGracehours = function(value) {
+  return jspb.Message.setProto3IntField(this, 38, value);
+};
+
+
+/**
+ * optional string timeZone = 39;
+ * @return {string}
+ */
+proto.ag.Assignment.prototype.getTimezone = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 39, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setTimezone = function(value) {
+  return jspb.Message.setProto3StringField(this, 39, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp deadlineTime = 40;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.ag.Assignment.prototype.getDeadlinetime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 40));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.ag.Assignment} returns this
+*/
+proto.ag.Assignment.prototype.setDeadlinetime = function(value) {
+  return jspb.Message.setWrapperField(this, 40, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.clearDeadlinetime = function() {
+  return this.setDeadlinetime(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ag.Assignment.prototype.hasDeadlinetime = function() {
+  return jspb.Message.getField(this, 40) != null;
+};
+
+
+/**
+ * optional string dockerfile = 41;
+ * @return {string}
+ */
+proto.ag.Assignment.prototype.getDockerfile = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 41, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setDockerfile = function(value) {
+  return jspb.Message.setProto3StringField(this, 41, value);
+};
+
+
+/**
+ * optional string dockerImage = 42;
+ * @return {string}
+ */
+proto.ag.Assignment.prototype.getDockerimage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 42, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setDockerimage = function(value) {
+  return jspb.Message.setProto3StringField(this, 42, value);
+};
+
+
+/**
+ * repeated Task tasks = 43;
+ * @return {!Array<!proto.ag.Task>}
+ */
+proto.ag.Assignment.prototype.getTasksList = function() {
+  return /** @type{!Array<!proto.ag.Task>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.Task, 43));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.Task>} value
+ * @return {!proto.ag.Assignment} returns this
+*/
+proto.ag.Assignment.prototype.setTasksList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 43, value);
+};
+
+
+/**
+ * @param {!proto.ag.Task=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.Task}
+ */
+proto.ag.Assignment.prototype.addTasks = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 43, opt_value, proto.ag.Task, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.clearTasksList = function() {
+  return this.setTasksList([]);
+};
+
+
+/**
+ * optional string requires = 44;
+ * @return {string}
+ */
+proto.ag.Assignment.prototype.getRequires = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 44, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setRequires = function(value) {
+  return jspb.Message.setProto3StringField(this, 44, value);
+};
+
+
+/**
+ * optional uint32 maxAttempts = 45;
+ * @return {number}
+ */
+proto.ag.Assignment.prototype.getMaxattempts = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 45, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setMaxattempts = function(value) {
+  return jspb.Message.setProto3IntField(this, 45, value);
+};
+
+
+/**
+ * optional uint32 cooldownMinutes = 46;
+ * @return {number}
+ */
+proto.ag.Assignment.prototype.getCooldownminutes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 46, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setCooldownminutes = function(value) {
+  return jspb.Message.setProto3IntField(this, 46, value);
+};
+
+
+/**
+ * optional bool randomSeed = 47;
+ * @return {boolean}
+ */
+proto.ag.Assignment.prototype.getRandomseed = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 47, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setRandomseed = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 47, value);
+};
+
+
+/**
+ * optional string secrets = 48;
+ * @return {string}
+ */
+proto.ag.Assignment.prototype.getSecrets = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 48, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setSecrets = function(value) {
+  return jspb.Message.setProto3StringField(this, 48, value);
+};
+
+
+/**
+ * optional uint32 approvalRuns = 49;
+ * @return {number}
+ */
+proto.ag.Assignment.prototype.getApprovalruns = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 49, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setApprovalruns = function(value) {
+  return jspb.Message.setProto3IntField(this, 49, value);
+};
+
+
+/**
+ * optional bool approveBeforeDeadline = 50;
+ * @return {boolean}
+ */
+proto.ag.Assignment.prototype.getApprovebeforedeadline = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 50, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setApprovebeforedeadline = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 50, value);
+};
+
+
+/**
+ * optional bool manualReview = 51;
+ * @return {boolean}
+ */
+proto.ag.Assignment.prototype.getManualreview = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 51, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setManualreview = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 51, value);
+};
+
+
+/**
+ * optional uint32 cpuLimit = 52;
+ * @return {number}
+ */
+proto.ag.Assignment.prototype.getCpulimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 52, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setCpulimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 52, value);
+};
+
+
+/**
+ * optional uint64 memoryLimit = 53;
+ * @return {number}
+ */
+proto.ag.Assignment.prototype.getMemorylimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 53, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setMemorylimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 53, value);
+};
+
+
+/**
+ * optional uint32 pidsLimit = 54;
+ * @return {number}
+ */
+proto.ag.Assignment.prototype.getPidslimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 54, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setPidslimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 54, value);
+};
+
+
+/**
+ * optional uint64 diskLimit = 55;
+ * @return {number}
+ */
+proto.ag.Assignment.prototype.getDisklimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 55, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setDisklimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 55, value);
+};
+
+
+/**
+ * optional string allowedHosts = 56;
+ * @return {string}
+ */
+proto.ag.Assignment.prototype.getAllowedhosts = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 56, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setAllowedhosts = function(value) {
+  return jspb.Message.setProto3StringField(this, 56, value);
+};
+
+
+/**
+ * optional uint32 maxReruns = 57;
+ * @return {number}
+ */
+proto.ag.Assignment.prototype.getMaxreruns = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 57, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setMaxreruns = function(value) {
+  return jspb.Message.setProto3IntField(this, 57, value);
+};
+
+
+/**
+ * optional string coverageProfile = 58;
+ * @return {string}
+ */
+proto.ag.Assignment.prototype.getCoverageprofile = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 58, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setCoverageprofile = function(value) {
+  return jspb.Message.setProto3StringField(this, 58, value);
+};
+
+
+/**
+ * optional uint32 coverageWeight = 59;
+ * @return {number}
+ */
+proto.ag.Assignment.prototype.getCoverageweight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 59, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setCoverageweight = function(value) {
+  return jspb.Message.setProto3IntField(this, 59, value);
+};
+
+
+/**
+ * optional uint32 coverageTarget = 60;
+ * @return {number}
+ */
+proto.ag.Assignment.prototype.getCoveragetarget = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 60, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setCoveragetarget = function(value) {
+  return jspb.Message.setProto3IntField(this, 60, value);
+};
+
+
+/**
+ * repeated AnalysisCheck analysisChecks = 61;
+ * @return {!Array<!proto.ag.AnalysisCheck>}
+ */
+proto.ag.Assignment.prototype.getAnalysischecksList = function() {
+  return /** @type{!Array<!proto.ag.AnalysisCheck>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.AnalysisCheck, 61));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.AnalysisCheck>} value
+ * @return {!proto.ag.Assignment} returns this
+*/
+proto.ag.Assignment.prototype.setAnalysischecksList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 61, value);
+};
+
+
+/**
+ * @param {!proto.ag.AnalysisCheck=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.AnalysisCheck}
+ */
+proto.ag.Assignment.prototype.addAnalysischecks = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 61, opt_value, proto.ag.AnalysisCheck, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.clearAnalysischecksList = function() {
+  return this.setAnalysischecksList([]);
+};
+
+
+/**
+ * optional string matrix = 62;
+ * @return {string}
+ */
+proto.ag.Assignment.prototype.getMatrix = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 62, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setMatrix = function(value) {
+  return jspb.Message.setProto3StringField(this, 62, value);
+};
+
+
+/**
+ * optional string testFormat = 63;
+ * @return {string}
+ */
+proto.ag.Assignment.prototype.getTestformat = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 63, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setTestformat = function(value) {
+  return jspb.Message.setProto3StringField(this, 63, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.TestConfig.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.TestConfig.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.TestConfig} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.TestConfig.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    testname: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    retryable: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
+    extracredit: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
+    maxscore: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    weight: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    passthreshold: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    hidden: jspb.Message.getBooleanFieldWithDefault(msg, 9, false),
+    requiredforapproval: jspb.Message.getBooleanFieldWithDefault(msg, 10, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.TestConfig}
+ */
+proto.ag.TestConfig.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.TestConfig;
+  return proto.ag.TestConfig.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.TestConfig} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.TestConfig}
+ */
+proto.ag.TestConfig.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTestname(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setRetryable(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setExtracredit(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setMaxscore(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setWeight(value);
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setPassthreshold(value);
+      break;
+    case 9:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setHidden(value);
+      break;
+    case 10:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setRequiredforapproval(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.TestConfig.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.TestConfig.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.TestConfig} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.TestConfig.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getTestname();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getRetryable();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+  f = message.getExtracredit();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+  f = message.getMaxscore();
+  if (f !== 0) {
+    writer.writeInt32(
+      6,
+      f
+    );
+  }
+  f = message.getWeight();
+  if (f !== 0) {
+    writer.writeInt32(
+      7,
+      f
+    );
+  }
+  f = message.getPassthreshold();
+  if (f !== 0) {
+    writer.writeUint32(
+      8,
+      f
+    );
+  }
+  f = message.getHidden();
+  if (f) {
+    writer.writeBool(
+      9,
+      f
+    );
+  }
+  f = message.getRequiredforapproval();
+  if (f) {
+    writer.writeBool(
+      10,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.ag.TestConfig.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.TestConfig} returns this
+ */
+proto.ag.TestConfig.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 AssignmentID = 2;
+ * @return {number}
+ */
+proto.ag.TestConfig.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.TestConfig} returns this
+ */
+proto.ag.TestConfig.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string testName = 3;
+ * @return {string}
+ */
+proto.ag.TestConfig.prototype.getTestname = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.TestConfig} returns this
+ */
+proto.ag.TestConfig.prototype.setTestname = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional bool retryable = 4;
+ * @return {boolean}
+ */
+proto.ag.TestConfig.prototype.getRetryable = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ag.TestConfig} returns this
+ */
+proto.ag.TestConfig.prototype.setRetryable = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
+};
+
+
+/**
+ * optional bool extraCredit = 5;
+ * @return {boolean}
+ */
+proto.ag.TestConfig.prototype.getExtracredit = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ag.TestConfig} returns this
+ */
+proto.ag.TestConfig.prototype.setExtracredit = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
+};
+
+
+/**
+ * optional int32 maxScore = 6;
+ * @return {number}
+ */
+proto.ag.TestConfig.prototype.getMaxscore = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.TestConfig} returns this
+ */
+proto.ag.TestConfig.prototype.setMaxscore = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+/**
+ * optional int32 weight = 7;
+ * @return {number}
+ */
+proto.ag.TestConfig.prototype.getWeight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.TestConfig} returns this
+ */
+proto.ag.TestConfig.prototype.setWeight = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
+};
+
+
+/**
+ * optional uint32 passThreshold = 8;
+ * @return {number}
+ */
+proto.ag.TestConfig.prototype.getPassthreshold = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.TestConfig} returns this
+ */
+proto.ag.TestConfig.prototype.setPassthreshold = function(value) {
+  return jspb.Message.setProto3IntField(this, 8, value);
+};
+
+
+/**
+ * optional bool hidden = 9;
+ * @return {boolean}
+ */
+proto.ag.TestConfig.prototype.getHidden = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 9, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ag.TestConfig} returns this
+ */
+proto.ag.TestConfig.prototype.setHidden = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 9, value);
+};
+
+
+/**
+ * optional bool requiredForApproval = 10;
+ * @return {boolean}
+ */
+proto.ag.TestConfig.prototype.getRequiredforapproval = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 10, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ag.TestConfig} returns this
+ */
+proto.ag.TestConfig.prototype.setRequiredforapproval = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 10, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.AnalysisCheck.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.AnalysisCheck.toObject(opt_includeInstance, this);
 };
 
 
@@ -1141,23 +8356,18 @@ proto.ag.User.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.User} msg The msg instance to transform.
+ * @param {!proto.ag.AnalysisCheck} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.User.toObject = function(includeInstance, msg) {
+proto.ag.AnalysisCheck.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    isadmin: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
     name: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    studentid: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    email: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    avatarurl: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    login: jspb.Message.getFieldWithDefault(msg, 7, ""),
-    remoteidentitiesList: jspb.Message.toObjectList(msg.getRemoteidentitiesList(),
-    proto.ag.RemoteIdentity.toObject, includeInstance),
-    enrollmentsList: jspb.Message.toObjectList(msg.getEnrollmentsList(),
-    proto.ag.Enrollment.toObject, includeInstance)
+    command: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    weight: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    penalty: jspb.Message.getFieldWithDefault(msg, 6, 0)
   };
 
   if (includeInstance) {
@@ -1171,23 +8381,23 @@ proto.ag.User.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.User}
+ * @return {!proto.ag.AnalysisCheck}
  */
-proto.ag.User.deserializeBinary = function(bytes) {
+proto.ag.AnalysisCheck.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.User;
-  return proto.ag.User.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.AnalysisCheck;
+  return proto.ag.AnalysisCheck.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.User} msg The message object to deserialize into.
+ * @param {!proto.ag.AnalysisCheck} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.User}
+ * @return {!proto.ag.AnalysisCheck}
  */
-proto.ag.User.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.AnalysisCheck.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1199,8 +8409,8 @@ proto.ag.User.deserializeBinaryFromReader = function(msg, reader) {
       msg.setId(value);
       break;
     case 2:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setIsadmin(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
       break;
     case 3:
       var value = /** @type {string} */ (reader.readString());
@@ -1208,29 +8418,15 @@ proto.ag.User.deserializeBinaryFromReader = function(msg, reader) {
       break;
     case 4:
       var value = /** @type {string} */ (reader.readString());
-      msg.setStudentid(value);
+      msg.setCommand(value);
       break;
     case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setEmail(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setWeight(value);
       break;
     case 6:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setAvatarurl(value);
-      break;
-    case 7:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setLogin(value);
-      break;
-    case 8:
-      var value = new proto.ag.RemoteIdentity;
-      reader.readMessage(value,proto.ag.RemoteIdentity.deserializeBinaryFromReader);
-      msg.addRemoteidentities(value);
-      break;
-    case 9:
-      var value = new proto.ag.Enrollment;
-      reader.readMessage(value,proto.ag.Enrollment.deserializeBinaryFromReader);
-      msg.addEnrollments(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setPenalty(value);
       break;
     default:
       reader.skipField();
@@ -1245,9 +8441,9 @@ proto.ag.User.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.User.prototype.serializeBinary = function() {
+proto.ag.AnalysisCheck.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.User.serializeBinaryToWriter(this, writer);
+  proto.ag.AnalysisCheck.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1255,11 +8451,11 @@ proto.ag.User.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.User} message
+ * @param {!proto.ag.AnalysisCheck} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.User.serializeBinaryToWriter = function(message, writer) {
+proto.ag.AnalysisCheck.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f !== 0) {
@@ -1268,9 +8464,9 @@ proto.ag.User.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
-  f = message.getIsadmin();
-  if (f) {
-    writer.writeBool(
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
       2,
       f
     );
@@ -1282,50 +8478,27 @@ proto.ag.User.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
-  f = message.getStudentid();
+  f = message.getCommand();
   if (f.length > 0) {
     writer.writeString(
       4,
       f
     );
   }
-  f = message.getEmail();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getWeight();
+  if (f !== 0) {
+    writer.writeInt32(
       5,
       f
     );
   }
-  f = message.getAvatarurl();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getPenalty();
+  if (f !== 0) {
+    writer.writeUint32(
       6,
       f
     );
   }
-  f = message.getLogin();
-  if (f.length > 0) {
-    writer.writeString(
-      7,
-      f
-    );
-  }
-  f = message.getRemoteidentitiesList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      8,
-      f,
-      proto.ag.RemoteIdentity.serializeBinaryToWriter
-    );
-  }
-  f = message.getEnrollmentsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      9,
-      f,
-      proto.ag.Enrollment.serializeBinaryToWriter
-    );
-  }
 };
 
 
@@ -1333,35 +8506,35 @@ proto.ag.User.serializeBinaryToWriter = function(message, writer) {
  * optional uint64 ID = 1;
  * @return {number}
  */
-proto.ag.User.prototype.getId = function() {
+proto.ag.AnalysisCheck.prototype.getId = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.User} returns this
+ * @return {!proto.ag.AnalysisCheck} returns this
  */
-proto.ag.User.prototype.setId = function(value) {
+proto.ag.AnalysisCheck.prototype.setId = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional bool isAdmin = 2;
- * @return {boolean}
+ * optional uint64 AssignmentID = 2;
+ * @return {number}
  */
-proto.ag.User.prototype.getIsadmin = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+proto.ag.AnalysisCheck.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.ag.User} returns this
+ * @param {number} value
+ * @return {!proto.ag.AnalysisCheck} returns this
  */
-proto.ag.User.prototype.setIsadmin = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 2, value);
+proto.ag.AnalysisCheck.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
@@ -1369,176 +8542,235 @@ proto.ag.User.prototype.setIsadmin = function(value) {
  * optional string name = 3;
  * @return {string}
  */
-proto.ag.User.prototype.getName = function() {
+proto.ag.AnalysisCheck.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.ag.User} returns this
+ * @return {!proto.ag.AnalysisCheck} returns this
  */
-proto.ag.User.prototype.setName = function(value) {
+proto.ag.AnalysisCheck.prototype.setName = function(value) {
   return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * optional string studentID = 4;
+ * optional string command = 4;
  * @return {string}
  */
-proto.ag.User.prototype.getStudentid = function() {
+proto.ag.AnalysisCheck.prototype.getCommand = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.ag.User} returns this
+ * @return {!proto.ag.AnalysisCheck} returns this
  */
-proto.ag.User.prototype.setStudentid = function(value) {
+proto.ag.AnalysisCheck.prototype.setCommand = function(value) {
   return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * optional string email = 5;
- * @return {string}
+ * optional int32 weight = 5;
+ * @return {number}
  */
-proto.ag.User.prototype.getEmail = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+proto.ag.AnalysisCheck.prototype.getWeight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ag.User} returns this
+ * @param {number} value
+ * @return {!proto.ag.AnalysisCheck} returns this
  */
-proto.ag.User.prototype.setEmail = function(value) {
-  return jspb.Message.setProto3StringField(this, 5, value);
+proto.ag.AnalysisCheck.prototype.setWeight = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
 };
 
 
 /**
- * optional string avatarURL = 6;
- * @return {string}
+ * optional uint32 penalty = 6;
+ * @return {number}
  */
-proto.ag.User.prototype.getAvatarurl = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+proto.ag.AnalysisCheck.prototype.getPenalty = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ag.User} returns this
+ * @param {number} value
+ * @return {!proto.ag.AnalysisCheck} returns this
  */
-proto.ag.User.prototype.setAvatarurl = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
+proto.ag.AnalysisCheck.prototype.setPenalty = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
 };
 
 
+
 /**
- * optional string login = 7;
- * @return {string}
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.ag.User.prototype.getLogin = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+proto.ag.Assignments.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.Assignments.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Assignments.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ag.User} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.Assignments} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.User.prototype.setLogin = function(value) {
-  return jspb.Message.setProto3StringField(this, 7, value);
+proto.ag.Assignments.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    assignmentsList: jspb.Message.toObjectList(msg.getAssignmentsList(),
+    proto.ag.Assignment.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * repeated RemoteIdentity remoteIdentities = 8;
- * @return {!Array<!proto.ag.RemoteIdentity>}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.Assignments}
  */
-proto.ag.User.prototype.getRemoteidentitiesList = function() {
-  return /** @type{!Array<!proto.ag.RemoteIdentity>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.RemoteIdentity, 8));
+proto.ag.Assignments.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.Assignments;
+  return proto.ag.Assignments.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {!Array<!proto.ag.RemoteIdentity>} value
- * @return {!proto.ag.User} returns this
-*/
-proto.ag.User.prototype.setRemoteidentitiesList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 8, value);
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.Assignments} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.Assignments}
+ */
+proto.ag.Assignments.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.ag.Assignment;
+      reader.readMessage(value,proto.ag.Assignment.deserializeBinaryFromReader);
+      msg.addAssignments(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {!proto.ag.RemoteIdentity=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ag.RemoteIdentity}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.ag.User.prototype.addRemoteidentities = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 8, opt_value, proto.ag.RemoteIdentity, opt_index);
+proto.ag.Assignments.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.Assignments.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ag.User} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.Assignments} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.User.prototype.clearRemoteidentitiesList = function() {
-  return this.setRemoteidentitiesList([]);
+proto.ag.Assignments.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAssignmentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.ag.Assignment.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * repeated Enrollment enrollments = 9;
- * @return {!Array<!proto.ag.Enrollment>}
+ * repeated Assignment assignments = 1;
+ * @return {!Array<!proto.ag.Assignment>}
  */
-proto.ag.User.prototype.getEnrollmentsList = function() {
-  return /** @type{!Array<!proto.ag.Enrollment>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.Enrollment, 9));
+proto.ag.Assignments.prototype.getAssignmentsList = function() {
+  return /** @type{!Array<!proto.ag.Assignment>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.Assignment, 1));
 };
 
 
 /**
- * @param {!Array<!proto.ag.Enrollment>} value
- * @return {!proto.ag.User} returns this
+ * @param {!Array<!proto.ag.Assignment>} value
+ * @return {!proto.ag.Assignments} returns this
 */
-proto.ag.User.prototype.setEnrollmentsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 9, value);
+proto.ag.Assignments.prototype.setAssignmentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.ag.Enrollment=} opt_value
+ * @param {!proto.ag.Assignment=} opt_value
  * @param {number=} opt_index
- * @return {!proto.ag.Enrollment}
+ * @return {!proto.ag.Assignment}
  */
-proto.ag.User.prototype.addEnrollments = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 9, opt_value, proto.ag.Enrollment, opt_index);
+proto.ag.Assignments.prototype.addAssignments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.Assignment, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.ag.User} returns this
+ * @return {!proto.ag.Assignments} returns this
  */
-proto.ag.User.prototype.clearEnrollmentsList = function() {
-  return this.setEnrollmentsList([]);
+proto.ag.Assignments.prototype.clearAssignmentsList = function() {
+  return this.setAssignmentsList([]);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.ag.Users.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -1554,8 +8786,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Users.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Users.toObject(opt_includeInstance, this);
+proto.ag.Task.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Task.toObject(opt_includeInstance, this);
 };
 
 
@@ -1564,14 +8796,17 @@ proto.ag.Users.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Users} msg The msg instance to transform.
+ * @param {!proto.ag.Task} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Users.toObject = function(includeInstance, msg) {
+proto.ag.Task.toObject = function(includeInstance, msg) {
   var f, obj = {
-    usersList: jspb.Message.toObjectList(msg.getUsersList(),
-    proto.ag.User.toObject, includeInstance)
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    name: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    title: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    body: jspb.Message.getFieldWithDefault(msg, 5, "")
   };
 
   if (includeInstance) {
@@ -1585,23 +8820,23 @@ proto.ag.Users.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Users}
+ * @return {!proto.ag.Task}
  */
-proto.ag.Users.deserializeBinary = function(bytes) {
+proto.ag.Task.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Users;
-  return proto.ag.Users.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.Task;
+  return proto.ag.Task.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Users} msg The message object to deserialize into.
+ * @param {!proto.ag.Task} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Users}
+ * @return {!proto.ag.Task}
  */
-proto.ag.Users.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.Task.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1609,9 +8844,24 @@ proto.ag.Users.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.ag.User;
-      reader.readMessage(value,proto.ag.User.deserializeBinaryFromReader);
-      msg.addUsers(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTitle(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBody(value);
       break;
     default:
       reader.skipField();
@@ -1626,9 +8876,9 @@ proto.ag.Users.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.Users.prototype.serializeBinary = function() {
+proto.ag.Task.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.Users.serializeBinaryToWriter(this, writer);
+  proto.ag.Task.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1636,58 +8886,137 @@ proto.ag.Users.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Users} message
+ * @param {!proto.ag.Task} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Users.serializeBinaryToWriter = function(message, writer) {
+proto.ag.Task.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUsersList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
       1,
-      f,
-      proto.ag.User.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getTitle();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getBody();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
     );
   }
 };
 
 
 /**
- * repeated User users = 1;
- * @return {!Array<!proto.ag.User>}
+ * optional uint64 ID = 1;
+ * @return {number}
  */
-proto.ag.Users.prototype.getUsersList = function() {
-  return /** @type{!Array<!proto.ag.User>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.User, 1));
+proto.ag.Task.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {!Array<!proto.ag.User>} value
- * @return {!proto.ag.Users} returns this
-*/
-proto.ag.Users.prototype.setUsersList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {number} value
+ * @return {!proto.ag.Task} returns this
+ */
+proto.ag.Task.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.ag.User=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ag.User}
+ * optional uint64 AssignmentID = 2;
+ * @return {number}
  */
-proto.ag.Users.prototype.addUsers = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.User, opt_index);
+proto.ag.Task.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ag.Users} returns this
+ * @param {number} value
+ * @return {!proto.ag.Task} returns this
  */
-proto.ag.Users.prototype.clearUsersList = function() {
-  return this.setUsersList([]);
+proto.ag.Task.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string name = 3;
+ * @return {string}
+ */
+proto.ag.Task.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Task} returns this
+ */
+proto.ag.Task.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string title = 4;
+ * @return {string}
+ */
+proto.ag.Task.prototype.getTitle = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Task} returns this
+ */
+proto.ag.Task.prototype.setTitle = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string body = 5;
+ * @return {string}
+ */
+proto.ag.Task.prototype.getBody = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Task} returns this
+ */
+proto.ag.Task.prototype.setBody = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
 };
 
 
@@ -1707,8 +9036,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.RemoteIdentity.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.RemoteIdentity.toObject(opt_includeInstance, this);
+proto.ag.Issue.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Issue.toObject(opt_includeInstance, this);
 };
 
 
@@ -1717,17 +9046,19 @@ proto.ag.RemoteIdentity.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.RemoteIdentity} msg The msg instance to transform.
+ * @param {!proto.ag.Issue} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.RemoteIdentity.toObject = function(includeInstance, msg) {
+proto.ag.Issue.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    provider: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    remoteid: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    accesstoken: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    userid: jspb.Message.getFieldWithDefault(msg, 5, 0)
+    repositoryid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    taskname: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    issuenumber: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    title: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    body: jspb.Message.getFieldWithDefault(msg, 7, "")
   };
 
   if (includeInstance) {
@@ -1741,23 +9072,23 @@ proto.ag.RemoteIdentity.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.RemoteIdentity}
+ * @return {!proto.ag.Issue}
  */
-proto.ag.RemoteIdentity.deserializeBinary = function(bytes) {
+proto.ag.Issue.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.RemoteIdentity;
-  return proto.ag.RemoteIdentity.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.Issue;
+  return proto.ag.Issue.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.RemoteIdentity} msg The message object to deserialize into.
+ * @param {!proto.ag.Issue} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.RemoteIdentity}
+ * @return {!proto.ag.Issue}
  */
-proto.ag.RemoteIdentity.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.Issue.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1769,20 +9100,28 @@ proto.ag.RemoteIdentity.deserializeBinaryFromReader = function(msg, reader) {
       msg.setId(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setProvider(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setRepositoryid(value);
       break;
     case 3:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setRemoteid(value);
+      msg.setAssignmentid(value);
       break;
     case 4:
       var value = /** @type {string} */ (reader.readString());
-      msg.setAccesstoken(value);
+      msg.setTaskname(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setIssuenumber(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTitle(value);
       break;
-    case 5:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setUserid(value);
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBody(value);
       break;
     default:
       reader.skipField();
@@ -1797,9 +9136,9 @@ proto.ag.RemoteIdentity.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.RemoteIdentity.prototype.serializeBinary = function() {
+proto.ag.Issue.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.RemoteIdentity.serializeBinaryToWriter(this, writer);
+  proto.ag.Issue.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1807,11 +9146,11 @@ proto.ag.RemoteIdentity.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.RemoteIdentity} message
+ * @param {!proto.ag.Issue} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.RemoteIdentity.serializeBinaryToWriter = function(message, writer) {
+proto.ag.Issue.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f !== 0) {
@@ -1820,34 +9159,48 @@ proto.ag.RemoteIdentity.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
-  f = message.getProvider();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getRepositoryid();
+  if (f !== 0) {
+    writer.writeUint64(
       2,
       f
     );
   }
-  f = message.getRemoteid();
+  f = message.getAssignmentid();
   if (f !== 0) {
     writer.writeUint64(
       3,
       f
     );
   }
-  f = message.getAccesstoken();
+  f = message.getTaskname();
   if (f.length > 0) {
     writer.writeString(
       4,
       f
     );
   }
-  f = message.getUserid();
+  f = message.getIssuenumber();
   if (f !== 0) {
     writer.writeUint64(
       5,
       f
     );
   }
+  f = message.getTitle();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getBody();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
 };
 
 
@@ -1855,99 +9208,135 @@ proto.ag.RemoteIdentity.serializeBinaryToWriter = function(message, writer) {
  * optional uint64 ID = 1;
  * @return {number}
  */
-proto.ag.RemoteIdentity.prototype.getId = function() {
+proto.ag.Issue.prototype.getId = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.RemoteIdentity} returns this
+ * @return {!proto.ag.Issue} returns this
  */
-proto.ag.RemoteIdentity.prototype.setId = function(value) {
+proto.ag.Issue.prototype.setId = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional string provider = 2;
- * @return {string}
+ * optional uint64 RepositoryID = 2;
+ * @return {number}
  */
-proto.ag.RemoteIdentity.prototype.getProvider = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.ag.Issue.prototype.getRepositoryid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ag.RemoteIdentity} returns this
+ * @param {number} value
+ * @return {!proto.ag.Issue} returns this
  */
-proto.ag.RemoteIdentity.prototype.setProvider = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.ag.Issue.prototype.setRepositoryid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional uint64 remoteID = 3;
+ * optional uint64 AssignmentID = 3;
  * @return {number}
  */
-proto.ag.RemoteIdentity.prototype.getRemoteid = function() {
+proto.ag.Issue.prototype.getAssignmentid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.RemoteIdentity} returns this
+ * @return {!proto.ag.Issue} returns this
  */
-proto.ag.RemoteIdentity.prototype.setRemoteid = function(value) {
+proto.ag.Issue.prototype.setAssignmentid = function(value) {
   return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * optional string accessToken = 4;
+ * optional string taskName = 4;
  * @return {string}
  */
-proto.ag.RemoteIdentity.prototype.getAccesstoken = function() {
+proto.ag.Issue.prototype.getTaskname = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.ag.RemoteIdentity} returns this
+ * @return {!proto.ag.Issue} returns this
  */
-proto.ag.RemoteIdentity.prototype.setAccesstoken = function(value) {
+proto.ag.Issue.prototype.setTaskname = function(value) {
   return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * optional uint64 userID = 5;
+ * optional uint64 issueNumber = 5;
  * @return {number}
  */
-proto.ag.RemoteIdentity.prototype.getUserid = function() {
+proto.ag.Issue.prototype.getIssuenumber = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.RemoteIdentity} returns this
+ * @return {!proto.ag.Issue} returns this
  */
-proto.ag.RemoteIdentity.prototype.setUserid = function(value) {
+proto.ag.Issue.prototype.setIssuenumber = function(value) {
   return jspb.Message.setProto3IntField(this, 5, value);
 };
 
 
+/**
+ * optional string title = 6;
+ * @return {string}
+ */
+proto.ag.Issue.prototype.getTitle = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Issue} returns this
+ */
+proto.ag.Issue.prototype.setTitle = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional string body = 7;
+ * @return {string}
+ */
+proto.ag.Issue.prototype.getBody = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Issue} returns this
+ */
+proto.ag.Issue.prototype.setBody = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
 
 /**
  * List of repeated fields within this message type.
  * @private {!Array<number>}
  * @const
  */
-proto.ag.Group.repeatedFields_ = [6,7];
+proto.ag.Submission.repeatedFields_ = [10,12,17,18];
 
 
 
@@ -1964,8 +9353,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Group.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Group.toObject(opt_includeInstance, this);
+proto.ag.Submission.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Submission.toObject(opt_includeInstance, this);
 };
 
 
@@ -1974,21 +9363,34 @@ proto.ag.Group.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Group} msg The msg instance to transform.
+ * @param {!proto.ag.Submission} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Group.toObject = function(includeInstance, msg) {
+proto.ag.Submission.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    courseid: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    teamid: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    status: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    usersList: jspb.Message.toObjectList(msg.getUsersList(),
-    proto.ag.User.toObject, includeInstance),
-    enrollmentsList: jspb.Message.toObjectList(msg.getEnrollmentsList(),
-    proto.ag.Enrollment.toObject, includeInstance)
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    userid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    groupid: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    score: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    commithash: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    released: jspb.Message.getBooleanFieldWithDefault(msg, 7, false),
+    status: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    approveddate: jspb.Message.getFieldWithDefault(msg, 9, ""),
+    reviewsList: jspb.Message.toObjectList(msg.getReviewsList(),
+    proto.ag.Review.toObject, includeInstance),
+    buildinfo: (f = msg.getBuildinfo()) && kit_score_score_pb.BuildInfo.toObject(includeInstance, f),
+    scoresList: jspb.Message.toObjectList(msg.getScoresList(),
+    kit_score_score_pb.Score.toObject, includeInstance),
+    attempts: jspb.Message.getFieldWithDefault(msg, 13, 0),
+    passstreak: jspb.Message.getFieldWithDefault(msg, 14, 0),
+    reruns: jspb.Message.getFieldWithDefault(msg, 15, 0),
+    coveragepercent: jspb.Message.getFloatingPointFieldWithDefault(msg, 16, 0.0),
+    annotationsList: jspb.Message.toObjectList(msg.getAnnotationsList(),
+    proto.ag.Annotation.toObject, includeInstance),
+    matrixresultsList: jspb.Message.toObjectList(msg.getMatrixresultsList(),
+    proto.ag.MatrixResult.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -2002,23 +9404,23 @@ proto.ag.Group.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Group}
+ * @return {!proto.ag.Submission}
  */
-proto.ag.Group.deserializeBinary = function(bytes) {
+proto.ag.Submission.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Group;
-  return proto.ag.Group.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.Submission;
+  return proto.ag.Submission.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Group} msg The message object to deserialize into.
+ * @param {!proto.ag.Submission} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Group}
+ * @return {!proto.ag.Submission}
  */
-proto.ag.Group.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.Submission.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2030,30 +9432,77 @@ proto.ag.Group.deserializeBinaryFromReader = function(msg, reader) {
       msg.setId(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
       break;
     case 3:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
+      msg.setUserid(value);
       break;
     case 4:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setTeamid(value);
+      msg.setGroupid(value);
       break;
     case 5:
-      var value = /** @type {!proto.ag.Group.GroupStatus} */ (reader.readEnum());
-      msg.setStatus(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setScore(value);
       break;
     case 6:
-      var value = new proto.ag.User;
-      reader.readMessage(value,proto.ag.User.deserializeBinaryFromReader);
-      msg.addUsers(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCommithash(value);
       break;
     case 7:
-      var value = new proto.ag.Enrollment;
-      reader.readMessage(value,proto.ag.Enrollment.deserializeBinaryFromReader);
-      msg.addEnrollments(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setReleased(value);
+      break;
+    case 8:
+      var value = /** @type {!proto.ag.Submission.Status} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setApproveddate(value);
+      break;
+    case 10:
+      var value = new proto.ag.Review;
+      reader.readMessage(value,proto.ag.Review.deserializeBinaryFromReader);
+      msg.addReviews(value);
+      break;
+    case 11:
+      var value = new kit_score_score_pb.BuildInfo;
+      reader.readMessage(value,kit_score_score_pb.BuildInfo.deserializeBinaryFromReader);
+      msg.setBuildinfo(value);
+      break;
+    case 12:
+      var value = new kit_score_score_pb.Score;
+      reader.readMessage(value,kit_score_score_pb.Score.deserializeBinaryFromReader);
+      msg.addScores(value);
+      break;
+    case 13:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setAttempts(value);
+      break;
+    case 14:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setPassstreak(value);
+      break;
+    case 15:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setReruns(value);
+      break;
+    case 16:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setCoveragepercent(value);
+      break;
+    case 17:
+      var value = new proto.ag.Annotation;
+      reader.readMessage(value,proto.ag.Annotation.deserializeBinaryFromReader);
+      msg.addAnnotations(value);
+      break;
+    case 18:
+      var value = new proto.ag.MatrixResult;
+      reader.readMessage(value,proto.ag.MatrixResult.deserializeBinaryFromReader);
+      msg.addMatrixresults(value);
       break;
     default:
       reader.skipField();
@@ -2068,9 +9517,9 @@ proto.ag.Group.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.Group.prototype.serializeBinary = function() {
+proto.ag.Submission.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.Group.serializeBinaryToWriter(this, writer);
+  proto.ag.Submission.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2078,11 +9527,11 @@ proto.ag.Group.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Group} message
+ * @param {!proto.ag.Submission} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Group.serializeBinaryToWriter = function(message, writer) {
+proto.ag.Submission.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f !== 0) {
@@ -2091,48 +9540,128 @@ proto.ag.Group.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
-  f = message.getName();
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getUserid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getGroupid();
+  if (f !== 0) {
+    writer.writeUint64(
+      4,
+      f
+    );
+  }
+  f = message.getScore();
+  if (f !== 0) {
+    writer.writeUint32(
+      5,
+      f
+    );
+  }
+  f = message.getCommithash();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getReleased();
+  if (f) {
+    writer.writeBool(
+      7,
+      f
+    );
+  }
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      8,
+      f
+    );
+  }
+  f = message.getApproveddate();
+  if (f.length > 0) {
+    writer.writeString(
+      9,
+      f
+    );
+  }
+  f = message.getReviewsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      10,
+      f,
+      proto.ag.Review.serializeBinaryToWriter
+    );
+  }
+  f = message.getBuildinfo();
+  if (f != null) {
+    writer.writeMessage(
+      11,
+      f,
+      kit_score_score_pb.BuildInfo.serializeBinaryToWriter
+    );
+  }
+  f = message.getScoresList();
   if (f.length > 0) {
-    writer.writeString(
-      2,
+    writer.writeRepeatedMessage(
+      12,
+      f,
+      kit_score_score_pb.Score.serializeBinaryToWriter
+    );
+  }
+  f = message.getAttempts();
+  if (f !== 0) {
+    writer.writeUint32(
+      13,
       f
     );
   }
-  f = message.getCourseid();
+  f = message.getPassstreak();
   if (f !== 0) {
-    writer.writeUint64(
-      3,
+    writer.writeUint32(
+      14,
       f
     );
   }
-  f = message.getTeamid();
+  f = message.getReruns();
   if (f !== 0) {
-    writer.writeUint64(
-      4,
+    writer.writeUint32(
+      15,
       f
     );
   }
-  f = message.getStatus();
+  f = message.getCoveragepercent();
   if (f !== 0.0) {
-    writer.writeEnum(
-      5,
+    writer.writeDouble(
+      16,
       f
     );
   }
-  f = message.getUsersList();
+  f = message.getAnnotationsList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
-      6,
+      17,
       f,
-      proto.ag.User.serializeBinaryToWriter
+      proto.ag.Annotation.serializeBinaryToWriter
     );
   }
-  f = message.getEnrollmentsList();
+  f = message.getMatrixresultsList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
-      7,
+      18,
       f,
-      proto.ag.Enrollment.serializeBinaryToWriter
+      proto.ag.MatrixResult.serializeBinaryToWriter
     );
   }
 };
@@ -2141,345 +9670,437 @@ proto.ag.Group.serializeBinaryToWriter = function(message, writer) {
 /**
  * @enum {number}
  */
-proto.ag.Group.GroupStatus = {
-  PENDING: 0,
-  APPROVED: 1
+proto.ag.Submission.Status = {
+  NONE: 0,
+  APPROVED: 1,
+  REJECTED: 2,
+  REVISION: 3
 };
 
 /**
  * optional uint64 ID = 1;
  * @return {number}
  */
-proto.ag.Group.prototype.getId = function() {
+proto.ag.Submission.prototype.getId = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Group} returns this
+ * @return {!proto.ag.Submission} returns this
  */
-proto.ag.Group.prototype.setId = function(value) {
+proto.ag.Submission.prototype.setId = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional string name = 2;
- * @return {string}
+ * optional uint64 AssignmentID = 2;
+ * @return {number}
  */
-proto.ag.Group.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.ag.Submission.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ag.Group} returns this
+ * @param {number} value
+ * @return {!proto.ag.Submission} returns this
  */
-proto.ag.Group.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.ag.Submission.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional uint64 courseID = 3;
+ * optional uint64 userID = 3;
  * @return {number}
  */
-proto.ag.Group.prototype.getCourseid = function() {
+proto.ag.Submission.prototype.getUserid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Group} returns this
+ * @return {!proto.ag.Submission} returns this
  */
-proto.ag.Group.prototype.setCourseid = function(value) {
+proto.ag.Submission.prototype.setUserid = function(value) {
   return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * optional uint64 teamID = 4;
+ * optional uint64 groupID = 4;
  * @return {number}
  */
-proto.ag.Group.prototype.getTeamid = function() {
+proto.ag.Submission.prototype.getGroupid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Group} returns this
+ * @return {!proto.ag.Submission} returns this
  */
-proto.ag.Group.prototype.setTeamid = function(value) {
+proto.ag.Submission.prototype.setGroupid = function(value) {
   return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 /**
- * optional GroupStatus status = 5;
- * @return {!proto.ag.Group.GroupStatus}
+ * optional uint32 score = 5;
+ * @return {number}
  */
-proto.ag.Group.prototype.getStatus = function() {
-  return /** @type {!proto.ag.Group.GroupStatus} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.ag.Submission.prototype.getScore = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
- * @param {!proto.ag.Group.GroupStatus} value
- * @return {!proto.ag.Group} returns this
+ * @param {number} value
+ * @return {!proto.ag.Submission} returns this
  */
-proto.ag.Group.prototype.setStatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 5, value);
+proto.ag.Submission.prototype.setScore = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
 };
 
 
 /**
- * repeated User users = 6;
- * @return {!Array<!proto.ag.User>}
+ * optional string commitHash = 6;
+ * @return {string}
  */
-proto.ag.Group.prototype.getUsersList = function() {
-  return /** @type{!Array<!proto.ag.User>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.User, 6));
+proto.ag.Submission.prototype.getCommithash = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Submission} returns this
+ */
+proto.ag.Submission.prototype.setCommithash = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional bool released = 7;
+ * @return {boolean}
+ */
+proto.ag.Submission.prototype.getReleased = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 7, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ag.Submission} returns this
+ */
+proto.ag.Submission.prototype.setReleased = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 7, value);
+};
+
+
+/**
+ * optional Status status = 8;
+ * @return {!proto.ag.Submission.Status}
+ */
+proto.ag.Submission.prototype.getStatus = function() {
+  return /** @type {!proto.ag.Submission.Status} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {!proto.ag.Submission.Status} value
+ * @return {!proto.ag.Submission} returns this
+ */
+proto.ag.Submission.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 8, value);
+};
+
+
+/**
+ * optional string approvedDate = 9;
+ * @return {string}
+ */
+proto.ag.Submission.prototype.getApproveddate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Submission} returns this
+ */
+proto.ag.Submission.prototype.setApproveddate = function(value) {
+  return jspb.Message.setProto3StringField(this, 9, value);
+};
+
+
+/**
+ * repeated Review reviews = 10;
+ * @return {!Array<!proto.ag.Review>}
+ */
+proto.ag.Submission.prototype.getReviewsList = function() {
+  return /** @type{!Array<!proto.ag.Review>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.Review, 10));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.Review>} value
+ * @return {!proto.ag.Submission} returns this
+*/
+proto.ag.Submission.prototype.setReviewsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 10, value);
+};
+
+
+/**
+ * @param {!proto.ag.Review=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.Review}
+ */
+proto.ag.Submission.prototype.addReviews = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 10, opt_value, proto.ag.Review, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.Submission} returns this
+ */
+proto.ag.Submission.prototype.clearReviewsList = function() {
+  return this.setReviewsList([]);
+};
+
+
+/**
+ * optional score.BuildInfo BuildInfo = 11;
+ * @return {?proto.score.BuildInfo}
+ */
+proto.ag.Submission.prototype.getBuildinfo = function() {
+  return /** @type{?proto.score.BuildInfo} */ (
+    jspb.Message.getWrapperField(this, kit_score_score_pb.BuildInfo, 11));
+};
+
+
+/**
+ * @param {?proto.score.BuildInfo|undefined} value
+ * @return {!proto.ag.Submission} returns this
+*/
+proto.ag.Submission.prototype.setBuildinfo = function(value) {
+  return jspb.Message.setWrapperField(this, 11, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ag.Submission} returns this
+ */
+proto.ag.Submission.prototype.clearBuildinfo = function() {
+  return this.setBuildinfo(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ag.Submission.prototype.hasBuildinfo = function() {
+  return jspb.Message.getField(this, 11) != null;
+};
+
+
+/**
+ * repeated score.Score Scores = 12;
+ * @return {!Array<!proto.score.Score>}
+ */
+proto.ag.Submission.prototype.getScoresList = function() {
+  return /** @type{!Array<!proto.score.Score>} */ (
+    jspb.Message.getRepeatedWrapperField(this, kit_score_score_pb.Score, 12));
 };
 
 
 /**
- * @param {!Array<!proto.ag.User>} value
- * @return {!proto.ag.Group} returns this
+ * @param {!Array<!proto.score.Score>} value
+ * @return {!proto.ag.Submission} returns this
 */
-proto.ag.Group.prototype.setUsersList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 6, value);
+proto.ag.Submission.prototype.setScoresList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 12, value);
 };
 
 
 /**
- * @param {!proto.ag.User=} opt_value
+ * @param {!proto.score.Score=} opt_value
  * @param {number=} opt_index
- * @return {!proto.ag.User}
+ * @return {!proto.score.Score}
  */
-proto.ag.Group.prototype.addUsers = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 6, opt_value, proto.ag.User, opt_index);
+proto.ag.Submission.prototype.addScores = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 12, opt_value, proto.score.Score, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.ag.Group} returns this
+ * @return {!proto.ag.Submission} returns this
  */
-proto.ag.Group.prototype.clearUsersList = function() {
-  return this.setUsersList([]);
+proto.ag.Submission.prototype.clearScoresList = function() {
+  return this.setScoresList([]);
 };
 
 
 /**
- * repeated Enrollment enrollments = 7;
- * @return {!Array<!proto.ag.Enrollment>}
+ * optional uint32 attempts = 13;
+ * @return {number}
  */
-proto.ag.Group.prototype.getEnrollmentsList = function() {
-  return /** @type{!Array<!proto.ag.Enrollment>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.Enrollment, 7));
+proto.ag.Submission.prototype.getAttempts = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 13, 0));
 };
 
 
 /**
- * @param {!Array<!proto.ag.Enrollment>} value
- * @return {!proto.ag.Group} returns this
-*/
-proto.ag.Group.prototype.setEnrollmentsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 7, value);
+ * @param {number} value
+ * @return {!proto.ag.Submission} returns this
+ */
+proto.ag.Submission.prototype.setAttempts = function(value) {
+  return jspb.Message.setProto3IntField(this, 13, value);
 };
 
 
 /**
- * @param {!proto.ag.Enrollment=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ag.Enrollment}
+ * optional uint32 passStreak = 14;
+ * @return {number}
  */
-proto.ag.Group.prototype.addEnrollments = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 7, opt_value, proto.ag.Enrollment, opt_index);
+proto.ag.Submission.prototype.getPassstreak = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 14, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ag.Group} returns this
+ * @param {number} value
+ * @return {!proto.ag.Submission} returns this
  */
-proto.ag.Group.prototype.clearEnrollmentsList = function() {
-  return this.setEnrollmentsList([]);
+proto.ag.Submission.prototype.setPassstreak = function(value) {
+  return jspb.Message.setProto3IntField(this, 14, value);
 };
 
 
-
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * optional uint32 reruns = 15;
+ * @return {number}
  */
-proto.ag.Groups.repeatedFields_ = [1];
-
+proto.ag.Submission.prototype.getReruns = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 15, 0));
+};
 
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * @param {number} value
+ * @return {!proto.ag.Submission} returns this
  */
-proto.ag.Groups.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Groups.toObject(opt_includeInstance, this);
+proto.ag.Submission.prototype.setReruns = function(value) {
+  return jspb.Message.setProto3IntField(this, 15, value);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.ag.Groups} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional double coveragePercent = 16;
+ * @return {number}
  */
-proto.ag.Groups.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    groupsList: jspb.Message.toObjectList(msg.getGroupsList(),
-    proto.ag.Group.toObject, includeInstance)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.ag.Submission.prototype.getCoveragepercent = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 16, 0.0));
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Groups}
+ * @param {number} value
+ * @return {!proto.ag.Submission} returns this
  */
-proto.ag.Groups.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Groups;
-  return proto.ag.Groups.deserializeBinaryFromReader(msg, reader);
+proto.ag.Submission.prototype.setCoveragepercent = function(value) {
+  return jspb.Message.setProto3FloatField(this, 16, value);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.ag.Groups} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Groups}
+ * repeated Annotation annotations = 17;
+ * @return {!Array<!proto.ag.Annotation>}
  */
-proto.ag.Groups.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = new proto.ag.Group;
-      reader.readMessage(value,proto.ag.Group.deserializeBinaryFromReader);
-      msg.addGroups(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.ag.Submission.prototype.getAnnotationsList = function() {
+  return /** @type{!Array<!proto.ag.Annotation>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.Annotation, 17));
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * @param {!Array<!proto.ag.Annotation>} value
+ * @return {!proto.ag.Submission} returns this
+*/
+proto.ag.Submission.prototype.setAnnotationsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 17, value);
+};
+
+
+/**
+ * @param {!proto.ag.Annotation=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.Annotation}
  */
-proto.ag.Groups.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.ag.Groups.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.ag.Submission.prototype.addAnnotations = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 17, opt_value, proto.ag.Annotation, opt_index);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Groups} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.Submission} returns this
  */
-proto.ag.Groups.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getGroupsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      1,
-      f,
-      proto.ag.Group.serializeBinaryToWriter
-    );
-  }
+proto.ag.Submission.prototype.clearAnnotationsList = function() {
+  return this.setAnnotationsList([]);
 };
 
 
 /**
- * repeated Group groups = 1;
- * @return {!Array<!proto.ag.Group>}
+ * repeated MatrixResult matrixResults = 18;
+ * @return {!Array<!proto.ag.MatrixResult>}
  */
-proto.ag.Groups.prototype.getGroupsList = function() {
-  return /** @type{!Array<!proto.ag.Group>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.Group, 1));
+proto.ag.Submission.prototype.getMatrixresultsList = function() {
+  return /** @type{!Array<!proto.ag.MatrixResult>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.MatrixResult, 18));
 };
 
 
 /**
- * @param {!Array<!proto.ag.Group>} value
- * @return {!proto.ag.Groups} returns this
+ * @param {!Array<!proto.ag.MatrixResult>} value
+ * @return {!proto.ag.Submission} returns this
 */
-proto.ag.Groups.prototype.setGroupsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+proto.ag.Submission.prototype.setMatrixresultsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 18, value);
 };
 
 
 /**
- * @param {!proto.ag.Group=} opt_value
+ * @param {!proto.ag.MatrixResult=} opt_value
  * @param {number=} opt_index
- * @return {!proto.ag.Group}
+ * @return {!proto.ag.MatrixResult}
  */
-proto.ag.Groups.prototype.addGroups = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.Group, opt_index);
+proto.ag.Submission.prototype.addMatrixresults = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 18, opt_value, proto.ag.MatrixResult, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.ag.Groups} returns this
+ * @return {!proto.ag.Submission} returns this
  */
-proto.ag.Groups.prototype.clearGroupsList = function() {
-  return this.setGroupsList([]);
+proto.ag.Submission.prototype.clearMatrixresultsList = function() {
+  return this.setMatrixresultsList([]);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.ag.Course.repeatedFields_ = [13,14,15];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -2495,8 +10116,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Course.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Course.toObject(opt_includeInstance, this);
+proto.ag.MatrixResult.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.MatrixResult.toObject(opt_includeInstance, this);
 };
 
 
@@ -2505,30 +10126,20 @@ proto.ag.Course.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Course} msg The msg instance to transform.
+ * @param {!proto.ag.MatrixResult} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Course.toObject = function(includeInstance, msg) {
+proto.ag.MatrixResult.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    coursecreatorid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    submissionid: jspb.Message.getFieldWithDefault(msg, 2, 0),
     name: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    code: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    year: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    tag: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    provider: jspb.Message.getFieldWithDefault(msg, 7, ""),
-    organizationid: jspb.Message.getFieldWithDefault(msg, 8, 0),
-    organizationpath: jspb.Message.getFieldWithDefault(msg, 9, ""),
-    slipdays: jspb.Message.getFieldWithDefault(msg, 10, 0),
-    dockerfile: jspb.Message.getFieldWithDefault(msg, 11, ""),
-    enrolled: jspb.Message.getFieldWithDefault(msg, 12, 0),
-    enrollmentsList: jspb.Message.toObjectList(msg.getEnrollmentsList(),
-    proto.ag.Enrollment.toObject, includeInstance),
-    assignmentsList: jspb.Message.toObjectList(msg.getAssignmentsList(),
-    proto.ag.Assignment.toObject, includeInstance),
-    groupsList: jspb.Message.toObjectList(msg.getGroupsList(),
-    proto.ag.Group.toObject, includeInstance)
+    image: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    score: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    buildlog: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    exectime: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    timedout: jspb.Message.getBooleanFieldWithDefault(msg, 8, false)
   };
 
   if (includeInstance) {
@@ -2542,23 +10153,23 @@ proto.ag.Course.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Course}
+ * @return {!proto.ag.MatrixResult}
  */
-proto.ag.Course.deserializeBinary = function(bytes) {
+proto.ag.MatrixResult.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Course;
-  return proto.ag.Course.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.MatrixResult;
+  return proto.ag.MatrixResult.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Course} msg The message object to deserialize into.
+ * @param {!proto.ag.MatrixResult} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Course}
+ * @return {!proto.ag.MatrixResult}
  */
-proto.ag.Course.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.MatrixResult.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2571,7 +10182,7 @@ proto.ag.Course.deserializeBinaryFromReader = function(msg, reader) {
       break;
     case 2:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setCoursecreatorid(value);
+      msg.setSubmissionid(value);
       break;
     case 3:
       var value = /** @type {string} */ (reader.readString());
@@ -2579,54 +10190,23 @@ proto.ag.Course.deserializeBinaryFromReader = function(msg, reader) {
       break;
     case 4:
       var value = /** @type {string} */ (reader.readString());
-      msg.setCode(value);
+      msg.setImage(value);
       break;
     case 5:
       var value = /** @type {number} */ (reader.readUint32());
-      msg.setYear(value);
+      msg.setScore(value);
       break;
     case 6:
       var value = /** @type {string} */ (reader.readString());
-      msg.setTag(value);
+      msg.setBuildlog(value);
       break;
     case 7:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setProvider(value);
-      break;
-    case 8:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setOrganizationid(value);
-      break;
-    case 9:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setOrganizationpath(value);
-      break;
-    case 10:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setSlipdays(value);
-      break;
-    case 11:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setDockerfile(value);
-      break;
-    case 12:
-      var value = /** @type {!proto.ag.Enrollment.UserStatus} */ (reader.readEnum());
-      msg.setEnrolled(value);
-      break;
-    case 13:
-      var value = new proto.ag.Enrollment;
-      reader.readMessage(value,proto.ag.Enrollment.deserializeBinaryFromReader);
-      msg.addEnrollments(value);
-      break;
-    case 14:
-      var value = new proto.ag.Assignment;
-      reader.readMessage(value,proto.ag.Assignment.deserializeBinaryFromReader);
-      msg.addAssignments(value);
-      break;
-    case 15:
-      var value = new proto.ag.Group;
-      reader.readMessage(value,proto.ag.Group.deserializeBinaryFromReader);
-      msg.addGroups(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setExectime(value);
+      break;
+    case 8:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setTimedout(value);
       break;
     default:
       reader.skipField();
@@ -2641,9 +10221,9 @@ proto.ag.Course.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.Course.prototype.serializeBinary = function() {
+proto.ag.MatrixResult.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.Course.serializeBinaryToWriter(this, writer);
+  proto.ag.MatrixResult.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2651,11 +10231,11 @@ proto.ag.Course.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Course} message
+ * @param {!proto.ag.MatrixResult} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Course.serializeBinaryToWriter = function(message, writer) {
+proto.ag.MatrixResult.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f !== 0) {
@@ -2664,7 +10244,7 @@ proto.ag.Course.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
-  f = message.getCoursecreatorid();
+  f = message.getSubmissionid();
   if (f !== 0) {
     writer.writeUint64(
       2,
@@ -2678,93 +10258,41 @@ proto.ag.Course.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
-  f = message.getCode();
+  f = message.getImage();
   if (f.length > 0) {
     writer.writeString(
       4,
       f
     );
   }
-  f = message.getYear();
+  f = message.getScore();
   if (f !== 0) {
     writer.writeUint32(
       5,
       f
     );
   }
-  f = message.getTag();
+  f = message.getBuildlog();
   if (f.length > 0) {
     writer.writeString(
       6,
       f
     );
   }
-  f = message.getProvider();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getExectime();
+  if (f !== 0) {
+    writer.writeInt64(
       7,
       f
     );
   }
-  f = message.getOrganizationid();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getTimedout();
+  if (f) {
+    writer.writeBool(
       8,
       f
     );
   }
-  f = message.getOrganizationpath();
-  if (f.length > 0) {
-    writer.writeString(
-      9,
-      f
-    );
-  }
-  f = message.getSlipdays();
-  if (f !== 0) {
-    writer.writeUint32(
-      10,
-      f
-    );
-  }
-  f = message.getDockerfile();
-  if (f.length > 0) {
-    writer.writeString(
-      11,
-      f
-    );
-  }
-  f = message.getEnrolled();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      12,
-      f
-    );
-  }
-  f = message.getEnrollmentsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      13,
-      f,
-      proto.ag.Enrollment.serializeBinaryToWriter
-    );
-  }
-  f = message.getAssignmentsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      14,
-      f,
-      proto.ag.Assignment.serializeBinaryToWriter
-    );
-  }
-  f = message.getGroupsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      15,
-      f,
-      proto.ag.Group.serializeBinaryToWriter
-    );
-  }
 };
 
 
@@ -2772,34 +10300,34 @@ proto.ag.Course.serializeBinaryToWriter = function(message, writer) {
  * optional uint64 ID = 1;
  * @return {number}
  */
-proto.ag.Course.prototype.getId = function() {
+proto.ag.MatrixResult.prototype.getId = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Course} returns this
+ * @return {!proto.ag.MatrixResult} returns this
  */
-proto.ag.Course.prototype.setId = function(value) {
+proto.ag.MatrixResult.prototype.setId = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 courseCreatorID = 2;
+ * optional uint64 SubmissionID = 2;
  * @return {number}
  */
-proto.ag.Course.prototype.getCoursecreatorid = function() {
+proto.ag.MatrixResult.prototype.getSubmissionid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Course} returns this
+ * @return {!proto.ag.MatrixResult} returns this
  */
-proto.ag.Course.prototype.setCoursecreatorid = function(value) {
+proto.ag.MatrixResult.prototype.setSubmissionid = function(value) {
   return jspb.Message.setProto3IntField(this, 2, value);
 };
 
@@ -2808,293 +10336,387 @@ proto.ag.Course.prototype.setCoursecreatorid = function(value) {
  * optional string name = 3;
  * @return {string}
  */
-proto.ag.Course.prototype.getName = function() {
+proto.ag.MatrixResult.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.ag.Course} returns this
+ * @return {!proto.ag.MatrixResult} returns this
  */
-proto.ag.Course.prototype.setName = function(value) {
+proto.ag.MatrixResult.prototype.setName = function(value) {
   return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * optional string code = 4;
+ * optional string image = 4;
  * @return {string}
  */
-proto.ag.Course.prototype.getCode = function() {
+proto.ag.MatrixResult.prototype.getImage = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.ag.Course} returns this
+ * @return {!proto.ag.MatrixResult} returns this
  */
-proto.ag.Course.prototype.setCode = function(value) {
+proto.ag.MatrixResult.prototype.setImage = function(value) {
   return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * optional uint32 year = 5;
+ * optional uint32 score = 5;
  * @return {number}
  */
-proto.ag.Course.prototype.getYear = function() {
+proto.ag.MatrixResult.prototype.getScore = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Course} returns this
+ * @return {!proto.ag.MatrixResult} returns this
  */
-proto.ag.Course.prototype.setYear = function(value) {
+proto.ag.MatrixResult.prototype.setScore = function(value) {
   return jspb.Message.setProto3IntField(this, 5, value);
 };
 
 
 /**
- * optional string tag = 6;
+ * optional string buildLog = 6;
  * @return {string}
  */
-proto.ag.Course.prototype.getTag = function() {
+proto.ag.MatrixResult.prototype.getBuildlog = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.ag.Course} returns this
+ * @return {!proto.ag.MatrixResult} returns this
  */
-proto.ag.Course.prototype.setTag = function(value) {
+proto.ag.MatrixResult.prototype.setBuildlog = function(value) {
   return jspb.Message.setProto3StringField(this, 6, value);
 };
 
 
 /**
- * optional string provider = 7;
- * @return {string}
+ * optional int64 execTime = 7;
+ * @return {number}
  */
-proto.ag.Course.prototype.getProvider = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+proto.ag.MatrixResult.prototype.getExectime = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ag.Course} returns this
+ * @param {number} value
+ * @return {!proto.ag.MatrixResult} returns this
  */
-proto.ag.Course.prototype.setProvider = function(value) {
-  return jspb.Message.setProto3StringField(this, 7, value);
+proto.ag.MatrixResult.prototype.setExectime = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
 };
 
 
 /**
- * optional uint64 organizationID = 8;
- * @return {number}
+ * optional bool timedOut = 8;
+ * @return {boolean}
  */
-proto.ag.Course.prototype.getOrganizationid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+proto.ag.MatrixResult.prototype.getTimedout = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 8, false));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.Course} returns this
+ * @param {boolean} value
+ * @return {!proto.ag.MatrixResult} returns this
  */
-proto.ag.Course.prototype.setOrganizationid = function(value) {
-  return jspb.Message.setProto3IntField(this, 8, value);
+proto.ag.MatrixResult.prototype.setTimedout = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 8, value);
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional string organizationPath = 9;
- * @return {string}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.ag.Course.prototype.getOrganizationpath = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+proto.ag.Annotation.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Annotation.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ag.Course} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.Annotation} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Course.prototype.setOrganizationpath = function(value) {
-  return jspb.Message.setProto3StringField(this, 9, value);
+proto.ag.Annotation.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    submissionid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    checkname: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    file: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    line: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    message: jspb.Message.getFieldWithDefault(msg, 6, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional uint32 slipDays = 10;
- * @return {number}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.Annotation}
  */
-proto.ag.Course.prototype.getSlipdays = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+proto.ag.Annotation.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.Annotation;
+  return proto.ag.Annotation.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.Course} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.Annotation} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.Annotation}
  */
-proto.ag.Course.prototype.setSlipdays = function(value) {
-  return jspb.Message.setProto3IntField(this, 10, value);
+proto.ag.Annotation.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setSubmissionid(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCheckname(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFile(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setLine(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMessage(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional string dockerfile = 11;
- * @return {string}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.ag.Course.prototype.getDockerfile = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 11, ""));
+proto.ag.Annotation.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.Annotation.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.Annotation} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Annotation.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getSubmissionid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getCheckname();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getFile();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getLine();
+  if (f !== 0) {
+    writer.writeUint32(
+      5,
+      f
+    );
+  }
+  f = message.getMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ag.Course} returns this
+ * optional uint64 ID = 1;
+ * @return {number}
  */
-proto.ag.Course.prototype.setDockerfile = function(value) {
-  return jspb.Message.setProto3StringField(this, 11, value);
+proto.ag.Annotation.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * optional Enrollment.UserStatus enrolled = 12;
- * @return {!proto.ag.Enrollment.UserStatus}
+ * @param {number} value
+ * @return {!proto.ag.Annotation} returns this
  */
-proto.ag.Course.prototype.getEnrolled = function() {
-  return /** @type {!proto.ag.Enrollment.UserStatus} */ (jspb.Message.getFieldWithDefault(this, 12, 0));
+proto.ag.Annotation.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.ag.Enrollment.UserStatus} value
- * @return {!proto.ag.Course} returns this
+ * optional uint64 SubmissionID = 2;
+ * @return {number}
  */
-proto.ag.Course.prototype.setEnrolled = function(value) {
-  return jspb.Message.setProto3EnumField(this, 12, value);
+proto.ag.Annotation.prototype.getSubmissionid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * repeated Enrollment enrollments = 13;
- * @return {!Array<!proto.ag.Enrollment>}
+ * @param {number} value
+ * @return {!proto.ag.Annotation} returns this
  */
-proto.ag.Course.prototype.getEnrollmentsList = function() {
-  return /** @type{!Array<!proto.ag.Enrollment>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.Enrollment, 13));
-};
-
-
-/**
- * @param {!Array<!proto.ag.Enrollment>} value
- * @return {!proto.ag.Course} returns this
-*/
-proto.ag.Course.prototype.setEnrollmentsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 13, value);
+proto.ag.Annotation.prototype.setSubmissionid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * @param {!proto.ag.Enrollment=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ag.Enrollment}
+ * optional string checkName = 3;
+ * @return {string}
  */
-proto.ag.Course.prototype.addEnrollments = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 13, opt_value, proto.ag.Enrollment, opt_index);
+proto.ag.Annotation.prototype.getCheckname = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ag.Course} returns this
+ * @param {string} value
+ * @return {!proto.ag.Annotation} returns this
  */
-proto.ag.Course.prototype.clearEnrollmentsList = function() {
-  return this.setEnrollmentsList([]);
+proto.ag.Annotation.prototype.setCheckname = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * repeated Assignment assignments = 14;
- * @return {!Array<!proto.ag.Assignment>}
+ * optional string file = 4;
+ * @return {string}
  */
-proto.ag.Course.prototype.getAssignmentsList = function() {
-  return /** @type{!Array<!proto.ag.Assignment>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.Assignment, 14));
-};
-
-
-/**
- * @param {!Array<!proto.ag.Assignment>} value
- * @return {!proto.ag.Course} returns this
-*/
-proto.ag.Course.prototype.setAssignmentsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 14, value);
+proto.ag.Annotation.prototype.getFile = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
- * @param {!proto.ag.Assignment=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ag.Assignment}
+ * @param {string} value
+ * @return {!proto.ag.Annotation} returns this
  */
-proto.ag.Course.prototype.addAssignments = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 14, opt_value, proto.ag.Assignment, opt_index);
+proto.ag.Annotation.prototype.setFile = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ag.Course} returns this
+ * optional uint32 line = 5;
+ * @return {number}
  */
-proto.ag.Course.prototype.clearAssignmentsList = function() {
-  return this.setAssignmentsList([]);
+proto.ag.Annotation.prototype.getLine = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
- * repeated Group groups = 15;
- * @return {!Array<!proto.ag.Group>}
+ * @param {number} value
+ * @return {!proto.ag.Annotation} returns this
  */
-proto.ag.Course.prototype.getGroupsList = function() {
-  return /** @type{!Array<!proto.ag.Group>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.Group, 15));
-};
-
-
-/**
- * @param {!Array<!proto.ag.Group>} value
- * @return {!proto.ag.Course} returns this
-*/
-proto.ag.Course.prototype.setGroupsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 15, value);
+proto.ag.Annotation.prototype.setLine = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
 };
 
 
 /**
- * @param {!proto.ag.Group=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ag.Group}
+ * optional string message = 6;
+ * @return {string}
  */
-proto.ag.Course.prototype.addGroups = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 15, opt_value, proto.ag.Group, opt_index);
+proto.ag.Annotation.prototype.getMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ag.Course} returns this
+ * @param {string} value
+ * @return {!proto.ag.Annotation} returns this
  */
-proto.ag.Course.prototype.clearGroupsList = function() {
-  return this.setGroupsList([]);
+proto.ag.Annotation.prototype.setMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
 };
 
 
@@ -3104,7 +10726,7 @@ proto.ag.Course.prototype.clearGroupsList = function() {
  * @private {!Array<number>}
  * @const
  */
-proto.ag.Courses.repeatedFields_ = [1];
+proto.ag.Submissions.repeatedFields_ = [1];
 
 
 
@@ -3121,8 +10743,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Courses.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Courses.toObject(opt_includeInstance, this);
+proto.ag.Submissions.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Submissions.toObject(opt_includeInstance, this);
 };
 
 
@@ -3131,14 +10753,14 @@ proto.ag.Courses.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Courses} msg The msg instance to transform.
+ * @param {!proto.ag.Submissions} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Courses.toObject = function(includeInstance, msg) {
+proto.ag.Submissions.toObject = function(includeInstance, msg) {
   var f, obj = {
-    coursesList: jspb.Message.toObjectList(msg.getCoursesList(),
-    proto.ag.Course.toObject, includeInstance)
+    submissionsList: jspb.Message.toObjectList(msg.getSubmissionsList(),
+    proto.ag.Submission.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -3152,23 +10774,23 @@ proto.ag.Courses.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Courses}
+ * @return {!proto.ag.Submissions}
  */
-proto.ag.Courses.deserializeBinary = function(bytes) {
+proto.ag.Submissions.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Courses;
-  return proto.ag.Courses.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.Submissions;
+  return proto.ag.Submissions.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Courses} msg The message object to deserialize into.
+ * @param {!proto.ag.Submissions} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Courses}
+ * @return {!proto.ag.Submissions}
  */
-proto.ag.Courses.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.Submissions.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3176,9 +10798,9 @@ proto.ag.Courses.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.ag.Course;
-      reader.readMessage(value,proto.ag.Course.deserializeBinaryFromReader);
-      msg.addCourses(value);
+      var value = new proto.ag.Submission;
+      reader.readMessage(value,proto.ag.Submission.deserializeBinaryFromReader);
+      msg.addSubmissions(value);
       break;
     default:
       reader.skipField();
@@ -3193,9 +10815,9 @@ proto.ag.Courses.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.Courses.prototype.serializeBinary = function() {
+proto.ag.Submissions.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.Courses.serializeBinaryToWriter(this, writer);
+  proto.ag.Submissions.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3203,58 +10825,58 @@ proto.ag.Courses.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Courses} message
+ * @param {!proto.ag.Submissions} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Courses.serializeBinaryToWriter = function(message, writer) {
+proto.ag.Submissions.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCoursesList();
+  f = message.getSubmissionsList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
       1,
       f,
-      proto.ag.Course.serializeBinaryToWriter
+      proto.ag.Submission.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * repeated Course courses = 1;
- * @return {!Array<!proto.ag.Course>}
+ * repeated Submission submissions = 1;
+ * @return {!Array<!proto.ag.Submission>}
  */
-proto.ag.Courses.prototype.getCoursesList = function() {
-  return /** @type{!Array<!proto.ag.Course>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.Course, 1));
+proto.ag.Submissions.prototype.getSubmissionsList = function() {
+  return /** @type{!Array<!proto.ag.Submission>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.Submission, 1));
 };
 
 
 /**
- * @param {!Array<!proto.ag.Course>} value
- * @return {!proto.ag.Courses} returns this
+ * @param {!Array<!proto.ag.Submission>} value
+ * @return {!proto.ag.Submissions} returns this
 */
-proto.ag.Courses.prototype.setCoursesList = function(value) {
+proto.ag.Submissions.prototype.setSubmissionsList = function(value) {
   return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.ag.Course=} opt_value
+ * @param {!proto.ag.Submission=} opt_value
  * @param {number=} opt_index
- * @return {!proto.ag.Course}
+ * @return {!proto.ag.Submission}
  */
-proto.ag.Courses.prototype.addCourses = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.Course, opt_index);
+proto.ag.Submissions.prototype.addSubmissions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.Submission, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.ag.Courses} returns this
+ * @return {!proto.ag.Submissions} returns this
  */
-proto.ag.Courses.prototype.clearCoursesList = function() {
-  return this.setCoursesList([]);
+proto.ag.Submissions.prototype.clearSubmissionsList = function() {
+  return this.setSubmissionsList([]);
 };
 
 
@@ -3274,8 +10896,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Repository.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Repository.toObject(opt_includeInstance, this);
+proto.ag.Artifact.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Artifact.toObject(opt_includeInstance, this);
 };
 
 
@@ -3284,19 +10906,17 @@ proto.ag.Repository.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Repository} msg The msg instance to transform.
+ * @param {!proto.ag.Artifact} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Repository.toObject = function(includeInstance, msg) {
+proto.ag.Artifact.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    organizationid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    repositoryid: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    userid: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    groupid: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    htmlurl: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    repotype: jspb.Message.getFieldWithDefault(msg, 7, 0)
+    submissionid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    name: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    size: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    content: msg.getContent_asB64()
   };
 
   if (includeInstance) {
@@ -3310,23 +10930,23 @@ proto.ag.Repository.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Repository}
+ * @return {!proto.ag.Artifact}
  */
-proto.ag.Repository.deserializeBinary = function(bytes) {
+proto.ag.Artifact.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Repository;
-  return proto.ag.Repository.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.Artifact;
+  return proto.ag.Artifact.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Repository} msg The message object to deserialize into.
+ * @param {!proto.ag.Artifact} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Repository}
+ * @return {!proto.ag.Artifact}
  */
-proto.ag.Repository.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.Artifact.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3339,27 +10959,19 @@ proto.ag.Repository.deserializeBinaryFromReader = function(msg, reader) {
       break;
     case 2:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setOrganizationid(value);
+      msg.setSubmissionid(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setRepositoryid(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
       break;
     case 4:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setUserid(value);
+      msg.setSize(value);
       break;
     case 5:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setGroupid(value);
-      break;
-    case 6:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setHtmlurl(value);
-      break;
-    case 7:
-      var value = /** @type {!proto.ag.Repository.Type} */ (reader.readEnum());
-      msg.setRepotype(value);
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setContent(value);
       break;
     default:
       reader.skipField();
@@ -3374,9 +10986,9 @@ proto.ag.Repository.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.Repository.prototype.serializeBinary = function() {
+proto.ag.Artifact.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.Repository.serializeBinaryToWriter(this, writer);
+  proto.ag.Artifact.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3384,11 +10996,11 @@ proto.ag.Repository.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Repository} message
+ * @param {!proto.ag.Artifact} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Repository.serializeBinaryToWriter = function(message, writer) {
+proto.ag.Artifact.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f !== 0) {
@@ -3397,196 +11009,311 @@ proto.ag.Repository.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
-  f = message.getOrganizationid();
+  f = message.getSubmissionid();
   if (f !== 0) {
     writer.writeUint64(
       2,
       f
     );
   }
-  f = message.getRepositoryid();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
       3,
       f
     );
-  }
-  f = message.getUserid();
-  if (f !== 0) {
-    writer.writeUint64(
-      4,
-      f
-    );
-  }
-  f = message.getGroupid();
-  if (f !== 0) {
-    writer.writeUint64(
-      5,
-      f
-    );
-  }
-  f = message.getHtmlurl();
-  if (f.length > 0) {
-    writer.writeString(
-      6,
+  }
+  f = message.getSize();
+  if (f !== 0) {
+    writer.writeUint64(
+      4,
       f
     );
   }
-  f = message.getRepotype();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      7,
+  f = message.getContent_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      5,
       f
     );
   }
 };
 
 
-/**
- * @enum {number}
- */
-proto.ag.Repository.Type = {
-  NONE: 0,
-  COURSEINFO: 1,
-  ASSIGNMENTS: 2,
-  TESTS: 3,
-  USER: 4,
-  GROUP: 5
-};
-
 /**
  * optional uint64 ID = 1;
  * @return {number}
  */
-proto.ag.Repository.prototype.getId = function() {
+proto.ag.Artifact.prototype.getId = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Repository} returns this
+ * @return {!proto.ag.Artifact} returns this
  */
-proto.ag.Repository.prototype.setId = function(value) {
+proto.ag.Artifact.prototype.setId = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 organizationID = 2;
+ * optional uint64 SubmissionID = 2;
  * @return {number}
  */
-proto.ag.Repository.prototype.getOrganizationid = function() {
+proto.ag.Artifact.prototype.getSubmissionid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Repository} returns this
+ * @return {!proto.ag.Artifact} returns this
  */
-proto.ag.Repository.prototype.setOrganizationid = function(value) {
+proto.ag.Artifact.prototype.setSubmissionid = function(value) {
   return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional uint64 repositoryID = 3;
- * @return {number}
+ * optional string name = 3;
+ * @return {string}
  */
-proto.ag.Repository.prototype.getRepositoryid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.ag.Artifact.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.Repository} returns this
+ * @param {string} value
+ * @return {!proto.ag.Artifact} returns this
  */
-proto.ag.Repository.prototype.setRepositoryid = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.ag.Artifact.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * optional uint64 userID = 4;
+ * optional uint64 size = 4;
  * @return {number}
  */
-proto.ag.Repository.prototype.getUserid = function() {
+proto.ag.Artifact.prototype.getSize = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Repository} returns this
+ * @return {!proto.ag.Artifact} returns this
  */
-proto.ag.Repository.prototype.setUserid = function(value) {
+proto.ag.Artifact.prototype.setSize = function(value) {
   return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 /**
- * optional uint64 groupID = 5;
- * @return {number}
+ * optional bytes content = 5;
+ * @return {!(string|Uint8Array)}
  */
-proto.ag.Repository.prototype.getGroupid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.ag.Artifact.prototype.getContent = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.Repository} returns this
+ * optional bytes content = 5;
+ * This is a type-conversion wrapper around `getContent()`
+ * @return {string}
  */
-proto.ag.Repository.prototype.setGroupid = function(value) {
-  return jspb.Message.setProto3IntField(this, 5, value);
+proto.ag.Artifact.prototype.getContent_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getContent()));
 };
 
 
 /**
- * optional string HTMLURL = 6;
- * @return {string}
+ * optional bytes content = 5;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getContent()`
+ * @return {!Uint8Array}
  */
-proto.ag.Repository.prototype.getHtmlurl = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+proto.ag.Artifact.prototype.getContent_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getContent()));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ag.Repository} returns this
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.ag.Artifact} returns this
  */
-proto.ag.Repository.prototype.setHtmlurl = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
+proto.ag.Artifact.prototype.setContent = function(value) {
+  return jspb.Message.setProto3BytesField(this, 5, value);
 };
 
 
+
 /**
- * optional Type repoType = 7;
- * @return {!proto.ag.Repository.Type}
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.ag.Repository.prototype.getRepotype = function() {
-  return /** @type {!proto.ag.Repository.Type} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+proto.ag.Artifacts.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.Artifacts.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Artifacts.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {!proto.ag.Repository.Type} value
- * @return {!proto.ag.Repository} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.Artifacts} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Repository.prototype.setRepotype = function(value) {
-  return jspb.Message.setProto3EnumField(this, 7, value);
+proto.ag.Artifacts.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    artifactsList: jspb.Message.toObjectList(msg.getArtifactsList(),
+    proto.ag.Artifact.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
+
 
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.Artifacts}
+ */
+proto.ag.Artifacts.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.Artifacts;
+  return proto.ag.Artifacts.deserializeBinaryFromReader(msg, reader);
+};
 
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.Artifacts} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.Artifacts}
  */
-proto.ag.Enrollment.repeatedFields_ = [14];
+proto.ag.Artifacts.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.ag.Artifact;
+      reader.readMessage(value,proto.ag.Artifact.deserializeBinaryFromReader);
+      msg.addArtifacts(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.Artifacts.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.Artifacts.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.Artifacts} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Artifacts.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getArtifactsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.ag.Artifact.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated Artifact artifacts = 1;
+ * @return {!Array<!proto.ag.Artifact>}
+ */
+proto.ag.Artifacts.prototype.getArtifactsList = function() {
+  return /** @type{!Array<!proto.ag.Artifact>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.Artifact, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.Artifact>} value
+ * @return {!proto.ag.Artifacts} returns this
+*/
+proto.ag.Artifacts.prototype.setArtifactsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.ag.Artifact=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.Artifact}
+ */
+proto.ag.Artifacts.prototype.addArtifacts = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.Artifact, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.Artifacts} returns this
+ */
+proto.ag.Artifacts.prototype.clearArtifactsList = function() {
+  return this.setArtifactsList([]);
+};
+
+
 
 
 
@@ -3603,8 +11330,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Enrollment.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Enrollment.toObject(opt_includeInstance, this);
+proto.ag.DeadlineExtension.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.DeadlineExtension.toObject(opt_includeInstance, this);
 };
 
 
@@ -3613,27 +11340,17 @@ proto.ag.Enrollment.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Enrollment} msg The msg instance to transform.
+ * @param {!proto.ag.DeadlineExtension} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Enrollment.toObject = function(includeInstance, msg) {
+proto.ag.DeadlineExtension.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    courseid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
     userid: jspb.Message.getFieldWithDefault(msg, 3, 0),
     groupid: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    hasteacherscopes: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
-    user: (f = msg.getUser()) && proto.ag.User.toObject(includeInstance, f),
-    course: (f = msg.getCourse()) && proto.ag.Course.toObject(includeInstance, f),
-    group: (f = msg.getGroup()) && proto.ag.Group.toObject(includeInstance, f),
-    status: jspb.Message.getFieldWithDefault(msg, 9, 0),
-    state: jspb.Message.getFieldWithDefault(msg, 10, 0),
-    slipdaysremaining: jspb.Message.getFieldWithDefault(msg, 11, 0),
-    lastactivitydate: jspb.Message.getFieldWithDefault(msg, 12, ""),
-    totalapproved: jspb.Message.getFieldWithDefault(msg, 13, 0),
-    usedslipdaysList: jspb.Message.toObjectList(msg.getUsedslipdaysList(),
-    proto.ag.UsedSlipDays.toObject, includeInstance)
+    deadline: jspb.Message.getFieldWithDefault(msg, 5, "")
   };
 
   if (includeInstance) {
@@ -3647,88 +11364,48 @@ proto.ag.Enrollment.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Enrollment}
+ * @return {!proto.ag.DeadlineExtension}
  */
-proto.ag.Enrollment.deserializeBinary = function(bytes) {
+proto.ag.DeadlineExtension.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Enrollment;
-  return proto.ag.Enrollment.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.DeadlineExtension;
+  return proto.ag.DeadlineExtension.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Enrollment} msg The message object to deserialize into.
+ * @param {!proto.ag.DeadlineExtension} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Enrollment}
+ * @return {!proto.ag.DeadlineExtension}
  */
-proto.ag.Enrollment.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.DeadlineExtension.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setId(value);
-      break;
-    case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setUserid(value);
-      break;
-    case 4:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setGroupid(value);
-      break;
-    case 5:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setHasteacherscopes(value);
-      break;
-    case 6:
-      var value = new proto.ag.User;
-      reader.readMessage(value,proto.ag.User.deserializeBinaryFromReader);
-      msg.setUser(value);
-      break;
-    case 7:
-      var value = new proto.ag.Course;
-      reader.readMessage(value,proto.ag.Course.deserializeBinaryFromReader);
-      msg.setCourse(value);
-      break;
-    case 8:
-      var value = new proto.ag.Group;
-      reader.readMessage(value,proto.ag.Group.deserializeBinaryFromReader);
-      msg.setGroup(value);
-      break;
-    case 9:
-      var value = /** @type {!proto.ag.Enrollment.UserStatus} */ (reader.readEnum());
-      msg.setStatus(value);
-      break;
-    case 10:
-      var value = /** @type {!proto.ag.Enrollment.DisplayState} */ (reader.readEnum());
-      msg.setState(value);
-      break;
-    case 11:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setSlipdaysremaining(value);
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
       break;
-    case 12:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setLastactivitydate(value);
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
       break;
-    case 13:
+    case 3:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setTotalapproved(value);
+      msg.setUserid(value);
       break;
-    case 14:
-      var value = new proto.ag.UsedSlipDays;
-      reader.readMessage(value,proto.ag.UsedSlipDays.deserializeBinaryFromReader);
-      msg.addUsedslipdays(value);
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setGroupid(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDeadline(value);
       break;
     default:
       reader.skipField();
@@ -3743,9 +11420,9 @@ proto.ag.Enrollment.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.Enrollment.prototype.serializeBinary = function() {
+proto.ag.DeadlineExtension.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.Enrollment.serializeBinaryToWriter(this, writer);
+  proto.ag.DeadlineExtension.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3753,11 +11430,11 @@ proto.ag.Enrollment.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Enrollment} message
+ * @param {!proto.ag.DeadlineExtension} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Enrollment.serializeBinaryToWriter = function(message, writer) {
+proto.ag.DeadlineExtension.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f !== 0) {
@@ -3766,7 +11443,7 @@ proto.ag.Enrollment.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
-  f = message.getCourseid();
+  f = message.getAssignmentid();
   if (f !== 0) {
     writer.writeUint64(
       2,
@@ -3787,135 +11464,48 @@ proto.ag.Enrollment.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
-  f = message.getHasteacherscopes();
-  if (f) {
-    writer.writeBool(
-      5,
-      f
-    );
-  }
-  f = message.getUser();
-  if (f != null) {
-    writer.writeMessage(
-      6,
-      f,
-      proto.ag.User.serializeBinaryToWriter
-    );
-  }
-  f = message.getCourse();
-  if (f != null) {
-    writer.writeMessage(
-      7,
-      f,
-      proto.ag.Course.serializeBinaryToWriter
-    );
-  }
-  f = message.getGroup();
-  if (f != null) {
-    writer.writeMessage(
-      8,
-      f,
-      proto.ag.Group.serializeBinaryToWriter
-    );
-  }
-  f = message.getStatus();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      9,
-      f
-    );
-  }
-  f = message.getState();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      10,
-      f
-    );
-  }
-  f = message.getSlipdaysremaining();
-  if (f !== 0) {
-    writer.writeUint32(
-      11,
-      f
-    );
-  }
-  f = message.getLastactivitydate();
+  f = message.getDeadline();
   if (f.length > 0) {
     writer.writeString(
-      12,
-      f
-    );
-  }
-  f = message.getTotalapproved();
-  if (f !== 0) {
-    writer.writeUint64(
-      13,
+      5,
       f
     );
   }
-  f = message.getUsedslipdaysList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      14,
-      f,
-      proto.ag.UsedSlipDays.serializeBinaryToWriter
-    );
-  }
-};
-
-
-/**
- * @enum {number}
- */
-proto.ag.Enrollment.UserStatus = {
-  NONE: 0,
-  PENDING: 1,
-  STUDENT: 2,
-  TEACHER: 3
 };
 
-/**
- * @enum {number}
- */
-proto.ag.Enrollment.DisplayState = {
-  UNSET: 0,
-  HIDDEN: 1,
-  VISIBLE: 2,
-  FAVORITE: 3
-};
 
 /**
  * optional uint64 ID = 1;
  * @return {number}
  */
-proto.ag.Enrollment.prototype.getId = function() {
+proto.ag.DeadlineExtension.prototype.getId = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Enrollment} returns this
+ * @return {!proto.ag.DeadlineExtension} returns this
  */
-proto.ag.Enrollment.prototype.setId = function(value) {
+proto.ag.DeadlineExtension.prototype.setId = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 courseID = 2;
+ * optional uint64 AssignmentID = 2;
  * @return {number}
  */
-proto.ag.Enrollment.prototype.getCourseid = function() {
+proto.ag.DeadlineExtension.prototype.getAssignmentid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Enrollment} returns this
+ * @return {!proto.ag.DeadlineExtension} returns this
  */
-proto.ag.Enrollment.prototype.setCourseid = function(value) {
+proto.ag.DeadlineExtension.prototype.setAssignmentid = function(value) {
   return jspb.Message.setProto3IntField(this, 2, value);
 };
 
@@ -3924,16 +11514,16 @@ proto.ag.Enrollment.prototype.setCourseid = function(value) {
  * optional uint64 userID = 3;
  * @return {number}
  */
-proto.ag.Enrollment.prototype.getUserid = function() {
+proto.ag.DeadlineExtension.prototype.getUserid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Enrollment} returns this
+ * @return {!proto.ag.DeadlineExtension} returns this
  */
-proto.ag.Enrollment.prototype.setUserid = function(value) {
+proto.ag.DeadlineExtension.prototype.setUserid = function(value) {
   return jspb.Message.setProto3IntField(this, 3, value);
 };
 
@@ -3942,274 +11532,255 @@ proto.ag.Enrollment.prototype.setUserid = function(value) {
  * optional uint64 groupID = 4;
  * @return {number}
  */
-proto.ag.Enrollment.prototype.getGroupid = function() {
+proto.ag.DeadlineExtension.prototype.getGroupid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Enrollment} returns this
+ * @return {!proto.ag.DeadlineExtension} returns this
  */
-proto.ag.Enrollment.prototype.setGroupid = function(value) {
+proto.ag.DeadlineExtension.prototype.setGroupid = function(value) {
   return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 /**
- * optional bool hasTeacherScopes = 5;
- * @return {boolean}
- */
-proto.ag.Enrollment.prototype.getHasteacherscopes = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.ag.Enrollment} returns this
- */
-proto.ag.Enrollment.prototype.setHasteacherscopes = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 5, value);
-};
-
-
-/**
- * optional User user = 6;
- * @return {?proto.ag.User}
- */
-proto.ag.Enrollment.prototype.getUser = function() {
-  return /** @type{?proto.ag.User} */ (
-    jspb.Message.getWrapperField(this, proto.ag.User, 6));
-};
-
-
-/**
- * @param {?proto.ag.User|undefined} value
- * @return {!proto.ag.Enrollment} returns this
-*/
-proto.ag.Enrollment.prototype.setUser = function(value) {
-  return jspb.Message.setWrapperField(this, 6, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.ag.Enrollment} returns this
- */
-proto.ag.Enrollment.prototype.clearUser = function() {
-  return this.setUser(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.ag.Enrollment.prototype.hasUser = function() {
-  return jspb.Message.getField(this, 6) != null;
-};
-
-
-/**
- * optional Course course = 7;
- * @return {?proto.ag.Course}
- */
-proto.ag.Enrollment.prototype.getCourse = function() {
-  return /** @type{?proto.ag.Course} */ (
-    jspb.Message.getWrapperField(this, proto.ag.Course, 7));
-};
-
-
-/**
- * @param {?proto.ag.Course|undefined} value
- * @return {!proto.ag.Enrollment} returns this
-*/
-proto.ag.Enrollment.prototype.setCourse = function(value) {
-  return jspb.Message.setWrapperField(this, 7, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.ag.Enrollment} returns this
- */
-proto.ag.Enrollment.prototype.clearCourse = function() {
-  return this.setCourse(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.ag.Enrollment.prototype.hasCourse = function() {
-  return jspb.Message.getField(this, 7) != null;
-};
-
-
-/**
- * optional Group group = 8;
- * @return {?proto.ag.Group}
+ * optional string deadline = 5;
+ * @return {string}
  */
-proto.ag.Enrollment.prototype.getGroup = function() {
-  return /** @type{?proto.ag.Group} */ (
-    jspb.Message.getWrapperField(this, proto.ag.Group, 8));
-};
-
-
-/**
- * @param {?proto.ag.Group|undefined} value
- * @return {!proto.ag.Enrollment} returns this
-*/
-proto.ag.Enrollment.prototype.setGroup = function(value) {
-  return jspb.Message.setWrapperField(this, 8, value);
+proto.ag.DeadlineExtension.prototype.getDeadline = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.ag.Enrollment} returns this
+ * @param {string} value
+ * @return {!proto.ag.DeadlineExtension} returns this
  */
-proto.ag.Enrollment.prototype.clearGroup = function() {
-  return this.setGroup(undefined);
+proto.ag.DeadlineExtension.prototype.setDeadline = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
 };
 
 
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.ag.Enrollment.prototype.hasGroup = function() {
-  return jspb.Message.getField(this, 8) != null;
-};
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional UserStatus status = 9;
- * @return {!proto.ag.Enrollment.UserStatus}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.ag.Enrollment.prototype.getStatus = function() {
-  return /** @type {!proto.ag.Enrollment.UserStatus} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+proto.ag.CourseSecret.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.CourseSecret.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {!proto.ag.Enrollment.UserStatus} value
- * @return {!proto.ag.Enrollment} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.CourseSecret} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Enrollment.prototype.setStatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 9, value);
+proto.ag.CourseSecret.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    courseid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    name: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    value: jspb.Message.getFieldWithDefault(msg, 4, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional DisplayState state = 10;
- * @return {!proto.ag.Enrollment.DisplayState}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.CourseSecret}
  */
-proto.ag.Enrollment.prototype.getState = function() {
-  return /** @type {!proto.ag.Enrollment.DisplayState} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+proto.ag.CourseSecret.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.CourseSecret;
+  return proto.ag.CourseSecret.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {!proto.ag.Enrollment.DisplayState} value
- * @return {!proto.ag.Enrollment} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.CourseSecret} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.CourseSecret}
  */
-proto.ag.Enrollment.prototype.setState = function(value) {
-  return jspb.Message.setProto3EnumField(this, 10, value);
+proto.ag.CourseSecret.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setValue(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional uint32 slipDaysRemaining = 11;
- * @return {number}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.ag.Enrollment.prototype.getSlipdaysremaining = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
+proto.ag.CourseSecret.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.CourseSecret.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.Enrollment} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.CourseSecret} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Enrollment.prototype.setSlipdaysremaining = function(value) {
-  return jspb.Message.setProto3IntField(this, 11, value);
+proto.ag.CourseSecret.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getValue();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string lastActivityDate = 12;
- * @return {string}
+ * optional uint64 ID = 1;
+ * @return {number}
  */
-proto.ag.Enrollment.prototype.getLastactivitydate = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 12, ""));
+proto.ag.CourseSecret.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ag.Enrollment} returns this
+ * @param {number} value
+ * @return {!proto.ag.CourseSecret} returns this
  */
-proto.ag.Enrollment.prototype.setLastactivitydate = function(value) {
-  return jspb.Message.setProto3StringField(this, 12, value);
+proto.ag.CourseSecret.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 totalApproved = 13;
+ * optional uint64 CourseID = 2;
  * @return {number}
  */
-proto.ag.Enrollment.prototype.getTotalapproved = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 13, 0));
+proto.ag.CourseSecret.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Enrollment} returns this
+ * @return {!proto.ag.CourseSecret} returns this
  */
-proto.ag.Enrollment.prototype.setTotalapproved = function(value) {
-  return jspb.Message.setProto3IntField(this, 13, value);
+proto.ag.CourseSecret.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * repeated UsedSlipDays usedSlipDays = 14;
- * @return {!Array<!proto.ag.UsedSlipDays>}
+ * optional string name = 3;
+ * @return {string}
  */
-proto.ag.Enrollment.prototype.getUsedslipdaysList = function() {
-  return /** @type{!Array<!proto.ag.UsedSlipDays>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.UsedSlipDays, 14));
+proto.ag.CourseSecret.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * @param {!Array<!proto.ag.UsedSlipDays>} value
- * @return {!proto.ag.Enrollment} returns this
-*/
-proto.ag.Enrollment.prototype.setUsedslipdaysList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 14, value);
+ * @param {string} value
+ * @return {!proto.ag.CourseSecret} returns this
+ */
+proto.ag.CourseSecret.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * @param {!proto.ag.UsedSlipDays=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ag.UsedSlipDays}
+ * optional string value = 4;
+ * @return {string}
  */
-proto.ag.Enrollment.prototype.addUsedslipdays = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 14, opt_value, proto.ag.UsedSlipDays, opt_index);
+proto.ag.CourseSecret.prototype.getValue = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ag.Enrollment} returns this
+ * @param {string} value
+ * @return {!proto.ag.CourseSecret} returns this
  */
-proto.ag.Enrollment.prototype.clearUsedslipdaysList = function() {
-  return this.setUsedslipdaysList([]);
+proto.ag.CourseSecret.prototype.setValue = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
@@ -4229,8 +11800,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.UsedSlipDays.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.UsedSlipDays.toObject(opt_includeInstance, this);
+proto.ag.CourseModule.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.CourseModule.toObject(opt_includeInstance, this);
 };
 
 
@@ -4239,16 +11810,17 @@ proto.ag.UsedSlipDays.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.UsedSlipDays} msg The msg instance to transform.
+ * @param {!proto.ag.CourseModule} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.UsedSlipDays.toObject = function(includeInstance, msg) {
+proto.ag.CourseModule.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    enrollmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    assignmentid: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    usedslipdays: jspb.Message.getFieldWithDefault(msg, 4, 0)
+    courseid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    name: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    weight: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    assignments: jspb.Message.getFieldWithDefault(msg, 5, "")
   };
 
   if (includeInstance) {
@@ -4262,23 +11834,23 @@ proto.ag.UsedSlipDays.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.UsedSlipDays}
+ * @return {!proto.ag.CourseModule}
  */
-proto.ag.UsedSlipDays.deserializeBinary = function(bytes) {
+proto.ag.CourseModule.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.UsedSlipDays;
-  return proto.ag.UsedSlipDays.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.CourseModule;
+  return proto.ag.CourseModule.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.UsedSlipDays} msg The message object to deserialize into.
+ * @param {!proto.ag.CourseModule} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.UsedSlipDays}
+ * @return {!proto.ag.CourseModule}
  */
-proto.ag.UsedSlipDays.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.CourseModule.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4291,15 +11863,19 @@ proto.ag.UsedSlipDays.deserializeBinaryFromReader = function(msg, reader) {
       break;
     case 2:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setEnrollmentid(value);
+      msg.setCourseid(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setAssignmentid(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
       break;
     case 4:
       var value = /** @type {number} */ (reader.readUint32());
-      msg.setUsedslipdays(value);
+      msg.setWeight(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAssignments(value);
       break;
     default:
       reader.skipField();
@@ -4314,9 +11890,9 @@ proto.ag.UsedSlipDays.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.UsedSlipDays.prototype.serializeBinary = function() {
+proto.ag.CourseModule.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.UsedSlipDays.serializeBinaryToWriter(this, writer);
+  proto.ag.CourseModule.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4324,11 +11900,11 @@ proto.ag.UsedSlipDays.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.UsedSlipDays} message
+ * @param {!proto.ag.CourseModule} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.UsedSlipDays.serializeBinaryToWriter = function(message, writer) {
+proto.ag.CourseModule.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f !== 0) {
@@ -4337,27 +11913,34 @@ proto.ag.UsedSlipDays.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
-  f = message.getEnrollmentid();
+  f = message.getCourseid();
   if (f !== 0) {
     writer.writeUint64(
       2,
       f
     );
   }
-  f = message.getAssignmentid();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
       3,
       f
     );
   }
-  f = message.getUsedslipdays();
+  f = message.getWeight();
   if (f !== 0) {
     writer.writeUint32(
       4,
       f
     );
   }
+  f = message.getAssignments();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
 };
 
 
@@ -4365,81 +11948,92 @@ proto.ag.UsedSlipDays.serializeBinaryToWriter = function(message, writer) {
  * optional uint64 ID = 1;
  * @return {number}
  */
-proto.ag.UsedSlipDays.prototype.getId = function() {
+proto.ag.CourseModule.prototype.getId = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.UsedSlipDays} returns this
+ * @return {!proto.ag.CourseModule} returns this
  */
-proto.ag.UsedSlipDays.prototype.setId = function(value) {
+proto.ag.CourseModule.prototype.setId = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 enrollmentID = 2;
+ * optional uint64 CourseID = 2;
  * @return {number}
  */
-proto.ag.UsedSlipDays.prototype.getEnrollmentid = function() {
+proto.ag.CourseModule.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.UsedSlipDays} returns this
+ * @return {!proto.ag.CourseModule} returns this
  */
-proto.ag.UsedSlipDays.prototype.setEnrollmentid = function(value) {
+proto.ag.CourseModule.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional uint64 assignmentID = 3;
- * @return {number}
+ * optional string name = 3;
+ * @return {string}
  */
-proto.ag.UsedSlipDays.prototype.getAssignmentid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.ag.CourseModule.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.UsedSlipDays} returns this
+ * @param {string} value
+ * @return {!proto.ag.CourseModule} returns this
  */
-proto.ag.UsedSlipDays.prototype.setAssignmentid = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.ag.CourseModule.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * optional uint32 usedSlipDays = 4;
+ * optional uint32 weight = 4;
  * @return {number}
  */
-proto.ag.UsedSlipDays.prototype.getUsedslipdays = function() {
+proto.ag.CourseModule.prototype.getWeight = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.UsedSlipDays} returns this
+ * @return {!proto.ag.CourseModule} returns this
  */
-proto.ag.UsedSlipDays.prototype.setUsedslipdays = function(value) {
+proto.ag.CourseModule.prototype.setWeight = function(value) {
   return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
+/**
+ * optional string assignments = 5;
+ * @return {string}
+ */
+proto.ag.CourseModule.prototype.getAssignments = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {string} value
+ * @return {!proto.ag.CourseModule} returns this
  */
-proto.ag.Enrollments.repeatedFields_ = [1];
+proto.ag.CourseModule.prototype.setAssignments = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
 
 
 
@@ -4456,8 +12050,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Enrollments.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Enrollments.toObject(opt_includeInstance, this);
+proto.ag.ModuleGrade.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.ModuleGrade.toObject(opt_includeInstance, this);
 };
 
 
@@ -4466,14 +12060,15 @@ proto.ag.Enrollments.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Enrollments} msg The msg instance to transform.
+ * @param {!proto.ag.ModuleGrade} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Enrollments.toObject = function(includeInstance, msg) {
+proto.ag.ModuleGrade.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enrollmentsList: jspb.Message.toObjectList(msg.getEnrollmentsList(),
-    proto.ag.Enrollment.toObject, includeInstance)
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    weight: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    grade: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -4487,23 +12082,23 @@ proto.ag.Enrollments.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Enrollments}
+ * @return {!proto.ag.ModuleGrade}
  */
-proto.ag.Enrollments.deserializeBinary = function(bytes) {
+proto.ag.ModuleGrade.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Enrollments;
-  return proto.ag.Enrollments.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.ModuleGrade;
+  return proto.ag.ModuleGrade.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Enrollments} msg The message object to deserialize into.
+ * @param {!proto.ag.ModuleGrade} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Enrollments}
+ * @return {!proto.ag.ModuleGrade}
  */
-proto.ag.Enrollments.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.ModuleGrade.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4511,9 +12106,16 @@ proto.ag.Enrollments.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.ag.Enrollment;
-      reader.readMessage(value,proto.ag.Enrollment.deserializeBinaryFromReader);
-      msg.addEnrollments(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setWeight(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setGrade(value);
       break;
     default:
       reader.skipField();
@@ -4528,9 +12130,9 @@ proto.ag.Enrollments.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.Enrollments.prototype.serializeBinary = function() {
+proto.ag.ModuleGrade.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.Enrollments.serializeBinaryToWriter(this, writer);
+  proto.ag.ModuleGrade.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4538,62 +12140,98 @@ proto.ag.Enrollments.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Enrollments} message
+ * @param {!proto.ag.ModuleGrade} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Enrollments.serializeBinaryToWriter = function(message, writer) {
+proto.ag.ModuleGrade.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getEnrollmentsList();
+  f = message.getName();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       1,
-      f,
-      proto.ag.Enrollment.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getWeight();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+  f = message.getGrade();
+  if (f !== 0) {
+    writer.writeUint32(
+      3,
+      f
     );
   }
 };
 
 
 /**
- * repeated Enrollment enrollments = 1;
- * @return {!Array<!proto.ag.Enrollment>}
+ * optional string name = 1;
+ * @return {string}
  */
-proto.ag.Enrollments.prototype.getEnrollmentsList = function() {
-  return /** @type{!Array<!proto.ag.Enrollment>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.Enrollment, 1));
+proto.ag.ModuleGrade.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!Array<!proto.ag.Enrollment>} value
- * @return {!proto.ag.Enrollments} returns this
-*/
-proto.ag.Enrollments.prototype.setEnrollmentsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {string} value
+ * @return {!proto.ag.ModuleGrade} returns this
+ */
+proto.ag.ModuleGrade.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.ag.Enrollment=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ag.Enrollment}
+ * optional uint32 weight = 2;
+ * @return {number}
  */
-proto.ag.Enrollments.prototype.addEnrollments = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.Enrollment, opt_index);
+proto.ag.ModuleGrade.prototype.getWeight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ag.Enrollments} returns this
+ * @param {number} value
+ * @return {!proto.ag.ModuleGrade} returns this
  */
-proto.ag.Enrollments.prototype.clearEnrollmentsList = function() {
-  return this.setEnrollmentsList([]);
+proto.ag.ModuleGrade.prototype.setWeight = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint32 grade = 3;
+ * @return {number}
+ */
+proto.ag.ModuleGrade.prototype.getGrade = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.ModuleGrade} returns this
+ */
+proto.ag.ModuleGrade.prototype.setGrade = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.CourseGrade.repeatedFields_ = [4];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -4609,8 +12247,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.SubmissionLink.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.SubmissionLink.toObject(opt_includeInstance, this);
+proto.ag.CourseGrade.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.CourseGrade.toObject(opt_includeInstance, this);
 };
 
 
@@ -4619,14 +12257,17 @@ proto.ag.SubmissionLink.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.SubmissionLink} msg The msg instance to transform.
+ * @param {!proto.ag.CourseGrade} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.SubmissionLink.toObject = function(includeInstance, msg) {
+proto.ag.CourseGrade.toObject = function(includeInstance, msg) {
   var f, obj = {
-    assignment: (f = msg.getAssignment()) && proto.ag.Assignment.toObject(includeInstance, f),
-    submission: (f = msg.getSubmission()) && proto.ag.Submission.toObject(includeInstance, f)
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    userid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    grade: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    modulesList: jspb.Message.toObjectList(msg.getModulesList(),
+    proto.ag.ModuleGrade.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -4640,23 +12281,23 @@ proto.ag.SubmissionLink.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.SubmissionLink}
+ * @return {!proto.ag.CourseGrade}
  */
-proto.ag.SubmissionLink.deserializeBinary = function(bytes) {
+proto.ag.CourseGrade.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.SubmissionLink;
-  return proto.ag.SubmissionLink.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.CourseGrade;
+  return proto.ag.CourseGrade.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.SubmissionLink} msg The message object to deserialize into.
+ * @param {!proto.ag.CourseGrade} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.SubmissionLink}
+ * @return {!proto.ag.CourseGrade}
  */
-proto.ag.SubmissionLink.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.CourseGrade.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4664,14 +12305,21 @@ proto.ag.SubmissionLink.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.ag.Assignment;
-      reader.readMessage(value,proto.ag.Assignment.deserializeBinaryFromReader);
-      msg.setAssignment(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
       break;
     case 2:
-      var value = new proto.ag.Submission;
-      reader.readMessage(value,proto.ag.Submission.deserializeBinaryFromReader);
-      msg.setSubmission(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setUserid(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setGrade(value);
+      break;
+    case 4:
+      var value = new proto.ag.ModuleGrade;
+      reader.readMessage(value,proto.ag.ModuleGrade.deserializeBinaryFromReader);
+      msg.addModules(value);
       break;
     default:
       reader.skipField();
@@ -4686,9 +12334,9 @@ proto.ag.SubmissionLink.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.SubmissionLink.prototype.serializeBinary = function() {
+proto.ag.CourseGrade.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.SubmissionLink.serializeBinaryToWriter(this, writer);
+  proto.ag.CourseGrade.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4696,102 +12344,133 @@ proto.ag.SubmissionLink.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.SubmissionLink} message
+ * @param {!proto.ag.CourseGrade} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.SubmissionLink.serializeBinaryToWriter = function(message, writer) {
+proto.ag.CourseGrade.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getAssignment();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
       1,
-      f,
-      proto.ag.Assignment.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getSubmission();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getUserid();
+  if (f !== 0) {
+    writer.writeUint64(
       2,
+      f
+    );
+  }
+  f = message.getGrade();
+  if (f !== 0) {
+    writer.writeUint32(
+      3,
+      f
+    );
+  }
+  f = message.getModulesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
       f,
-      proto.ag.Submission.serializeBinaryToWriter
+      proto.ag.ModuleGrade.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional Assignment assignment = 1;
- * @return {?proto.ag.Assignment}
+ * optional uint64 courseID = 1;
+ * @return {number}
  */
-proto.ag.SubmissionLink.prototype.getAssignment = function() {
-  return /** @type{?proto.ag.Assignment} */ (
-    jspb.Message.getWrapperField(this, proto.ag.Assignment, 1));
+proto.ag.CourseGrade.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {?proto.ag.Assignment|undefined} value
- * @return {!proto.ag.SubmissionLink} returns this
-*/
-proto.ag.SubmissionLink.prototype.setAssignment = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+ * @param {number} value
+ * @return {!proto.ag.CourseGrade} returns this
+ */
+proto.ag.CourseGrade.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.ag.SubmissionLink} returns this
+ * optional uint64 userID = 2;
+ * @return {number}
  */
-proto.ag.SubmissionLink.prototype.clearAssignment = function() {
-  return this.setAssignment(undefined);
+proto.ag.CourseGrade.prototype.getUserid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.ag.CourseGrade} returns this
  */
-proto.ag.SubmissionLink.prototype.hasAssignment = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.ag.CourseGrade.prototype.setUserid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional Submission submission = 2;
- * @return {?proto.ag.Submission}
+ * optional uint32 grade = 3;
+ * @return {number}
  */
-proto.ag.SubmissionLink.prototype.getSubmission = function() {
-  return /** @type{?proto.ag.Submission} */ (
-    jspb.Message.getWrapperField(this, proto.ag.Submission, 2));
+proto.ag.CourseGrade.prototype.getGrade = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * @param {?proto.ag.Submission|undefined} value
- * @return {!proto.ag.SubmissionLink} returns this
+ * @param {number} value
+ * @return {!proto.ag.CourseGrade} returns this
+ */
+proto.ag.CourseGrade.prototype.setGrade = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * repeated ModuleGrade modules = 4;
+ * @return {!Array<!proto.ag.ModuleGrade>}
+ */
+proto.ag.CourseGrade.prototype.getModulesList = function() {
+  return /** @type{!Array<!proto.ag.ModuleGrade>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.ModuleGrade, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.ModuleGrade>} value
+ * @return {!proto.ag.CourseGrade} returns this
 */
-proto.ag.SubmissionLink.prototype.setSubmission = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
+proto.ag.CourseGrade.prototype.setModulesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.ag.SubmissionLink} returns this
+ * @param {!proto.ag.ModuleGrade=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.ModuleGrade}
  */
-proto.ag.SubmissionLink.prototype.clearSubmission = function() {
-  return this.setSubmission(undefined);
+proto.ag.CourseGrade.prototype.addModules = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.ag.ModuleGrade, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.CourseGrade} returns this
  */
-proto.ag.SubmissionLink.prototype.hasSubmission = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.ag.CourseGrade.prototype.clearModulesList = function() {
+  return this.setModulesList([]);
 };
 
 
@@ -4801,7 +12480,7 @@ proto.ag.SubmissionLink.prototype.hasSubmission = function() {
  * @private {!Array<number>}
  * @const
  */
-proto.ag.EnrollmentLink.repeatedFields_ = [3];
+proto.ag.GradingBenchmark.repeatedFields_ = [6];
 
 
 
@@ -4818,8 +12497,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.EnrollmentLink.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.EnrollmentLink.toObject(opt_includeInstance, this);
+proto.ag.GradingBenchmark.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.GradingBenchmark.toObject(opt_includeInstance, this);
 };
 
 
@@ -4828,15 +12507,20 @@ proto.ag.EnrollmentLink.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.EnrollmentLink} msg The msg instance to transform.
+ * @param {!proto.ag.GradingBenchmark} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.EnrollmentLink.toObject = function(includeInstance, msg) {
+proto.ag.GradingBenchmark.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enrollment: (f = msg.getEnrollment()) && proto.ag.Enrollment.toObject(includeInstance, f),
-    submissionsList: jspb.Message.toObjectList(msg.getSubmissionsList(),
-    proto.ag.SubmissionLink.toObject, includeInstance)
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    reviewid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    heading: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    comment: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    criteriaList: jspb.Message.toObjectList(msg.getCriteriaList(),
+    proto.ag.GradingCriterion.toObject, includeInstance),
+    weight: jspb.Message.getFieldWithDefault(msg, 7, 0)
   };
 
   if (includeInstance) {
@@ -4850,38 +12534,57 @@ proto.ag.EnrollmentLink.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.EnrollmentLink}
+ * @return {!proto.ag.GradingBenchmark}
  */
-proto.ag.EnrollmentLink.deserializeBinary = function(bytes) {
+proto.ag.GradingBenchmark.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.EnrollmentLink;
-  return proto.ag.EnrollmentLink.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.GradingBenchmark;
+  return proto.ag.GradingBenchmark.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.EnrollmentLink} msg The message object to deserialize into.
+ * @param {!proto.ag.GradingBenchmark} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.EnrollmentLink}
+ * @return {!proto.ag.GradingBenchmark}
  */
-proto.ag.EnrollmentLink.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.GradingBenchmark.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
     case 2:
-      var value = new proto.ag.Enrollment;
-      reader.readMessage(value,proto.ag.Enrollment.deserializeBinaryFromReader);
-      msg.setEnrollment(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
       break;
     case 3:
-      var value = new proto.ag.SubmissionLink;
-      reader.readMessage(value,proto.ag.SubmissionLink.deserializeBinaryFromReader);
-      msg.addSubmissions(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setReviewid(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setHeading(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setComment(value);
+      break;
+    case 6:
+      var value = new proto.ag.GradingCriterion;
+      reader.readMessage(value,proto.ag.GradingCriterion.deserializeBinaryFromReader);
+      msg.addCriteria(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setWeight(value);
       break;
     default:
       reader.skipField();
@@ -4896,9 +12599,9 @@ proto.ag.EnrollmentLink.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.EnrollmentLink.prototype.serializeBinary = function() {
+proto.ag.GradingBenchmark.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.EnrollmentLink.serializeBinaryToWriter(this, writer);
+  proto.ag.GradingBenchmark.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4906,103 +12609,208 @@ proto.ag.EnrollmentLink.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.EnrollmentLink} message
+ * @param {!proto.ag.GradingBenchmark} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.EnrollmentLink.serializeBinaryToWriter = function(message, writer) {
+proto.ag.GradingBenchmark.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getEnrollment();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
       2,
-      f,
-      proto.ag.Enrollment.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getSubmissionsList();
+  f = message.getReviewid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getHeading();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getComment();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getCriteriaList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
-      3,
+      6,
       f,
-      proto.ag.SubmissionLink.serializeBinaryToWriter
+      proto.ag.GradingCriterion.serializeBinaryToWriter
+    );
+  }
+  f = message.getWeight();
+  if (f !== 0) {
+    writer.writeInt32(
+      7,
+      f
     );
   }
 };
 
 
 /**
- * optional Enrollment enrollment = 2;
- * @return {?proto.ag.Enrollment}
+ * optional uint64 ID = 1;
+ * @return {number}
  */
-proto.ag.EnrollmentLink.prototype.getEnrollment = function() {
-  return /** @type{?proto.ag.Enrollment} */ (
-    jspb.Message.getWrapperField(this, proto.ag.Enrollment, 2));
+proto.ag.GradingBenchmark.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {?proto.ag.Enrollment|undefined} value
- * @return {!proto.ag.EnrollmentLink} returns this
-*/
-proto.ag.EnrollmentLink.prototype.setEnrollment = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
+ * @param {number} value
+ * @return {!proto.ag.GradingBenchmark} returns this
+ */
+proto.ag.GradingBenchmark.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.ag.EnrollmentLink} returns this
+ * optional uint64 AssignmentID = 2;
+ * @return {number}
  */
-proto.ag.EnrollmentLink.prototype.clearEnrollment = function() {
-  return this.setEnrollment(undefined);
+proto.ag.GradingBenchmark.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.ag.GradingBenchmark} returns this
  */
-proto.ag.EnrollmentLink.prototype.hasEnrollment = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.ag.GradingBenchmark.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * repeated SubmissionLink submissions = 3;
- * @return {!Array<!proto.ag.SubmissionLink>}
+ * optional uint64 ReviewID = 3;
+ * @return {number}
  */
-proto.ag.EnrollmentLink.prototype.getSubmissionsList = function() {
-  return /** @type{!Array<!proto.ag.SubmissionLink>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.SubmissionLink, 3));
+proto.ag.GradingBenchmark.prototype.getReviewid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * @param {!Array<!proto.ag.SubmissionLink>} value
- * @return {!proto.ag.EnrollmentLink} returns this
+ * @param {number} value
+ * @return {!proto.ag.GradingBenchmark} returns this
+ */
+proto.ag.GradingBenchmark.prototype.setReviewid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional string heading = 4;
+ * @return {string}
+ */
+proto.ag.GradingBenchmark.prototype.getHeading = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.GradingBenchmark} returns this
+ */
+proto.ag.GradingBenchmark.prototype.setHeading = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string comment = 5;
+ * @return {string}
+ */
+proto.ag.GradingBenchmark.prototype.getComment = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.GradingBenchmark} returns this
+ */
+proto.ag.GradingBenchmark.prototype.setComment = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * repeated GradingCriterion criteria = 6;
+ * @return {!Array<!proto.ag.GradingCriterion>}
+ */
+proto.ag.GradingBenchmark.prototype.getCriteriaList = function() {
+  return /** @type{!Array<!proto.ag.GradingCriterion>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.GradingCriterion, 6));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.GradingCriterion>} value
+ * @return {!proto.ag.GradingBenchmark} returns this
 */
-proto.ag.EnrollmentLink.prototype.setSubmissionsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+proto.ag.GradingBenchmark.prototype.setCriteriaList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 6, value);
 };
 
 
 /**
- * @param {!proto.ag.SubmissionLink=} opt_value
+ * @param {!proto.ag.GradingCriterion=} opt_value
  * @param {number=} opt_index
- * @return {!proto.ag.SubmissionLink}
+ * @return {!proto.ag.GradingCriterion}
  */
-proto.ag.EnrollmentLink.prototype.addSubmissions = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.ag.SubmissionLink, opt_index);
+proto.ag.GradingBenchmark.prototype.addCriteria = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 6, opt_value, proto.ag.GradingCriterion, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.ag.EnrollmentLink} returns this
+ * @return {!proto.ag.GradingBenchmark} returns this
  */
-proto.ag.EnrollmentLink.prototype.clearSubmissionsList = function() {
-  return this.setSubmissionsList([]);
+proto.ag.GradingBenchmark.prototype.clearCriteriaList = function() {
+  return this.setCriteriaList([]);
+};
+
+
+/**
+ * optional int32 weight = 7;
+ * @return {number}
+ */
+proto.ag.GradingBenchmark.prototype.getWeight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.GradingBenchmark} returns this
+ */
+proto.ag.GradingBenchmark.prototype.setWeight = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
 };
 
 
@@ -5012,7 +12820,7 @@ proto.ag.EnrollmentLink.prototype.clearSubmissionsList = function() {
  * @private {!Array<number>}
  * @const
  */
-proto.ag.CourseSubmissions.repeatedFields_ = [2];
+proto.ag.Benchmarks.repeatedFields_ = [1];
 
 
 
@@ -5029,8 +12837,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.CourseSubmissions.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.CourseSubmissions.toObject(opt_includeInstance, this);
+proto.ag.Benchmarks.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Benchmarks.toObject(opt_includeInstance, this);
 };
 
 
@@ -5039,15 +12847,14 @@ proto.ag.CourseSubmissions.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.CourseSubmissions} msg The msg instance to transform.
+ * @param {!proto.ag.Benchmarks} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.CourseSubmissions.toObject = function(includeInstance, msg) {
+proto.ag.Benchmarks.toObject = function(includeInstance, msg) {
   var f, obj = {
-    course: (f = msg.getCourse()) && proto.ag.Course.toObject(includeInstance, f),
-    linksList: jspb.Message.toObjectList(msg.getLinksList(),
-    proto.ag.EnrollmentLink.toObject, includeInstance)
+    benchmarksList: jspb.Message.toObjectList(msg.getBenchmarksList(),
+    proto.ag.GradingBenchmark.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -5061,23 +12868,23 @@ proto.ag.CourseSubmissions.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.CourseSubmissions}
+ * @return {!proto.ag.Benchmarks}
  */
-proto.ag.CourseSubmissions.deserializeBinary = function(bytes) {
+proto.ag.Benchmarks.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.CourseSubmissions;
-  return proto.ag.CourseSubmissions.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.Benchmarks;
+  return proto.ag.Benchmarks.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.CourseSubmissions} msg The message object to deserialize into.
+ * @param {!proto.ag.Benchmarks} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.CourseSubmissions}
+ * @return {!proto.ag.Benchmarks}
  */
-proto.ag.CourseSubmissions.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.Benchmarks.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5085,14 +12892,9 @@ proto.ag.CourseSubmissions.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.ag.Course;
-      reader.readMessage(value,proto.ag.Course.deserializeBinaryFromReader);
-      msg.setCourse(value);
-      break;
-    case 2:
-      var value = new proto.ag.EnrollmentLink;
-      reader.readMessage(value,proto.ag.EnrollmentLink.deserializeBinaryFromReader);
-      msg.addLinks(value);
+      var value = new proto.ag.GradingBenchmark;
+      reader.readMessage(value,proto.ag.GradingBenchmark.deserializeBinaryFromReader);
+      msg.addBenchmarks(value);
       break;
     default:
       reader.skipField();
@@ -5107,9 +12909,9 @@ proto.ag.CourseSubmissions.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.CourseSubmissions.prototype.serializeBinary = function() {
+proto.ag.Benchmarks.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.CourseSubmissions.serializeBinaryToWriter(this, writer);
+  proto.ag.Benchmarks.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5117,114 +12919,62 @@ proto.ag.CourseSubmissions.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.CourseSubmissions} message
+ * @param {!proto.ag.Benchmarks} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.CourseSubmissions.serializeBinaryToWriter = function(message, writer) {
+proto.ag.Benchmarks.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCourse();
-  if (f != null) {
-    writer.writeMessage(
-      1,
-      f,
-      proto.ag.Course.serializeBinaryToWriter
-    );
-  }
-  f = message.getLinksList();
+  f = message.getBenchmarksList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
-      2,
+      1,
       f,
-      proto.ag.EnrollmentLink.serializeBinaryToWriter
+      proto.ag.GradingBenchmark.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional Course course = 1;
- * @return {?proto.ag.Course}
- */
-proto.ag.CourseSubmissions.prototype.getCourse = function() {
-  return /** @type{?proto.ag.Course} */ (
-    jspb.Message.getWrapperField(this, proto.ag.Course, 1));
-};
-
-
-/**
- * @param {?proto.ag.Course|undefined} value
- * @return {!proto.ag.CourseSubmissions} returns this
-*/
-proto.ag.CourseSubmissions.prototype.setCourse = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.ag.CourseSubmissions} returns this
- */
-proto.ag.CourseSubmissions.prototype.clearCourse = function() {
-  return this.setCourse(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.ag.CourseSubmissions.prototype.hasCourse = function() {
-  return jspb.Message.getField(this, 1) != null;
-};
-
-
-/**
- * repeated EnrollmentLink links = 2;
- * @return {!Array<!proto.ag.EnrollmentLink>}
+ * repeated GradingBenchmark benchmarks = 1;
+ * @return {!Array<!proto.ag.GradingBenchmark>}
  */
-proto.ag.CourseSubmissions.prototype.getLinksList = function() {
-  return /** @type{!Array<!proto.ag.EnrollmentLink>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.EnrollmentLink, 2));
+proto.ag.Benchmarks.prototype.getBenchmarksList = function() {
+  return /** @type{!Array<!proto.ag.GradingBenchmark>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.GradingBenchmark, 1));
 };
 
 
 /**
- * @param {!Array<!proto.ag.EnrollmentLink>} value
- * @return {!proto.ag.CourseSubmissions} returns this
+ * @param {!Array<!proto.ag.GradingBenchmark>} value
+ * @return {!proto.ag.Benchmarks} returns this
 */
-proto.ag.CourseSubmissions.prototype.setLinksList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+proto.ag.Benchmarks.prototype.setBenchmarksList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.ag.EnrollmentLink=} opt_value
+ * @param {!proto.ag.GradingBenchmark=} opt_value
  * @param {number=} opt_index
- * @return {!proto.ag.EnrollmentLink}
+ * @return {!proto.ag.GradingBenchmark}
  */
-proto.ag.CourseSubmissions.prototype.addLinks = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.ag.EnrollmentLink, opt_index);
+proto.ag.Benchmarks.prototype.addBenchmarks = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.GradingBenchmark, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.ag.CourseSubmissions} returns this
+ * @return {!proto.ag.Benchmarks} returns this
  */
-proto.ag.CourseSubmissions.prototype.clearLinksList = function() {
-  return this.setLinksList([]);
+proto.ag.Benchmarks.prototype.clearBenchmarksList = function() {
+  return this.setBenchmarksList([]);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.ag.Assignment.repeatedFields_ = [11,12];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -5240,8 +12990,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Assignment.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Assignment.toObject(opt_includeInstance, this);
+proto.ag.GradingCriterion.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.GradingCriterion.toObject(opt_includeInstance, this);
 };
 
 
@@ -5250,27 +13000,18 @@ proto.ag.Assignment.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Assignment} msg The msg instance to transform.
+ * @param {!proto.ag.GradingCriterion} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Assignment.toObject = function(includeInstance, msg) {
+proto.ag.GradingCriterion.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    courseid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    name: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    scriptfile: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    deadline: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    autoapprove: jspb.Message.getBooleanFieldWithDefault(msg, 6, false),
-    order: jspb.Message.getFieldWithDefault(msg, 7, 0),
-    isgrouplab: jspb.Message.getBooleanFieldWithDefault(msg, 8, false),
-    scorelimit: jspb.Message.getFieldWithDefault(msg, 9, 0),
-    reviewers: jspb.Message.getFieldWithDefault(msg, 10, 0),
-    submissionsList: jspb.Message.toObjectList(msg.getSubmissionsList(),
-    proto.ag.Submission.toObject, includeInstance),
-    gradingbenchmarksList: jspb.Message.toObjectList(msg.getGradingbenchmarksList(),
-    proto.ag.GradingBenchmark.toObject, includeInstance),
-    containertimeout: jspb.Message.getFieldWithDefault(msg, 13, 0)
+    benchmarkid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    points: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    description: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    grade: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    comment: jspb.Message.getFieldWithDefault(msg, 6, "")
   };
 
   if (includeInstance) {
@@ -5284,23 +13025,23 @@ proto.ag.Assignment.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Assignment}
+ * @return {!proto.ag.GradingCriterion}
  */
-proto.ag.Assignment.deserializeBinary = function(bytes) {
+proto.ag.GradingCriterion.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Assignment;
-  return proto.ag.Assignment.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.GradingCriterion;
+  return proto.ag.GradingCriterion.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Assignment} msg The message object to deserialize into.
+ * @param {!proto.ag.GradingCriterion} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Assignment}
+ * @return {!proto.ag.GradingCriterion}
  */
-proto.ag.Assignment.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.GradingCriterion.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5313,53 +13054,23 @@ proto.ag.Assignment.deserializeBinaryFromReader = function(msg, reader) {
       break;
     case 2:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
+      msg.setBenchmarkid(value);
       break;
     case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setPoints(value);
       break;
     case 4:
       var value = /** @type {string} */ (reader.readString());
-      msg.setScriptfile(value);
+      msg.setDescription(value);
       break;
     case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setDeadline(value);
+      var value = /** @type {!proto.ag.GradingCriterion.Grade} */ (reader.readEnum());
+      msg.setGrade(value);
       break;
     case 6:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setAutoapprove(value);
-      break;
-    case 7:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setOrder(value);
-      break;
-    case 8:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setIsgrouplab(value);
-      break;
-    case 9:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setScorelimit(value);
-      break;
-    case 10:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setReviewers(value);
-      break;
-    case 11:
-      var value = new proto.ag.Submission;
-      reader.readMessage(value,proto.ag.Submission.deserializeBinaryFromReader);
-      msg.addSubmissions(value);
-      break;
-    case 12:
-      var value = new proto.ag.GradingBenchmark;
-      reader.readMessage(value,proto.ag.GradingBenchmark.deserializeBinaryFromReader);
-      msg.addGradingbenchmarks(value);
-      break;
-    case 13:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setContainertimeout(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setComment(value);
       break;
     default:
       reader.skipField();
@@ -5374,9 +13085,9 @@ proto.ag.Assignment.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.Assignment.prototype.serializeBinary = function() {
+proto.ag.GradingCriterion.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.Assignment.serializeBinaryToWriter(this, writer);
+  proto.ag.GradingCriterion.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5384,342 +13095,504 @@ proto.ag.Assignment.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Assignment} message
+ * @param {!proto.ag.GradingCriterion} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Assignment.serializeBinaryToWriter = function(message, writer) {
+proto.ag.GradingCriterion.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
-  if (f !== 0) {
-    writer.writeUint64(
-      1,
-      f
-    );
-  }
-  f = message.getCourseid();
-  if (f !== 0) {
-    writer.writeUint64(
-      2,
-      f
-    );
-  }
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
-  f = message.getScriptfile();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getDeadline();
-  if (f.length > 0) {
-    writer.writeString(
-      5,
-      f
-    );
-  }
-  f = message.getAutoapprove();
-  if (f) {
-    writer.writeBool(
-      6,
-      f
-    );
-  }
-  f = message.getOrder();
-  if (f !== 0) {
-    writer.writeUint32(
-      7,
-      f
-    );
-  }
-  f = message.getIsgrouplab();
-  if (f) {
-    writer.writeBool(
-      8,
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
       f
     );
   }
-  f = message.getScorelimit();
+  f = message.getBenchmarkid();
   if (f !== 0) {
-    writer.writeUint32(
-      9,
+    writer.writeUint64(
+      2,
       f
     );
   }
-  f = message.getReviewers();
+  f = message.getPoints();
   if (f !== 0) {
-    writer.writeUint32(
-      10,
+    writer.writeUint64(
+      3,
       f
     );
   }
-  f = message.getSubmissionsList();
+  f = message.getDescription();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      11,
-      f,
-      proto.ag.Submission.serializeBinaryToWriter
+    writer.writeString(
+      4,
+      f
     );
   }
-  f = message.getGradingbenchmarksList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      12,
-      f,
-      proto.ag.GradingBenchmark.serializeBinaryToWriter
+  f = message.getGrade();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      5,
+      f
     );
   }
-  f = message.getContainertimeout();
-  if (f !== 0) {
-    writer.writeUint32(
-      13,
+  f = message.getComment();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
       f
     );
   }
 };
 
 
+/**
+ * @enum {number}
+ */
+proto.ag.GradingCriterion.Grade = {
+  NONE: 0,
+  FAILED: 1,
+  PASSED: 2
+};
+
 /**
  * optional uint64 ID = 1;
  * @return {number}
  */
-proto.ag.Assignment.prototype.getId = function() {
+proto.ag.GradingCriterion.prototype.getId = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Assignment} returns this
+ * @return {!proto.ag.GradingCriterion} returns this
  */
-proto.ag.Assignment.prototype.setId = function(value) {
+proto.ag.GradingCriterion.prototype.setId = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 CourseID = 2;
+ * optional uint64 BenchmarkID = 2;
  * @return {number}
  */
-proto.ag.Assignment.prototype.getCourseid = function() {
+proto.ag.GradingCriterion.prototype.getBenchmarkid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Assignment} returns this
+ * @return {!proto.ag.GradingCriterion} returns this
  */
-proto.ag.Assignment.prototype.setCourseid = function(value) {
+proto.ag.GradingCriterion.prototype.setBenchmarkid = function(value) {
   return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional string name = 3;
- * @return {string}
+ * optional uint64 points = 3;
+ * @return {number}
  */
-proto.ag.Assignment.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.ag.GradingCriterion.prototype.getPoints = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ag.Assignment} returns this
+ * @param {number} value
+ * @return {!proto.ag.GradingCriterion} returns this
  */
-proto.ag.Assignment.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.ag.GradingCriterion.prototype.setPoints = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * optional string scriptFile = 4;
+ * optional string description = 4;
  * @return {string}
  */
-proto.ag.Assignment.prototype.getScriptfile = function() {
+proto.ag.GradingCriterion.prototype.getDescription = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.ag.Assignment} returns this
+ * @return {!proto.ag.GradingCriterion} returns this
  */
-proto.ag.Assignment.prototype.setScriptfile = function(value) {
+proto.ag.GradingCriterion.prototype.setDescription = function(value) {
   return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * optional string deadline = 5;
+ * optional Grade grade = 5;
+ * @return {!proto.ag.GradingCriterion.Grade}
+ */
+proto.ag.GradingCriterion.prototype.getGrade = function() {
+  return /** @type {!proto.ag.GradingCriterion.Grade} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {!proto.ag.GradingCriterion.Grade} value
+ * @return {!proto.ag.GradingCriterion} returns this
+ */
+proto.ag.GradingCriterion.prototype.setGrade = function(value) {
+  return jspb.Message.setProto3EnumField(this, 5, value);
+};
+
+
+/**
+ * optional string comment = 6;
  * @return {string}
  */
-proto.ag.Assignment.prototype.getDeadline = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+proto.ag.GradingCriterion.prototype.getComment = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.ag.Assignment} returns this
+ * @return {!proto.ag.GradingCriterion} returns this
  */
-proto.ag.Assignment.prototype.setDeadline = function(value) {
-  return jspb.Message.setProto3StringField(this, 5, value);
+proto.ag.GradingCriterion.prototype.setComment = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
 };
 
 
+
 /**
- * optional bool autoApprove = 6;
- * @return {boolean}
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.ag.Assignment.prototype.getAutoapprove = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
+proto.ag.Review.repeatedFields_ = [7];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.Review.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Review.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.Review} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Review.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    submissionid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    reviewerid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    feedback: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    ready: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
+    score: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    gradingbenchmarksList: jspb.Message.toObjectList(msg.getGradingbenchmarksList(),
+    proto.ag.GradingBenchmark.toObject, includeInstance),
+    edited: jspb.Message.getFieldWithDefault(msg, 8, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.Review}
+ */
+proto.ag.Review.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.Review;
+  return proto.ag.Review.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.Review} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.Review}
+ */
+proto.ag.Review.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setSubmissionid(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setReviewerid(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFeedback(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setReady(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setScore(value);
+      break;
+    case 7:
+      var value = new proto.ag.GradingBenchmark;
+      reader.readMessage(value,proto.ag.GradingBenchmark.deserializeBinaryFromReader);
+      msg.addGradingbenchmarks(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEdited(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.Review.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.Review.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.ag.Assignment} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.Review} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Assignment.prototype.setAutoapprove = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 6, value);
+proto.ag.Review.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getSubmissionid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getReviewerid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getFeedback();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getReady();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+  f = message.getScore();
+  if (f !== 0) {
+    writer.writeUint32(
+      6,
+      f
+    );
+  }
+  f = message.getGradingbenchmarksList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      7,
+      f,
+      proto.ag.GradingBenchmark.serializeBinaryToWriter
+    );
+  }
+  f = message.getEdited();
+  if (f.length > 0) {
+    writer.writeString(
+      8,
+      f
+    );
+  }
 };
 
 
 /**
- * optional uint32 order = 7;
+ * optional uint64 ID = 1;
  * @return {number}
  */
-proto.ag.Assignment.prototype.getOrder = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+proto.ag.Review.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Assignment} returns this
+ * @return {!proto.ag.Review} returns this
  */
-proto.ag.Assignment.prototype.setOrder = function(value) {
-  return jspb.Message.setProto3IntField(this, 7, value);
+proto.ag.Review.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional bool isGroupLab = 8;
- * @return {boolean}
+ * optional uint64 SubmissionID = 2;
+ * @return {number}
  */
-proto.ag.Assignment.prototype.getIsgrouplab = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 8, false));
+proto.ag.Review.prototype.getSubmissionid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.ag.Assignment} returns this
+ * @param {number} value
+ * @return {!proto.ag.Review} returns this
  */
-proto.ag.Assignment.prototype.setIsgrouplab = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 8, value);
+proto.ag.Review.prototype.setSubmissionid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional uint32 scoreLimit = 9;
+ * optional uint64 ReviewerID = 3;
  * @return {number}
  */
-proto.ag.Assignment.prototype.getScorelimit = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+proto.ag.Review.prototype.getReviewerid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Assignment} returns this
+ * @return {!proto.ag.Review} returns this
  */
-proto.ag.Assignment.prototype.setScorelimit = function(value) {
-  return jspb.Message.setProto3IntField(this, 9, value);
+proto.ag.Review.prototype.setReviewerid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * optional uint32 reviewers = 10;
- * @return {number}
+ * optional string feedback = 4;
+ * @return {string}
  */
-proto.ag.Assignment.prototype.getReviewers = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+proto.ag.Review.prototype.getFeedback = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.Assignment} returns this
+ * @param {string} value
+ * @return {!proto.ag.Review} returns this
  */
-proto.ag.Assignment.prototype.setReviewers = function(value) {
-  return jspb.Message.setProto3IntField(this, 10, value);
+proto.ag.Review.prototype.setFeedback = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * repeated Submission submissions = 11;
- * @return {!Array<!proto.ag.Submission>}
+ * optional bool ready = 5;
+ * @return {boolean}
  */
-proto.ag.Assignment.prototype.getSubmissionsList = function() {
-  return /** @type{!Array<!proto.ag.Submission>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.Submission, 11));
+proto.ag.Review.prototype.getReady = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
 };
 
 
 /**
- * @param {!Array<!proto.ag.Submission>} value
- * @return {!proto.ag.Assignment} returns this
-*/
-proto.ag.Assignment.prototype.setSubmissionsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 11, value);
+ * @param {boolean} value
+ * @return {!proto.ag.Review} returns this
+ */
+proto.ag.Review.prototype.setReady = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
 };
 
 
 /**
- * @param {!proto.ag.Submission=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ag.Submission}
+ * optional uint32 score = 6;
+ * @return {number}
  */
-proto.ag.Assignment.prototype.addSubmissions = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 11, opt_value, proto.ag.Submission, opt_index);
+proto.ag.Review.prototype.getScore = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ag.Assignment} returns this
+ * @param {number} value
+ * @return {!proto.ag.Review} returns this
  */
-proto.ag.Assignment.prototype.clearSubmissionsList = function() {
-  return this.setSubmissionsList([]);
+proto.ag.Review.prototype.setScore = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
 };
 
 
 /**
- * repeated GradingBenchmark gradingBenchmarks = 12;
+ * repeated GradingBenchmark gradingBenchmarks = 7;
  * @return {!Array<!proto.ag.GradingBenchmark>}
  */
-proto.ag.Assignment.prototype.getGradingbenchmarksList = function() {
+proto.ag.Review.prototype.getGradingbenchmarksList = function() {
   return /** @type{!Array<!proto.ag.GradingBenchmark>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.GradingBenchmark, 12));
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.GradingBenchmark, 7));
 };
 
 
 /**
  * @param {!Array<!proto.ag.GradingBenchmark>} value
- * @return {!proto.ag.Assignment} returns this
+ * @return {!proto.ag.Review} returns this
 */
-proto.ag.Assignment.prototype.setGradingbenchmarksList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 12, value);
+proto.ag.Review.prototype.setGradingbenchmarksList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 7, value);
 };
 
 
@@ -5728,35 +13601,35 @@ proto.ag.Assignment.prototype.setGradingbenchmarksList = function(value) {
  * @param {number=} opt_index
  * @return {!proto.ag.GradingBenchmark}
  */
-proto.ag.Assignment.prototype.addGradingbenchmarks = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 12, opt_value, proto.ag.GradingBenchmark, opt_index);
+proto.ag.Review.prototype.addGradingbenchmarks = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 7, opt_value, proto.ag.GradingBenchmark, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.ag.Assignment} returns this
+ * @return {!proto.ag.Review} returns this
  */
-proto.ag.Assignment.prototype.clearGradingbenchmarksList = function() {
+proto.ag.Review.prototype.clearGradingbenchmarksList = function() {
   return this.setGradingbenchmarksList([]);
 };
 
 
 /**
- * optional uint32 containerTimeout = 13;
- * @return {number}
+ * optional string edited = 8;
+ * @return {string}
  */
-proto.ag.Assignment.prototype.getContainertimeout = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 13, 0));
+proto.ag.Review.prototype.getEdited = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.Assignment} returns this
+ * @param {string} value
+ * @return {!proto.ag.Review} returns this
  */
-proto.ag.Assignment.prototype.setContainertimeout = function(value) {
-  return jspb.Message.setProto3IntField(this, 13, value);
+proto.ag.Review.prototype.setEdited = function(value) {
+  return jspb.Message.setProto3StringField(this, 8, value);
 };
 
 
@@ -5766,7 +13639,7 @@ proto.ag.Assignment.prototype.setContainertimeout = function(value) {
  * @private {!Array<number>}
  * @const
  */
-proto.ag.Assignments.repeatedFields_ = [1];
+proto.ag.Reviewers.repeatedFields_ = [1];
 
 
 
@@ -5783,8 +13656,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Assignments.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Assignments.toObject(opt_includeInstance, this);
+proto.ag.Reviewers.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Reviewers.toObject(opt_includeInstance, this);
 };
 
 
@@ -5793,14 +13666,14 @@ proto.ag.Assignments.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Assignments} msg The msg instance to transform.
+ * @param {!proto.ag.Reviewers} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Assignments.toObject = function(includeInstance, msg) {
+proto.ag.Reviewers.toObject = function(includeInstance, msg) {
   var f, obj = {
-    assignmentsList: jspb.Message.toObjectList(msg.getAssignmentsList(),
-    proto.ag.Assignment.toObject, includeInstance)
+    reviewersList: jspb.Message.toObjectList(msg.getReviewersList(),
+    proto.ag.User.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -5814,23 +13687,23 @@ proto.ag.Assignments.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Assignments}
+ * @return {!proto.ag.Reviewers}
  */
-proto.ag.Assignments.deserializeBinary = function(bytes) {
+proto.ag.Reviewers.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Assignments;
-  return proto.ag.Assignments.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.Reviewers;
+  return proto.ag.Reviewers.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Assignments} msg The message object to deserialize into.
+ * @param {!proto.ag.Reviewers} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Assignments}
+ * @return {!proto.ag.Reviewers}
  */
-proto.ag.Assignments.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.Reviewers.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5838,9 +13711,9 @@ proto.ag.Assignments.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.ag.Assignment;
-      reader.readMessage(value,proto.ag.Assignment.deserializeBinaryFromReader);
-      msg.addAssignments(value);
+      var value = new proto.ag.User;
+      reader.readMessage(value,proto.ag.User.deserializeBinaryFromReader);
+      msg.addReviewers(value);
       break;
     default:
       reader.skipField();
@@ -5855,9 +13728,9 @@ proto.ag.Assignments.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.Assignments.prototype.serializeBinary = function() {
+proto.ag.Reviewers.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.Assignments.serializeBinaryToWriter(this, writer);
+  proto.ag.Reviewers.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5865,69 +13738,62 @@ proto.ag.Assignments.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Assignments} message
+ * @param {!proto.ag.Reviewers} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Assignments.serializeBinaryToWriter = function(message, writer) {
+proto.ag.Reviewers.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getAssignmentsList();
+  f = message.getReviewersList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
       1,
       f,
-      proto.ag.Assignment.serializeBinaryToWriter
+      proto.ag.User.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * repeated Assignment assignments = 1;
- * @return {!Array<!proto.ag.Assignment>}
+ * repeated User reviewers = 1;
+ * @return {!Array<!proto.ag.User>}
  */
-proto.ag.Assignments.prototype.getAssignmentsList = function() {
-  return /** @type{!Array<!proto.ag.Assignment>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.Assignment, 1));
+proto.ag.Reviewers.prototype.getReviewersList = function() {
+  return /** @type{!Array<!proto.ag.User>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.User, 1));
 };
 
 
-/**
- * @param {!Array<!proto.ag.Assignment>} value
- * @return {!proto.ag.Assignments} returns this
+/**
+ * @param {!Array<!proto.ag.User>} value
+ * @return {!proto.ag.Reviewers} returns this
 */
-proto.ag.Assignments.prototype.setAssignmentsList = function(value) {
+proto.ag.Reviewers.prototype.setReviewersList = function(value) {
   return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.ag.Assignment=} opt_value
+ * @param {!proto.ag.User=} opt_value
  * @param {number=} opt_index
- * @return {!proto.ag.Assignment}
+ * @return {!proto.ag.User}
  */
-proto.ag.Assignments.prototype.addAssignments = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.Assignment, opt_index);
+proto.ag.Reviewers.prototype.addReviewers = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.User, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.ag.Assignments} returns this
+ * @return {!proto.ag.Reviewers} returns this
  */
-proto.ag.Assignments.prototype.clearAssignmentsList = function() {
-  return this.setAssignmentsList([]);
+proto.ag.Reviewers.prototype.clearReviewersList = function() {
+  return this.setReviewersList([]);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.ag.Submission.repeatedFields_ = [10,12];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -5943,8 +13809,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Submission.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Submission.toObject(opt_includeInstance, this);
+proto.ag.ReviewRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.ReviewRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -5953,26 +13819,14 @@ proto.ag.Submission.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Submission} msg The msg instance to transform.
+ * @param {!proto.ag.ReviewRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Submission.toObject = function(includeInstance, msg) {
+proto.ag.ReviewRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    userid: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    groupid: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    score: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    commithash: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    released: jspb.Message.getBooleanFieldWithDefault(msg, 7, false),
-    status: jspb.Message.getFieldWithDefault(msg, 8, 0),
-    approveddate: jspb.Message.getFieldWithDefault(msg, 9, ""),
-    reviewsList: jspb.Message.toObjectList(msg.getReviewsList(),
-    proto.ag.Review.toObject, includeInstance),
-    buildinfo: (f = msg.getBuildinfo()) && kit_score_score_pb.BuildInfo.toObject(includeInstance, f),
-    scoresList: jspb.Message.toObjectList(msg.getScoresList(),
-    kit_score_score_pb.Score.toObject, includeInstance)
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    review: (f = msg.getReview()) && proto.ag.Review.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -5986,23 +13840,23 @@ proto.ag.Submission.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Submission}
+ * @return {!proto.ag.ReviewRequest}
  */
-proto.ag.Submission.deserializeBinary = function(bytes) {
+proto.ag.ReviewRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Submission;
-  return proto.ag.Submission.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.ReviewRequest;
+  return proto.ag.ReviewRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Submission} msg The message object to deserialize into.
+ * @param {!proto.ag.ReviewRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Submission}
+ * @return {!proto.ag.ReviewRequest}
  */
-proto.ag.Submission.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.ReviewRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6011,54 +13865,12 @@ proto.ag.Submission.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setId(value);
+      msg.setCourseid(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setAssignmentid(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setUserid(value);
-      break;
-    case 4:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setGroupid(value);
-      break;
-    case 5:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setScore(value);
-      break;
-    case 6:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setCommithash(value);
-      break;
-    case 7:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setReleased(value);
-      break;
-    case 8:
-      var value = /** @type {!proto.ag.Submission.Status} */ (reader.readEnum());
-      msg.setStatus(value);
-      break;
-    case 9:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setApproveddate(value);
-      break;
-    case 10:
       var value = new proto.ag.Review;
       reader.readMessage(value,proto.ag.Review.deserializeBinaryFromReader);
-      msg.addReviews(value);
-      break;
-    case 11:
-      var value = new kit_score_score_pb.BuildInfo;
-      reader.readMessage(value,kit_score_score_pb.BuildInfo.deserializeBinaryFromReader);
-      msg.setBuildinfo(value);
-      break;
-    case 12:
-      var value = new kit_score_score_pb.Score;
-      reader.readMessage(value,kit_score_score_pb.Score.deserializeBinaryFromReader);
-      msg.addScores(value);
+      msg.setReview(value);
       break;
     default:
       reader.skipField();
@@ -6073,9 +13885,9 @@ proto.ag.Submission.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.Submission.prototype.serializeBinary = function() {
+proto.ag.ReviewRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.Submission.serializeBinaryToWriter(this, writer);
+  proto.ag.ReviewRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6083,395 +13895,436 @@ proto.ag.Submission.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Submission} message
+ * @param {!proto.ag.ReviewRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Submission.serializeBinaryToWriter = function(message, writer) {
+proto.ag.ReviewRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getCourseid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getAssignmentid();
-  if (f !== 0) {
-    writer.writeUint64(
-      2,
-      f
-    );
-  }
-  f = message.getUserid();
-  if (f !== 0) {
-    writer.writeUint64(
-      3,
-      f
-    );
-  }
-  f = message.getGroupid();
-  if (f !== 0) {
-    writer.writeUint64(
-      4,
-      f
-    );
-  }
-  f = message.getScore();
-  if (f !== 0) {
-    writer.writeUint32(
-      5,
-      f
-    );
-  }
-  f = message.getCommithash();
-  if (f.length > 0) {
-    writer.writeString(
-      6,
-      f
-    );
-  }
-  f = message.getReleased();
-  if (f) {
-    writer.writeBool(
-      7,
-      f
-    );
-  }
-  f = message.getStatus();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      8,
-      f
-    );
-  }
-  f = message.getApproveddate();
-  if (f.length > 0) {
-    writer.writeString(
-      9,
-      f
-    );
-  }
-  f = message.getReviewsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      10,
-      f,
-      proto.ag.Review.serializeBinaryToWriter
-    );
-  }
-  f = message.getBuildinfo();
+  f = message.getReview();
   if (f != null) {
     writer.writeMessage(
-      11,
-      f,
-      kit_score_score_pb.BuildInfo.serializeBinaryToWriter
-    );
-  }
-  f = message.getScoresList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      12,
+      2,
       f,
-      kit_score_score_pb.Score.serializeBinaryToWriter
+      proto.ag.Review.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * @enum {number}
- */
-proto.ag.Submission.Status = {
-  NONE: 0,
-  APPROVED: 1,
-  REJECTED: 2,
-  REVISION: 3
-};
-
-/**
- * optional uint64 ID = 1;
+ * optional uint64 courseID = 1;
  * @return {number}
  */
-proto.ag.Submission.prototype.getId = function() {
+proto.ag.ReviewRequest.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Submission} returns this
+ * @return {!proto.ag.ReviewRequest} returns this
  */
-proto.ag.Submission.prototype.setId = function(value) {
+proto.ag.ReviewRequest.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 AssignmentID = 2;
- * @return {number}
- */
-proto.ag.Submission.prototype.getAssignmentid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.ag.Submission} returns this
+ * optional Review review = 2;
+ * @return {?proto.ag.Review}
  */
-proto.ag.Submission.prototype.setAssignmentid = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.ag.ReviewRequest.prototype.getReview = function() {
+  return /** @type{?proto.ag.Review} */ (
+    jspb.Message.getWrapperField(this, proto.ag.Review, 2));
 };
 
 
 /**
- * optional uint64 userID = 3;
- * @return {number}
- */
-proto.ag.Submission.prototype.getUserid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+ * @param {?proto.ag.Review|undefined} value
+ * @return {!proto.ag.ReviewRequest} returns this
+*/
+proto.ag.ReviewRequest.prototype.setReview = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.Submission} returns this
+ * Clears the message field making it undefined.
+ * @return {!proto.ag.ReviewRequest} returns this
  */
-proto.ag.Submission.prototype.setUserid = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.ag.ReviewRequest.prototype.clearReview = function() {
+  return this.setReview(undefined);
 };
 
 
 /**
- * optional uint64 groupID = 4;
- * @return {number}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.ag.Submission.prototype.getGroupid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.ag.ReviewRequest.prototype.hasReview = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
-/**
- * @param {number} value
- * @return {!proto.ag.Submission} returns this
- */
-proto.ag.Submission.prototype.setGroupid = function(value) {
-  return jspb.Message.setProto3IntField(this, 4, value);
-};
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional uint32 score = 5;
- * @return {number}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.ag.Submission.prototype.getScore = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.ag.CourseRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.CourseRequest.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.Submission} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.CourseRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Submission.prototype.setScore = function(value) {
-  return jspb.Message.setProto3IntField(this, 5, value);
+proto.ag.CourseRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional string commitHash = 6;
- * @return {string}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.CourseRequest}
  */
-proto.ag.Submission.prototype.getCommithash = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+proto.ag.CourseRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.CourseRequest;
+  return proto.ag.CourseRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ag.Submission} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.CourseRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.CourseRequest}
  */
-proto.ag.Submission.prototype.setCommithash = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
+proto.ag.CourseRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional bool released = 7;
- * @return {boolean}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.ag.Submission.prototype.getReleased = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 7, false));
+proto.ag.CourseRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.CourseRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.ag.Submission} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.CourseRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Submission.prototype.setReleased = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 7, value);
+proto.ag.CourseRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * optional Status status = 8;
- * @return {!proto.ag.Submission.Status}
+ * optional uint64 courseID = 1;
+ * @return {number}
  */
-proto.ag.Submission.prototype.getStatus = function() {
-  return /** @type {!proto.ag.Submission.Status} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+proto.ag.CourseRequest.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {!proto.ag.Submission.Status} value
- * @return {!proto.ag.Submission} returns this
+ * @param {number} value
+ * @return {!proto.ag.CourseRequest} returns this
  */
-proto.ag.Submission.prototype.setStatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 8, value);
+proto.ag.CourseRequest.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional string approvedDate = 9;
- * @return {string}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.ag.Submission.prototype.getApproveddate = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+proto.ag.BuildLogRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.BuildLogRequest.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ag.Submission} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.BuildLogRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Submission.prototype.setApproveddate = function(value) {
-  return jspb.Message.setProto3StringField(this, 9, value);
+proto.ag.BuildLogRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    userid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    groupid: jspb.Message.getFieldWithDefault(msg, 4, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * repeated Review reviews = 10;
- * @return {!Array<!proto.ag.Review>}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.BuildLogRequest}
  */
-proto.ag.Submission.prototype.getReviewsList = function() {
-  return /** @type{!Array<!proto.ag.Review>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.Review, 10));
+proto.ag.BuildLogRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.BuildLogRequest;
+  return proto.ag.BuildLogRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {!Array<!proto.ag.Review>} value
- * @return {!proto.ag.Submission} returns this
-*/
-proto.ag.Submission.prototype.setReviewsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 10, value);
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.BuildLogRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.BuildLogRequest}
+ */
+proto.ag.BuildLogRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setUserid(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setGroupid(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {!proto.ag.Review=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ag.Review}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.ag.Submission.prototype.addReviews = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 10, opt_value, proto.ag.Review, opt_index);
+proto.ag.BuildLogRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.BuildLogRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ag.Submission} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.BuildLogRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Submission.prototype.clearReviewsList = function() {
-  return this.setReviewsList([]);
+proto.ag.BuildLogRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getUserid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getGroupid();
+  if (f !== 0) {
+    writer.writeUint64(
+      4,
+      f
+    );
+  }
 };
 
 
 /**
- * optional score.BuildInfo BuildInfo = 11;
- * @return {?proto.score.BuildInfo}
+ * optional uint64 courseID = 1;
+ * @return {number}
  */
-proto.ag.Submission.prototype.getBuildinfo = function() {
-  return /** @type{?proto.score.BuildInfo} */ (
-    jspb.Message.getWrapperField(this, kit_score_score_pb.BuildInfo, 11));
+proto.ag.BuildLogRequest.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {?proto.score.BuildInfo|undefined} value
- * @return {!proto.ag.Submission} returns this
-*/
-proto.ag.Submission.prototype.setBuildinfo = function(value) {
-  return jspb.Message.setWrapperField(this, 11, value);
+ * @param {number} value
+ * @return {!proto.ag.BuildLogRequest} returns this
+ */
+proto.ag.BuildLogRequest.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.ag.Submission} returns this
+ * optional uint64 assignmentID = 2;
+ * @return {number}
  */
-proto.ag.Submission.prototype.clearBuildinfo = function() {
-  return this.setBuildinfo(undefined);
+proto.ag.BuildLogRequest.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.ag.BuildLogRequest} returns this
  */
-proto.ag.Submission.prototype.hasBuildinfo = function() {
-  return jspb.Message.getField(this, 11) != null;
+proto.ag.BuildLogRequest.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * repeated score.Score Scores = 12;
- * @return {!Array<!proto.score.Score>}
+ * optional uint64 userID = 3;
+ * @return {number}
  */
-proto.ag.Submission.prototype.getScoresList = function() {
-  return /** @type{!Array<!proto.score.Score>} */ (
-    jspb.Message.getRepeatedWrapperField(this, kit_score_score_pb.Score, 12));
+proto.ag.BuildLogRequest.prototype.getUserid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * @param {!Array<!proto.score.Score>} value
- * @return {!proto.ag.Submission} returns this
-*/
-proto.ag.Submission.prototype.setScoresList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 12, value);
+ * @param {number} value
+ * @return {!proto.ag.BuildLogRequest} returns this
+ */
+proto.ag.BuildLogRequest.prototype.setUserid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * @param {!proto.score.Score=} opt_value
- * @param {number=} opt_index
- * @return {!proto.score.Score}
+ * optional uint64 groupID = 4;
+ * @return {number}
  */
-proto.ag.Submission.prototype.addScores = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 12, opt_value, proto.score.Score, opt_index);
+proto.ag.BuildLogRequest.prototype.getGroupid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ag.Submission} returns this
+ * @param {number} value
+ * @return {!proto.ag.BuildLogRequest} returns this
  */
-proto.ag.Submission.prototype.clearScoresList = function() {
-  return this.setScoresList([]);
+proto.ag.BuildLogRequest.prototype.setGroupid = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.ag.Submissions.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -6487,8 +14340,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Submissions.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Submissions.toObject(opt_includeInstance, this);
+proto.ag.BuildLogChunk.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.BuildLogChunk.toObject(opt_includeInstance, this);
 };
 
 
@@ -6497,14 +14350,13 @@ proto.ag.Submissions.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Submissions} msg The msg instance to transform.
+ * @param {!proto.ag.BuildLogChunk} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Submissions.toObject = function(includeInstance, msg) {
+proto.ag.BuildLogChunk.toObject = function(includeInstance, msg) {
   var f, obj = {
-    submissionsList: jspb.Message.toObjectList(msg.getSubmissionsList(),
-    proto.ag.Submission.toObject, includeInstance)
+    log: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -6518,23 +14370,23 @@ proto.ag.Submissions.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Submissions}
+ * @return {!proto.ag.BuildLogChunk}
  */
-proto.ag.Submissions.deserializeBinary = function(bytes) {
+proto.ag.BuildLogChunk.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Submissions;
-  return proto.ag.Submissions.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.BuildLogChunk;
+  return proto.ag.BuildLogChunk.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Submissions} msg The message object to deserialize into.
+ * @param {!proto.ag.BuildLogChunk} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Submissions}
+ * @return {!proto.ag.BuildLogChunk}
  */
-proto.ag.Submissions.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.BuildLogChunk.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6542,9 +14394,8 @@ proto.ag.Submissions.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.ag.Submission;
-      reader.readMessage(value,proto.ag.Submission.deserializeBinaryFromReader);
-      msg.addSubmissions(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setLog(value);
       break;
     default:
       reader.skipField();
@@ -6559,9 +14410,9 @@ proto.ag.Submissions.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.Submissions.prototype.serializeBinary = function() {
+proto.ag.BuildLogChunk.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.Submissions.serializeBinaryToWriter(this, writer);
+  proto.ag.BuildLogChunk.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6569,69 +14420,41 @@ proto.ag.Submissions.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Submissions} message
+ * @param {!proto.ag.BuildLogChunk} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Submissions.serializeBinaryToWriter = function(message, writer) {
+proto.ag.BuildLogChunk.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSubmissionsList();
+  f = message.getLog();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       1,
-      f,
-      proto.ag.Submission.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * repeated Submission submissions = 1;
- * @return {!Array<!proto.ag.Submission>}
- */
-proto.ag.Submissions.prototype.getSubmissionsList = function() {
-  return /** @type{!Array<!proto.ag.Submission>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.Submission, 1));
-};
-
-
-/**
- * @param {!Array<!proto.ag.Submission>} value
- * @return {!proto.ag.Submissions} returns this
-*/
-proto.ag.Submissions.prototype.setSubmissionsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
-};
-
-
-/**
- * @param {!proto.ag.Submission=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ag.Submission}
+ * optional string log = 1;
+ * @return {string}
  */
-proto.ag.Submissions.prototype.addSubmissions = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.Submission, opt_index);
+proto.ag.BuildLogChunk.prototype.getLog = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ag.Submissions} returns this
+ * @param {string} value
+ * @return {!proto.ag.BuildLogChunk} returns this
  */
-proto.ag.Submissions.prototype.clearSubmissionsList = function() {
-  return this.setSubmissionsList([]);
+proto.ag.BuildLogChunk.prototype.setLog = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.ag.GradingBenchmark.repeatedFields_ = [6];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -6647,8 +14470,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.GradingBenchmark.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.GradingBenchmark.toObject(opt_includeInstance, this);
+proto.ag.RegradeProgress.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.RegradeProgress.toObject(opt_includeInstance, this);
 };
 
 
@@ -6657,19 +14480,17 @@ proto.ag.GradingBenchmark.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.GradingBenchmark} msg The msg instance to transform.
+ * @param {!proto.ag.RegradeProgress} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.GradingBenchmark.toObject = function(includeInstance, msg) {
+proto.ag.RegradeProgress.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    reviewid: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    heading: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    comment: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    criteriaList: jspb.Message.toObjectList(msg.getCriteriaList(),
-    proto.ag.GradingCriterion.toObject, includeInstance)
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    triggeredby: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    total: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    completed: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    failed: jspb.Message.getFieldWithDefault(msg, 5, 0)
   };
 
   if (includeInstance) {
@@ -6683,23 +14504,23 @@ proto.ag.GradingBenchmark.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.GradingBenchmark}
+ * @return {!proto.ag.RegradeProgress}
  */
-proto.ag.GradingBenchmark.deserializeBinary = function(bytes) {
+proto.ag.RegradeProgress.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.GradingBenchmark;
-  return proto.ag.GradingBenchmark.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.RegradeProgress;
+  return proto.ag.RegradeProgress.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.GradingBenchmark} msg The message object to deserialize into.
+ * @param {!proto.ag.RegradeProgress} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.GradingBenchmark}
+ * @return {!proto.ag.RegradeProgress}
  */
-proto.ag.GradingBenchmark.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.RegradeProgress.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6708,28 +14529,23 @@ proto.ag.GradingBenchmark.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setId(value);
+      msg.setAssignmentid(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setAssignmentid(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTriggeredby(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setReviewid(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setTotal(value);
       break;
     case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setHeading(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setCompleted(value);
       break;
     case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setComment(value);
-      break;
-    case 6:
-      var value = new proto.ag.GradingCriterion;
-      reader.readMessage(value,proto.ag.GradingCriterion.deserializeBinaryFromReader);
-      msg.addCriteria(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setFailed(value);
       break;
     default:
       reader.skipField();
@@ -6744,9 +14560,9 @@ proto.ag.GradingBenchmark.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.GradingBenchmark.prototype.serializeBinary = function() {
+proto.ag.RegradeProgress.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.GradingBenchmark.serializeBinaryToWriter(this, writer);
+  proto.ag.RegradeProgress.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6754,194 +14570,141 @@ proto.ag.GradingBenchmark.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.GradingBenchmark} message
+ * @param {!proto.ag.RegradeProgress} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.GradingBenchmark.serializeBinaryToWriter = function(message, writer) {
+proto.ag.RegradeProgress.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getAssignmentid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getAssignmentid();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getTriggeredby();
+  if (f.length > 0) {
+    writer.writeString(
       2,
       f
     );
   }
-  f = message.getReviewid();
+  f = message.getTotal();
   if (f !== 0) {
-    writer.writeUint64(
+    writer.writeUint32(
       3,
       f
     );
   }
-  f = message.getHeading();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getCompleted();
+  if (f !== 0) {
+    writer.writeUint32(
       4,
       f
     );
   }
-  f = message.getComment();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getFailed();
+  if (f !== 0) {
+    writer.writeUint32(
       5,
       f
     );
   }
-  f = message.getCriteriaList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      6,
-      f,
-      proto.ag.GradingCriterion.serializeBinaryToWriter
-    );
-  }
 };
 
 
 /**
- * optional uint64 ID = 1;
+ * optional uint64 assignmentID = 1;
  * @return {number}
  */
-proto.ag.GradingBenchmark.prototype.getId = function() {
+proto.ag.RegradeProgress.prototype.getAssignmentid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.GradingBenchmark} returns this
+ * @return {!proto.ag.RegradeProgress} returns this
  */
-proto.ag.GradingBenchmark.prototype.setId = function(value) {
+proto.ag.RegradeProgress.prototype.setAssignmentid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 AssignmentID = 2;
- * @return {number}
- */
-proto.ag.GradingBenchmark.prototype.getAssignmentid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.ag.GradingBenchmark} returns this
- */
-proto.ag.GradingBenchmark.prototype.setAssignmentid = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
-};
-
-
-/**
- * optional uint64 ReviewID = 3;
- * @return {number}
- */
-proto.ag.GradingBenchmark.prototype.getReviewid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.ag.GradingBenchmark} returns this
- */
-proto.ag.GradingBenchmark.prototype.setReviewid = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
-};
-
-
-/**
- * optional string heading = 4;
+ * optional string triggeredBy = 2;
  * @return {string}
  */
-proto.ag.GradingBenchmark.prototype.getHeading = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.ag.RegradeProgress.prototype.getTriggeredby = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.ag.GradingBenchmark} returns this
+ * @return {!proto.ag.RegradeProgress} returns this
  */
-proto.ag.GradingBenchmark.prototype.setHeading = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.ag.RegradeProgress.prototype.setTriggeredby = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional string comment = 5;
- * @return {string}
+ * optional uint32 total = 3;
+ * @return {number}
  */
-proto.ag.GradingBenchmark.prototype.getComment = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+proto.ag.RegradeProgress.prototype.getTotal = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ag.GradingBenchmark} returns this
+ * @param {number} value
+ * @return {!proto.ag.RegradeProgress} returns this
  */
-proto.ag.GradingBenchmark.prototype.setComment = function(value) {
-  return jspb.Message.setProto3StringField(this, 5, value);
+proto.ag.RegradeProgress.prototype.setTotal = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * repeated GradingCriterion criteria = 6;
- * @return {!Array<!proto.ag.GradingCriterion>}
+ * optional uint32 completed = 4;
+ * @return {number}
  */
-proto.ag.GradingBenchmark.prototype.getCriteriaList = function() {
-  return /** @type{!Array<!proto.ag.GradingCriterion>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.GradingCriterion, 6));
+proto.ag.RegradeProgress.prototype.getCompleted = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * @param {!Array<!proto.ag.GradingCriterion>} value
- * @return {!proto.ag.GradingBenchmark} returns this
-*/
-proto.ag.GradingBenchmark.prototype.setCriteriaList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 6, value);
+ * @param {number} value
+ * @return {!proto.ag.RegradeProgress} returns this
+ */
+proto.ag.RegradeProgress.prototype.setCompleted = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 /**
- * @param {!proto.ag.GradingCriterion=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ag.GradingCriterion}
+ * optional uint32 failed = 5;
+ * @return {number}
  */
-proto.ag.GradingBenchmark.prototype.addCriteria = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 6, opt_value, proto.ag.GradingCriterion, opt_index);
+proto.ag.RegradeProgress.prototype.getFailed = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ag.GradingBenchmark} returns this
+ * @param {number} value
+ * @return {!proto.ag.RegradeProgress} returns this
  */
-proto.ag.GradingBenchmark.prototype.clearCriteriaList = function() {
-  return this.setCriteriaList([]);
+proto.ag.RegradeProgress.prototype.setFailed = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.ag.Benchmarks.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -6957,8 +14720,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Benchmarks.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Benchmarks.toObject(opt_includeInstance, this);
+proto.ag.SubmissionQueue.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.SubmissionQueue.toObject(opt_includeInstance, this);
 };
 
 
@@ -6967,14 +14730,15 @@ proto.ag.Benchmarks.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Benchmarks} msg The msg instance to transform.
+ * @param {!proto.ag.SubmissionQueue} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Benchmarks.toObject = function(includeInstance, msg) {
+proto.ag.SubmissionQueue.toObject = function(includeInstance, msg) {
   var f, obj = {
-    benchmarksList: jspb.Message.toObjectList(msg.getBenchmarksList(),
-    proto.ag.GradingBenchmark.toObject, includeInstance)
+    pending: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    running: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    position: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -6988,23 +14752,23 @@ proto.ag.Benchmarks.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Benchmarks}
+ * @return {!proto.ag.SubmissionQueue}
  */
-proto.ag.Benchmarks.deserializeBinary = function(bytes) {
+proto.ag.SubmissionQueue.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Benchmarks;
-  return proto.ag.Benchmarks.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.SubmissionQueue;
+  return proto.ag.SubmissionQueue.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Benchmarks} msg The message object to deserialize into.
+ * @param {!proto.ag.SubmissionQueue} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Benchmarks}
+ * @return {!proto.ag.SubmissionQueue}
  */
-proto.ag.Benchmarks.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.SubmissionQueue.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7012,9 +14776,16 @@ proto.ag.Benchmarks.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.ag.GradingBenchmark;
-      reader.readMessage(value,proto.ag.GradingBenchmark.deserializeBinaryFromReader);
-      msg.addBenchmarks(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setPending(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRunning(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setPosition(value);
       break;
     default:
       reader.skipField();
@@ -7029,9 +14800,9 @@ proto.ag.Benchmarks.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.Benchmarks.prototype.serializeBinary = function() {
+proto.ag.SubmissionQueue.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.Benchmarks.serializeBinaryToWriter(this, writer);
+  proto.ag.SubmissionQueue.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7039,58 +14810,87 @@ proto.ag.Benchmarks.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Benchmarks} message
+ * @param {!proto.ag.SubmissionQueue} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Benchmarks.serializeBinaryToWriter = function(message, writer) {
+proto.ag.SubmissionQueue.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getBenchmarksList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
+  f = message.getPending();
+  if (f !== 0) {
+    writer.writeUint32(
       1,
-      f,
-      proto.ag.GradingBenchmark.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getRunning();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+  f = message.getPosition();
+  if (f !== 0) {
+    writer.writeUint32(
+      3,
+      f
     );
   }
 };
 
 
 /**
- * repeated GradingBenchmark benchmarks = 1;
- * @return {!Array<!proto.ag.GradingBenchmark>}
+ * optional uint32 pending = 1;
+ * @return {number}
  */
-proto.ag.Benchmarks.prototype.getBenchmarksList = function() {
-  return /** @type{!Array<!proto.ag.GradingBenchmark>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.GradingBenchmark, 1));
+proto.ag.SubmissionQueue.prototype.getPending = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {!Array<!proto.ag.GradingBenchmark>} value
- * @return {!proto.ag.Benchmarks} returns this
-*/
-proto.ag.Benchmarks.prototype.setBenchmarksList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {number} value
+ * @return {!proto.ag.SubmissionQueue} returns this
+ */
+proto.ag.SubmissionQueue.prototype.setPending = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.ag.GradingBenchmark=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ag.GradingBenchmark}
+ * optional uint32 running = 2;
+ * @return {number}
  */
-proto.ag.Benchmarks.prototype.addBenchmarks = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.GradingBenchmark, opt_index);
+proto.ag.SubmissionQueue.prototype.getRunning = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ag.Benchmarks} returns this
+ * @param {number} value
+ * @return {!proto.ag.SubmissionQueue} returns this
  */
-proto.ag.Benchmarks.prototype.clearBenchmarksList = function() {
-  return this.setBenchmarksList([]);
+proto.ag.SubmissionQueue.prototype.setRunning = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint32 position = 3;
+ * @return {number}
+ */
+proto.ag.SubmissionQueue.prototype.getPosition = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.SubmissionQueue} returns this
+ */
+proto.ag.SubmissionQueue.prototype.setPosition = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
@@ -7110,8 +14910,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.GradingCriterion.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.GradingCriterion.toObject(opt_includeInstance, this);
+proto.ag.UserRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.UserRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -7120,18 +14920,13 @@ proto.ag.GradingCriterion.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.GradingCriterion} msg The msg instance to transform.
+ * @param {!proto.ag.UserRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.GradingCriterion.toObject = function(includeInstance, msg) {
+proto.ag.UserRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    benchmarkid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    points: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    description: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    grade: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    comment: jspb.Message.getFieldWithDefault(msg, 6, "")
+    userid: jspb.Message.getFieldWithDefault(msg, 1, 0)
   };
 
   if (includeInstance) {
@@ -7145,23 +14940,23 @@ proto.ag.GradingCriterion.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.GradingCriterion}
+ * @return {!proto.ag.UserRequest}
  */
-proto.ag.GradingCriterion.deserializeBinary = function(bytes) {
+proto.ag.UserRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.GradingCriterion;
-  return proto.ag.GradingCriterion.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.UserRequest;
+  return proto.ag.UserRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.GradingCriterion} msg The message object to deserialize into.
+ * @param {!proto.ag.UserRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.GradingCriterion}
+ * @return {!proto.ag.UserRequest}
  */
-proto.ag.GradingCriterion.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.UserRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7170,27 +14965,7 @@ proto.ag.GradingCriterion.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setId(value);
-      break;
-    case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setBenchmarkid(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setPoints(value);
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setDescription(value);
-      break;
-    case 5:
-      var value = /** @type {!proto.ag.GradingCriterion.Grade} */ (reader.readEnum());
-      msg.setGrade(value);
-      break;
-    case 6:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setComment(value);
+      msg.setUserid(value);
       break;
     default:
       reader.skipField();
@@ -7205,9 +14980,9 @@ proto.ag.GradingCriterion.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.GradingCriterion.prototype.serializeBinary = function() {
+proto.ag.UserRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.GradingCriterion.serializeBinaryToWriter(this, writer);
+  proto.ag.UserRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7215,182 +14990,171 @@ proto.ag.GradingCriterion.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.GradingCriterion} message
+ * @param {!proto.ag.UserRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.GradingCriterion.serializeBinaryToWriter = function(message, writer) {
+proto.ag.UserRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getUserid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
-    );
-  }
-  f = message.getBenchmarkid();
-  if (f !== 0) {
-    writer.writeUint64(
-      2,
-      f
-    );
-  }
-  f = message.getPoints();
-  if (f !== 0) {
-    writer.writeUint64(
-      3,
-      f
-    );
-  }
-  f = message.getDescription();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getGrade();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      5,
-      f
-    );
-  }
-  f = message.getComment();
-  if (f.length > 0) {
-    writer.writeString(
-      6,
-      f
-    );
-  }
-};
-
-
-/**
- * @enum {number}
- */
-proto.ag.GradingCriterion.Grade = {
-  NONE: 0,
-  FAILED: 1,
-  PASSED: 2
-};
-
-/**
- * optional uint64 ID = 1;
- * @return {number}
- */
-proto.ag.GradingCriterion.prototype.getId = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.ag.GradingCriterion} returns this
- */
-proto.ag.GradingCriterion.prototype.setId = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+    );
+  }
 };
 
 
 /**
- * optional uint64 BenchmarkID = 2;
+ * optional uint64 userID = 1;
  * @return {number}
  */
-proto.ag.GradingCriterion.prototype.getBenchmarkid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.ag.UserRequest.prototype.getUserid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.GradingCriterion} returns this
+ * @return {!proto.ag.UserRequest} returns this
  */
-proto.ag.GradingCriterion.prototype.setBenchmarkid = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.ag.UserRequest.prototype.setUserid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional uint64 points = 3;
- * @return {number}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.ag.GradingCriterion.prototype.getPoints = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.ag.GetGroupRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.GetGroupRequest.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.GradingCriterion} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.GetGroupRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.GradingCriterion.prototype.setPoints = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.ag.GetGroupRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    groupid: jspb.Message.getFieldWithDefault(msg, 1, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional string description = 4;
- * @return {string}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.GetGroupRequest}
  */
-proto.ag.GradingCriterion.prototype.getDescription = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.ag.GetGroupRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.GetGroupRequest;
+  return proto.ag.GetGroupRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ag.GradingCriterion} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.GetGroupRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.GetGroupRequest}
  */
-proto.ag.GradingCriterion.prototype.setDescription = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.ag.GetGroupRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setGroupid(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional Grade grade = 5;
- * @return {!proto.ag.GradingCriterion.Grade}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.ag.GradingCriterion.prototype.getGrade = function() {
-  return /** @type {!proto.ag.GradingCriterion.Grade} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.ag.GetGroupRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.GetGroupRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {!proto.ag.GradingCriterion.Grade} value
- * @return {!proto.ag.GradingCriterion} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.GetGroupRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.GradingCriterion.prototype.setGrade = function(value) {
-  return jspb.Message.setProto3EnumField(this, 5, value);
+proto.ag.GetGroupRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getGroupid();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string comment = 6;
- * @return {string}
+ * optional uint64 groupID = 1;
+ * @return {number}
  */
-proto.ag.GradingCriterion.prototype.getComment = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+proto.ag.GetGroupRequest.prototype.getGroupid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ag.GradingCriterion} returns this
+ * @param {number} value
+ * @return {!proto.ag.GetGroupRequest} returns this
  */
-proto.ag.GradingCriterion.prototype.setComment = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
+proto.ag.GetGroupRequest.prototype.setGroupid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.ag.Review.repeatedFields_ = [7];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -7406,8 +15170,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Review.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Review.toObject(opt_includeInstance, this);
+proto.ag.GroupRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.GroupRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -7416,21 +15180,15 @@ proto.ag.Review.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Review} msg The msg instance to transform.
+ * @param {!proto.ag.GroupRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Review.toObject = function(includeInstance, msg) {
+proto.ag.GroupRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    submissionid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    reviewerid: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    feedback: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    ready: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
-    score: jspb.Message.getFieldWithDefault(msg, 6, 0),
-    gradingbenchmarksList: jspb.Message.toObjectList(msg.getGradingbenchmarksList(),
-    proto.ag.GradingBenchmark.toObject, includeInstance),
-    edited: jspb.Message.getFieldWithDefault(msg, 8, "")
+    userid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    groupid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    courseid: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -7444,23 +15202,23 @@ proto.ag.Review.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Review}
+ * @return {!proto.ag.GroupRequest}
  */
-proto.ag.Review.deserializeBinary = function(bytes) {
+proto.ag.GroupRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Review;
-  return proto.ag.Review.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.GroupRequest;
+  return proto.ag.GroupRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Review} msg The message object to deserialize into.
+ * @param {!proto.ag.GroupRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Review}
+ * @return {!proto.ag.GroupRequest}
  */
-proto.ag.Review.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.GroupRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7469,36 +15227,15 @@ proto.ag.Review.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setId(value);
+      msg.setUserid(value);
       break;
     case 2:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setSubmissionid(value);
+      msg.setGroupid(value);
       break;
     case 3:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setReviewerid(value);
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setFeedback(value);
-      break;
-    case 5:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setReady(value);
-      break;
-    case 6:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setScore(value);
-      break;
-    case 7:
-      var value = new proto.ag.GradingBenchmark;
-      reader.readMessage(value,proto.ag.GradingBenchmark.deserializeBinaryFromReader);
-      msg.addGradingbenchmarks(value);
-      break;
-    case 8:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setEdited(value);
+      msg.setCourseid(value);
       break;
     default:
       reader.skipField();
@@ -7513,9 +15250,9 @@ proto.ag.Review.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.Review.prototype.serializeBinary = function() {
+proto.ag.GroupRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.Review.serializeBinaryToWriter(this, writer);
+  proto.ag.GroupRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7523,66 +15260,30 @@ proto.ag.Review.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Review} message
+ * @param {!proto.ag.GroupRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Review.serializeBinaryToWriter = function(message, writer) {
+proto.ag.GroupRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getUserid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getSubmissionid();
+  f = message.getGroupid();
   if (f !== 0) {
     writer.writeUint64(
       2,
       f
     );
   }
-  f = message.getReviewerid();
-  if (f !== 0) {
-    writer.writeUint64(
-      3,
-      f
-    );
-  }
-  f = message.getFeedback();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getReady();
-  if (f) {
-    writer.writeBool(
-      5,
-      f
-    );
-  }
-  f = message.getScore();
+  f = message.getCourseid();
   if (f !== 0) {
-    writer.writeUint32(
-      6,
-      f
-    );
-  }
-  f = message.getGradingbenchmarksList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      7,
-      f,
-      proto.ag.GradingBenchmark.serializeBinaryToWriter
-    );
-  }
-  f = message.getEdited();
-  if (f.length > 0) {
-    writer.writeString(
-      8,
+    writer.writeUint64(
+      3,
       f
     );
   }
@@ -7590,177 +15291,190 @@ proto.ag.Review.serializeBinaryToWriter = function(message, writer) {
 
 
 /**
- * optional uint64 ID = 1;
+ * optional uint64 userID = 1;
  * @return {number}
  */
-proto.ag.Review.prototype.getId = function() {
+proto.ag.GroupRequest.prototype.getUserid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Review} returns this
+ * @return {!proto.ag.GroupRequest} returns this
  */
-proto.ag.Review.prototype.setId = function(value) {
+proto.ag.GroupRequest.prototype.setUserid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 SubmissionID = 2;
+ * optional uint64 groupID = 2;
  * @return {number}
  */
-proto.ag.Review.prototype.getSubmissionid = function() {
+proto.ag.GroupRequest.prototype.getGroupid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Review} returns this
+ * @return {!proto.ag.GroupRequest} returns this
  */
-proto.ag.Review.prototype.setSubmissionid = function(value) {
+proto.ag.GroupRequest.prototype.setGroupid = function(value) {
   return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional uint64 ReviewerID = 3;
+ * optional uint64 courseID = 3;
  * @return {number}
  */
-proto.ag.Review.prototype.getReviewerid = function() {
+proto.ag.GroupRequest.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Review} returns this
+ * @return {!proto.ag.GroupRequest} returns this
  */
-proto.ag.Review.prototype.setReviewerid = function(value) {
+proto.ag.GroupRequest.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
-/**
- * optional string feedback = 4;
- * @return {string}
- */
-proto.ag.Review.prototype.getFeedback = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
-};
-
 
-/**
- * @param {string} value
- * @return {!proto.ag.Review} returns this
- */
-proto.ag.Review.prototype.setFeedback = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional bool ready = 5;
- * @return {boolean}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.ag.Review.prototype.getReady = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+proto.ag.Provider.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Provider.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.ag.Review} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.Provider} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Review.prototype.setReady = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 5, value);
-};
-
+proto.ag.Provider.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    provider: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
 
-/**
- * optional uint32 score = 6;
- * @return {number}
- */
-proto.ag.Review.prototype.getScore = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.Review} returns this
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.Provider}
  */
-proto.ag.Review.prototype.setScore = function(value) {
-  return jspb.Message.setProto3IntField(this, 6, value);
+proto.ag.Provider.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.Provider;
+  return proto.ag.Provider.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * repeated GradingBenchmark gradingBenchmarks = 7;
- * @return {!Array<!proto.ag.GradingBenchmark>}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.Provider} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.Provider}
  */
-proto.ag.Review.prototype.getGradingbenchmarksList = function() {
-  return /** @type{!Array<!proto.ag.GradingBenchmark>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.GradingBenchmark, 7));
-};
-
-
-/**
- * @param {!Array<!proto.ag.GradingBenchmark>} value
- * @return {!proto.ag.Review} returns this
-*/
-proto.ag.Review.prototype.setGradingbenchmarksList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 7, value);
+proto.ag.Provider.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setProvider(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {!proto.ag.GradingBenchmark=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ag.GradingBenchmark}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.ag.Review.prototype.addGradingbenchmarks = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 7, opt_value, proto.ag.GradingBenchmark, opt_index);
+proto.ag.Provider.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.Provider.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ag.Review} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.Provider} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Review.prototype.clearGradingbenchmarksList = function() {
-  return this.setGradingbenchmarksList([]);
+proto.ag.Provider.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getProvider();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string edited = 8;
+ * optional string provider = 1;
  * @return {string}
  */
-proto.ag.Review.prototype.getEdited = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+proto.ag.Provider.prototype.getProvider = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.ag.Review} returns this
+ * @return {!proto.ag.Provider} returns this
  */
-proto.ag.Review.prototype.setEdited = function(value) {
-  return jspb.Message.setProto3StringField(this, 8, value);
+proto.ag.Provider.prototype.setProvider = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.ag.Reviewers.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -7776,8 +15490,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Reviewers.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Reviewers.toObject(opt_includeInstance, this);
+proto.ag.OrgRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.OrgRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -7786,14 +15500,13 @@ proto.ag.Reviewers.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Reviewers} msg The msg instance to transform.
+ * @param {!proto.ag.OrgRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Reviewers.toObject = function(includeInstance, msg) {
+proto.ag.OrgRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    reviewersList: jspb.Message.toObjectList(msg.getReviewersList(),
-    proto.ag.User.toObject, includeInstance)
+    orgname: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -7807,23 +15520,23 @@ proto.ag.Reviewers.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Reviewers}
+ * @return {!proto.ag.OrgRequest}
  */
-proto.ag.Reviewers.deserializeBinary = function(bytes) {
+proto.ag.OrgRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Reviewers;
-  return proto.ag.Reviewers.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.OrgRequest;
+  return proto.ag.OrgRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Reviewers} msg The message object to deserialize into.
+ * @param {!proto.ag.OrgRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Reviewers}
+ * @return {!proto.ag.OrgRequest}
  */
-proto.ag.Reviewers.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.OrgRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7831,9 +15544,8 @@ proto.ag.Reviewers.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.ag.User;
-      reader.readMessage(value,proto.ag.User.deserializeBinaryFromReader);
-      msg.addReviewers(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOrgname(value);
       break;
     default:
       reader.skipField();
@@ -7848,9 +15560,9 @@ proto.ag.Reviewers.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.Reviewers.prototype.serializeBinary = function() {
+proto.ag.OrgRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.Reviewers.serializeBinaryToWriter(this, writer);
+  proto.ag.OrgRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7858,58 +15570,37 @@ proto.ag.Reviewers.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Reviewers} message
+ * @param {!proto.ag.OrgRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Reviewers.serializeBinaryToWriter = function(message, writer) {
+proto.ag.OrgRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getReviewersList();
+  f = message.getOrgname();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       1,
-      f,
-      proto.ag.User.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * repeated User reviewers = 1;
- * @return {!Array<!proto.ag.User>}
- */
-proto.ag.Reviewers.prototype.getReviewersList = function() {
-  return /** @type{!Array<!proto.ag.User>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.User, 1));
-};
-
-
-/**
- * @param {!Array<!proto.ag.User>} value
- * @return {!proto.ag.Reviewers} returns this
-*/
-proto.ag.Reviewers.prototype.setReviewersList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
-};
-
-
-/**
- * @param {!proto.ag.User=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ag.User}
+ * optional string orgName = 1;
+ * @return {string}
  */
-proto.ag.Reviewers.prototype.addReviewers = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.User, opt_index);
+proto.ag.OrgRequest.prototype.getOrgname = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ag.Reviewers} returns this
+ * @param {string} value
+ * @return {!proto.ag.OrgRequest} returns this
  */
-proto.ag.Reviewers.prototype.clearReviewersList = function() {
-  return this.setReviewersList([]);
+proto.ag.OrgRequest.prototype.setOrgname = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -7929,8 +15620,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.ReviewRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.ReviewRequest.toObject(opt_includeInstance, this);
+proto.ag.Organization.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Organization.toObject(opt_includeInstance, this);
 };
 
 
@@ -7939,14 +15630,16 @@ proto.ag.ReviewRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.ReviewRequest} msg The msg instance to transform.
+ * @param {!proto.ag.Organization} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.ReviewRequest.toObject = function(includeInstance, msg) {
+proto.ag.Organization.toObject = function(includeInstance, msg) {
   var f, obj = {
-    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    review: (f = msg.getReview()) && proto.ag.Review.toObject(includeInstance, f)
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    path: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    avatar: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    paymentplan: jspb.Message.getFieldWithDefault(msg, 4, "")
   };
 
   if (includeInstance) {
@@ -7960,23 +15653,23 @@ proto.ag.ReviewRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.ReviewRequest}
+ * @return {!proto.ag.Organization}
  */
-proto.ag.ReviewRequest.deserializeBinary = function(bytes) {
+proto.ag.Organization.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.ReviewRequest;
-  return proto.ag.ReviewRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.Organization;
+  return proto.ag.Organization.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.ReviewRequest} msg The message object to deserialize into.
+ * @param {!proto.ag.Organization} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.ReviewRequest}
+ * @return {!proto.ag.Organization}
  */
-proto.ag.ReviewRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.Organization.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7985,12 +15678,19 @@ proto.ag.ReviewRequest.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
+      msg.setId(value);
       break;
     case 2:
-      var value = new proto.ag.Review;
-      reader.readMessage(value,proto.ag.Review.deserializeBinaryFromReader);
-      msg.setReview(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPath(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAvatar(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPaymentplan(value);
       break;
     default:
       reader.skipField();
@@ -8005,9 +15705,9 @@ proto.ag.ReviewRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.ReviewRequest.prototype.serializeBinary = function() {
+proto.ag.Organization.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.ReviewRequest.serializeBinaryToWriter(this, writer);
+  proto.ag.Organization.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8015,86 +15715,123 @@ proto.ag.ReviewRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.ReviewRequest} message
+ * @param {!proto.ag.Organization} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.ReviewRequest.serializeBinaryToWriter = function(message, writer) {
+proto.ag.Organization.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCourseid();
+  f = message.getId();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getReview();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getPath();
+  if (f.length > 0) {
+    writer.writeString(
       2,
-      f,
-      proto.ag.Review.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getAvatar();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getPaymentplan();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
     );
   }
 };
 
 
 /**
- * optional uint64 courseID = 1;
+ * optional uint64 ID = 1;
  * @return {number}
  */
-proto.ag.ReviewRequest.prototype.getCourseid = function() {
+proto.ag.Organization.prototype.getId = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.ReviewRequest} returns this
+ * @return {!proto.ag.Organization} returns this
  */
-proto.ag.ReviewRequest.prototype.setCourseid = function(value) {
+proto.ag.Organization.prototype.setId = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional Review review = 2;
- * @return {?proto.ag.Review}
+ * optional string path = 2;
+ * @return {string}
  */
-proto.ag.ReviewRequest.prototype.getReview = function() {
-  return /** @type{?proto.ag.Review} */ (
-    jspb.Message.getWrapperField(this, proto.ag.Review, 2));
+proto.ag.Organization.prototype.getPath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {?proto.ag.Review|undefined} value
- * @return {!proto.ag.ReviewRequest} returns this
-*/
-proto.ag.ReviewRequest.prototype.setReview = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
+ * @param {string} value
+ * @return {!proto.ag.Organization} returns this
+ */
+proto.ag.Organization.prototype.setPath = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.ag.ReviewRequest} returns this
+ * optional string avatar = 3;
+ * @return {string}
  */
-proto.ag.ReviewRequest.prototype.clearReview = function() {
-  return this.setReview(undefined);
+proto.ag.Organization.prototype.getAvatar = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.ag.Organization} returns this
  */
-proto.ag.ReviewRequest.prototype.hasReview = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.ag.Organization.prototype.setAvatar = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string paymentPlan = 4;
+ * @return {string}
+ */
+proto.ag.Organization.prototype.getPaymentplan = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Organization} returns this
+ */
+proto.ag.Organization.prototype.setPaymentplan = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.Organizations.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -8110,8 +15847,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.CourseRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.CourseRequest.toObject(opt_includeInstance, this);
+proto.ag.Organizations.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Organizations.toObject(opt_includeInstance, this);
 };
 
 
@@ -8120,13 +15857,14 @@ proto.ag.CourseRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.CourseRequest} msg The msg instance to transform.
+ * @param {!proto.ag.Organizations} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.CourseRequest.toObject = function(includeInstance, msg) {
+proto.ag.Organizations.toObject = function(includeInstance, msg) {
   var f, obj = {
-    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0)
+    organizationsList: jspb.Message.toObjectList(msg.getOrganizationsList(),
+    proto.ag.Organization.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -8140,23 +15878,23 @@ proto.ag.CourseRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.CourseRequest}
+ * @return {!proto.ag.Organizations}
  */
-proto.ag.CourseRequest.deserializeBinary = function(bytes) {
+proto.ag.Organizations.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.CourseRequest;
-  return proto.ag.CourseRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.Organizations;
+  return proto.ag.Organizations.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.CourseRequest} msg The message object to deserialize into.
+ * @param {!proto.ag.Organizations} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.CourseRequest}
+ * @return {!proto.ag.Organizations}
  */
-proto.ag.CourseRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.Organizations.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8164,8 +15902,9 @@ proto.ag.CourseRequest.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
+      var value = new proto.ag.Organization;
+      reader.readMessage(value,proto.ag.Organization.deserializeBinaryFromReader);
+      msg.addOrganizations(value);
       break;
     default:
       reader.skipField();
@@ -8180,9 +15919,9 @@ proto.ag.CourseRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.CourseRequest.prototype.serializeBinary = function() {
+proto.ag.Organizations.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.CourseRequest.serializeBinaryToWriter(this, writer);
+  proto.ag.Organizations.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8190,40 +15929,68 @@ proto.ag.CourseRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.CourseRequest} message
+ * @param {!proto.ag.Organizations} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.CourseRequest.serializeBinaryToWriter = function(message, writer) {
+proto.ag.Organizations.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCourseid();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getOrganizationsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
       1,
-      f
+      f,
+      proto.ag.Organization.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional uint64 courseID = 1;
- * @return {number}
+ * repeated Organization organizations = 1;
+ * @return {!Array<!proto.ag.Organization>}
  */
-proto.ag.CourseRequest.prototype.getCourseid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.ag.Organizations.prototype.getOrganizationsList = function() {
+  return /** @type{!Array<!proto.ag.Organization>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.Organization, 1));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.CourseRequest} returns this
+ * @param {!Array<!proto.ag.Organization>} value
+ * @return {!proto.ag.Organizations} returns this
+*/
+proto.ag.Organizations.prototype.setOrganizationsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.ag.Organization=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.Organization}
  */
-proto.ag.CourseRequest.prototype.setCourseid = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+proto.ag.Organizations.prototype.addOrganizations = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.Organization, opt_index);
 };
 
 
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.Organizations} returns this
+ */
+proto.ag.Organizations.prototype.clearOrganizationsList = function() {
+  return this.setOrganizationsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.EnrollmentRequest.repeatedFields_ = [4];
 
 
 
@@ -8240,8 +16007,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.UserRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.UserRequest.toObject(opt_includeInstance, this);
+proto.ag.EnrollmentRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.EnrollmentRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -8250,13 +16017,16 @@ proto.ag.UserRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.UserRequest} msg The msg instance to transform.
+ * @param {!proto.ag.EnrollmentRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.UserRequest.toObject = function(includeInstance, msg) {
+proto.ag.EnrollmentRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    userid: jspb.Message.getFieldWithDefault(msg, 1, 0)
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    ignoregroupmembers: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    withactivity: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    statusesList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -8270,23 +16040,23 @@ proto.ag.UserRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.UserRequest}
+ * @return {!proto.ag.EnrollmentRequest}
  */
-proto.ag.UserRequest.deserializeBinary = function(bytes) {
+proto.ag.EnrollmentRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.UserRequest;
-  return proto.ag.UserRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.EnrollmentRequest;
+  return proto.ag.EnrollmentRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.UserRequest} msg The message object to deserialize into.
+ * @param {!proto.ag.EnrollmentRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.UserRequest}
+ * @return {!proto.ag.EnrollmentRequest}
  */
-proto.ag.UserRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.EnrollmentRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8295,7 +16065,21 @@ proto.ag.UserRequest.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setUserid(value);
+      msg.setCourseid(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIgnoregroupmembers(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setWithactivity(value);
+      break;
+    case 4:
+      var values = /** @type {!Array<!proto.ag.Enrollment.UserStatus>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addStatuses(values[i]);
+      }
       break;
     default:
       reader.skipField();
@@ -8310,9 +16094,9 @@ proto.ag.UserRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.UserRequest.prototype.serializeBinary = function() {
+proto.ag.EnrollmentRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.UserRequest.serializeBinaryToWriter(this, writer);
+  proto.ag.EnrollmentRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8320,40 +16104,141 @@ proto.ag.UserRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.UserRequest} message
+ * @param {!proto.ag.EnrollmentRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.UserRequest.serializeBinaryToWriter = function(message, writer) {
+proto.ag.EnrollmentRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUserid();
+  f = message.getCourseid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
+  f = message.getIgnoregroupmembers();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+  f = message.getWithactivity();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = message.getStatusesList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
+      4,
+      f
+    );
+  }
 };
 
 
 /**
- * optional uint64 userID = 1;
+ * optional uint64 courseID = 1;
  * @return {number}
  */
-proto.ag.UserRequest.prototype.getUserid = function() {
+proto.ag.EnrollmentRequest.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.UserRequest} returns this
+ * @return {!proto.ag.EnrollmentRequest} returns this
  */
-proto.ag.UserRequest.prototype.setUserid = function(value) {
+proto.ag.EnrollmentRequest.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
+/**
+ * optional bool ignoreGroupMembers = 2;
+ * @return {boolean}
+ */
+proto.ag.EnrollmentRequest.prototype.getIgnoregroupmembers = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ag.EnrollmentRequest} returns this
+ */
+proto.ag.EnrollmentRequest.prototype.setIgnoregroupmembers = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+/**
+ * optional bool withActivity = 3;
+ * @return {boolean}
+ */
+proto.ag.EnrollmentRequest.prototype.getWithactivity = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ag.EnrollmentRequest} returns this
+ */
+proto.ag.EnrollmentRequest.prototype.setWithactivity = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
+/**
+ * repeated Enrollment.UserStatus statuses = 4;
+ * @return {!Array<!proto.ag.Enrollment.UserStatus>}
+ */
+proto.ag.EnrollmentRequest.prototype.getStatusesList = function() {
+  return /** @type {!Array<!proto.ag.Enrollment.UserStatus>} */ (jspb.Message.getRepeatedField(this, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.Enrollment.UserStatus>} value
+ * @return {!proto.ag.EnrollmentRequest} returns this
+ */
+proto.ag.EnrollmentRequest.prototype.setStatusesList = function(value) {
+  return jspb.Message.setField(this, 4, value || []);
+};
+
+
+/**
+ * @param {!proto.ag.Enrollment.UserStatus} value
+ * @param {number=} opt_index
+ * @return {!proto.ag.EnrollmentRequest} returns this
+ */
+proto.ag.EnrollmentRequest.prototype.addStatuses = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.EnrollmentRequest} returns this
+ */
+proto.ag.EnrollmentRequest.prototype.clearStatusesList = function() {
+  return this.setStatusesList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.EnrollmentStatusRequest.repeatedFields_ = [2];
 
 
 
@@ -8370,8 +16255,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.GetGroupRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.GetGroupRequest.toObject(opt_includeInstance, this);
+proto.ag.EnrollmentStatusRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.EnrollmentStatusRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -8380,13 +16265,14 @@ proto.ag.GetGroupRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.GetGroupRequest} msg The msg instance to transform.
+ * @param {!proto.ag.EnrollmentStatusRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.GetGroupRequest.toObject = function(includeInstance, msg) {
+proto.ag.EnrollmentStatusRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    groupid: jspb.Message.getFieldWithDefault(msg, 1, 0)
+    userid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    statusesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -8400,23 +16286,23 @@ proto.ag.GetGroupRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.GetGroupRequest}
+ * @return {!proto.ag.EnrollmentStatusRequest}
  */
-proto.ag.GetGroupRequest.deserializeBinary = function(bytes) {
+proto.ag.EnrollmentStatusRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.GetGroupRequest;
-  return proto.ag.GetGroupRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.EnrollmentStatusRequest;
+  return proto.ag.EnrollmentStatusRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.GetGroupRequest} msg The message object to deserialize into.
+ * @param {!proto.ag.EnrollmentStatusRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.GetGroupRequest}
+ * @return {!proto.ag.EnrollmentStatusRequest}
  */
-proto.ag.GetGroupRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.EnrollmentStatusRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8425,7 +16311,13 @@ proto.ag.GetGroupRequest.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setGroupid(value);
+      msg.setUserid(value);
+      break;
+    case 2:
+      var values = /** @type {!Array<!proto.ag.Enrollment.UserStatus>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addStatuses(values[i]);
+      }
       break;
     default:
       reader.skipField();
@@ -8440,9 +16332,9 @@ proto.ag.GetGroupRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.GetGroupRequest.prototype.serializeBinary = function() {
+proto.ag.EnrollmentStatusRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.GetGroupRequest.serializeBinaryToWriter(this, writer);
+  proto.ag.EnrollmentStatusRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8450,40 +16342,84 @@ proto.ag.GetGroupRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.GetGroupRequest} message
+ * @param {!proto.ag.EnrollmentStatusRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.GetGroupRequest.serializeBinaryToWriter = function(message, writer) {
+proto.ag.EnrollmentStatusRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getGroupid();
+  f = message.getUserid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
+  f = message.getStatusesList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional uint64 groupID = 1;
+ * optional uint64 userID = 1;
  * @return {number}
  */
-proto.ag.GetGroupRequest.prototype.getGroupid = function() {
+proto.ag.EnrollmentStatusRequest.prototype.getUserid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.GetGroupRequest} returns this
+ * @return {!proto.ag.EnrollmentStatusRequest} returns this
  */
-proto.ag.GetGroupRequest.prototype.setGroupid = function(value) {
+proto.ag.EnrollmentStatusRequest.prototype.setUserid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
+/**
+ * repeated Enrollment.UserStatus statuses = 2;
+ * @return {!Array<!proto.ag.Enrollment.UserStatus>}
+ */
+proto.ag.EnrollmentStatusRequest.prototype.getStatusesList = function() {
+  return /** @type {!Array<!proto.ag.Enrollment.UserStatus>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.Enrollment.UserStatus>} value
+ * @return {!proto.ag.EnrollmentStatusRequest} returns this
+ */
+proto.ag.EnrollmentStatusRequest.prototype.setStatusesList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {!proto.ag.Enrollment.UserStatus} value
+ * @param {number=} opt_index
+ * @return {!proto.ag.EnrollmentStatusRequest} returns this
+ */
+proto.ag.EnrollmentStatusRequest.prototype.addStatuses = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.EnrollmentStatusRequest} returns this
+ */
+proto.ag.EnrollmentStatusRequest.prototype.clearStatusesList = function() {
+  return this.setStatusesList([]);
+};
+
+
 
 
 
@@ -8500,8 +16436,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.GroupRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.GroupRequest.toObject(opt_includeInstance, this);
+proto.ag.SubmissionRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.SubmissionRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -8510,11 +16446,11 @@ proto.ag.GroupRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.GroupRequest} msg The msg instance to transform.
+ * @param {!proto.ag.SubmissionRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.GroupRequest.toObject = function(includeInstance, msg) {
+proto.ag.SubmissionRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     userid: jspb.Message.getFieldWithDefault(msg, 1, 0),
     groupid: jspb.Message.getFieldWithDefault(msg, 2, 0),
@@ -8532,23 +16468,23 @@ proto.ag.GroupRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.GroupRequest}
+ * @return {!proto.ag.SubmissionRequest}
  */
-proto.ag.GroupRequest.deserializeBinary = function(bytes) {
+proto.ag.SubmissionRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.GroupRequest;
-  return proto.ag.GroupRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.SubmissionRequest;
+  return proto.ag.SubmissionRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.GroupRequest} msg The message object to deserialize into.
+ * @param {!proto.ag.SubmissionRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.GroupRequest}
+ * @return {!proto.ag.SubmissionRequest}
  */
-proto.ag.GroupRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.SubmissionRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8580,9 +16516,9 @@ proto.ag.GroupRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.GroupRequest.prototype.serializeBinary = function() {
+proto.ag.SubmissionRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.GroupRequest.serializeBinaryToWriter(this, writer);
+  proto.ag.SubmissionRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8590,11 +16526,11 @@ proto.ag.GroupRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.GroupRequest} message
+ * @param {!proto.ag.SubmissionRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.GroupRequest.serializeBinaryToWriter = function(message, writer) {
+proto.ag.SubmissionRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getUserid();
   if (f !== 0) {
@@ -8624,16 +16560,16 @@ proto.ag.GroupRequest.serializeBinaryToWriter = function(message, writer) {
  * optional uint64 userID = 1;
  * @return {number}
  */
-proto.ag.GroupRequest.prototype.getUserid = function() {
+proto.ag.SubmissionRequest.prototype.getUserid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.GroupRequest} returns this
+ * @return {!proto.ag.SubmissionRequest} returns this
  */
-proto.ag.GroupRequest.prototype.setUserid = function(value) {
+proto.ag.SubmissionRequest.prototype.setUserid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
@@ -8642,16 +16578,16 @@ proto.ag.GroupRequest.prototype.setUserid = function(value) {
  * optional uint64 groupID = 2;
  * @return {number}
  */
-proto.ag.GroupRequest.prototype.getGroupid = function() {
+proto.ag.SubmissionRequest.prototype.getGroupid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.GroupRequest} returns this
+ * @return {!proto.ag.SubmissionRequest} returns this
  */
-proto.ag.GroupRequest.prototype.setGroupid = function(value) {
+proto.ag.SubmissionRequest.prototype.setGroupid = function(value) {
   return jspb.Message.setProto3IntField(this, 2, value);
 };
 
@@ -8660,16 +16596,16 @@ proto.ag.GroupRequest.prototype.setGroupid = function(value) {
  * optional uint64 courseID = 3;
  * @return {number}
  */
-proto.ag.GroupRequest.prototype.getCourseid = function() {
+proto.ag.SubmissionRequest.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.GroupRequest} returns this
+ * @return {!proto.ag.SubmissionRequest} returns this
  */
-proto.ag.GroupRequest.prototype.setCourseid = function(value) {
+proto.ag.SubmissionRequest.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 3, value);
 };
 
@@ -8690,8 +16626,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Provider.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Provider.toObject(opt_includeInstance, this);
+proto.ag.UpdateSubmissionRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.UpdateSubmissionRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -8700,13 +16636,17 @@ proto.ag.Provider.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Provider} msg The msg instance to transform.
+ * @param {!proto.ag.UpdateSubmissionRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Provider.toObject = function(includeInstance, msg) {
+proto.ag.UpdateSubmissionRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    provider: jspb.Message.getFieldWithDefault(msg, 1, "")
+    submissionid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    courseid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    score: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    released: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
+    status: jspb.Message.getFieldWithDefault(msg, 5, 0)
   };
 
   if (includeInstance) {
@@ -8720,23 +16660,23 @@ proto.ag.Provider.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Provider}
+ * @return {!proto.ag.UpdateSubmissionRequest}
  */
-proto.ag.Provider.deserializeBinary = function(bytes) {
+proto.ag.UpdateSubmissionRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Provider;
-  return proto.ag.Provider.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.UpdateSubmissionRequest;
+  return proto.ag.UpdateSubmissionRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Provider} msg The message object to deserialize into.
+ * @param {!proto.ag.UpdateSubmissionRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Provider}
+ * @return {!proto.ag.UpdateSubmissionRequest}
  */
-proto.ag.Provider.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.UpdateSubmissionRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8744,8 +16684,24 @@ proto.ag.Provider.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setProvider(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setSubmissionid(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setScore(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setReleased(value);
+      break;
+    case 5:
+      var value = /** @type {!proto.ag.Submission.Status} */ (reader.readEnum());
+      msg.setStatus(value);
       break;
     default:
       reader.skipField();
@@ -8760,9 +16716,9 @@ proto.ag.Provider.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.Provider.prototype.serializeBinary = function() {
+proto.ag.UpdateSubmissionRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.Provider.serializeBinaryToWriter(this, writer);
+  proto.ag.UpdateSubmissionRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8770,167 +16726,137 @@ proto.ag.Provider.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Provider} message
+ * @param {!proto.ag.UpdateSubmissionRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Provider.serializeBinaryToWriter = function(message, writer) {
+proto.ag.UpdateSubmissionRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getProvider();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getSubmissionid();
+  if (f !== 0) {
+    writer.writeUint64(
       1,
       f
     );
   }
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getScore();
+  if (f !== 0) {
+    writer.writeUint32(
+      3,
+      f
+    );
+  }
+  f = message.getReleased();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      5,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string provider = 1;
- * @return {string}
+ * optional uint64 submissionID = 1;
+ * @return {number}
  */
-proto.ag.Provider.prototype.getProvider = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.ag.UpdateSubmissionRequest.prototype.getSubmissionid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ag.Provider} returns this
+ * @param {number} value
+ * @return {!proto.ag.UpdateSubmissionRequest} returns this
  */
-proto.ag.Provider.prototype.setProvider = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.ag.UpdateSubmissionRequest.prototype.setSubmissionid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * optional uint64 courseID = 2;
+ * @return {number}
  */
-proto.ag.OrgRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.OrgRequest.toObject(opt_includeInstance, this);
+proto.ag.UpdateSubmissionRequest.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.ag.OrgRequest} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {number} value
+ * @return {!proto.ag.UpdateSubmissionRequest} returns this
  */
-proto.ag.OrgRequest.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    orgname: jspb.Message.getFieldWithDefault(msg, 1, "")
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.ag.UpdateSubmissionRequest.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.OrgRequest}
+ * optional uint32 score = 3;
+ * @return {number}
  */
-proto.ag.OrgRequest.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.OrgRequest;
-  return proto.ag.OrgRequest.deserializeBinaryFromReader(msg, reader);
+proto.ag.UpdateSubmissionRequest.prototype.getScore = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.ag.OrgRequest} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.OrgRequest}
+ * @param {number} value
+ * @return {!proto.ag.UpdateSubmissionRequest} returns this
  */
-proto.ag.OrgRequest.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setOrgname(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.ag.UpdateSubmissionRequest.prototype.setScore = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional bool released = 4;
+ * @return {boolean}
  */
-proto.ag.OrgRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.ag.OrgRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.ag.UpdateSubmissionRequest.prototype.getReleased = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.ag.OrgRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {boolean} value
+ * @return {!proto.ag.UpdateSubmissionRequest} returns this
  */
-proto.ag.OrgRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getOrgname();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
+proto.ag.UpdateSubmissionRequest.prototype.setReleased = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
 };
 
 
 /**
- * optional string orgName = 1;
- * @return {string}
+ * optional Submission.Status status = 5;
+ * @return {!proto.ag.Submission.Status}
  */
-proto.ag.OrgRequest.prototype.getOrgname = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.ag.UpdateSubmissionRequest.prototype.getStatus = function() {
+  return /** @type {!proto.ag.Submission.Status} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
-/**
- * @param {string} value
- * @return {!proto.ag.OrgRequest} returns this
+/**
+ * @param {!proto.ag.Submission.Status} value
+ * @return {!proto.ag.UpdateSubmissionRequest} returns this
  */
-proto.ag.OrgRequest.prototype.setOrgname = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.ag.UpdateSubmissionRequest.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 5, value);
 };
 
 
@@ -8950,8 +16876,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Organization.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Organization.toObject(opt_includeInstance, this);
+proto.ag.UpdateSubmissionsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.UpdateSubmissionsRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -8960,16 +16886,17 @@ proto.ag.Organization.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Organization} msg The msg instance to transform.
+ * @param {!proto.ag.UpdateSubmissionsRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Organization.toObject = function(includeInstance, msg) {
+proto.ag.UpdateSubmissionsRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    path: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    avatar: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    paymentplan: jspb.Message.getFieldWithDefault(msg, 4, "")
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    scorelimit: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    release: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
+    approve: jspb.Message.getBooleanFieldWithDefault(msg, 5, false)
   };
 
   if (includeInstance) {
@@ -8983,23 +16910,23 @@ proto.ag.Organization.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Organization}
+ * @return {!proto.ag.UpdateSubmissionsRequest}
  */
-proto.ag.Organization.deserializeBinary = function(bytes) {
+proto.ag.UpdateSubmissionsRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Organization;
-  return proto.ag.Organization.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.UpdateSubmissionsRequest;
+  return proto.ag.UpdateSubmissionsRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Organization} msg The message object to deserialize into.
+ * @param {!proto.ag.UpdateSubmissionsRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Organization}
+ * @return {!proto.ag.UpdateSubmissionsRequest}
  */
-proto.ag.Organization.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.UpdateSubmissionsRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9008,19 +16935,23 @@ proto.ag.Organization.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setId(value);
+      msg.setCourseid(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPath(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
       break;
     case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setAvatar(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setScorelimit(value);
       break;
     case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPaymentplan(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setRelease(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setApprove(value);
       break;
     default:
       reader.skipField();
@@ -9035,9 +16966,9 @@ proto.ag.Organization.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.Organization.prototype.serializeBinary = function() {
+proto.ag.UpdateSubmissionsRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.Organization.serializeBinaryToWriter(this, writer);
+  proto.ag.UpdateSubmissionsRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9045,122 +16976,140 @@ proto.ag.Organization.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Organization} message
+ * @param {!proto.ag.UpdateSubmissionsRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Organization.serializeBinaryToWriter = function(message, writer) {
+proto.ag.UpdateSubmissionsRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getCourseid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getPath();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
       2,
       f
     );
   }
-  f = message.getAvatar();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getScorelimit();
+  if (f !== 0) {
+    writer.writeUint32(
       3,
       f
     );
   }
-  f = message.getPaymentplan();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getRelease();
+  if (f) {
+    writer.writeBool(
       4,
       f
     );
   }
+  f = message.getApprove();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
 };
 
 
 /**
- * optional uint64 ID = 1;
+ * optional uint64 courseID = 1;
  * @return {number}
  */
-proto.ag.Organization.prototype.getId = function() {
+proto.ag.UpdateSubmissionsRequest.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Organization} returns this
+ * @return {!proto.ag.UpdateSubmissionsRequest} returns this
  */
-proto.ag.Organization.prototype.setId = function(value) {
+proto.ag.UpdateSubmissionsRequest.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional string path = 2;
- * @return {string}
+ * optional uint64 assignmentID = 2;
+ * @return {number}
  */
-proto.ag.Organization.prototype.getPath = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.ag.UpdateSubmissionsRequest.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ag.Organization} returns this
+ * @param {number} value
+ * @return {!proto.ag.UpdateSubmissionsRequest} returns this
  */
-proto.ag.Organization.prototype.setPath = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.ag.UpdateSubmissionsRequest.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional string avatar = 3;
- * @return {string}
+ * optional uint32 scoreLimit = 3;
+ * @return {number}
  */
-proto.ag.Organization.prototype.getAvatar = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.ag.UpdateSubmissionsRequest.prototype.getScorelimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ag.Organization} returns this
+ * @param {number} value
+ * @return {!proto.ag.UpdateSubmissionsRequest} returns this
  */
-proto.ag.Organization.prototype.setAvatar = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.ag.UpdateSubmissionsRequest.prototype.setScorelimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * optional string paymentPlan = 4;
- * @return {string}
+ * optional bool release = 4;
+ * @return {boolean}
  */
-proto.ag.Organization.prototype.getPaymentplan = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.ag.UpdateSubmissionsRequest.prototype.getRelease = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ag.Organization} returns this
+ * @param {boolean} value
+ * @return {!proto.ag.UpdateSubmissionsRequest} returns this
  */
-proto.ag.Organization.prototype.setPaymentplan = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.ag.UpdateSubmissionsRequest.prototype.setRelease = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
 };
 
 
+/**
+ * optional bool approve = 5;
+ * @return {boolean}
+ */
+proto.ag.UpdateSubmissionsRequest.prototype.getApprove = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {boolean} value
+ * @return {!proto.ag.UpdateSubmissionsRequest} returns this
  */
-proto.ag.Organizations.repeatedFields_ = [1];
+proto.ag.UpdateSubmissionsRequest.prototype.setApprove = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
+};
+
+
 
 
 
@@ -9177,8 +17126,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Organizations.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Organizations.toObject(opt_includeInstance, this);
+proto.ag.ArtifactRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.ArtifactRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -9187,14 +17136,15 @@ proto.ag.Organizations.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Organizations} msg The msg instance to transform.
+ * @param {!proto.ag.ArtifactRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Organizations.toObject = function(includeInstance, msg) {
+proto.ag.ArtifactRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    organizationsList: jspb.Message.toObjectList(msg.getOrganizationsList(),
-    proto.ag.Organization.toObject, includeInstance)
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    submissionid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    name: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -9208,23 +17158,23 @@ proto.ag.Organizations.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Organizations}
+ * @return {!proto.ag.ArtifactRequest}
  */
-proto.ag.Organizations.deserializeBinary = function(bytes) {
+proto.ag.ArtifactRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Organizations;
-  return proto.ag.Organizations.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.ArtifactRequest;
+  return proto.ag.ArtifactRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Organizations} msg The message object to deserialize into.
+ * @param {!proto.ag.ArtifactRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Organizations}
+ * @return {!proto.ag.ArtifactRequest}
  */
-proto.ag.Organizations.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.ArtifactRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9232,9 +17182,16 @@ proto.ag.Organizations.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.ag.Organization;
-      reader.readMessage(value,proto.ag.Organization.deserializeBinaryFromReader);
-      msg.addOrganizations(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setSubmissionid(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
       break;
     default:
       reader.skipField();
@@ -9249,9 +17206,9 @@ proto.ag.Organizations.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.Organizations.prototype.serializeBinary = function() {
+proto.ag.ArtifactRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.Organizations.serializeBinaryToWriter(this, writer);
+  proto.ag.ArtifactRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9259,69 +17216,91 @@ proto.ag.Organizations.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Organizations} message
+ * @param {!proto.ag.ArtifactRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Organizations.serializeBinaryToWriter = function(message, writer) {
+proto.ag.ArtifactRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getOrganizationsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
       1,
-      f,
-      proto.ag.Organization.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getSubmissionid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
     );
   }
 };
 
 
 /**
- * repeated Organization organizations = 1;
- * @return {!Array<!proto.ag.Organization>}
+ * optional uint64 courseID = 1;
+ * @return {number}
  */
-proto.ag.Organizations.prototype.getOrganizationsList = function() {
-  return /** @type{!Array<!proto.ag.Organization>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.Organization, 1));
+proto.ag.ArtifactRequest.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {!Array<!proto.ag.Organization>} value
- * @return {!proto.ag.Organizations} returns this
-*/
-proto.ag.Organizations.prototype.setOrganizationsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {number} value
+ * @return {!proto.ag.ArtifactRequest} returns this
+ */
+proto.ag.ArtifactRequest.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.ag.Organization=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ag.Organization}
+ * optional uint64 submissionID = 2;
+ * @return {number}
+ */
+proto.ag.ArtifactRequest.prototype.getSubmissionid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.ArtifactRequest} returns this
+ */
+proto.ag.ArtifactRequest.prototype.setSubmissionid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string name = 3;
+ * @return {string}
  */
-proto.ag.Organizations.prototype.addOrganizations = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.Organization, opt_index);
+proto.ag.ArtifactRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ag.Organizations} returns this
+ * @param {string} value
+ * @return {!proto.ag.ArtifactRequest} returns this
  */
-proto.ag.Organizations.prototype.clearOrganizationsList = function() {
-  return this.setOrganizationsList([]);
+proto.ag.ArtifactRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.ag.EnrollmentRequest.repeatedFields_ = [4];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -9337,8 +17316,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.EnrollmentRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.EnrollmentRequest.toObject(opt_includeInstance, this);
+proto.ag.SubmissionReviewersRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.SubmissionReviewersRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -9347,16 +17326,14 @@ proto.ag.EnrollmentRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.EnrollmentRequest} msg The msg instance to transform.
+ * @param {!proto.ag.SubmissionReviewersRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.EnrollmentRequest.toObject = function(includeInstance, msg) {
+proto.ag.SubmissionReviewersRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    ignoregroupmembers: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
-    withactivity: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
-    statusesList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f
+    submissionid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    courseid: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -9370,23 +17347,23 @@ proto.ag.EnrollmentRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.EnrollmentRequest}
+ * @return {!proto.ag.SubmissionReviewersRequest}
  */
-proto.ag.EnrollmentRequest.deserializeBinary = function(bytes) {
+proto.ag.SubmissionReviewersRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.EnrollmentRequest;
-  return proto.ag.EnrollmentRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.SubmissionReviewersRequest;
+  return proto.ag.SubmissionReviewersRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.EnrollmentRequest} msg The message object to deserialize into.
+ * @param {!proto.ag.SubmissionReviewersRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.EnrollmentRequest}
+ * @return {!proto.ag.SubmissionReviewersRequest}
  */
-proto.ag.EnrollmentRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.SubmissionReviewersRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9395,21 +17372,11 @@ proto.ag.EnrollmentRequest.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
+      msg.setSubmissionid(value);
       break;
     case 2:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setIgnoregroupmembers(value);
-      break;
-    case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setWithactivity(value);
-      break;
-    case 4:
-      var values = /** @type {!Array<!proto.ag.Enrollment.UserStatus>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
-      for (var i = 0; i < values.length; i++) {
-        msg.addStatuses(values[i]);
-      }
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
       break;
     default:
       reader.skipField();
@@ -9424,9 +17391,9 @@ proto.ag.EnrollmentRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.EnrollmentRequest.prototype.serializeBinary = function() {
+proto.ag.SubmissionReviewersRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.EnrollmentRequest.serializeBinaryToWriter(this, writer);
+  proto.ag.SubmissionReviewersRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9434,131 +17401,62 @@ proto.ag.EnrollmentRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.EnrollmentRequest} message
+ * @param {!proto.ag.SubmissionReviewersRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.EnrollmentRequest.serializeBinaryToWriter = function(message, writer) {
+proto.ag.SubmissionReviewersRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCourseid();
+  f = message.getSubmissionid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getIgnoregroupmembers();
-  if (f) {
-    writer.writeBool(
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
       2,
       f
     );
   }
-  f = message.getWithactivity();
-  if (f) {
-    writer.writeBool(
-      3,
-      f
-    );
-  }
-  f = message.getStatusesList();
-  if (f.length > 0) {
-    writer.writePackedEnum(
-      4,
-      f
-    );
-  }
 };
 
 
 /**
- * optional uint64 courseID = 1;
+ * optional uint64 submissionID = 1;
  * @return {number}
  */
-proto.ag.EnrollmentRequest.prototype.getCourseid = function() {
+proto.ag.SubmissionReviewersRequest.prototype.getSubmissionid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.EnrollmentRequest} returns this
+ * @return {!proto.ag.SubmissionReviewersRequest} returns this
  */
-proto.ag.EnrollmentRequest.prototype.setCourseid = function(value) {
+proto.ag.SubmissionReviewersRequest.prototype.setSubmissionid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional bool ignoreGroupMembers = 2;
- * @return {boolean}
- */
-proto.ag.EnrollmentRequest.prototype.getIgnoregroupmembers = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.ag.EnrollmentRequest} returns this
- */
-proto.ag.EnrollmentRequest.prototype.setIgnoregroupmembers = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 2, value);
-};
-
-
-/**
- * optional bool withActivity = 3;
- * @return {boolean}
- */
-proto.ag.EnrollmentRequest.prototype.getWithactivity = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.ag.EnrollmentRequest} returns this
- */
-proto.ag.EnrollmentRequest.prototype.setWithactivity = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 3, value);
-};
-
-
-/**
- * repeated Enrollment.UserStatus statuses = 4;
- * @return {!Array<!proto.ag.Enrollment.UserStatus>}
- */
-proto.ag.EnrollmentRequest.prototype.getStatusesList = function() {
-  return /** @type {!Array<!proto.ag.Enrollment.UserStatus>} */ (jspb.Message.getRepeatedField(this, 4));
-};
-
-
-/**
- * @param {!Array<!proto.ag.Enrollment.UserStatus>} value
- * @return {!proto.ag.EnrollmentRequest} returns this
- */
-proto.ag.EnrollmentRequest.prototype.setStatusesList = function(value) {
-  return jspb.Message.setField(this, 4, value || []);
-};
-
-
-/**
- * @param {!proto.ag.Enrollment.UserStatus} value
- * @param {number=} opt_index
- * @return {!proto.ag.EnrollmentRequest} returns this
+ * optional uint64 courseID = 2;
+ * @return {number}
  */
-proto.ag.EnrollmentRequest.prototype.addStatuses = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+proto.ag.SubmissionReviewersRequest.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ag.EnrollmentRequest} returns this
+ * @param {number} value
+ * @return {!proto.ag.SubmissionReviewersRequest} returns this
  */
-proto.ag.EnrollmentRequest.prototype.clearStatusesList = function() {
-  return this.setStatusesList([]);
+proto.ag.SubmissionReviewersRequest.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
@@ -9568,7 +17466,7 @@ proto.ag.EnrollmentRequest.prototype.clearStatusesList = function() {
  * @private {!Array<number>}
  * @const
  */
-proto.ag.EnrollmentStatusRequest.repeatedFields_ = [2];
+proto.ag.Providers.repeatedFields_ = [1];
 
 
 
@@ -9585,8 +17483,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.EnrollmentStatusRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.EnrollmentStatusRequest.toObject(opt_includeInstance, this);
+proto.ag.Providers.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Providers.toObject(opt_includeInstance, this);
 };
 
 
@@ -9595,14 +17493,13 @@ proto.ag.EnrollmentStatusRequest.prototype.toObject = function(opt_includeInstan
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.EnrollmentStatusRequest} msg The msg instance to transform.
+ * @param {!proto.ag.Providers} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.EnrollmentStatusRequest.toObject = function(includeInstance, msg) {
+proto.ag.Providers.toObject = function(includeInstance, msg) {
   var f, obj = {
-    userid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    statusesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
+    providersList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -9616,23 +17513,23 @@ proto.ag.EnrollmentStatusRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.EnrollmentStatusRequest}
+ * @return {!proto.ag.Providers}
  */
-proto.ag.EnrollmentStatusRequest.deserializeBinary = function(bytes) {
+proto.ag.Providers.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.EnrollmentStatusRequest;
-  return proto.ag.EnrollmentStatusRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.Providers;
+  return proto.ag.Providers.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.EnrollmentStatusRequest} msg The message object to deserialize into.
+ * @param {!proto.ag.Providers} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.EnrollmentStatusRequest}
+ * @return {!proto.ag.Providers}
  */
-proto.ag.EnrollmentStatusRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.Providers.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9640,14 +17537,8 @@ proto.ag.EnrollmentStatusRequest.deserializeBinaryFromReader = function(msg, rea
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setUserid(value);
-      break;
-    case 2:
-      var values = /** @type {!Array<!proto.ag.Enrollment.UserStatus>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
-      for (var i = 0; i < values.length; i++) {
-        msg.addStatuses(values[i]);
-      }
+      var value = /** @type {string} */ (reader.readString());
+      msg.addProviders(value);
       break;
     default:
       reader.skipField();
@@ -9662,9 +17553,9 @@ proto.ag.EnrollmentStatusRequest.deserializeBinaryFromReader = function(msg, rea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.EnrollmentStatusRequest.prototype.serializeBinary = function() {
+proto.ag.Providers.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.EnrollmentStatusRequest.serializeBinaryToWriter(this, writer);
+  proto.ag.Providers.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9672,23 +17563,16 @@ proto.ag.EnrollmentStatusRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.EnrollmentStatusRequest} message
+ * @param {!proto.ag.Providers} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.EnrollmentStatusRequest.serializeBinaryToWriter = function(message, writer) {
+proto.ag.Providers.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUserid();
-  if (f !== 0) {
-    writer.writeUint64(
-      1,
-      f
-    );
-  }
-  f = message.getStatusesList();
+  f = message.getProvidersList();
   if (f.length > 0) {
-    writer.writePackedEnum(
-      2,
+    writer.writeRepeatedString(
+      1,
       f
     );
   }
@@ -9696,61 +17580,50 @@ proto.ag.EnrollmentStatusRequest.serializeBinaryToWriter = function(message, wri
 
 
 /**
- * optional uint64 userID = 1;
- * @return {number}
- */
-proto.ag.EnrollmentStatusRequest.prototype.getUserid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.ag.EnrollmentStatusRequest} returns this
- */
-proto.ag.EnrollmentStatusRequest.prototype.setUserid = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
-};
-
-
-/**
- * repeated Enrollment.UserStatus statuses = 2;
- * @return {!Array<!proto.ag.Enrollment.UserStatus>}
+ * repeated string providers = 1;
+ * @return {!Array<string>}
  */
-proto.ag.EnrollmentStatusRequest.prototype.getStatusesList = function() {
-  return /** @type {!Array<!proto.ag.Enrollment.UserStatus>} */ (jspb.Message.getRepeatedField(this, 2));
+proto.ag.Providers.prototype.getProvidersList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
-/**
- * @param {!Array<!proto.ag.Enrollment.UserStatus>} value
- * @return {!proto.ag.EnrollmentStatusRequest} returns this
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.ag.Providers} returns this
  */
-proto.ag.EnrollmentStatusRequest.prototype.setStatusesList = function(value) {
-  return jspb.Message.setField(this, 2, value || []);
+proto.ag.Providers.prototype.setProvidersList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
 };
 
 
 /**
- * @param {!proto.ag.Enrollment.UserStatus} value
+ * @param {string} value
  * @param {number=} opt_index
- * @return {!proto.ag.EnrollmentStatusRequest} returns this
+ * @return {!proto.ag.Providers} returns this
  */
-proto.ag.EnrollmentStatusRequest.prototype.addStatuses = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+proto.ag.Providers.prototype.addProviders = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.ag.EnrollmentStatusRequest} returns this
+ * @return {!proto.ag.Providers} returns this
  */
-proto.ag.EnrollmentStatusRequest.prototype.clearStatusesList = function() {
-  return this.setStatusesList([]);
+proto.ag.Providers.prototype.clearProvidersList = function() {
+  return this.setProvidersList([]);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.URLRequest.repeatedFields_ = [2];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -9766,8 +17639,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.SubmissionRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.SubmissionRequest.toObject(opt_includeInstance, this);
+proto.ag.URLRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.URLRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -9776,15 +17649,14 @@ proto.ag.SubmissionRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.SubmissionRequest} msg The msg instance to transform.
+ * @param {!proto.ag.URLRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.SubmissionRequest.toObject = function(includeInstance, msg) {
+proto.ag.URLRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    userid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    groupid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    courseid: jspb.Message.getFieldWithDefault(msg, 3, 0)
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    repotypesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -9798,23 +17670,23 @@ proto.ag.SubmissionRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.SubmissionRequest}
+ * @return {!proto.ag.URLRequest}
  */
-proto.ag.SubmissionRequest.deserializeBinary = function(bytes) {
+proto.ag.URLRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.SubmissionRequest;
-  return proto.ag.SubmissionRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.URLRequest;
+  return proto.ag.URLRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.SubmissionRequest} msg The message object to deserialize into.
+ * @param {!proto.ag.URLRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.SubmissionRequest}
+ * @return {!proto.ag.URLRequest}
  */
-proto.ag.SubmissionRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.URLRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9823,15 +17695,13 @@ proto.ag.SubmissionRequest.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setUserid(value);
+      msg.setCourseid(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setGroupid(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
+      var values = /** @type {!Array<!proto.ag.Repository.Type>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addRepotypes(values[i]);
+      }
       break;
     default:
       reader.skipField();
@@ -9846,9 +17716,9 @@ proto.ag.SubmissionRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.SubmissionRequest.prototype.serializeBinary = function() {
+proto.ag.URLRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.SubmissionRequest.serializeBinaryToWriter(this, writer);
+  proto.ag.URLRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9856,87 +17726,81 @@ proto.ag.SubmissionRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.SubmissionRequest} message
+ * @param {!proto.ag.URLRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.SubmissionRequest.serializeBinaryToWriter = function(message, writer) {
+proto.ag.URLRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUserid();
+  f = message.getCourseid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getGroupid();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getRepotypesList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
       2,
       f
     );
   }
-  f = message.getCourseid();
-  if (f !== 0) {
-    writer.writeUint64(
-      3,
-      f
-    );
-  }
 };
 
 
 /**
- * optional uint64 userID = 1;
+ * optional uint64 courseID = 1;
  * @return {number}
  */
-proto.ag.SubmissionRequest.prototype.getUserid = function() {
+proto.ag.URLRequest.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.SubmissionRequest} returns this
+ * @return {!proto.ag.URLRequest} returns this
  */
-proto.ag.SubmissionRequest.prototype.setUserid = function(value) {
+proto.ag.URLRequest.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 groupID = 2;
- * @return {number}
+ * repeated Repository.Type repoTypes = 2;
+ * @return {!Array<!proto.ag.Repository.Type>}
  */
-proto.ag.SubmissionRequest.prototype.getGroupid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.ag.URLRequest.prototype.getRepotypesList = function() {
+  return /** @type {!Array<!proto.ag.Repository.Type>} */ (jspb.Message.getRepeatedField(this, 2));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.SubmissionRequest} returns this
+ * @param {!Array<!proto.ag.Repository.Type>} value
+ * @return {!proto.ag.URLRequest} returns this
  */
-proto.ag.SubmissionRequest.prototype.setGroupid = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.ag.URLRequest.prototype.setRepotypesList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
 };
 
 
 /**
- * optional uint64 courseID = 3;
- * @return {number}
+ * @param {!proto.ag.Repository.Type} value
+ * @param {number=} opt_index
+ * @return {!proto.ag.URLRequest} returns this
  */
-proto.ag.SubmissionRequest.prototype.getCourseid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.ag.URLRequest.prototype.addRepotypes = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.SubmissionRequest} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.URLRequest} returns this
  */
-proto.ag.SubmissionRequest.prototype.setCourseid = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.ag.URLRequest.prototype.clearRepotypesList = function() {
+  return this.setRepotypesList([]);
 };
 
 
@@ -9956,8 +17820,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.UpdateSubmissionRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.UpdateSubmissionRequest.toObject(opt_includeInstance, this);
+proto.ag.RepositoryRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.RepositoryRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -9966,17 +17830,15 @@ proto.ag.UpdateSubmissionRequest.prototype.toObject = function(opt_includeInstan
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.UpdateSubmissionRequest} msg The msg instance to transform.
+ * @param {!proto.ag.RepositoryRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.UpdateSubmissionRequest.toObject = function(includeInstance, msg) {
+proto.ag.RepositoryRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    submissionid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    courseid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    score: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    released: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
-    status: jspb.Message.getFieldWithDefault(msg, 5, 0)
+    userid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    groupid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    courseid: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -9990,23 +17852,23 @@ proto.ag.UpdateSubmissionRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.UpdateSubmissionRequest}
+ * @return {!proto.ag.RepositoryRequest}
  */
-proto.ag.UpdateSubmissionRequest.deserializeBinary = function(bytes) {
+proto.ag.RepositoryRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.UpdateSubmissionRequest;
-  return proto.ag.UpdateSubmissionRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.RepositoryRequest;
+  return proto.ag.RepositoryRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.UpdateSubmissionRequest} msg The message object to deserialize into.
+ * @param {!proto.ag.RepositoryRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.UpdateSubmissionRequest}
+ * @return {!proto.ag.RepositoryRequest}
  */
-proto.ag.UpdateSubmissionRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.RepositoryRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -10015,23 +17877,15 @@ proto.ag.UpdateSubmissionRequest.deserializeBinaryFromReader = function(msg, rea
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setSubmissionid(value);
+      msg.setUserid(value);
       break;
     case 2:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
+      msg.setGroupid(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setScore(value);
-      break;
-    case 4:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setReleased(value);
-      break;
-    case 5:
-      var value = /** @type {!proto.ag.Submission.Status} */ (reader.readEnum());
-      msg.setStatus(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
       break;
     default:
       reader.skipField();
@@ -10046,9 +17900,9 @@ proto.ag.UpdateSubmissionRequest.deserializeBinaryFromReader = function(msg, rea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.UpdateSubmissionRequest.prototype.serializeBinary = function() {
+proto.ag.RepositoryRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.UpdateSubmissionRequest.serializeBinaryToWriter(this, writer);
+  proto.ag.RepositoryRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -10056,137 +17910,87 @@ proto.ag.UpdateSubmissionRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.UpdateSubmissionRequest} message
+ * @param {!proto.ag.RepositoryRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.UpdateSubmissionRequest.serializeBinaryToWriter = function(message, writer) {
+proto.ag.RepositoryRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSubmissionid();
+  f = message.getUserid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getCourseid();
+  f = message.getGroupid();
   if (f !== 0) {
     writer.writeUint64(
       2,
       f
     );
   }
-  f = message.getScore();
+  f = message.getCourseid();
   if (f !== 0) {
-    writer.writeUint32(
+    writer.writeUint64(
       3,
       f
     );
   }
-  f = message.getReleased();
-  if (f) {
-    writer.writeBool(
-      4,
-      f
-    );
-  }
-  f = message.getStatus();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      5,
-      f
-    );
-  }
 };
 
 
 /**
- * optional uint64 submissionID = 1;
+ * optional uint64 userID = 1;
  * @return {number}
  */
-proto.ag.UpdateSubmissionRequest.prototype.getSubmissionid = function() {
+proto.ag.RepositoryRequest.prototype.getUserid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.UpdateSubmissionRequest} returns this
+ * @return {!proto.ag.RepositoryRequest} returns this
  */
-proto.ag.UpdateSubmissionRequest.prototype.setSubmissionid = function(value) {
+proto.ag.RepositoryRequest.prototype.setUserid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 courseID = 2;
+ * optional uint64 groupID = 2;
  * @return {number}
  */
-proto.ag.UpdateSubmissionRequest.prototype.getCourseid = function() {
+proto.ag.RepositoryRequest.prototype.getGroupid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.UpdateSubmissionRequest} returns this
- */
-proto.ag.UpdateSubmissionRequest.prototype.setCourseid = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
-};
-
-
-/**
- * optional uint32 score = 3;
- * @return {number}
- */
-proto.ag.UpdateSubmissionRequest.prototype.getScore = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.ag.UpdateSubmissionRequest} returns this
- */
-proto.ag.UpdateSubmissionRequest.prototype.setScore = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
-};
-
-
-/**
- * optional bool released = 4;
- * @return {boolean}
- */
-proto.ag.UpdateSubmissionRequest.prototype.getReleased = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.ag.UpdateSubmissionRequest} returns this
+ * @return {!proto.ag.RepositoryRequest} returns this
  */
-proto.ag.UpdateSubmissionRequest.prototype.setReleased = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 4, value);
+proto.ag.RepositoryRequest.prototype.setGroupid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional Submission.Status status = 5;
- * @return {!proto.ag.Submission.Status}
+ * optional uint64 courseID = 3;
+ * @return {number}
  */
-proto.ag.UpdateSubmissionRequest.prototype.getStatus = function() {
-  return /** @type {!proto.ag.Submission.Status} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.ag.RepositoryRequest.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * @param {!proto.ag.Submission.Status} value
- * @return {!proto.ag.UpdateSubmissionRequest} returns this
+ * @param {number} value
+ * @return {!proto.ag.RepositoryRequest} returns this
  */
-proto.ag.UpdateSubmissionRequest.prototype.setStatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 5, value);
+proto.ag.RepositoryRequest.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
@@ -10206,8 +18010,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.UpdateSubmissionsRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.UpdateSubmissionsRequest.toObject(opt_includeInstance, this);
+proto.ag.Repositories.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Repositories.toObject(opt_includeInstance, this);
 };
 
 
@@ -10216,17 +18020,13 @@ proto.ag.UpdateSubmissionsRequest.prototype.toObject = function(opt_includeInsta
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.UpdateSubmissionsRequest} msg The msg instance to transform.
+ * @param {!proto.ag.Repositories} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.UpdateSubmissionsRequest.toObject = function(includeInstance, msg) {
+proto.ag.Repositories.toObject = function(includeInstance, msg) {
   var f, obj = {
-    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    scorelimit: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    release: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
-    approve: jspb.Message.getBooleanFieldWithDefault(msg, 5, false)
+    urlsMap: (f = msg.getUrlsMap()) ? f.toObject(includeInstance, undefined) : []
   };
 
   if (includeInstance) {
@@ -10240,23 +18040,23 @@ proto.ag.UpdateSubmissionsRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.UpdateSubmissionsRequest}
+ * @return {!proto.ag.Repositories}
  */
-proto.ag.UpdateSubmissionsRequest.deserializeBinary = function(bytes) {
+proto.ag.Repositories.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.UpdateSubmissionsRequest;
-  return proto.ag.UpdateSubmissionsRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.Repositories;
+  return proto.ag.Repositories.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.UpdateSubmissionsRequest} msg The message object to deserialize into.
+ * @param {!proto.ag.Repositories} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.UpdateSubmissionsRequest}
+ * @return {!proto.ag.Repositories}
  */
-proto.ag.UpdateSubmissionsRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.Repositories.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -10264,24 +18064,10 @@ proto.ag.UpdateSubmissionsRequest.deserializeBinaryFromReader = function(msg, re
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
-      break;
-    case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setAssignmentid(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setScorelimit(value);
-      break;
-    case 4:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setRelease(value);
-      break;
-    case 5:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setApprove(value);
+      var value = msg.getUrlsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
       break;
     default:
       reader.skipField();
@@ -10296,9 +18082,9 @@ proto.ag.UpdateSubmissionsRequest.deserializeBinaryFromReader = function(msg, re
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.UpdateSubmissionsRequest.prototype.serializeBinary = function() {
+proto.ag.Repositories.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.UpdateSubmissionsRequest.serializeBinaryToWriter(this, writer);
+  proto.ag.Repositories.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -10306,137 +18092,168 @@ proto.ag.UpdateSubmissionsRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.UpdateSubmissionsRequest} message
+ * @param {!proto.ag.Repositories} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.UpdateSubmissionsRequest.serializeBinaryToWriter = function(message, writer) {
+proto.ag.Repositories.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCourseid();
-  if (f !== 0) {
-    writer.writeUint64(
-      1,
-      f
-    );
-  }
-  f = message.getAssignmentid();
-  if (f !== 0) {
-    writer.writeUint64(
-      2,
-      f
-    );
-  }
-  f = message.getScorelimit();
-  if (f !== 0) {
-    writer.writeUint32(
-      3,
-      f
-    );
-  }
-  f = message.getRelease();
-  if (f) {
-    writer.writeBool(
-      4,
-      f
-    );
-  }
-  f = message.getApprove();
-  if (f) {
-    writer.writeBool(
-      5,
-      f
-    );
+  f = message.getUrlsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
   }
 };
 
 
 /**
- * optional uint64 courseID = 1;
- * @return {number}
+ * map<string, string> URLs = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
  */
-proto.ag.UpdateSubmissionsRequest.prototype.getCourseid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.ag.Repositories.prototype.getUrlsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      null));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.UpdateSubmissionsRequest} returns this
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.ag.Repositories} returns this
  */
-proto.ag.UpdateSubmissionsRequest.prototype.setCourseid = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
-};
+proto.ag.Repositories.prototype.clearUrlsMap = function() {
+  this.getUrlsMap().clear();
+  return this;};
+
+
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional uint64 assignmentID = 2;
- * @return {number}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.ag.UpdateSubmissionsRequest.prototype.getAssignmentid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.ag.AuthorizationResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.AuthorizationResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.UpdateSubmissionsRequest} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.AuthorizationResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.UpdateSubmissionsRequest.prototype.setAssignmentid = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.ag.AuthorizationResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    isauthorized: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional uint32 scoreLimit = 3;
- * @return {number}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.AuthorizationResponse}
  */
-proto.ag.UpdateSubmissionsRequest.prototype.getScorelimit = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.ag.AuthorizationResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.AuthorizationResponse;
+  return proto.ag.AuthorizationResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.UpdateSubmissionsRequest} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.AuthorizationResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.AuthorizationResponse}
  */
-proto.ag.UpdateSubmissionsRequest.prototype.setScorelimit = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.ag.AuthorizationResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsauthorized(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional bool release = 4;
- * @return {boolean}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.ag.UpdateSubmissionsRequest.prototype.getRelease = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+proto.ag.AuthorizationResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.AuthorizationResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.ag.UpdateSubmissionsRequest} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.AuthorizationResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.UpdateSubmissionsRequest.prototype.setRelease = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 4, value);
+proto.ag.AuthorizationResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getIsauthorized();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * optional bool approve = 5;
+ * optional bool IsAuthorized = 1;
  * @return {boolean}
  */
-proto.ag.UpdateSubmissionsRequest.prototype.getApprove = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+proto.ag.AuthorizationResponse.prototype.getIsauthorized = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
 };
 
 
 /**
  * @param {boolean} value
- * @return {!proto.ag.UpdateSubmissionsRequest} returns this
+ * @return {!proto.ag.AuthorizationResponse} returns this
  */
-proto.ag.UpdateSubmissionsRequest.prototype.setApprove = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 5, value);
+proto.ag.AuthorizationResponse.prototype.setIsauthorized = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
 };
 
 
@@ -10456,8 +18273,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.SubmissionReviewersRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.SubmissionReviewersRequest.toObject(opt_includeInstance, this);
+proto.ag.Status.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Status.toObject(opt_includeInstance, this);
 };
 
 
@@ -10466,14 +18283,14 @@ proto.ag.SubmissionReviewersRequest.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.SubmissionReviewersRequest} msg The msg instance to transform.
+ * @param {!proto.ag.Status} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.SubmissionReviewersRequest.toObject = function(includeInstance, msg) {
+proto.ag.Status.toObject = function(includeInstance, msg) {
   var f, obj = {
-    submissionid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    courseid: jspb.Message.getFieldWithDefault(msg, 2, 0)
+    code: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    error: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -10487,23 +18304,23 @@ proto.ag.SubmissionReviewersRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.SubmissionReviewersRequest}
+ * @return {!proto.ag.Status}
  */
-proto.ag.SubmissionReviewersRequest.deserializeBinary = function(bytes) {
+proto.ag.Status.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.SubmissionReviewersRequest;
-  return proto.ag.SubmissionReviewersRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.Status;
+  return proto.ag.Status.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.SubmissionReviewersRequest} msg The message object to deserialize into.
+ * @param {!proto.ag.Status} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.SubmissionReviewersRequest}
+ * @return {!proto.ag.Status}
  */
-proto.ag.SubmissionReviewersRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.Status.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -10512,11 +18329,11 @@ proto.ag.SubmissionReviewersRequest.deserializeBinaryFromReader = function(msg,
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setSubmissionid(value);
+      msg.setCode(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setError(value);
       break;
     default:
       reader.skipField();
@@ -10531,9 +18348,9 @@ proto.ag.SubmissionReviewersRequest.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.SubmissionReviewersRequest.prototype.serializeBinary = function() {
+proto.ag.Status.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.SubmissionReviewersRequest.serializeBinaryToWriter(this, writer);
+  proto.ag.Status.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -10541,22 +18358,22 @@ proto.ag.SubmissionReviewersRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.SubmissionReviewersRequest} message
+ * @param {!proto.ag.Status} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.SubmissionReviewersRequest.serializeBinaryToWriter = function(message, writer) {
+proto.ag.Status.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSubmissionid();
+  f = message.getCode();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getCourseid();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getError();
+  if (f.length > 0) {
+    writer.writeString(
       2,
       f
     );
@@ -10565,49 +18382,42 @@ proto.ag.SubmissionReviewersRequest.serializeBinaryToWriter = function(message,
 
 
 /**
- * optional uint64 submissionID = 1;
+ * optional uint64 Code = 1;
  * @return {number}
  */
-proto.ag.SubmissionReviewersRequest.prototype.getSubmissionid = function() {
+proto.ag.Status.prototype.getCode = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.SubmissionReviewersRequest} returns this
+ * @return {!proto.ag.Status} returns this
  */
-proto.ag.SubmissionReviewersRequest.prototype.setSubmissionid = function(value) {
+proto.ag.Status.prototype.setCode = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 courseID = 2;
- * @return {number}
+ * optional string Error = 2;
+ * @return {string}
  */
-proto.ag.SubmissionReviewersRequest.prototype.getCourseid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.ag.Status.prototype.getError = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.SubmissionReviewersRequest} returns this
+ * @param {string} value
+ * @return {!proto.ag.Status} returns this
  */
-proto.ag.SubmissionReviewersRequest.prototype.setCourseid = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.ag.Status.prototype.setError = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.ag.Providers.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -10623,8 +18433,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Providers.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Providers.toObject(opt_includeInstance, this);
+proto.ag.SubmissionsForCourseRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.SubmissionsForCourseRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -10633,13 +18443,15 @@ proto.ag.Providers.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Providers} msg The msg instance to transform.
+ * @param {!proto.ag.SubmissionsForCourseRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Providers.toObject = function(includeInstance, msg) {
+proto.ag.SubmissionsForCourseRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    providersList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    type: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    withbuildinfo: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
   };
 
   if (includeInstance) {
@@ -10653,23 +18465,23 @@ proto.ag.Providers.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Providers}
+ * @return {!proto.ag.SubmissionsForCourseRequest}
  */
-proto.ag.Providers.deserializeBinary = function(bytes) {
+proto.ag.SubmissionsForCourseRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Providers;
-  return proto.ag.Providers.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.SubmissionsForCourseRequest;
+  return proto.ag.SubmissionsForCourseRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Providers} msg The message object to deserialize into.
+ * @param {!proto.ag.SubmissionsForCourseRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Providers}
+ * @return {!proto.ag.SubmissionsForCourseRequest}
  */
-proto.ag.Providers.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.SubmissionsForCourseRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -10677,8 +18489,16 @@ proto.ag.Providers.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addProviders(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    case 2:
+      var value = /** @type {!proto.ag.SubmissionsForCourseRequest.Type} */ (reader.readEnum());
+      msg.setType(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setWithbuildinfo(value);
       break;
     default:
       reader.skipField();
@@ -10693,9 +18513,9 @@ proto.ag.Providers.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.Providers.prototype.serializeBinary = function() {
+proto.ag.SubmissionsForCourseRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.Providers.serializeBinaryToWriter(this, writer);
+  proto.ag.SubmissionsForCourseRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -10703,66 +18523,99 @@ proto.ag.Providers.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Providers} message
+ * @param {!proto.ag.SubmissionsForCourseRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Providers.serializeBinaryToWriter = function(message, writer) {
+proto.ag.SubmissionsForCourseRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getProvidersList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
       1,
       f
     );
   }
+  f = message.getType();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
+  f = message.getWithbuildinfo();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
 };
 
 
 /**
- * repeated string providers = 1;
- * @return {!Array<string>}
+ * @enum {number}
  */
-proto.ag.Providers.prototype.getProvidersList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+proto.ag.SubmissionsForCourseRequest.Type = {
+  ALL: 0,
+  INDIVIDUAL: 1,
+  GROUP: 2
+};
+
+/**
+ * optional uint64 courseID = 1;
+ * @return {number}
+ */
+proto.ag.SubmissionsForCourseRequest.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.ag.Providers} returns this
+ * @param {number} value
+ * @return {!proto.ag.SubmissionsForCourseRequest} returns this
  */
-proto.ag.Providers.prototype.setProvidersList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
+proto.ag.SubmissionsForCourseRequest.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.ag.Providers} returns this
+ * optional Type type = 2;
+ * @return {!proto.ag.SubmissionsForCourseRequest.Type}
  */
-proto.ag.Providers.prototype.addProviders = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+proto.ag.SubmissionsForCourseRequest.prototype.getType = function() {
+  return /** @type {!proto.ag.SubmissionsForCourseRequest.Type} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ag.Providers} returns this
+ * @param {!proto.ag.SubmissionsForCourseRequest.Type} value
+ * @return {!proto.ag.SubmissionsForCourseRequest} returns this
  */
-proto.ag.Providers.prototype.clearProvidersList = function() {
-  return this.setProvidersList([]);
+proto.ag.SubmissionsForCourseRequest.prototype.setType = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
 };
 
 
+/**
+ * optional bool withBuildInfo = 3;
+ * @return {boolean}
+ */
+proto.ag.SubmissionsForCourseRequest.prototype.getWithbuildinfo = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {boolean} value
+ * @return {!proto.ag.SubmissionsForCourseRequest} returns this
  */
-proto.ag.URLRequest.repeatedFields_ = [2];
+proto.ag.SubmissionsForCourseRequest.prototype.setWithbuildinfo = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
 
 
 
@@ -10779,8 +18632,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.URLRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.URLRequest.toObject(opt_includeInstance, this);
+proto.ag.RebuildRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.RebuildRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -10789,14 +18642,14 @@ proto.ag.URLRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.URLRequest} msg The msg instance to transform.
+ * @param {!proto.ag.RebuildRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.URLRequest.toObject = function(includeInstance, msg) {
+proto.ag.RebuildRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    repotypesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
+    submissionid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -10810,23 +18663,23 @@ proto.ag.URLRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.URLRequest}
+ * @return {!proto.ag.RebuildRequest}
  */
-proto.ag.URLRequest.deserializeBinary = function(bytes) {
+proto.ag.RebuildRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.URLRequest;
-  return proto.ag.URLRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.RebuildRequest;
+  return proto.ag.RebuildRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.URLRequest} msg The message object to deserialize into.
+ * @param {!proto.ag.RebuildRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.URLRequest}
+ * @return {!proto.ag.RebuildRequest}
  */
-proto.ag.URLRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.RebuildRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -10835,13 +18688,11 @@ proto.ag.URLRequest.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
+      msg.setSubmissionid(value);
       break;
     case 2:
-      var values = /** @type {!Array<!proto.ag.Repository.Type>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
-      for (var i = 0; i < values.length; i++) {
-        msg.addRepotypes(values[i]);
-      }
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
       break;
     default:
       reader.skipField();
@@ -10856,9 +18707,9 @@ proto.ag.URLRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.URLRequest.prototype.serializeBinary = function() {
+proto.ag.RebuildRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.URLRequest.serializeBinaryToWriter(this, writer);
+  proto.ag.RebuildRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -10866,22 +18717,22 @@ proto.ag.URLRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.URLRequest} message
+ * @param {!proto.ag.RebuildRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.URLRequest.serializeBinaryToWriter = function(message, writer) {
+proto.ag.RebuildRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCourseid();
+  f = message.getSubmissionid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getRepotypesList();
-  if (f.length > 0) {
-    writer.writePackedEnum(
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
       2,
       f
     );
@@ -10890,57 +18741,38 @@ proto.ag.URLRequest.serializeBinaryToWriter = function(message, writer) {
 
 
 /**
- * optional uint64 courseID = 1;
+ * optional uint64 submissionID = 1;
  * @return {number}
  */
-proto.ag.URLRequest.prototype.getCourseid = function() {
+proto.ag.RebuildRequest.prototype.getSubmissionid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.URLRequest} returns this
+ * @return {!proto.ag.RebuildRequest} returns this
  */
-proto.ag.URLRequest.prototype.setCourseid = function(value) {
+proto.ag.RebuildRequest.prototype.setSubmissionid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * repeated Repository.Type repoTypes = 2;
- * @return {!Array<!proto.ag.Repository.Type>}
- */
-proto.ag.URLRequest.prototype.getRepotypesList = function() {
-  return /** @type {!Array<!proto.ag.Repository.Type>} */ (jspb.Message.getRepeatedField(this, 2));
-};
-
-
-/**
- * @param {!Array<!proto.ag.Repository.Type>} value
- * @return {!proto.ag.URLRequest} returns this
- */
-proto.ag.URLRequest.prototype.setRepotypesList = function(value) {
-  return jspb.Message.setField(this, 2, value || []);
-};
-
-
-/**
- * @param {!proto.ag.Repository.Type} value
- * @param {number=} opt_index
- * @return {!proto.ag.URLRequest} returns this
+ * optional uint64 assignmentID = 2;
+ * @return {number}
  */
-proto.ag.URLRequest.prototype.addRepotypes = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+proto.ag.RebuildRequest.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ag.URLRequest} returns this
+ * @param {number} value
+ * @return {!proto.ag.RebuildRequest} returns this
  */
-proto.ag.URLRequest.prototype.clearRepotypesList = function() {
-  return this.setRepotypesList([]);
+proto.ag.RebuildRequest.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
@@ -10960,8 +18792,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.RepositoryRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.RepositoryRequest.toObject(opt_includeInstance, this);
+proto.ag.CourseUserRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.CourseUserRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -10970,15 +18802,15 @@ proto.ag.RepositoryRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.RepositoryRequest} msg The msg instance to transform.
+ * @param {!proto.ag.CourseUserRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.RepositoryRequest.toObject = function(includeInstance, msg) {
+proto.ag.CourseUserRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    userid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    groupid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    courseid: jspb.Message.getFieldWithDefault(msg, 3, 0)
+    coursecode: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    courseyear: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    userlogin: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -10992,23 +18824,23 @@ proto.ag.RepositoryRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.RepositoryRequest}
+ * @return {!proto.ag.CourseUserRequest}
  */
-proto.ag.RepositoryRequest.deserializeBinary = function(bytes) {
+proto.ag.CourseUserRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.RepositoryRequest;
-  return proto.ag.RepositoryRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.CourseUserRequest;
+  return proto.ag.CourseUserRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.RepositoryRequest} msg The message object to deserialize into.
+ * @param {!proto.ag.CourseUserRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.RepositoryRequest}
+ * @return {!proto.ag.CourseUserRequest}
  */
-proto.ag.RepositoryRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.CourseUserRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -11016,16 +18848,16 @@ proto.ag.RepositoryRequest.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setUserid(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCoursecode(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setGroupid(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setCourseyear(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUserlogin(value);
       break;
     default:
       reader.skipField();
@@ -11040,9 +18872,9 @@ proto.ag.RepositoryRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.RepositoryRequest.prototype.serializeBinary = function() {
+proto.ag.CourseUserRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.RepositoryRequest.serializeBinaryToWriter(this, writer);
+  proto.ag.CourseUserRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -11050,29 +18882,29 @@ proto.ag.RepositoryRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.RepositoryRequest} message
+ * @param {!proto.ag.CourseUserRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.RepositoryRequest.serializeBinaryToWriter = function(message, writer) {
+proto.ag.CourseUserRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUserid();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getCoursecode();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getGroupid();
+  f = message.getCourseyear();
   if (f !== 0) {
-    writer.writeUint64(
+    writer.writeUint32(
       2,
       f
     );
   }
-  f = message.getCourseid();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getUserlogin();
+  if (f.length > 0) {
+    writer.writeString(
       3,
       f
     );
@@ -11081,56 +18913,56 @@ proto.ag.RepositoryRequest.serializeBinaryToWriter = function(message, writer) {
 
 
 /**
- * optional uint64 userID = 1;
- * @return {number}
+ * optional string courseCode = 1;
+ * @return {string}
  */
-proto.ag.RepositoryRequest.prototype.getUserid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.ag.CourseUserRequest.prototype.getCoursecode = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.RepositoryRequest} returns this
+ * @param {string} value
+ * @return {!proto.ag.CourseUserRequest} returns this
  */
-proto.ag.RepositoryRequest.prototype.setUserid = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+proto.ag.CourseUserRequest.prototype.setCoursecode = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional uint64 groupID = 2;
+ * optional uint32 courseYear = 2;
  * @return {number}
  */
-proto.ag.RepositoryRequest.prototype.getGroupid = function() {
+proto.ag.CourseUserRequest.prototype.getCourseyear = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.RepositoryRequest} returns this
+ * @return {!proto.ag.CourseUserRequest} returns this
  */
-proto.ag.RepositoryRequest.prototype.setGroupid = function(value) {
+proto.ag.CourseUserRequest.prototype.setCourseyear = function(value) {
   return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional uint64 courseID = 3;
- * @return {number}
+ * optional string userLogin = 3;
+ * @return {string}
  */
-proto.ag.RepositoryRequest.prototype.getCourseid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.ag.CourseUserRequest.prototype.getUserlogin = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.RepositoryRequest} returns this
+ * @param {string} value
+ * @return {!proto.ag.CourseUserRequest} returns this
  */
-proto.ag.RepositoryRequest.prototype.setCourseid = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.ag.CourseUserRequest.prototype.setUserlogin = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
@@ -11150,8 +18982,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Repositories.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Repositories.toObject(opt_includeInstance, this);
+proto.ag.AssignmentRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.AssignmentRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -11160,13 +18992,14 @@ proto.ag.Repositories.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Repositories} msg The msg instance to transform.
+ * @param {!proto.ag.AssignmentRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Repositories.toObject = function(includeInstance, msg) {
+proto.ag.AssignmentRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    urlsMap: (f = msg.getUrlsMap()) ? f.toObject(includeInstance, undefined) : []
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -11180,23 +19013,23 @@ proto.ag.Repositories.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Repositories}
+ * @return {!proto.ag.AssignmentRequest}
  */
-proto.ag.Repositories.deserializeBinary = function(bytes) {
+proto.ag.AssignmentRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Repositories;
-  return proto.ag.Repositories.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.AssignmentRequest;
+  return proto.ag.AssignmentRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Repositories} msg The message object to deserialize into.
+ * @param {!proto.ag.AssignmentRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Repositories}
+ * @return {!proto.ag.AssignmentRequest}
  */
-proto.ag.Repositories.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.AssignmentRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -11204,10 +19037,12 @@ proto.ag.Repositories.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = msg.getUrlsMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
-         });
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
       break;
     default:
       reader.skipField();
@@ -11222,9 +19057,9 @@ proto.ag.Repositories.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.Repositories.prototype.serializeBinary = function() {
+proto.ag.AssignmentRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.Repositories.serializeBinaryToWriter(this, writer);
+  proto.ag.AssignmentRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -11232,39 +19067,63 @@ proto.ag.Repositories.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Repositories} message
+ * @param {!proto.ag.AssignmentRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Repositories.serializeBinaryToWriter = function(message, writer) {
+proto.ag.AssignmentRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUrlsMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
   }
 };
 
 
 /**
- * map<string, string> URLs = 1;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,string>}
+ * optional uint64 courseID = 1;
+ * @return {number}
  */
-proto.ag.Repositories.prototype.getUrlsMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,string>} */ (
-      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
-      null));
+proto.ag.AssignmentRequest.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.ag.Repositories} returns this
+ * @param {number} value
+ * @return {!proto.ag.AssignmentRequest} returns this
  */
-proto.ag.Repositories.prototype.clearUrlsMap = function() {
-  this.getUrlsMap().clear();
-  return this;};
+proto.ag.AssignmentRequest.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 assignmentID = 2;
+ * @return {number}
+ */
+proto.ag.AssignmentRequest.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.AssignmentRequest} returns this
+ */
+proto.ag.AssignmentRequest.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
 
 
 
@@ -11283,8 +19142,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.AuthorizationResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.AuthorizationResponse.toObject(opt_includeInstance, this);
+proto.ag.DeadlineExtensionRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.DeadlineExtensionRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -11293,13 +19152,14 @@ proto.ag.AuthorizationResponse.prototype.toObject = function(opt_includeInstance
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.AuthorizationResponse} msg The msg instance to transform.
+ * @param {!proto.ag.DeadlineExtensionRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.AuthorizationResponse.toObject = function(includeInstance, msg) {
+proto.ag.DeadlineExtensionRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    isauthorized: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    extension: (f = msg.getExtension$()) && proto.ag.DeadlineExtension.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -11313,23 +19173,23 @@ proto.ag.AuthorizationResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.AuthorizationResponse}
+ * @return {!proto.ag.DeadlineExtensionRequest}
  */
-proto.ag.AuthorizationResponse.deserializeBinary = function(bytes) {
+proto.ag.DeadlineExtensionRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.AuthorizationResponse;
-  return proto.ag.AuthorizationResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.DeadlineExtensionRequest;
+  return proto.ag.DeadlineExtensionRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.AuthorizationResponse} msg The message object to deserialize into.
+ * @param {!proto.ag.DeadlineExtensionRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.AuthorizationResponse}
+ * @return {!proto.ag.DeadlineExtensionRequest}
  */
-proto.ag.AuthorizationResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.DeadlineExtensionRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -11337,8 +19197,13 @@ proto.ag.AuthorizationResponse.deserializeBinaryFromReader = function(msg, reade
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setIsauthorized(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    case 2:
+      var value = new proto.ag.DeadlineExtension;
+      reader.readMessage(value,proto.ag.DeadlineExtension.deserializeBinaryFromReader);
+      msg.setExtension$(value);
       break;
     default:
       reader.skipField();
@@ -11353,9 +19218,9 @@ proto.ag.AuthorizationResponse.deserializeBinaryFromReader = function(msg, reade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.AuthorizationResponse.prototype.serializeBinary = function() {
+proto.ag.DeadlineExtensionRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.AuthorizationResponse.serializeBinaryToWriter(this, writer);
+  proto.ag.DeadlineExtensionRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -11363,37 +19228,82 @@ proto.ag.AuthorizationResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.AuthorizationResponse} message
+ * @param {!proto.ag.DeadlineExtensionRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.AuthorizationResponse.serializeBinaryToWriter = function(message, writer) {
+proto.ag.DeadlineExtensionRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getIsauthorized();
-  if (f) {
-    writer.writeBool(
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
       1,
       f
     );
   }
+  f = message.getExtension$();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.ag.DeadlineExtension.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional bool IsAuthorized = 1;
- * @return {boolean}
+ * optional uint64 courseID = 1;
+ * @return {number}
  */
-proto.ag.AuthorizationResponse.prototype.getIsauthorized = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+proto.ag.DeadlineExtensionRequest.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.ag.AuthorizationResponse} returns this
+ * @param {number} value
+ * @return {!proto.ag.DeadlineExtensionRequest} returns this
  */
-proto.ag.AuthorizationResponse.prototype.setIsauthorized = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
+proto.ag.DeadlineExtensionRequest.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional DeadlineExtension extension = 2;
+ * @return {?proto.ag.DeadlineExtension}
+ */
+proto.ag.DeadlineExtensionRequest.prototype.getExtension$ = function() {
+  return /** @type{?proto.ag.DeadlineExtension} */ (
+    jspb.Message.getWrapperField(this, proto.ag.DeadlineExtension, 2));
+};
+
+
+/**
+ * @param {?proto.ag.DeadlineExtension|undefined} value
+ * @return {!proto.ag.DeadlineExtensionRequest} returns this
+*/
+proto.ag.DeadlineExtensionRequest.prototype.setExtension$ = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ag.DeadlineExtensionRequest} returns this
+ */
+proto.ag.DeadlineExtensionRequest.prototype.clearExtension$ = function() {
+  return this.setExtension$(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ag.DeadlineExtensionRequest.prototype.hasExtension$ = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
@@ -11413,8 +19323,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Status.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Status.toObject(opt_includeInstance, this);
+proto.ag.CourseGradeRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.CourseGradeRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -11423,14 +19333,14 @@ proto.ag.Status.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Status} msg The msg instance to transform.
+ * @param {!proto.ag.CourseGradeRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Status.toObject = function(includeInstance, msg) {
+proto.ag.CourseGradeRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    code: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    error: jspb.Message.getFieldWithDefault(msg, 2, "")
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    userid: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -11444,23 +19354,23 @@ proto.ag.Status.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Status}
+ * @return {!proto.ag.CourseGradeRequest}
  */
-proto.ag.Status.deserializeBinary = function(bytes) {
+proto.ag.CourseGradeRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Status;
-  return proto.ag.Status.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.CourseGradeRequest;
+  return proto.ag.CourseGradeRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Status} msg The message object to deserialize into.
+ * @param {!proto.ag.CourseGradeRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Status}
+ * @return {!proto.ag.CourseGradeRequest}
  */
-proto.ag.Status.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.CourseGradeRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -11469,11 +19379,11 @@ proto.ag.Status.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setCode(value);
+      msg.setCourseid(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setError(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setUserid(value);
       break;
     default:
       reader.skipField();
@@ -11488,9 +19398,9 @@ proto.ag.Status.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.Status.prototype.serializeBinary = function() {
+proto.ag.CourseGradeRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.Status.serializeBinaryToWriter(this, writer);
+  proto.ag.CourseGradeRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -11498,22 +19408,22 @@ proto.ag.Status.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Status} message
+ * @param {!proto.ag.CourseGradeRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Status.serializeBinaryToWriter = function(message, writer) {
+proto.ag.CourseGradeRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCode();
+  f = message.getCourseid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getError();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getUserid();
+  if (f !== 0) {
+    writer.writeUint64(
       2,
       f
     );
@@ -11522,42 +19432,49 @@ proto.ag.Status.serializeBinaryToWriter = function(message, writer) {
 
 
 /**
- * optional uint64 Code = 1;
+ * optional uint64 courseID = 1;
  * @return {number}
  */
-proto.ag.Status.prototype.getCode = function() {
+proto.ag.CourseGradeRequest.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Status} returns this
+ * @return {!proto.ag.CourseGradeRequest} returns this
  */
-proto.ag.Status.prototype.setCode = function(value) {
+proto.ag.CourseGradeRequest.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional string Error = 2;
- * @return {string}
+ * optional uint64 userID = 2;
+ * @return {number}
  */
-proto.ag.Status.prototype.getError = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.ag.CourseGradeRequest.prototype.getUserid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ag.Status} returns this
+ * @param {number} value
+ * @return {!proto.ag.CourseGradeRequest} returns this
  */
-proto.ag.Status.prototype.setError = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.ag.CourseGradeRequest.prototype.setUserid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.CourseRepositoryValidation.repeatedFields_ = [2,3,4,5,6];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -11573,8 +19490,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.SubmissionsForCourseRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.SubmissionsForCourseRequest.toObject(opt_includeInstance, this);
+proto.ag.CourseRepositoryValidation.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.CourseRepositoryValidation.toObject(opt_includeInstance, this);
 };
 
 
@@ -11583,15 +19500,19 @@ proto.ag.SubmissionsForCourseRequest.prototype.toObject = function(opt_includeIn
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.SubmissionsForCourseRequest} msg The msg instance to transform.
+ * @param {!proto.ag.CourseRepositoryValidation} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.SubmissionsForCourseRequest.toObject = function(includeInstance, msg) {
+proto.ag.CourseRepositoryValidation.toObject = function(includeInstance, msg) {
   var f, obj = {
     courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    type: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    withbuildinfo: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
+    assignmentsList: jspb.Message.toObjectList(msg.getAssignmentsList(),
+    proto.ag.Assignment.toObject, includeInstance),
+    changedassignmentsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+    removedassignmentsList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
+    warningsList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f,
+    errorsList: (f = jspb.Message.getRepeatedField(msg, 6)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -11605,23 +19526,23 @@ proto.ag.SubmissionsForCourseRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.SubmissionsForCourseRequest}
+ * @return {!proto.ag.CourseRepositoryValidation}
  */
-proto.ag.SubmissionsForCourseRequest.deserializeBinary = function(bytes) {
+proto.ag.CourseRepositoryValidation.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.SubmissionsForCourseRequest;
-  return proto.ag.SubmissionsForCourseRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.CourseRepositoryValidation;
+  return proto.ag.CourseRepositoryValidation.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.SubmissionsForCourseRequest} msg The message object to deserialize into.
+ * @param {!proto.ag.CourseRepositoryValidation} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.SubmissionsForCourseRequest}
+ * @return {!proto.ag.CourseRepositoryValidation}
  */
-proto.ag.SubmissionsForCourseRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.CourseRepositoryValidation.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -11633,12 +19554,25 @@ proto.ag.SubmissionsForCourseRequest.deserializeBinaryFromReader = function(msg,
       msg.setCourseid(value);
       break;
     case 2:
-      var value = /** @type {!proto.ag.SubmissionsForCourseRequest.Type} */ (reader.readEnum());
-      msg.setType(value);
+      var value = new proto.ag.Assignment;
+      reader.readMessage(value,proto.ag.Assignment.deserializeBinaryFromReader);
+      msg.addAssignments(value);
       break;
     case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setWithbuildinfo(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.addChangedassignments(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addRemovedassignments(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addWarnings(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addErrors(value);
       break;
     default:
       reader.skipField();
@@ -11653,9 +19587,9 @@ proto.ag.SubmissionsForCourseRequest.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.SubmissionsForCourseRequest.prototype.serializeBinary = function() {
+proto.ag.CourseRepositoryValidation.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.SubmissionsForCourseRequest.serializeBinaryToWriter(this, writer);
+  proto.ag.CourseRepositoryValidation.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -11663,11 +19597,11 @@ proto.ag.SubmissionsForCourseRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.SubmissionsForCourseRequest} message
+ * @param {!proto.ag.CourseRepositoryValidation} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.SubmissionsForCourseRequest.serializeBinaryToWriter = function(message, writer) {
+proto.ag.CourseRepositoryValidation.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getCourseid();
   if (f !== 0) {
@@ -11676,247 +19610,257 @@ proto.ag.SubmissionsForCourseRequest.serializeBinaryToWriter = function(message,
       f
     );
   }
-  f = message.getType();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getAssignmentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
       2,
-      f
+      f,
+      proto.ag.Assignment.serializeBinaryToWriter
     );
   }
-  f = message.getWithbuildinfo();
-  if (f) {
-    writer.writeBool(
+  f = message.getChangedassignmentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
       3,
       f
     );
   }
+  f = message.getRemovedassignmentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      4,
+      f
+    );
+  }
+  f = message.getWarningsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      5,
+      f
+    );
+  }
+  f = message.getErrorsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      6,
+      f
+    );
+  }
 };
 
 
-/**
- * @enum {number}
- */
-proto.ag.SubmissionsForCourseRequest.Type = {
-  ALL: 0,
-  INDIVIDUAL: 1,
-  GROUP: 2
-};
-
 /**
  * optional uint64 courseID = 1;
  * @return {number}
  */
-proto.ag.SubmissionsForCourseRequest.prototype.getCourseid = function() {
+proto.ag.CourseRepositoryValidation.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.SubmissionsForCourseRequest} returns this
+ * @return {!proto.ag.CourseRepositoryValidation} returns this
  */
-proto.ag.SubmissionsForCourseRequest.prototype.setCourseid = function(value) {
+proto.ag.CourseRepositoryValidation.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional Type type = 2;
- * @return {!proto.ag.SubmissionsForCourseRequest.Type}
+ * repeated Assignment assignments = 2;
+ * @return {!Array<!proto.ag.Assignment>}
  */
-proto.ag.SubmissionsForCourseRequest.prototype.getType = function() {
-  return /** @type {!proto.ag.SubmissionsForCourseRequest.Type} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.ag.CourseRepositoryValidation.prototype.getAssignmentsList = function() {
+  return /** @type{!Array<!proto.ag.Assignment>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.Assignment, 2));
 };
 
 
 /**
- * @param {!proto.ag.SubmissionsForCourseRequest.Type} value
- * @return {!proto.ag.SubmissionsForCourseRequest} returns this
+ * @param {!Array<!proto.ag.Assignment>} value
+ * @return {!proto.ag.CourseRepositoryValidation} returns this
+*/
+proto.ag.CourseRepositoryValidation.prototype.setAssignmentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.ag.Assignment=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.Assignment}
  */
-proto.ag.SubmissionsForCourseRequest.prototype.setType = function(value) {
-  return jspb.Message.setProto3EnumField(this, 2, value);
+proto.ag.CourseRepositoryValidation.prototype.addAssignments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.ag.Assignment, opt_index);
 };
 
 
 /**
- * optional bool withBuildInfo = 3;
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.CourseRepositoryValidation} returns this
  */
-proto.ag.SubmissionsForCourseRequest.prototype.getWithbuildinfo = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+proto.ag.CourseRepositoryValidation.prototype.clearAssignmentsList = function() {
+  return this.setAssignmentsList([]);
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.ag.SubmissionsForCourseRequest} returns this
+ * repeated string changedAssignments = 3;
+ * @return {!Array<string>}
  */
-proto.ag.SubmissionsForCourseRequest.prototype.setWithbuildinfo = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 3, value);
+proto.ag.CourseRepositoryValidation.prototype.getChangedassignmentsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.ag.CourseRepositoryValidation} returns this
+ */
+proto.ag.CourseRepositoryValidation.prototype.setChangedassignmentsList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.ag.CourseRepositoryValidation} returns this
+ */
+proto.ag.CourseRepositoryValidation.prototype.addChangedassignments = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.CourseRepositoryValidation} returns this
+ */
+proto.ag.CourseRepositoryValidation.prototype.clearChangedassignmentsList = function() {
+  return this.setChangedassignmentsList([]);
 };
 
 
+/**
+ * repeated string removedAssignments = 4;
+ * @return {!Array<string>}
+ */
+proto.ag.CourseRepositoryValidation.prototype.getRemovedassignmentsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 4));
+};
 
 
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.ag.CourseRepositoryValidation} returns this
+ */
+proto.ag.CourseRepositoryValidation.prototype.setRemovedassignmentsList = function(value) {
+  return jspb.Message.setField(this, 4, value || []);
+};
+
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.ag.CourseRepositoryValidation} returns this
  */
-proto.ag.RebuildRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.RebuildRequest.toObject(opt_includeInstance, this);
+proto.ag.CourseRepositoryValidation.prototype.addRemovedassignments = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.ag.RebuildRequest} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.CourseRepositoryValidation} returns this
  */
-proto.ag.RebuildRequest.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    submissionid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.ag.CourseRepositoryValidation.prototype.clearRemovedassignmentsList = function() {
+  return this.setRemovedassignmentsList([]);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.RebuildRequest}
+ * repeated string warnings = 5;
+ * @return {!Array<string>}
  */
-proto.ag.RebuildRequest.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.RebuildRequest;
-  return proto.ag.RebuildRequest.deserializeBinaryFromReader(msg, reader);
+proto.ag.CourseRepositoryValidation.prototype.getWarningsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 5));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.ag.RebuildRequest} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.RebuildRequest}
+ * @param {!Array<string>} value
+ * @return {!proto.ag.CourseRepositoryValidation} returns this
  */
-proto.ag.RebuildRequest.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setSubmissionid(value);
-      break;
-    case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setAssignmentid(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.ag.CourseRepositoryValidation.prototype.setWarningsList = function(value) {
+  return jspb.Message.setField(this, 5, value || []);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.ag.CourseRepositoryValidation} returns this
  */
-proto.ag.RebuildRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.ag.RebuildRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.ag.CourseRepositoryValidation.prototype.addWarnings = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 5, value, opt_index);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.ag.RebuildRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.CourseRepositoryValidation} returns this
  */
-proto.ag.RebuildRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getSubmissionid();
-  if (f !== 0) {
-    writer.writeUint64(
-      1,
-      f
-    );
-  }
-  f = message.getAssignmentid();
-  if (f !== 0) {
-    writer.writeUint64(
-      2,
-      f
-    );
-  }
+proto.ag.CourseRepositoryValidation.prototype.clearWarningsList = function() {
+  return this.setWarningsList([]);
 };
 
 
 /**
- * optional uint64 submissionID = 1;
- * @return {number}
+ * repeated string errors = 6;
+ * @return {!Array<string>}
  */
-proto.ag.RebuildRequest.prototype.getSubmissionid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.ag.CourseRepositoryValidation.prototype.getErrorsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 6));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.RebuildRequest} returns this
+ * @param {!Array<string>} value
+ * @return {!proto.ag.CourseRepositoryValidation} returns this
  */
-proto.ag.RebuildRequest.prototype.setSubmissionid = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+proto.ag.CourseRepositoryValidation.prototype.setErrorsList = function(value) {
+  return jspb.Message.setField(this, 6, value || []);
 };
 
 
 /**
- * optional uint64 assignmentID = 2;
- * @return {number}
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.ag.CourseRepositoryValidation} returns this
  */
-proto.ag.RebuildRequest.prototype.getAssignmentid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.ag.CourseRepositoryValidation.prototype.addErrors = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 6, value, opt_index);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.RebuildRequest} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.CourseRepositoryValidation} returns this
  */
-proto.ag.RebuildRequest.prototype.setAssignmentid = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.ag.CourseRepositoryValidation.prototype.clearErrorsList = function() {
+  return this.setErrorsList([]);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.AssignmentArchiveRequest.repeatedFields_ = [2];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -11932,8 +19876,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.CourseUserRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.CourseUserRequest.toObject(opt_includeInstance, this);
+proto.ag.AssignmentArchiveRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.AssignmentArchiveRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -11942,15 +19886,14 @@ proto.ag.CourseUserRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.CourseUserRequest} msg The msg instance to transform.
+ * @param {!proto.ag.AssignmentArchiveRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.CourseUserRequest.toObject = function(includeInstance, msg) {
+proto.ag.AssignmentArchiveRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    coursecode: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    courseyear: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    userlogin: jspb.Message.getFieldWithDefault(msg, 3, "")
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    assignmentnamesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -11964,23 +19907,23 @@ proto.ag.CourseUserRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.CourseUserRequest}
+ * @return {!proto.ag.AssignmentArchiveRequest}
  */
-proto.ag.CourseUserRequest.deserializeBinary = function(bytes) {
+proto.ag.AssignmentArchiveRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.CourseUserRequest;
-  return proto.ag.CourseUserRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.AssignmentArchiveRequest;
+  return proto.ag.AssignmentArchiveRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.CourseUserRequest} msg The message object to deserialize into.
+ * @param {!proto.ag.AssignmentArchiveRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.CourseUserRequest}
+ * @return {!proto.ag.AssignmentArchiveRequest}
  */
-proto.ag.CourseUserRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.AssignmentArchiveRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -11988,16 +19931,12 @@ proto.ag.CourseUserRequest.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setCoursecode(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setCourseyear(value);
-      break;
-    case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.setUserlogin(value);
+      msg.addAssignmentnames(value);
       break;
     default:
       reader.skipField();
@@ -12012,9 +19951,9 @@ proto.ag.CourseUserRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.CourseUserRequest.prototype.serializeBinary = function() {
+proto.ag.AssignmentArchiveRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.CourseUserRequest.serializeBinaryToWriter(this, writer);
+  proto.ag.AssignmentArchiveRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -12022,30 +19961,23 @@ proto.ag.CourseUserRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.CourseUserRequest} message
+ * @param {!proto.ag.AssignmentArchiveRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.CourseUserRequest.serializeBinaryToWriter = function(message, writer) {
+proto.ag.AssignmentArchiveRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCoursecode();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getCourseyear();
+  f = message.getCourseid();
   if (f !== 0) {
-    writer.writeUint32(
-      2,
+    writer.writeUint64(
+      1,
       f
     );
   }
-  f = message.getUserlogin();
+  f = message.getAssignmentnamesList();
   if (f.length > 0) {
-    writer.writeString(
-      3,
+    writer.writeRepeatedString(
+      2,
       f
     );
   }
@@ -12053,56 +19985,57 @@ proto.ag.CourseUserRequest.serializeBinaryToWriter = function(message, writer) {
 
 
 /**
- * optional string courseCode = 1;
- * @return {string}
+ * optional uint64 courseID = 1;
+ * @return {number}
  */
-proto.ag.CourseUserRequest.prototype.getCoursecode = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.ag.AssignmentArchiveRequest.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ag.CourseUserRequest} returns this
+ * @param {number} value
+ * @return {!proto.ag.AssignmentArchiveRequest} returns this
  */
-proto.ag.CourseUserRequest.prototype.setCoursecode = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.ag.AssignmentArchiveRequest.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint32 courseYear = 2;
- * @return {number}
+ * repeated string assignmentNames = 2;
+ * @return {!Array<string>}
  */
-proto.ag.CourseUserRequest.prototype.getCourseyear = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.ag.AssignmentArchiveRequest.prototype.getAssignmentnamesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.CourseUserRequest} returns this
+ * @param {!Array<string>} value
+ * @return {!proto.ag.AssignmentArchiveRequest} returns this
  */
-proto.ag.CourseUserRequest.prototype.setCourseyear = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.ag.AssignmentArchiveRequest.prototype.setAssignmentnamesList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
 };
 
 
 /**
- * optional string userLogin = 3;
- * @return {string}
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.ag.AssignmentArchiveRequest} returns this
  */
-proto.ag.CourseUserRequest.prototype.getUserlogin = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.ag.AssignmentArchiveRequest.prototype.addAssignmentnames = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ag.CourseUserRequest} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.AssignmentArchiveRequest} returns this
  */
-proto.ag.CourseUserRequest.prototype.setUserlogin = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.ag.AssignmentArchiveRequest.prototype.clearAssignmentnamesList = function() {
+  return this.setAssignmentnamesList([]);
 };
 
 
@@ -12122,8 +20055,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.AssignmentRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.AssignmentRequest.toObject(opt_includeInstance, this);
+proto.ag.AssignmentArchive.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.AssignmentArchive.toObject(opt_includeInstance, this);
 };
 
 
@@ -12132,14 +20065,14 @@ proto.ag.AssignmentRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.AssignmentRequest} msg The msg instance to transform.
+ * @param {!proto.ag.AssignmentArchive} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.AssignmentRequest.toObject = function(includeInstance, msg) {
+proto.ag.AssignmentArchive.toObject = function(includeInstance, msg) {
   var f, obj = {
     courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0)
+    archive: msg.getArchive_asB64()
   };
 
   if (includeInstance) {
@@ -12153,23 +20086,23 @@ proto.ag.AssignmentRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.AssignmentRequest}
+ * @return {!proto.ag.AssignmentArchive}
  */
-proto.ag.AssignmentRequest.deserializeBinary = function(bytes) {
+proto.ag.AssignmentArchive.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.AssignmentRequest;
-  return proto.ag.AssignmentRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.AssignmentArchive;
+  return proto.ag.AssignmentArchive.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.AssignmentRequest} msg The message object to deserialize into.
+ * @param {!proto.ag.AssignmentArchive} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.AssignmentRequest}
+ * @return {!proto.ag.AssignmentArchive}
  */
-proto.ag.AssignmentRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.AssignmentArchive.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -12181,8 +20114,8 @@ proto.ag.AssignmentRequest.deserializeBinaryFromReader = function(msg, reader) {
       msg.setCourseid(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setAssignmentid(value);
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setArchive(value);
       break;
     default:
       reader.skipField();
@@ -12197,9 +20130,9 @@ proto.ag.AssignmentRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.AssignmentRequest.prototype.serializeBinary = function() {
+proto.ag.AssignmentArchive.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.AssignmentRequest.serializeBinaryToWriter(this, writer);
+  proto.ag.AssignmentArchive.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -12207,11 +20140,11 @@ proto.ag.AssignmentRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.AssignmentRequest} message
+ * @param {!proto.ag.AssignmentArchive} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.AssignmentRequest.serializeBinaryToWriter = function(message, writer) {
+proto.ag.AssignmentArchive.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getCourseid();
   if (f !== 0) {
@@ -12220,9 +20153,9 @@ proto.ag.AssignmentRequest.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
-  f = message.getAssignmentid();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getArchive_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
       2,
       f
     );
@@ -12234,35 +20167,59 @@ proto.ag.AssignmentRequest.serializeBinaryToWriter = function(message, writer) {
  * optional uint64 courseID = 1;
  * @return {number}
  */
-proto.ag.AssignmentRequest.prototype.getCourseid = function() {
+proto.ag.AssignmentArchive.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.AssignmentRequest} returns this
+ * @return {!proto.ag.AssignmentArchive} returns this
  */
-proto.ag.AssignmentRequest.prototype.setCourseid = function(value) {
+proto.ag.AssignmentArchive.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 assignmentID = 2;
- * @return {number}
+ * optional bytes archive = 2;
+ * @return {!(string|Uint8Array)}
  */
-proto.ag.AssignmentRequest.prototype.getAssignmentid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.ag.AssignmentArchive.prototype.getArchive = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.AssignmentRequest} returns this
+ * optional bytes archive = 2;
+ * This is a type-conversion wrapper around `getArchive()`
+ * @return {string}
  */
-proto.ag.AssignmentRequest.prototype.setAssignmentid = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.ag.AssignmentArchive.prototype.getArchive_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getArchive()));
+};
+
+
+/**
+ * optional bytes archive = 2;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getArchive()`
+ * @return {!Uint8Array}
+ */
+proto.ag.AssignmentArchive.prototype.getArchive_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getArchive()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.ag.AssignmentArchive} returns this
+ */
+proto.ag.AssignmentArchive.prototype.setArchive = function(value) {
+  return jspb.Message.setProto3BytesField(this, 2, value);
 };
 
 
@@ -27,6 +27,15 @@ export class Score extends jspb.Message {
   getTestdetails(): string;
   setTestdetails(value: string): Score;
 
+  getExectimems(): number;
+  setExectimems(value: number): Score;
+
+  getSignature(): string;
+  setSignature(value: string): Score;
+
+  getDelta(): number;
+  setDelta(value: number): Score;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): Score.AsObject;
   static toObject(includeInstance: boolean, msg: Score): Score.AsObject;
@@ -45,6 +54,9 @@ export namespace Score {
     maxscore: number,
     weight: number,
     testdetails: string,
+    exectimems: number,
+    signature: string,
+    delta: number,
   }
 }
 
@@ -64,6 +76,15 @@ export class BuildInfo extends jspb.Message {
   getExectime(): number;
   setExectime(value: number): BuildInfo;
 
+  getSeed(): number;
+  setSeed(value: number): BuildInfo;
+
+  getTimedout(): boolean;
+  setTimedout(value: boolean): BuildInfo;
+
+  getTriggeredby(): string;
+  setTriggeredby(value: string): BuildInfo;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): BuildInfo.AsObject;
   static toObject(includeInstance: boolean, msg: BuildInfo): BuildInfo.AsObject;
@@ -79,6 +100,9 @@ export namespace BuildInfo {
     builddate: string,
     buildlog: string,
     exectime: number,
+    seed: number,
+    timedout: boolean,
+    triggeredby: string,
   }
 }
 
@@ -104,7 +104,10 @@ proto.score.Score.toObject = function(includeInstance, msg) {
     score: jspb.Message.getFieldWithDefault(msg, 5, 0),
     maxscore: jspb.Message.getFieldWithDefault(msg, 6, 0),
     weight: jspb.Message.getFieldWithDefault(msg, 7, 0),
-    testdetails: jspb.Message.getFieldWithDefault(msg, 8, "")
+    testdetails: jspb.Message.getFieldWithDefault(msg, 8, ""),
+    exectimems: jspb.Message.getFieldWithDefault(msg, 9, 0),
+    signature: jspb.Message.getFieldWithDefault(msg, 10, ""),
+    delta: jspb.Message.getFieldWithDefault(msg, 11, 0)
   };
 
   if (includeInstance) {
@@ -173,6 +176,18 @@ proto.score.Score.deserializeBinaryFromReader = function(msg, reader) {
       var value = /** @type {string} */ (reader.readString());
       msg.setTestdetails(value);
       break;
+    case 9:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setExectimems(value);
+      break;
+    case 10:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSignature(value);
+      break;
+    case 11:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setDelta(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -258,6 +273,27 @@ proto.score.Score.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
+  f = message.getExectimems();
+  if (f !== 0) {
+    writer.writeInt64(
+      9,
+      f
+    );
+  }
+  f = message.getSignature();
+  if (f.length > 0) {
+    writer.writeString(
+      10,
+      f
+    );
+  }
+  f = message.getDelta();
+  if (f !== 0) {
+    writer.writeInt32(
+      11,
+      f
+    );
+  }
 };
 
 
@@ -405,6 +441,60 @@ proto.score.Score.prototype.setTestdetails = function(value) {
 };
 
 
+/**
+ * optional int64 ExecTimeMS = 9;
+ * @return {number}
+ */
+proto.score.Score.prototype.getExectimems = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.score.Score} returns this
+ */
+proto.score.Score.prototype.setExectimems = function(value) {
+  return jspb.Message.setProto3IntField(this, 9, value);
+};
+
+
+/**
+ * optional string Signature = 10;
+ * @return {string}
+ */
+proto.score.Score.prototype.getSignature = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 10, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.score.Score} returns this
+ */
+proto.score.Score.prototype.setSignature = function(value) {
+  return jspb.Message.setProto3StringField(this, 10, value);
+};
+
+
+/**
+ * optional int32 Delta = 11;
+ * @return {number}
+ */
+proto.score.Score.prototype.getDelta = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.score.Score} returns this
+ */
+proto.score.Score.prototype.setDelta = function(value) {
+  return jspb.Message.setProto3IntField(this, 11, value);
+};
+
+
 
 
 
@@ -441,7 +531,10 @@ proto.score.BuildInfo.toObject = function(includeInstance, msg) {
     submissionid: jspb.Message.getFieldWithDefault(msg, 2, 0),
     builddate: jspb.Message.getFieldWithDefault(msg, 3, ""),
     buildlog: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    exectime: jspb.Message.getFieldWithDefault(msg, 5, 0)
+    exectime: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    seed: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    timedout: jspb.Message.getBooleanFieldWithDefault(msg, 7, false),
+    triggeredby: jspb.Message.getFieldWithDefault(msg, 8, "")
   };
 
   if (includeInstance) {
@@ -498,6 +591,18 @@ proto.score.BuildInfo.deserializeBinaryFromReader = function(msg, reader) {
       var value = /** @type {number} */ (reader.readInt64());
       msg.setExectime(value);
       break;
+    case 6:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setSeed(value);
+      break;
+    case 7:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setTimedout(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTriggeredby(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -562,6 +667,27 @@ proto.score.BuildInfo.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
+  f = message.getSeed();
+  if (f !== 0) {
+    writer.writeUint64(
+      6,
+      f
+    );
+  }
+  f = message.getTimedout();
+  if (f) {
+    writer.writeBool(
+      7,
+      f
+    );
+  }
+  f = message.getTriggeredby();
+  if (f.length > 0) {
+    writer.writeString(
+      8,
+      f
+    );
+  }
 };
 
 
@@ -655,4 +781,58 @@ proto.score.BuildInfo.prototype.setExectime = function(value) {
 };
 
 
+/**
+ * optional uint64 Seed = 6;
+ * @return {number}
+ */
+proto.score.BuildInfo.prototype.getSeed = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.score.BuildInfo} returns this
+ */
+proto.score.BuildInfo.prototype.setSeed = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+/**
+ * optional bool TimedOut = 7;
+ * @return {boolean}
+ */
+proto.score.BuildInfo.prototype.getTimedout = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 7, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.score.BuildInfo} returns this
+ */
+proto.score.BuildInfo.prototype.setTimedout = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 7, value);
+};
+
+
+/**
+ * optional string TriggeredBy = 8;
+ * @return {string}
+ */
+proto.score.BuildInfo.prototype.getTriggeredby = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.score.BuildInfo} returns this
+ */
+proto.score.BuildInfo.prototype.setTriggeredby = function(value) {
+  return jspb.Message.setProto3StringField(this, 8, value);
+};
+
+
 goog.object.extend(exports, proto.score);
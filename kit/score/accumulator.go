@@ -0,0 +1,75 @@
+package score
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Accumulator collects score objects from test output as it is produced,
+// allowing a provisional grade to be computed before the tests have completed.
+// It is safe for concurrent use.
+type Accumulator struct {
+	mu          sync.Mutex
+	secret      string
+	totalWeight int32
+	results     *Results
+	errs        []error
+}
+
+// NewAccumulator returns an accumulator for score objects carrying the given secret.
+// The totalWeight is the sum of the weights of all tests expected to be reported,
+// if known in advance; otherwise it should be zero.
+func NewAccumulator(secret string, totalWeight int32) *Accumulator {
+	return &Accumulator{
+		secret:      secret,
+		totalWeight: totalWeight,
+		results:     NewResults(),
+	}
+}
+
+// Add records the score object in the given line of test output, if any.
+// Lines without a score object are ignored.
+func (a *Accumulator) Add(line string) {
+	if !isScoreLine(line) {
+		return
+	}
+	sc, err := parseScoreLine(strings.TrimSpace(line), a.secret)
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if err != nil {
+		a.errs = append(a.errs, fmt.Errorf("failed to parse score: %v", err))
+		return
+	}
+	a.results.addScore(sc)
+}
+
+// Results returns the results accumulated so far.
+func (a *Accumulator) Results() *Results {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return &Results{
+		Scores: a.results.toScoreSlice(),
+		Errors: append([]error(nil), a.errs...),
+	}
+}
+
+// ProvisionalGrade returns the weighted grade in the range 0-100 computed over
+// the scores received so far. If the total weight of the tests is known, the
+// grade is normalized against it, such that tests not yet reported count as
+// failed. Otherwise, the grade is normalized against the weights received so far.
+func (a *Accumulator) ProvisionalGrade() uint32 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	scores := a.results.toScoreSlice()
+	receivedWeight := int32(0)
+	for _, sc := range scores {
+		receivedWeight += sc.GetWeight()
+	}
+	totalWeight := a.totalWeight
+	if totalWeight < receivedWeight {
+		// more tests were reported than declared; avoid grades above 100
+		totalWeight = receivedWeight
+	}
+	return grade(scores, float64(totalWeight))
+}
@@ -0,0 +1,65 @@
+package score_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/autograde/quickfeed/kit/score"
+)
+
+const accumulatorSecret = "my secret code"
+
+func scoreLine(testName string, points, maxScore, weight int) string {
+	return fmt.Sprintf(`{"Secret":%q,"TestName":%q,"Score":%d,"MaxScore":%d,"Weight":%d}`,
+		accumulatorSecret, testName, points, maxScore, weight)
+}
+
+func TestAccumulatorProvisionalGrade(t *testing.T) {
+	lines := []string{
+		"=== RUN   TestA",
+		scoreLine("TestA", 10, 10, 1),
+		"--- PASS: TestA (0.00s)",
+		scoreLine("TestB", 5, 10, 1),
+		scoreLine("TestC", 0, 10, 2),
+		scoreLine("TestD", 10, 10, 4),
+	}
+	tests := []struct {
+		name        string
+		totalWeight int32
+		want        []uint32 // provisional grade after each line
+	}{
+		{name: "unknown total weight", totalWeight: 0, want: []uint32{0, 100, 100, 75, 38, 69}},
+		{name: "known total weight", totalWeight: 8, want: []uint32{0, 13, 13, 19, 19, 69}},
+		{name: "underestimated total weight", totalWeight: 2, want: []uint32{0, 50, 50, 75, 38, 69}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			acc := score.NewAccumulator(accumulatorSecret, test.totalWeight)
+			for i, line := range lines {
+				acc.Add(line)
+				if got := acc.ProvisionalGrade(); got != test.want[i] {
+					t.Errorf("ProvisionalGrade() after line %d = %d, want %d", i, got, test.want[i])
+				}
+			}
+			results := acc.Results()
+			if len(results.Errors) > 0 {
+				t.Errorf("Results().Errors = %v, want none", results.Errors)
+			}
+			if got, want := results.Sum(), test.want[len(test.want)-1]; got != want {
+				t.Errorf("Results().Sum() = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestAccumulatorInvalidScore(t *testing.T) {
+	acc := score.NewAccumulator(accumulatorSecret, 0)
+	acc.Add(scoreLine("TestA", 10, 10, 1))
+	acc.Add(`{"Secret":"wrong secret","TestName":"TestB","Score":0,"MaxScore":10,"Weight":1}`)
+	if got := acc.ProvisionalGrade(); got != 100 {
+		t.Errorf("ProvisionalGrade() = %d, want 100", got)
+	}
+	if errs := acc.Results().Errors; len(errs) != 1 {
+		t.Errorf("Results().Errors = %v, want 1 error", errs)
+	}
+}
@@ -0,0 +1,111 @@
+package score
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+)
+
+// BenchmarkMetric identifies a per-operation measurement of a benchmark.
+type BenchmarkMetric int
+
+const (
+	// NsPerOp is the number of nanoseconds per operation.
+	NsPerOp BenchmarkMetric = iota
+	// AllocsPerOp is the number of memory allocations per operation.
+	AllocsPerOp
+	// BytesPerOp is the number of bytes allocated per operation.
+	BytesPerOp
+)
+
+func (m BenchmarkMetric) String() string {
+	switch m {
+	case NsPerOp:
+		return "ns/op"
+	case AllocsPerOp:
+		return "allocs/op"
+	case BytesPerOp:
+		return "B/op"
+	}
+	return fmt.Sprintf("BenchmarkMetric(%d)", int(m))
+}
+
+// value returns the measurement of the metric from the benchmark result.
+func (m BenchmarkMetric) value(result testing.BenchmarkResult) int64 {
+	switch m {
+	case NsPerOp:
+		return result.NsPerOp()
+	case AllocsPerOp:
+		return result.AllocsPerOp()
+	case BytesPerOp:
+		return result.AllocedBytesPerOp()
+	}
+	return math.MaxInt64
+}
+
+// BenchmarkBand awards the given percentage of the max score
+// if the measured value is at most Threshold.
+type BenchmarkBand struct {
+	Threshold int64
+	Percent   uint32
+}
+
+// BenchmarkCriterion holds the instructor-provided partial credit bands for one
+// metric of a benchmark. The band with the highest percentage whose threshold is
+// met applies; if no band's threshold is met, no credit is awarded.
+type BenchmarkCriterion struct {
+	Metric BenchmarkMetric
+	Bands  []BenchmarkBand
+}
+
+// percent returns the percentage awarded for the given measured value.
+func (c BenchmarkCriterion) percent(value int64) uint32 {
+	var best uint32
+	for _, band := range c.Bands {
+		if value <= band.Threshold && band.Percent > best {
+			best = band.Percent
+		}
+	}
+	if best > 100 {
+		return 100
+	}
+	return best
+}
+
+// Benchmark sets the score based on the given benchmark result, such as the
+// one returned by testing.Benchmark, measured against the given criteria.
+// The score is the max score scaled by the lowest percentage awarded by any
+// of the criteria, such that a solution must meet the thresholds of all criteria
+// to obtain the corresponding credit. The measurements and awarded percentages
+// are recorded in the TestDetails field. If the benchmark did not run, e.g.,
+// because it failed, the score is set to zero.
+//
+// For example, to award full credit for at most 1000 ns/op and half credit
+// for at most 5000 ns/op:
+//
+//   sc := score.Min()
+//   defer sc.Print(t)
+//   sc.Benchmark(testing.Benchmark(BenchmarkFib), score.BenchmarkCriterion{
+//       Metric: score.NsPerOp,
+//       Bands:  []score.BenchmarkBand{{Threshold: 1000, Percent: 100}, {Threshold: 5000, Percent: 50}},
+//   })
+func (s *Score) Benchmark(result testing.BenchmarkResult, criteria ...BenchmarkCriterion) {
+	if result.N <= 0 {
+		s.Score = 0
+		s.TestDetails = "benchmark did not run"
+		return
+	}
+	percent := uint32(100)
+	details := make([]string, 0, len(criteria))
+	for _, c := range criteria {
+		value := c.Metric.value(result)
+		p := c.percent(value)
+		if p < percent {
+			percent = p
+		}
+		details = append(details, fmt.Sprintf("%d %v: %d%%", value, c.Metric, p))
+	}
+	s.Score = int32(math.Round(float64(s.GetMaxScore()) * float64(percent) / 100))
+	s.TestDetails = strings.Join(details, "\n")
+}
@@ -0,0 +1,53 @@
+package score_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/autograde/quickfeed/kit/score"
+)
+
+func TestScoreBenchmark(t *testing.T) {
+	speed := score.BenchmarkCriterion{
+		Metric: score.NsPerOp,
+		Bands: []score.BenchmarkBand{
+			{Threshold: 1000, Percent: 100},
+			{Threshold: 5000, Percent: 50},
+		},
+	}
+	allocs := score.BenchmarkCriterion{
+		Metric: score.AllocsPerOp,
+		Bands: []score.BenchmarkBand{
+			{Threshold: 0, Percent: 100},
+			{Threshold: 2, Percent: 80},
+		},
+	}
+	// result returns a benchmark result of 1000 operations with the given measurements per operation.
+	result := func(nsPerOp time.Duration, allocsPerOp uint64) testing.BenchmarkResult {
+		return testing.BenchmarkResult{N: 1000, T: 1000 * nsPerOp, MemAllocs: 1000 * allocsPerOp}
+	}
+	tests := []struct {
+		name     string
+		result   testing.BenchmarkResult
+		criteria []score.BenchmarkCriterion
+		want     int32
+	}{
+		{name: "fast", result: result(800, 0), criteria: []score.BenchmarkCriterion{speed}, want: 20},
+		{name: "threshold is inclusive", result: result(1000, 0), criteria: []score.BenchmarkCriterion{speed}, want: 20},
+		{name: "partial credit", result: result(3000, 0), criteria: []score.BenchmarkCriterion{speed}, want: 10},
+		{name: "too slow", result: result(6000, 0), criteria: []score.BenchmarkCriterion{speed}, want: 0},
+		{name: "fast with allocations", result: result(800, 1), criteria: []score.BenchmarkCriterion{speed, allocs}, want: 16},
+		{name: "lowest band applies", result: result(3000, 0), criteria: []score.BenchmarkCriterion{speed, allocs}, want: 10},
+		{name: "no criteria", result: result(6000, 5), want: 20},
+		{name: "did not run", result: testing.BenchmarkResult{}, criteria: []score.BenchmarkCriterion{speed}, want: 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sc := &score.Score{TestName: "TestFib", MaxScore: 20, Weight: 1}
+			sc.Benchmark(test.result, test.criteria...)
+			if sc.Score != test.want {
+				t.Errorf("Benchmark() score = %d, want %d (details: %q)", sc.Score, test.want, sc.TestDetails)
+			}
+		})
+	}
+}
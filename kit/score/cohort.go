@@ -0,0 +1,209 @@
+package score
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ErrInconsistentWeights is returned by ConsistentWeights if a test's weight
+// differs between the submissions of a cohort.
+var ErrInconsistentWeights = errors.New("inconsistent test weights across submissions")
+
+// DifficultyIndex returns, for each test name found in the given results,
+// the fraction of submissions that passed the test. An index close to 1
+// indicates that the test is easy to pass, whereas an index close to 0
+// indicates that few submissions pass the test.
+//
+// A test that is absent from a submission's results is counted as not
+// passed for that submission, since a missing score typically means that
+// the test did not run, e.g., due to a compilation failure.
+// Nil results are ignored.
+func DifficultyIndex(results []*Results) map[string]float64 {
+	passed := make(map[string]int)
+	submissions := 0
+	for _, r := range results {
+		if r == nil {
+			continue
+		}
+		submissions++
+		for _, sc := range r.Scores {
+			if _, found := passed[sc.GetTestName()]; !found {
+				passed[sc.GetTestName()] = 0
+			}
+			if sc.IsPassing() {
+				passed[sc.GetTestName()]++
+			}
+		}
+	}
+	index := make(map[string]float64, len(passed))
+	for testName, n := range passed {
+		index[testName] = float64(n) / float64(submissions)
+	}
+	return index
+}
+
+// ConsistentWeights returns the names of the tests whose weights differ between
+// the given results, along with an error wrapping ErrInconsistentWeights if any
+// such tests were found. Differing weights typically result from a change to the
+// test harness while submissions were made, making the grades incomparable.
+// The test names are sorted. Tests absent from some results and nil results
+// are ignored.
+func ConsistentWeights(results []*Results) ([]string, error) {
+	weights := make(map[string]int32)
+	inconsistent := make(map[string]bool)
+	for _, r := range results {
+		if r == nil {
+			continue
+		}
+		for _, sc := range r.Scores {
+			testName := sc.GetTestName()
+			weight, found := weights[testName]
+			if !found {
+				weights[testName] = sc.GetWeight()
+				continue
+			}
+			if weight != sc.GetWeight() {
+				inconsistent[testName] = true
+			}
+		}
+	}
+	if len(inconsistent) == 0 {
+		return nil, nil
+	}
+	testNames := make([]string, 0, len(inconsistent))
+	for testName := range inconsistent {
+		testNames = append(testNames, testName)
+	}
+	sort.Strings(testNames)
+	return testNames, fmt.Errorf("%w: %s", ErrInconsistentWeights, strings.Join(testNames, ", "))
+}
+
+// CurveMethod determines how a curve adjusts the grades of a cohort.
+type CurveMethod int
+
+const (
+	// LinearToTop scales all grades linearly, such that the top grade becomes 100.
+	LinearToTop CurveMethod = iota
+	// Additive adds a fixed number of points to all grades.
+	Additive
+)
+
+// CurvePolicy describes the curve to apply to the grades of a cohort.
+type CurvePolicy struct {
+	Method CurveMethod
+	// Points is the number of points added to each grade by the Additive method.
+	Points uint32
+	// Top is the top grade of the cohort, to which the LinearToTop method scales
+	// grades when curving a single grade with Apply. Curve computes the top grade
+	// from the given results instead.
+	Top uint32
+}
+
+// Apply returns the given grade curved according to the policy.
+// Curved grades never exceed 100. If the top grade is zero, the
+// LinearToTop method leaves the grade unchanged.
+func (p CurvePolicy) Apply(grade uint32) uint32 {
+	switch p.Method {
+	case LinearToTop:
+		if p.Top > 0 {
+			grade = uint32(math.Round(float64(grade) * 100 / float64(p.Top)))
+		}
+	case Additive:
+		grade += p.Points
+	}
+	if grade > 100 {
+		grade = 100
+	}
+	return grade
+}
+
+// Curve returns the curved grades for the given results, keyed by submission ID.
+// The curve is applied to the weighted grades computed by Sum, and curved grades
+// never exceed 100. If no submission has a grade above zero, the LinearToTop
+// method leaves the grades unchanged. Nil results are ignored. The results are
+// not modified.
+func Curve(results map[uint64]*Results, policy CurvePolicy) map[uint64]uint32 {
+	grades := make(map[uint64]uint32, len(results))
+	top := uint32(0)
+	for submissionID, r := range results {
+		if r == nil {
+			continue
+		}
+		g := r.Sum()
+		grades[submissionID] = g
+		if g > top {
+			top = g
+		}
+	}
+	policy.Top = top
+	for submissionID, g := range grades {
+		grades[submissionID] = policy.Apply(g)
+	}
+	return grades
+}
+
+// AverageGrade returns the average of the weighted grades computed by Sum
+// over the given results, e.g., for a student's submissions to an assignment,
+// rounded to the nearest integer. Nil results are ignored.
+// If there are no results, zero is returned.
+func AverageGrade(results []*Results) uint32 {
+	total, n := uint32(0), 0
+	for _, r := range results {
+		if r == nil {
+			continue
+		}
+		total += r.Sum()
+		n++
+	}
+	if n == 0 {
+		return 0
+	}
+	return uint32(math.Round(float64(total) / float64(n)))
+}
+
+// BestGrade returns the highest of the weighted grades computed by Sum
+// over the given results, e.g., for a student's submissions to an assignment.
+// Nil results are ignored. If there are no results, zero is returned.
+func BestGrade(results []*Results) uint32 {
+	best := uint32(0)
+	for _, r := range results {
+		if r == nil {
+			continue
+		}
+		if g := r.Sum(); g > best {
+			best = g
+		}
+	}
+	return best
+}
+
+// FirstPassing returns the earliest of the given results, by build date, whose
+// weighted grade computed by Sum is at least the given threshold, e.g., the
+// assignment's score limit for auto approval. Results with a missing or invalid
+// build date are considered later than results with a valid build date; results
+// with equal build dates are considered in the given order. Nil results are ignored.
+// If no results meet the threshold, nil and false are returned.
+func FirstPassing(results []*Results, threshold uint32) (*Results, bool) {
+	var first *Results
+	var firstDate time.Time
+	for _, r := range results {
+		if r == nil || r.Sum() < threshold {
+			continue
+		}
+		date, err := time.Parse(layout, r.BuildInfo.GetBuildDate())
+		if err != nil {
+			if first == nil {
+				first = r
+			}
+			continue
+		}
+		if first == nil || firstDate.IsZero() || date.Before(firstDate) {
+			first, firstDate = r, date
+		}
+	}
+	return first, first != nil
+}
@@ -0,0 +1,259 @@
+package score_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/autograde/quickfeed/kit/score"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDifficultyIndex(t *testing.T) {
+	cohort := []*score.Results{
+		score.NewResults(
+			&score.Score{TestName: "TestA", Score: 10, MaxScore: 10, Weight: 1},
+			&score.Score{TestName: "TestB", Score: 5, MaxScore: 10, Weight: 1},
+			&score.Score{TestName: "TestC", Score: 0, MaxScore: 10, Weight: 1},
+		),
+		score.NewResults(
+			&score.Score{TestName: "TestA", Score: 10, MaxScore: 10, Weight: 1},
+			&score.Score{TestName: "TestB", Score: 10, MaxScore: 10, Weight: 1},
+			&score.Score{TestName: "TestC", Score: 0, MaxScore: 10, Weight: 1},
+		),
+		score.NewResults(
+			&score.Score{TestName: "TestA", Score: 10, MaxScore: 10, Weight: 1},
+			&score.Score{TestName: "TestB", Score: 10, MaxScore: 10, Weight: 1},
+			&score.Score{TestName: "TestC", Score: 0, MaxScore: 10, Weight: 1},
+		),
+		// TestB and TestC missing; counted as not passed
+		score.NewResults(
+			&score.Score{TestName: "TestA", Score: 10, MaxScore: 10, Weight: 1},
+		),
+		nil,
+	}
+	want := map[string]float64{
+		"TestA": 1,
+		"TestB": 0.5,
+		"TestC": 0,
+	}
+	got := score.DifficultyIndex(cohort)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("DifficultyIndex() mismatch (-want +got):\n%s", diff)
+	}
+	if got := score.DifficultyIndex(nil); len(got) != 0 {
+		t.Errorf("DifficultyIndex(nil) = %v, want empty map", got)
+	}
+}
+
+func TestConsistentWeights(t *testing.T) {
+	tests := []struct {
+		name   string
+		cohort []*score.Results
+		want   []string
+	}{
+		{
+			name: "consistent",
+			cohort: []*score.Results{
+				score.NewResults(
+					&score.Score{TestName: "TestA", Score: 10, MaxScore: 10, Weight: 1},
+					&score.Score{TestName: "TestB", Score: 5, MaxScore: 10, Weight: 2},
+				),
+				score.NewResults(
+					&score.Score{TestName: "TestA", Score: 0, MaxScore: 10, Weight: 1},
+					&score.Score{TestName: "TestB", Score: 10, MaxScore: 10, Weight: 2},
+				),
+				// TestB missing; ignored
+				score.NewResults(
+					&score.Score{TestName: "TestA", Score: 10, MaxScore: 10, Weight: 1},
+				),
+				nil,
+			},
+			want: nil,
+		},
+		{
+			name: "inconsistent",
+			cohort: []*score.Results{
+				score.NewResults(
+					&score.Score{TestName: "TestA", Score: 10, MaxScore: 10, Weight: 1},
+					&score.Score{TestName: "TestB", Score: 5, MaxScore: 10, Weight: 2},
+					&score.Score{TestName: "TestC", Score: 5, MaxScore: 10, Weight: 1},
+				),
+				score.NewResults(
+					&score.Score{TestName: "TestA", Score: 10, MaxScore: 10, Weight: 1},
+					&score.Score{TestName: "TestB", Score: 5, MaxScore: 10, Weight: 2},
+					&score.Score{TestName: "TestC", Score: 5, MaxScore: 10, Weight: 3},
+				),
+				score.NewResults(
+					&score.Score{TestName: "TestA", Score: 10, MaxScore: 10, Weight: 5},
+					&score.Score{TestName: "TestB", Score: 5, MaxScore: 10, Weight: 2},
+					&score.Score{TestName: "TestC", Score: 5, MaxScore: 10, Weight: 3},
+				),
+			},
+			want: []string{"TestA", "TestC"},
+		},
+		{
+			name:   "empty cohort",
+			cohort: nil,
+			want:   nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := score.ConsistentWeights(test.cohort)
+			if wantErr := test.want != nil; (err != nil) != wantErr || (wantErr && !errors.Is(err, score.ErrInconsistentWeights)) {
+				t.Errorf("ConsistentWeights() error = %v, want ErrInconsistentWeights: %t", err, wantErr)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("ConsistentWeights() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCurve(t *testing.T) {
+	cohort := map[uint64]*score.Results{
+		1: score.NewResults(
+			&score.Score{TestName: "TestA", Score: 8, MaxScore: 10, Weight: 1},
+		),
+		2: score.NewResults(
+			&score.Score{TestName: "TestA", Score: 4, MaxScore: 10, Weight: 1},
+		),
+		3: score.NewResults(
+			&score.Score{TestName: "TestA", Score: 0, MaxScore: 10, Weight: 1},
+		),
+		4: nil,
+	}
+	tests := []struct {
+		name    string
+		results map[uint64]*score.Results
+		policy  score.CurvePolicy
+		want    map[uint64]uint32
+	}{
+		{
+			name:    "linear to top",
+			results: cohort,
+			policy:  score.CurvePolicy{Method: score.LinearToTop},
+			want:    map[uint64]uint32{1: 100, 2: 50, 3: 0},
+		},
+		{
+			name:    "additive",
+			results: cohort,
+			policy:  score.CurvePolicy{Method: score.Additive, Points: 15},
+			want:    map[uint64]uint32{1: 95, 2: 55, 3: 15},
+		},
+		{
+			name:    "additive above 100",
+			results: cohort,
+			policy:  score.CurvePolicy{Method: score.Additive, Points: 30},
+			want:    map[uint64]uint32{1: 100, 2: 70, 3: 30},
+		},
+		{
+			name: "linear to top with all zero grades",
+			results: map[uint64]*score.Results{
+				1: score.NewResults(&score.Score{TestName: "TestA", Score: 0, MaxScore: 10, Weight: 1}),
+			},
+			policy: score.CurvePolicy{Method: score.LinearToTop},
+			want:   map[uint64]uint32{1: 0},
+		},
+		{
+			name:    "empty cohort/linear to top",
+			results: map[uint64]*score.Results{},
+			policy:  score.CurvePolicy{Method: score.LinearToTop},
+			want:    map[uint64]uint32{},
+		},
+		{
+			name:    "empty cohort/additive",
+			results: nil,
+			policy:  score.CurvePolicy{Method: score.Additive, Points: 10},
+			want:    map[uint64]uint32{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := score.Curve(test.results, test.policy)
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("Curve() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+	// the results must not be modified by the curve
+	if got := cohort[2].Sum(); got != 40 {
+		t.Errorf("Sum() = %d after Curve(), want 40", got)
+	}
+}
+
+func TestAverageAndBestGrade(t *testing.T) {
+	submissions := []*score.Results{
+		score.NewResults(
+			&score.Score{TestName: "TestA", Score: 10, MaxScore: 10, Weight: 1},
+			&score.Score{TestName: "TestB", Score: 0, MaxScore: 10, Weight: 1},
+		), // 50
+		score.NewResults(
+			&score.Score{TestName: "TestA", Score: 10, MaxScore: 10, Weight: 1},
+			&score.Score{TestName: "TestB", Score: 5, MaxScore: 10, Weight: 3},
+		), // 63
+		nil,
+		score.NewResults(
+			&score.Score{TestName: "TestA", Score: 10, MaxScore: 10, Weight: 1},
+			&score.Score{TestName: "TestB", Score: 8, MaxScore: 10, Weight: 1},
+		), // 90
+	}
+	tests := []struct {
+		name        string
+		results     []*score.Results
+		wantAverage uint32
+		wantBest    uint32
+	}{
+		{name: "several submissions", results: submissions, wantAverage: 68, wantBest: 90},
+		{name: "single submission", results: submissions[:1], wantAverage: 50, wantBest: 50},
+		{name: "only nil results", results: []*score.Results{nil}, wantAverage: 0, wantBest: 0},
+		{name: "no submissions", results: nil, wantAverage: 0, wantBest: 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := score.AverageGrade(test.results); got != test.wantAverage {
+				t.Errorf("AverageGrade() = %d, want %d", got, test.wantAverage)
+			}
+			if got := score.BestGrade(test.results); got != test.wantBest {
+				t.Errorf("BestGrade() = %d, want %d", got, test.wantBest)
+			}
+		})
+	}
+}
+
+func TestFirstPassing(t *testing.T) {
+	submission := func(buildDate string, points int32) *score.Results {
+		r := score.NewResults(&score.Score{TestName: "TestA", Score: points, MaxScore: 10, Weight: 1})
+		r.BuildInfo = &score.BuildInfo{BuildDate: buildDate}
+		return r
+	}
+	first := submission("2021-09-01T10:00:00", 4)
+	second := submission("2021-09-02T10:00:00", 7)
+	third := submission("2021-09-03T10:00:00", 9)
+	fourth := submission("2021-09-04T10:00:00", 6)
+	undated := submission("", 10)
+
+	tests := []struct {
+		name      string
+		results   []*score.Results
+		threshold uint32
+		want      *score.Results
+		wantFound bool
+	}{
+		{name: "crosses threshold", results: []*score.Results{first, second, third, fourth}, threshold: 70, want: second, wantFound: true},
+		{name: "unordered history", results: []*score.Results{fourth, third, nil, second, first}, threshold: 70, want: second, wantFound: true},
+		{name: "exactly at threshold", results: []*score.Results{first, second, third}, threshold: 40, want: first, wantFound: true},
+		{name: "dated before undated", results: []*score.Results{undated, third}, threshold: 80, want: third, wantFound: true},
+		{name: "only undated", results: []*score.Results{first, undated}, threshold: 80, want: undated, wantFound: true},
+		{name: "never passing", results: []*score.Results{first, second, fourth}, threshold: 80, want: nil, wantFound: false},
+		{name: "no submissions", results: nil, threshold: 80, want: nil, wantFound: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, found := score.FirstPassing(test.results, test.threshold)
+			if got != test.want || found != test.wantFound {
+				t.Errorf("FirstPassing() = (%v, %t), want (%v, %t)", got, found, test.want, test.wantFound)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package score
+
+import "strings"
+
+// Comparison modes for grading a program's output against the expected output,
+// as specified by the 'diffmode' field of an 'assignment.yml' file.
+const (
+	// DiffExact requires the output to match the expected output exactly.
+	DiffExact = "exact"
+	// DiffTrimmed ignores trailing whitespace on each line, as well as
+	// leading and trailing blank lines, and Windows line endings.
+	DiffTrimmed = "trimmed"
+	// DiffNormalized treats every run of whitespace, including line breaks,
+	// as a single space, and ignores leading and trailing whitespace.
+	DiffNormalized = "normalized"
+)
+
+// DiffModes is the list of known comparison modes.
+var DiffModes = []string{DiffExact, DiffTrimmed, DiffNormalized}
+
+// CompareOutput returns true if the actual output matches the expected output
+// under the given comparison mode. The empty mode is the same as DiffExact.
+// An unknown mode never matches, so that a misconfigured assignment does not
+// award credit.
+func CompareOutput(expected, actual, mode string) bool {
+	switch mode {
+	case "", DiffExact:
+		return expected == actual
+	case DiffTrimmed:
+		return trimLines(expected) == trimLines(actual)
+	case DiffNormalized:
+		return strings.Join(strings.Fields(expected), " ") == strings.Join(strings.Fields(actual), " ")
+	}
+	return false
+}
+
+// trimLines returns s with trailing whitespace removed from each line,
+// and leading and trailing blank lines removed.
+func trimLines(s string) string {
+	lines := strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t\r")
+	}
+	return strings.Trim(strings.Join(lines, "\n"), "\n")
+}
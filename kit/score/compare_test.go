@@ -0,0 +1,42 @@
+package score_test
+
+import (
+	"testing"
+
+	"github.com/autograde/quickfeed/kit/score"
+)
+
+func TestCompareOutput(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+		actual   string
+		mode     string
+		want     bool
+	}{
+		{name: "exact/identical", expected: "1\n2\n3\n", actual: "1\n2\n3\n", mode: score.DiffExact, want: true},
+		{name: "exact/default mode", expected: "1\n2\n3\n", actual: "1\n2\n3\n", mode: "", want: true},
+		{name: "exact/trailing space", expected: "1\n2\n3\n", actual: "1 \n2\n3\n", mode: score.DiffExact, want: false},
+		{name: "exact/missing final newline", expected: "1\n2\n3\n", actual: "1\n2\n3", mode: score.DiffExact, want: false},
+
+		{name: "trimmed/trailing space", expected: "1\n2\n3\n", actual: "1 \n2\t\n3\n", mode: score.DiffTrimmed, want: true},
+		{name: "trimmed/trailing blank lines", expected: "1\n2\n3\n", actual: "1\n2\n3\n\n\n", mode: score.DiffTrimmed, want: true},
+		{name: "trimmed/windows line endings", expected: "1\n2\n3\n", actual: "1\r\n2\r\n3\r\n", mode: score.DiffTrimmed, want: true},
+		{name: "trimmed/leading space", expected: "1\n2\n3\n", actual: " 1\n2\n3\n", mode: score.DiffTrimmed, want: false},
+		{name: "trimmed/inner blank line", expected: "1\n2\n3\n", actual: "1\n\n2\n3\n", mode: score.DiffTrimmed, want: false},
+
+		{name: "normalized/spaces and newlines", expected: "1 2 3", actual: "1\n2   3\n", mode: score.DiffNormalized, want: true},
+		{name: "normalized/leading and trailing whitespace", expected: "hello world", actual: "  hello\tworld  \n", mode: score.DiffNormalized, want: true},
+		{name: "normalized/different words", expected: "hello world", actual: "hello  there", mode: score.DiffNormalized, want: false},
+		{name: "normalized/joined words", expected: "hello world", actual: "helloworld", mode: score.DiffNormalized, want: false},
+
+		{name: "unknown mode", expected: "1", actual: "1", mode: "fuzzy", want: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := score.CompareOutput(test.expected, test.actual, test.mode); got != test.want {
+				t.Errorf("CompareOutput(%q, %q, %q) = %t, want %t", test.expected, test.actual, test.mode, got, test.want)
+			}
+		})
+	}
+}
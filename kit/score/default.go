@@ -0,0 +1,73 @@
+package score
+
+// defaultRegistry is the registry used by the package-level registration functions.
+var defaultRegistry = NewRegistry()
+
+// Add test with given max score and weight to the default registry.
+// This should be called from an init function in the test package.
+//
+// Will panic if the test has already been registered or if max or weight is non-positive.
+func Add(test interface{}, max, weight int) {
+	defaultRegistry.Add(test, max, weight)
+}
+
+// AddSub test with given max score and weight to the default registry.
+//
+// Will panic if the test has already been registered or if max or weight is non-positive.
+func AddSub(test interface{}, subTestName string, max, weight int) {
+	defaultRegistry.AddSub(test, subTestName, max, weight)
+}
+
+// Max returns a score object from the default registry with Score equal to MaxScore.
+//
+// Will panic with unknown score test, if the test hasn't been added.
+func Max() *Score {
+	return defaultRegistry.Max()
+}
+
+// Min returns a score object from the default registry with Score equal to zero.
+//
+// Will panic with unknown score test, if the test hasn't been added.
+func Min() *Score {
+	return defaultRegistry.Min()
+}
+
+// MaxByName returns a score object from the default registry for the given
+// test name with Score equal to MaxScore.
+//
+// Will panic with unknown score test, if the test hasn't been added.
+func MaxByName(testName string) *Score {
+	return defaultRegistry.MaxByName(testName)
+}
+
+// MinByName returns a score object from the default registry for the given
+// test name with Score equal to zero.
+//
+// Will panic with unknown score test, if the test hasn't been added.
+func MinByName(testName string) *Score {
+	return defaultRegistry.MinByName(testName)
+}
+
+// Compose returns a score object for the calling test from the default registry,
+// composed from the scores of its subtests.
+//
+// Will panic with unknown score test, if the calling test hasn't been added.
+func Compose() *Score {
+	return defaultRegistry.Compose()
+}
+
+// PrintTestInfo prints a JSON representation of all tests in the default registry.
+func PrintTestInfo(sorted ...bool) {
+	defaultRegistry.PrintTestInfo(sorted...)
+}
+
+// Validate returns an error if one of the tests in the default registry never
+// reported a score, reported a score more than once, or reported an invalid score.
+func Validate() error {
+	return defaultRegistry.Validate()
+}
+
+// Manifest returns the tests in the default registry, with their max score and weight.
+func Manifest() []*Score {
+	return defaultRegistry.Manifest()
+}
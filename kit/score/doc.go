@@ -34,16 +34,24 @@
 //
 // In addition, TestMain() should call score.PrintTestInfo() before running the tests
 // to ensure that all tests are registered and will be picked up by QuickFeed.
+// After running the tests, TestMain() should call score.Validate() to ensure that
+// every registered test reported its score exactly once.
 //
 // func TestMain(m *testing.M) {
 //     score.PrintTestInfo()
-//     os.Exit(m.Run())
+//     exitCode := m.Run()
+//     if err := score.Validate(); err != nil {
+//         fmt.Println(err)
+//         exitCode = 1
+//     }
+//     os.Exit(exitCode)
 // }
 //
 // To implement a test with scoring, you may use score.Max() to obtain a score object
 // with Score equals to MaxScore, which may be decremented for each test failure.
 // Note that sc.Print(t) should be called with a defer to ensure that it gets executed
-// even if the test panics.
+// even if the test panics. Alternatively, defer score.PanicGuard(t, sc) in place of
+// sc.Print(t) to also record the panic in the score's TestDetails.
 //
 // func TestFibonacciMax(t *testing.T) {
 //     sc := score.Max()
@@ -70,6 +78,21 @@
 //     }
 // }
 //
+// A test with subtests may also report a single score composed from the scores
+// of its subtests, each weighted by the weight given to AddSub. The composed
+// score is scaled to the max score given to Add for the parent test.
+//
+// func TestFibonacciComposed(t *testing.T) {
+//     for _, name := range []string{"Small", "Large"} {
+//         t.Run(name, func(t *testing.T) {
+//             sc := score.MinByName(t.Name())
+//             ...
+//         })
+//     }
+//     sc := score.Compose()
+//     sc.Print(t)
+// }
+//
 // Please see package score/testdata/sequence for other usage examples.
 //
 package score
@@ -0,0 +1,89 @@
+package score
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FailureReason classifies why a test run failed, as determined from its build log.
+type FailureReason int
+
+const (
+	// NoFailure means that the build log shows no recognized failure.
+	NoFailure FailureReason = iota
+	// CompileError means that the student's code failed to compile.
+	CompileError
+	// Timeout means that the tests did not complete before the deadline,
+	// e.g., due to an infinite loop in the student's code.
+	Timeout
+	// ImagePullFailure means that the runner failed to pull the container image.
+	ImagePullFailure
+	// RunnerUnavailable means that the runner could not reach the container engine.
+	RunnerUnavailable
+	// HostResources means that the host running the tests ran out of memory or disk space.
+	HostResources
+	// ResourceLimit means that the tests exceeded the memory, process or disk limits
+	// of the assignment, e.g., due to a memory leak or a fork bomb in the student's code.
+	ResourceLimit
+)
+
+func (f FailureReason) String() string {
+	switch f {
+	case NoFailure:
+		return "no failure"
+	case CompileError:
+		return "compile error"
+	case Timeout:
+		return "timeout"
+	case ImagePullFailure:
+		return "image pull failure"
+	case RunnerUnavailable:
+		return "runner unavailable"
+	case HostResources:
+		return "host out of resources"
+	case ResourceLimit:
+		return "resource limit exceeded"
+	}
+	return fmt.Sprintf("FailureReason(%d)", int(f))
+}
+
+// IsInfra returns true if the failure is caused by the grading infrastructure
+// rather than by the student's code, such that rerunning the tests may succeed.
+func (f FailureReason) IsInfra() bool {
+	return f == ImagePullFailure || f == RunnerUnavailable || f == HostResources
+}
+
+// failurePatterns maps failure reasons to the build log messages indicating them.
+// The reasons are checked in order, such that infrastructure failures take
+// precedence over failures that may be caused by the student's code. However,
+// the runner's message for exceeding the assignment's resource limits comes first,
+// since the errors reported by the tests then resemble the host running out of resources.
+var failurePatterns = []struct {
+	reason   FailureReason
+	patterns []string
+}{
+	{ResourceLimit, []string{"resource limit exceeded"}},
+	{ImagePullFailure, []string{"pull access denied", "manifest unknown", "failed to pull image", "toomanyrequests"}},
+	{RunnerUnavailable, []string{"Cannot connect to the Docker daemon", "docker client not initialized"}},
+	{HostResources, []string{"cannot allocate memory", "no space left on device"}},
+	{Timeout, []string{"context deadline exceeded"}},
+	{CompileError, []string{"[build failed]", "[setup failed]", "syntax error", "undefined:"}},
+}
+
+// FailureReason returns the reason the test run failed, as determined by
+// well-known messages in the build log, or NoFailure if none are found.
+// A test run stopped at the container timeout always failed due to the timeout.
+func (b *BuildInfo) FailureReason() FailureReason {
+	if b.GetTimedOut() {
+		return Timeout
+	}
+	log := b.GetBuildLog()
+	for _, fp := range failurePatterns {
+		for _, pattern := range fp.patterns {
+			if strings.Contains(log, pattern) {
+				return fp.reason
+			}
+		}
+	}
+	return NoFailure
+}
@@ -0,0 +1,39 @@
+package score_test
+
+import (
+	"testing"
+
+	"github.com/autograde/quickfeed/kit/score"
+)
+
+func TestFailureReason(t *testing.T) {
+	tests := []struct {
+		name      string
+		buildInfo *score.BuildInfo
+		want      score.FailureReason
+		wantInfra bool
+	}{
+		{name: "nil build info", buildInfo: nil, want: score.NoFailure},
+		{name: "passing", buildInfo: &score.BuildInfo{BuildLog: "ok  \tlab1\t0.012s"}, want: score.NoFailure},
+		{name: "compile error", buildInfo: &score.BuildInfo{BuildLog: "# lab1\n./fib.go:7:2: undefined: fibonacci\nFAIL\tlab1 [build failed]"}, want: score.CompileError},
+		{name: "timeout", buildInfo: &score.BuildInfo{BuildLog: "test execution failed: context deadline exceeded"}, want: score.Timeout},
+		{name: "timed out", buildInfo: &score.BuildInfo{BuildLog: "--- PASS: TestFib\nContainer timeout. Please check for infinite loops or other slowness.", TimedOut: true}, want: score.Timeout},
+		{name: "image pull", buildInfo: &score.BuildInfo{BuildLog: "Error response from daemon: pull access denied for quickfeed/go, repository does not exist"}, want: score.ImagePullFailure, wantInfra: true},
+		{name: "docker daemon", buildInfo: &score.BuildInfo{BuildLog: "Cannot connect to the Docker daemon at unix:///var/run/docker.sock"}, want: score.RunnerUnavailable, wantInfra: true},
+		{name: "host out of memory", buildInfo: &score.BuildInfo{BuildLog: "fork/exec /bin/sh: cannot allocate memory"}, want: score.HostResources, wantInfra: true},
+		{name: "memory limit", buildInfo: &score.BuildInfo{BuildLog: "Container killed: resource limit exceeded: memory limit of 64MiB"}, want: score.ResourceLimit},
+		{name: "disk limit", buildInfo: &score.BuildInfo{BuildLog: "write out.txt: no space left on device\nTests failed: resource limit exceeded: disk limit of 10MiB"}, want: score.ResourceLimit},
+		{name: "infra takes precedence", buildInfo: &score.BuildInfo{BuildLog: "FAIL\tlab1 [build failed]\nwrite /tmp/go-build: no space left on device"}, want: score.HostResources, wantInfra: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.buildInfo.FailureReason()
+			if got != test.want {
+				t.Errorf("FailureReason() = %v, want %v", got, test.want)
+			}
+			if got.IsInfra() != test.wantInfra {
+				t.Errorf("FailureReason().IsInfra() = %t, want %t", got.IsInfra(), test.wantInfra)
+			}
+		})
+	}
+}
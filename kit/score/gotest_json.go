@@ -0,0 +1,185 @@
+package score
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// testEvent is an event in the output stream of go test -json; see go doc test2json.
+type testEvent struct {
+	Action  string
+	Package string
+	Test    string
+	Elapsed float64
+	Output  string
+}
+
+// TestFailure holds structured details of a failed test, extracted from
+// the output of go test -json. The TestDetails field of a failed test's
+// score object holds the JSON encoding of a list of test failures.
+type TestFailure struct {
+	Test     string `json:"test"`               // name of the failed test or subtest
+	Location string `json:"location,omitempty"` // file:line of the failing assertion
+	Message  string `json:"message,omitempty"`  // message of the failing assertion
+	Output   string `json:"output,omitempty"`   // captured stdout of the test
+}
+
+// assertionLine matches the file:line prefix of a t.Error or t.Fatal message,
+// such as "    fib_test.go:12: fib(3) = 1, want 2".
+var assertionLine = regexp.MustCompile(`^\s+(\S+\.go:\d+): ?(.*)$`)
+
+// ExtractResultsJSON returns the results from a test execution extracted
+// from the given go test -json event stream. Score objects are extracted
+// from the output events as in ExtractResults, and the TestDetails field of
+// each failed test's score object is populated with the JSON encoding of its
+// test failures, including those of its subtests. Lines that are not valid
+// events, such as compiler errors, are included in the build log as is.
+func ExtractResultsJSON(r io.Reader, secret string, execTime time.Duration) (*Results, error) {
+	var filteredLog []string
+	errs := make([]error, 0)
+	results := NewResults()
+	output := make(map[string][]string)
+	var failures []*TestFailure
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		var ev testEvent
+		if err := json.Unmarshal([]byte(line), &ev); err != nil || ev.Action == "" {
+			if strings.TrimSpace(line) != "" {
+				filteredLog = append(filteredLog, line)
+			}
+			continue
+		}
+		switch ev.Action {
+		case "output":
+			out := strings.TrimRight(ev.Output, "\n")
+			if HasPrefix(out) {
+				sc, err := Parse(out, secret)
+				if err != nil {
+					errs = append(errs, fmt.Errorf("failed to parse score: %s: %v", out, err))
+					continue
+				}
+				results.addScore(sc)
+				continue
+			}
+			if strings.TrimSpace(out) == "" {
+				continue
+			}
+			filteredLog = append(filteredLog, out)
+			if ev.Test != "" && !isFramingLine(out) {
+				output[ev.Test] = append(output[ev.Test], out)
+			}
+		case "fail":
+			if ev.Test == "" {
+				continue
+			}
+			// a parent test that only failed because of its subtests has no details of its own
+			if f := newTestFailure(ev.Test, output[ev.Test]); f.Location != "" || f.Output != "" {
+				failures = append(failures, f)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read test events: %w", err)
+	}
+	if err := results.addFailureDetails(failures); err != nil {
+		return nil, err
+	}
+	return &Results{
+		BuildInfo: &BuildInfo{
+			BuildDate: time.Now().Format(layout),
+			BuildLog:  strings.Join(filteredLog, "\n"),
+			ExecTime:  execTime.Milliseconds(),
+		},
+		Scores: results.toScoreSlice(),
+		Errors: errs,
+	}, nil
+}
+
+// EventOutput returns the output of the given line of a go test -json event stream,
+// without its trailing newline, and true if the line is an event. Events other than
+// output events have no output.
+func EventOutput(line string) (string, bool) {
+	var ev testEvent
+	if err := json.Unmarshal([]byte(line), &ev); err != nil || ev.Action == "" {
+		return "", false
+	}
+	if ev.Action != "output" {
+		return "", true
+	}
+	return strings.TrimRight(ev.Output, "\n"), true
+}
+
+// isFramingLine returns true if the given output line is one of
+// the status lines that go test prints around a test's own output.
+func isFramingLine(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	for _, prefix := range []string{"=== RUN", "=== PAUSE", "=== CONT", "--- PASS", "--- FAIL", "--- SKIP"} {
+		if strings.HasPrefix(trimmed, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// newTestFailure returns the failure details of the named test from its output lines.
+// The first assertion line gives the location and message of the failure, where
+// further indented lines continue the message; other lines are captured stdout.
+func newTestFailure(test string, lines []string) *TestFailure {
+	f := &TestFailure{Test: test}
+	var message, stdout []string
+	inMessage := false
+	for _, line := range lines {
+		if m := assertionLine.FindStringSubmatch(line); m != nil {
+			if f.Location == "" {
+				f.Location = m[1]
+			}
+			message = append(message, m[2])
+			inMessage = true
+			continue
+		}
+		if inMessage && strings.HasPrefix(line, "        ") {
+			// continuation of a multi-line assertion message
+			message = append(message, strings.TrimSpace(line))
+			continue
+		}
+		inMessage = false
+		stdout = append(stdout, line)
+	}
+	f.Message = strings.Join(message, "\n")
+	f.Output = strings.Join(stdout, "\n")
+	return f
+}
+
+// addFailureDetails sets the TestDetails field of each score object that has
+// failures to the JSON encoding of those failures. A subtest's failure belongs
+// to the score object of its closest enclosing test that reported a score.
+func (r *Results) addFailureDetails(failures []*TestFailure) error {
+	details := make(map[string][]*TestFailure)
+	for _, f := range failures {
+		for name := f.Test; ; name = name[:strings.LastIndex(name, "/")] {
+			if _, ok := r.scores[name]; ok {
+				details[name] = append(details[name], f)
+				break
+			}
+			if !strings.Contains(name, "/") {
+				break
+			}
+		}
+	}
+	for name, fs := range details {
+		b, err := json.Marshal(fs)
+		if err != nil {
+			return fmt.Errorf("failed to encode failures of %s: %w", name, err)
+		}
+		r.scores[name].TestDetails = string(b)
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package score_test
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/autograde/quickfeed/kit/score"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExtractResultsJSON(t *testing.T) {
+	const secret = "s3cr3t"
+	f, err := os.Open("testdata/gotest.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	res, err := score.ExtractResultsJSON(f, secret, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Errors) > 0 {
+		t.Fatal(res.Errors[0])
+	}
+	if strings.Contains(res.BuildInfo.BuildLog, secret) {
+		t.Error("build log contains secret")
+	}
+	if !strings.Contains(res.BuildInfo.BuildLog, "# lab1 [lab1.test]") {
+		t.Errorf("build log is missing non-event line:\n%s", res.BuildInfo.BuildLog)
+	}
+	if len(res.Scores) != 2 {
+		t.Fatalf("ExtractResultsJSON() got %d scores, want 2", len(res.Scores))
+	}
+
+	var got []score.TestFailure
+	if err := json.Unmarshal([]byte(res.Scores[0].GetTestDetails()), &got); err != nil {
+		t.Fatalf("TestDetails of %s is not a list of failures: %v", res.Scores[0].GetTestName(), err)
+	}
+	want := []score.TestFailure{
+		{
+			Test:     "TestFib/large",
+			Location: "fib_test.go:10",
+			Message:  "fib(20) = 1\nwant 6765",
+			Output:   "computing fib(20)",
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("TestDetails mismatch (-want +got):\n%s", diff)
+	}
+	if details := res.Scores[1].GetTestDetails(); details != "" {
+		t.Errorf("TestDetails of passing test %s = %q, want empty", res.Scores[1].GetTestName(), details)
+	}
+}
+
+func TestEventOutput(t *testing.T) {
+	for _, test := range []struct {
+		line    string
+		want    string
+		isEvent bool
+	}{
+		{line: `{"Action":"output","Package":"lab1","Test":"TestFib","Output":"=== RUN   TestFib\n"}`, want: "=== RUN   TestFib", isEvent: true},
+		{line: `{"Action":"pass","Package":"lab1","Test":"TestFib","Elapsed":0}`, want: "", isEvent: true},
+		{line: "# lab1 [lab1.test]", want: "", isEvent: false},
+		{line: `{"Secret":"s3cr3t","TestName":"TestFib","Score":1,"MaxScore":1,"Weight":1}`, want: "", isEvent: false},
+	} {
+		got, isEvent := score.EventOutput(test.line)
+		if got != test.want || isEvent != test.isEvent {
+			t.Errorf("EventOutput(%q) = (%q, %t), want (%q, %t)", test.line, got, isEvent, test.want, test.isEvent)
+		}
+	}
+}
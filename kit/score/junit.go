@@ -0,0 +1,78 @@
+package score
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+)
+
+// junitTestSuite is the <testsuite> element of a JUnit XML report.
+type junitTestSuite struct {
+	XMLName   xml.Name        `xml:"testsuite"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Errors    int             `xml:"errors,attr"`
+	Time      string          `xml:"time,attr,omitempty"`
+	Timestamp string          `xml:"timestamp,attr,omitempty"`
+	TestCases []junitTestCase `xml:"testcase"`
+	SystemOut string          `xml:"system-out,omitempty"`
+}
+
+// junitTestCase is the <testcase> element of a JUnit XML report.
+type junitTestCase struct {
+	Name    string        `xml:"name,attr"`
+	Points  string        `xml:"points,attr"`
+	Time    string        `xml:"time,attr,omitempty"`
+	Failure *junitFailure `xml:"failure,omitempty"`
+}
+
+// junitFailure is the <failure> element of a failed JUnit test case.
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+}
+
+// WriteJUnit writes the results to w as a JUnit XML report, allowing the results
+// to be viewed with standard CI tooling. The report holds a single <testsuite>
+// with one <testcase> per score object, where tests that did not obtain their
+// max score are marked as failed with the test details as the failure message.
+// The execution time of each test is included if recorded by its score object,
+// along with the total execution time and build log from the build info, if any.
+func (r *Results) WriteJUnit(w io.Writer) error {
+	suite := junitTestSuite{
+		Tests:     len(r.Scores),
+		Errors:    len(r.Errors),
+		Timestamp: r.BuildInfo.GetBuildDate(),
+		SystemOut: r.BuildInfo.GetBuildLog(),
+	}
+	if r.BuildInfo.GetExecTime() > 0 {
+		suite.Time = fmt.Sprintf("%.3f", r.ExecTime().Seconds())
+	}
+	for _, sc := range r.Scores {
+		testCase := junitTestCase{
+			Name:   sc.GetTestName(),
+			Points: fmt.Sprintf("%d/%d", sc.GetScore(), sc.GetMaxScore()),
+		}
+		if sc.GetExecTimeMS() > 0 {
+			testCase.Time = fmt.Sprintf("%.3f", sc.ExecTime().Seconds())
+		}
+		if !sc.IsPassing() {
+			suite.Failures++
+			message := sc.GetTestDetails()
+			if message == "" {
+				message = fmt.Sprintf("obtained %d of %d points", sc.GetScore(), sc.GetMaxScore())
+			}
+			testCase.Failure = &junitFailure{Message: message}
+		}
+		suite.TestCases = append(suite.TestCases, testCase)
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(suite); err != nil {
+		return fmt.Errorf("failed to encode JUnit report: %w", err)
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
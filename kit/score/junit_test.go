@@ -0,0 +1,73 @@
+package score_test
+
+import (
+	"encoding/xml"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/autograde/quickfeed/kit/score"
+	"github.com/google/go-cmp/cmp"
+)
+
+const wantJUnit = `<?xml version="1.0" encoding="UTF-8"?>
+<testsuite tests="3" failures="2" errors="1" time="1.500" timestamp="2021-09-01T12:00:00">
+  <testcase name="TestPass" points="5/5" time="0.250"></testcase>
+  <testcase name="TestFail" points="2/5">
+    <failure message="expected &lt;nil&gt;, got &#34;error&#34;"></failure>
+  </testcase>
+  <testcase name="TestFailWithoutDetails" points="0/3">
+    <failure message="obtained 0 of 3 points"></failure>
+  </testcase>
+  <system-out>ok      lab1    1.500s</system-out>
+</testsuite>
+`
+
+func TestWriteJUnit(t *testing.T) {
+	results := &score.Results{
+		BuildInfo: &score.BuildInfo{
+			BuildDate: "2021-09-01T12:00:00",
+			BuildLog:  "ok      lab1    1.500s",
+			ExecTime:  1500,
+		},
+		Scores: []*score.Score{
+			{TestName: "TestPass", Score: 5, MaxScore: 5, Weight: 1, ExecTimeMS: 250},
+			{TestName: "TestFail", Score: 2, MaxScore: 5, Weight: 1, TestDetails: `expected <nil>, got "error"`},
+			{TestName: "TestFailWithoutDetails", Score: 0, MaxScore: 3, Weight: 1},
+		},
+		Errors: []error{errors.New("failed to parse score")},
+	}
+	var b strings.Builder
+	if err := results.WriteJUnit(&b); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(wantJUnit, b.String()); diff != "" {
+		t.Errorf("WriteJUnit() mismatch (-want +got):\n%s", diff)
+	}
+	// the report must be well-formed XML
+	var suite struct {
+		Tests int `xml:"tests,attr"`
+	}
+	if err := xml.Unmarshal([]byte(b.String()), &suite); err != nil {
+		t.Fatalf("WriteJUnit() produced malformed XML: %v", err)
+	}
+	if suite.Tests != 3 {
+		t.Errorf("WriteJUnit() tests = %d, want 3", suite.Tests)
+	}
+}
+
+func TestWriteJUnitWithoutBuildInfo(t *testing.T) {
+	results := score.NewResults(&score.Score{TestName: "TestPass", Score: 1, MaxScore: 1, Weight: 1})
+	var b strings.Builder
+	if err := results.WriteJUnit(&b); err != nil {
+		t.Fatal(err)
+	}
+	want := `<?xml version="1.0" encoding="UTF-8"?>
+<testsuite tests="1" failures="0" errors="0">
+  <testcase name="TestPass" points="1/1"></testcase>
+</testsuite>
+`
+	if diff := cmp.Diff(want, b.String()); diff != "" {
+		t.Errorf("WriteJUnit() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,45 @@
+package score
+
+import "strings"
+
+// ScoreLineMarker marks a score line in the output of a test runner for a
+// language other than Go. A score line consists of the marker followed by
+// a JSON score object on the same line, for example:
+//
+//   QUICKFEED_SCORE {"TestName":"test_fib","Score":3,"MaxScore":5,"Weight":1,"Signature":"..."}
+//
+// The marker may be preceded by other output on the same line, such as a log
+// prefix added by the test runner. The test runner obtains the session secret
+// from the QUICKFEED_SESSION_SECRET environment variable, and must either sign
+// the score object with an HMAC-SHA256 keyed by the secret over the string
+// "TestName|Score|MaxScore|Weight", as done by Sign, or include the secret in
+// the Secret field. Signing is preferred, since it keeps the secret out of the
+// test output. Hence, tests written in Python, Java, or C++ can report scores
+// to QuickFeed without linking this package.
+const ScoreLineMarker = "QUICKFEED_SCORE "
+
+// ParseScoreLine returns the score object in the given score line, which must
+// contain the ScoreLineMarker followed by a JSON score object for the given secret.
+// If the line does not contain the marker, ErrScoreNotFound is returned.
+func ParseScoreLine(line, secret string) (*Score, error) {
+	i := strings.Index(line, ScoreLineMarker)
+	if i < 0 {
+		return nil, ErrScoreNotFound
+	}
+	return Parse(strings.TrimSpace(line[i+len(ScoreLineMarker):]), secret)
+}
+
+// isScoreLine returns true if the given line holds a score object,
+// either as printed by this package or following the ScoreLineMarker.
+func isScoreLine(line string) bool {
+	return HasPrefix(line) || strings.Contains(line, ScoreLineMarker)
+}
+
+// parseScoreLine returns the score object in the given line, which is
+// either printed by this package or follows the ScoreLineMarker.
+func parseScoreLine(line, secret string) (*Score, error) {
+	if strings.Contains(line, ScoreLineMarker) {
+		return ParseScoreLine(line, secret)
+	}
+	return Parse(line, secret)
+}
@@ -0,0 +1,58 @@
+package score_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/autograde/quickfeed/kit/score"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+// externalScoreLine returns a score line as produced by a test runner for
+// another language, signing the score object as described by the protocol.
+func externalScoreLine(secret, testName string, points, maxScore, weight int) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%s|%d|%d|%d", testName, points, maxScore, weight)
+	return fmt.Sprintf(`%s{"TestName":%q,"Score":%d,"MaxScore":%d,"Weight":%d,"Signature":%q}`,
+		score.ScoreLineMarker, testName, points, maxScore, weight, hex.EncodeToString(mac.Sum(nil)))
+}
+
+func TestExtractResultsLineProtocol(t *testing.T) {
+	const secret = "59fd5fe1c4f741604c1beeab875b9c789d2a7c73"
+	out := strings.Join([]string{
+		"============================= test session starts ==============================",
+		"collected 3 items",
+		externalScoreLine(secret, "test_fib", 5, 5, 2),
+		"INFO:grader: " + externalScoreLine(secret, "test_sum", 1, 4, 1),
+		score.ScoreLineMarker + `{"Secret":"` + secret + `","TestName":"test_legacy","Score":2,"MaxScore":2,"Weight":1}`,
+		externalScoreLine("guessed", "test_forged", 10, 10, 1),
+		"========================= 1 failed, 2 passed in 0.12s ==========================",
+	}, "\n")
+
+	results := score.ExtractResults(out, secret, 0)
+	want := []*score.Score{
+		{TestName: "test_fib", Score: 5, MaxScore: 5, Weight: 2},
+		{TestName: "test_sum", Score: 1, MaxScore: 4, Weight: 1},
+		{TestName: "test_legacy", Score: 2, MaxScore: 2, Weight: 1},
+	}
+	if diff := cmp.Diff(want, results.Scores, cmpopts.IgnoreUnexported(score.Score{}), cmpopts.IgnoreFields(score.Score{}, "Signature")); diff != "" {
+		t.Errorf("ExtractResults() mismatch (-want +got):\n%s", diff)
+	}
+	if len(results.Errors) != 1 {
+		t.Errorf("ExtractResults() errors = %v, want one error for the forged score", results.Errors)
+	}
+	if strings.Contains(results.BuildInfo.BuildLog, score.ScoreLineMarker) {
+		t.Errorf("build log contains score lines:\n%s", results.BuildInfo.BuildLog)
+	}
+}
+
+func TestParseScoreLine(t *testing.T) {
+	if _, err := score.ParseScoreLine(`{"TestName":"test_fib","Score":1,"MaxScore":1,"Weight":1}`, ""); err != score.ErrScoreNotFound {
+		t.Errorf("ParseScoreLine() = %v, want %v", err, score.ErrScoreNotFound)
+	}
+}
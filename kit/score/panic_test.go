@@ -3,9 +3,12 @@ package score_test
 import (
 	"fmt"
 	"os"
+	"os/exec"
 	"testing"
 
+	"github.com/autograde/quickfeed/kit/score"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 )
 
 // To run all these tests, to show stack trace and panic output, use:
@@ -160,3 +163,44 @@ func triangularPanic(n uint) uint {
 	}
 	return (n * (n + 1)) / 2
 }
+
+func TestPanicGuardPanic(t *testing.T) {
+	// This test panics, and is run by TestPanicGuard in a separate process.
+	panicTest := os.Getenv(panicTestEnvName)
+	if panicTest == "" {
+		t.Skipf("Skipping; expected to fail. Run with: %s=1 go test -v -run %s", panicTestEnvName, t.Name())
+	}
+
+	registry := score.NewRegistry()
+	registry.Add(TestPanicGuardPanic, len(triangularTests), 5)
+	sc := registry.Max()
+	defer score.PanicGuard(t, sc)
+	for _, test := range triangularTests {
+		if diff := cmp.Diff(test.want, triangularPanic(test.in)); diff != "" {
+			sc.Dec()
+			t.Errorf("triangular(%d): (-want +got):\n%s", test.in, diff)
+		}
+	}
+}
+
+func TestPanicGuard(t *testing.T) {
+	cmd := exec.Command("go", "test", "-run", "^TestPanicGuardPanic$")
+	cmd.Env = append(os.Environ(), panicTestEnvName+"=1")
+	out, err := cmd.Output()
+	if err == nil {
+		t.Fatal("TestPanicGuardPanic passed, want failure")
+	}
+	// TestMain also prints the tests registered with the scores registry; ignore those
+	var got []*score.Score
+	for _, sc := range score.ExtractResults(string(out), "", 0).Scores {
+		if sc.GetTestName() == "TestPanicGuardPanic" {
+			got = append(got, sc)
+		}
+	}
+	want := []*score.Score{
+		{TestName: "TestPanicGuardPanic", Score: 0, MaxScore: int32(len(triangularTests)), Weight: 5, TestDetails: "test panicked: n > 4"},
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreUnexported(score.Score{}), cmpopts.IgnoreFields(score.Score{}, "ExecTimeMS", "Signature")); diff != "" {
+		t.Errorf("PanicGuard() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -14,13 +14,16 @@ var (
 	ErrWeight           = errors.New("Weight must be greater than 0")
 	ErrEmptyTestName    = errors.New("TestName must be specified")
 	ErrSecret           = errors.New("Secret field must match expected secret")
+	ErrSignature        = errors.New("Signature field must match expected signature")
 	ErrSuppressedSecret = errors.New("Error suppressed to avoid revealing secret")
+	ErrNotReported      = errors.New("Test never reported a score")
+	ErrReportedTwice    = errors.New("Test reported a score more than once")
 )
 
 // Parse returns a score object for the provided JSON string s
-// which contains secret.
+// which contains secret or a signature made with secret.
 func Parse(s, secret string) (*Score, error) {
-	if strings.Contains(s, secret) {
+	if strings.Contains(s, secret) || strings.Contains(s, `"Signature":`) {
 		var sc Score
 		err := json.Unmarshal([]byte(s), &sc)
 		if err == nil {
@@ -40,8 +43,9 @@ func Parse(s, secret string) (*Score, error) {
 
 // IsValid returns an error if the score object is invalid.
 // Otherwise, nil is returned.
-// If the given secret matches the score's secret value,
-// the Secret field is redacted with the empty string "".
+// A signed score object is valid only if its signature was made with the
+// given secret; an unsigned score object must match the secret instead.
+// If the score is valid, the Secret field is redacted with the empty string "".
 func (sc *Score) IsValid(secret string) error {
 	tName := sc.GetTestName()
 	if tName == "" {
@@ -56,7 +60,10 @@ func (sc *Score) IsValid(secret string) error {
 	if sc.Score < 0 || sc.Score > sc.MaxScore {
 		return errMsg(tName, ErrScoreInterval.Error())
 	}
-	if sc.Secret != secret {
+	if !sc.validFor(secret) {
+		if sc.GetSignature() != "" {
+			return errMsg(tName, ErrSignature.Error())
+		}
 		return errMsg(tName, ErrSecret.Error())
 	}
 	sc.Secret = "" // redact the secret session key
@@ -2,32 +2,47 @@ package score
 
 import (
 	"fmt"
+	"math"
 	"path/filepath"
 	"reflect"
 	"runtime"
 	"sort"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
-// registry keeps a map of score objects and a slice of test names,
+// Registry keeps a map of score objects and a slice of test names,
 // in registration order, which is used to preserve deterministic iteration order.
-type registry struct {
+type Registry struct {
 	testNames []string          // testNames in registration order
 	scores    map[string]*Score // map from TestName to score object
 }
 
-func NewRegistry() *registry {
-	return &registry{
+func NewRegistry() *Registry {
+	return &Registry{
 		testNames: make([]string, 0),
 		scores:    make(map[string]*Score),
 	}
 }
 
-// Validate returns an error if one of the recorded score objects are invalid.
-// Otherwise, nil is returned.
-func (s *registry) Validate() error {
+// Validate returns an error if one of the registered tests never reported a score,
+// reported a score more than once, or if one of the recorded score objects are invalid.
+// Otherwise, nil is returned. This prevents tests from being silently skipped,
+// and should be called from TestMain after the tests have completed; the test
+// run should fail if an error is returned.
+func (s *Registry) Validate() error {
 	callFrame()
+	for _, name := range s.testNames {
+		switch reports(s.scores[name]) {
+		case 0:
+			return errMsg(name, ErrNotReported.Error())
+		case 1:
+		default:
+			return errMsg(name, ErrReportedTwice.Error())
+		}
+	}
 	for _, sc := range s.scores {
 		if err := sc.IsValid(sessionSecret); err != nil {
 			return err
@@ -36,6 +51,22 @@ func (s *registry) Validate() error {
 	return nil
 }
 
+// Manifest returns the registered tests in registration order, with their max
+// score and weight, but without their secret and score. The manifest is the
+// canonical list of tests and their max scores for grading.
+func (s *Registry) Manifest() []*Score {
+	manifest := make([]*Score, len(s.testNames))
+	for i, name := range s.testNames {
+		sc := s.scores[name]
+		manifest[i] = &Score{
+			TestName: sc.GetTestName(),
+			MaxScore: sc.GetMaxScore(),
+			Weight:   sc.GetWeight(),
+		}
+	}
+	return manifest
+}
+
 // PrintTestInfo prints a JSON representation of all registered tests
 // in the order they were registered, or if sorted is true the test names
 // will be sorted before printing.
@@ -44,7 +75,7 @@ func (s *registry) Validate() error {
 // but before test execution. This can be done in TestMain.
 //
 // Will panic if called from a non-test function.
-func (s *registry) PrintTestInfo(sorted ...bool) {
+func (s *Registry) PrintTestInfo(sorted ...bool) {
 	callFrame()
 	if len(sorted) == 1 && sorted[0] {
 		sort.Strings(s.testNames)
@@ -60,7 +91,7 @@ func (s *registry) PrintTestInfo(sorted ...bool) {
 // Add test with given max score and weight to the registry.
 //
 // Will panic if the test has already been registered or if max or weight is non-positive.
-func (s *registry) Add(test interface{}, max, weight int) {
+func (s *Registry) Add(test interface{}, max, weight int) {
 	s.add(testName(test), max, weight)
 }
 
@@ -69,7 +100,7 @@ func (s *registry) Add(test interface{}, max, weight int) {
 // conjunction with MaxByName and MinByName called from within a subtest.
 //
 // Will panic if the test has already been registered or if max or weight is non-positive.
-func (s *registry) AddSub(test interface{}, subTestName string, max, weight int) {
+func (s *Registry) AddSub(test interface{}, subTestName string, max, weight int) {
 	tstName := fmt.Sprintf("%s/%s", testName(test), subTestName)
 	s.add(tstName, max, weight)
 }
@@ -78,7 +109,7 @@ func (s *registry) AddSub(test interface{}, subTestName string, max, weight int)
 // The returned score object should be used with score.Dec() and score.DecBy().
 //
 // Will panic with unknown score test, if the test hasn't been added.
-func (s *registry) Max() *Score {
+func (s *Registry) Max() *Score {
 	testName := callerTestName()
 	sc := s.get(testName)
 	sc.Score = sc.GetMaxScore()
@@ -89,7 +120,7 @@ func (s *registry) Max() *Score {
 // The returned score object should be used with score.Inc() and score.IncBy().
 //
 // Will panic with unknown score test, if the test hasn't been added.
-func (s *registry) Min() *Score {
+func (s *Registry) Min() *Score {
 	testName := callerTestName()
 	return s.get(testName)
 }
@@ -99,7 +130,7 @@ func (s *registry) Min() *Score {
 // The returned score object should be used with score.Dec() and score.DecBy().
 //
 // Will panic with unknown score test, if the test hasn't been added.
-func (s *registry) MaxByName(testName string) *Score {
+func (s *Registry) MaxByName(testName string) *Score {
 	sc := s.get(testName)
 	sc.Score = sc.GetMaxScore()
 	return sc
@@ -110,10 +141,45 @@ func (s *registry) MaxByName(testName string) *Score {
 // The returned score object should be used with score.Inc() and score.IncBy().
 //
 // Will panic with unknown score test, if the test hasn't been added.
-func (s *registry) MinByName(testName string) *Score {
+func (s *Registry) MinByName(testName string) *Score {
 	return s.get(testName)
 }
 
+// Compose returns a score object for the calling test whose Score is composed
+// from the scores of its subtests, registered with AddSub and obtained with
+// MaxByName or MinByName. Each subtest contributes its relative score in
+// proportion to its weight, and the result is scaled to the max score of the
+// calling test, which must be registered with Add. The subtests' scores are
+// summarized in the returned score object's TestDetails.
+//
+// This should be called after all subtests have completed. Only the returned
+// score object should be printed; printing the subtests' score objects as well
+// would count their points twice.
+//
+// Will panic with unknown score test, if the calling test hasn't been added.
+func (s *Registry) Compose() *Score {
+	parentName := callerTestName()
+	parent := s.get(parentName)
+	var details []string
+	var weightedScore, totalWeight float64
+	for _, name := range s.testNames {
+		if !strings.HasPrefix(name, parentName+"/") {
+			continue
+		}
+		sub := s.scores[name]
+		// the subtest's score is reported as part of the composed score
+		recordReport(sub)
+		weightedScore += float64(sub.GetScore()) / float64(sub.GetMaxScore()) * float64(sub.GetWeight())
+		totalWeight += float64(sub.GetWeight())
+		details = append(details, sub.RelativeScore())
+	}
+	if totalWeight > 0 {
+		parent.Score = int32(math.Round(weightedScore / totalWeight * float64(parent.GetMaxScore())))
+	}
+	parent.TestDetails = strings.Join(details, "\n")
+	return parent
+}
+
 func testName(testFn interface{}) string {
 	typ := reflect.TypeOf(testFn)
 	if typ.Kind() != reflect.Func {
@@ -159,7 +225,7 @@ func firstElem(name string) string {
 	return name[:end]
 }
 
-func (s *registry) add(testName string, max, weight int) {
+func (s *Registry) add(testName string, max, weight int) {
 	if _, found := s.scores[testName]; found {
 		panic(errMsg(testName, "Duplicate score test"))
 	}
@@ -180,7 +246,7 @@ func (s *registry) add(testName string, max, weight int) {
 	s.scores[testName] = sc
 }
 
-func (s *registry) get(testName string) *Score {
+func (s *Registry) get(testName string) *Score {
 	callingTestName := callFrame()
 	testFnName := stripPkg(callingTestName.Function)
 	rootTestName := firstElem(testFnName)
@@ -189,7 +255,46 @@ func (s *registry) get(testName string) *Score {
 		panic(errMsg(testName, "unauthorized lookup"))
 	}
 	if sc, ok := s.scores[testName]; ok {
+		recordStart(sc)
 		return sc
 	}
 	panic(errMsg(testName, "unknown score test"))
 }
+
+var (
+	reportsMu sync.Mutex
+	reported  = make(map[*Score]int)
+	started   = make(map[*Score]time.Time)
+)
+
+// recordStart records the current time as the start time of the given
+// score object's test, unless a start time has already been recorded.
+func recordStart(sc *Score) {
+	reportsMu.Lock()
+	defer reportsMu.Unlock()
+	if _, ok := started[sc]; !ok {
+		started[sc] = time.Now()
+	}
+}
+
+// startTime returns the start time of the given score object's test, if recorded.
+func startTime(sc *Score) (time.Time, bool) {
+	reportsMu.Lock()
+	defer reportsMu.Unlock()
+	start, ok := started[sc]
+	return start, ok
+}
+
+// recordReport records that the given score object has been reported.
+func recordReport(sc *Score) {
+	reportsMu.Lock()
+	defer reportsMu.Unlock()
+	reported[sc]++
+}
+
+// reports returns the number of times the given score object has been reported.
+func reports(sc *Score) int {
+	reportsMu.Lock()
+	defer reportsMu.Unlock()
+	return reported[sc]
+}
@@ -6,8 +6,11 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/autograde/quickfeed/kit/score"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 )
 
 func fibonacci(n uint) uint {
@@ -31,6 +34,9 @@ func init() {
 	for _, ft := range fibonacciTests {
 		scoreRegistry.AddSub(TestFibonacciSubTest, subTestName("Min", ft.in), 1, 1)
 	}
+	scoreRegistry.Add(TestFibonacciComposed, 20, 1)
+	scoreRegistry.AddSub(TestFibonacciComposed, "Small", len(fibonacciTests[:numCorrect]), 1)
+	scoreRegistry.AddSub(TestFibonacciComposed, "Large", len(fibonacciTests[numCorrect:]), 3)
 }
 
 const (
@@ -137,6 +143,35 @@ func TestFibonacciSubTest(t *testing.T) {
 	}
 }
 
+func TestFibonacciComposed(t *testing.T) {
+	subtests := map[string][]struct{ in, want uint }{
+		"Small": fibonacciTests[:numCorrect],
+		"Large": fibonacciTests[numCorrect:],
+	}
+	for _, name := range []string{"Small", "Large"} {
+		t.Run(name, func(t *testing.T) {
+			sc := scoreRegistry.MinByName(t.Name())
+			for _, ft := range subtests[name] {
+				if fibonacci(ft.in) == ft.want {
+					sc.Inc()
+				}
+			}
+		})
+	}
+	sc := scoreRegistry.Compose()
+	// Small: 10/10 with weight 1; Large: 0/4 with weight 3
+	if want := int32(5); sc.Score != want {
+		t.Errorf("Score=%d, expected %d", sc.Score, want)
+	}
+	if sc.TestName != t.Name() {
+		t.Errorf("TestName=%s, expected %s", sc.TestName, t.Name())
+	}
+	wantDetails := "TestFibonacciComposed/Small: score = 10/10 = 1.0\nTestFibonacciComposed/Large: score = 0/4 = 0.0"
+	if sc.TestDetails != wantDetails {
+		t.Errorf("TestDetails=%q, expected %q", sc.TestDetails, wantDetails)
+	}
+}
+
 func subTestName(prefix string, i uint) string {
 	return fmt.Sprintf("%s/%d", prefix, i)
 }
@@ -175,3 +210,65 @@ func TestStudentAttackCode(t *testing.T) {
 		})
 	}
 }
+
+func TestRegistryValidateReports(t *testing.T) {
+	tests := []struct {
+		name    string
+		prints  map[string]int
+		wantErr error
+	}{
+		{name: "all_reported", prints: map[string]int{"A": 1, "B": 1}, wantErr: nil},
+		{name: "never_reported", prints: map[string]int{"A": 1, "B": 0}, wantErr: score.ErrNotReported},
+		{name: "reported_twice", prints: map[string]int{"A": 2, "B": 1}, wantErr: score.ErrReportedTwice},
+	}
+	for _, test := range tests {
+		registry := score.NewRegistry()
+		for _, sub := range []string{"A", "B"} {
+			registry.AddSub(TestRegistryValidateReports, test.name+"/"+sub, 1, 1)
+		}
+		t.Run(test.name, func(t *testing.T) {
+			for _, sub := range []string{"A", "B"} {
+				sc := registry.MaxByName(t.Name() + "/" + sub)
+				for i := 0; i < test.prints[sub]; i++ {
+					sc.Print(t)
+				}
+			}
+			err := registry.Validate()
+			if test.wantErr == nil && err != nil {
+				t.Errorf("Validate() = %v, want nil", err)
+			}
+			if test.wantErr != nil && (err == nil || !strings.Contains(err.Error(), test.wantErr.Error())) {
+				t.Errorf("Validate() = %v, want error containing %q", err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestDefaultRegistry(t *testing.T) {
+	score.Add(TestDefaultRegistry, 4, 2)
+	want := []*score.Score{{TestName: "TestDefaultRegistry", MaxScore: 4, Weight: 2}}
+	if diff := cmp.Diff(want, score.Manifest(), cmpopts.IgnoreUnexported(score.Score{})); diff != "" {
+		t.Errorf("Manifest() mismatch (-want +got):\n%s", diff)
+	}
+	if err := score.Validate(); err == nil || !strings.Contains(err.Error(), score.ErrNotReported.Error()) {
+		t.Errorf("Validate() = %v, want error containing %q", err, score.ErrNotReported)
+	}
+	sc := score.Max()
+	sc.Dec()
+	sc.Print(t)
+	if err := score.Validate(); err != nil {
+		t.Errorf("Validate() = %v, want nil", err)
+	}
+}
+
+func TestRegistryExecTime(t *testing.T) {
+	const delay = 20 * time.Millisecond
+	registry := score.NewRegistry()
+	registry.Add(TestRegistryExecTime, 1, 1)
+	sc := registry.Max()
+	time.Sleep(delay)
+	sc.Print(t)
+	if sc.ExecTime() < delay {
+		t.Errorf("ExecTime() = %v, want at least %v", sc.ExecTime(), delay)
+	}
+}
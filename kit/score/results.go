@@ -3,6 +3,7 @@ package score
 import (
 	"fmt"
 	"math"
+	"sort"
 	"strings"
 	"time"
 )
@@ -39,6 +40,10 @@ type Results struct {
 	Errors    []error    // errors encountered during test execution
 	testNames []string   // defines the order
 	scores    map[string]*Score
+
+	// MemberContributions holds each group member's fraction of the group's work,
+	// keyed by member, for display alongside a group's results; nil if none.
+	MemberContributions map[string]float64
 }
 
 // ExtractResults returns the results from a test execution extracted from the given out string.
@@ -48,8 +53,8 @@ func ExtractResults(out, secret string, execTime time.Duration) *Results {
 	results := NewResults()
 	for _, line := range strings.Split(out, "\n") {
 		// check if line has expected JSON score string
-		if HasPrefix(line) {
-			sc, err := Parse(line, secret)
+		if isScoreLine(line) {
+			sc, err := parseScoreLine(line, secret)
 			if err != nil {
 				errs = append(errs, fmt.Errorf("failed to parse score: %s: %v", line, err))
 				continue
@@ -71,6 +76,19 @@ func ExtractResults(out, secret string, execTime time.Duration) *Results {
 	}
 }
 
+// EnsureBuildInfo initializes an empty build info object, if none exists.
+// Results obtained from a failed parse may lack build info.
+func (r *Results) EnsureBuildInfo() {
+	if r.BuildInfo == nil {
+		r.BuildInfo = &BuildInfo{}
+	}
+}
+
+// ExecTime returns the execution time of the tests, or zero if no build info exists.
+func (r *Results) ExecTime() time.Duration {
+	return time.Duration(r.BuildInfo.GetExecTime()) * time.Millisecond
+}
+
 // addScore adds the given score to the set of scores.
 // This method assumes that the provided score object is valid.
 func (r *Results) addScore(sc *Score) {
@@ -92,35 +110,455 @@ func (r *Results) addScore(sc *Score) {
 	r.scores[testName] = sc
 }
 
-// Validate returns an error if one of the recorded score objects are invalid.
+// Validate returns an error if one of the recorded score objects are invalid,
+// or if more than one score object was recorded for the same test.
 // Otherwise, nil is returned.
 func (r *Results) Validate(secret string) error {
+	seen := make(map[string]bool)
 	for _, sc := range r.Scores {
 		if err := sc.IsValid(secret); err != nil {
 			return err
 		}
+		if seen[sc.GetTestName()] {
+			return fmt.Errorf("%s: %w", sc.GetTestName(), ErrReportedTwice)
+		}
+		seen[sc.GetTestName()] = true
 	}
 	return nil
 }
 
+// SecretLeaked returns true if the given secret appears in the build log or in
+// the test details of any score object, e.g., because the student's code printed
+// it. The server should redact the results and flag the submission before showing
+// the results to students. An empty secret is never considered leaked.
+func (r *Results) SecretLeaked(secret string) bool {
+	if secret == "" {
+		return false
+	}
+	if strings.Contains(r.BuildInfo.GetBuildLog(), secret) {
+		return true
+	}
+	for _, sc := range r.Scores {
+		if strings.Contains(sc.GetTestDetails(), secret) {
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveInvalidScoresMulti removes the score objects whose secret, or signature,
+// does not belong to one of the given valid secrets, generalizing Validate to
+// overlapping grading sessions, each with its own secret. An error is recorded for
+// each removed score object, and the secret of the remaining score objects is
+// redacted. The order of the remaining score objects is preserved.
+func (r *Results) RemoveInvalidScoresMulti(valid map[string]bool) {
+	var scores []*Score
+	for _, sc := range r.Scores {
+		if !sc.validForAny(valid) {
+			err := ErrSecret
+			if sc.GetSignature() != "" {
+				err = ErrSignature
+			}
+			r.Errors = append(r.Errors, fmt.Errorf("%s: %w", sc.GetTestName(), err))
+			continue
+		}
+		sc.Secret = "" // redact the secret session key
+		scores = append(scores, sc)
+	}
+	r.Scores = scores
+	r.testNames = nil
+	r.scores = make(map[string]*Score)
+	for _, sc := range scores {
+		if _, found := r.scores[sc.GetTestName()]; !found {
+			r.testNames = append(r.testNames, sc.GetTestName())
+		}
+		r.scores[sc.GetTestName()] = sc
+	}
+}
+
 // Sum returns the total score computed over the set of recorded scores.
-// The total is a grade in the range 0-100.
+// The total is a grade in the range 0-100, rounded to the nearest integer,
+// with halves rounded up.
 // This method must only be called after Validate has returned nil.
 func (r *Results) Sum() uint32 {
 	totalWeight := float64(0)
-	var max, score, weight []float64
 	for _, ts := range r.Scores {
 		totalWeight += float64(ts.Weight)
+	}
+	return grade(r.Scores, totalWeight)
+}
+
+// BeatsBest returns true if the grade computed by Sum exceeds the given best
+// grade, along with the computed grade. This implements the policy of keeping
+// a student's best grade: the stored best grade should only be replaced by a
+// strictly higher grade.
+func (r *Results) BeatsBest(bestGrade uint32) (bool, uint32) {
+	newGrade := r.Sum()
+	return newGrade > bestGrade, newGrade
+}
+
+// SumWithExtraCredit returns the total score computed over the set of recorded
+// scores, where the scores of the given extra credit tests are added on top of
+// the grade obtained from the other tests. That is, extra credit tests do not
+// contribute to the total weight. The total is clamped to the given max grade.
+// This method must only be called after Validate has returned nil.
+func (r *Results) SumWithExtraCredit(extraCredit []string, maxGrade uint32) uint32 {
+	isExtraCredit := make(map[string]bool)
+	for _, testName := range extraCredit {
+		isExtraCredit[testName] = true
+	}
+	baseWeight := float64(0)
+	for _, ts := range r.Scores {
+		if !isExtraCredit[ts.GetTestName()] {
+			baseWeight += float64(ts.Weight)
+		}
+	}
+	total := grade(r.Scores, baseWeight)
+	if total > maxGrade {
+		return maxGrade
+	}
+	return total
+}
+
+// grade returns the weighted grade for the given scores, where each score's
+// weight is relative to the given total weight. The grade is in the range 0-100,
+// unless the weights of the scores add up to more than the total weight.
+// Scores are clamped to the interval [0, MaxScore], and a score with a
+// non-positive max score counts as failed.
+func grade(scores []*Score, totalWeight float64) uint32 {
+	if totalWeight <= 0 {
+		return 0
+	}
+	var max, score, weight []float64
+	for _, ts := range scores {
 		weight = append(weight, float64(ts.Weight))
 		score = append(score, float64(ts.Score))
 		max = append(max, float64(ts.MaxScore))
 	}
 	total := float64(0)
 	for i := 0; i < len(score); i++ {
-		if score[i] > max[i] {
-			score[i] = max[i]
+		if max[i] <= 0 {
+			continue
 		}
+		score[i] = math.Max(0, math.Min(score[i], max[i]))
 		total += (score[i] / max[i]) * (weight[i] / totalWeight)
 	}
 	return uint32(math.Round(total * 100))
 }
+
+// TrivialPasses returns the names of tests that pass in both these results and
+// the given baseline results. The baseline is expected to be obtained by running
+// the tests against an empty or trivial submission; a test that passes for such
+// a submission is likely broken. The test names are returned in the order they
+// appear in these results.
+func (r *Results) TrivialPasses(baseline *Results) []string {
+	if baseline == nil {
+		return nil
+	}
+	baselinePasses := make(map[string]bool)
+	for _, sc := range baseline.Scores {
+		if sc.IsPassing() {
+			baselinePasses[sc.GetTestName()] = true
+		}
+	}
+	var trivial []string
+	for _, sc := range r.Scores {
+		if sc.IsPassing() && baselinePasses[sc.GetTestName()] {
+			trivial = append(trivial, sc.GetTestName())
+		}
+	}
+	return trivial
+}
+
+// Partition returns two results objects, the first holding the passing tests
+// and the second holding the failing tests, as determined by Score.IsPassing.
+// Both results objects share the build info of r.
+func (r *Results) Partition() (passed, failed *Results) {
+	var passing, failing []*Score
+	for _, sc := range r.Scores {
+		if sc.IsPassing() {
+			passing = append(passing, sc)
+		} else {
+			failing = append(failing, sc)
+		}
+	}
+	passed, failed = NewResults(passing...), NewResults(failing...)
+	passed.BuildInfo, failed.BuildInfo = r.BuildInfo, r.BuildInfo
+	return passed, failed
+}
+
+// Reconcile returns the results of the first run, except that the retryable
+// tests that failed in the first run are replaced by the score obtained in
+// a later run, if the test passed in that run. That is, a retryable test
+// counts as passed if it passed in any of the runs.
+// The build info and errors of the first run are retained.
+func Reconcile(retryable []string, runs ...*Results) *Results {
+	if len(runs) == 0 || runs[0] == nil {
+		return nil
+	}
+	isRetryable := make(map[string]bool)
+	for _, testName := range retryable {
+		isRetryable[testName] = true
+	}
+	first := runs[0]
+	scores := make([]*Score, len(first.Scores))
+	for i, sc := range first.Scores {
+		scores[i] = sc
+		if sc.IsPassing() || !isRetryable[sc.GetTestName()] {
+			continue
+		}
+		for _, run := range runs[1:] {
+			if retry := run.find(sc.GetTestName()); retry.IsPassing() {
+				scores[i] = retry
+				break
+			}
+		}
+	}
+	return &Results{
+		BuildInfo: first.BuildInfo,
+		Scores:    scores,
+		Errors:    first.Errors,
+	}
+}
+
+// WithoutPoints returns a copy of the results whose score objects only reveal
+// whether each test passed, for presenting results to students without points.
+// The build info and errors are shared with r.
+func (r *Results) WithoutPoints() *Results {
+	scores := make([]*Score, len(r.Scores))
+	for i, sc := range r.Scores {
+		scores[i] = sc.WithoutPoints()
+	}
+	return &Results{
+		BuildInfo: r.BuildInfo,
+		Scores:    scores,
+		Errors:    r.Errors,
+	}
+}
+
+// WithoutTests returns a copy of the results without the score objects of the
+// given tests, for presenting results to students without the hidden tests.
+// The build info and errors are shared with r.
+func (r *Results) WithoutTests(testNames []string) *Results {
+	hidden := make(map[string]bool, len(testNames))
+	for _, testName := range testNames {
+		hidden[testName] = true
+	}
+	scores := make([]*Score, 0, len(r.Scores))
+	for _, sc := range r.Scores {
+		if !hidden[sc.GetTestName()] {
+			scores = append(scores, sc)
+		}
+	}
+	return &Results{
+		BuildInfo: r.BuildInfo,
+		Scores:    scores,
+		Errors:    r.Errors,
+	}
+}
+
+// CoalescePolicy determines which score object to keep when the results
+// contain multiple score objects for the same test.
+type CoalescePolicy int
+
+const (
+	// KeepFirst keeps the first score object recorded for the test.
+	KeepFirst CoalescePolicy = iota
+	// KeepLast keeps the last score object recorded for the test.
+	KeepLast
+	// KeepBest keeps the score object with the highest relative score.
+	KeepBest
+	// KeepWorst keeps the score object with the lowest relative score.
+	KeepWorst
+)
+
+// Coalesce replaces duplicate score objects for the same test with a single
+// score object selected according to the given policy. The coalesced score
+// objects are ordered by the first occurrence of each test name. If several
+// score objects are equally good (or bad), the first one is kept, so that the
+// outcome does not depend on the order in which the tests were reported.
+func (r *Results) Coalesce(policy CoalescePolicy) {
+	var testNames []string
+	kept := make(map[string]*Score)
+	for _, sc := range r.Scores {
+		testName := sc.GetTestName()
+		current, found := kept[testName]
+		if !found {
+			testNames = append(testNames, testName)
+			kept[testName] = sc
+			continue
+		}
+		switch policy {
+		case KeepLast:
+			kept[testName] = sc
+		case KeepBest:
+			if sc.compare(current) > 0 {
+				kept[testName] = sc
+			}
+		case KeepWorst:
+			if sc.compare(current) < 0 {
+				kept[testName] = sc
+			}
+		}
+	}
+	r.testNames = testNames
+	r.scores = kept
+	r.Scores = r.toScoreSlice()
+}
+
+// AddMissing adds a failed score object for each of the expected tests without a
+// recorded score, with the expected test's max score and weight, such that the
+// missing tests count as failed. Returns the names of the added tests, in the
+// order they were expected.
+func (r *Results) AddMissing(expected []*Score) []string {
+	var missing []string
+	for _, exp := range expected {
+		if r.find(exp.GetTestName()) != nil {
+			continue
+		}
+		sc := &Score{
+			TestName: exp.GetTestName(),
+			MaxScore: exp.GetMaxScore(),
+			Weight:   exp.GetWeight(),
+		}
+		if r.scores != nil {
+			// keep the index of results created by NewResults up to date
+			r.testNames = append(r.testNames, sc.TestName)
+			r.scores[sc.TestName] = sc
+		}
+		r.Scores = append(r.Scores, sc)
+		missing = append(missing, sc.TestName)
+	}
+	return missing
+}
+
+// find returns the score object for the given test name,
+// or nil if no score was found for the test.
+func (r *Results) find(testName string) *Score {
+	if r == nil {
+		return nil
+	}
+	for _, sc := range r.Scores {
+		if sc.GetTestName() == testName {
+			return sc
+		}
+	}
+	return nil
+}
+
+// SetDeltas sets the Delta field of each score object to the change in score
+// since the corresponding score object in prev, the results of the student's
+// previous submission, allowing the frontend to show which tests improved and
+// which worsened. Tests without a score object in prev get a zero delta.
+func (r *Results) SetDeltas(prev *Results) {
+	for _, sc := range r.Scores {
+		sc.Delta = 0
+		if old := prev.find(sc.GetTestName()); old != nil {
+			sc.Delta = sc.GetScore() - old.GetScore()
+		}
+	}
+}
+
+// UnchangedFrom returns true if r holds the same results as prev, allowing the
+// storage layer to skip writing identical results, e.g., when a submission is
+// graded again. The score objects are compared regardless of their order, and
+// only the test name, score, max score, weight and test details are compared.
+// The build info's build date and execution time are also ignored, since they
+// differ between test runs, whereas the build log is compared.
+func (r *Results) UnchangedFrom(prev *Results) bool {
+	if r == nil || prev == nil {
+		return r == prev
+	}
+	if r.BuildInfo.GetBuildLog() != prev.BuildInfo.GetBuildLog() || len(r.Scores) != len(prev.Scores) {
+		return false
+	}
+	current, previous := r.scoreKeys(), prev.scoreKeys()
+	for i := range current {
+		if current[i] != previous[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// ChangedSince returns the results holding only the tests whose pass/fail status
+// or score changed from prev, e.g., to show students which tests they fixed and
+// which they broke since their last submission. Tests that are new in r are
+// included, whereas tests that are only in prev are ignored. The score objects
+// are in the order they appear in r, and the build info is shared with r.
+func (r *Results) ChangedSince(prev *Results) *Results {
+	var changed []*Score
+	for _, sc := range r.Scores {
+		old := prev.find(sc.GetTestName())
+		if old == nil || old.IsPassing() != sc.IsPassing() ||
+			old.GetScore() != sc.GetScore() || old.GetMaxScore() != sc.GetMaxScore() {
+			changed = append(changed, sc)
+		}
+	}
+	results := NewResults(changed...)
+	results.BuildInfo = r.BuildInfo
+	return results
+}
+
+// scoreKeys returns the sorted keys of the score objects, where each key
+// represents the score object's content, excluding its secret and IDs.
+func (r *Results) scoreKeys() []string {
+	keys := make([]string, len(r.Scores))
+	for i, sc := range r.Scores {
+		keys[i] = fmt.Sprintf("%q %d/%d %d %q", sc.GetTestName(), sc.GetScore(), sc.GetMaxScore(), sc.GetWeight(), sc.GetTestDetails())
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Contributions returns each test's share, in percent, of the total possible
+// grade, based on the test's weight. The shares sum to 100 across all tests.
+// If the total weight is zero, an empty map is returned.
+func (r *Results) Contributions() map[string]float64 {
+	contributions := make(map[string]float64)
+	totalWeight := float64(0)
+	for _, sc := range r.Scores {
+		totalWeight += float64(sc.GetWeight())
+	}
+	if totalWeight <= 0 {
+		return contributions
+	}
+	for _, sc := range r.Scores {
+		contributions[sc.GetTestName()] += float64(sc.GetWeight()) / totalWeight * 100
+	}
+	return contributions
+}
+
+// contributionTolerance is the rounding error allowed when summing contribution fractions.
+const contributionTolerance = 1e-9
+
+// AttachContributions attaches the given per-member contribution fractions, e.g.,
+// obtained from the group's commit history, to the results for display. An error
+// is returned, and no fractions are attached, if a fraction is negative or not
+// a number, or if the fractions sum to more than 1. The fractions may sum to less
+// than 1, since the analysis may not attribute all work to the group's members.
+func (r *Results) AttachContributions(fractions map[string]float64) error {
+	members := make([]string, 0, len(fractions))
+	for member := range fractions {
+		members = append(members, member)
+	}
+	// sort to report the first invalid fraction deterministically
+	sort.Strings(members)
+	var sum float64
+	for _, member := range members {
+		fraction := fractions[member]
+		if fraction < 0 || math.IsNaN(fraction) {
+			return fmt.Errorf("%s: invalid contribution fraction %v", member, fraction)
+		}
+		sum += fraction
+	}
+	if sum > 1+contributionTolerance {
+		return fmt.Errorf("contribution fractions sum to %v, which is above 1", sum)
+	}
+	contributions := make(map[string]float64, len(fractions))
+	for member, fraction := range fractions {
+		contributions[member] = fraction
+	}
+	r.MemberContributions = contributions
+	return nil
+}
@@ -2,8 +2,10 @@ package score_test
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math"
 	"strings"
 	"testing"
 	"time"
@@ -600,3 +602,563 @@ func TestValidate(t *testing.T) {
 		}
 	}
 }
+
+func TestTrivialPasses(t *testing.T) {
+	baseline := score.NewResults(
+		&score.Score{TestName: "TestEmptyInput", Score: 5, MaxScore: 5, Weight: 1},
+		&score.Score{TestName: "TestFibonacci", Score: 0, MaxScore: 10, Weight: 1},
+		&score.Score{TestName: "TestAlwaysTrue", Score: 1, MaxScore: 1, Weight: 1},
+		&score.Score{TestName: "TestPartial", Score: 2, MaxScore: 4, Weight: 1},
+	)
+	submission := score.NewResults(
+		&score.Score{TestName: "TestFibonacci", Score: 10, MaxScore: 10, Weight: 1},
+		&score.Score{TestName: "TestAlwaysTrue", Score: 1, MaxScore: 1, Weight: 1},
+		&score.Score{TestName: "TestPartial", Score: 4, MaxScore: 4, Weight: 1},
+		&score.Score{TestName: "TestEmptyInput", Score: 5, MaxScore: 5, Weight: 1},
+	)
+	want := []string{"TestAlwaysTrue", "TestEmptyInput"}
+	if diff := cmp.Diff(want, submission.TrivialPasses(baseline)); diff != "" {
+		t.Errorf("TrivialPasses() mismatch (-want +got):\n%s", diff)
+	}
+	if got := submission.TrivialPasses(nil); len(got) != 0 {
+		t.Errorf("TrivialPasses(nil) = %v, want no tests", got)
+	}
+}
+
+func TestResultsNilBuildInfo(t *testing.T) {
+	results := score.NewResults(
+		&score.Score{TestName: "TestA", Score: 5, MaxScore: 5, Weight: 1},
+		&score.Score{TestName: "TestB", Score: 0, MaxScore: 5, Weight: 1},
+	)
+	if results.BuildInfo != nil {
+		t.Fatalf("BuildInfo = %v, want nil", results.BuildInfo)
+	}
+	if got := results.Sum(); got != 50 {
+		t.Errorf("Sum() = %d, want %d", got, 50)
+	}
+	if got := results.ExecTime(); got != 0 {
+		t.Errorf("ExecTime() = %v, want 0", got)
+	}
+	if got := results.TrivialPasses(results); len(got) != 1 {
+		t.Errorf("TrivialPasses() = %v, want [TestA]", got)
+	}
+	results.EnsureBuildInfo()
+	if results.BuildInfo == nil {
+		t.Fatal("EnsureBuildInfo() did not initialize BuildInfo")
+	}
+	buildInfo := results.BuildInfo
+	buildInfo.ExecTime = 1500
+	results.EnsureBuildInfo()
+	if results.BuildInfo != buildInfo {
+		t.Error("EnsureBuildInfo() replaced existing BuildInfo")
+	}
+	if got := results.ExecTime(); got != 1500*time.Millisecond {
+		t.Errorf("ExecTime() = %v, want %v", got, 1500*time.Millisecond)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	pass1 := &score.Score{TestName: "TestPass1", Score: 5, MaxScore: 5, Weight: 1}
+	pass2 := &score.Score{TestName: "TestPass2", Score: 1, MaxScore: 1, Weight: 2}
+	fail1 := &score.Score{TestName: "TestFail1", Score: 4, MaxScore: 5, Weight: 1}
+	fail2 := &score.Score{TestName: "TestFail2", Score: 0, MaxScore: 1, Weight: 2}
+
+	tests := []struct {
+		name       string
+		scores     []*score.Score
+		wantPassed []*score.Score
+		wantFailed []*score.Score
+	}{
+		{name: "all pass", scores: []*score.Score{pass1, pass2}, wantPassed: []*score.Score{pass1, pass2}, wantFailed: []*score.Score{}},
+		{name: "all fail", scores: []*score.Score{fail1, fail2}, wantPassed: []*score.Score{}, wantFailed: []*score.Score{fail1, fail2}},
+		{name: "mixed", scores: []*score.Score{pass1, fail1, pass2, fail2}, wantPassed: []*score.Score{pass1, pass2}, wantFailed: []*score.Score{fail1, fail2}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			results := score.NewResults(test.scores...)
+			results.BuildInfo = &score.BuildInfo{BuildLog: "log", ExecTime: 10}
+			passed, failed := results.Partition()
+			if diff := cmp.Diff(test.wantPassed, passed.Scores, cmpopts.IgnoreUnexported(score.Score{})); diff != "" {
+				t.Errorf("Partition() passed mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(test.wantFailed, failed.Scores, cmpopts.IgnoreUnexported(score.Score{})); diff != "" {
+				t.Errorf("Partition() failed mismatch (-want +got):\n%s", diff)
+			}
+			if passed.BuildInfo != results.BuildInfo || failed.BuildInfo != results.BuildInfo {
+				t.Errorf("Partition() did not carry BuildInfo: passed=%v, failed=%v", passed.BuildInfo, failed.BuildInfo)
+			}
+		})
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	first := score.NewResults(
+		&score.Score{TestName: "TestStable", Score: 2, MaxScore: 5, Weight: 1},
+		&score.Score{TestName: "TestFlaky", Score: 0, MaxScore: 5, Weight: 1},
+		&score.Score{TestName: "TestBroken", Score: 0, MaxScore: 5, Weight: 1},
+	)
+	first.BuildInfo = &score.BuildInfo{BuildLog: "first run"}
+	second := score.NewResults(
+		&score.Score{TestName: "TestStable", Score: 5, MaxScore: 5, Weight: 1},
+		&score.Score{TestName: "TestFlaky", Score: 5, MaxScore: 5, Weight: 1},
+		&score.Score{TestName: "TestBroken", Score: 1, MaxScore: 5, Weight: 1},
+	)
+	second.BuildInfo = &score.BuildInfo{BuildLog: "second run"}
+
+	got := score.Reconcile([]string{"TestFlaky", "TestBroken"}, first, second)
+	want := []*score.Score{
+		// not retryable; keep score from first run
+		{TestName: "TestStable", Score: 2, MaxScore: 5, Weight: 1},
+		// passed in second run
+		{TestName: "TestFlaky", Score: 5, MaxScore: 5, Weight: 1},
+		// failed in both runs; keep score from first run
+		{TestName: "TestBroken", Score: 0, MaxScore: 5, Weight: 1},
+	}
+	if diff := cmp.Diff(want, got.Scores, cmpopts.IgnoreUnexported(score.Score{})); diff != "" {
+		t.Errorf("Reconcile() mismatch (-want +got):\n%s", diff)
+	}
+	if got.BuildInfo != first.BuildInfo {
+		t.Errorf("Reconcile() BuildInfo = %v, want %v", got.BuildInfo, first.BuildInfo)
+	}
+	if got := score.Reconcile(nil); got != nil {
+		t.Errorf("Reconcile() = %v, want nil", got)
+	}
+}
+
+func TestCoalesce(t *testing.T) {
+	a1 := &score.Score{TestName: "TestA", Score: 3, MaxScore: 5, Weight: 1}
+	a2 := &score.Score{TestName: "TestA", Score: 5, MaxScore: 5, Weight: 1}
+	a3 := &score.Score{TestName: "TestA", Score: 1, MaxScore: 5, Weight: 1}
+	b1 := &score.Score{TestName: "TestB", Score: 2, MaxScore: 4, Weight: 1}
+	b2 := &score.Score{TestName: "TestB", Score: 1, MaxScore: 2, Weight: 1} // same relative score as b1
+	c1 := &score.Score{TestName: "TestC", Score: 1, MaxScore: 1, Weight: 1}
+
+	tests := []struct {
+		name   string
+		policy score.CoalescePolicy
+		scores []*score.Score
+		want   []*score.Score
+	}{
+		{name: "keep first", policy: score.KeepFirst, scores: []*score.Score{a1, b1, a2, c1, a3, b2}, want: []*score.Score{a1, b1, c1}},
+		{name: "keep last", policy: score.KeepLast, scores: []*score.Score{a1, b1, a2, c1, a3, b2}, want: []*score.Score{a3, b2, c1}},
+		{name: "keep best", policy: score.KeepBest, scores: []*score.Score{a1, b1, a2, c1, a3, b2}, want: []*score.Score{a2, b1, c1}},
+		{name: "keep worst", policy: score.KeepWorst, scores: []*score.Score{a1, b1, a2, c1, a3, b2}, want: []*score.Score{a3, b1, c1}},
+		{name: "keep best reordered", policy: score.KeepBest, scores: []*score.Score{a3, b2, a2, a1, b1, c1}, want: []*score.Score{a2, b2, c1}},
+		{name: "keep worst reordered", policy: score.KeepWorst, scores: []*score.Score{c1, a2, a3, b2, a1, b1}, want: []*score.Score{c1, a3, b2}},
+		{name: "no duplicates", policy: score.KeepBest, scores: []*score.Score{a1, b1, c1}, want: []*score.Score{a1, b1, c1}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			results := &score.Results{Scores: test.scores}
+			results.Coalesce(test.policy)
+			if diff := cmp.Diff(test.want, results.Scores, cmpopts.IgnoreUnexported(score.Score{})); diff != "" {
+				t.Errorf("Coalesce() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAddMissing(t *testing.T) {
+	a := &score.Score{TestName: "TestA", Score: 3, MaxScore: 5, Weight: 1}
+	b := &score.Score{TestName: "TestB", Score: 2, MaxScore: 2, Weight: 1}
+	expected := []*score.Score{
+		{TestName: "TestC", MaxScore: 4, Weight: 2},
+		{TestName: "TestA", MaxScore: 5, Weight: 1},
+		{TestName: "TestD", MaxScore: 1, Weight: 1},
+	}
+	for _, results := range []*score.Results{
+		{Scores: []*score.Score{a, b}},
+		score.NewResults(a, b),
+	} {
+		missing := results.AddMissing(expected)
+		if diff := cmp.Diff([]string{"TestC", "TestD"}, missing); diff != "" {
+			t.Errorf("AddMissing() mismatch (-want +got):\n%s", diff)
+		}
+		want := []*score.Score{a, b, {TestName: "TestC", MaxScore: 4, Weight: 2}, {TestName: "TestD", MaxScore: 1, Weight: 1}}
+		if diff := cmp.Diff(want, results.Scores, cmpopts.IgnoreUnexported(score.Score{})); diff != "" {
+			t.Errorf("AddMissing() scores mismatch (-want +got):\n%s", diff)
+		}
+		// the missing tests count as failed
+		if got := results.Sum(); got != 32 {
+			t.Errorf("Sum() = %d, want 32", got)
+		}
+		if missing := results.AddMissing(expected); len(missing) != 0 {
+			t.Errorf("AddMissing() again = %v, want none", missing)
+		}
+	}
+}
+
+func TestSumWithExtraCredit(t *testing.T) {
+	base := []*score.Score{
+		{TestName: "TestA", Score: 10, MaxScore: 10, Weight: 1},
+		{TestName: "TestB", Score: 8, MaxScore: 10, Weight: 1},
+	}
+	tests := []struct {
+		name     string
+		bonus    *score.Score
+		maxGrade uint32
+		want     uint32
+	}{
+		{name: "no extra credit points", bonus: &score.Score{TestName: "TestBonus", Score: 0, MaxScore: 10, Weight: 1}, maxGrade: 110, want: 90},
+		{name: "below cap", bonus: &score.Score{TestName: "TestBonus", Score: 3, MaxScore: 10, Weight: 1}, maxGrade: 110, want: 105},
+		{name: "above 100 up to cap", bonus: &score.Score{TestName: "TestBonus", Score: 10, MaxScore: 10, Weight: 1}, maxGrade: 110, want: 110},
+		{name: "clamped to 100", bonus: &score.Score{TestName: "TestBonus", Score: 10, MaxScore: 10, Weight: 1}, maxGrade: 100, want: 100},
+		{name: "weighted bonus clamped to cap", bonus: &score.Score{TestName: "TestBonus", Score: 10, MaxScore: 10, Weight: 2}, maxGrade: 120, want: 120},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			results := score.NewResults(append(base, test.bonus)...)
+			if got := results.SumWithExtraCredit([]string{"TestBonus"}, test.maxGrade); got != test.want {
+				t.Errorf("SumWithExtraCredit() = %d, want %d", got, test.want)
+			}
+		})
+	}
+	results := score.NewResults(base...)
+	if got, want := results.SumWithExtraCredit(nil, 100), results.Sum(); got != want {
+		t.Errorf("SumWithExtraCredit(nil, 100) = %d, want Sum() = %d", got, want)
+	}
+}
+
+func TestResultsWithoutPoints(t *testing.T) {
+	results := score.NewResults(
+		&score.Score{Secret: "secret", TestName: "TestPass", Score: 7, MaxScore: 7, Weight: 2},
+		&score.Score{Secret: "secret", TestName: "TestFail", Score: 3, MaxScore: 7, Weight: 1},
+	)
+	results.BuildInfo = &score.BuildInfo{BuildLog: "log"}
+	got := results.WithoutPoints()
+	want := []*score.Score{
+		{TestName: "TestPass", Score: 1, MaxScore: 1, Weight: 2},
+		{TestName: "TestFail", Score: 0, MaxScore: 1, Weight: 1},
+	}
+	if diff := cmp.Diff(want, got.Scores, cmpopts.IgnoreUnexported(score.Score{})); diff != "" {
+		t.Errorf("WithoutPoints() mismatch (-want +got):\n%s", diff)
+	}
+	if got.BuildInfo != results.BuildInfo {
+		t.Errorf("WithoutPoints() did not carry BuildInfo: %v", got.BuildInfo)
+	}
+	// the serialized results must not reveal the points
+	b, err := json.Marshal(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, points := range []string{`"Score":7`, `"Score":3`, `"MaxScore":7`, "secret"} {
+		if strings.Contains(string(b), points) {
+			t.Errorf("json.Marshal(WithoutPoints()) = %s, contains %s", b, points)
+		}
+	}
+	// the original results are not modified
+	if results.Scores[0].GetScore() != 7 || results.Scores[0].GetMaxScore() != 7 {
+		t.Errorf("WithoutPoints() modified the original score: %v", results.Scores[0])
+	}
+}
+
+func TestResultsWithoutTests(t *testing.T) {
+	results := score.NewResults(
+		&score.Score{TestName: "TestPass", Score: 7, MaxScore: 7, Weight: 2},
+		&score.Score{TestName: "TestHidden", Score: 3, MaxScore: 7, Weight: 1},
+		&score.Score{TestName: "TestFail", Score: 0, MaxScore: 7, Weight: 1},
+	)
+	results.BuildInfo = &score.BuildInfo{BuildLog: "log"}
+	got := results.WithoutTests([]string{"TestHidden"})
+	want := []*score.Score{
+		{TestName: "TestPass", Score: 7, MaxScore: 7, Weight: 2},
+		{TestName: "TestFail", Score: 0, MaxScore: 7, Weight: 1},
+	}
+	if diff := cmp.Diff(want, got.Scores, cmpopts.IgnoreUnexported(score.Score{})); diff != "" {
+		t.Errorf("WithoutTests() mismatch (-want +got):\n%s", diff)
+	}
+	if got.BuildInfo != results.BuildInfo {
+		t.Errorf("WithoutTests() did not carry BuildInfo: %v", got.BuildInfo)
+	}
+	if len(results.Scores) != 3 {
+		t.Errorf("WithoutTests() modified the original results: %v", results.Scores)
+	}
+}
+
+func TestRemoveInvalidScoresMulti(t *testing.T) {
+	const (
+		session1 = "session one secret"
+		session2 = "session two secret"
+		expired  = "expired secret"
+	)
+	results := score.NewResults(
+		&score.Score{Secret: session1, TestName: "TestA", Score: 5, MaxScore: 5, Weight: 1},
+		&score.Score{Secret: expired, TestName: "TestB", Score: 5, MaxScore: 5, Weight: 1},
+		&score.Score{Secret: session2, TestName: "TestC", Score: 2, MaxScore: 4, Weight: 1},
+		&score.Score{Secret: "", TestName: "TestD", Score: 4, MaxScore: 4, Weight: 1},
+	)
+	results.RemoveInvalidScoresMulti(map[string]bool{session1: true, session2: true})
+
+	want := []*score.Score{
+		{TestName: "TestA", Score: 5, MaxScore: 5, Weight: 1},
+		{TestName: "TestC", Score: 2, MaxScore: 4, Weight: 1},
+	}
+	if diff := cmp.Diff(want, results.Scores, cmpopts.IgnoreUnexported(score.Score{})); diff != "" {
+		t.Errorf("RemoveInvalidScoresMulti() mismatch (-want +got):\n%s", diff)
+	}
+	if len(results.Errors) != 2 {
+		t.Fatalf("RemoveInvalidScoresMulti() recorded %d errors, want 2: %v", len(results.Errors), results.Errors)
+	}
+	for _, err := range results.Errors {
+		if !errors.Is(err, score.ErrSecret) {
+			t.Errorf("RemoveInvalidScoresMulti() error = %v, want %v", err, score.ErrSecret)
+		}
+	}
+	if got := results.Sum(); got != 75 {
+		t.Errorf("Sum() = %d, want 75", got)
+	}
+
+	// no valid secrets; all scores are removed
+	results = score.NewResults(&score.Score{Secret: session1, TestName: "TestA", Score: 5, MaxScore: 5, Weight: 1})
+	results.RemoveInvalidScoresMulti(nil)
+	if len(results.Scores) != 0 {
+		t.Errorf("RemoveInvalidScoresMulti(nil) kept %d scores, want 0", len(results.Scores))
+	}
+}
+
+func TestUnchangedFrom(t *testing.T) {
+	prev := &score.Results{
+		BuildInfo: &score.BuildInfo{BuildDate: "2021-09-01T12:00:00", BuildLog: "ok lab1", ExecTime: 1500},
+		Scores: []*score.Score{
+			{ID: 1, SubmissionID: 1, TestName: "TestA", Score: 5, MaxScore: 5, Weight: 1},
+			{ID: 2, SubmissionID: 1, TestName: "TestB", Score: 2, MaxScore: 5, Weight: 2, TestDetails: "wrong answer"},
+		},
+	}
+	rerun := func(scores ...*score.Score) *score.Results {
+		return &score.Results{
+			BuildInfo: &score.BuildInfo{BuildDate: "2021-09-02T08:00:00", BuildLog: "ok lab1", ExecTime: 1700},
+			Scores:    scores,
+		}
+	}
+	a := &score.Score{Secret: "new secret", TestName: "TestA", Score: 5, MaxScore: 5, Weight: 1}
+	b := &score.Score{Secret: "new secret", TestName: "TestB", Score: 2, MaxScore: 5, Weight: 2, TestDetails: "wrong answer"}
+
+	tests := []struct {
+		name    string
+		results *score.Results
+		prev    *score.Results
+		want    bool
+	}{
+		{name: "identical", results: rerun(a, b), prev: prev, want: true},
+		{name: "reordered identical", results: rerun(b, a), prev: prev, want: true},
+		{name: "changed score", results: rerun(a, &score.Score{TestName: "TestB", Score: 3, MaxScore: 5, Weight: 2, TestDetails: "wrong answer"}), prev: prev, want: false},
+		{name: "changed weight", results: rerun(a, &score.Score{TestName: "TestB", Score: 2, MaxScore: 5, Weight: 1, TestDetails: "wrong answer"}), prev: prev, want: false},
+		{name: "changed test details", results: rerun(a, &score.Score{TestName: "TestB", Score: 2, MaxScore: 5, Weight: 2}), prev: prev, want: false},
+		{name: "missing test", results: rerun(a), prev: prev, want: false},
+		{name: "duplicate test", results: rerun(a, a), prev: rerun(a, b), want: false},
+		{name: "changed build log", results: &score.Results{BuildInfo: &score.BuildInfo{BuildLog: "FAIL lab1"}, Scores: []*score.Score{a, b}}, prev: prev, want: false},
+		{name: "no previous results", results: rerun(a, b), prev: nil, want: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.results.UnchangedFrom(test.prev); got != test.want {
+				t.Errorf("UnchangedFrom() = %t, want %t", got, test.want)
+			}
+		})
+	}
+}
+
+func TestContributions(t *testing.T) {
+	results := score.NewResults(
+		&score.Score{TestName: "TestA", Score: 5, MaxScore: 5, Weight: 1},
+		&score.Score{TestName: "TestB", Score: 0, MaxScore: 10, Weight: 2},
+		&score.Score{TestName: "TestC", Score: 3, MaxScore: 4, Weight: 5},
+	)
+	want := map[string]float64{"TestA": 12.5, "TestB": 25, "TestC": 62.5}
+	got := results.Contributions()
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Contributions() mismatch (-want +got):\n%s", diff)
+	}
+
+	results = score.NewResults(
+		&score.Score{TestName: "TestA", Score: 1, MaxScore: 1, Weight: 1},
+		&score.Score{TestName: "TestB", Score: 1, MaxScore: 1, Weight: 1},
+		&score.Score{TestName: "TestC", Score: 1, MaxScore: 1, Weight: 1},
+	)
+	sum := float64(0)
+	for _, contribution := range results.Contributions() {
+		sum += contribution
+	}
+	if math.Abs(sum-100) > 1e-9 {
+		t.Errorf("Contributions() sum to %f, want 100", sum)
+	}
+
+	for name, results := range map[string]*score.Results{
+		"zero weight": score.NewResults(&score.Score{TestName: "TestA", Score: 1, MaxScore: 1, Weight: 0}),
+		"no scores":   score.NewResults(),
+	} {
+		if got := results.Contributions(); got == nil || len(got) != 0 {
+			t.Errorf("%s: Contributions() = %v, want empty map", name, got)
+		}
+	}
+}
+
+func TestChangedSince(t *testing.T) {
+	prev := score.NewResults(
+		&score.Score{TestName: "TestFixed", Score: 0, MaxScore: 5, Weight: 1},
+		&score.Score{TestName: "TestBroken", Score: 5, MaxScore: 5, Weight: 1},
+		&score.Score{TestName: "TestImproved", Score: 1, MaxScore: 5, Weight: 1},
+		&score.Score{TestName: "TestUnchanged", Score: 3, MaxScore: 5, Weight: 1},
+		&score.Score{TestName: "TestRemoved", Score: 5, MaxScore: 5, Weight: 1},
+	)
+	fixed := &score.Score{TestName: "TestFixed", Score: 5, MaxScore: 5, Weight: 1}
+	broken := &score.Score{TestName: "TestBroken", Score: 2, MaxScore: 5, Weight: 1}
+	improved := &score.Score{TestName: "TestImproved", Score: 4, MaxScore: 5, Weight: 1}
+	unchanged := &score.Score{TestName: "TestUnchanged", Score: 3, MaxScore: 5, Weight: 1}
+	added := &score.Score{TestName: "TestNew", Score: 0, MaxScore: 5, Weight: 1}
+
+	tests := []struct {
+		name   string
+		scores []*score.Score
+		prev   *score.Results
+		want   []*score.Score
+	}{
+		{name: "improvements", scores: []*score.Score{fixed, improved, unchanged}, prev: prev, want: []*score.Score{fixed, improved}},
+		{name: "regressions", scores: []*score.Score{unchanged, broken}, prev: prev, want: []*score.Score{broken}},
+		{name: "new tests", scores: []*score.Score{added, unchanged}, prev: prev, want: []*score.Score{added}},
+		{name: "no changes", scores: []*score.Score{unchanged}, prev: prev, want: []*score.Score{}},
+		{name: "no previous results", scores: []*score.Score{fixed, broken}, prev: nil, want: []*score.Score{fixed, broken}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			results := score.NewResults(test.scores...)
+			results.BuildInfo = &score.BuildInfo{BuildLog: "log", ExecTime: 10}
+			changed := results.ChangedSince(test.prev)
+			if diff := cmp.Diff(test.want, changed.Scores, cmpopts.IgnoreUnexported(score.Score{})); diff != "" {
+				t.Errorf("ChangedSince() mismatch (-want +got):\n%s", diff)
+			}
+			if changed.BuildInfo != results.BuildInfo {
+				t.Errorf("ChangedSince() BuildInfo = %v, want %v", changed.BuildInfo, results.BuildInfo)
+			}
+		})
+	}
+}
+
+func TestSecretLeaked(t *testing.T) {
+	const secret = "my secret code"
+	tests := []struct {
+		name    string
+		results *score.Results
+		want    bool
+	}{
+		{name: "clean", results: &score.Results{
+			BuildInfo: &score.BuildInfo{BuildLog: "ok\tlab1\t0.012s"},
+			Scores:    []*score.Score{{TestName: "TestFib", Score: 5, MaxScore: 5, Weight: 1, TestDetails: "all good"}},
+		}, want: false},
+		{name: "build log", results: &score.Results{
+			BuildInfo: &score.BuildInfo{BuildLog: "printing " + secret + " from main"},
+			Scores:    []*score.Score{{TestName: "TestFib", Score: 5, MaxScore: 5, Weight: 1}},
+		}, want: true},
+		{name: "test details", results: &score.Results{
+			BuildInfo: &score.BuildInfo{BuildLog: "ok"},
+			Scores:    []*score.Score{{TestName: "TestFib", Score: 5, MaxScore: 5, Weight: 1, TestDetails: "got " + secret}},
+		}, want: true},
+		{name: "no build info", results: &score.Results{}, want: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.results.SecretLeaked(secret); got != test.want {
+				t.Errorf("SecretLeaked() = %t, want %t", got, test.want)
+			}
+		})
+	}
+	leaky := &score.Results{BuildInfo: &score.BuildInfo{BuildLog: "some output"}}
+	if leaky.SecretLeaked("") {
+		t.Error("SecretLeaked(\"\") = true, want false")
+	}
+}
+
+func TestAttachContributions(t *testing.T) {
+	results := score.NewResults(&score.Score{TestName: "TestFib", Score: 5, MaxScore: 5, Weight: 1})
+	if results.MemberContributions != nil {
+		t.Fatalf("MemberContributions = %v, want nil", results.MemberContributions)
+	}
+	if err := results.AttachContributions(map[string]float64{"alice": 0.6, "bob": 0.4, "carol": 0}); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]float64{"alice": 0.6, "bob": 0.4, "carol": 0}
+	if diff := cmp.Diff(want, results.MemberContributions); diff != "" {
+		t.Errorf("AttachContributions() mismatch (-want +got):\n%s", diff)
+	}
+
+	tests := []struct {
+		name      string
+		fractions map[string]float64
+	}{
+		{name: "negative", fractions: map[string]float64{"alice": 0.5, "bob": -0.2}},
+		{name: "not a number", fractions: map[string]float64{"alice": 0.5, "carol": math.NaN()}},
+		{name: "above one", fractions: map[string]float64{"alice": 1.2}},
+		{name: "sum above one", fractions: map[string]float64{"alice": 0.7, "bob": 0.4}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			results := score.NewResults(&score.Score{TestName: "TestFib", Score: 5, MaxScore: 5, Weight: 1})
+			if err := results.AttachContributions(test.fractions); err == nil {
+				t.Errorf("AttachContributions(%v) succeeded, want error", test.fractions)
+			}
+			if results.MemberContributions != nil || len(results.Errors) != 0 {
+				t.Errorf("AttachContributions(%v) attached %v with errors %v, want nothing", test.fractions, results.MemberContributions, results.Errors)
+			}
+		})
+	}
+}
+
+func TestBeatsBest(t *testing.T) {
+	results := score.NewResults(
+		&score.Score{TestName: "TestA", Score: 5, MaxScore: 5, Weight: 1},
+		&score.Score{TestName: "TestB", Score: 2, MaxScore: 5, Weight: 1},
+	)
+	tests := []struct {
+		name      string
+		bestGrade uint32
+		want      bool
+	}{
+		{name: "higher", bestGrade: 60, want: true},
+		{name: "no previous grade", bestGrade: 0, want: true},
+		{name: "equal", bestGrade: 70, want: false},
+		{name: "lower", bestGrade: 90, want: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			beats, grade := results.BeatsBest(test.bestGrade)
+			if beats != test.want || grade != 70 {
+				t.Errorf("BeatsBest(%d) = (%t, %d), want (%t, 70)", test.bestGrade, beats, grade, test.want)
+			}
+		})
+	}
+}
+
+func TestValidateDuplicateScores(t *testing.T) {
+	results := &score.Results{Scores: []*score.Score{
+		{TestName: "TestA", Score: 1, MaxScore: 1, Weight: 1},
+		{TestName: "TestA", Score: 1, MaxScore: 1, Weight: 1},
+	}}
+	if err := results.Validate(""); !errors.Is(err, score.ErrReportedTwice) {
+		t.Errorf("Validate() = %v, want %v", err, score.ErrReportedTwice)
+	}
+}
+
+func TestSetDeltas(t *testing.T) {
+	prev := score.NewResults(
+		&score.Score{TestName: "TestA", Score: 5, MaxScore: 10, Weight: 1},
+		&score.Score{TestName: "TestB", Score: 10, MaxScore: 10, Weight: 1},
+	)
+	results := score.NewResults(
+		&score.Score{TestName: "TestA", Score: 8, MaxScore: 10, Weight: 1},
+		&score.Score{TestName: "TestB", Score: 4, MaxScore: 10, Weight: 1},
+		&score.Score{TestName: "TestC", Score: 3, MaxScore: 10, Weight: 1},
+	)
+	results.SetDeltas(prev)
+	want := map[string]int32{"TestA": 3, "TestB": -6, "TestC": 0}
+	for _, sc := range results.Scores {
+		if sc.GetDelta() != want[sc.GetTestName()] {
+			t.Errorf("SetDeltas() %s delta = %d, want %d", sc.GetTestName(), sc.GetDelta(), want[sc.GetTestName()])
+		}
+	}
+	results.SetDeltas(nil)
+	for _, sc := range results.Scores {
+		if sc.GetDelta() != 0 {
+			t.Errorf("SetDeltas(nil) %s delta = %d, want 0", sc.GetTestName(), sc.GetDelta())
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"runtime/debug"
 	"strings"
 	"testing"
+	"time"
 )
 
 // Fail sets Score to zero.
@@ -48,6 +49,79 @@ func (s *Score) DecBy(n int) {
 	}
 }
 
+// IsPassing returns true if the score equals the max score.
+func (s *Score) IsPassing() bool {
+	return s.GetMaxScore() > 0 && s.GetScore() >= s.GetMaxScore()
+}
+
+// IsPassingAt returns true if the score is at least the given threshold
+// percentage of the max score, e.g., 70 for tests that pass at partial credit.
+// A threshold of zero, or above 100, requires the max score, as for IsPassing.
+func (s *Score) IsPassingAt(threshold uint32) bool {
+	if threshold == 0 || threshold > 100 {
+		return s.IsPassing()
+	}
+	return s.GetMaxScore() > 0 && int64(s.GetScore())*100 >= int64(threshold)*int64(s.GetMaxScore())
+}
+
+// WithoutPoints returns a copy of the score object that only reveals whether
+// the test passed: the score is 1 if the test passed and 0 otherwise, out of
+// a max score of 1. The secret is not copied.
+func (s *Score) WithoutPoints() *Score {
+	return s.WithoutPointsAt(0)
+}
+
+// WithoutPointsAt is like WithoutPoints, except that the test is considered
+// passed if the score is at least the given threshold percentage of the max score.
+func (s *Score) WithoutPointsAt(threshold uint32) *Score {
+	passed := int32(0)
+	if s.IsPassingAt(threshold) {
+		passed = 1
+	}
+	return &Score{
+		ID:           s.GetID(),
+		SubmissionID: s.GetSubmissionID(),
+		TestName:     s.GetTestName(),
+		Score:        passed,
+		MaxScore:     1,
+		Weight:       s.GetWeight(),
+		TestDetails:  s.GetTestDetails(),
+		ExecTimeMS:   s.GetExecTimeMS(),
+	}
+}
+
+// compare returns a positive number if s has a higher relative score than other,
+// a negative number if s has a lower relative score than other, and zero if
+// the relative scores are equal. A score with a non-positive max score is
+// considered lower than any other score.
+func (s *Score) compare(other *Score) int {
+	if s.GetMaxScore() <= 0 || other.GetMaxScore() <= 0 {
+		return boolToInt(s.GetMaxScore() > 0) - boolToInt(other.GetMaxScore() > 0)
+	}
+	// cross-multiply to compare Score/MaxScore ratios without rounding errors
+	lhs := int64(s.GetScore()) * int64(other.GetMaxScore())
+	rhs := int64(other.GetScore()) * int64(s.GetMaxScore())
+	switch {
+	case lhs > rhs:
+		return 1
+	case lhs < rhs:
+		return -1
+	}
+	return 0
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// ExecTime returns the execution time of the test, or zero if unknown.
+func (s *Score) ExecTime() time.Duration {
+	return time.Duration(s.GetExecTimeMS()) * time.Millisecond
+}
+
 // Normalize the score to the given maxScore.
 func (s *Score) Normalize(maxScore int) {
 	f := float64(maxScore) / float64(s.MaxScore)
@@ -72,6 +146,8 @@ func (s *Score) RelativeScore() string {
 }
 
 // Print prints a JSON representation of the score that can be picked up by QuickFeed.
+// The score's execution time is recorded as the time elapsed since the score object
+// was first obtained from the registry, e.g., with Max() or Min().
 // To ensure that panic message and stack trace is printed, this method must be called via defer.
 // If a test panics, the score will be set to zero, and a panic message will be emitted.
 // Note that, if subtests are used, each subtest must defer call the PanicHandler method
@@ -84,9 +160,29 @@ func (s *Score) Print(t *testing.T, msg ...string) {
 	}
 	// We rely on JSON score objects to start on a new line, since otherwise
 	// scanning long student generated output lines can be costly.
+	if start, ok := startTime(s); ok {
+		s.ExecTimeMS = time.Since(start).Milliseconds()
+	}
 	fmt.Println()
-	// print JSON score object: {"Secret":"my secret code","TestName": ...}
+	// print signed JSON score object: {"TestName":"TestFib","Score": ...,"Signature":"..."}
 	fmt.Println(s.json())
+	recordReport(s)
+}
+
+// PanicGuard prints the given score object, as Print does, ensuring that the score
+// is emitted even if the student's code panics, rather than silently disappearing
+// from the results. If the test panicked, the score is reset to zero, the test is
+// failed, and the panic is recorded in the score's TestDetails. To catch panics,
+// this must be called via defer, in place of the call to Print:
+//   sc := score.Max()
+//   defer score.PanicGuard(t, sc)
+func PanicGuard(t *testing.T, sc *Score) {
+	if r := recover(); r != nil {
+		sc.fail(t)
+		sc.TestDetails = fmt.Sprintf("test panicked: %v", r)
+		printPanicMessage(sc.TestName, "", r)
+	}
+	sc.Print(t)
 }
 
 // PanicHandler recovers from a panicking test, resets the score to zero and
@@ -114,9 +210,19 @@ func (s *Score) fail(t *testing.T) {
 	t.Fail()
 }
 
-// json returns a JSON string for the score object.
+// json returns a JSON string for the score object, signed with the session secret.
+// The secret itself is omitted, such that it is not revealed in the test output.
 func (s *Score) json() string {
-	b, err := json.Marshal(s)
+	s.Sign(sessionSecret)
+	b, err := json.Marshal(&Score{
+		TestName:    s.GetTestName(),
+		Score:       s.GetScore(),
+		MaxScore:    s.GetMaxScore(),
+		Weight:      s.GetWeight(),
+		TestDetails: s.GetTestDetails(),
+		ExecTimeMS:  s.GetExecTimeMS(),
+		Signature:   s.GetSignature(),
+	})
 	if err != nil {
 		return fmt.Sprintf("json.Marshal error: %v\n", err)
 	}
@@ -29,12 +29,15 @@ type Score struct {
 
 	ID           uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
 	SubmissionID uint64 `protobuf:"varint,2,opt,name=SubmissionID,proto3" json:"SubmissionID,omitempty" gorm:"foreignKey:ID"`
-	Secret       string `protobuf:"bytes,3,opt,name=Secret,proto3" json:"Secret,omitempty" gorm:"-"`  // the unique identifier for a scoring session
-	TestName     string `protobuf:"bytes,4,opt,name=TestName,proto3" json:"TestName,omitempty"`       // name of the test
-	Score        int32  `protobuf:"varint,5,opt,name=Score,proto3" json:"Score,omitempty"`            // the score obtained
-	MaxScore     int32  `protobuf:"varint,6,opt,name=MaxScore,proto3" json:"MaxScore,omitempty"`      // max score possible to get on this specific test
-	Weight       int32  `protobuf:"varint,7,opt,name=Weight,proto3" json:"Weight,omitempty"`          // the weight of this test; used to compute final grade
-	TestDetails  string `protobuf:"bytes,8,opt,name=TestDetails,proto3" json:"TestDetails,omitempty"` // if populated, the frontend may display additional details (TODO(meling) adapt to output from go test -json)
+	Secret       string `protobuf:"bytes,3,opt,name=Secret,proto3" json:"Secret,omitempty" gorm:"-"`        // the unique identifier for a scoring session
+	TestName     string `protobuf:"bytes,4,opt,name=TestName,proto3" json:"TestName,omitempty"`             // name of the test
+	Score        int32  `protobuf:"varint,5,opt,name=Score,proto3" json:"Score,omitempty"`                  // the score obtained
+	MaxScore     int32  `protobuf:"varint,6,opt,name=MaxScore,proto3" json:"MaxScore,omitempty"`            // max score possible to get on this specific test
+	Weight       int32  `protobuf:"varint,7,opt,name=Weight,proto3" json:"Weight,omitempty"`                // the weight of this test; used to compute final grade
+	TestDetails  string `protobuf:"bytes,8,opt,name=TestDetails,proto3" json:"TestDetails,omitempty"`       // if populated, the frontend may display additional details; see TestFailure
+	ExecTimeMS   int64  `protobuf:"varint,9,opt,name=ExecTimeMS,proto3" json:"ExecTimeMS,omitempty"`        // execution time of this test in milliseconds; zero if unknown
+	Signature    string `protobuf:"bytes,10,opt,name=Signature,proto3" json:"Signature,omitempty" gorm:"-"` // HMAC of the score, keyed by the session secret
+	Delta        int32  `protobuf:"varint,11,opt,name=Delta,proto3" json:"Delta,omitempty"`                 // change in score since the previous submission; zero if unknown
 }
 
 func (x *Score) Reset() {
@@ -125,6 +128,27 @@ func (x *Score) GetTestDetails() string {
 	return ""
 }
 
+func (x *Score) GetExecTimeMS() int64 {
+	if x != nil {
+		return x.ExecTimeMS
+	}
+	return 0
+}
+
+func (x *Score) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *Score) GetDelta() int32 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
 // BuildInfo holds build data for an assignment's test execution.
 type BuildInfo struct {
 	state         protoimpl.MessageState
@@ -136,6 +160,9 @@ type BuildInfo struct {
 	BuildDate    string `protobuf:"bytes,3,opt,name=BuildDate,proto3" json:"BuildDate,omitempty"`
 	BuildLog     string `protobuf:"bytes,4,opt,name=BuildLog,proto3" json:"BuildLog,omitempty"`
 	ExecTime     int64  `protobuf:"varint,5,opt,name=ExecTime,proto3" json:"ExecTime,omitempty"`
+	Seed         uint64 `protobuf:"varint,6,opt,name=Seed,proto3" json:"Seed,omitempty"`              // seed for randomizing the test inputs of the run; zero if not randomized
+	TimedOut     bool   `protobuf:"varint,7,opt,name=TimedOut,proto3" json:"TimedOut,omitempty"`      // the tests were stopped at the container timeout; the scores are those reported before the timeout
+	TriggeredBy  string `protobuf:"bytes,8,opt,name=TriggeredBy,proto3" json:"TriggeredBy,omitempty"` // login of the user who re-ran the tests; empty if the tests were run for a push
 }
 
 func (x *BuildInfo) Reset() {
@@ -205,12 +232,33 @@ func (x *BuildInfo) GetExecTime() int64 {
 	return 0
 }
 
+func (x *BuildInfo) GetSeed() uint64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *BuildInfo) GetTimedOut() bool {
+	if x != nil {
+		return x.TimedOut
+	}
+	return false
+}
+
+func (x *BuildInfo) GetTriggeredBy() string {
+	if x != nil {
+		return x.TriggeredBy
+	}
+	return ""
+}
+
 var File_kit_score_score_proto protoreflect.FileDescriptor
 
 var file_kit_score_score_proto_rawDesc = []byte{
 	0x0a, 0x15, 0x6b, 0x69, 0x74, 0x2f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x73, 0x63, 0x6f, 0x72,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x1a, 0x0e,
-	0x70, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x89,
+	0x70, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xee,
 	0x02, 0x0a, 0x05, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x3f, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x6d,
 	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1b,
@@ -227,22 +275,33 @@ var file_kit_score_score_proto_rawDesc = []byte{
 	0x12, 0x16, 0x0a, 0x06, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
 	0x52, 0x06, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x54, 0x65, 0x73, 0x74,
 	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x54,
-	0x65, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0xb2, 0x01, 0x0a, 0x09, 0x42,
-	0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x3f, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1b,
-	0xca, 0xb5, 0x03, 0x17, 0xa2, 0x01, 0x14, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x66, 0x6f, 0x72,
-	0x65, 0x69, 0x67, 0x6e, 0x4b, 0x65, 0x79, 0x3a, 0x49, 0x44, 0x22, 0x52, 0x0c, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x42, 0x75, 0x69,
-	0x6c, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x42, 0x75,
-	0x69, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x42, 0x75, 0x69, 0x6c, 0x64,
-	0x4c, 0x6f, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x42, 0x75, 0x69, 0x6c, 0x64,
-	0x4c, 0x6f, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x45, 0x78, 0x65, 0x63, 0x54, 0x69, 0x6d, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x45, 0x78, 0x65, 0x63, 0x54, 0x69, 0x6d, 0x65, 0x42,
-	0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x75,
-	0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x2f, 0x71, 0x75, 0x69, 0x63, 0x6b, 0x66, 0x65, 0x65,
-	0x64, 0x2f, 0x6b, 0x69, 0x74, 0x2f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x65, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x45, 0x78,
+	0x65, 0x63, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x53, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x45, 0x78, 0x65, 0x63, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x53, 0x12, 0x2d, 0x0a, 0x09, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xca,
+	0xb5, 0x03, 0x0b, 0xa2, 0x01, 0x08, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x2d, 0x22, 0x52, 0x09,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x44, 0x65, 0x6c,
+	0x74, 0x61, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x22,
+	0x84, 0x02, 0x0a, 0x09, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a,
+	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x3f, 0x0a,
+	0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x1b, 0xca, 0xb5, 0x03, 0x17, 0xa2, 0x01, 0x14, 0x67, 0x6f, 0x72, 0x6d,
+	0x3a, 0x22, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4b, 0x65, 0x79, 0x3a, 0x49, 0x44, 0x22,
+	0x52, 0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1c,
+	0x0a, 0x09, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x6f, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x6f, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x45, 0x78, 0x65, 0x63,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x45, 0x78, 0x65, 0x63,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x65, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x04, 0x53, 0x65, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x69, 0x6d, 0x65,
+	0x64, 0x4f, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x54, 0x69, 0x6d, 0x65,
+	0x64, 0x4f, 0x75, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x65,
+	0x64, 0x42, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x54, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x65, 0x64, 0x42, 0x79, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x2f, 0x71,
+	0x75, 0x69, 0x63, 0x6b, 0x66, 0x65, 0x65, 0x64, 0x2f, 0x6b, 0x69, 0x74, 0x2f, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
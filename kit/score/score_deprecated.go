@@ -25,7 +25,7 @@ func NewScore(t *testing.T, max, weight int) *Score {
 		Weight:   int32(weight),
 	}
 	// prints JSON score object with zero score, e.g.:
-	// {"Secret":"my secret code","TestName":"TestPanicHandler","Score":0,"MaxScore":8,"Weight":5}
+	// {"TestName":"TestPanicHandler","Score":0,"MaxScore":8,"Weight":5,"Signature":"..."}
 	// This registers the test, in case a panic occurs that prevents printing the score object.
 	fmt.Println(sc.json())
 	return sc
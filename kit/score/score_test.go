@@ -32,3 +32,32 @@ func TestNormalize(t *testing.T) {
 		t.Errorf("Normalize(%d) = %d, expected %d", newMaxScore, sc.Score, expectedScore)
 	}
 }
+
+func TestIsPassingAt(t *testing.T) {
+	tests := []struct {
+		score     int32
+		threshold uint32
+		want      bool
+	}{
+		{score: 10, threshold: 0, want: true},
+		{score: 9, threshold: 0, want: false},
+		{score: 7, threshold: 70, want: true},
+		{score: 8, threshold: 70, want: true},
+		{score: 6, threshold: 70, want: false},
+		{score: 0, threshold: 0, want: false},
+		{score: 9, threshold: 120, want: false},
+	}
+	for _, test := range tests {
+		sc := &score.Score{TestName: t.Name(), Score: test.score, MaxScore: 10, Weight: 1}
+		if got := sc.IsPassingAt(test.threshold); got != test.want {
+			t.Errorf("IsPassingAt(%d) for score %d/10 = %t, want %t", test.threshold, test.score, got, test.want)
+		}
+		passed := int32(0)
+		if test.want {
+			passed = 1
+		}
+		if got := sc.WithoutPointsAt(test.threshold).GetScore(); got != passed {
+			t.Errorf("WithoutPointsAt(%d) for score %d/10 = %d, want %d", test.threshold, test.score, got, passed)
+		}
+	}
+}
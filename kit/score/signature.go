@@ -0,0 +1,43 @@
+package score
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+// Sign sets the score's signature to the HMAC-SHA256 of its test name, score,
+// max score, and weight, keyed by the given session secret. A signed score object
+// can be verified by the CI runner without the secret appearing in the test output,
+// preventing students from forging score objects.
+func (s *Score) Sign(secret string) {
+	s.Signature = s.signature(secret)
+}
+
+// signature returns the hex-encoded HMAC of the score, keyed by the given secret.
+func (s *Score) signature(secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%s|%d|%d|%d", s.GetTestName(), s.GetScore(), s.GetMaxScore(), s.GetWeight())
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// validFor returns true if the score object belongs to the session with the given secret.
+// A signed score object must carry a valid signature for the secret; score objects
+// from test harnesses that predate signing must instead carry the secret itself.
+func (s *Score) validFor(secret string) bool {
+	if s.GetSignature() != "" {
+		return hmac.Equal([]byte(s.GetSignature()), []byte(s.signature(secret)))
+	}
+	return s.GetSecret() == secret
+}
+
+// validForAny returns true if the score object belongs to the session of one of the given valid secrets.
+func (s *Score) validForAny(valid map[string]bool) bool {
+	for secret, ok := range valid {
+		if ok && s.validFor(secret) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+package score_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/autograde/quickfeed/kit/score"
+)
+
+func signedLine(t *testing.T, secret string, sc *score.Score) string {
+	t.Helper()
+	sc.Sign(secret)
+	b, err := json.Marshal(sc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+func TestParseSignedScore(t *testing.T) {
+	const secret = "session nonce"
+	valid := signedLine(t, secret, &score.Score{TestName: "TestFib", Score: 5, MaxScore: 10, Weight: 1})
+	if strings.Contains(valid, secret) {
+		t.Fatalf("signed score line reveals the secret: %s", valid)
+	}
+	sc, err := score.Parse(valid, secret)
+	if err != nil {
+		t.Fatalf("Parse(%s) = %v, want nil", valid, err)
+	}
+	if want := (&score.Score{TestName: "TestFib", Score: 5, MaxScore: 10, Weight: 1}); !want.Equal(sc) {
+		t.Errorf("Parse(%s) = %v, want %v", valid, sc, want)
+	}
+
+	tests := []struct {
+		name string
+		line string
+	}{
+		{name: "tampered score", line: strings.Replace(valid, `"Score":5`, `"Score":10`, 1)},
+		{name: "wrong secret", line: signedLine(t, "guessed", &score.Score{TestName: "TestFib", Score: 10, MaxScore: 10, Weight: 1})},
+		{name: "forged signature", line: `{"TestName":"TestFib","Score":10,"MaxScore":10,"Weight":1,"Signature":"00"}`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sc, err := score.Parse(test.line, secret)
+			if err == nil || !strings.Contains(err.Error(), score.ErrSignature.Error()) {
+				t.Errorf("Parse(%s) = %v, want error containing %q", test.line, err, score.ErrSignature)
+			}
+			if sc != nil {
+				t.Errorf("Parse(%s) returned score object %v, want nil", test.line, sc)
+			}
+		})
+	}
+}
+
+func TestRemoveInvalidScoresMultiSigned(t *testing.T) {
+	const session1, session2 = "session 1", "session 2"
+	a := &score.Score{TestName: "TestA", Score: 5, MaxScore: 5, Weight: 1}
+	a.Sign(session1)
+	b := &score.Score{TestName: "TestB", Score: 5, MaxScore: 5, Weight: 1}
+	b.Sign("expired")
+	results := score.NewResults(a, b)
+	results.RemoveInvalidScoresMulti(map[string]bool{session1: true, session2: true})
+	if len(results.Scores) != 1 || results.Scores[0].GetTestName() != "TestA" {
+		t.Errorf("RemoveInvalidScoresMulti() kept %v, want only TestA", results.Scores)
+	}
+	if len(results.Errors) != 1 || !errors.Is(results.Errors[0], score.ErrSignature) {
+		t.Errorf("RemoveInvalidScoresMulti() errors = %v, want one %v", results.Errors, score.ErrSignature)
+	}
+}
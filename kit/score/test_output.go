@@ -0,0 +1,79 @@
+package score
+
+import "strings"
+
+// TestOutputFilter removes the output of a set of tests, e.g., hidden tests,
+// from the output of running the tests, line by line, such that the output
+// can be streamed. The output of a test in go test's verbose format is the
+// test's === and --- lines, and the lines following them until the next such
+// line or the package summary. Other lines naming one of the tests, e.g., as
+// reported by other test frameworks, are also removed.
+type TestOutputFilter struct {
+	testNames []string
+	current   string // the test whose output the following lines belong to; empty if none
+}
+
+// NewTestOutputFilter returns a filter removing the output of the given tests,
+// including their subtests.
+func NewTestOutputFilter(testNames []string) *TestOutputFilter {
+	return &TestOutputFilter{testNames: testNames}
+}
+
+// Keep returns true if the given line of output, which follows the lines
+// previously given to the filter, does not belong to one of the filtered tests.
+func (f *TestOutputFilter) Keep(line string) bool {
+	if f == nil || len(f.testNames) == 0 {
+		return true
+	}
+	trimmed := strings.TrimSpace(line)
+	fields := strings.Fields(trimmed)
+	switch {
+	case strings.HasPrefix(trimmed, "=== ") && len(fields) >= 3:
+		// === RUN, PAUSE, CONT or NAME line
+		f.current = fields[2]
+		return !f.filters(f.current)
+	case strings.HasPrefix(trimmed, "--- ") && len(fields) >= 3 && strings.HasSuffix(fields[1], ":"):
+		// --- PASS, FAIL or SKIP line, which may be followed by the test's log
+		f.current = fields[2]
+		return !f.filters(f.current)
+	case trimmed == "PASS" || trimmed == "FAIL" || strings.HasPrefix(line, "ok ") || strings.HasPrefix(line, "FAIL\t"):
+		// the package summary ends the output of the package's tests
+		f.current = ""
+		return true
+	case f.current != "" && f.filters(f.current):
+		return false
+	}
+	for _, testName := range f.testNames {
+		if strings.Contains(line, testName) {
+			return false
+		}
+	}
+	return true
+}
+
+// filters returns true if the given test, or the test it is a subtest of, is filtered.
+func (f *TestOutputFilter) filters(testName string) bool {
+	for _, name := range f.testNames {
+		if testName == name || strings.HasPrefix(testName, name+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// WithoutTestOutput returns the given output of running tests without the output
+// of the given tests, as removed by TestOutputFilter.
+func WithoutTestOutput(output string, testNames []string) string {
+	if len(testNames) == 0 {
+		return output
+	}
+	f := NewTestOutputFilter(testNames)
+	lines := strings.Split(output, "\n")
+	kept := lines[:0]
+	for _, line := range lines {
+		if f.Keep(line) {
+			kept = append(kept, line)
+		}
+	}
+	return strings.Join(kept, "\n")
+}
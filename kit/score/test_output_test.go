@@ -0,0 +1,46 @@
+package score_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/autograde/quickfeed/kit/score"
+)
+
+func TestWithoutTestOutput(t *testing.T) {
+	output := strings.Join([]string{
+		"=== RUN   TestFib",
+		"--- PASS: TestFib (0.00s)",
+		"=== RUN   TestHidden",
+		"    hidden_test.go:12: got 5, want 8",
+		"=== RUN   TestHidden/large",
+		"--- FAIL: TestHidden (0.00s)",
+		"    --- FAIL: TestHidden/large (0.00s)",
+		"        hidden_test.go:20: got 0, want 832040",
+		"=== RUN   TestSum",
+		"    sum_test.go:8: summing",
+		"--- PASS: TestSum (0.00s)",
+		"=== RUN   TestHiddenPanic",
+		"panic: runtime error: index out of range [recovered]",
+		"goroutine 7 [running]:",
+		"FAIL\tdat320/lab1\t0.009s",
+		"test_hidden (tests.TestLab) ... FAIL",
+		"FAIL",
+	}, "\n")
+	want := strings.Join([]string{
+		"=== RUN   TestFib",
+		"--- PASS: TestFib (0.00s)",
+		"=== RUN   TestSum",
+		"    sum_test.go:8: summing",
+		"--- PASS: TestSum (0.00s)",
+		"FAIL\tdat320/lab1\t0.009s",
+		"FAIL",
+	}, "\n")
+	got := score.WithoutTestOutput(output, []string{"TestHidden", "TestHiddenPanic", "test_hidden"})
+	if got != want {
+		t.Errorf("WithoutTestOutput() = %q, want %q", got, want)
+	}
+	if got := score.WithoutTestOutput(output, nil); got != output {
+		t.Errorf("WithoutTestOutput(nil) = %q, want output unchanged", got)
+	}
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
@@ -8,9 +9,13 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"runtime"
+	"time"
 
+	"github.com/autograde/quickfeed/assignments"
 	"github.com/autograde/quickfeed/ci"
 	logq "github.com/autograde/quickfeed/log"
+	"github.com/autograde/quickfeed/notify"
 	"github.com/autograde/quickfeed/web"
 	"github.com/autograde/quickfeed/web/auth"
 
@@ -57,13 +62,21 @@ var reg = prometheus.NewRegistry()
 
 func main() {
 	var (
-		baseURL  = flag.String("service.url", "", "base service DNS name")
-		dbFile   = flag.String("database.file", "qf.db", "database file")
-		public   = flag.String("http.public", "public", "path to content to serve")
-		httpAddr = flag.String("http.addr", ":8081", "HTTP listen address")
-		grpcAddr = flag.String("grpc.addr", ":9090", "gRPC listen address")
+		baseURL      = flag.String("service.url", "", "base service DNS name")
+		dbFile       = flag.String("database.file", "qf.db", "database file")
+		public       = flag.String("http.public", "public", "path to content to serve")
+		httpAddr     = flag.String("http.addr", ":8081", "HTTP listen address")
+		grpcAddr     = flag.String("grpc.addr", ":9090", "gRPC listen address")
+		remind       = flag.String("reminders", "", "times before a deadline to remind students by email, e.g., 48h,2h; empty to disable")
+		ciRunner     = flag.String("ci.runner", "docker", "runner of the tests: docker, or local to run the tests on this machine without docker")
+		ciUnconfined = flag.Bool("ci.unconfined", false, "allow the local runner on systems other than linux, whose tests can access the files of the user running quickfeed")
+		testsDepth   = flag.Int("tests.depth", assignments.MaxWalkDepth, "maximum directory depth of a course's tests repository")
 	)
 	flag.Parse()
+	if *testsDepth < 1 {
+		log.Fatalf("invalid tests.depth %d; must be at least 1\n", *testsDepth)
+	}
+	assignments.MaxWalkDepth = *testsDepth
 
 	logger := logq.Zap(true)
 	defer logger.Sync()
@@ -80,11 +93,33 @@ func main() {
 		Secret:  os.Getenv("WEBHOOK_SECRET"),
 	}
 
-	runner, err := ci.NewDockerCI(logger)
-	if err != nil {
-		log.Fatalf("failed to set up docker client: %v\n", err)
+	var runner ci.Runner
+	switch *ciRunner {
+	case "docker":
+		docker, err := ci.NewDockerCI(logger)
+		if err != nil {
+			log.Fatalf("failed to set up docker client: %v\n", err)
+		}
+		defer docker.Close()
+		runner = docker
+	case "local":
+		local := &ci.Local{TempDir: true}
+		if runtime.GOOS != "linux" {
+			if !*ciUnconfined {
+				log.Fatalf("the local runner only isolates the tests from this machine's files on linux; use -ci.unconfined to allow it\n")
+			}
+			log.Println("Running tests on this machine without docker, unconfined")
+		} else {
+			// fail early if the tests cannot be sandboxed, e.g., without user namespaces
+			if _, err := local.Run(context.Background(), &ci.Job{Name: "sandbox check", Commands: []string{"true"}}); err != nil {
+				log.Fatalf("the local runner cannot sandbox the tests on this machine: %v\n", err)
+			}
+			log.Println("Running tests on this machine without docker, sandboxed")
+		}
+		runner = local
+	default:
+		log.Fatalf("unknown runner %q; must be docker or local\n", *ciRunner)
 	}
-	defer runner.Close()
 
 	// Add application token for external applications (to allow invoking gRPC methods)
 	// TODO(meling): this is a temporary solution, and we should find a better way to do this
@@ -97,12 +132,33 @@ func main() {
 	agService := web.NewAutograderService(logger, db, scms, bh, runner)
 	go web.New(agService, *public, *httpAddr)
 
+	reminders, err := assignments.ParseReminders(*remind)
+	if err != nil {
+		log.Fatalf("invalid reminders %q: %v\n", *remind, err)
+	}
+	if len(reminders) > 0 {
+		var notifier notify.Notifier = notify.NewLogger(logger)
+		if addr := os.Getenv("QUICKFEED_SMTP_ADDR"); addr != "" {
+			notifier, err = notify.NewMailer(addr, os.Getenv("QUICKFEED_SMTP_USER"), os.Getenv("QUICKFEED_SMTP_PASSWORD"), os.Getenv("QUICKFEED_SMTP_FROM"))
+			if err != nil {
+				log.Fatalf("failed to set up email reminders: %v\n", err)
+			}
+		} else {
+			log.Println("QUICKFEED_SMTP_ADDR is not set; reminders are only recorded in the log")
+		}
+		scheduler := assignments.NewReminderScheduler(logger, db, notifier, reminders...)
+		go scheduler.Run(context.Background(), time.Minute)
+	}
+
 	lis, err := net.Listen("tcp", *grpcAddr)
 	if err != nil {
 		log.Fatalf("failed to start tcp listener: %v\n", err)
 	}
 	opt := grpc.ChainUnaryInterceptor(auth.UserVerifier(), pb.Interceptor(logger))
-	grpcServer := grpc.NewServer(opt)
+	streamOpt := grpc.ChainStreamInterceptor(auth.StreamUserVerifier())
+	// allow messages holding assignment archives, with room for the rest of the message
+	const maxMsgSize = assignments.MaxArchiveSize + 1<<20
+	grpcServer := grpc.NewServer(opt, streamOpt, grpc.MaxRecvMsgSize(maxMsgSize), grpc.MaxSendMsgSize(maxMsgSize))
 	// Create a HTTP server for prometheus.
 	httpServer := &http.Server{
 		Handler: promhttp.HandlerFor(reg, promhttp.HandlerOpts{}),
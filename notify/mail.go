@@ -0,0 +1,61 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strings"
+)
+
+// Mailer is a Notifier that delivers notifications by email through an SMTP server.
+type Mailer struct {
+	addr string
+	auth smtp.Auth
+	from string
+	send func(addr string, auth smtp.Auth, from string, to []string, msg []byte) error
+}
+
+// NewMailer returns a Notifier that sends notifications from the given address through
+// the SMTP server at addr, given as host:port. The server is authenticated with
+// the given username and password, unless the username is empty.
+func NewMailer(addr, username, password, from string) (*Mailer, error) {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SMTP server address %q: %w", addr, err)
+	}
+	if _, err := mail.ParseAddress(from); err != nil {
+		return nil, fmt.Errorf("invalid sender address %q: %w", from, err)
+	}
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+	return &Mailer{addr: addr, auth: auth, from: from, send: smtp.SendMail}, nil
+}
+
+// Notify sends the given notification to the user's email address.
+func (m *Mailer) Notify(_ context.Context, notification *Notification) error {
+	to, err := mail.ParseAddress(notification.User.GetEmail())
+	if err != nil {
+		return fmt.Errorf("user %s has no valid email address: %w", notification.User.GetLogin(), err)
+	}
+	return m.send(m.addr, m.auth, m.from, []string{to.Address}, message(m.from, to.Address, notification))
+}
+
+// message returns the email message holding the given notification.
+func message(from, to string, notification *Notification) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", to)
+	// the encoded subject holds no line breaks, which would end the header
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", notification.Subject))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(notification.Body, "\n", "\r\n"))
+	b.WriteString("\r\n")
+	return []byte(b.String())
+}
@@ -0,0 +1,59 @@
+package notify
+
+import (
+	"context"
+	"net/smtp"
+	"strings"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMailerNotify(t *testing.T) {
+	mailer, err := NewMailer("smtp.example.com:587", "quickfeed", "secret", "quickfeed@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var gotTo []string
+	var gotMsg string
+	mailer.send = func(addr string, _ smtp.Auth, from string, to []string, msg []byte) error {
+		if addr != "smtp.example.com:587" || from != "quickfeed@example.com" {
+			t.Errorf("send(%s, %s), want send(smtp.example.com:587, quickfeed@example.com)", addr, from)
+		}
+		gotTo, gotMsg = to, string(msg)
+		return nil
+	}
+	err = mailer.Notify(context.Background(), &Notification{
+		User:    &pb.User{Login: "meling", Email: "meling@example.com"},
+		Subject: "DAT320: lab1 is due 2022-09-01T23:59:00\r\nBcc: all@example.com",
+		Body:    "The deadline for lab1 is approaching.\nGood luck!",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"meling@example.com"}, gotTo); diff != "" {
+		t.Errorf("Notify() recipients mismatch (-want +got):\n%s", diff)
+	}
+	header := gotMsg[:strings.Index(gotMsg, "\r\n\r\n")]
+	if strings.Contains(header, "\r\nBcc:") {
+		t.Errorf("Notify() subject added a header:\n%s", header)
+	}
+	if !strings.HasSuffix(gotMsg, "\r\n\r\nThe deadline for lab1 is approaching.\r\nGood luck!\r\n") {
+		t.Errorf("Notify() message = %q, want the notification's body", gotMsg)
+	}
+
+	err = mailer.Notify(context.Background(), &Notification{User: &pb.User{Login: "noemail"}, Subject: "s", Body: "b"})
+	if err == nil {
+		t.Error("Notify() to user without email address succeeded, want error")
+	}
+}
+
+func TestNewMailerInvalid(t *testing.T) {
+	if _, err := NewMailer("smtp.example.com", "", "", "quickfeed@example.com"); err == nil {
+		t.Error("NewMailer() without port succeeded, want error")
+	}
+	if _, err := NewMailer("smtp.example.com:25", "", "", "quickfeed"); err == nil {
+		t.Error("NewMailer() with invalid sender succeeded, want error")
+	}
+}
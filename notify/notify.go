@@ -0,0 +1,38 @@
+// Package notify delivers notifications to QuickFeed users.
+package notify
+
+import (
+	"context"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"go.uber.org/zap"
+)
+
+// Notification is a message to a single user.
+type Notification struct {
+	User    *pb.User
+	Subject string
+	Body    string
+}
+
+// Notifier delivers notifications to users.
+type Notifier interface {
+	Notify(ctx context.Context, notification *Notification) error
+}
+
+// Logger is a Notifier that records notifications in the log,
+// for deployments without another means of delivery.
+type Logger struct {
+	logger *zap.SugaredLogger
+}
+
+// NewLogger returns a Notifier that records notifications in the given log.
+func NewLogger(logger *zap.Logger) *Logger {
+	return &Logger{logger: logger.Sugar()}
+}
+
+// Notify records the given notification in the log.
+func (l *Logger) Notify(_ context.Context, notification *Notification) error {
+	l.logger.Infof("Notification to %s: %s: %s", notification.User.GetLogin(), notification.Subject, notification.Body)
+	return nil
+}
@@ -3,6 +3,7 @@ package scm
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strconv"
 
 	pb "github.com/autograde/quickfeed/ag"
@@ -14,6 +15,7 @@ type FakeSCM struct {
 	Organizations map[uint64]*pb.Organization
 	Hooks         map[uint64]int
 	Teams         map[uint64]*Team
+	Issues        map[string][]*Issue // issues indexed by organization/repository
 }
 
 // NewFakeSCMClient returns a new Fake client implementing the SCM interface.
@@ -23,6 +25,7 @@ func NewFakeSCMClient() *FakeSCM {
 		Organizations: make(map[uint64]*pb.Organization),
 		Hooks:         make(map[uint64]int),
 		Teams:         make(map[uint64]*Team),
+		Issues:        make(map[string][]*Issue),
 	}
 }
 
@@ -217,3 +220,34 @@ func (s *FakeSCM) GetUserScopes(ctx context.Context) *Authorization {
 	// TODO no implementation provided yet
 	return nil
 }
+
+// CreateIssue implements the SCM interface
+func (s *FakeSCM) CreateIssue(ctx context.Context, opt *IssueOptions) (*Issue, error) {
+	if !opt.valid() {
+		return nil, ErrMissingFields{
+			Method:  "CreateIssue",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	repo := opt.Organization + "/" + opt.Repository
+	issue := &Issue{
+		ID:     uint64(len(s.Issues[repo]) + 1),
+		Number: len(s.Issues[repo]) + 1,
+		Title:  opt.Title,
+		Body:   opt.Body,
+	}
+	s.Issues[repo] = append(s.Issues[repo], issue)
+	return issue, nil
+}
+
+// UpdateIssue implements the SCM interface
+func (s *FakeSCM) UpdateIssue(ctx context.Context, opt *IssueOptions) (*Issue, error) {
+	repo := opt.Organization + "/" + opt.Repository
+	if opt.Number < 1 || opt.Number > len(s.Issues[repo]) {
+		return nil, errors.New("issue not found")
+	}
+	issue := s.Issues[repo][opt.Number-1]
+	issue.Title = opt.Title
+	issue.Body = opt.Body
+	return issue, nil
+}
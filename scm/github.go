@@ -14,6 +14,13 @@ import (
 	"golang.org/x/oauth2"
 )
 
+// Bounds for paginated listings; GitHub returns at most 100 items per page.
+// The page limit guards against looping forever on a misbehaving API.
+const (
+	maxPerPage = 100
+	maxPages   = 100
+)
+
 // GithubSCM implements the SCM interface.
 type GithubSCM struct {
 	logger *zap.SugaredLogger
@@ -188,20 +195,32 @@ func (s *GithubSCM) GetRepositories(ctx context.Context, org *pb.Organization) (
 		path = org.Path
 	}
 
-	repos, _, err := s.client.Repositories.ListByOrg(ctx, path, nil)
-	if err != nil {
-		return nil, ErrFailedSCM{
-			GitError: err,
-			Method:   "GetRepositories",
-			Message:  fmt.Sprintf("failed to access repositories for organization %s", path),
+	var repositories []*Repository
+	opt := &github.RepositoryListByOrgOptions{
+		ListOptions: github.ListOptions{PerPage: maxPerPage},
+	}
+	for page := 0; page < maxPages; page++ {
+		repos, resp, err := s.client.Repositories.ListByOrg(ctx, path, opt)
+		if err != nil {
+			return nil, ErrFailedSCM{
+				GitError: err,
+				Method:   "GetRepositories",
+				Message:  fmt.Sprintf("failed to access repositories for organization %s", path),
+			}
 		}
+		for _, repo := range repos {
+			repositories = append(repositories, toRepository(repo))
+		}
+		if resp.NextPage == 0 {
+			return repositories, nil
+		}
+		opt.Page = resp.NextPage
 	}
-
-	var repositories []*Repository
-	for _, repo := range repos {
-		repositories = append(repositories, toRepository(repo))
+	return nil, ErrFailedSCM{
+		GitError: fmt.Errorf("more than %d pages of repositories", maxPages),
+		Method:   "GetRepositories",
+		Message:  fmt.Sprintf("failed to list all repositories for organization %s", path),
 	}
-	return repositories, nil
 }
 
 // DeleteRepository implements the SCM interface.
@@ -689,6 +708,59 @@ func (s *GithubSCM) GetUserScopes(ctx context.Context) *Authorization {
 	return &Authorization{Scopes: gitScopes}
 }
 
+// CreateIssue implements the SCM interface
+func (s *GithubSCM) CreateIssue(ctx context.Context, opt *IssueOptions) (*Issue, error) {
+	if !opt.valid() {
+		return nil, ErrMissingFields{
+			Method:  "CreateIssue",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	issue, _, err := s.client.Issues.Create(ctx, opt.Organization, opt.Repository, &github.IssueRequest{
+		Title: &opt.Title,
+		Body:  &opt.Body,
+	})
+	if err != nil {
+		return nil, ErrFailedSCM{
+			GitError: err,
+			Method:   "CreateIssue",
+			Message:  fmt.Sprintf("failed to create issue in repository %s/%s", opt.Organization, opt.Repository),
+		}
+	}
+	return toIssue(issue), nil
+}
+
+// UpdateIssue implements the SCM interface
+func (s *GithubSCM) UpdateIssue(ctx context.Context, opt *IssueOptions) (*Issue, error) {
+	if !opt.valid() || opt.Number < 1 {
+		return nil, ErrMissingFields{
+			Method:  "UpdateIssue",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	issue, _, err := s.client.Issues.Edit(ctx, opt.Organization, opt.Repository, opt.Number, &github.IssueRequest{
+		Title: &opt.Title,
+		Body:  &opt.Body,
+	})
+	if err != nil {
+		return nil, ErrFailedSCM{
+			GitError: err,
+			Method:   "UpdateIssue",
+			Message:  fmt.Sprintf("failed to update issue %d in repository %s/%s", opt.Number, opt.Organization, opt.Repository),
+		}
+	}
+	return toIssue(issue), nil
+}
+
+func toIssue(issue *github.Issue) *Issue {
+	return &Issue{
+		ID:     uint64(issue.GetID()),
+		Number: issue.GetNumber(),
+		Title:  issue.GetTitle(),
+		Body:   issue.GetBody(),
+	}
+}
+
 func toRepository(repo *github.Repository) *Repository {
 	return &Repository{
 		ID:      uint64(repo.GetID()),
@@ -280,3 +280,21 @@ func (s *GitlabSCM) GetUserScopes(ctx context.Context) *Authorization {
 	// TODO no implementation provided yet
 	return nil
 }
+
+// CreateIssue implements the SCM interface
+func (s *GitlabSCM) CreateIssue(ctx context.Context, opt *IssueOptions) (*Issue, error) {
+	// TODO no implementation provided yet
+	return nil, ErrNotSupported{
+		SCM:    "gitlab",
+		Method: "CreateIssue",
+	}
+}
+
+// UpdateIssue implements the SCM interface
+func (s *GitlabSCM) UpdateIssue(ctx context.Context, opt *IssueOptions) (*Issue, error) {
+	// TODO no implementation provided yet
+	return nil, ErrNotSupported{
+		SCM:    "gitlab",
+		Method: "UpdateIssue",
+	}
+}
@@ -149,3 +149,7 @@ type ErrFailedSCM struct {
 func (e ErrFailedSCM) Error() string {
 	return "github method " + e.Method + " failed: " + e.GitError.Error() + "\n" + e.Message
 }
+
+func (opt IssueOptions) valid() bool {
+	return opt.Organization != "" && opt.Repository != "" && opt.Title != ""
+}
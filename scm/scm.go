@@ -62,6 +62,10 @@ type SCM interface {
 	RemoveMember(context.Context, *OrgMembershipOptions) error
 	// Lists all authorizations for authenticated user.
 	GetUserScopes(context.Context) *Authorization
+	// CreateIssue creates an issue in the given repository.
+	CreateIssue(context.Context, *IssueOptions) (*Issue, error)
+	// UpdateIssue updates the title and body of an issue in the given repository.
+	UpdateIssue(context.Context, *IssueOptions) (*Issue, error)
 }
 
 // NewSCMClient returns a new provider client implementing the SCM interface.
@@ -214,3 +218,21 @@ type Team struct {
 type Authorization struct {
 	Scopes []string
 }
+
+// IssueOptions contains information on how an issue should be created or updated.
+// The issue's Number is only used when updating an issue.
+type IssueOptions struct {
+	Organization string
+	Repository   string
+	Number       int
+	Title        string
+	Body         string
+}
+
+// Issue represents an issue in a repository.
+type Issue struct {
+	ID     uint64
+	Number int
+	Title  string
+	Body   string
+}
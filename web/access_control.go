@@ -125,6 +125,38 @@ func (s *AutograderService) isTeacher(userID, courseID uint64) bool {
 	})
 }
 
+// canStreamBuildLog returns true if the given user may follow the requested test run.
+func (s *AutograderService) canStreamBuildLog(usr *pb.User, request *pb.BuildLogRequest) bool {
+	return s.canSeeTestRun(usr, request.GetCourseID(), request.GetAssignmentID(), request.GetUserID(), request.GetGroupID())
+}
+
+// canGetArtifacts returns true if the given user may download the artifacts of the given submission.
+func (s *AutograderService) canGetArtifacts(usr *pb.User, request *pb.ArtifactRequest) bool {
+	submission, err := s.db.GetSubmission(&pb.Submission{ID: request.GetSubmissionID()})
+	if err != nil {
+		return false
+	}
+	return s.canSeeTestRun(usr, request.GetCourseID(), submission.GetAssignmentID(), submission.GetUserID(), submission.GetGroupID())
+}
+
+// canSeeTestRun returns true if the given user may see the test runs of the given student
+// or group for the given assignment: teachers may see all test runs in their course, and
+// students their own test runs and those of their group, for the course's assignments.
+func (s *AutograderService) canSeeTestRun(usr *pb.User, courseID, assignmentID, userID, groupID uint64) bool {
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: assignmentID})
+	if err != nil || assignment.GetCourseID() != courseID {
+		return false
+	}
+	if s.isTeacher(usr.GetID(), courseID) {
+		return true
+	}
+	if groupID > 0 {
+		group, err := s.db.GetGroup(groupID)
+		return err == nil && group.GetCourseID() == courseID && group.Contains(usr)
+	}
+	return userID == usr.GetID() && s.isEnrolled(usr.GetID(), courseID)
+}
+
 // isCourseCreator returns true if the given user is course creator for the given course.
 func (s *AutograderService) isCourseCreator(courseID, userID uint64) bool {
 	course, _ := s.db.GetCourse(courseID, false)
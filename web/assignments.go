@@ -1,11 +1,14 @@
 package web
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/assignments"
+	"github.com/autograde/quickfeed/scm"
 )
 
 const reviewLayout = "02 Jan 15:04"
@@ -35,6 +38,65 @@ func (s *AutograderService) updateAssignments(courseID uint64) error {
 	return nil
 }
 
+// validateCourseRepository checks the course's tests repository without updating the course's assignments.
+func (s *AutograderService) validateCourseRepository(ctx context.Context, sc scm.SCM, courseID uint64) (*pb.CourseRepositoryValidation, error) {
+	course, err := s.db.GetCourse(courseID, false)
+	if err != nil {
+		return nil, fmt.Errorf("could not find course ID %d", courseID)
+	}
+	return assignments.ValidateCourseRepository(ctx, s.logger, s.db, sc, course)
+}
+
+// exportAssignments returns an archive of the requested assignments in the course's tests repository.
+func (s *AutograderService) exportAssignments(ctx context.Context, sc scm.SCM, request *pb.AssignmentArchiveRequest) (*pb.AssignmentArchive, error) {
+	course, err := s.db.GetCourse(request.GetCourseID(), false)
+	if err != nil {
+		return nil, fmt.Errorf("could not find course ID %d", request.GetCourseID())
+	}
+	var archive bytes.Buffer
+	if err := assignments.ExportAssignments(ctx, s.logger, sc, course, &archive, request.GetAssignmentNames()...); err != nil {
+		return nil, err
+	}
+	return &pb.AssignmentArchive{CourseID: course.GetID(), Archive: archive.Bytes()}, nil
+}
+
+// importAssignments imports the assignments in the given archive into the tests repository of the archive's course.
+func (s *AutograderService) importAssignments(ctx context.Context, sc scm.SCM, archive *pb.AssignmentArchive) (*pb.Assignments, error) {
+	if len(archive.GetArchive()) > assignments.MaxArchiveSize {
+		return nil, fmt.Errorf("assignment archive exceeds %d bytes", assignments.MaxArchiveSize)
+	}
+	course, err := s.db.GetCourse(archive.GetCourseID(), false)
+	if err != nil {
+		return nil, fmt.Errorf("could not find course ID %d", archive.GetCourseID())
+	}
+	imported, err := assignments.ImportAssignments(ctx, s.logger, s.db, sc, course, bytes.NewReader(archive.GetArchive()))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Assignments{Assignments: imported}, nil
+}
+
+// grantDeadlineExtension stores the requested deadline extension, provided
+// that the assignment belongs to the course and the extension is given to a
+// group for a group assignment or to a student for an individual assignment.
+func (s *AutograderService) grantDeadlineExtension(request *pb.DeadlineExtensionRequest) (*pb.DeadlineExtension, error) {
+	ext := request.GetExtension()
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: ext.GetAssignmentID()})
+	if err != nil {
+		return nil, err
+	}
+	if assignment.GetCourseID() != request.GetCourseID() {
+		return nil, fmt.Errorf("assignment %d does not belong to course %d", assignment.GetID(), request.GetCourseID())
+	}
+	if assignment.GetIsGroupLab() != (ext.GetGroupID() > 0) {
+		return nil, fmt.Errorf("extension for user %d and group %d does not match assignment %s", ext.GetUserID(), ext.GetGroupID(), assignment.GetName())
+	}
+	if err := s.db.UpdateDeadlineExtension(ext); err != nil {
+		return nil, err
+	}
+	return ext, nil
+}
+
 func (s *AutograderService) createBenchmark(query *pb.GradingBenchmark) (*pb.GradingBenchmark, error) {
 	if _, err := s.db.GetAssignment(&pb.Assignment{
 		ID: query.AssignmentID,
@@ -328,6 +328,12 @@ func OAuth2Callback(logger *zap.SugaredLogger, db database.Database, scms *Scms)
 				return err
 			}
 			logger.Debugf("access token updated: %v", remote)
+			// replace the scm client for the previous access token, if any
+			if oldAccessToken := findAccessToken(user, remote); oldAccessToken != remote.AccessToken {
+				if _, err := scms.UpdateSCMEntry(logger.Desugar(), provider, oldAccessToken, remote.AccessToken); err != nil {
+					logger.Errorf("Failed to update SCM for User: %v: %v", user, err)
+				}
+			}
 
 		case err == gorm.ErrRecordNotFound:
 			logger.Debug("user not found in database; creating new user")
@@ -456,6 +462,17 @@ func updateScm(ctx echo.Context, logger *zap.SugaredLogger, scms *Scms, user *pb
 	return foundSCMProvider
 }
 
+// findAccessToken returns the access token of the user's remote identity
+// matching the provider and remote ID of the given remote identity.
+func findAccessToken(user *pb.User, remote *pb.RemoteIdentity) string {
+	for _, remoteID := range user.GetRemoteIdentities() {
+		if remoteID.GetProvider() == remote.GetProvider() && remoteID.GetRemoteID() == remote.GetRemoteID() {
+			return remoteID.GetAccessToken()
+		}
+	}
+	return ""
+}
+
 func extractRedirectURL(r *http.Request, key string) string {
 	// TODO: Validate redirect URL.
 
@@ -511,6 +528,35 @@ func UserVerifier() grpc.UnaryServerInterceptor {
 	}
 }
 
+// StreamUserVerifier returns a stream server interceptor verifying the user of
+// streaming requests, as done by UserVerifier for unary requests.
+func StreamUserVerifier() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		meta, ok := metadata.FromIncomingContext(stream.Context())
+		if !ok {
+			return ErrContextMetadata
+		}
+		newMeta, err := userValidation(meta)
+		if err != nil {
+			return err
+		}
+		// create new context with user id instead of cookie for use internally
+		newCtx := metadata.NewIncomingContext(stream.Context(), newMeta)
+		return handler(srv, &userStream{ServerStream: stream, ctx: newCtx})
+	}
+}
+
+// userStream is a server stream whose context holds the verified user.
+type userStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the context holding the verified user.
+func (s *userStream) Context() context.Context {
+	return s.ctx
+}
+
 // userValidation returns modified metadata containing a valid user.
 // An error is returned if the user is not authenticated.
 func userValidation(meta metadata.MD) (metadata.MD, error) {
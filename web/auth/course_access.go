@@ -0,0 +1,62 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+// Context keys set by RequireCourseRole.
+const (
+	CourseKey     = "course"
+	EnrollmentKey = "enrollment"
+)
+
+// CourseParam is the name of the route parameter holding the course ID.
+const CourseParam = "cid"
+
+// RequireCourseRole returns a middleware that only calls the next handler if the
+// current user has at least the given role in the course identified by the route's
+// course ID parameter. Admins are granted access to all courses. The course and,
+// if the user is enrolled, the user's enrollment are stored in the context under
+// CourseKey and EnrollmentKey. Requests without a current user result in a 401
+// unauthorized response, requests for unknown courses result in a 404 not found
+// response, and insufficient roles result in a 403 forbidden response.
+func RequireCourseRole(db database.Database, minRole pb.Enrollment_UserStatus) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			user, ok := c.Get(UserKey).(*pb.User)
+			if !ok {
+				return echo.ErrUnauthorized
+			}
+			courseID, err := strconv.ParseUint(c.Param(CourseParam), 10, 64)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, "invalid course ID")
+			}
+			course, err := db.GetCourse(courseID, false)
+			if err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return echo.NewHTTPError(http.StatusNotFound, "course not found")
+				}
+				return err
+			}
+			enrollment, err := db.GetEnrollmentByCourseAndUser(courseID, user.GetID())
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
+			}
+			if !user.GetIsAdmin() && enrollment.GetStatus() < minRole {
+				return echo.NewHTTPError(http.StatusForbidden, "insufficient course role")
+			}
+			c.Set(CourseKey, course)
+			if enrollment != nil {
+				c.Set(EnrollmentKey, enrollment)
+			}
+			return next(c)
+		}
+	}
+}
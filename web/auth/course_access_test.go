@@ -0,0 +1,89 @@
+package auth_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web/auth"
+	"github.com/labstack/echo/v4"
+)
+
+func TestRequireCourseRole(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	teacher := qtest.CreateFakeUser(t, db, 2)
+	student := qtest.CreateFakeUser(t, db, 3)
+	pending := qtest.CreateFakeUser(t, db, 4)
+	outsider := qtest.CreateFakeUser(t, db, 5)
+	course := &pb.Course{}
+	qtest.CreateCourse(t, db, admin, course)
+	if err := db.CreateEnrollment(&pb.Enrollment{UserID: teacher.ID, CourseID: course.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateEnrollment(&pb.Enrollment{
+		UserID:   teacher.ID,
+		CourseID: course.ID,
+		Status:   pb.Enrollment_TEACHER,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	qtest.EnrollStudent(t, db, student, course)
+	if err := db.CreateEnrollment(&pb.Enrollment{UserID: pending.ID, CourseID: course.ID}); err != nil {
+		t.Fatal(err)
+	}
+
+	courseURL := fmt.Sprintf("/courses/%d", course.ID)
+	unknownURL := fmt.Sprintf("/courses/%d", course.ID+1)
+	tests := []struct {
+		name     string
+		user     *pb.User
+		minRole  pb.Enrollment_UserStatus
+		url      string
+		wantCode int
+	}{
+		{name: "admin/teacher route", user: admin, minRole: pb.Enrollment_TEACHER, url: courseURL, wantCode: http.StatusOK},
+		{name: "teacher/teacher route", user: teacher, minRole: pb.Enrollment_TEACHER, url: courseURL, wantCode: http.StatusOK},
+		{name: "student/teacher route", user: student, minRole: pb.Enrollment_TEACHER, url: courseURL, wantCode: http.StatusForbidden},
+		{name: "teacher/student route", user: teacher, minRole: pb.Enrollment_STUDENT, url: courseURL, wantCode: http.StatusOK},
+		{name: "student/student route", user: student, minRole: pb.Enrollment_STUDENT, url: courseURL, wantCode: http.StatusOK},
+		{name: "pending/student route", user: pending, minRole: pb.Enrollment_STUDENT, url: courseURL, wantCode: http.StatusForbidden},
+		{name: "not enrolled/student route", user: outsider, minRole: pb.Enrollment_STUDENT, url: courseURL, wantCode: http.StatusForbidden},
+		{name: "no user", user: nil, minRole: pb.Enrollment_STUDENT, url: courseURL, wantCode: http.StatusUnauthorized},
+		{name: "missing course", user: admin, minRole: pb.Enrollment_STUDENT, url: unknownURL, wantCode: http.StatusNotFound},
+		{name: "invalid course ID", user: admin, minRole: pb.Enrollment_STUDENT, url: "/courses/x", wantCode: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			// stand-in for the AccessControl middleware
+			e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+				return func(c echo.Context) error {
+					if tt.user != nil {
+						c.Set(auth.UserKey, tt.user)
+					}
+					return next(c)
+				}
+			})
+			var gotCourse *pb.Course
+			e.GET("/courses/:"+auth.CourseParam, func(c echo.Context) error {
+				gotCourse, _ = c.Get(auth.CourseKey).(*pb.Course)
+				return c.NoContent(http.StatusOK)
+			}, auth.RequireCourseRole(db, tt.minRole))
+
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.url, nil))
+			if rec.Code != tt.wantCode {
+				t.Errorf("RequireCourseRole() = %d, want %d", rec.Code, tt.wantCode)
+			}
+			if tt.wantCode == http.StatusOK && gotCourse.GetID() != course.ID {
+				t.Errorf("RequireCourseRole() stored course %v, want course %d", gotCourse, course.ID)
+			}
+		})
+	}
+}
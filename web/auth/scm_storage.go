@@ -44,3 +44,23 @@ func (s *Scms) GetOrCreateSCMEntry(logger *zap.Logger, provider, accessToken str
 	s.scms[accessToken] = client
 	return client, nil
 }
+
+// UpdateSCMEntry replaces the scm client stored for the old access token with
+// a new scm client for the given access token. This should be called when the
+// access token of a remote identity changes, e.g., when a user re-authenticates,
+// to ensure that subsequent lookups find an scm client for the fresh token.
+// If the old access token is empty or equal to the new access token,
+// this is equivalent to GetOrCreateSCMEntry.
+func (s *Scms) UpdateSCMEntry(logger *zap.Logger, provider, oldAccessToken, accessToken string) (scm.SCM, error) {
+	client, err := scm.NewSCMClient(logger.Sugar(), provider, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if oldAccessToken != accessToken {
+		delete(s.scms, oldAccessToken)
+	}
+	s.scms[accessToken] = client
+	return client, nil
+}
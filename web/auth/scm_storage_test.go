@@ -0,0 +1,54 @@
+package auth_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/autograde/quickfeed/web/auth"
+	"go.uber.org/zap"
+)
+
+func TestUpdateSCMEntry(t *testing.T) {
+	scms := auth.NewScms()
+	logger := zap.NewNop()
+
+	// add a new token
+	sc, err := scms.UpdateSCMEntry(logger, "fake", "", "token1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := scms.GetSCM("token1"); !ok || got != sc {
+		t.Errorf("GetSCM(token1) = %v, %t, want %v, true", got, ok, sc)
+	}
+
+	// replace the token while reads are in flight
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+					scms.GetSCM("token1")
+					scms.GetSCM("token2")
+				}
+			}
+		}()
+	}
+	sc, err = scms.UpdateSCMEntry(logger, "fake", "token1", "token2")
+	close(stop)
+	wg.Wait()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := scms.GetSCM("token2"); !ok || got != sc {
+		t.Errorf("GetSCM(token2) = %v, %t, want %v, true", got, ok, sc)
+	}
+	if _, ok := scms.GetSCM("token1"); ok {
+		t.Error("GetSCM(token1) found stale scm client after token was replaced")
+	}
+}
@@ -18,22 +18,26 @@ import (
 // AutograderService holds references to the database and
 // other shared data structures.
 type AutograderService struct {
-	logger *zap.SugaredLogger
-	db     database.Database
-	scms   *auth.Scms
-	bh     BaseHookOptions
-	runner ci.Runner
+	logger   *zap.SugaredLogger
+	db       database.Database
+	scms     *auth.Scms
+	bh       BaseHookOptions
+	runner   ci.Runner
+	queue    *ci.Queue
+	regrades *regrades
 	pb.UnimplementedAutograderServiceServer
 }
 
 // NewAutograderService returns an AutograderService object.
 func NewAutograderService(logger *zap.Logger, db database.Database, scms *auth.Scms, bh BaseHookOptions, runner ci.Runner) *AutograderService {
 	return &AutograderService{
-		logger: logger.Sugar(),
-		db:     db,
-		scms:   scms,
-		bh:     bh,
-		runner: runner,
+		logger:   logger.Sugar(),
+		db:       db,
+		scms:     scms,
+		bh:       bh,
+		runner:   runner,
+		queue:    ci.NewQueue(logger.Sugar(), db, runner, ci.DefaultQueueLimits),
+		regrades: newRegrades(),
 	}
 }
 
@@ -230,6 +234,26 @@ func (s *AutograderService) UpdateCourseVisibility(ctx context.Context, in *pb.E
 	return &pb.Void{}, err
 }
 
+// UpdateCourseSecret creates or updates a secret given to the tests of the course's assignments.
+// A secret without a value is deleted. Secrets are never returned to clients.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) UpdateCourseSecret(ctx context.Context, in *pb.CourseSecret) (*pb.Void, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("UpdateCourseSecret failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		s.logger.Error("UpdateCourseSecret failed: user is not teacher")
+		return nil, status.Error(codes.PermissionDenied, "only teachers can update course secrets")
+	}
+	if err := s.updateCourseSecret(in); err != nil {
+		s.logger.Errorf("UpdateCourseSecret failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to update course secret")
+	}
+	return &pb.Void{}, nil
+}
+
 // CreateEnrollment enrolls a new student for the course specified in the request.
 // Access policy: Any User.
 func (s *AutograderService) CreateEnrollment(ctx context.Context, in *pb.Enrollment) (*pb.Void, error) {
@@ -532,9 +556,36 @@ func (s *AutograderService) GetSubmissions(ctx context.Context, in *pb.Submissio
 		s.logger.Errorf("GetSubmissions failed: %v", err)
 		return nil, status.Error(codes.NotFound, "no submissions found")
 	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		if err := s.hideResults(in.GetCourseID(), submissions.GetSubmissions()); err != nil {
+			s.logger.Errorf("GetSubmissions failed: %v", err)
+			return nil, status.Error(codes.NotFound, "no submissions found")
+		}
+	}
 	return submissions, nil
 }
 
+// GetCourseGrade returns the course grade of the given user, weighted by the course's modules, if any.
+// Access policy: Teacher of CourseID, or the user with UserID.
+func (s *AutograderService) GetCourseGrade(ctx context.Context, in *pb.CourseGradeRequest) (*pb.CourseGrade, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetCourseGrade failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) && !usr.IsOwner(in.GetUserID()) {
+		s.logger.Errorf("GetCourseGrade failed: user %s is not teacher or the requested user", usr.GetLogin())
+		return nil, status.Error(codes.PermissionDenied, "only teachers and the user can get the course grade")
+	}
+	// students are only graded by the results they are shown
+	grade, err := s.getCourseGrade(in, !s.isTeacher(usr.GetID(), in.GetCourseID()))
+	if err != nil {
+		s.logger.Errorf("GetCourseGrade failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get course grade")
+	}
+	return grade, nil
+}
+
 // GetSubmissionsByCourse returns all the latest submissions
 // for every individual or group course assignment for all course students/groups.
 // Access policy: Admin enrolled in CourseID, Teacher of CourseID.
@@ -599,21 +650,43 @@ func (s *AutograderService) UpdateSubmission(ctx context.Context, in *pb.UpdateS
 }
 
 // RebuildSubmission rebuilds the submission with the given ID
-// Access policy: any user.
+// Access policy: Teacher of the submission's course.
 func (s *AutograderService) RebuildSubmission(ctx context.Context, in *pb.RebuildRequest) (*pb.Submission, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("RebuildSubmission failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
 	if !s.isValidSubmission(in.GetSubmissionID()) {
-		s.logger.Errorf("ApproveSubmission failed: submitter has no access to the course")
+		s.logger.Errorf("RebuildSubmission failed: submitter has no access to the course")
 		return nil, status.Error(codes.PermissionDenied, "submitter has no course access")
 	}
-	submission, err := s.rebuildSubmission(in)
+	// the teacher must teach the course of the submission, not only of the requested assignment
+	submission, err := s.db.GetSubmission(&pb.Submission{ID: in.GetSubmissionID()})
+	if err != nil {
+		s.logger.Errorf("RebuildSubmission failed: %v", err)
+		return nil, status.Error(codes.NotFound, "submission not found")
+	}
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: submission.GetAssignmentID()})
+	if err != nil || !s.isTeacher(usr.GetID(), assignment.GetCourseID()) {
+		s.logger.Errorf("RebuildSubmission failed: user %s is not teacher for assignment %d", usr.GetLogin(), submission.GetAssignmentID())
+		return nil, status.Error(codes.PermissionDenied, "only teachers can rebuild submissions")
+	}
+	if in.GetAssignmentID() != submission.GetAssignmentID() {
+		s.logger.Errorf("RebuildSubmission failed: submission %d does not belong to assignment %d", in.GetSubmissionID(), in.GetAssignmentID())
+		return nil, status.Error(codes.InvalidArgument, "submission does not belong to the assignment")
+	}
+	rebuilt, err := s.rebuildSubmission(in, usr.GetLogin())
 	if err != nil {
 		s.logger.Errorf("RebuildSubmission failed: %v", err)
 		return nil, status.Error(codes.InvalidArgument, "failed to rebuild submission")
 	}
-	return submission, nil
+	return rebuilt, nil
 }
 
-// RebuildSubmissions runs tests for all submissions for the given assignment ID.
+// RebuildSubmissions re-runs the tests of the latest submission of every student or group
+// for the given assignment, and waits for the test runs to complete. The rebuild's progress
+// can be followed with GetRegradeProgress.
 // Access policy: Teacher of CourseID.
 func (s *AutograderService) RebuildSubmissions(ctx context.Context, in *pb.AssignmentRequest) (*pb.Void, error) {
 	usr, err := s.getCurrentUser(ctx)
@@ -625,13 +698,233 @@ func (s *AutograderService) RebuildSubmissions(ctx context.Context, in *pb.Assig
 		s.logger.Error("RebuildSubmissions failed: user is not teacher")
 		return nil, status.Error(codes.PermissionDenied, "only teachers can rebuild all submissions")
 	}
-	if err := s.rebuildSubmissions(in); err != nil {
+	_, done, err := s.rebuildSubmissions(in, usr.GetLogin())
+	if err != nil {
 		s.logger.Errorf("RebuildSubmissions failed: %v", err)
+		if err == errRegradeInProgress {
+			return nil, status.Error(codes.FailedPrecondition, "assignment is already being regraded")
+		}
 		return nil, status.Error(codes.InvalidArgument, "failed to rebuild submissions")
 	}
+	<-done
 	return &pb.Void{}, nil
 }
 
+// RegradeAssignment starts re-running the tests of the latest submission of every student
+// or group for the given assignment, and returns the progress of the regrade without
+// waiting for the test runs to complete. Use GetRegradeProgress to follow the regrade.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) RegradeAssignment(ctx context.Context, in *pb.AssignmentRequest) (*pb.RegradeProgress, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("RegradeAssignment failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		s.logger.Errorf("RegradeAssignment failed: user %s is not teacher for course %d", usr.GetLogin(), in.GetCourseID())
+		return nil, status.Error(codes.PermissionDenied, "only teachers can regrade assignments")
+	}
+	progress, _, err := s.rebuildSubmissions(in, usr.GetLogin())
+	if err != nil {
+		s.logger.Errorf("RegradeAssignment failed: %v", err)
+		if err == errRegradeInProgress {
+			return nil, status.Error(codes.FailedPrecondition, "assignment is already being regraded")
+		}
+		return nil, status.Error(codes.InvalidArgument, "failed to regrade assignment")
+	}
+	return progress, nil
+}
+
+// GetRegradeProgress returns the progress of the latest regrade of the given assignment.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) GetRegradeProgress(ctx context.Context, in *pb.AssignmentRequest) (*pb.RegradeProgress, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetRegradeProgress failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		s.logger.Errorf("GetRegradeProgress failed: user %s is not teacher for course %d", usr.GetLogin(), in.GetCourseID())
+		return nil, status.Error(codes.PermissionDenied, "only teachers can see regrade progress")
+	}
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: in.GetAssignmentID()})
+	if err != nil || assignment.GetCourseID() != in.GetCourseID() {
+		s.logger.Errorf("GetRegradeProgress failed: assignment %d not found in course %d: %v", in.GetAssignmentID(), in.GetCourseID(), err)
+		return nil, status.Error(codes.NotFound, "assignment not found")
+	}
+	progress, ok := s.regrades.get(in.GetAssignmentID())
+	if !ok {
+		return nil, status.Error(codes.NotFound, "assignment has not been regraded")
+	}
+	return progress, nil
+}
+
+// RerunSubmission re-runs the tests of the latest commit of the user's submission, or the
+// submission of the user's group, for the given assignment, up to the assignment's maximum number
+// of re-runs for each commit. Returns the submission with the results of the re-run, hiding
+// the results not shown to students as GetSubmissions does.
+// Access policy: Any User enrolled in CourseID.
+func (s *AutograderService) RerunSubmission(ctx context.Context, in *pb.AssignmentRequest) (*pb.Submission, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("RerunSubmission failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isEnrolled(usr.GetID(), in.GetCourseID()) {
+		s.logger.Errorf("RerunSubmission failed: user %s is not enrolled in course %d", usr.GetLogin(), in.GetCourseID())
+		return nil, status.Error(codes.PermissionDenied, "only enrolled users can re-run their tests")
+	}
+	submission, err := s.rerunSubmission(usr, in)
+	if err != nil {
+		s.logger.Errorf("RerunSubmission failed: %v", err)
+		if err == database.ErrRerunLimit {
+			return nil, status.Error(codes.ResourceExhausted, "no re-runs left for the latest commit")
+		}
+		return nil, status.Error(codes.InvalidArgument, "failed to re-run submission")
+	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		if err := s.hideResults(in.GetCourseID(), []*pb.Submission{submission}); err != nil {
+			s.logger.Errorf("RerunSubmission failed: %v", err)
+			return nil, status.Error(codes.NotFound, "no submission found")
+		}
+	}
+	return submission, nil
+}
+
+// GetSubmissionQueue returns the number of pending and running test runs in the course,
+// and the position of the first pending test run for the user's or the user's group's repository.
+// Access policy: Any User enrolled in CourseID.
+func (s *AutograderService) GetSubmissionQueue(ctx context.Context, in *pb.CourseRequest) (*pb.SubmissionQueue, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetSubmissionQueue failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isEnrolled(usr.ID, in.GetCourseID()) {
+		s.logger.Errorf("GetSubmissionQueue failed: user %s is not enrolled in course %d", usr.GetLogin(), in.GetCourseID())
+		return nil, status.Error(codes.PermissionDenied, "only enrolled users can see the submission queue")
+	}
+	enrollment, err := s.db.GetEnrollmentByCourseAndUser(in.GetCourseID(), usr.ID)
+	if err != nil {
+		s.logger.Errorf("GetSubmissionQueue failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get enrollment")
+	}
+	return s.queue.Status(in.GetCourseID(), usr.ID, enrollment.GetGroupID()), nil
+}
+
+// StreamBuildLog streams the output of the test run in progress for the given assignment
+// and student or group, as the tests run, until the test run completes. The output of the
+// assignment's hidden tests is omitted until the deadline, also when followed by teachers.
+// Access policy: Teacher of CourseID, or the student or a member of the group whose tests are running.
+func (s *AutograderService) StreamBuildLog(in *pb.BuildLogRequest, stream pb.AutograderService_StreamBuildLogServer) error {
+	ctx := stream.Context()
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("StreamBuildLog failed: authentication error: %v", err)
+		return ErrInvalidUserInfo
+	}
+	// streaming requests are not checked by the validation interceptor
+	if !in.IsValid() {
+		return status.Error(codes.InvalidArgument, "invalid payload")
+	}
+	if !s.canStreamBuildLog(usr, in) {
+		s.logger.Errorf("StreamBuildLog failed: user %s cannot follow test run %+v", usr.GetLogin(), in)
+		return status.Error(codes.PermissionDenied, "only teachers and the submitting student or group can follow test runs")
+	}
+	log, updates, cancel, ok := s.queue.BuildLogs().Subscribe(ci.BuildLogKey{
+		AssignmentID: in.GetAssignmentID(),
+		UserID:       in.GetUserID(),
+		GroupID:      in.GetGroupID(),
+	})
+	if !ok {
+		return status.Error(codes.NotFound, "no test run in progress")
+	}
+	defer cancel()
+	if log != "" {
+		if err := stream.Send(&pb.BuildLogChunk{Log: log}); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case chunk, ok := <-updates:
+			if !ok {
+				// the test run has completed; its results are found in the submission
+				return nil
+			}
+			if err := stream.Send(&pb.BuildLogChunk{Log: chunk}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// GetArtifacts returns the names and sizes of the artifacts written by the tests of the given submission.
+// Access policy: Teacher of CourseID, or the student or a member of the group that made the submission.
+func (s *AutograderService) GetArtifacts(ctx context.Context, in *pb.ArtifactRequest) (*pb.Artifacts, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetArtifacts failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.canGetArtifacts(usr, in) {
+		s.logger.Errorf("GetArtifacts failed: user %s cannot access submission %d", usr.GetLogin(), in.GetSubmissionID())
+		return nil, status.Error(codes.PermissionDenied, "only teachers and the submitting student or group can get artifacts")
+	}
+	artifacts, err := s.db.GetArtifacts(in.GetSubmissionID())
+	if err != nil {
+		s.logger.Errorf("GetArtifacts failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get artifacts")
+	}
+	return &pb.Artifacts{Artifacts: artifacts}, nil
+}
+
+// GetArtifact returns the named artifact, including its contents, written by the tests of the given submission.
+// Access policy: Teacher of CourseID, or the student or a member of the group that made the submission.
+func (s *AutograderService) GetArtifact(ctx context.Context, in *pb.ArtifactRequest) (*pb.Artifact, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetArtifact failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if in.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing artifact name")
+	}
+	if !s.canGetArtifacts(usr, in) {
+		s.logger.Errorf("GetArtifact failed: user %s cannot access submission %d", usr.GetLogin(), in.GetSubmissionID())
+		return nil, status.Error(codes.PermissionDenied, "only teachers and the submitting student or group can get artifacts")
+	}
+	artifact, err := s.db.GetArtifact(in.GetSubmissionID(), in.GetName())
+	if err != nil {
+		s.logger.Errorf("GetArtifact failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get artifact")
+	}
+	return artifact, nil
+}
+
+// GrantDeadlineExtension grants a student or group a later deadline for an assignment,
+// replacing any previously granted extension.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) GrantDeadlineExtension(ctx context.Context, in *pb.DeadlineExtensionRequest) (*pb.DeadlineExtension, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GrantDeadlineExtension failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.ID, in.GetCourseID()) {
+		s.logger.Error("GrantDeadlineExtension failed: user is not teacher")
+		return nil, status.Error(codes.PermissionDenied, "only teachers can grant deadline extensions")
+	}
+	ext, err := s.grantDeadlineExtension(in)
+	if err != nil {
+		s.logger.Errorf("GrantDeadlineExtension failed for %+v: %v", in, err)
+		return nil, status.Error(codes.InvalidArgument, "failed to grant deadline extension")
+	}
+	return ext, nil
+}
+
 // CreateBenchmark adds a new grading benchmark for an assignment
 // Access policy: Teacher of CourseID
 func (s *AutograderService) CreateBenchmark(ctx context.Context, in *pb.GradingBenchmark) (*pb.GradingBenchmark, error) {
@@ -821,6 +1114,81 @@ func (s *AutograderService) UpdateAssignments(ctx context.Context, in *pb.Course
 	return &pb.Void{}, nil
 }
 
+// ValidateCourseRepository returns the assignments parsed from the course's tests repository,
+// how they differ from the course's assignments, and any problems found, without updating
+// the course's assignments, such that teachers can preview changes before publishing them.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) ValidateCourseRepository(ctx context.Context, in *pb.CourseRequest) (*pb.CourseRepositoryValidation, error) {
+	usr, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("ValidateCourseRepository failed: scm authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		s.logger.Error("ValidateCourseRepository failed: user is not teacher")
+		return nil, status.Error(codes.PermissionDenied, "only teachers can validate the tests repository")
+	}
+	validation, err := s.validateCourseRepository(ctx, scm, in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("ValidateCourseRepository failed: %v", err)
+		if contextCanceled(ctx) {
+			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
+		}
+		return nil, status.Error(codes.NotFound, "failed to validate tests repository")
+	}
+	return validation, nil
+}
+
+// ExportAssignments returns the files of the requested assignments in the course's
+// tests repository, or of all assignments if none are requested, as an archive
+// that can be imported into another course.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) ExportAssignments(ctx context.Context, in *pb.AssignmentArchiveRequest) (*pb.AssignmentArchive, error) {
+	usr, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("ExportAssignments failed: scm authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		s.logger.Error("ExportAssignments failed: user is not teacher")
+		return nil, status.Error(codes.PermissionDenied, "only teachers can export course assignments")
+	}
+	archive, err := s.exportAssignments(ctx, scm, in)
+	if err != nil {
+		s.logger.Errorf("ExportAssignments failed: %v", err)
+		if contextCanceled(ctx) {
+			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
+		}
+		return nil, status.Error(codes.NotFound, "failed to export assignments")
+	}
+	return archive, nil
+}
+
+// ImportAssignments adds the assignments in the given archive, as returned by
+// ExportAssignments, to the course's tests repository, updates the course's
+// assignments from the tests repository, and returns the imported assignments.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) ImportAssignments(ctx context.Context, in *pb.AssignmentArchive) (*pb.Assignments, error) {
+	usr, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("ImportAssignments failed: scm authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		s.logger.Error("ImportAssignments failed: user is not teacher")
+		return nil, status.Error(codes.PermissionDenied, "only teachers can import course assignments")
+	}
+	assignments, err := s.importAssignments(ctx, scm, in)
+	if err != nil {
+		s.logger.Errorf("ImportAssignments failed: %v", err)
+		if contextCanceled(ctx) {
+			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "failed to import assignments: %v", err)
+	}
+	return assignments, nil
+}
+
 // GetProviders returns a list of SCM providers supported by the backend.
 // Access policy: Any User.
 func (s *AutograderService) GetProviders(ctx context.Context, in *pb.Void) (*pb.Providers, error) {
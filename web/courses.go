@@ -7,6 +7,7 @@ import (
 	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/assignments"
 	"github.com/autograde/quickfeed/scm"
 )
 
@@ -125,6 +126,15 @@ func (s *AutograderService) getCourse(courseID uint64) (*pb.Course, error) {
 	return s.db.GetCourse(courseID, false)
 }
 
+// updateCourseSecret creates, updates or deletes the given course secret.
+func (s *AutograderService) updateCourseSecret(secret *pb.CourseSecret) error {
+	return s.db.UpdateCourseSecret(&pb.CourseSecret{
+		CourseID: secret.GetCourseID(),
+		Name:     secret.GetName(),
+		Value:    secret.GetValue(),
+	})
+}
+
 // getSubmissions returns all the latests submissions for a user of the given course.
 func (s *AutograderService) getSubmissions(request *pb.SubmissionRequest) (*pb.Submissions, error) {
 	// only one of user ID and group ID will be set; enforced by IsValid on pb.SubmissionRequest
@@ -139,6 +149,99 @@ func (s *AutograderService) getSubmissions(request *pb.SubmissionRequest) (*pb.S
 	return &pb.Submissions{Submissions: submissions}, nil
 }
 
+// hideResults hides the results of the given submissions that must not be shown
+// to students: the scores of hidden tests before the assignment's deadline, and
+// the points of assignments in the given course that are configured to not show
+// points to students.
+func (s *AutograderService) hideResults(courseID uint64, submissions []*pb.Submission) error {
+	assignments, err := s.db.GetAssignmentsByCourse(courseID, false)
+	if err != nil {
+		return err
+	}
+	withTests := make(map[uint64]*pb.Assignment)
+	for _, assignment := range assignments {
+		// fetch the assignment with its tests' pass thresholds and hidden tests
+		a, err := s.db.GetAssignment(&pb.Assignment{ID: assignment.GetID()})
+		if err != nil {
+			return err
+		}
+		withTests[assignment.GetID()] = a
+	}
+	now := time.Now()
+	for _, submission := range submissions {
+		assignment, ok := withTests[submission.GetAssignmentID()]
+		if !ok {
+			continue
+		}
+		if len(assignment.HiddenTests()) > 0 && !assignment.RevealsHiddenTests(now) {
+			submission.HideTests(assignment)
+		}
+		if assignment.GetHidePoints() {
+			submission.HidePoints(assignment)
+		}
+	}
+	return nil
+}
+
+// getCourseGrade returns the course grade of the user in the given request, computed
+// from the user's latest submissions, or the latest submissions of the user's group
+// for group assignments. If hidden is true, the grade is computed from the results
+// shown to students, as by hideResults, such that the grade does not reveal the scores
+// of hidden tests or the points of assignments that hide points; otherwise, the grade
+// is computed from the submissions' recorded scores.
+func (s *AutograderService) getCourseGrade(request *pb.CourseGradeRequest, hidden bool) (*pb.CourseGrade, error) {
+	enrollment, err := s.db.GetEnrollmentByCourseAndUser(request.GetCourseID(), request.GetUserID())
+	if err != nil {
+		return nil, err
+	}
+	courseAssignments, err := s.db.GetAssignmentsByCourse(request.GetCourseID(), false)
+	if err != nil {
+		return nil, err
+	}
+	submissions, err := s.db.GetLastSubmissions(request.GetCourseID(), &pb.Submission{UserID: request.GetUserID()})
+	if err != nil {
+		return nil, err
+	}
+	if enrollment.GetGroupID() > 0 {
+		groupSubmissions, err := s.db.GetLastSubmissions(request.GetCourseID(), &pb.Submission{GroupID: enrollment.GetGroupID()})
+		if err != nil {
+			return nil, err
+		}
+		submissions = append(submissions, groupSubmissions...)
+	}
+	if hidden {
+		if err := s.hideResults(request.GetCourseID(), submissions); err != nil {
+			return nil, err
+		}
+	}
+	byID := make(map[uint64]*pb.Assignment, len(courseAssignments))
+	for _, assignment := range courseAssignments {
+		byID[assignment.GetID()] = assignment
+	}
+	assignmentGrades := make(map[string]uint32)
+	for _, submission := range submissions {
+		assignment, ok := byID[submission.GetAssignmentID()]
+		if !ok || assignment.GetIsGroupLab() != (submission.GetGroupID() > 0) {
+			continue
+		}
+		assignmentGrades[assignment.GetName()] = submission.GetScore()
+	}
+	modules, err := s.db.GetCourseModules(request.GetCourseID())
+	if err != nil {
+		return nil, err
+	}
+	grade, moduleGrades, err := assignments.CourseGradeByModules(assignmentGrades, courseAssignments, modules)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CourseGrade{
+		CourseID: request.GetCourseID(),
+		UserID:   request.GetUserID(),
+		Grade:    grade,
+		Modules:  moduleGrades,
+	}, nil
+}
+
 // getAllCourseSubmissions returns all individual lab submissions by students enrolled in the specified course.
 func (s *AutograderService) getAllCourseSubmissions(request *pb.SubmissionsForCourseRequest) (*pb.CourseSubmissions, error) {
 	assignments, err := s.db.GetAssignmentsWithSubmissions(request.GetCourseID(), request.Type, request.GetWithBuildInfo())
@@ -5,9 +5,11 @@ import (
 	"reflect"
 	"strconv"
 	"testing"
+	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/kit/score"
 	"github.com/google/go-cmp/cmp"
 	"github.com/markbates/goth"
 	"go.uber.org/zap"
@@ -629,3 +631,117 @@ func TestPromoteDemoteRejectTeacher(t *testing.T) {
 		t.Error("expected error 'ta cannot be demoted course creator'")
 	}
 }
+
+func TestGetCourseGrade(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	student := qtest.CreateFakeUser(t, db, 2)
+	other := qtest.CreateFakeUser(t, db, 3)
+	course := &pb.Course{}
+	qtest.CreateCourse(t, db, teacher, course)
+	qtest.EnrollStudent(t, db, student, course)
+	qtest.EnrollStudent(t, db, other, course)
+	group := &pb.Group{CourseID: course.ID, Name: "group", Users: []*pb.User{student}}
+	if err := db.CreateGroup(group); err != nil {
+		t.Fatal(err)
+	}
+
+	// the teacher's grade counts the points of assignments hiding points and the scores of
+	// hidden tests, while the student's grade is computed from the results shown to students
+	assignments := []*pb.Assignment{
+		{CourseID: course.ID, Name: "lab1", Order: 1, CourseWeight: 1, HidePoints: true},
+		{
+			CourseID:     course.ID,
+			Name:         "lab2",
+			Order:        2,
+			CourseWeight: 3,
+			Deadline:     time.Now().Add(24 * time.Hour).Format(pb.TimeLayout),
+			Tests:        []*pb.TestConfig{{TestName: "TestHidden", Hidden: true}},
+		},
+		{CourseID: course.ID, Name: "project", Order: 3, IsGroupLab: true},
+	}
+	submissions := []*pb.Submission{
+		{UserID: student.ID, Score: 40},
+		{UserID: student.ID, Score: 80, Scores: []*score.Score{
+			{TestName: "TestPass", Score: 5, MaxScore: 5, Weight: 1},
+			{TestName: "TestHidden", Score: 3, MaxScore: 5, Weight: 1},
+		}},
+		{GroupID: group.ID, Score: 90},
+	}
+	for i, assignment := range assignments {
+		if err := db.CreateAssignment(assignment); err != nil {
+			t.Fatal(err)
+		}
+		submissions[i].AssignmentID = assignment.ID
+		if err := db.CreateSubmission(submissions[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	request := &pb.CourseGradeRequest{CourseID: course.ID, UserID: student.ID}
+
+	// without modules, the project does not count, since it has no course weight
+	for _, tt := range []struct {
+		user *pb.User
+		want uint32
+	}{
+		{user: teacher, want: 70},
+		// lab1 shows no points, and lab2 scores 100 without the hidden test
+		{user: student, want: 75},
+	} {
+		grade, err := ags.GetCourseGrade(withUserContext(context.Background(), tt.user), request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if grade.GetGrade() != tt.want || len(grade.GetModules()) != 0 {
+			t.Errorf("GetCourseGrade() for %s = %v, want grade %d without modules", tt.user.GetLogin(), grade, tt.want)
+		}
+	}
+
+	if err := db.UpdateCourseModules(course.ID, []*pb.CourseModule{
+		{Name: "labs", Weight: 60, Assignments: "lab1,lab2"},
+		{Name: "project", Weight: 40, Assignments: "project"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		user *pb.User
+		want *pb.CourseGrade
+	}{
+		{user: teacher, want: &pb.CourseGrade{
+			CourseID: course.ID,
+			UserID:   student.ID,
+			Grade:    78,
+			Modules: []*pb.ModuleGrade{
+				{Name: "labs", Weight: 60, Grade: 70},
+				{Name: "project", Weight: 40, Grade: 90},
+			},
+		}},
+		{user: student, want: &pb.CourseGrade{
+			CourseID: course.ID,
+			UserID:   student.ID,
+			Grade:    81,
+			Modules: []*pb.ModuleGrade{
+				{Name: "labs", Weight: 60, Grade: 75},
+				{Name: "project", Weight: 40, Grade: 90},
+			},
+		}},
+	} {
+		grade, err := ags.GetCourseGrade(withUserContext(context.Background(), tt.user), request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(tt.want, grade, protocmp.Transform()); diff != "" {
+			t.Errorf("GetCourseGrade() for %s mismatch (-want +got):\n%s", tt.user.GetLogin(), diff)
+		}
+	}
+
+	_, err := ags.GetCourseGrade(withUserContext(context.Background(), other), request)
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetCourseGrade() by another student = %v, want %v", err, codes.PermissionDenied)
+	}
+}
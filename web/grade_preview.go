@@ -0,0 +1,135 @@
+package web
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/kit/score"
+	"github.com/autograde/quickfeed/web/auth"
+	"github.com/labstack/echo/v4"
+)
+
+// GradePreview holds the grading outcome that would be recorded for a results
+// payload, had it been produced by a test run for the assignment.
+type GradePreview struct {
+	Score        uint32   `json:"score"`        // grade in the range 0-100, or up to the extra credit cap
+	LatePenalty  uint32   `json:"latePenalty"`  // points deducted from the score for a late submission
+	Grade        uint32   `json:"grade"`        // score after deducting the late penalty
+	StudentScore uint32   `json:"studentScore"` // score shown to students, without hidden tests or points
+	Late         bool     `json:"late"`         // true if the results were built after the deadline
+	Lateness     string   `json:"lateness"`     // duration since the deadline; negative if before the deadline
+	Status       string   `json:"status"`       // submission status after auto-approval
+	Warnings     []string `json:"warnings"`     // problems with the results payload
+}
+
+// GradePreviewHandler computes the grade, lateness, and auto-approval decision
+// for the results posted in the request body, as if they had been produced by
+// a test run for the assignment given by the path parameter. Nothing is stored.
+// The handler must be guarded by RequireCourseRole, limiting access to teachers
+// of the course and admins.
+func (s *AutograderService) GradePreviewHandler(c echo.Context) error {
+	course, ok := c.Get(auth.CourseKey).(*pb.Course)
+	if !ok {
+		return echo.ErrForbidden
+	}
+	assignmentID, err := strconv.ParseUint(c.Param("aid"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid assignment ID")
+	}
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: assignmentID, CourseID: course.GetID()})
+	if err != nil {
+		s.logger.Errorf("GradePreview failed to get assignment %d for course %d: %v", assignmentID, course.GetID(), err)
+		return echo.NewHTTPError(http.StatusNotFound, "assignment not found")
+	}
+	results := &score.Results{}
+	if err := c.Bind(results); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid results payload")
+	}
+	preview, err := previewGrade(assignment, results, time.Now())
+	if err != nil {
+		s.logger.Errorf("GradePreview failed: %v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "invalid assignment deadline")
+	}
+	return c.JSON(http.StatusOK, preview)
+}
+
+// previewGrade returns the grade preview for the given results and assignment.
+// Invalid scores are reported as warnings and left out of the grade, and the
+// remaining scores are graded by ci.GradeResults, as for a test run. The student
+// score is the score shown to students, as by hideResults, at the build time.
+// If the results carry no build date, the results are graded as built now.
+// An error is returned if the assignment's deadline is malformed.
+func previewGrade(assignment *pb.Assignment, results *score.Results, now time.Time) (*GradePreview, error) {
+	preview := &GradePreview{Warnings: []string{}}
+	var valid []*score.Score
+	for _, sc := range results.Scores {
+		if err := checkScore(sc); err != nil {
+			preview.Warnings = append(preview.Warnings, fmt.Sprintf("%s: %v", sc.GetTestName(), err))
+			continue
+		}
+		valid = append(valid, sc)
+	}
+	if len(valid) == 0 {
+		preview.Warnings = append(preview.Warnings, "no valid scores in results")
+	}
+
+	buildTime := now
+	if buildDate := results.BuildInfo.GetBuildDate(); buildDate != "" {
+		t, err := time.ParseInLocation(pb.TimeLayout, buildDate, now.Location())
+		if err != nil {
+			preview.Warnings = append(preview.Warnings, fmt.Sprintf("invalid build date %q; using current time", buildDate))
+		} else {
+			buildTime = t
+		}
+	}
+	graded := score.NewResults(valid...)
+	graded.BuildInfo = results.BuildInfo
+	report, err := ci.GradeResults(graded, assignment, buildTime)
+	if err != nil {
+		return nil, err
+	}
+	for _, testName := range report.MissingTests {
+		preview.Warnings = append(preview.Warnings, fmt.Sprintf("%s: no score in results; graded as failed", testName))
+	}
+	sinceDeadline, err := assignment.SinceDeadline(buildTime)
+	if err != nil {
+		return nil, fmt.Errorf("invalid deadline for assignment %s: %w", assignment.GetName(), err)
+	}
+	preview.Score = report.Score
+	preview.LatePenalty = report.LatePenalty
+	preview.Grade = report.Grade
+	preview.Late = sinceDeadline > 0
+	preview.Lateness = sinceDeadline.String()
+	preview.Status = report.Status.String()
+
+	shown := &pb.Submission{Score: report.Score, Scores: report.Results.Scores}
+	if len(assignment.HiddenTests()) > 0 && !assignment.RevealsHiddenTests(buildTime) {
+		shown.HideTests(assignment)
+	}
+	if assignment.GetHidePoints() {
+		shown.HidePoints(assignment)
+	}
+	preview.StudentScore = shown.GetScore()
+	return preview, nil
+}
+
+// checkScore returns an error if the score object is invalid.
+// Unlike score.IsValid, the secret is not checked, since the payload is not
+// produced by a test run, and the check is safe to use outside test code.
+func checkScore(sc *score.Score) error {
+	switch {
+	case sc.GetTestName() == "":
+		return score.ErrEmptyTestName
+	case sc.GetMaxScore() <= 0:
+		return score.ErrMaxScore
+	case sc.GetWeight() <= 0:
+		return score.ErrWeight
+	case sc.GetScore() < 0 || sc.GetScore() > sc.GetMaxScore():
+		return score.ErrScoreInterval
+	}
+	return nil
+}
@@ -0,0 +1,221 @@
+package web_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/kit/score"
+	"github.com/autograde/quickfeed/web"
+	"github.com/autograde/quickfeed/web/auth"
+	"github.com/google/go-cmp/cmp"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+func TestGradePreviewHandler(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	teacher := qtest.CreateFakeUser(t, db, 2)
+	student := qtest.CreateFakeUser(t, db, 3)
+	course := &pb.Course{}
+	qtest.CreateCourse(t, db, admin, course)
+	if err := db.CreateEnrollment(&pb.Enrollment{UserID: teacher.ID, CourseID: course.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateEnrollment(&pb.Enrollment{
+		UserID:   teacher.ID,
+		CourseID: course.ID,
+		Status:   pb.Enrollment_TEACHER,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	qtest.EnrollStudent(t, db, student, course)
+	assignment := &pb.Assignment{
+		CourseID:    course.ID,
+		Name:        "lab1",
+		Order:       1,
+		Deadline:    "2021-03-01T23:59:00",
+		AutoApprove: true,
+		ScoreLimit:  80,
+	}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+
+	const results = `{
+		"BuildInfo": {"BuildDate": "2021-03-02T23:59:00"},
+		"Scores": [
+			{"TestName": "TestA", "Score": 10, "MaxScore": 10, "Weight": 1},
+			{"TestName": "TestB", "Score": 6, "MaxScore": 10, "Weight": 1},
+			{"TestName": "TestC", "Score": 5, "MaxScore": 0, "Weight": 1}
+		]
+	}`
+	wantPreview := &web.GradePreview{
+		Score:        80,
+		Grade:        80,
+		StudentScore: 80,
+		Late:         true,
+		Lateness:     "24h0m0s",
+		Status:       pb.Submission_APPROVED.String(),
+		Warnings:     []string{"TestC: " + score.ErrMaxScore.Error()},
+	}
+
+	tests := []struct {
+		name     string
+		user     *pb.User
+		wantCode int
+	}{
+		{name: "admin", user: admin, wantCode: http.StatusOK},
+		{name: "teacher", user: teacher, wantCode: http.StatusOK},
+		{name: "student", user: student, wantCode: http.StatusForbidden},
+		{name: "no user", user: nil, wantCode: http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := postGradePreview(ags, db, tt.user, assignment, results)
+			if rec.Code != tt.wantCode {
+				t.Fatalf("GradePreviewHandler() = %d, want %d", rec.Code, tt.wantCode)
+			}
+			if tt.wantCode != http.StatusOK {
+				return
+			}
+			gotPreview := &web.GradePreview{}
+			if err := json.Unmarshal(rec.Body.Bytes(), gotPreview); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(wantPreview, gotPreview); diff != "" {
+				t.Errorf("GradePreviewHandler() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	// previewing a grade must not create a submission
+	submissions, err := db.GetSubmissions(&pb.Submission{AssignmentID: assignment.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(submissions) != 0 {
+		t.Errorf("GradePreviewHandler() stored %d submissions, want 0", len(submissions))
+	}
+}
+
+func TestGradePreviewHandlerAssignmentTests(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{}
+	qtest.CreateCourse(t, db, admin, course)
+	assignment := &pb.Assignment{
+		CourseID:    course.ID,
+		Name:        "lab1",
+		Order:       1,
+		Deadline:    "2021-03-01T23:59:00",
+		LatePenalty: 10,
+		AutoApprove: true,
+		ScoreLimit:  50,
+		Tests: []*pb.TestConfig{
+			{TestName: "TestA", MaxScore: 20},
+			{TestName: "TestB", Weight: 2, Hidden: true},
+			{TestName: "TestD"},
+		},
+	}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+
+	// the results are graded as by a test run: TestA is scaled to its max score,
+	// TestB is given its weight, and the missing TestD is graded as failed;
+	// the hidden TestB is left out of the score shown to students until the deadline
+	const results = `{
+		"BuildInfo": {"BuildDate": %q},
+		"Scores": [
+			{"TestName": "TestA", "Score": 10, "MaxScore": 10, "Weight": 1},
+			{"TestName": "TestB", "Score": 6, "MaxScore": 10, "Weight": 1}
+		]
+	}`
+	missing := []string{"TestD: no score in results; graded as failed"}
+	tests := []struct {
+		name        string
+		buildDate   string
+		wantPreview *web.GradePreview
+	}{
+		{name: "before deadline", buildDate: "2021-03-01T12:00:00", wantPreview: &web.GradePreview{
+			Score:        55,
+			Grade:        55,
+			StudentScore: 50,
+			Late:         false,
+			Lateness:     "-11h59m0s",
+			Status:       pb.Submission_APPROVED.String(),
+			Warnings:     missing,
+		}},
+		{name: "after deadline", buildDate: "2021-03-02T23:59:00", wantPreview: &web.GradePreview{
+			Score:        55,
+			LatePenalty:  10,
+			Grade:        45,
+			StudentScore: 55,
+			Late:         true,
+			Lateness:     "24h0m0s",
+			Status:       pb.Submission_NONE.String(),
+			Warnings:     missing,
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := postGradePreview(ags, db, admin, assignment, fmt.Sprintf(results, tt.buildDate))
+			if rec.Code != http.StatusOK {
+				t.Fatalf("GradePreviewHandler() = %d, want %d", rec.Code, http.StatusOK)
+			}
+			gotPreview := &web.GradePreview{}
+			if err := json.Unmarshal(rec.Body.Bytes(), gotPreview); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.wantPreview, gotPreview); diff != "" {
+				t.Errorf("GradePreviewHandler() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// postGradePreview posts the given results to the grade preview endpoint
+// of the given assignment on behalf of the given user.
+func postGradePreview(ags *web.AutograderService, db database.Database, user *pb.User, assignment *pb.Assignment, results string) *httptest.ResponseRecorder {
+	e := echo.New()
+	e.Use(withUser(user))
+	e.POST("/courses/:cid/assignments/:aid/grade-preview", ags.GradePreviewHandler, auth.RequireCourseRole(db, pb.Enrollment_TEACHER))
+	url := fmt.Sprintf("/courses/%d/assignments/%d/grade-preview", assignment.CourseID, assignment.ID)
+	req := httptest.NewRequest(http.MethodPost, url, strings.NewReader(results))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+// withUser returns a middleware that stores the given user in the context,
+// standing in for the AccessControl middleware.
+func withUser(user *pb.User) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if user != nil {
+				c.Set(auth.UserKey, user)
+			}
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package web
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strings"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/web/auth"
+	"github.com/labstack/echo/v4"
+)
+
+// groupsCSVHeader is the header row of the CSV file returned by GroupsCSVHandler.
+var groupsCSVHeader = []string{"group", "status", "members", "repository"}
+
+// GroupsCSVHandler returns all groups of the course as a CSV file, with one row
+// per group holding the group's name, status, the logins of its members separated
+// by spaces, and the URL of its repository, if any. The rows are flushed to the
+// client as they are written, to avoid buffering the entire file for large courses.
+// The handler must be guarded by RequireCourseRole, limiting access to teachers
+// of the course and admins.
+func (s *AutograderService) GroupsCSVHandler(c echo.Context) error {
+	course, ok := c.Get(auth.CourseKey).(*pb.Course)
+	if !ok {
+		return echo.ErrForbidden
+	}
+	groups, err := s.db.GetGroupsByCourse(course.GetID())
+	if err != nil {
+		s.logger.Errorf("GroupsCSV failed to get groups for course %d: %v", course.GetID(), err)
+		return err
+	}
+
+	resp := c.Response()
+	resp.Header().Set(echo.HeaderContentType, "text/csv; charset=utf-8")
+	resp.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", strings.ToLower(course.GetCode())+"-groups.csv"))
+	resp.WriteHeader(http.StatusOK)
+
+	w := csv.NewWriter(resp)
+	if err := w.Write(groupsCSVHeader); err != nil {
+		return err
+	}
+	for _, group := range groups {
+		logins := make([]string, 0, len(group.GetUsers()))
+		for _, user := range group.GetUsers() {
+			logins = append(logins, user.GetLogin())
+		}
+		repoURL := ""
+		repos, err := s.db.GetRepositories(&pb.Repository{GroupID: group.GetID(), RepoType: pb.Repository_GROUP})
+		if err != nil {
+			// the response has been started; the error can only be logged
+			s.logger.Errorf("GroupsCSV failed to get repository for group %d: %v", group.GetID(), err)
+		} else if len(repos) > 0 {
+			repoURL = repos[0].GetHTMLURL()
+		}
+		if err := w.Write([]string{group.GetName(), group.GetStatus().String(), strings.Join(logins, " "), repoURL}); err != nil {
+			return err
+		}
+		w.Flush()
+		resp.Flush()
+	}
+	w.Flush()
+	return w.Error()
+}
@@ -0,0 +1,97 @@
+package web_test
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+	"github.com/autograde/quickfeed/web/auth"
+	"github.com/google/go-cmp/cmp"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+func TestGroupsCSVHandler(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	student1 := qtest.CreateFakeUser(t, db, 2)
+	student2 := qtest.CreateFakeUser(t, db, 3)
+	student3 := qtest.CreateFakeUser(t, db, 4)
+	course := &pb.Course{Code: "DAT320", OrganizationID: 1}
+	qtest.CreateCourse(t, db, admin, course)
+	for _, student := range []*pb.User{student1, student2, student3} {
+		qtest.EnrollStudent(t, db, student, course)
+	}
+	approved := &pb.Group{Name: "approved_group", CourseID: course.ID, Users: []*pb.User{student1, student2}}
+	if err := db.CreateGroup(approved); err != nil {
+		t.Fatal(err)
+	}
+	approved.Status = pb.Group_APPROVED
+	if err := db.UpdateGroupStatus(approved); err != nil {
+		t.Fatal(err)
+	}
+	repo := &pb.Repository{
+		OrganizationID: course.OrganizationID,
+		RepositoryID:   10,
+		GroupID:        approved.ID,
+		HTMLURL:        "https://github.com/dat320/approved_group",
+		RepoType:       pb.Repository_GROUP,
+	}
+	if err := db.CreateRepository(repo); err != nil {
+		t.Fatal(err)
+	}
+	pending := &pb.Group{Name: "pending_group", CourseID: course.ID, Users: []*pb.User{student3}}
+	if err := db.CreateGroup(pending); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	tests := []struct {
+		name     string
+		user     *pb.User
+		wantCode int
+	}{
+		{name: "admin", user: admin, wantCode: http.StatusOK},
+		{name: "student", user: student1, wantCode: http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.Use(withUser(tt.user))
+			e.GET("/courses/:cid/groups/export", ags.GroupsCSVHandler, auth.RequireCourseRole(db, pb.Enrollment_TEACHER))
+
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/courses/%d/groups/export", course.ID), nil))
+			if rec.Code != tt.wantCode {
+				t.Fatalf("GroupsCSVHandler() = %d, want %d", rec.Code, tt.wantCode)
+			}
+			if tt.wantCode != http.StatusOK {
+				return
+			}
+			if got := rec.Header().Get(echo.HeaderContentType); got != "text/csv; charset=utf-8" {
+				t.Errorf("GroupsCSVHandler() content type = %q, want text/csv", got)
+			}
+			rows, err := csv.NewReader(rec.Body).ReadAll()
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := [][]string{
+				{"group", "status", "members", "repository"},
+				{"approved_group", "APPROVED", student1.Login + " " + student2.Login, repo.HTMLURL},
+				{"pending_group", "PENDING", student3.Login, ""},
+			}
+			if diff := cmp.Diff(want, rows); diff != "" {
+				t.Errorf("GroupsCSVHandler() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
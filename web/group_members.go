@@ -0,0 +1,66 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/web/auth"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+// GroupMember holds a group member and the member's enrollment status
+// in the group's course.
+type GroupMember struct {
+	UserID uint64 `json:"userID"`
+	Name   string `json:"name"`
+	Login  string `json:"login"`
+	Status string `json:"status"` // enrollment status; NONE if no longer enrolled in the course
+}
+
+// GroupMembersHandler returns the members of the group given by the path parameter,
+// along with each member's enrollment status in the course. This allows teachers
+// to see if a group contains a teaching assistant or a student that has left the course.
+// The handler must be guarded by RequireCourseRole, limiting access to teachers
+// of the course and admins.
+func (s *AutograderService) GroupMembersHandler(c echo.Context) error {
+	course, ok := c.Get(auth.CourseKey).(*pb.Course)
+	if !ok {
+		return echo.ErrForbidden
+	}
+	groupID, err := strconv.ParseUint(c.Param("gid"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid group ID")
+	}
+	group, err := s.db.GetGroup(groupID)
+	if err != nil || group.GetCourseID() != course.GetID() {
+		s.logger.Errorf("GroupMembers failed to get group %d for course %d: %v", groupID, course.GetID(), err)
+		return echo.NewHTTPError(http.StatusNotFound, "group not found")
+	}
+	users, err := s.db.GetGroupUsers(groupID)
+	if err != nil {
+		s.logger.Errorf("GroupMembers failed to get users of group %d: %v", groupID, err)
+		return err
+	}
+	members := make([]*GroupMember, 0, len(users))
+	for _, user := range users {
+		status := pb.Enrollment_NONE
+		enrollment, err := s.db.GetEnrollmentByCourseAndUser(course.GetID(), user.GetID())
+		switch {
+		case err == nil:
+			status = enrollment.GetStatus()
+		case !errors.Is(err, gorm.ErrRecordNotFound):
+			s.logger.Errorf("GroupMembers failed to get enrollment for user %d: %v", user.GetID(), err)
+			return err
+		}
+		members = append(members, &GroupMember{
+			UserID: user.GetID(),
+			Name:   user.GetName(),
+			Login:  user.GetLogin(),
+			Status: status.String(),
+		})
+	}
+	return c.JSON(http.StatusOK, members)
+}
@@ -0,0 +1,87 @@
+package web_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+	"github.com/autograde/quickfeed/web/auth"
+	"github.com/google/go-cmp/cmp"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+func TestGroupMembersHandler(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	assistant := qtest.CreateFakeUser(t, db, 2)
+	student := qtest.CreateFakeUser(t, db, 3)
+	dropped := qtest.CreateFakeUser(t, db, 4)
+	course := &pb.Course{}
+	qtest.CreateCourse(t, db, admin, course)
+	if err := db.CreateEnrollment(&pb.Enrollment{UserID: assistant.ID, CourseID: course.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateEnrollment(&pb.Enrollment{
+		UserID:   assistant.ID,
+		CourseID: course.ID,
+		Status:   pb.Enrollment_TEACHER,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	qtest.EnrollStudent(t, db, student, course)
+	qtest.EnrollStudent(t, db, dropped, course)
+	group := &pb.Group{
+		Name:     "group1",
+		CourseID: course.ID,
+		Users:    []*pb.User{assistant, student, dropped},
+	}
+	if err := db.CreateGroup(group); err != nil {
+		t.Fatal(err)
+	}
+	// the student leaves the course after joining the group
+	if err := db.RejectEnrollment(dropped.ID, course.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	e := echo.New()
+	e.Use(withUser(admin))
+	e.GET("/courses/:cid/groups/:gid/members", ags.GroupMembersHandler, auth.RequireCourseRole(db, pb.Enrollment_TEACHER))
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/courses/%d/groups/%d/members", course.ID, group.ID), nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GroupMembersHandler() = %d, want %d", rec.Code, http.StatusOK)
+	}
+	var gotMembers []*web.GroupMember
+	if err := json.Unmarshal(rec.Body.Bytes(), &gotMembers); err != nil {
+		t.Fatal(err)
+	}
+	wantMembers := []*web.GroupMember{
+		{UserID: assistant.ID, Name: assistant.Name, Login: assistant.Login, Status: pb.Enrollment_TEACHER.String()},
+		{UserID: student.ID, Name: student.Name, Login: student.Login, Status: pb.Enrollment_STUDENT.String()},
+		{UserID: dropped.ID, Name: dropped.Name, Login: dropped.Login, Status: pb.Enrollment_NONE.String()},
+	}
+	if diff := cmp.Diff(wantMembers, gotMembers); diff != "" {
+		t.Errorf("GroupMembersHandler() mismatch (-want +got):\n%s", diff)
+	}
+
+	// groups of other courses are not found
+	otherCourse := &pb.Course{OrganizationID: 2}
+	qtest.CreateCourse(t, db, admin, otherCourse)
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/courses/%d/groups/%d/members", otherCourse.ID, group.ID), nil))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("GroupMembersHandler() for group of other course = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
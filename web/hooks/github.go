@@ -19,13 +19,14 @@ import (
 type GitHubWebHook struct {
 	logger *zap.SugaredLogger
 	db     database.Database
-	runner ci.Runner
+	queue  *ci.Queue
 	secret string
 }
 
 // NewGitHubWebHook creates a new webhook to handle POST requests from GitHub to the QuickFeed server.
-func NewGitHubWebHook(logger *zap.SugaredLogger, db database.Database, runner ci.Runner, secret string) *GitHubWebHook {
-	return &GitHubWebHook{logger: logger, db: db, runner: runner, secret: secret}
+// Test runs triggered by push events are added to the given queue.
+func NewGitHubWebHook(logger *zap.SugaredLogger, db database.Database, queue *ci.Queue, secret string) *GitHubWebHook {
+	return &GitHubWebHook{logger: logger, db: db, queue: queue, secret: secret}
 }
 
 // Handle take POST requests from GitHub, representing Push events
@@ -167,12 +168,18 @@ func (wh GitHubWebHook) runAssignmentTests(assignment *pb.Assignment, repo *pb.R
 		CommitID:   payload.GetHeadCommit().GetID(),
 		JobOwner:   payload.GetSender().GetLogin(),
 	}
+	if ci.RejectLocked(wh.logger, wh.db, runData) {
+		return
+	}
 	if assignment.GradedManually() {
 		wh.logger.Debugf("Assignment %s for course %s is manually reviewed", assignment.Name, course.Name)
 		wh.recordSubmissionWithoutTests(runData)
 		return
 	}
-	ci.RunTests(wh.logger, wh.db, wh.runner, runData)
+	if ci.RateLimited(wh.logger, wh.db, runData) {
+		return
+	}
+	wh.queue.Enqueue(runData)
 }
 
 // recordSubmissionWithoutTests saves a new submission without running any tests
@@ -69,7 +69,8 @@ func TestGitHubWebHook(t *testing.T) {
 	// TODO(meling) db is nil; will cause handling of push event to panic; will need a database with content for this to work fully.
 	var db database.Database
 	var runner ci.Runner
-	webhook := NewGitHubWebHook(logger, db, runner, secret)
+	queue := ci.NewQueue(logger, db, runner, ci.DefaultQueueLimits)
+	webhook := NewGitHubWebHook(logger, db, queue, secret)
 
 	log.Println("starting webhook server")
 	http.HandleFunc("/webhook", webhook.Handle)
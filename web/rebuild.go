@@ -1,6 +1,7 @@
 package web
 
 import (
+	"fmt"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -10,18 +11,23 @@ import (
 	"github.com/gosimple/slug"
 )
 
-const maxContainers = 10
-
-// rebuildSubmission rebuilds the given assignment and submission.
-func (s *AutograderService) rebuildSubmission(request *pb.RebuildRequest) (*pb.Submission, error) {
+// rebuildSubmission rebuilds the given assignment and submission,
+// attributing the test run to the user with the given login.
+func (s *AutograderService) rebuildSubmission(request *pb.RebuildRequest, triggeredBy string) (*pb.Submission, error) {
 	submission, err := s.db.GetSubmission(&pb.Submission{ID: request.GetSubmissionID()})
 	if err != nil {
 		return nil, err
 	}
+	if submission.GetAssignmentID() != request.GetAssignmentID() {
+		return nil, fmt.Errorf("submission %d does not belong to assignment %d", submission.GetID(), request.GetAssignmentID())
+	}
 	assignment, course, err := s.getAssignmentWithCourse(&pb.Assignment{ID: request.AssignmentID}, false)
 	if err != nil {
 		return nil, err
 	}
+	if assignment.GetManualOnly() {
+		return nil, fmt.Errorf("assignment %s is graded manually only; no tests to run", assignment.GetName())
+	}
 	name := s.lookupName(submission)
 
 	var repo *pb.Repository
@@ -39,57 +45,115 @@ func (s *AutograderService) rebuildSubmission(request *pb.RebuildRequest) (*pb.S
 	}
 
 	runData := &ci.RunData{
-		Course:     course,
-		Assignment: assignment,
-		Repo:       repo,
-		CommitID:   submission.GetCommitHash(),
-		JobOwner:   slug.Make(name),
-		Rebuild:    true,
-	}
-	ci.RunTests(s.logger, s.db, s.runner, runData)
+		Course:      course,
+		Assignment:  assignment,
+		Repo:        repo,
+		CommitID:    submission.GetCommitHash(),
+		JobOwner:    slug.Make(name),
+		Rebuild:     true,
+		TriggeredBy: triggeredBy,
+	}
+	// wait for the queued test run to complete
+	<-s.queue.Enqueue(runData)
 	return s.db.GetSubmission(&pb.Submission{ID: request.GetSubmissionID()})
 }
 
-func (s *AutograderService) rebuildSubmissions(request *pb.AssignmentRequest) error {
-	if _, err := s.db.GetAssignment(&pb.Assignment{ID: request.AssignmentID}); err != nil {
-		return err
+// rebuildSubmissions queues test runs for the latest submission of every student or group
+// for the given assignment, attributing the test runs to the user with the given login.
+// Returns the progress of the rebuild, which is kept for GetRegradeProgress, and a channel
+// that is closed when all test runs have completed. The queue limits the number of test
+// runs executing concurrently. Returns errRegradeInProgress if the assignment's previous
+// rebuild has not completed.
+func (s *AutograderService) rebuildSubmissions(request *pb.AssignmentRequest, triggeredBy string) (*pb.RegradeProgress, <-chan struct{}, error) {
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: request.GetAssignmentID()})
+	if err != nil {
+		return nil, nil, err
+	}
+	if assignment.GetCourseID() != request.GetCourseID() {
+		return nil, nil, fmt.Errorf("assignment %d does not belong to course %d", assignment.GetID(), request.GetCourseID())
 	}
-	submissions, err := s.db.GetSubmissions(&pb.Submission{AssignmentID: request.AssignmentID})
+	if assignment.GetManualOnly() {
+		return nil, nil, fmt.Errorf("assignment %s is graded manually only; no tests to run", assignment.GetName())
+	}
+	submissions, err := s.db.GetSubmissions(&pb.Submission{AssignmentID: assignment.GetID()})
+	if err != nil {
+		return nil, nil, err
+	}
+	submissions = latestSubmissions(submissions)
+	progress, err := s.regrades.start(assignment.GetID(), triggeredBy, len(submissions))
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
-	s.logger.Debugf("Rebuilding all submissions for assignment %d for course %d\n", request.GetAssignmentID(), request.GetCourseID())
+	s.logger.Debugf("Rebuilding %d submissions for assignment %s, requested by %s", len(submissions), assignment.GetName(), triggeredBy)
 	start := time.Now()
 
-	// counting semaphore: limit concurrent rebuilding to maxContainers
-	sem := make(chan struct{}, maxContainers)
 	errCnt := int32(0)
 	var wg sync.WaitGroup
 	wg.Add(len(submissions))
 	for _, submission := range submissions {
 		rebuildReq := &pb.RebuildRequest{
-			AssignmentID: request.AssignmentID,
-			SubmissionID: submission.ID,
+			AssignmentID: assignment.GetID(),
+			SubmissionID: submission.GetID(),
 		}
-		// the counting semaphore limits concurrency to maxContainers
 		go func() {
-			sem <- struct{}{} // acquire semaphore
-			_, err := s.rebuildSubmission(rebuildReq)
+			defer wg.Done()
+			_, err := s.rebuildSubmission(rebuildReq, triggeredBy)
 			if err != nil {
 				atomic.AddInt32(&errCnt, 1)
-				s.logger.Errorf("Failed to rebuild submission %d: %v\n", rebuildReq.GetSubmissionID(), err)
+				s.logger.Errorf("Failed to rebuild submission %d: %v", rebuildReq.GetSubmissionID(), err)
 			}
-			<-sem // release semaphore
-			wg.Done()
+			s.regrades.complete(rebuildReq.GetAssignmentID(), err != nil)
 		}()
 	}
-	// wait for all submissions to finish rebuilding
-	wg.Wait()
-	close(sem)
+	done := make(chan struct{})
+	go func() {
+		// wait for all submissions to finish rebuilding
+		wg.Wait()
+		s.logger.Debugf("Rebuilt %d submissions in %v (failed: %d)",
+			len(submissions), time.Since(start), atomic.LoadInt32(&errCnt))
+		close(done)
+	}()
+	return progress, done, nil
+}
 
-	s.logger.Debugf("Rebuilt %d submissions in %v (failed: %d)",
-		len(submissions), time.Since(start), errCnt)
-	return nil
+// rerunSubmission re-runs the tests of the latest commit of the given user's submission,
+// or the submission of the user's group, for the given assignment, unless the submission
+// has been re-run the maximum number of times allowed by the assignment.
+func (s *AutograderService) rerunSubmission(usr *pb.User, request *pb.AssignmentRequest) (*pb.Submission, error) {
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: request.GetAssignmentID()})
+	if err != nil {
+		return nil, err
+	}
+	if assignment.GetCourseID() != request.GetCourseID() {
+		return nil, fmt.Errorf("assignment %d does not belong to course %d", assignment.GetID(), request.GetCourseID())
+	}
+	if assignment.GetManualOnly() {
+		return nil, fmt.Errorf("assignment %s is graded manually only; no tests to run", assignment.GetName())
+	}
+	query := &pb.Submission{AssignmentID: assignment.GetID(), UserID: usr.GetID()}
+	if assignment.GetIsGroupLab() {
+		enrollment, err := s.db.GetEnrollmentByCourseAndUser(request.GetCourseID(), usr.GetID())
+		if err != nil {
+			return nil, err
+		}
+		if enrollment.GetGroupID() == 0 {
+			return nil, fmt.Errorf("user %s has no group for group assignment %s", usr.GetLogin(), assignment.GetName())
+		}
+		query = &pb.Submission{AssignmentID: assignment.GetID(), GroupID: enrollment.GetGroupID()}
+	}
+	submission, err := s.db.GetSubmission(query)
+	if err != nil {
+		return nil, err
+	}
+	// count the re-run before it is queued, such that queued re-runs cannot exceed the limit
+	if err := s.db.CountRerun(submission.GetID(), assignment.GetMaxReruns()); err != nil {
+		return nil, err
+	}
+	s.logger.Debugf("Re-running submission %d for assignment %s, requested by %s", submission.GetID(), assignment.GetName(), usr.GetLogin())
+	return s.rebuildSubmission(&pb.RebuildRequest{
+		AssignmentID: assignment.GetID(),
+		SubmissionID: submission.GetID(),
+	}, usr.GetLogin())
 }
 
 func (s *AutograderService) lookupName(submission *pb.Submission) string {
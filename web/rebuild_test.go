@@ -2,62 +2,22 @@ package web_test
 
 import (
 	"context"
-	"fmt"
-	"math"
-	"sync"
-	"sync/atomic"
 	"testing"
+	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/ci"
 	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/kit/score"
 	"github.com/autograde/quickfeed/scm"
 	"github.com/autograde/quickfeed/web"
+	"github.com/google/go-cmp/cmp"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
 )
 
-func TestSimulatedRebuildWorkpoolWithErrCount(t *testing.T) {
-	// this tests the workpool logic used in rebuildSubmissions
-	for _, maxContainers := range []int{3, 5, 6, 8, 10} {
-		for _, errRate := range []int{2, 3, 4, 5, 6} {
-			for _, numSubs := range []int{10, 15, 20, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 150, 500} {
-				t.Run(fmt.Sprintf("containers=%d/errRate=%d/submissions=%d", maxContainers, errRate, numSubs), func(t *testing.T) {
-					submissions := make([]int, numSubs)
-					for i := range submissions {
-						submissions[i] = i
-					}
-					sem := make(chan struct{}, maxContainers)
-					errCnt := int32(0)
-					var wg sync.WaitGroup
-					wg.Add(len(submissions))
-					for _, submission := range submissions {
-						submission := submission
-						// the counting semaphore limits concurrency to maxContainers
-						go func() {
-							sem <- struct{}{} // acquire semaphore
-							// here we are rebuilding submission
-							if submission%errRate == 0 { // simulate error every errRate submission
-								// count the error
-								atomic.AddInt32(&errCnt, 1)
-							}
-							<-sem // release semaphore
-							wg.Done()
-						}()
-					}
-					// wait for all submissions to finish rebuilding
-					wg.Wait()
-					close(sem)
-
-					expectedErrCnt := int32(math.Ceil(float64(len(submissions)) / float64(errRate)))
-					if errCnt != expectedErrCnt {
-						t.Errorf("errCnt != expectedErrCnt ==> %d != %d == (%d/%d)", errCnt, expectedErrCnt, len(submissions), errRate)
-					}
-				})
-			}
-		}
-	}
-}
-
 func TestRebuildSubmissions(t *testing.T) {
 	db, cleanup := qtest.TestDB(t)
 	defer cleanup()
@@ -183,6 +143,14 @@ func TestRebuildSubmissions(t *testing.T) {
 	if _, err = ags.RebuildSubmissions(ctx, &request); err != nil {
 		t.Fatalf("Failed to rebuild submissions: %s", err)
 	}
+	// the rebuild has completed when RebuildSubmissions returns
+	progress, err := ags.GetRegradeProgress(ctx, &request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if progress.GetTotal() == 0 || progress.GetCompleted() != progress.GetTotal() {
+		t.Errorf("GetRegradeProgress() after rebuild = %d of %d submissions completed, want all", progress.GetCompleted(), progress.GetTotal())
+	}
 	rebuiltSubmissions, err := db.GetSubmissions(&pb.Submission{AssignmentID: assignment.ID})
 	if err != nil {
 		t.Fatalf("Failed to get created submissions: %s", err)
@@ -197,3 +165,220 @@ func TestRebuildSubmissions(t *testing.T) {
 		t.Fatal("Expected error: authentication failed")
 	}
 }
+
+func TestRerunSubmission(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	student := qtest.CreateFakeUser(t, db, 2)
+	other := qtest.CreateFakeUser(t, db, 3)
+	course := &pb.Course{Provider: "fake", OrganizationID: 1}
+	qtest.CreateCourse(t, db, teacher, course)
+	qtest.EnrollStudent(t, db, student, course)
+	qtest.EnrollStudent(t, db, other, course)
+	for _, user := range []*pb.User{student, other} {
+		if err := db.CreateRepository(&pb.Repository{
+			OrganizationID: 1,
+			RepositoryID:   user.ID,
+			UserID:         user.ID,
+			RepoType:       pb.Repository_USER,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	assignment := &pb.Assignment{
+		CourseID:   course.ID,
+		Name:       "lab1",
+		ScriptFile: "go.sh",
+		Order:      1,
+		MaxReruns:  1,
+		Deadline:   time.Now().Add(24 * time.Hour).Format(pb.TimeLayout),
+		Tests:      []*pb.TestConfig{{TestName: "TestHidden", Hidden: true}},
+	}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	submission := &pb.Submission{
+		AssignmentID: assignment.ID,
+		UserID:       student.ID,
+		Score:        50,
+		Scores: []*score.Score{
+			{TestName: "TestPass", Score: 5, MaxScore: 5, Weight: 1},
+			{TestName: "TestHidden", Score: 0, MaxScore: 5, Weight: 1},
+		},
+	}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	request := &pb.AssignmentRequest{CourseID: course.ID, AssignmentID: assignment.ID}
+
+	studentCtx := withUserContext(context.Background(), student)
+	rerun, err := ags.RerunSubmission(studentCtx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rerun.GetID() != submission.ID || rerun.GetReruns() != 1 {
+		t.Errorf("RerunSubmission() = submission %d with %d re-runs, want submission %d with 1 re-run", rerun.GetID(), rerun.GetReruns(), submission.ID)
+	}
+	// the hidden test's result is not shown to the student before the deadline
+	wantScores := []*score.Score{{TestName: "TestPass", Score: 5, MaxScore: 5, Weight: 1}}
+	if diff := cmp.Diff(wantScores, rerun.GetScores(), protocmp.Transform(),
+		protocmp.IgnoreFields(&score.Score{}, "ID", "SubmissionID")); diff != "" {
+		t.Errorf("RerunSubmission().Scores mismatch (-want +got):\n%s", diff)
+	}
+	if rerun.GetScore() != 100 {
+		t.Errorf("RerunSubmission().Score = %d, want 100", rerun.GetScore())
+	}
+	if _, err := ags.RerunSubmission(studentCtx, request); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("RerunSubmission() beyond maxreruns: error = %v, want code %v", err, codes.ResourceExhausted)
+	}
+	// a student without a submission has no commit to re-run
+	if _, err := ags.RerunSubmission(withUserContext(context.Background(), other), request); err == nil {
+		t.Error("RerunSubmission() without submission succeeded, want error")
+	}
+
+	// only teachers may rebuild submissions, also after the student's re-runs are used
+	rebuildRequest := &pb.RebuildRequest{AssignmentID: assignment.ID, SubmissionID: submission.ID}
+	if _, err := ags.RebuildSubmission(studentCtx, rebuildRequest); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("RebuildSubmission() by student: error = %v, want code %v", err, codes.PermissionDenied)
+	}
+	if _, err := ags.RebuildSubmission(withUserContext(context.Background(), teacher), rebuildRequest); err != nil {
+		t.Errorf("RebuildSubmission() by teacher failed: %v", err)
+	}
+}
+
+func TestRegradeAssignment(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	student := qtest.CreateFakeUser(t, db, 2)
+	other := qtest.CreateFakeUser(t, db, 3)
+	course := &pb.Course{Provider: "fake", OrganizationID: 1}
+	qtest.CreateCourse(t, db, teacher, course)
+	qtest.EnrollStudent(t, db, student, course)
+	qtest.EnrollStudent(t, db, other, course)
+	for _, user := range []*pb.User{student, other} {
+		if err := db.CreateRepository(&pb.Repository{
+			OrganizationID: 1,
+			RepositoryID:   user.ID,
+			UserID:         user.ID,
+			RepoType:       pb.Repository_USER,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", ScriptFile: "go.sh", Order: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	// the student has two submissions; only the latest is regraded
+	for _, user := range []*pb.User{student, student, other} {
+		if err := db.CreateSubmission(&pb.Submission{AssignmentID: assignment.ID, UserID: user.ID}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	request := &pb.AssignmentRequest{CourseID: course.ID, AssignmentID: assignment.ID}
+	teacherCtx := withUserContext(context.Background(), teacher)
+	studentCtx := withUserContext(context.Background(), student)
+
+	if _, err := ags.RegradeAssignment(studentCtx, request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("RegradeAssignment() by student: error = %v, want code %v", err, codes.PermissionDenied)
+	}
+	if _, err := ags.GetRegradeProgress(teacherCtx, request); status.Code(err) != codes.NotFound {
+		t.Errorf("GetRegradeProgress() before regrade: error = %v, want code %v", err, codes.NotFound)
+	}
+	progress, err := ags.RegradeAssignment(teacherCtx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if progress.GetTotal() != 2 || progress.GetTriggeredBy() != teacher.GetLogin() {
+		t.Errorf("RegradeAssignment() = %d submissions by %q, want 2 submissions by %q", progress.GetTotal(), progress.GetTriggeredBy(), teacher.GetLogin())
+	}
+
+	progress = waitForRegrade(t, ags, teacherCtx, request)
+	if progress.GetFailed() != 0 {
+		t.Errorf("GetRegradeProgress() = %d failed submissions, want 0", progress.GetFailed())
+	}
+	if _, err := ags.GetRegradeProgress(studentCtx, request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetRegradeProgress() by student: error = %v, want code %v", err, codes.PermissionDenied)
+	}
+	// a completed regrade can be restarted
+	if _, err := ags.RegradeAssignment(teacherCtx, request); err != nil {
+		t.Fatalf("RegradeAssignment() after completed regrade failed: %v", err)
+	}
+	waitForRegrade(t, ags, teacherCtx, request)
+}
+
+// waitForRegrade waits for the regrade of the requested assignment to complete, and returns its progress.
+func waitForRegrade(t *testing.T, ags *web.AutograderService, ctx context.Context, request *pb.AssignmentRequest) *pb.RegradeProgress {
+	t.Helper()
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		progress, err := ags.GetRegradeProgress(ctx, request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if progress.GetCompleted() == progress.GetTotal() {
+			return progress
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("regrade did not complete: %d of %d submissions regraded", progress.GetCompleted(), progress.GetTotal())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestRebuildSubmissionOtherCourse(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacherA := qtest.CreateFakeUser(t, db, 1)
+	teacherB := qtest.CreateFakeUser(t, db, 2)
+	student := qtest.CreateFakeUser(t, db, 3)
+	if err := db.UpdateUser(&pb.User{ID: teacherB.ID, IsAdmin: true}); err != nil {
+		t.Fatal(err)
+	}
+	courseA := &pb.Course{Code: "A", Provider: "fake", OrganizationID: 1}
+	qtest.CreateCourse(t, db, teacherA, courseA)
+	courseB := &pb.Course{Code: "B", Provider: "fake", OrganizationID: 2}
+	qtest.CreateCourse(t, db, teacherB, courseB)
+	qtest.EnrollStudent(t, db, student, courseB)
+	labA := &pb.Assignment{CourseID: courseA.ID, Name: "lab1", ScriptFile: "go.sh", Order: 1}
+	labB := &pb.Assignment{CourseID: courseB.ID, Name: "lab1", ScriptFile: "go.sh", Order: 1}
+	for _, assignment := range []*pb.Assignment{labA, labB} {
+		if err := db.CreateAssignment(assignment); err != nil {
+			t.Fatal(err)
+		}
+	}
+	submission := &pb.Submission{AssignmentID: labB.ID, UserID: student.ID, Score: 80}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ctx := withUserContext(context.Background(), teacherA)
+	// the teacher of course A cannot rebuild, and thereby read, a submission of course B
+	for _, request := range []*pb.RebuildRequest{
+		{AssignmentID: labA.ID, SubmissionID: submission.ID},
+		{AssignmentID: labB.ID, SubmissionID: submission.ID},
+	} {
+		got, err := ags.RebuildSubmission(ctx, request)
+		if status.Code(err) != codes.PermissionDenied || got != nil {
+			t.Errorf("RebuildSubmission(%v) by teacher of other course = %v, %v; want code %v", request, got, err, codes.PermissionDenied)
+		}
+	}
+	// the teacher of course B must name the submission's own assignment
+	request := &pb.RebuildRequest{AssignmentID: labA.ID, SubmissionID: submission.ID}
+	if _, err := ags.RebuildSubmission(withUserContext(context.Background(), teacherB), request); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("RebuildSubmission(%v) with other course's assignment: error = %v, want code %v", request, err, codes.InvalidArgument)
+	}
+}
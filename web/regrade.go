@@ -0,0 +1,89 @@
+package web
+
+import (
+	"errors"
+	"sort"
+	"sync"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+var errRegradeInProgress = errors.New("regrade in progress")
+
+// regrades holds the progress of the latest regrade of each assignment, by assignment ID.
+// The progress of a completed regrade is kept until the assignment is regraded again.
+type regrades struct {
+	mu       sync.Mutex
+	progress map[uint64]*pb.RegradeProgress
+}
+
+func newRegrades() *regrades {
+	return &regrades{progress: make(map[uint64]*pb.RegradeProgress)}
+}
+
+// start records the start of a regrade of the given number of submissions for the given
+// assignment, and returns its progress. Returns errRegradeInProgress if the assignment's
+// previous regrade has not completed.
+func (r *regrades) start(assignmentID uint64, triggeredBy string, total int) (*pb.RegradeProgress, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if p, ok := r.progress[assignmentID]; ok && p.GetCompleted() < p.GetTotal() {
+		return nil, errRegradeInProgress
+	}
+	p := &pb.RegradeProgress{AssignmentID: assignmentID, TriggeredBy: triggeredBy, Total: uint32(total)}
+	r.progress[assignmentID] = p
+	return snapshot(p), nil
+}
+
+// complete records the completion of a test run of the given assignment's regrade.
+func (r *regrades) complete(assignmentID uint64, failed bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	p := r.progress[assignmentID]
+	p.Completed++
+	if failed {
+		p.Failed++
+	}
+}
+
+// get returns the progress of the latest regrade of the given assignment,
+// or false if the assignment has not been regraded.
+func (r *regrades) get(assignmentID uint64) (*pb.RegradeProgress, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	p, ok := r.progress[assignmentID]
+	if !ok {
+		return nil, false
+	}
+	return snapshot(p), true
+}
+
+// snapshot returns a copy of the given progress, which can be used without holding the lock.
+func snapshot(p *pb.RegradeProgress) *pb.RegradeProgress {
+	return &pb.RegradeProgress{
+		AssignmentID: p.GetAssignmentID(),
+		TriggeredBy:  p.GetTriggeredBy(),
+		Total:        p.GetTotal(),
+		Completed:    p.GetCompleted(),
+		Failed:       p.GetFailed(),
+	}
+}
+
+// latestSubmissions returns the latest of the given submissions of each student or group,
+// in the order they were created.
+func latestSubmissions(submissions []*pb.Submission) []*pb.Submission {
+	type owner struct{ userID, groupID uint64 }
+	latest := make(map[owner]*pb.Submission)
+	for _, submission := range submissions {
+		key := owner{submission.GetUserID(), submission.GetGroupID()}
+		if prev, ok := latest[key]; !ok || submission.GetID() > prev.GetID() {
+			latest[key] = submission
+		}
+	}
+	result := make([]*pb.Submission, 0, len(latest))
+	for _, submission := range latest {
+		result = append(result, submission)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].GetID() < result[j].GetID() })
+	return result
+}
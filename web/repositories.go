@@ -1,9 +1,12 @@
 package web
 
 import (
+	"context"
 	"fmt"
 
 	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/scm"
 )
 
 func (s *AutograderService) getUserRepo(course *pb.Course, userID uint64) (*pb.Repository, error) {
@@ -31,3 +34,43 @@ func (s *AutograderService) getGroupRepo(course *pb.Course, groupID uint64) (*pb
 	}
 	return repos[0], nil
 }
+
+// OrphanedRepositories returns the repositories in the course's organization on the SCM
+// that have no corresponding repository record in the database, or whose group no
+// longer exists, e.g., repositories of deleted groups or repositories created manually.
+// This allows admins to clean up the course's organization. The function only reads
+// from the SCM and the database; it does not delete any repositories.
+func OrphanedRepositories(ctx context.Context, sc scm.SCM, db database.Database, course *pb.Course) ([]*scm.Repository, error) {
+	org := &pb.Organization{ID: course.GetOrganizationID(), Path: course.GetOrganizationPath()}
+	scmRepos, err := sc.GetRepositories(ctx, org)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repositories for course %s: %w", course.GetCode(), err)
+	}
+	dbRepos, err := db.GetRepositories(&pb.Repository{OrganizationID: course.GetOrganizationID()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository records for course %s: %w", course.GetCode(), err)
+	}
+	groups, err := db.GetGroupsByCourse(course.GetID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get groups for course %s: %w", course.GetCode(), err)
+	}
+	groupExists := make(map[uint64]bool, len(groups))
+	for _, group := range groups {
+		groupExists[group.GetID()] = true
+	}
+	known := make(map[uint64]bool, len(dbRepos))
+	for _, repo := range dbRepos {
+		if repo.GetRepoType() == pb.Repository_GROUP && !groupExists[repo.GetGroupID()] {
+			// the group was deleted, but its repository record remains
+			continue
+		}
+		known[repo.GetRepositoryID()] = true
+	}
+	var orphans []*scm.Repository
+	for _, repo := range scmRepos {
+		if !known[repo.ID] {
+			orphans = append(orphans, repo)
+		}
+	}
+	return orphans, nil
+}
@@ -0,0 +1,94 @@
+package web_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/scm"
+	"github.com/autograde/quickfeed/web"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestOrphanedRepositories(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	student1 := qtest.CreateFakeUser(t, db, 2)
+	student2 := qtest.CreateFakeUser(t, db, 3)
+	course := &pb.Course{Code: "DAT320", OrganizationID: 1, OrganizationPath: "dat320"}
+	qtest.CreateCourse(t, db, admin, course)
+	qtest.EnrollStudent(t, db, student1, course)
+	qtest.EnrollStudent(t, db, student2, course)
+
+	sc, _ := qtest.FakeProviderMap(t)
+	ctx := context.Background()
+	org := &pb.Organization{ID: course.OrganizationID, Path: course.OrganizationPath}
+	createRepo := func(path string) *scm.Repository {
+		t.Helper()
+		repo, err := sc.CreateRepository(ctx, &scm.CreateRepositoryOptions{Organization: org, Path: path})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return repo
+	}
+	recordRepo := func(repo *scm.Repository, repoType pb.Repository_Type, userID, groupID uint64) {
+		t.Helper()
+		if err := db.CreateRepository(&pb.Repository{
+			OrganizationID: course.OrganizationID,
+			RepositoryID:   repo.ID,
+			UserID:         userID,
+			GroupID:        groupID,
+			HTMLURL:        repo.WebURL,
+			RepoType:       repoType,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	recordRepo(createRepo(pb.InfoRepo), pb.Repository_COURSEINFO, 0, 0)
+	recordRepo(createRepo(pb.TestsRepo), pb.Repository_TESTS, 0, 0)
+	recordRepo(createRepo(student1.Login+"-labs"), pb.Repository_USER, student1.ID, 0)
+	group := &pb.Group{Name: "active_group", CourseID: course.ID, Users: []*pb.User{student1}}
+	if err := db.CreateGroup(group); err != nil {
+		t.Fatal(err)
+	}
+	recordRepo(createRepo(group.Name), pb.Repository_GROUP, 0, group.ID)
+
+	// a repository created manually on the SCM
+	manual := createRepo("manual")
+	// a repository whose group was deleted
+	deletedGroup := &pb.Group{Name: "deleted_group", CourseID: course.ID, Users: []*pb.User{student2}}
+	if err := db.CreateGroup(deletedGroup); err != nil {
+		t.Fatal(err)
+	}
+	deleted := createRepo(deletedGroup.Name)
+	recordRepo(deleted, pb.Repository_GROUP, 0, deletedGroup.ID)
+	if err := db.DeleteGroup(deletedGroup.ID); err != nil {
+		t.Fatal(err)
+	}
+	// repositories of other organizations are ignored
+	if _, err := sc.CreateRepository(ctx, &scm.CreateRepositoryOptions{
+		Organization: &pb.Organization{ID: 2, Path: "other"},
+		Path:         "unrelated",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	orphans, err := web.OrphanedRepositories(ctx, sc, db, course)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, repo := range orphans {
+		got = append(got, repo.Path)
+	}
+	sort.Strings(got)
+	want := []string{deleted.Path, manual.Path}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("OrphanedRepositories() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"reflect"
 	"testing"
+	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/ci"
@@ -14,6 +15,8 @@ import (
 	"github.com/autograde/quickfeed/web"
 	"github.com/google/go-cmp/cmp"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/testing/protocmp"
 )
 
@@ -778,3 +781,234 @@ func isApproved(requirements int, approved []bool) bool {
 	}
 	return requirements <= 0
 }
+
+func TestGetSubmissionsHidePoints(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	student := qtest.CreateFakeUser(t, db, 2)
+	course := &pb.Course{}
+	qtest.CreateCourse(t, db, teacher, course)
+	qtest.EnrollStudent(t, db, student, course)
+
+	assignments := []*pb.Assignment{
+		{CourseID: course.ID, Name: "lab1", Order: 1, HidePoints: true},
+		{CourseID: course.ID, Name: "lab2", Order: 2},
+	}
+	for _, assignment := range assignments {
+		if err := db.CreateAssignment(assignment); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.CreateSubmission(&pb.Submission{
+			AssignmentID: assignment.ID,
+			UserID:       student.ID,
+			Score:        50,
+			Scores: []*score.Score{
+				{TestName: "TestPass", Score: 5, MaxScore: 5, Weight: 1},
+				{TestName: "TestFail", Score: 0, MaxScore: 5, Weight: 1},
+			},
+			MatrixResults: []*pb.MatrixResult{{Name: "go1.17", Image: "golang:1.17", Score: 50}},
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	request := &pb.SubmissionRequest{CourseID: course.ID, UserID: student.ID}
+
+	pointsShown := []*score.Score{
+		{TestName: "TestPass", Score: 5, MaxScore: 5, Weight: 1},
+		{TestName: "TestFail", Score: 0, MaxScore: 5, Weight: 1},
+	}
+	pointsHidden := []*score.Score{
+		{TestName: "TestPass", Score: 1, MaxScore: 1, Weight: 1},
+		{TestName: "TestFail", Score: 0, MaxScore: 1, Weight: 1},
+	}
+	tests := []struct {
+		name       string
+		user       *pb.User
+		wantScore  []uint32
+		wantScores [][]*score.Score
+	}{
+		{name: "student", user: student, wantScore: []uint32{0, 50}, wantScores: [][]*score.Score{pointsHidden, pointsShown}},
+		{name: "teacher", user: teacher, wantScore: []uint32{50, 50}, wantScores: [][]*score.Score{pointsShown, pointsShown}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			submissions, err := ags.GetSubmissions(withUserContext(context.Background(), tt.user), request)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(submissions.GetSubmissions()) != len(assignments) {
+				t.Fatalf("GetSubmissions() returned %d submissions, want %d", len(submissions.GetSubmissions()), len(assignments))
+			}
+			for i, submission := range submissions.GetSubmissions() {
+				if submission.GetScore() != tt.wantScore[i] {
+					t.Errorf("GetSubmissions()[%d].Score = %d, want %d", i, submission.GetScore(), tt.wantScore[i])
+				}
+				if len(submission.GetMatrixResults()) != 1 {
+					t.Fatalf("GetSubmissions()[%d] has %d matrix results, want 1", i, len(submission.GetMatrixResults()))
+				}
+				for _, result := range submission.GetMatrixResults() {
+					if result.GetScore() != tt.wantScore[i] {
+						t.Errorf("GetSubmissions()[%d].MatrixResults[%s].Score = %d, want %d", i, result.GetName(), result.GetScore(), tt.wantScore[i])
+					}
+				}
+				if diff := cmp.Diff(tt.wantScores[i], submission.GetScores(), protocmp.Transform(),
+					protocmp.IgnoreFields(&score.Score{}, "ID", "SubmissionID")); diff != "" {
+					t.Errorf("GetSubmissions()[%d].Scores mismatch (-want +got):\n%s", i, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestGetSubmissionsHiddenTests(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	student := qtest.CreateFakeUser(t, db, 2)
+	course := &pb.Course{}
+	qtest.CreateCourse(t, db, teacher, course)
+	qtest.EnrollStudent(t, db, student, course)
+
+	hiddenTests := []*pb.TestConfig{{TestName: "TestHidden", Hidden: true}}
+	assignments := []*pb.Assignment{
+		// before the deadline, the hidden test is not shown to students
+		{CourseID: course.ID, Name: "lab1", Order: 1, Deadline: time.Now().Add(24 * time.Hour).Format(pb.TimeLayout), Tests: hiddenTests, Matrix: "go1.17=golang:1.17"},
+		// after the deadline, the hidden test is shown
+		{CourseID: course.ID, Name: "lab2", Order: 2, Deadline: time.Now().Add(-24 * time.Hour).Format(pb.TimeLayout), Tests: hiddenTests, Matrix: "go1.17=golang:1.17"},
+	}
+	for _, assignment := range assignments {
+		if err := db.CreateAssignment(assignment); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.CreateSubmission(&pb.Submission{
+			AssignmentID: assignment.ID,
+			UserID:       student.ID,
+			Score:        50,
+			Scores: []*score.Score{
+				{TestName: "TestPass", Score: 5, MaxScore: 5, Weight: 1},
+				{TestName: "TestHidden", Score: 0, MaxScore: 5, Weight: 1},
+			},
+			// the matrix entry's score includes the hidden test
+			MatrixResults: []*pb.MatrixResult{{Name: "go1.17", Image: "golang:1.17", Score: 50}},
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	request := &pb.SubmissionRequest{CourseID: course.ID, UserID: student.ID}
+
+	allTests := []*score.Score{
+		{TestName: "TestPass", Score: 5, MaxScore: 5, Weight: 1},
+		{TestName: "TestHidden", Score: 0, MaxScore: 5, Weight: 1},
+	}
+	visibleTests := []*score.Score{
+		{TestName: "TestPass", Score: 5, MaxScore: 5, Weight: 1},
+	}
+	tests := []struct {
+		name            string
+		user            *pb.User
+		wantScore       []uint32
+		wantMatrixScore []uint32
+		wantScores      [][]*score.Score
+	}{
+		{name: "student", user: student, wantScore: []uint32{100, 50}, wantMatrixScore: []uint32{0, 50}, wantScores: [][]*score.Score{visibleTests, allTests}},
+		{name: "teacher", user: teacher, wantScore: []uint32{50, 50}, wantMatrixScore: []uint32{50, 50}, wantScores: [][]*score.Score{allTests, allTests}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			submissions, err := ags.GetSubmissions(withUserContext(context.Background(), tt.user), request)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(submissions.GetSubmissions()) != len(assignments) {
+				t.Fatalf("GetSubmissions() returned %d submissions, want %d", len(submissions.GetSubmissions()), len(assignments))
+			}
+			for i, submission := range submissions.GetSubmissions() {
+				if submission.GetScore() != tt.wantScore[i] {
+					t.Errorf("GetSubmissions()[%d].Score = %d, want %d", i, submission.GetScore(), tt.wantScore[i])
+				}
+				if len(submission.GetMatrixResults()) != 1 {
+					t.Fatalf("GetSubmissions()[%d] has %d matrix results, want 1", i, len(submission.GetMatrixResults()))
+				}
+				if got := submission.GetMatrixResults()[0].GetScore(); got != tt.wantMatrixScore[i] {
+					t.Errorf("GetSubmissions()[%d].MatrixResults[0].Score = %d, want %d", i, got, tt.wantMatrixScore[i])
+				}
+				if diff := cmp.Diff(tt.wantScores[i], submission.GetScores(), protocmp.Transform(),
+					protocmp.IgnoreFields(&score.Score{}, "ID", "SubmissionID")); diff != "" {
+					t.Errorf("GetSubmissions()[%d].Scores mismatch (-want +got):\n%s", i, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestGetArtifactsAccess(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	student := qtest.CreateFakeUser(t, db, 2)
+	other := qtest.CreateFakeUser(t, db, 3)
+	course := &pb.Course{}
+	qtest.CreateCourse(t, db, teacher, course)
+	qtest.EnrollStudent(t, db, student, course)
+	qtest.EnrollStudent(t, db, other, course)
+
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	submission := &pb.Submission{AssignmentID: assignment.ID, UserID: student.ID}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateArtifacts(submission.ID, []*pb.Artifact{
+		{Name: "coverage.html", Size: 4, Content: []byte("html")},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	request := &pb.ArtifactRequest{CourseID: course.ID, SubmissionID: submission.ID, Name: "coverage.html"}
+
+	tests := []struct {
+		name     string
+		user     *pb.User
+		wantCode codes.Code
+	}{
+		{name: "teacher", user: teacher, wantCode: codes.OK},
+		{name: "student", user: student, wantCode: codes.OK},
+		{name: "other student", user: other, wantCode: codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := withUserContext(context.Background(), tt.user)
+			artifacts, err := ags.GetArtifacts(ctx, request)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("GetArtifacts() error = %v, want code %v", err, tt.wantCode)
+			}
+			artifact, err := ags.GetArtifact(ctx, request)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("GetArtifact() error = %v, want code %v", err, tt.wantCode)
+			}
+			if tt.wantCode != codes.OK {
+				return
+			}
+			if len(artifacts.GetArtifacts()) != 1 || artifacts.GetArtifacts()[0].GetName() != "coverage.html" {
+				t.Errorf("GetArtifacts() = %v, want coverage.html", artifacts.GetArtifacts())
+			}
+			if string(artifact.GetContent()) != "html" {
+				t.Errorf("GetArtifact() content = %q, want %q", artifact.GetContent(), "html")
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"time"
 
+	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/internal/rand"
 	"github.com/autograde/quickfeed/web/auth"
 	"github.com/autograde/quickfeed/web/hooks"
@@ -44,6 +45,7 @@ func New(ags *AutograderService, public, httpAddr string) {
 	enabled := enableProviders(ags.logger, ags.bh.BaseURL)
 	registerWebhooks(ags, e, enabled)
 	registerAuth(ags, e)
+	registerAPI(ags, e)
 
 	registerFrontend(e, entryPoint, public)
 	runWebServer(ags.logger, e, httpAddr)
@@ -107,7 +109,7 @@ func enableProviders(l *zap.SugaredLogger, baseURL string) map[string]bool {
 
 func registerWebhooks(ags *AutograderService, e *echo.Echo, enabled map[string]bool) {
 	if enabled["github"] {
-		ghHook := hooks.NewGitHubWebHook(ags.logger, ags.db, ags.runner, ags.bh.Secret)
+		ghHook := hooks.NewGitHubWebHook(ags.logger, ags.db, ags.queue, ags.bh.Secret)
 		e.POST("/hook/github/events", func(c echo.Context) error {
 			ghHook.Handle(c.Response(), c.Request())
 			return nil
@@ -115,7 +117,7 @@ func registerWebhooks(ags *AutograderService, e *echo.Echo, enabled map[string]b
 	}
 	if enabled["gitlab"] {
 		// TODO(meling) fix gitlab
-		glHook := hooks.NewGitHubWebHook(ags.logger, ags.db, ags.runner, ags.bh.Secret)
+		glHook := hooks.NewGitHubWebHook(ags.logger, ags.db, ags.queue, ags.bh.Secret)
 		e.POST("/hook/gitlab/events", func(c echo.Context) error {
 			glHook.Handle(c.Response(), c.Request())
 			return nil
@@ -141,6 +143,14 @@ func registerAuth(ags *AutograderService, e *echo.Echo) {
 	e.GET("/logout", auth.OAuth2Logout(ags.logger))
 }
 
+func registerAPI(ags *AutograderService, e *echo.Echo) {
+	api := e.Group("/api/v1")
+	teacher := api.Group("/courses/:"+auth.CourseParam, auth.RequireCourseRole(ags.db, pb.Enrollment_TEACHER))
+	teacher.POST("/assignments/:aid/grade-preview", ags.GradePreviewHandler)
+	teacher.GET("/groups/:gid/members", ags.GroupMembersHandler)
+	teacher.GET("/groups/export", ags.GroupsCSVHandler)
+}
+
 func registerFrontend(e *echo.Echo, entryPoint, public string) {
 	index := func(c echo.Context) error {
 		return c.File(entryPoint)